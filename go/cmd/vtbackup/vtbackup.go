@@ -43,6 +43,11 @@ The process vtbackup follows to take a new backup is as follows:
 5. Wait until replication is caught up to the goal position or beyond.
 6. Stop mysqld and take a new backup.
 
+With -backup_binlogs, the last step instead stores the binary logs vtbackup's
+mysqld wrote while catching up, as an incremental binlog backup that
+RestoreFromBackup can apply on top of a full backup to restore a tablet to a
+point in time.
+
 Aside from additional replication load while vtbackup's mysqld catches up on
 new transactions, the shard should be otherwise unaffected. Existing tablets
 will continue to serve, and no new tablets will appear in topology, meaning no
@@ -113,6 +118,7 @@ var (
 
 	initialBackup    = flag.Bool("initial_backup", false, "Instead of restoring from backup, initialize an empty database with the provided init_db_sql_file and upload a backup of that for the shard, if the shard has no backups yet. This can be used to seed a brand new shard with an initial, empty backup. If any backups already exist for the shard, this will be considered a successful no-op. This can only be done before the shard exists in topology (i.e. before any tablets are deployed).")
 	allowFirstBackup = flag.Bool("allow_first_backup", false, "Allow this job to take the first backup of an existing shard.")
+	backupBinlogs    = flag.Bool("backup_binlogs", false, "Instead of taking a full backup, back up the binary logs written since the most recent backup of the shard, for point-in-time recovery. A full backup must already exist.")
 
 	// vttablet-like flags
 	initDbNameOverride = flag.String("init_db_name_override", "", "(init parameter) override the name of the db used by vttablet")
@@ -393,7 +399,11 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	}
 
 	// Now we can take a new backup.
-	if err := mysqlctl.Backup(ctx, backupParams); err != nil {
+	if *backupBinlogs {
+		if err := mysqlctl.BackupBinlogs(ctx, backupParams); err != nil {
+			return fmt.Errorf("error taking binlog backup: %v", err)
+		}
+	} else if err := mysqlctl.Backup(ctx, backupParams); err != nil {
 		return fmt.Errorf("error taking backup: %v", err)
 	}
 
@@ -516,10 +526,17 @@ func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage
 		log.Infof("Found %v backups. Not pruning any since this is within the min_retention_count of %v.", numBackups, *minRetentionCount)
 		return nil
 	}
+	// Binlog backups can only be restored on top of a full backup, so the
+	// most recent full backup is never pruned, nor anything newer than it.
+	lastFullBackup := lastCompleteFullBackup(ctx, backups)
 	// We have more than the minimum retention count, so we could afford to
 	// prune some. See if any are beyond the minimum retention time.
 	// ListBackups returns them sorted by oldest first.
 	for _, backup := range backups {
+		if lastFullBackup != nil && backup.Name() == lastFullBackup.Name() {
+			log.Infof("Backup %v is the most recent full backup. Nothing left to prune.", backup.Name())
+			break
+		}
 		backupTime, err := parseBackupTime(backup.Name())
 		if err != nil {
 			return err
@@ -658,6 +675,18 @@ func lastCompleteBackup(ctx context.Context, backups []backupstorage.BackupHandl
 	return nil
 }
 
+func lastCompleteFullBackup(ctx context.Context, backups []backupstorage.BackupHandle) backupstorage.BackupHandle {
+	for i := len(backups) - 1; i >= 0; i-- {
+		manifest, err := mysqlctl.GetBackupManifest(ctx, backups[i])
+		if err != nil || manifest.Incremental {
+			continue
+		}
+		return backups[i]
+	}
+
+	return nil
+}
+
 func checkBackupComplete(ctx context.Context, backup backupstorage.BackupHandle) error {
 	manifest, err := mysqlctl.GetBackupManifest(ctx, backup)
 	if err != nil {
@@ -17,22 +17,139 @@ limitations under the License.
 package command
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vttime"
 )
 
-// GetBackups makes a GetBackups gRPC call to a vtctld.
-var GetBackups = &cobra.Command{
-	Use:  "GetBackups <keyspace/shard>",
-	Args: cobra.ExactArgs(1),
-	RunE: commandGetBackups,
+var (
+	// Backup makes a Backup gRPC call to a vtctld.
+	Backup = &cobra.Command{
+		Use:                   "Backup [--concurrency <concurrency>] [--allow-primary] <tablet_alias>",
+		Short:                 "Uses the BackupStorage service on the given tablet to create and store a new backup.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandBackup,
+	}
+	// BackupShard makes a BackupShard gRPC call to a vtctld.
+	BackupShard = &cobra.Command{
+		Use:   "BackupShard [--concurrency <concurrency>] [--allow-primary] <keyspace/shard>",
+		Short: "Finds the most up-to-date REPLICA, RDONLY, or SPARE tablet in the given shard and uses the BackupStorage service on that tablet to create and store a new backup.",
+		Long: `Finds the most up-to-date REPLICA, RDONLY, or SPARE tablet in the given shard and uses the BackupStorage service on that tablet to create and store a new backup.
+
+If no replica-type tablet can be found, the backup can be taken on the primary if --allow-primary is specified.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandBackupShard,
+	}
+	// GetBackups makes a GetBackups gRPC call to a vtctld.
+	GetBackups = &cobra.Command{
+		Use:  "GetBackups <keyspace/shard>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandGetBackups,
+	}
+	// RemoveBackup makes a RemoveBackup gRPC call to a vtctld.
+	RemoveBackup = &cobra.Command{
+		Use:                   "RemoveBackup <keyspace/shard> <backup name>",
+		Short:                 "Removes the given backup from the BackupStorage used by vtctld.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandRemoveBackup,
+	}
+	// RestoreFromBackup makes a RestoreFromBackup gRPC call to a vtctld.
+	RestoreFromBackup = &cobra.Command{
+		Use:   "RestoreFromBackup [--restore-to-pos <position> | --restore-to-timestamp <timestamp>] <tablet_alias>",
+		Short: "Stops mysqld on the specified tablet and restores the data from the latest backup.",
+		Long: `Stops mysqld on the specified tablet and restores the data from the latest backup.
+
+If --restore-to-pos or --restore-to-timestamp is specified, the tablet restores the most recent full backup before that point, then applies binlog backups to reach it. The tablet is then left DRAINED, without replication, so it does not move past that point.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandRestoreFromBackup,
+	}
+)
+
+var backupOptions = struct {
+	AllowPrimary bool
+	Concurrency  uint64
+}{}
+
+func commandBackup(cmd *cobra.Command, args []string) error {
+	tabletAlias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	stream, err := client.Backup(commandCtx, &vtctldatapb.BackupRequest{
+		TabletAlias:  tabletAlias,
+		AllowPrimary: backupOptions.AllowPrimary,
+		Concurrency:  backupOptions.Concurrency,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		switch err {
+		case nil:
+			fmt.Printf("%s/%s (%s): %v\n", resp.Keyspace, resp.Shard, topoproto.TabletAliasString(resp.TabletAlias), logutil.EventString(resp.Event))
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+var backupShardOptions = struct {
+	AllowPrimary bool
+	Concurrency  uint64
+}{}
+
+func commandBackupShard(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	stream, err := client.BackupShard(commandCtx, &vtctldatapb.BackupShardRequest{
+		Keyspace:     keyspace,
+		Shard:        shard,
+		AllowPrimary: backupShardOptions.AllowPrimary,
+		Concurrency:  backupShardOptions.Concurrency,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		switch err {
+		case nil:
+			fmt.Printf("%s/%s (%s): %v\n", resp.Keyspace, resp.Shard, topoproto.TabletAliasString(resp.TabletAlias), logutil.EventString(resp.Event))
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
 }
 
 var getBackupsOptions = struct {
@@ -77,8 +194,101 @@ func commandGetBackups(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandRemoveBackup(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	name := cmd.Flags().Arg(1)
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.RemoveBackup(commandCtx, &vtctldatapb.RemoveBackupRequest{
+		Keyspace: keyspace,
+		Shard:    shard,
+		Name:     name,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully removed backup %s from %s/%s\n", name, keyspace, shard)
+
+	return nil
+}
+
+var restoreFromBackupOptions = struct {
+	RestoreToPos       string
+	RestoreToTimestamp string
+}{}
+
+func commandRestoreFromBackup(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if restoreFromBackupOptions.RestoreToPos != "" && restoreFromBackupOptions.RestoreToTimestamp != "" {
+		return errors.New("--restore-to-pos and --restore-to-timestamp are mutually exclusive")
+	}
+
+	if restoreFromBackupOptions.RestoreToPos != "" {
+		if _, err := mysql.DecodePosition(restoreFromBackupOptions.RestoreToPos); err != nil {
+			return fmt.Errorf("cannot parse --restore-to-pos: %w", err)
+		}
+	}
+
+	var restoreToTimestamp *vttime.Time
+	if restoreFromBackupOptions.RestoreToTimestamp != "" {
+		t, err := time.Parse(time.RFC3339, restoreFromBackupOptions.RestoreToTimestamp)
+		if err != nil {
+			return fmt.Errorf("cannot parse --restore-to-timestamp as RFC3339: %w", err)
+		}
+
+		restoreToTimestamp = logutil.TimeToProto(t)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	stream, err := client.RestoreFromBackup(commandCtx, &vtctldatapb.RestoreFromBackupRequest{
+		TabletAlias:        alias,
+		RestoreToPos:       restoreFromBackupOptions.RestoreToPos,
+		RestoreToTimestamp: restoreToTimestamp,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		switch err {
+		case nil:
+			fmt.Printf("%s/%s (%s): %v\n", resp.Keyspace, resp.Shard, topoproto.TabletAliasString(resp.TabletAlias), logutil.EventString(resp.Event))
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
 func init() {
+	Backup.Flags().BoolVar(&backupOptions.AllowPrimary, "allow-primary", false, "Allow the backup to occur on a PRIMARY tablet. See the --allow-primary flag of BackupShard for warnings and caveats.")
+	Backup.Flags().Uint64Var(&backupOptions.Concurrency, "concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously.")
+	Root.AddCommand(Backup)
+
+	BackupShard.Flags().BoolVar(&backupShardOptions.AllowPrimary, "allow-primary", false, "Allow the backup to occur on a PRIMARY tablet if no replica-type tablet is available. If using the builtin backup engine, this will shutdown mysqld on the primary for as long as it takes to create the backup.")
+	BackupShard.Flags().Uint64Var(&backupShardOptions.Concurrency, "concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously.")
+	Root.AddCommand(BackupShard)
+
 	GetBackups.Flags().Uint32VarP(&getBackupsOptions.Limit, "limit", "l", 0, "Retrieve only the most recent N backups")
 	GetBackups.Flags().BoolVarP(&getBackupsOptions.OutputJSON, "json", "j", false, "Output backup info in JSON format rather than a list of backups")
 	Root.AddCommand(GetBackups)
+
+	Root.AddCommand(RemoveBackup)
+
+	RestoreFromBackup.Flags().StringVar(&restoreFromBackupOptions.RestoreToPos, "restore-to-pos", "", "Restore to this replication position, in <flavor>/<position> format, using binlog backups.")
+	RestoreFromBackup.Flags().StringVar(&restoreFromBackupOptions.RestoreToTimestamp, "restore-to-timestamp", "", "Restore to this point in time, as a timestamp in RFC3339 format, using binlog backups.")
+	Root.AddCommand(RestoreFromBackup)
 }
@@ -72,6 +72,22 @@ var (
 		Args: cobra.ExactArgs(2),
 		RunE: commandRemoveKeyspaceCell,
 	}
+	// ValidateKeyspace makes a ValidateKeyspace gRPC call to a vtctld.
+	ValidateKeyspace = &cobra.Command{
+		Use:                   "ValidateKeyspace [--ping-tablets] <keyspace>",
+		Short:                 "Validates that all nodes reachable from the specified keyspace are consistent.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandValidateKeyspace,
+	}
+	// ValidateVersionKeyspace makes a ValidateVersionKeyspace gRPC call to a vtctld.
+	ValidateVersionKeyspace = &cobra.Command{
+		Use:                   "ValidateVersionKeyspace <keyspace>",
+		Short:                 "Validates that the version on the primary of the first shard matches all of the other tablets in the keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandValidateVersionKeyspace,
+	}
 )
 
 var createKeyspaceOptions = struct {
@@ -266,6 +282,61 @@ func commandRemoveKeyspaceCell(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var validateKeyspaceOptions = struct {
+	PingTablets bool
+}{}
+
+func commandValidateKeyspace(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	keyspace := cmd.Flags().Arg(0)
+	resp, err := client.ValidateKeyspace(commandCtx, &vtctldatapb.ValidateKeyspaceRequest{
+		Keyspace:    keyspace,
+		PingTablets: validateKeyspaceOptions.PingTablets,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	if len(resp.Results) > 0 {
+		return fmt.Errorf("keyspace %s has %d validation errors", keyspace, len(resp.Results))
+	}
+
+	return nil
+}
+
+func commandValidateVersionKeyspace(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	keyspace := cmd.Flags().Arg(0)
+	resp, err := client.ValidateVersionKeyspace(commandCtx, &vtctldatapb.ValidateVersionKeyspaceRequest{
+		Keyspace: keyspace,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	if len(resp.Results) > 0 {
+		return fmt.Errorf("version diffs found in keyspace %s", keyspace)
+	}
+
+	return nil
+}
+
 func init() {
 	CreateKeyspace.Flags().BoolVarP(&createKeyspaceOptions.Force, "force", "f", false, "Proceeds even if the keyspace already exists. Does not overwrite the existing keyspace record")
 	CreateKeyspace.Flags().BoolVarP(&createKeyspaceOptions.AllowEmptyVSchema, "allow-empty-vschema", "e", false, "Allows a new keyspace to have no vschema")
@@ -287,4 +358,9 @@ func init() {
 	RemoveKeyspaceCell.Flags().BoolVarP(&removeKeyspaceCellOptions.Force, "force", "f", false, "Proceed even if the cell's topology server cannot be reached. The assumption is that you turned down the entire cell, and just need to update the global topo data.")
 	RemoveKeyspaceCell.Flags().BoolVarP(&removeKeyspaceCellOptions.Recursive, "recursive", "r", false, "Also delete all tablets in that cell beloning to the specified keyspace.")
 	Root.AddCommand(RemoveKeyspaceCell)
+
+	ValidateKeyspace.Flags().BoolVarP(&validateKeyspaceOptions.PingTablets, "ping-tablets", "p", false, "Indicates whether all tablets should be pinged during the validation process.")
+	Root.AddCommand(ValidateKeyspace)
+
+	Root.AddCommand(ValidateVersionKeyspace)
 }
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// ApplyRangeMaps makes an ApplyRangeMaps gRPC call to a vtctld.
+	ApplyRangeMaps = &cobra.Command{
+		Use:                   "ApplyRangeMaps {--range-maps RANGE_MAPS | --range-maps-file RANGE_MAPS_FILE} [--cells=c1,c2,...] [--skip-rebuild] [--dry-run]",
+		Short:                 "Applies the range maps of the range_map vindexes.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE:                  commandApplyRangeMaps,
+	}
+	// GetRangeMaps makes a GetRangeMaps gRPC call to a vtctld.
+	GetRangeMaps = &cobra.Command{
+		Use:                   "GetRangeMaps",
+		Short:                 "Displays the range maps of the range_map vindexes.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE:                  commandGetRangeMaps,
+	}
+)
+
+var applyRangeMapsOptions = struct {
+	RangeMaps         string
+	RangeMapsFilePath string
+	Cells             []string
+	SkipRebuild       bool
+	DryRun            bool
+}{}
+
+func commandApplyRangeMaps(cmd *cobra.Command, args []string) error {
+	if applyRangeMapsOptions.RangeMaps != "" && applyRangeMapsOptions.RangeMapsFilePath != "" {
+		return fmt.Errorf("cannot pass both --range-maps (=%s) and --range-maps-file (=%s)", applyRangeMapsOptions.RangeMaps, applyRangeMapsOptions.RangeMapsFilePath)
+	}
+
+	if applyRangeMapsOptions.RangeMaps == "" && applyRangeMapsOptions.RangeMapsFilePath == "" {
+		return errors.New("must pass exactly one of --range-maps and --range-maps-file")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	var rangeMapsBytes []byte
+	if applyRangeMapsOptions.RangeMapsFilePath != "" {
+		data, err := ioutil.ReadFile(applyRangeMapsOptions.RangeMapsFilePath)
+		if err != nil {
+			return err
+		}
+
+		rangeMapsBytes = data
+	} else {
+		rangeMapsBytes = []byte(applyRangeMapsOptions.RangeMaps)
+	}
+
+	rm := &vschemapb.RangeMaps{}
+	if err := json2.Unmarshal(rangeMapsBytes, &rm); err != nil {
+		return err
+	}
+
+	// Round-trip so when we display the result it's readable.
+	data, err := cli.MarshalJSON(rm)
+	if err != nil {
+		return err
+	}
+
+	if applyRangeMapsOptions.DryRun {
+		fmt.Printf("[DRY RUN] Would have saved new RangeMaps object:\n%s\n", data)
+
+		if applyRangeMapsOptions.SkipRebuild {
+			fmt.Println("[DRY RUN] Would not have rebuilt VSchema graph, would have required operator to run RebuildVSchemaGraph for changes to take effect")
+		} else {
+			fmt.Print("[DRY RUN] Would have rebuilt the VSchema graph")
+			if len(applyRangeMapsOptions.Cells) == 0 {
+				fmt.Print(" in all cells\n")
+			} else {
+				fmt.Printf(" in the following cells: %s.\n", strings.Join(applyRangeMapsOptions.Cells, ", "))
+			}
+		}
+
+		return nil
+	}
+
+	_, err = client.ApplyRangeMaps(commandCtx, &vtctldatapb.ApplyRangeMapsRequest{
+		RangeMaps:    rm,
+		SkipRebuild:  applyRangeMapsOptions.SkipRebuild,
+		RebuildCells: applyRangeMapsOptions.Cells,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New RangeMaps object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", data)
+
+	if applyRangeMapsOptions.SkipRebuild {
+		fmt.Println("Skipping rebuild of VSchema graph, will need to run RebuildVSchemaGraph for changes to take effect.")
+	}
+
+	return nil
+}
+
+func commandGetRangeMaps(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetRangeMaps(commandCtx, &vtctldatapb.GetRangeMapsRequest{})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.RangeMaps)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	ApplyRangeMaps.Flags().StringVarP(&applyRangeMapsOptions.RangeMaps, "range-maps", "r", "", "Range maps, specified as a string")
+	ApplyRangeMaps.Flags().StringVarP(&applyRangeMapsOptions.RangeMapsFilePath, "range-maps-file", "f", "", "Path to a file containing range maps specified as JSON")
+	ApplyRangeMaps.Flags().StringSliceVarP(&applyRangeMapsOptions.Cells, "cells", "c", nil, "Limit the VSchema graph rebuilding to the specified cells. Ignored if --skip-rebuild is specified.")
+	ApplyRangeMaps.Flags().BoolVar(&applyRangeMapsOptions.SkipRebuild, "skip-rebuild", false, "Skip rebuilding the SrvVSchema objects.")
+	ApplyRangeMaps.Flags().BoolVarP(&applyRangeMapsOptions.DryRun, "dry-run", "d", false, "Load the specified range maps as a validation step, but do not actually apply them to the topo.")
+	Root.AddCommand(ApplyRangeMaps)
+
+	Root.AddCommand(GetRangeMaps)
+}
@@ -19,21 +19,125 @@ package command
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
-// GetSchema makes a GetSchema gRPC call to a vtctld.
-var GetSchema = &cobra.Command{
-	Use:  "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
-	Args: cobra.ExactArgs(1),
-	RunE: commandGetSchema,
+var (
+	// ApplySchema makes an ApplySchema gRPC call to a vtctld.
+	ApplySchema = &cobra.Command{
+		Use:   "ApplySchema [--allow-long-unavailability] [--ddl-strategy <strategy>] [--migration-context <context>] [--wait-replicas-timeout <duration>] [--skip-preflight] {--sql <sql> | --sql-file <file>} <keyspace>",
+		Short: "Applies the schema change to the specified keyspace on every primary, running in parallel on all shards.",
+		Long: `Applies the schema change to the specified keyspace on every primary, running in parallel on all shards.
+
+The changes are then propagated to replicas via replication. If --allow-long-unavailability is set, schema
+changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will
+not be rejected. --ddl-strategy is used to instruct migrations via vreplication, gh-ost or pt-osc with
+optional parameters. When an online DDL strategy is used, the UUIDs of the submitted migrations are
+printed, one per line.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandApplySchema,
+	}
+	// GetSchema makes a GetSchema gRPC call to a vtctld.
+	GetSchema = &cobra.Command{
+		Use:  "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
+		Args: cobra.ExactArgs(1),
+		RunE: commandGetSchema,
+	}
+	// ReloadSchema makes a ReloadSchema gRPC call to a vtctld.
+	ReloadSchema = &cobra.Command{
+		Use:                   "ReloadSchema <tablet_alias>",
+		Short:                 "Reloads the schema on a remote tablet.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandReloadSchema,
+	}
+	// ReloadSchemaKeyspace makes a ReloadSchemaKeyspace gRPC call to a vtctld.
+	ReloadSchemaKeyspace = &cobra.Command{
+		Use:                   "ReloadSchemaKeyspace [--concurrency=<concurrency>] [--include-primary] [--wait-position=<position>] <keyspace>",
+		Short:                 "Reloads the schema on all tablets in a keyspace. This is done on a best-effort basis.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandReloadSchemaKeyspace,
+	}
+	// ReloadSchemaShard makes a ReloadSchemaShard gRPC call to a vtctld.
+	ReloadSchemaShard = &cobra.Command{
+		Use:                   "ReloadSchemaShard [--concurrency=<concurrency>] [--include-primary] [--wait-position=<position>] <keyspace/shard>",
+		Short:                 "Reloads the schema on all tablets in a shard. This is done on a best-effort basis.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandReloadSchemaShard,
+	}
+	// ValidateSchemaKeyspace makes a ValidateSchemaKeyspace gRPC call to a vtctld.
+	ValidateSchemaKeyspace = &cobra.Command{
+		Use:                   "ValidateSchemaKeyspace [--exclude-tables=<exclude_tables>] [--include-views] [--skip-no-primary] [--include-vschema] <keyspace>",
+		Short:                 "Validates that the schema on the primary tablet for the first shard matches the schema on all other tablets in the keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandValidateSchemaKeyspace,
+	}
+)
+
+var applySchemaOptions = struct {
+	AllowLongUnavailability bool
+	SQL                     []string
+	SQLFile                 string
+	DDLStrategy             string
+	MigrationContext        string
+	WaitReplicasTimeout     time.Duration
+	SkipPreflight           bool
+}{}
+
+func commandApplySchema(cmd *cobra.Command, args []string) error {
+	var allSQL string
+	if applySchemaOptions.SQLFile != "" {
+		if len(applySchemaOptions.SQL) != 0 {
+			return errors.New("exactly one of --sql and --sql-file must be specified, not both")
+		}
+
+		data, err := ioutil.ReadFile(applySchemaOptions.SQLFile)
+		if err != nil {
+			return err
+		}
+
+		allSQL = string(data)
+	} else {
+		allSQL = strings.Join(applySchemaOptions.SQL, ";")
+	}
+
+	if allSQL == "" {
+		return errors.New("one of --sql or --sql-file must be specified")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ApplySchema(commandCtx, &vtctldatapb.ApplySchemaRequest{
+		Keyspace:                cmd.Flags().Arg(0),
+		AllowLongUnavailability: applySchemaOptions.AllowLongUnavailability,
+		Sql:                     []string{allSQL},
+		DdlStrategy:             applySchemaOptions.DDLStrategy,
+		MigrationContext:        applySchemaOptions.MigrationContext,
+		WaitReplicasTimeout:     protoutil.DurationToProto(applySchemaOptions.WaitReplicasTimeout),
+		SkipPreflight:           applySchemaOptions.SkipPreflight,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(strings.Join(resp.UuidList, "\n"))
+
+	return nil
 }
 
 var getSchemaOptions = struct {
@@ -90,7 +194,127 @@ func commandGetSchema(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandReloadSchema(cmd *cobra.Command, args []string) error {
+	tabletAlias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.ReloadSchema(commandCtx, &vtctldatapb.ReloadSchemaRequest{
+		TabletAlias: tabletAlias,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var reloadSchemaKeyspaceOptions = struct {
+	Concurrency    uint32
+	IncludePrimary bool
+	WaitPosition   string
+}{}
+
+func commandReloadSchemaKeyspace(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	logger := logutil.NewConsoleLogger()
+
+	resp, err := client.ReloadSchemaKeyspace(commandCtx, &vtctldatapb.ReloadSchemaKeyspaceRequest{
+		Keyspace:       cmd.Flags().Arg(0),
+		Concurrency:    reloadSchemaKeyspaceOptions.Concurrency,
+		IncludePrimary: reloadSchemaKeyspaceOptions.IncludePrimary,
+		WaitPosition:   reloadSchemaKeyspaceOptions.WaitPosition,
+	})
+	if resp != nil {
+		for _, e := range resp.Events {
+			logutil.LogEvent(logger, e)
+		}
+	}
+
+	return err
+}
+
+var reloadSchemaShardOptions = struct {
+	Concurrency    uint32
+	IncludePrimary bool
+	WaitPosition   string
+}{}
+
+func commandReloadSchemaShard(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	logger := logutil.NewConsoleLogger()
+
+	resp, err := client.ReloadSchemaShard(commandCtx, &vtctldatapb.ReloadSchemaShardRequest{
+		Keyspace:       keyspace,
+		Shard:          shard,
+		Concurrency:    reloadSchemaShardOptions.Concurrency,
+		IncludePrimary: reloadSchemaShardOptions.IncludePrimary,
+		WaitPosition:   reloadSchemaShardOptions.WaitPosition,
+	})
+	if resp != nil {
+		for _, e := range resp.Events {
+			logutil.LogEvent(logger, e)
+		}
+	}
+
+	return err
+}
+
+var validateSchemaKeyspaceOptions = struct {
+	ExcludeTables  []string
+	IncludeViews   bool
+	SkipNoPrimary  bool
+	IncludeVSchema bool
+}{}
+
+func commandValidateSchemaKeyspace(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ValidateSchemaKeyspace(commandCtx, &vtctldatapb.ValidateSchemaKeyspaceRequest{
+		Keyspace:       cmd.Flags().Arg(0),
+		ExcludeTables:  validateSchemaKeyspaceOptions.ExcludeTables,
+		IncludeViews:   validateSchemaKeyspaceOptions.IncludeViews,
+		SkipNoPrimary:  validateSchemaKeyspaceOptions.SkipNoPrimary,
+		IncludeVschema: validateSchemaKeyspaceOptions.IncludeVSchema,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	if len(resp.Results) > 0 {
+		return fmt.Errorf("schema diffs found in keyspace %s", cmd.Flags().Arg(0))
+	}
+
+	return nil
+}
+
 func init() {
+	ApplySchema.Flags().BoolVar(&applySchemaOptions.AllowLongUnavailability, "allow-long-unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
+	ApplySchema.Flags().StringArrayVar(&applySchemaOptions.SQL, "sql", nil, "A list of semicolon-delimited SQL commands to execute on every primary in the keyspace. Mutually exclusive with --sql-file.")
+	ApplySchema.Flags().StringVar(&applySchemaOptions.SQLFile, "sql-file", "", "Path to a file containing the SQL commands to execute. Mutually exclusive with --sql.")
+	ApplySchema.Flags().StringVar(&applySchemaOptions.DDLStrategy, "ddl-strategy", "direct", "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100').")
+	ApplySchema.Flags().StringVar(&applySchemaOptions.MigrationContext, "migration-context", "", "For Online DDL, optionally supply a custom unique string used as context for the migration(s) in this command. By default a unique context is auto-generated by Vitess.")
+	ApplySchema.Flags().DurationVar(&applySchemaOptions.WaitReplicasTimeout, "wait-replicas-timeout", 10*time.Second, "The amount of time to wait for replicas to receive the schema change via replication.")
+	ApplySchema.Flags().BoolVar(&applySchemaOptions.SkipPreflight, "skip-preflight", false, "Skip pre-apply schema checks, and directly forward schema change query to shards.")
+	Root.AddCommand(ApplySchema)
+
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.Tables, "tables", nil, "TODO")
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.ExcludeTables, "exclude-tables", nil, "TODO")
 	GetSchema.Flags().BoolVar(&getSchemaOptions.IncludeViews, "include-views", false, "TODO")
@@ -98,4 +322,22 @@ func init() {
 	GetSchema.Flags().BoolVarP(&getSchemaOptions.TableSizesOnly, "table-sizes-only", "s", false, "TODO")
 
 	Root.AddCommand(GetSchema)
+
+	Root.AddCommand(ReloadSchema)
+
+	ReloadSchemaKeyspace.Flags().Uint32Var(&reloadSchemaKeyspaceOptions.Concurrency, "concurrency", 10, "Number of tablets to reload in parallel. Set to zero for unbounded concurrency.")
+	ReloadSchemaKeyspace.Flags().BoolVar(&reloadSchemaKeyspaceOptions.IncludePrimary, "include-primary", false, "Also reload the primary tablets.")
+	ReloadSchemaKeyspace.Flags().StringVar(&reloadSchemaKeyspaceOptions.WaitPosition, "wait-position", "", "Allows scheduling a schema reload to occur after a given DDL has replicated to this server, by specifying a replication position to wait for. Leave empty to trigger the reload immediately.")
+	Root.AddCommand(ReloadSchemaKeyspace)
+
+	ReloadSchemaShard.Flags().Uint32Var(&reloadSchemaShardOptions.Concurrency, "concurrency", 10, "Number of tablets to reload in parallel. Set to zero for unbounded concurrency.")
+	ReloadSchemaShard.Flags().BoolVar(&reloadSchemaShardOptions.IncludePrimary, "include-primary", false, "Also reload the primary tablet.")
+	ReloadSchemaShard.Flags().StringVar(&reloadSchemaShardOptions.WaitPosition, "wait-position", "", "Allows scheduling a schema reload to occur after a given DDL has replicated to this server, by specifying a replication position to wait for. Leave empty to trigger the reload immediately.")
+	Root.AddCommand(ReloadSchemaShard)
+
+	ValidateSchemaKeyspace.Flags().StringSliceVar(&validateSchemaKeyspaceOptions.ExcludeTables, "exclude-tables", nil, "Tables to exclude during schema comparison.")
+	ValidateSchemaKeyspace.Flags().BoolVar(&validateSchemaKeyspaceOptions.IncludeViews, "include-views", false, "Includes views in compared schemas.")
+	ValidateSchemaKeyspace.Flags().BoolVar(&validateSchemaKeyspaceOptions.SkipNoPrimary, "skip-no-primary", false, "Skips validation on shards that do not have a primary tablet.")
+	ValidateSchemaKeyspace.Flags().BoolVar(&validateSchemaKeyspaceOptions.IncludeVSchema, "include-vschema", false, "Includes VSchema validation in the comparison.")
+	Root.AddCommand(ValidateSchemaKeyspace)
 }
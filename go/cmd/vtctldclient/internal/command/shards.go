@@ -52,6 +52,31 @@ var (
 		Args: cobra.ExactArgs(2),
 		RunE: commandRemoveShardCell,
 	}
+	// SetShardTabletControl makes a SetShardTabletControl gRPC call to a vtctld.
+	SetShardTabletControl = &cobra.Command{
+		Use:   "SetShardTabletControl [--cells=c1,c2...] [--blacklisted-tables=t1,t2,...] [--remove] [--disable-query-service] <keyspace/shard> <tablet_type>",
+		Short: "Sets the TabletControl record for a shard and tablet type. Only use this for an emergency fix or after a finished MoveTables.",
+		Long: `Sets the TabletControl record for a shard and tablet type.
+
+Only use this for an emergency fix or after a finished MoveTables. The
+MigrateServedFrom and MigrateServedType commands set this field appropriately
+already.
+
+Always specify the --blacklisted-tables flag for vertical resharding, but never
+for horizontal resharding.
+
+To set the DisableQueryService flag, keep --blacklisted-tables empty, and set
+--disable-query-service to true or false. This is useful to fix horizontal
+resharding if the migration went wrong.
+
+To change the list of blacklisted tables, specify the --blacklisted-tables
+parameter with the new list.
+
+To just remove the ShardTabletControl entirely, use the --remove flag.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandSetShardTabletControl,
+	}
 	// ShardReplicationPositions makes a ShardReplicationPositions gRPC request
 	// to a vtctld.
 	ShardReplicationPositions = &cobra.Command{
@@ -62,6 +87,14 @@ Use ctrl-C to interrupt the command and see partial results if needed.`,
 		Args: cobra.ExactArgs(1),
 		RunE: commandShardReplicationPositions,
 	}
+	// ValidateShard makes a ValidateShard gRPC request to a vtctld.
+	ValidateShard = &cobra.Command{
+		Use:                   "ValidateShard [--ping-tablets] <keyspace/shard>",
+		Short:                 "Validates that all nodes reachable from the specified shard are consistent.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandValidateShard,
+	}
 )
 
 var createShardOptions = struct {
@@ -183,6 +216,49 @@ func commandRemoveShardCell(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var setShardTabletControlOptions = struct {
+	Cells               []string
+	BlacklistedTables   []string
+	Remove              bool
+	DisableQueryService bool
+}{}
+
+func commandSetShardTabletControl(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletType, err := topoproto.ParseTabletType(cmd.Flags().Arg(1))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.SetShardTabletControl(commandCtx, &vtctldatapb.SetShardTabletControlRequest{
+		Keyspace:            keyspace,
+		Shard:               shard,
+		TabletType:          tabletType,
+		Cells:               setShardTabletControlOptions.Cells,
+		BlacklistedTables:   setShardTabletControlOptions.BlacklistedTables,
+		Remove:              setShardTabletControlOptions.Remove,
+		DisableQueryService: setShardTabletControlOptions.DisableQueryService,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Shard)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func commandShardReplicationPositions(cmd *cobra.Command, args []string) error {
 	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
 	if err != nil {
@@ -215,6 +291,41 @@ func commandShardReplicationPositions(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var validateShardOptions = struct {
+	PingTablets bool
+}{}
+
+func commandValidateShard(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ValidateShard(commandCtx, &vtctldatapb.ValidateShardRequest{
+		Keyspace:    keyspace,
+		Shard:       shard,
+		PingTablets: validateShardOptions.PingTablets,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	if len(resp.Results) > 0 {
+		return fmt.Errorf("shard %s/%s has %d validation errors", keyspace, shard, len(resp.Results))
+	}
+
+	return nil
+}
+
 func init() {
 	CreateShard.Flags().BoolVarP(&createShardOptions.Force, "force", "f", false, "")
 	CreateShard.Flags().BoolVarP(&createShardOptions.IncludeParent, "include-parent", "p", false, "")
@@ -230,5 +341,14 @@ func init() {
 	RemoveShardCell.Flags().BoolVarP(&removeShardCellOptions.Recursive, "recursive", "r", false, "Also delete all tablets in that cell beloning to the specified shard.")
 	Root.AddCommand(RemoveShardCell)
 
+	SetShardTabletControl.Flags().StringSliceVarP(&setShardTabletControlOptions.Cells, "cells", "c", nil, "Specifies a comma-separated list of cells to update.")
+	SetShardTabletControl.Flags().StringSliceVar(&setShardTabletControlOptions.BlacklistedTables, "blacklisted-tables", nil, "Specifies a comma-separated list of tables to blacklist (used for vertical split). Each is either an exact match, or a regular expression of the form '/regexp/'.")
+	SetShardTabletControl.Flags().BoolVarP(&setShardTabletControlOptions.Remove, "remove", "r", false, "Removes cells for vertical splits.")
+	SetShardTabletControl.Flags().BoolVar(&setShardTabletControlOptions.DisableQueryService, "disable-query-service", false, "Disables query service on the provided nodes. This flag requires 'blacklisted-tables' and 'remove' to be unset, otherwise it's ignored.")
+	Root.AddCommand(SetShardTabletControl)
+
 	Root.AddCommand(ShardReplicationPositions)
+
+	ValidateShard.Flags().BoolVarP(&validateShardOptions.PingTablets, "ping-tablets", "p", false, "Indicates whether all tablets should be pinged during the validation process.")
+	Root.AddCommand(ValidateShard)
 }
@@ -19,12 +19,15 @@ package command
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
@@ -50,6 +53,21 @@ NOTE: This command automatically updates the serving graph.`,
 		Args:                  cobra.MinimumNArgs(1),
 		RunE:                  commandDeleteTablets,
 	}
+	// ExecuteHook makes an ExecuteHook gRPC call to a vtctld.
+	ExecuteHook = &cobra.Command{
+		Use:   "ExecuteHook <alias> <hook_name> [<param1=value1> ...]",
+		Short: "Runs the specified hook on the given tablet.",
+		Long: `Runs the specified hook on the given tablet.
+
+A hook is a script that resides in the $VTROOT/vthook directory. You can put
+any script into that directory and use this command to run that script.
+
+For this command, the param=value arguments are parameters that the command
+passes to the specified hook.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MinimumNArgs(2),
+		RunE:                  commandExecuteHook,
+	}
 	// GetTablet makes a GetTablet gRPC call to a vtctld.
 	GetTablet = &cobra.Command{
 		Use:                   "GetTablet <alias>",
@@ -96,6 +114,17 @@ Valid output formats are "awk" and "json".`,
 		Args:                  cobra.ExactArgs(1),
 		RunE:                  commandRefreshStateByShard,
 	}
+	// SleepTablet makes a SleepTablet gRPC call to a vtctld.
+	SleepTablet = &cobra.Command{
+		Use:   "SleepTablet <alias> <duration>",
+		Short: "Blocks the action queue on the specified tablet for the specified amount of time.",
+		Long: `Blocks the action queue on the specified tablet for the specified amount of time.
+
+This is typically used for testing.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandSleepTablet,
+	}
 )
 
 var changeTabletTypeOptions = struct {
@@ -163,6 +192,38 @@ func commandDeleteTablets(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandExecuteHook(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	hookName := cmd.Flags().Arg(1)
+	hookParams := cmd.Flags().Args()[2:]
+
+	resp, err := client.ExecuteHook(commandCtx, &vtctldatapb.ExecuteHookRequest{
+		TabletAlias: alias,
+		TabletHookRequest: &tabletmanagerdatapb.ExecuteHookRequest{
+			Name:       hookName,
+			Parameters: hookParams,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.HookResult)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func commandGetTablet(cmd *cobra.Command, args []string) error {
 	aliasStr := cmd.Flags().Arg(0)
 	alias, err := topoproto.ParseTabletAlias(aliasStr)
@@ -314,6 +375,26 @@ func commandRefreshStateByShard(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandSleepTablet(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	duration, err := time.ParseDuration(cmd.Flags().Arg(1))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.SleepTablet(commandCtx, &vtctldatapb.SleepTabletRequest{
+		TabletAlias: alias,
+		Duration:    protoutil.DurationToProto(duration),
+	})
+	return err
+}
+
 func init() {
 	ChangeTabletType.Flags().BoolVarP(&changeTabletTypeOptions.DryRun, "dry-run", "d", false, "Shows the proposed change without actually executing it")
 	Root.AddCommand(ChangeTabletType)
@@ -321,6 +402,8 @@ func init() {
 	DeleteTablets.Flags().BoolVarP(&deleteTabletsOptions.AllowPrimary, "allow-primary", "p", false, "Allow the primary tablet of a shard to be deleted. Use with caution.")
 	Root.AddCommand(DeleteTablets)
 
+	Root.AddCommand(ExecuteHook)
+
 	Root.AddCommand(GetTablet)
 
 	GetTablets.Flags().StringSliceVarP(&getTabletsOptions.TabletAliasStrings, "tablet-alias", "t", nil, "List of tablet aliases to filter by")
@@ -335,4 +418,6 @@ func init() {
 
 	RefreshStateByShard.Flags().StringSliceVarP(&refreshStateByShardOptions.Cells, "cells", "c", nil, "If specified, only call RefreshState on tablets in the specified cells. If empty, all cells are considered.")
 	Root.AddCommand(RefreshStateByShard)
+
+	Root.AddCommand(SleepTablet)
 }
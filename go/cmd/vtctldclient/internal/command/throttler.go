@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// ThrottleApp makes an UpdateThrottlerConfig gRPC call to a vtctld, to
+	// throttle an app.
+	ThrottleApp = &cobra.Command{
+		Use:   "ThrottleApp [--ratio <ratio>] [--duration <duration>] <keyspace> <app>",
+		Short: "Throttles an app on all the tablets of a keyspace.",
+		Long: `Throttles an app on all the tablets of a keyspace.
+
+The ratio is the fraction of the throttler checks of the app which are rejected,
+1 rejects all of them. The rule is stored in the keyspace record of the topology,
+and expires after the duration.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandThrottleApp,
+	}
+	// UnthrottleApp makes an UpdateThrottlerConfig gRPC call to a vtctld, to
+	// unthrottle an app.
+	UnthrottleApp = &cobra.Command{
+		Use:                   "UnthrottleApp <keyspace> <app>",
+		Short:                 "Removes the throttling rule of an app on all the tablets of a keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandUnthrottleApp,
+	}
+	// UpdateThrottlerConfig makes an UpdateThrottlerConfig gRPC call to a vtctld.
+	UpdateThrottlerConfig = &cobra.Command{
+		Use:   "UpdateThrottlerConfig [--threshold <seconds> | --reset-threshold] <keyspace>",
+		Short: "Updates the throttler configuration of all the tablets of a keyspace.",
+		Long: `Updates the throttler configuration of all the tablets of a keyspace.
+
+The configuration is stored in the keyspace record of the topology, and copied
+to the SrvKeyspace of each cell, where the tablets pick it up. Without any flag,
+the current configuration is printed, after pruning the expired throttled apps.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandUpdateThrottlerConfig,
+	}
+)
+
+var throttleAppOptions = struct {
+	Ratio    float64
+	Duration time.Duration
+}{}
+
+func commandThrottleApp(cmd *cobra.Command, args []string) error {
+	if throttleAppOptions.Ratio <= 0 || throttleAppOptions.Ratio > 1 {
+		return fmt.Errorf("--ratio must be greater than 0 and at most 1, got %v", throttleAppOptions.Ratio)
+	}
+	if throttleAppOptions.Duration <= 0 {
+		return fmt.Errorf("--duration must be positive, got %v", throttleAppOptions.Duration)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	return updateThrottlerConfig(&vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		ThrottledApp: &topodatapb.ThrottledAppRule{
+			Name:      cmd.Flags().Arg(1),
+			Ratio:     throttleAppOptions.Ratio,
+			ExpiresAt: protoutil.TimeToProto(time.Now().Add(throttleAppOptions.Duration)),
+		},
+	})
+}
+
+func commandUnthrottleApp(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	return updateThrottlerConfig(&vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		ThrottledApp: &topodatapb.ThrottledAppRule{
+			Name: cmd.Flags().Arg(1),
+		},
+	})
+}
+
+var updateThrottlerConfigOptions = struct {
+	Threshold      float64
+	ResetThreshold bool
+}{}
+
+func commandUpdateThrottlerConfig(cmd *cobra.Command, args []string) error {
+	if updateThrottlerConfigOptions.Threshold < 0 {
+		return fmt.Errorf("--threshold must be positive, got %v", updateThrottlerConfigOptions.Threshold)
+	}
+	if updateThrottlerConfigOptions.ResetThreshold && updateThrottlerConfigOptions.Threshold > 0 {
+		return fmt.Errorf("cannot pass both --threshold and --reset-threshold")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	threshold := updateThrottlerConfigOptions.Threshold
+	if updateThrottlerConfigOptions.ResetThreshold {
+		threshold = -1
+	}
+	return updateThrottlerConfig(&vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace:  cmd.Flags().Arg(0),
+		Threshold: threshold,
+	})
+}
+
+func updateThrottlerConfig(req *vtctldatapb.UpdateThrottlerConfigRequest) error {
+	resp, err := client.UpdateThrottlerConfig(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.ThrottlerConfig)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+	return nil
+}
+
+func init() {
+	ThrottleApp.Flags().Float64Var(&throttleAppOptions.Ratio, "ratio", 1, "The ratio of the throttler checks of the app to reject, between 0 (excluded) and 1.")
+	ThrottleApp.Flags().DurationVar(&throttleAppOptions.Duration, "duration", time.Hour, "How long the app is throttled for.")
+	Root.AddCommand(ThrottleApp)
+
+	Root.AddCommand(UnthrottleApp)
+
+	UpdateThrottlerConfig.Flags().Float64Var(&updateThrottlerConfigOptions.Threshold, "threshold", 0, "The threshold of the replication lag metric, in seconds. 0 leaves the threshold unchanged.")
+	UpdateThrottlerConfig.Flags().BoolVar(&updateThrottlerConfigOptions.ResetThreshold, "reset-threshold", false, "Reset the threshold to the one of the command line of the tablets.")
+	Root.AddCommand(UpdateThrottlerConfig)
+}
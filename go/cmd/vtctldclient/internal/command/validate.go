@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// Validate makes a Validate gRPC call to a vtctld.
+var Validate = &cobra.Command{
+	Use:                   "Validate [--ping-tablets]",
+	Short:                 "Validates that all nodes reachable from the global replication graph, as well as all tablets in discoverable cells, are consistent.",
+	DisableFlagsInUseLine: true,
+	Args:                  cobra.NoArgs,
+	RunE:                  commandValidate,
+}
+
+var validateOptions = struct {
+	PingTablets bool
+}{}
+
+func commandValidate(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.Validate(commandCtx, &vtctldatapb.ValidateRequest{
+		PingTablets: validateOptions.PingTablets,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	if len(resp.Results) > 0 {
+		return fmt.Errorf("%d validation errors found", len(resp.Results))
+	}
+
+	return nil
+}
+
+func init() {
+	Validate.Flags().BoolVarP(&validateOptions.PingTablets, "ping-tablets", "p", false, "Indicates whether all tablets should be pinged during the validation process.")
+	Root.AddCommand(Validate)
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// VDiff is the parent command of the VDiff subcommands. VDiffs run on the
+	// primaries of the target keyspace of a workflow, and can be stopped and
+	// resumed.
+	VDiff = &cobra.Command{
+		Use:   "VDiff",
+		Short: "Create, show, stop, resume and delete VDiffs of a workflow.",
+		Args:  cobra.NoArgs,
+	}
+	// VDiffCreate makes a VDiffCreate gRPC call to a vtctld.
+	VDiffCreate = &cobra.Command{
+		Use:  "create <keyspace.workflow>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandVDiffCreate,
+	}
+	// VDiffDelete makes a VDiffDelete gRPC call to a vtctld.
+	VDiffDelete = &cobra.Command{
+		Use:  "delete <keyspace.workflow> <uuid|all>",
+		Args: cobra.ExactArgs(2),
+		RunE: commandVDiffDelete,
+	}
+	// VDiffResume makes a VDiffResume gRPC call to a vtctld.
+	VDiffResume = &cobra.Command{
+		Use:  "resume <keyspace.workflow> <uuid>",
+		Args: cobra.ExactArgs(2),
+		RunE: commandVDiffResume,
+	}
+	// VDiffShow makes a VDiffShow gRPC call to a vtctld.
+	VDiffShow = &cobra.Command{
+		Use:  "show <keyspace.workflow> <uuid|last|all>",
+		Args: cobra.ExactArgs(2),
+		RunE: commandVDiffShow,
+	}
+	// VDiffStop makes a VDiffStop gRPC call to a vtctld.
+	VDiffStop = &cobra.Command{
+		Use:  "stop <keyspace.workflow> <uuid>",
+		Args: cobra.ExactArgs(2),
+		RunE: commandVDiffStop,
+	}
+)
+
+func splitKeyspaceWorkflow(in string) (keyspace string, workflow string, err error) {
+	parts := strings.Split(in, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid format for <keyspace.workflow>: %s", in)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+var vdiffCreateOptions = struct {
+	UUID                        string
+	SourceCells                 []string
+	TabletTypes                 string
+	Tables                      []string
+	MaxRows                     int64
+	OnlyPKs                     bool
+	DebugQuery                  bool
+	FilteredReplicationWaitTime time.Duration
+}{}
+
+func commandVDiffCreate(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := splitKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if vdiffCreateOptions.MaxRows < 0 {
+		return fmt.Errorf("--max-rows must not be negative, got %d", vdiffCreateOptions.MaxRows)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VDiffCreate(commandCtx, &vtctldatapb.VDiffCreateRequest{
+		Keyspace: keyspace,
+		Workflow: workflow,
+		Uuid:     vdiffCreateOptions.UUID,
+		Options: &tabletmanagerdatapb.VDiffOptions{
+			SourceCells:                 vdiffCreateOptions.SourceCells,
+			TabletTypes:                 vdiffCreateOptions.TabletTypes,
+			Tables:                      vdiffCreateOptions.Tables,
+			MaxRows:                     vdiffCreateOptions.MaxRows,
+			OnlyPks:                     vdiffCreateOptions.OnlyPKs,
+			DebugQuery:                  vdiffCreateOptions.DebugQuery,
+			FilteredReplicationWaitTime: protoutil.DurationToProto(vdiffCreateOptions.FilteredReplicationWaitTime),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("VDiff %s created on workflow %s.%s\n", resp.Uuid, keyspace, workflow)
+
+	return nil
+}
+
+func commandVDiffDelete(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := splitKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.VDiffDelete(commandCtx, &vtctldatapb.VDiffDeleteRequest{
+		Keyspace: keyspace,
+		Workflow: workflow,
+		Uuid:     cmd.Flags().Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully deleted VDiff %s on workflow %s.%s\n", cmd.Flags().Arg(1), keyspace, workflow)
+
+	return nil
+}
+
+func commandVDiffResume(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := splitKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.VDiffResume(commandCtx, &vtctldatapb.VDiffResumeRequest{
+		Keyspace: keyspace,
+		Workflow: workflow,
+		Uuid:     cmd.Flags().Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully resumed VDiff %s on workflow %s.%s\n", cmd.Flags().Arg(1), keyspace, workflow)
+
+	return nil
+}
+
+func commandVDiffShow(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := splitKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VDiffShow(commandCtx, &vtctldatapb.VDiffShowRequest{
+		Keyspace: keyspace,
+		Workflow: workflow,
+		Uuid:     cmd.Flags().Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandVDiffStop(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := splitKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.VDiffStop(commandCtx, &vtctldatapb.VDiffStopRequest{
+		Keyspace: keyspace,
+		Workflow: workflow,
+		Uuid:     cmd.Flags().Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully stopped VDiff %s on workflow %s.%s\n", cmd.Flags().Arg(1), keyspace, workflow)
+
+	return nil
+}
+
+func init() {
+	VDiffCreate.Flags().StringVar(&vdiffCreateOptions.UUID, "uuid", "", "The UUID to give to the new VDiff. One is generated if not specified.")
+	VDiffCreate.Flags().StringSliceVarP(&vdiffCreateOptions.SourceCells, "source-cells", "c", nil, "The cells to pick source tablets from. Defaults to the cell of each target primary.")
+	VDiffCreate.Flags().StringVar(&vdiffCreateOptions.TabletTypes, "tablet-types", "master,replica,rdonly", "The tablet types to pick source tablets from.")
+	VDiffCreate.Flags().StringSliceVar(&vdiffCreateOptions.Tables, "tables", nil, "Only diff these tables of the workflow.")
+	VDiffCreate.Flags().Int64Var(&vdiffCreateOptions.MaxRows, "max-rows", 0, "Stop comparing each table after this many rows. 0 means no limit.")
+	VDiffCreate.Flags().BoolVar(&vdiffCreateOptions.OnlyPKs, "only-pks", false, "Only report the primary keys of the mismatched rows.")
+	VDiffCreate.Flags().BoolVar(&vdiffCreateOptions.DebugQuery, "debug-query", false, "Add to each reported row a query which can be used to debug it.")
+	VDiffCreate.Flags().DurationVar(&vdiffCreateOptions.FilteredReplicationWaitTime, "filtered-replication-wait-time", 30*time.Second, "How long to wait for the sources and the workflow to catch up with each other before each table is compared.")
+	VDiff.AddCommand(VDiffCreate)
+
+	VDiff.AddCommand(VDiffDelete)
+	VDiff.AddCommand(VDiffResume)
+	VDiff.AddCommand(VDiffShow)
+	VDiff.AddCommand(VDiffStop)
+
+	Root.AddCommand(VDiff)
+}
@@ -18,11 +18,16 @@ package command
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
@@ -33,8 +38,179 @@ var (
 		Args: cobra.ExactArgs(1),
 		RunE: commandGetWorkflows,
 	}
+	// MoveTables makes a MoveTables gRPC call to a vtctld.
+	MoveTables = &cobra.Command{
+		Use:  "MoveTables <create|switchtraffic|reversetraffic|complete|cancel> <keyspace.workflow>",
+		Args: cobra.ExactArgs(2),
+		RunE: commandMoveTables,
+	}
+	// Reshard makes a Reshard gRPC call to a vtctld.
+	Reshard = &cobra.Command{
+		Use:  "Reshard <create|switchtraffic|reversetraffic|complete|cancel> <keyspace.workflow>",
+		Args: cobra.ExactArgs(2),
+		RunE: commandReshard,
+	}
 )
 
+// parseVReplicationWorkflowAction parses an action name case-insensitively,
+// with or without underscores, so both "SwitchTraffic" and "switch_traffic"
+// are accepted.
+func parseVReplicationWorkflowAction(in string) (vtctldatapb.VReplicationWorkflowAction, error) {
+	want := strings.ToLower(strings.ReplaceAll(in, "_", ""))
+	for name, value := range vtctldatapb.VReplicationWorkflowAction_value {
+		if strings.ToLower(strings.ReplaceAll(name, "_", "")) == want {
+			return vtctldatapb.VReplicationWorkflowAction(value), nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid workflow action: %s", in)
+}
+
+// vreplicationWorkflowOptions are the flags shared by MoveTables and Reshard.
+type vreplicationWorkflowOptions struct {
+	Cells                    []string
+	TabletTypes              []string
+	AutoStart                bool
+	StopAfterCopy            bool
+	Timeout                  time.Duration
+	EnableReverseReplication bool
+	DryRun                   bool
+	KeepData                 bool
+}
+
+func (opts *vreplicationWorkflowOptions) register(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(&opts.Cells, "cells", "c", nil, "The cells to pick source tablets from on create, or the cells to switch traffic in. Defaults to all cells.")
+	cmd.Flags().StringSliceVar(&opts.TabletTypes, "tablet-types", nil, "The tablet types to pick source tablets from on create, or the tablet types to switch traffic for. Switching defaults to all tablet types.")
+	cmd.Flags().BoolVar(&opts.AutoStart, "auto-start", true, "Start the workflow as soon as it is created.")
+	cmd.Flags().BoolVar(&opts.StopAfterCopy, "stop-after-copy", false, "Stop the workflow once the copy phase has completed.")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", 30*time.Second, "How long to wait for replication to catch up when switching writes.")
+	cmd.Flags().BoolVar(&opts.EnableReverseReplication, "reverse-replication", true, "Set up replication from the target back to the source when switching writes.")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Only report the actions that would be taken. Not supported on create and cancel.")
+	cmd.Flags().BoolVar(&opts.KeepData, "keep-data", false, "Keep the copied tables or shards on cancel, or the source tables or shards on complete.")
+}
+
+func (opts *vreplicationWorkflowOptions) tabletTypes() ([]topodatapb.TabletType, error) {
+	if len(opts.TabletTypes) == 0 {
+		return nil, nil
+	}
+
+	return topoproto.ParseTabletTypes(strings.Join(opts.TabletTypes, ","))
+}
+
+var moveTablesOptions = struct {
+	vreplicationWorkflowOptions
+	SourceKeyspace string
+	Tables         string
+	AllTables      bool
+	ExcludeTables  []string
+	RenameTables   bool
+}{}
+
+func commandMoveTables(cmd *cobra.Command, args []string) error {
+	action, err := parseVReplicationWorkflowAction(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	keyspace, workflow, err := splitKeyspaceWorkflow(cmd.Flags().Arg(1))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := moveTablesOptions.tabletTypes()
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.MoveTables(commandCtx, &vtctldatapb.MoveTablesRequest{
+		Action:                   action,
+		TargetKeyspace:           keyspace,
+		Workflow:                 workflow,
+		SourceKeyspace:           moveTablesOptions.SourceKeyspace,
+		Tables:                   moveTablesOptions.Tables,
+		AllTables:                moveTablesOptions.AllTables,
+		ExcludeTables:            moveTablesOptions.ExcludeTables,
+		Cells:                    moveTablesOptions.Cells,
+		TabletTypes:              tabletTypes,
+		AutoStart:                moveTablesOptions.AutoStart,
+		StopAfterCopy:            moveTablesOptions.StopAfterCopy,
+		Timeout:                  protoutil.DurationToProto(moveTablesOptions.Timeout),
+		EnableReverseReplication: moveTablesOptions.EnableReverseReplication,
+		DryRun:                   moveTablesOptions.DryRun,
+		KeepData:                 moveTablesOptions.KeepData,
+		RenameTables:             moveTablesOptions.RenameTables,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var reshardOptions = struct {
+	vreplicationWorkflowOptions
+	SourceShards   []string
+	TargetShards   []string
+	SkipSchemaCopy bool
+}{}
+
+func commandReshard(cmd *cobra.Command, args []string) error {
+	action, err := parseVReplicationWorkflowAction(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	keyspace, workflow, err := splitKeyspaceWorkflow(cmd.Flags().Arg(1))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := reshardOptions.tabletTypes()
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.Reshard(commandCtx, &vtctldatapb.ReshardRequest{
+		Action:                   action,
+		Keyspace:                 keyspace,
+		Workflow:                 workflow,
+		SourceShards:             reshardOptions.SourceShards,
+		TargetShards:             reshardOptions.TargetShards,
+		SkipSchemaCopy:           reshardOptions.SkipSchemaCopy,
+		Cells:                    reshardOptions.Cells,
+		TabletTypes:              tabletTypes,
+		AutoStart:                reshardOptions.AutoStart,
+		StopAfterCopy:            reshardOptions.StopAfterCopy,
+		Timeout:                  protoutil.DurationToProto(reshardOptions.Timeout),
+		EnableReverseReplication: reshardOptions.EnableReverseReplication,
+		DryRun:                   reshardOptions.DryRun,
+		KeepData:                 reshardOptions.KeepData,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 var getWorkflowsOptions = struct {
 	ShowAll bool
 }{}
@@ -66,4 +242,18 @@ func commandGetWorkflows(cmd *cobra.Command, args []string) error {
 func init() {
 	GetWorkflows.Flags().BoolVarP(&getWorkflowsOptions.ShowAll, "show-all", "a", false, "Show all workflows instead of just active workflows")
 	Root.AddCommand(GetWorkflows)
+
+	moveTablesOptions.register(MoveTables)
+	MoveTables.Flags().StringVar(&moveTablesOptions.SourceKeyspace, "source", "", "The keyspace to move the tables from. Required on create.")
+	MoveTables.Flags().StringVar(&moveTablesOptions.Tables, "tables", "", "A comma-separated list of the tables to move, or a JSON table spec. Required on create unless --all is set.")
+	MoveTables.Flags().BoolVar(&moveTablesOptions.AllTables, "all", false, "Move all the tables of the source keyspace.")
+	MoveTables.Flags().StringSliceVar(&moveTablesOptions.ExcludeTables, "exclude", nil, "Tables to leave out when --all is set.")
+	MoveTables.Flags().BoolVar(&moveTablesOptions.RenameTables, "rename-tables", false, "On complete, rename the source tables instead of dropping them.")
+	Root.AddCommand(MoveTables)
+
+	reshardOptions.register(Reshard)
+	Reshard.Flags().StringSliceVar(&reshardOptions.SourceShards, "source-shards", nil, "The shards to reshard from. Required on create.")
+	Reshard.Flags().StringSliceVar(&reshardOptions.TargetShards, "target-shards", nil, "The shards to reshard into. Required on create.")
+	Reshard.Flags().BoolVar(&reshardOptions.SkipSchemaCopy, "skip-schema-copy", false, "Do not copy the schema from the source shards to the target shards.")
+	Root.AddCommand(Reshard)
 }
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+	"syscall"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/debezium"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+
+	// Import and register the gRPC vtgateconn client
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+var (
+	usage = `
+vtdebezium streams the changes of a keyspace from a vtgate with the VStream
+API, and writes them as Debezium JSON change events.
+
+Without -output_dir, each event is written to stdout as one line of JSON with
+its topic, key and value. With -output_dir, the value of each event is
+appended as one line of JSON to the file <output_dir>/<topic>.json.
+
+The source of each change event has the VGtid of its transaction: passing it
+to -vgtid resumes the stream after the transaction.
+
+Examples:
+
+  $ vtdebezium -server vtgate:15991 -keyspace commerce -consistent_snapshot
+
+  $ vtdebezium -server vtgate:15991 -keyspace customer -tables 'customer|corder' -output_dir /tmp/cdc
+
+`
+	server             = flag.String("server", "", "vtgate server to connect to")
+	keyspace           = flag.String("keyspace", "", "keyspace to stream from")
+	shard              = flag.String("shard", "", "shard to stream from. All the shards of the keyspace are streamed if unspecified")
+	tabletType         = flag.String("tablet_type", "master", "type of the tablets to stream from")
+	tables             = flag.String("tables", ".*", "regular expression of the tables to stream")
+	gtid               = flag.String("gtid", "", "position to start streaming from: empty to copy the tables first, 'current' to only stream the changes from now on")
+	vgtidFlag          = flag.String("vgtid", "", "JSON encoded VGtid to resume streaming from, as found in the source of a change event. Overrides -keyspace, -shard and -gtid")
+	serverName         = flag.String("server_name", "vitess", "name of the server in the source of the events, and prefix of their topics")
+	outputDir          = flag.String("output_dir", "", "directory of the files to write the events to, instead of stdout")
+	copyBarrier        = flag.Bool("copy_barrier", false, "wait for all the shards to complete their copy before streaming their changes")
+	consistentSnapshot = flag.Bool("consistent_snapshot", false, "copy the tables of all the shards from a snapshot consistent across shards. Implies -copy_barrier")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprint(os.Stderr, usage)
+	}
+}
+
+func main() {
+	defer exit.Recover()
+	defer logutil.Flush()
+
+	flag.Parse()
+	if err := run(); err != nil {
+		log.Errorf("%v", err)
+		exit.Return(1)
+	}
+}
+
+func run() error {
+	if *server == "" {
+		return errors.New("-server is required")
+	}
+	vgtid, err := startVgtid()
+	if err != nil {
+		return err
+	}
+	tt, err := topoproto.ParseTabletType(*tabletType)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+		<-sigChan
+		cancel()
+	}()
+
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/" + *tables,
+		}},
+	}
+	flags := &vtgatepb.VStreamFlags{
+		CopyBarrier:        *copyBarrier,
+		ConsistentSnapshot: *consistentSnapshot,
+	}
+	reader, err := conn.VStream(ctx, tt, vgtid, filter, flags)
+	if err != nil {
+		return err
+	}
+
+	w := newWriter(*outputDir)
+	defer w.close()
+	converter := debezium.NewConverter(*serverName)
+	for {
+		events, err := reader.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		changes, err := converter.Convert(events)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			if err := w.write(change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// startVgtid returns the VGtid to start streaming from.
+func startVgtid() (*binlogdatapb.VGtid, error) {
+	if *vgtidFlag != "" {
+		return debezium.DecodeVgtid(*vgtidFlag)
+	}
+	if *keyspace == "" {
+		return nil, errors.New("-keyspace or -vgtid is required")
+	}
+	return &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: *keyspace,
+			Shard:    *shard,
+			Gtid:     *gtid,
+		}},
+	}, nil
+}
+
+// writer writes change events to stdout, or to one file per topic.
+type writer struct {
+	dir   string
+	files map[string]*os.File
+}
+
+func newWriter(dir string) *writer {
+	return &writer{
+		dir:   dir,
+		files: make(map[string]*os.File),
+	}
+}
+
+func (w *writer) write(ev *debezium.Event) error {
+	if w.dir == "" {
+		data, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Printf("%s\n", data)
+		return err
+	}
+
+	f, ok := w.files[ev.Topic]
+	if !ok {
+		var err error
+		f, err = os.OpenFile(path.Join(w.dir, ev.Topic+".json"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		w.files[ev.Topic] = f
+	}
+	data, err := json.Marshal(ev.Value)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+func (w *writer) close() {
+	for _, f := range w.files {
+		if err := f.Close(); err != nil {
+			log.Errorf("Error closing %s: %v", f.Name(), err)
+		}
+	}
+}
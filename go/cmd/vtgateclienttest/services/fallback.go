@@ -64,6 +64,14 @@ func (c fallbackClient) ResolveTransaction(ctx context.Context, dtid string) err
 	return c.fallback.ResolveTransaction(ctx, dtid)
 }
 
+func (c fallbackClient) MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	return c.fallback.MessageAck(ctx, keyspace, name, consumerGroup, ids)
+}
+
+func (c fallbackClient) MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error) {
+	return c.fallback.MessageNack(ctx, keyspace, name, ids)
+}
+
 func (c fallbackClient) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
 	return c.fallback.VStream(ctx, tabletType, vgtid, filter, flags, send)
 }
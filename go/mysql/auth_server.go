@@ -24,6 +24,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"net"
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/vt/log"
@@ -81,6 +82,29 @@ type AuthServer interface {
 	Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error)
 }
 
+// CachingSha2AuthServer is implemented by the AuthServers that support
+// the caching_sha2_password method, which AuthMethod can then return.
+//
+// The client first sends a scramble of its password, that is validated
+// with the SHA256 hash of the password if the server has it in its cache
+// ("fast" authentication). Otherwise, the client sends its password over
+// a secure connection, or encrypted with the RSA key of the server, and
+// the server can cache the hash of the password after validating it
+// ("full" authentication).
+type CachingSha2AuthServer interface {
+	AuthServer
+
+	// ValidateCachingSha2Scramble validates the scramble sent by the
+	// client with the cached hash of the password. cached is false if
+	// the hash is not in the cache, in which case full authentication
+	// is done.
+	ValidateCachingSha2Scramble(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (userData Getter, cached bool, err error)
+
+	// ValidateCachingSha2Password validates the clear text password
+	// received during full authentication, and caches its hash.
+	ValidateCachingSha2Password(user string, password string, remoteAddr net.Addr) (Getter, error)
+}
+
 // authServers is a registry of AuthServer implementations.
 var authServers = make(map[string]AuthServer)
 
@@ -221,6 +245,163 @@ func ScrambleCachingSha2Password(salt []byte, password []byte) []byte {
 	return stage1
 }
 
+// cachingSha2Hash returns the hash of a password that is cached by
+// caching_sha2_password for fast authentication: SHA256(SHA256(password)).
+func cachingSha2Hash(password []byte) []byte {
+	stage1 := sha256.Sum256(password)
+	stage2 := sha256.Sum256(stage1[:])
+	return stage2[:]
+}
+
+// isPassScrambleCachingSha2Password validates a scramble computed by
+// ScrambleCachingSha2Password with the hash returned by cachingSha2Hash.
+func isPassScrambleCachingSha2Password(reply, salt, hash []byte) bool {
+	/*
+		SERVER:  recv(reply)
+				 stage1=xor(reply, sha256(hash, salt))
+				 candidate_hash=sha256(stage1)
+				 check(candidate_hash==hash)
+	*/
+	if len(reply) != sha256.Size || len(hash) != sha256.Size {
+		return false
+	}
+
+	crypt := sha256.New()
+	crypt.Write(hash)
+	crypt.Write(salt)
+	scramble := crypt.Sum(nil)
+
+	for i := range scramble {
+		scramble[i] ^= reply[i]
+	}
+	candidateHash := sha256.Sum256(scramble)
+	return bytes.Equal(candidateHash[:], hash)
+}
+
+// Constants of the authentication_string of caching_sha2_password,
+// which looks like "$A$005$<salt><digest>", where 005 is the number
+// of thousands of rounds of SHA256 crypt.
+const (
+	cachingSha2AuthStringPrefix = "$A$"
+	cachingSha2SaltLength       = 20
+	cachingSha2DigestLength     = 43
+)
+
+// isPassCachingSha2Password returns true if the clear text password
+// matches the authentication_string of a caching_sha2_password user,
+// as found in the mysql.user table.
+func isPassCachingSha2Password(password, authString string) bool {
+	if !strings.HasPrefix(authString, cachingSha2AuthStringPrefix) {
+		return false
+	}
+	authString = authString[len(cachingSha2AuthStringPrefix):]
+	if len(authString) != 4+cachingSha2SaltLength+cachingSha2DigestLength || authString[3] != '$' {
+		return false
+	}
+	rounds, err := strconv.Atoi(authString[:3])
+	if err != nil || rounds <= 0 {
+		return false
+	}
+	salt := authString[4 : 4+cachingSha2SaltLength]
+	digest := authString[4+cachingSha2SaltLength:]
+	return sha256Crypt([]byte(password), []byte(salt), rounds*1000) == digest
+}
+
+// sha256Crypt computes the digest of the SHA256 crypt algorithm
+// described in https://www.akkadia.org/drepper/SHA-crypt.txt.
+// Unlike the original algorithm, the salt is not limited to 16 bytes,
+// like in the caching_sha2_password implementation of MySQL.
+func sha256Crypt(password, salt []byte, rounds int) string {
+	// digest B
+	crypt := sha256.New()
+	crypt.Write(password)
+	crypt.Write(salt)
+	crypt.Write(password)
+	digestB := crypt.Sum(nil)
+
+	// digest A
+	crypt.Reset()
+	crypt.Write(password)
+	crypt.Write(salt)
+	crypt.Write(repeatToLength(digestB, len(password)))
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			crypt.Write(digestB)
+		} else {
+			crypt.Write(password)
+		}
+	}
+	digestA := crypt.Sum(nil)
+
+	// sequence P, from digest DP
+	crypt.Reset()
+	for i := 0; i < len(password); i++ {
+		crypt.Write(password)
+	}
+	seqP := repeatToLength(crypt.Sum(nil), len(password))
+
+	// sequence S, from digest DS
+	crypt.Reset()
+	for i := 0; i < 16+int(digestA[0]); i++ {
+		crypt.Write(salt)
+	}
+	seqS := repeatToLength(crypt.Sum(nil), len(salt))
+
+	digest := digestA
+	for i := 0; i < rounds; i++ {
+		crypt.Reset()
+		if i&1 != 0 {
+			crypt.Write(seqP)
+		} else {
+			crypt.Write(digest)
+		}
+		if i%3 != 0 {
+			crypt.Write(seqS)
+		}
+		if i%7 != 0 {
+			crypt.Write(seqP)
+		}
+		if i&1 != 0 {
+			crypt.Write(digest)
+		} else {
+			crypt.Write(seqP)
+		}
+		digest = crypt.Sum(digest[:0])
+	}
+
+	// encode the digest with the byte order of the algorithm
+	const alphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	order := [][3]int{
+		{0, 10, 20}, {21, 1, 11}, {12, 22, 2}, {3, 13, 23}, {24, 4, 14},
+		{15, 25, 5}, {6, 16, 26}, {27, 7, 17}, {18, 28, 8}, {9, 19, 29},
+	}
+	var buf strings.Builder
+	encode := func(w uint32, n int) {
+		for ; n > 0; n-- {
+			buf.WriteByte(alphabet[w&0x3f])
+			w >>= 6
+		}
+	}
+	for _, o := range order {
+		encode(uint32(digest[o[0]])<<16|uint32(digest[o[1]])<<8|uint32(digest[o[2]]), 4)
+	}
+	encode(uint32(digest[31])<<8|uint32(digest[30]), 3)
+	return buf.String()
+}
+
+// repeatToLength repeats b until it reaches the given length
+func repeatToLength(b []byte, length int) []byte {
+	result := make([]byte, 0, length)
+	for len(result) < length {
+		n := length - len(result)
+		if n > len(b) {
+			n = len(b)
+		}
+		result = append(result, b[:n]...)
+	}
+	return result
+}
+
 // EncryptPasswordWithPublicKey obfuscates the password and encrypts it with server's public key as required by
 // caching_sha2_password plugin for "full" authentication
 func EncryptPasswordWithPublicKey(salt []byte, password []byte, pub *rsa.PublicKey) ([]byte, error) {
@@ -243,6 +424,24 @@ func EncryptPasswordWithPublicKey(salt []byte, password []byte, pub *rsa.PublicK
 	return enc, nil
 }
 
+// DecryptPasswordWithPrivateKey decrypts a password encrypted by
+// EncryptPasswordWithPublicKey, during the "full" authentication of
+// caching_sha2_password.
+func DecryptPasswordWithPrivateKey(salt []byte, enc []byte, priv *rsa.PrivateKey) (string, error) {
+	buffer, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, priv, enc, nil)
+	if err != nil {
+		return "", err
+	}
+	for i := range buffer {
+		buffer[i] ^= salt[i%len(salt)]
+	}
+	// the password is zero terminated
+	if len(buffer) == 0 || buffer[len(buffer)-1] != 0 {
+		return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "received invalid encrypted password")
+	}
+	return string(buffer[:len(buffer)-1]), nil
+}
+
 // Constants for the dialog plugin.
 const (
 	mysqlDialogMessage = "Enter password: "
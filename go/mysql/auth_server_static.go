@@ -24,6 +24,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -58,6 +59,9 @@ type AuthServerStatic struct {
 	mu sync.Mutex
 	// entries contains the users, passwords and user data.
 	entries map[string][]*AuthServerStaticEntry
+	// cachingSha2Cache contains the hashes of the passwords of the
+	// CachingSha2Password entries, once they have been validated.
+	cachingSha2Cache map[string][]byte
 
 	sigChan chan os.Signal
 	ticker  *time.Ticker
@@ -77,6 +81,12 @@ type AuthServerStaticEntry struct {
 	// MysqlNativePassword's format looks like "*6C8989366EAF75BB670AD8EA7A7FC1176A95CEF4", it store a hashing value.
 	// Use MysqlNativePassword in auth config, maybe more secure. After all, it is cryptographic storage.
 	MysqlNativePassword string
+	// CachingSha2Password is the authentication_string of a MySQL user
+	// identified with caching_sha2_password, as found in mysql.user:
+	// "$A$005$" followed by a salt of 20 characters and a SHA256 crypt
+	// digest of 43 characters. These users use the caching_sha2_password
+	// authentication method.
+	CachingSha2Password string
 	Password            string
 	UserData            string
 	SourceHost          string
@@ -115,11 +125,12 @@ func RegisterAuthServerStaticFromParams(file, jsonConfig string, reloadInterval
 // NewAuthServerStatic returns a new empty AuthServerStatic.
 func NewAuthServerStatic(file, jsonConfig string, reloadInterval time.Duration) *AuthServerStatic {
 	a := &AuthServerStatic{
-		file:           file,
-		jsonConfig:     jsonConfig,
-		reloadInterval: reloadInterval,
-		method:         MysqlNativePassword,
-		entries:        make(map[string][]*AuthServerStaticEntry),
+		file:             file,
+		jsonConfig:       jsonConfig,
+		reloadInterval:   reloadInterval,
+		method:           MysqlNativePassword,
+		entries:          make(map[string][]*AuthServerStaticEntry),
+		cachingSha2Cache: make(map[string][]byte),
 	}
 	a.reload()
 	a.installSignalHandlers()
@@ -145,6 +156,7 @@ func (a *AuthServerStatic) reload() {
 
 	a.mu.Lock()
 	a.entries = entries
+	a.cachingSha2Cache = make(map[string][]byte)
 	a.mu.Unlock()
 }
 
@@ -212,13 +224,29 @@ func validateConfig(config map[string][]*AuthServerStaticEntry) error {
 			if entry.SourceHost != "" && entry.SourceHost != localhostName {
 				return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid SourceHost found (only localhost is supported): %v", entry.SourceHost)
 			}
+			if entry.CachingSha2Password != "" && !strings.HasPrefix(entry.CachingSha2Password, cachingSha2AuthStringPrefix) {
+				return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid CachingSha2Password found (expected a %v... authentication_string)", cachingSha2AuthStringPrefix)
+			}
 		}
 	}
 	return nil
 }
 
 // AuthMethod is part of the AuthServer interface.
+// The users with a CachingSha2Password use caching_sha2_password,
+// unless the method of the server is not MysqlNativePassword.
 func (a *AuthServerStatic) AuthMethod(user string) (string, error) {
+	if a.method != MysqlNativePassword {
+		return a.method, nil
+	}
+	a.mu.Lock()
+	entries := a.entries[user]
+	a.mu.Unlock()
+	for _, entry := range entries {
+		if entry.CachingSha2Password != "" {
+			return CachingSha2Password, nil
+		}
+	}
 	return a.method, nil
 }
 
@@ -238,6 +266,10 @@ func (a *AuthServerStatic) ValidateHash(salt []byte, user string, authResponse [
 	}
 
 	for _, entry := range entries {
+		if entry.CachingSha2Password != "" {
+			// Can only be validated with caching_sha2_password.
+			continue
+		}
 		if entry.MysqlNativePassword != "" {
 			isPass := isPassScrambleMysqlNativePassword(authResponse, salt, entry.MysqlNativePassword)
 			if matchSourceHost(remoteAddr, entry.SourceHost) && isPass {
@@ -273,13 +305,90 @@ func (a *AuthServerStatic) Negotiate(c *Conn, user string, remoteAddr net.Addr)
 	}
 	for _, entry := range entries {
 		// Validate the password.
-		if matchSourceHost(remoteAddr, entry.SourceHost) && entry.Password == password {
+		if matchSourceHost(remoteAddr, entry.SourceHost) && a.isPass(user, entry, password) {
 			return &StaticUserData{entry.UserData, entry.Groups}, nil
 		}
 	}
 	return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
 }
 
+// ValidateCachingSha2Scramble is part of the CachingSha2AuthServer interface.
+// The scramble of the entries with a Password can always be validated,
+// while the hash of a CachingSha2Password is only known once a client has
+// done the full authentication.
+func (a *AuthServerStatic) ValidateCachingSha2Scramble(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	entries, ok := a.entries[user]
+	if !ok {
+		return &StaticUserData{}, false, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+
+	notCached := false
+	for _, entry := range entries {
+		if !matchSourceHost(remoteAddr, entry.SourceHost) {
+			continue
+		}
+		var hash []byte
+		switch {
+		case entry.CachingSha2Password != "":
+			if hash, ok = a.cachingSha2Cache[cachingSha2CacheKey(user, entry)]; !ok {
+				notCached = true
+				continue
+			}
+		case entry.MysqlNativePassword != "":
+			// Can only be validated with mysql_native_password.
+			continue
+		default:
+			hash = cachingSha2Hash([]byte(entry.Password))
+		}
+		if isPassScrambleCachingSha2Password(authResponse, salt, hash) {
+			return &StaticUserData{entry.UserData, entry.Groups}, true, nil
+		}
+	}
+	if notCached {
+		return nil, false, nil
+	}
+	return &StaticUserData{}, false, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// ValidateCachingSha2Password is part of the CachingSha2AuthServer interface.
+func (a *AuthServerStatic) ValidateCachingSha2Password(user string, password string, remoteAddr net.Addr) (Getter, error) {
+	a.mu.Lock()
+	entries, ok := a.entries[user]
+	a.mu.Unlock()
+
+	if !ok {
+		return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+	for _, entry := range entries {
+		if matchSourceHost(remoteAddr, entry.SourceHost) && a.isPass(user, entry, password) {
+			return &StaticUserData{entry.UserData, entry.Groups}, nil
+		}
+	}
+	return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// isPass returns true if the clear text password is the password of the
+// entry. The hash of a valid CachingSha2Password is cached.
+func (a *AuthServerStatic) isPass(user string, entry *AuthServerStaticEntry, password string) bool {
+	if entry.CachingSha2Password == "" {
+		return entry.MysqlNativePassword == "" && entry.Password == password
+	}
+	if !isPassCachingSha2Password(password, entry.CachingSha2Password) {
+		return false
+	}
+	a.mu.Lock()
+	a.cachingSha2Cache[cachingSha2CacheKey(user, entry)] = cachingSha2Hash([]byte(password))
+	a.mu.Unlock()
+	return true
+}
+
+func cachingSha2CacheKey(user string, entry *AuthServerStaticEntry) string {
+	return user + "\x00" + entry.CachingSha2Password
+}
+
 func matchSourceHost(remoteAddr net.Addr, targetSourceHost string) bool {
 	// Legacy support, there was not matcher defined default to true
 	if targetSourceHost == "" {
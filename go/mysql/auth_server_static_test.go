@@ -285,3 +285,86 @@ func TestStaticPasswords(t *testing.T) {
 		})
 	}
 }
+
+func TestSha256Crypt(t *testing.T) {
+	// Reference values generated with `openssl passwd -5`.
+	tests := []struct {
+		password string
+		salt     string
+		want     string
+	}{
+		{"Hello world!", "saltstring", "5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5"},
+		{"secret", "abcdefghijklmnop", "i7zHaTeYqXlesRXJ8dXj6o.MEFQdzfIJpJpcWAtszs6"},
+	}
+	for _, tcase := range tests {
+		if got := sha256Crypt([]byte(tcase.password), []byte(tcase.salt), 5000); got != tcase.want {
+			t.Errorf("sha256Crypt(%q, %q): %v, want %v", tcase.password, tcase.salt, got, tcase.want)
+		}
+	}
+}
+
+func TestStaticCachingSha2Passwords(t *testing.T) {
+	jsonConfig := `
+{
+	"user01": [{
+		"CachingSha2Password": "$A$005$0123456789abcdefghijvH/7483x7v0zJynii3XLhsw7EY2YJTAByYrlXKMsOa3",
+		"UserData": "userData1"
+	}],
+	"user02": [{ "Password": "password2" }]
+}`
+
+	auth := NewAuthServerStatic("", jsonConfig, 0)
+	defer auth.close()
+	addr := &net.IPAddr{IP: net.ParseIP("127.0.0.1"), Zone: ""}
+
+	method, err := auth.AuthMethod("user01")
+	if err != nil || method != CachingSha2Password {
+		t.Fatalf("AuthMethod(user01): %v, %v, want %v", method, err, CachingSha2Password)
+	}
+	method, err = auth.AuthMethod("user02")
+	if err != nil || method != MysqlNativePassword {
+		t.Fatalf("AuthMethod(user02): %v, %v, want %v", method, err, MysqlNativePassword)
+	}
+
+	salt, err := NewSalt()
+	if err != nil {
+		t.Fatalf("error generating salt: %v", err)
+	}
+
+	// Nothing is cached before a full authentication.
+	scrambled := ScrambleCachingSha2Password(salt, []byte("password1"))
+	if _, cached, err := auth.ValidateCachingSha2Scramble(salt, "user01", scrambled, addr); err != nil || cached {
+		t.Fatalf("fast authentication should have been a cache miss: %v, %v", cached, err)
+	}
+
+	if _, err := auth.ValidateCachingSha2Password("user01", "bad", addr); err == nil {
+		t.Fatalf("full authentication should have failed")
+	}
+	getter, err := auth.ValidateCachingSha2Password("user01", "password1", addr)
+	if err != nil {
+		t.Fatalf("full authentication should have succeeded: %v", err)
+	}
+	if got := getter.Get().Username; got != "userData1" {
+		t.Errorf("Get().Username: %v, want userData1", got)
+	}
+
+	// Now the fast path works, and still rejects a bad password.
+	if _, cached, err := auth.ValidateCachingSha2Scramble(salt, "user01", scrambled, addr); err != nil || !cached {
+		t.Fatalf("fast authentication should have succeeded: %v, %v", cached, err)
+	}
+	bad := ScrambleCachingSha2Password(salt, []byte("bad"))
+	if _, _, err := auth.ValidateCachingSha2Scramble(salt, "user01", bad, addr); err == nil {
+		t.Fatalf("fast authentication should have failed")
+	}
+
+	// Clear text passwords also work with caching_sha2_password.
+	if _, err := auth.ValidateCachingSha2Password("user02", "password2", addr); err != nil {
+		t.Fatalf("full authentication should have succeeded: %v", err)
+	}
+
+	// Reloading the configuration invalidates the cache.
+	auth.reload()
+	if _, cached, err := auth.ValidateCachingSha2Scramble(salt, "user01", scrambled, addr); err != nil || cached {
+		t.Fatalf("fast authentication should have been a cache miss after reload: %v, %v", cached, err)
+	}
+}
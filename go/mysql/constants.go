@@ -257,6 +257,9 @@ const (
 	// CachingSha2FullAuth is sent when server requests un-scrambled password to authenticate
 	CachingSha2FullAuth = 0x04
 
+	// cachingSha2RequestPublicKey is sent by the client to request the public key of the server
+	cachingSha2RequestPublicKey = 0x02
+
 	// AuthSwitchRequestPacket is used to switch auth method.
 	AuthSwitchRequestPacket = 0xfe
 )
@@ -518,9 +521,11 @@ const (
 	ERDerivedMustHaveAlias         = 1248
 	ERTableNameNotAllowedHere      = 1250
 	ERQueryInterrupted             = 1317
+	ERViewWrongList                = 1353
 	ERTruncatedWrongValueForField  = 1366
 	ERDataTooLong                  = 1406
 	ERForbidSchemaChange           = 1450
+	ERWrongParamcountToNativeFct   = 1582
 	ERDataOutOfRange               = 1690
 
 	// server not available
@@ -18,8 +18,12 @@ package mysql
 
 import (
 	"context"
+	"crypto/rsa"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"io"
+	"io/ioutil"
 	"net"
 	"strings"
 	"sync/atomic"
@@ -175,6 +179,12 @@ type Listener struct {
 	// RequireSecureTransport configures the server to reject connections from insecure clients
 	RequireSecureTransport bool
 
+	// CachingSha2PrivateKey is the RSA key used by the caching_sha2_password
+	// method to receive the password of the clients that do not use TLS or a
+	// Unix socket. If it is not set, these clients can only authenticate if
+	// the hash of their password is cached by the AuthServer.
+	CachingSha2PrivateKey *rsa.PrivateKey
+
 	// PreHandleFunc is called for each incoming connection, immediately after
 	// accepting a new connection. By default it's no-op. Useful for custom
 	// connection inspection or TLS termination. The returned connection is
@@ -428,6 +438,18 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		c.User = user
 		c.UserData = userData
 
+	case authServerMethod == CachingSha2Password:
+		// The server wants to use CachingSha2Password: the negotiation
+		// may need several roundtrips with the client.
+		userData, err := l.authCachingSha2(c, salt, user, authMethod, authResponse)
+		if err != nil {
+			log.Warningf("Error authenticating user using caching_sha2_password: %v", err)
+			c.writeErrorPacketFromError(err)
+			return
+		}
+		c.User = user
+		c.UserData = userData
+
 	default:
 		// The server wants to use something else, re-negotiate.
 
@@ -499,6 +521,126 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	}
 }
 
+// authCachingSha2 authenticates the client using caching_sha2_password.
+// It returns the user data of the client, or an error to send to it.
+func (l *Listener) authCachingSha2(c *Conn, salt []byte, user, authMethod string, authResponse []byte) (Getter, error) {
+	authServer, ok := l.authServer.(CachingSha2AuthServer)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "auth server does not support %v", CachingSha2Password)
+	}
+	remoteAddr := c.conn.RemoteAddr()
+
+	if authMethod != CachingSha2Password {
+		// The client sent a response for another method,
+		// ask it for a CachingSha2Password scramble.
+		// The binary protocol requires padding with 0
+		data := append(salt, byte(0x00))
+		if err := c.writeAuthSwitchRequest(CachingSha2Password, data); err != nil {
+			return nil, err
+		}
+		response, err := c.readEphemeralPacket()
+		if err != nil {
+			return nil, err
+		}
+		authResponse = make([]byte, len(response))
+		copy(authResponse, response)
+		c.recycleReadPacket()
+	}
+
+	// An empty response is sent for an empty password.
+	if len(authResponse) == 0 {
+		return authServer.ValidateCachingSha2Password(user, "", remoteAddr)
+	}
+
+	// Try the "fast" authentication first.
+	userData, cached, err := authServer.ValidateCachingSha2Scramble(salt, user, authResponse, remoteAddr)
+	if err != nil {
+		return nil, err
+	}
+	if cached {
+		if err := c.writeAuthMoreDataPacket([]byte{CachingSha2FastAuth}); err != nil {
+			return nil, err
+		}
+		return userData, nil
+	}
+
+	// The password is not cached, ask the client for its password.
+	if err := c.writeAuthMoreDataPacket([]byte{CachingSha2FullAuth}); err != nil {
+		return nil, err
+	}
+	password, err := l.readCachingSha2Password(c, salt)
+	if err != nil {
+		return nil, err
+	}
+	return authServer.ValidateCachingSha2Password(user, password, remoteAddr)
+}
+
+// readCachingSha2Password reads the password sent by the client during the
+// "full" authentication of caching_sha2_password. The password is sent in
+// clear text over secure connections, and encrypted with the public key of
+// the server otherwise.
+func (l *Listener) readCachingSha2Password(c *Conn, salt []byte) (string, error) {
+	if _, unix := c.conn.RemoteAddr().(*net.UnixAddr); unix || c.Capabilities&CapabilityClientSSL > 0 {
+		return AuthServerReadPacketString(c)
+	}
+
+	data, err := c.readEphemeralPacket()
+	if err != nil {
+		return "", err
+	}
+	if l.CachingSha2PrivateKey == nil {
+		c.recycleReadPacket()
+		return "", NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "Authentication requires secure connection.")
+	}
+	// The client may request the public key of the server
+	// before sending its encrypted password.
+	if len(data) == 1 && data[0] == cachingSha2RequestPublicKey {
+		c.recycleReadPacket()
+		publicKey, err := x509.MarshalPKIXPublicKey(&l.CachingSha2PrivateKey.PublicKey)
+		if err != nil {
+			return "", err
+		}
+		if err := c.writeAuthMoreDataPacket(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})); err != nil {
+			return "", err
+		}
+		if data, err = c.readEphemeralPacket(); err != nil {
+			return "", err
+		}
+	}
+	defer c.recycleReadPacket()
+	password, err := DecryptPasswordWithPrivateKey(salt, data, l.CachingSha2PrivateKey)
+	if err != nil {
+		return "", NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot decrypt password: %v", err)
+	}
+	return password, nil
+}
+
+// ReadRSAPrivateKey reads a PEM encoded RSA private key, in the PKCS #1
+// or PKCS #8 format, that can be used as the CachingSha2PrivateKey of a
+// Listener.
+func ReadRSAPrivateKey(file string) (*rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "no PEM data found in %v", file)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot parse private key in %v", file)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "private key in %v is not a RSA key", file)
+	}
+	return rsaKey, nil
+}
+
 // Close stops the listener, which prevents accept of any new connections. Existing connections won't be closed.
 func (l *Listener) Close() {
 	l.listener.Close()
@@ -815,6 +957,20 @@ func (c *Conn) writeAuthSwitchRequest(pluginName string, pluginData []byte) erro
 	return c.writeEphemeralPacket()
 }
 
+// writeAuthMoreDataPacket writes an AuthMoreData packet, used by
+// caching_sha2_password to send its state or public key to the client.
+func (c *Conn) writeAuthMoreDataPacket(payload []byte) error {
+	data, pos := c.startEphemeralPacketWithHeader(1 + len(payload))
+	pos = writeByte(data, pos, AuthMoreDataPacket)
+	pos += copy(data[pos:], payload)
+
+	// Sanity check.
+	if pos != len(data) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "error building AuthMoreDataPacket packet: got %v bytes expected %v", pos, len(data))
+	}
+	return c.writeEphemeralPacket()
+}
+
 // Whenever we move to a new version of go, we will need add any new supported TLS versions here
 func tlsVersionToString(version uint16) string {
 	switch version {
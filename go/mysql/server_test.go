@@ -18,6 +18,8 @@ package mysql
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
 	"fmt"
 	"io/ioutil"
@@ -764,6 +766,63 @@ func TestClearTextServer(t *testing.T) {
 	assert.Contains(t, output, "Access denied for user 'user1'", "Unexpected output for 'select rows': %v", output)
 }
 
+// TestCachingSha2PasswordServer creates a Server that uses
+// caching_sha2_password, and checks the full and fast authentication.
+func TestCachingSha2PasswordServer(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		CachingSha2Password: "$A$005$0123456789abcdefghijvH/7483x7v0zJynii3XLhsw7EY2YJTAByYrlXKMsOa3",
+		UserData:            "userData1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err)
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+
+	// Without an RSA key, the full authentication requires TLS.
+	_, err = Connect(context.Background(), params)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Authentication requires secure connection")
+
+	// With an RSA key, the password is encrypted with the public key.
+	lKey, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err)
+	defer lKey.Close()
+	lKey.CachingSha2PrivateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	go lKey.Accept()
+
+	keyHost, keyPort := getHostPort(t, lKey.Addr())
+	keyParams := *params
+	keyParams.Host, keyParams.Port = keyHost, keyPort
+	c, err := Connect(context.Background(), &keyParams)
+	require.NoError(t, err)
+	c.Close()
+
+	// The password is now cached, and the fast authentication succeeds
+	// without the key.
+	c, err = Connect(context.Background(), params)
+	require.NoError(t, err)
+	c.Close()
+
+	// A bad password is rejected.
+	params.Pass = "bad"
+	_, err = Connect(context.Background(), params)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Access denied for user 'user1'")
+}
+
 // TestDialogServer creates a Server that uses the dialog plugin on the client.
 func TestDialogServer(t *testing.T) {
 	th := &testHandler{}
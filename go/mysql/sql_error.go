@@ -164,6 +164,7 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.BadDb:                        {num: ERBadDb, state: SSClientError},
 	vterrors.BadFieldError:                {num: ERBadFieldError, state: SSBadFieldError},
 	vterrors.BadTableError:                {num: ERBadTable, state: SSUnknownTable},
+	vterrors.CantAggregate2Collations:     {num: ERCantAggregate2Collations, state: SSUnknownSQLState},
 	vterrors.CantUseOptionHere:            {num: ERCantUseOptionHere, state: SSClientError},
 	vterrors.DataOutOfRange:               {num: ERDataOutOfRange, state: SSDataOutOfRange},
 	vterrors.DbCreateExists:               {num: ERDbCreateExists, state: SSUnknownSQLState},
@@ -188,6 +189,7 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.UnknownTable:                 {num: ERUnknownTable, state: SSUnknownTable},
 	vterrors.WrongGroupField:              {num: ERWrongGroupField, state: SSClientError},
 	vterrors.WrongNumberOfColumnsInSelect: {num: ERWrongNumberOfColumnsInSelect, state: SSWrongNumberOfColumns},
+	vterrors.WrongParamcountToNativeFct:   {num: ERWrongParamcountToNativeFct, state: SSClientError},
 	vterrors.WrongTypeForVar:              {num: ERWrongTypeForVar, state: SSClientError},
 	vterrors.WrongValueForVar:             {num: ERWrongValueForVar, state: SSClientError},
 	vterrors.ServerNotAvailable:           {num: ERServerIsntAvailable, state: SSNetError},
@@ -89,8 +89,10 @@ func (c *cloneGen) sliceMethod(t types.Type, slice *types.Slice, spi generatorSP
 	c.addFunc(funcName,
 		//func (n Bytes) Clone() Bytes {
 		jen.Func().Id(funcName).Call(jen.Id("n").Id(typeString)).Id(typeString).Block(
+			//	if n == nil { return nil }
+			ifNilReturnNil("n"),
 			//	res := make(Bytes, len(n))
-			jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Lit(0), jen.Id("len").Call(jen.Id("n"))),
+			c.makeSlice(typeString, slice.Elem()),
 			c.copySliceElement(slice.Elem(), spi),
 			//	return res
 			jen.Return(jen.Id("res")),
@@ -102,6 +104,15 @@ func (c *cloneGen) basicMethod(t types.Type, basic *types.Basic, spi generatorSP
 	return nil
 }
 
+func (c *cloneGen) makeSlice(typeString string, elType types.Type) jen.Code {
+	if isBasic(elType) {
+		//	res := make(Bytes, len(n))
+		return jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Id("len").Call(jen.Id("n")))
+	}
+	//	res := make(Columns, 0, len(n))
+	return jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Lit(0), jen.Id("len").Call(jen.Id("n")))
+}
+
 func (c *cloneGen) copySliceElement(elType types.Type, spi generatorSPI) jen.Code {
 	if isBasic(elType) {
 		//	copy(res, n)
@@ -0,0 +1,279 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+/*
+This file makes it easy to build Vitess without including the OpenTelemetry
+binaries. All that is needed is to delete this file.
+*/
+
+var (
+	otelExporter     = flag.String("otel-exporter", "otlp", "where the opentelemetry tracer exports spans to. possible values are 'otlp' (to -otel-otlp-endpoint) or 'file' (to -otel-file)")
+	otelOTLPEndpoint = flag.String("otel-otlp-endpoint", "localhost:4317", "host and port of the OTLP gRPC collector to send spans to")
+	otelOTLPInsecure = flag.Bool("otel-otlp-insecure", false, "disable TLS to the OTLP collector")
+	otelFile         = flag.String("otel-file", "", "file to append the spans to as JSON, with the 'file' exporter. if empty, stdout is used")
+)
+
+// traceParentKey is the key of the W3C trace context in a propagation carrier.
+const traceParentKey = "traceparent"
+
+// newOpenTelemetryTracer will instantiate a tracingService implemented by the
+// OpenTelemetry SDK. Spans are sampled with -tracing-sampling-rate, unless the
+// parent span was sampled, and the span contexts are propagated with the W3C
+// trace context headers.
+func newOpenTelemetryTracer(serviceName string) (tracingService, io.Closer, error) {
+	var exporter sdktrace.SpanExporter
+	var file *os.File
+	switch *otelExporter {
+	case "otlp":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(*otelOTLPEndpoint)}
+		if *otelOTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		// The exporter connects in the background, and keeps
+		// retrying if the collector isn't up.
+		exp, err := otlptracegrpc.New(context.Background(), opts...)
+		if err != nil {
+			return nil, &nilCloser{}, err
+		}
+		exporter = exp
+		log.Infof("Tracing to OTLP collector %v as %v", *otelOTLPEndpoint, serviceName)
+	case "file":
+		w := io.Writer(os.Stdout)
+		if *otelFile != "" {
+			var err error
+			file, err = os.OpenFile(*otelFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+			if err != nil {
+				return nil, &nilCloser{}, err
+			}
+			w = file
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(w))
+		if err != nil {
+			if file != nil {
+				file.Close()
+			}
+			return nil, &nilCloser{}, err
+		}
+		exporter = exp
+		log.Infof("Tracing to file %q as %v", *otelFile, serviceName)
+	default:
+		return nil, &nilCloser{}, fmt.Errorf("unknown -otel-exporter %q", *otelExporter)
+	}
+
+	log.Infof("Tracing sampling rate %v", samplingRate.Get())
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRate.Get()))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+	propagator := propagation.TraceContext{}
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	if *enableLogging {
+		otel.SetErrorHandler(otelErrorHandler{})
+	}
+
+	return openTelemetryService{
+		provider:   provider,
+		tracer:     provider.Tracer("vitess.io/vitess"),
+		propagator: propagator,
+	}, &otelCloser{
+		provider: provider,
+		file:     file,
+	}, nil
+}
+
+func init() {
+	tracingBackendFactories["opentelemetry"] = newOpenTelemetryTracer
+}
+
+var _ io.Closer = (*otelCloser)(nil)
+
+// otelCloser flushes the spans that weren't exported yet.
+type otelCloser struct {
+	provider *sdktrace.TracerProvider
+	file     *os.File
+}
+
+func (c *otelCloser) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := c.provider.Shutdown(ctx)
+	if c.file != nil {
+		if cerr := c.file.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// otelErrorHandler logs the errors of the OpenTelemetry SDK.
+type otelErrorHandler struct{}
+
+func (otelErrorHandler) Handle(err error) {
+	log.Errorf("opentelemetry: %v", err)
+}
+
+var _ propagation.TextMapCarrier = (mapCarrier)(nil)
+
+// mapCarrier adapts a map to the propagation.TextMapCarrier interface.
+type mapCarrier map[string]string
+
+func (c mapCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c mapCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+var _ Span = (*openTelemetrySpan)(nil)
+
+type openTelemetrySpan struct {
+	otelSpan oteltrace.Span
+}
+
+// Finish will mark a span as finished
+func (s openTelemetrySpan) Finish() {
+	s.otelSpan.End()
+}
+
+// Annotate will add information to an existing span
+func (s openTelemetrySpan) Annotate(key string, value interface{}) {
+	var kv attribute.KeyValue
+	switch v := value.(type) {
+	case string:
+		kv = attribute.String(key, v)
+	case bool:
+		kv = attribute.Bool(key, v)
+	case int:
+		kv = attribute.Int(key, v)
+	case int32:
+		kv = attribute.Int64(key, int64(v))
+	case int64:
+		kv = attribute.Int64(key, v)
+	case uint32:
+		kv = attribute.Int64(key, int64(v))
+	case float64:
+		kv = attribute.Float64(key, v)
+	case fmt.Stringer:
+		kv = attribute.Stringer(key, v)
+	default:
+		kv = attribute.String(key, fmt.Sprint(v))
+	}
+	s.otelSpan.SetAttributes(kv)
+}
+
+var _ tracingService = (*openTelemetryService)(nil)
+
+type openTelemetryService struct {
+	provider   oteltrace.TracerProvider
+	tracer     oteltrace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// AddGrpcServerOptions is part of an interface implementation
+func (s openTelemetryService) AddGrpcServerOptions(addInterceptors func(s grpc.StreamServerInterceptor, u grpc.UnaryServerInterceptor)) {
+	opts := []otelgrpc.Option{otelgrpc.WithTracerProvider(s.provider), otelgrpc.WithPropagators(s.propagator)}
+	addInterceptors(otelgrpc.StreamServerInterceptor(opts...), otelgrpc.UnaryServerInterceptor(opts...))
+}
+
+// AddGrpcClientOptions is part of an interface implementation
+func (s openTelemetryService) AddGrpcClientOptions(addInterceptors func(s grpc.StreamClientInterceptor, u grpc.UnaryClientInterceptor)) {
+	opts := []otelgrpc.Option{otelgrpc.WithTracerProvider(s.provider), otelgrpc.WithPropagators(s.propagator)}
+	addInterceptors(otelgrpc.StreamClientInterceptor(opts...), otelgrpc.UnaryClientInterceptor(opts...))
+}
+
+// New is part of an interface implementation
+func (s openTelemetryService) New(parent Span, label string) Span {
+	ctx := context.Background()
+	if parent != nil {
+		ctx = oteltrace.ContextWithSpan(ctx, parent.(openTelemetrySpan).otelSpan)
+	}
+	_, span := s.tracer.Start(ctx, label)
+	return openTelemetrySpan{otelSpan: span}
+}
+
+// NewFromString is part of an interface implementation. The parent
+// is either a W3C traceparent header value, or the base64 encoded
+// JSON of a map of the trace context headers, like for opentracing.
+func (s openTelemetryService) NewFromString(parent, label string) (Span, error) {
+	carrier, err := extractMapFromString(parent)
+	if err != nil {
+		carrier = map[string]string{traceParentKey: parent}
+	}
+	ctx := s.propagator.Extract(context.Background(), mapCarrier(carrier))
+	if !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "failed to deserialize span context")
+	}
+	_, span := s.tracer.Start(ctx, label)
+	return openTelemetrySpan{otelSpan: span}, nil
+}
+
+// FromContext is part of an interface implementation
+func (s openTelemetryService) FromContext(ctx context.Context) (Span, bool) {
+	span := oteltrace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		return nil, false
+	}
+	return openTelemetrySpan{otelSpan: span}, true
+}
+
+// NewContext is part of an interface implementation
+func (s openTelemetryService) NewContext(parent context.Context, span Span) context.Context {
+	otelSpan, ok := span.(openTelemetrySpan)
+	if !ok {
+		return nil
+	}
+	return oteltrace.ContextWithSpan(parent, otelSpan.otelSpan)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func newTestOpenTelemetryTracer(t *testing.T) (openTelemetryService, func() []map[string]interface{}) {
+	t.Helper()
+	file := path.Join(t.TempDir(), "spans.json")
+	*otelExporter = "file"
+	*otelFile = file
+	defer func() {
+		*otelExporter = "otlp"
+		*otelFile = ""
+	}()
+	samplingRate.Set("1")
+
+	tracer, closer, err := newOpenTelemetryTracer("vtservice")
+	require.NoError(t, err)
+
+	// spans closes the tracer, and returns the exported spans.
+	spans := func() []map[string]interface{} {
+		require.NoError(t, closer.Close())
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		var result []map[string]interface{}
+		dec := json.NewDecoder(strings.NewReader(string(data)))
+		for dec.More() {
+			var span map[string]interface{}
+			require.NoError(t, dec.Decode(&span))
+			result = append(result, span)
+		}
+		return result
+	}
+	return tracer.(openTelemetryService), spans
+}
+
+func TestOpenTelemetrySpans(t *testing.T) {
+	tracer, spans := newTestOpenTelemetryTracer(t)
+
+	parent := tracer.New(nil, "parent")
+	ctx := tracer.NewContext(context.Background(), parent)
+	fromCtx, ok := tracer.FromContext(ctx)
+	require.True(t, ok)
+	child := tracer.New(fromCtx, "child")
+	child.Annotate("string", "value")
+	child.Annotate("int", 42)
+	child.Finish()
+	parent.Finish()
+
+	_, ok = tracer.FromContext(context.Background())
+	assert.False(t, ok)
+
+	got := spans()
+	require.Len(t, got, 2)
+	assert.Equal(t, "child", got[0]["Name"])
+	assert.Equal(t, "parent", got[1]["Name"])
+	childCtx := got[0]["SpanContext"].(map[string]interface{})
+	parentCtx := got[1]["SpanContext"].(map[string]interface{})
+	assert.Equal(t, parentCtx["TraceID"], childCtx["TraceID"])
+	assert.Equal(t, parentCtx["SpanID"], got[0]["Parent"].(map[string]interface{})["SpanID"])
+	assert.Contains(t, got[0]["Attributes"], map[string]interface{}{"Key": "int", "Value": map[string]interface{}{"Type": "INT64", "Value": float64(42)}})
+}
+
+func TestOpenTelemetryNewFromString(t *testing.T) {
+	tracer, spans := newTestOpenTelemetryTracer(t)
+
+	// A plain W3C traceparent.
+	span, err := tracer.NewFromString(testTraceParent, "plain")
+	require.NoError(t, err)
+	span.Finish()
+
+	// The base64 encoded JSON of the headers.
+	jsonBytes, err := json.Marshal(map[string]string{"traceparent": testTraceParent})
+	require.NoError(t, err)
+	span, err = tracer.NewFromString(base64.StdEncoding.EncodeToString(jsonBytes), "encoded")
+	require.NoError(t, err)
+	span.Finish()
+
+	_, err = tracer.NewFromString("not a span context", "bad")
+	assert.Error(t, err)
+
+	got := spans()
+	require.Len(t, got, 2)
+	for _, span := range got {
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span["SpanContext"].(map[string]interface{})["TraceID"])
+		assert.Equal(t, "00f067aa0ba902b7", span["Parent"].(map[string]interface{})["SpanID"])
+	}
+}
+
+func TestOpenTelemetryGrpcPropagation(t *testing.T) {
+	tracer, spans := newTestOpenTelemetryTracer(t)
+	defer spans()
+
+	var client grpc.UnaryClientInterceptor
+	tracer.AddGrpcClientOptions(func(s grpc.StreamClientInterceptor, u grpc.UnaryClientInterceptor) {
+		client = u
+	})
+	var server grpc.UnaryServerInterceptor
+	tracer.AddGrpcServerOptions(func(s grpc.StreamServerInterceptor, u grpc.UnaryServerInterceptor) {
+		server = u
+	})
+
+	parent, err := tracer.NewFromString(testTraceParent, "parent")
+	require.NoError(t, err)
+	ctx := tracer.NewContext(context.Background(), parent)
+
+	// The client sends the W3C trace context in the metadata,
+	// and the server makes its span a child of it.
+	cc, err := grpc.Dial("passthrough:///test", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer cc.Close()
+	var md metadata.MD
+	err = client(ctx, "/vtgate/Execute", nil, nil, cc, func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, md.Get("traceparent"), 1)
+	assert.Contains(t, md.Get("traceparent")[0], "4bf92f3577b34da6a3ce929d0e0e4736")
+
+	_, err = server(metadata.NewIncomingContext(context.Background(), md), nil, &grpc.UnaryServerInfo{FullMethod: "/vtgate/Execute"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		span, ok := tracer.FromContext(ctx)
+		require.True(t, ok)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.(openTelemetrySpan).otelSpan.SpanContext().TraceID().String())
+		return nil, nil
+	})
+	require.NoError(t, err)
+	parent.Finish()
+}
@@ -79,6 +79,8 @@ var (
 	RefreshInterval = flag.Duration("tablet_refresh_interval", 1*time.Minute, "tablet refresh interval")
 	// RefreshKnownTablets tells us whether to process all tablets or only new tablets
 	RefreshKnownTablets = flag.Bool("tablet_refresh_known_tablets", true, "tablet refresh reloads the tablet address/port map from topo in case it changes")
+	// WatchTablets tells us whether to watch the tablets in topo instead of polling them
+	WatchTablets = flag.Bool("tablet_refresh_watch", false, "watch the tablets of each cell in topo for changes, and only poll them every tablet_refresh_interval while the watch fails")
 	// TopoReadConcurrency tells us how many topo reads are allowed in parallel
 	TopoReadConcurrency = flag.Int("topo_read_concurrency", 32, "concurrent topo reads")
 )
@@ -295,7 +297,9 @@ func NewHealthCheck(ctx context.Context, retryDelay, healthCheckTimeout time.Dur
 		} else if len(KeyspacesToWatch) > 0 {
 			filter = NewFilterByKeyspace(KeyspacesToWatch)
 		}
-		topoWatchers = append(topoWatchers, NewCellTabletsWatcher(ctx, topoServer, hc, filter, c, *RefreshInterval, *RefreshKnownTablets, *TopoReadConcurrency))
+		tw := NewCellTabletsWatcher(ctx, topoServer, hc, filter, c, *RefreshInterval, *RefreshKnownTablets, *TopoReadConcurrency)
+		tw.watchTablets = *WatchTablets
+		topoWatchers = append(topoWatchers, tw)
 	}
 
 	hc.topoWatchers = topoWatchers
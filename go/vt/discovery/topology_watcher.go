@@ -45,13 +45,14 @@ const (
 	topologyWatcherOpAddTablet     = "AddTablet"
 	topologyWatcherOpRemoveTablet  = "RemoveTablet"
 	topologyWatcherOpReplaceTablet = "ReplaceTablet"
+	topologyWatcherOpWatchTablets  = "WatchTablets"
 )
 
 var (
 	topologyWatcherOperations = stats.NewCountersWithSingleLabel("TopologyWatcherOperations", "Topology watcher operation counts",
-		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet, topologyWatcherOpAddTablet, topologyWatcherOpRemoveTablet, topologyWatcherOpReplaceTablet)
+		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet, topologyWatcherOpAddTablet, topologyWatcherOpRemoveTablet, topologyWatcherOpReplaceTablet, topologyWatcherOpWatchTablets)
 	topologyWatcherErrors = stats.NewCountersWithSingleLabel("TopologyWatcherErrors", "Topology watcher error counts",
-		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet)
+		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet, topologyWatcherOpWatchTablets)
 )
 
 // tabletInfo is used internally by the TopologyWatcher class
@@ -63,6 +64,8 @@ type tabletInfo struct {
 // TopologyWatcher polls tablet from a configurable set of tablets
 // periodically. When tablets are added / removed, it calls
 // the LegacyTabletRecorder AddTablet / RemoveTablet interface appropriately.
+// If watchTablets is set, it watches all the tablets of the cell
+// instead, and only polls them while the watch can't be established.
 type TopologyWatcher struct {
 	// set at construction time
 	topoServer          *topo.Server
@@ -72,6 +75,7 @@ type TopologyWatcher struct {
 	refreshInterval     time.Duration
 	refreshKnownTablets bool
 	getTablets          func(tw *TopologyWatcher) ([]*topodata.TabletAlias, error)
+	watchTablets        bool
 	sem                 chan int
 	ctx                 context.Context
 	cancelFunc          context.CancelFunc
@@ -86,6 +90,8 @@ type TopologyWatcher struct {
 	topoChecksum uint32
 	// lastRefresh records the timestamp of the last topo refresh
 	lastRefresh time.Time
+	// watching is true while the tablets are kept up to date by a watch.
+	watching bool
 	// firstLoadDone is true when first load of the topology data is done.
 	firstLoadDone bool
 	// firstLoadChan is closed when the initial loading of topology data is done.
@@ -129,6 +135,11 @@ func (tw *TopologyWatcher) Start() {
 	ticker := time.NewTicker(tw.refreshInterval)
 	defer ticker.Stop()
 	for {
+		if tw.watchTablets {
+			// This only returns when the watch fails, in which
+			// case we poll the tablets until the next attempt.
+			tw.watch()
+		}
 		tw.loadTablets()
 		select {
 		case <-tw.ctx.Done():
@@ -163,14 +174,9 @@ func (tw *TopologyWatcher) loadTablets() {
 		return
 	}
 
-	// Accumulate a list of all known alias strings to use later
-	// when sorting
-	tabletAliasStrs := make([]string, 0, len(tabletAliases))
-
 	tw.mu.Lock()
 	for _, tAlias := range tabletAliases {
 		aliasStr := topoproto.TabletAliasString(tAlias)
-		tabletAliasStrs = append(tabletAliasStrs, aliasStr)
 
 		if !tw.refreshKnownTablets {
 			// we already have a tabletInfo for this and the flag tells us to not refresh
@@ -214,31 +220,147 @@ func (tw *TopologyWatcher) loadTablets() {
 	wg.Wait()
 	tw.mu.Lock()
 
-	for alias, newVal := range newTablets {
-		// trust the alias from topo and add it if it doesn't exist
-		if val, ok := tw.tablets[alias]; !ok {
-			tw.tabletRecorder.AddTablet(newVal.tablet)
-			topologyWatcherOperations.Add(topologyWatcherOpAddTablet, 1)
-		} else {
-			// check if the host and port have changed. If yes, replace tablet
-			oldKey := TabletToMapKey(val.tablet)
-			newKey := TabletToMapKey(newVal.tablet)
-			if oldKey != newKey {
-				// This is the case where the same tablet alias is now reporting
-				// a different address key.
-				tw.tabletRecorder.ReplaceTablet(val.tablet, newVal.tablet)
-				topologyWatcherOperations.Add(topologyWatcherOpReplaceTablet, 1)
+	tw.replaceTablets(newTablets)
+	tw.mu.Unlock()
+
+}
+
+// watch keeps the tablets up to date from a watch on all the tablets
+// of the cell, until the watch fails or the watcher is stopped.
+func (tw *TopologyWatcher) watch() {
+	current, changes, cancel, err := tw.topoServer.WatchTablets(tw.ctx, tw.cell)
+	topologyWatcherOperations.Add(topologyWatcherOpWatchTablets, 1)
+	if err != nil {
+		topologyWatcherErrors.Add(topologyWatcherOpWatchTablets, 1)
+		select {
+		case <-tw.ctx.Done():
+			return
+		default:
+		}
+		log.Errorf("cannot watch tablets for cell: %v: %v", tw.cell, err)
+		return
+	}
+	defer func() {
+		cancel()
+		for range changes {
+		}
+	}()
+
+	// The current tablets replace the ones we know of.
+	newTablets := make(map[string]*tabletInfo)
+	for _, twd := range current {
+		if ti := tw.filterTablet(topoproto.TabletAliasString(twd.Alias), twd.Value); ti != nil {
+			newTablets[ti.alias] = ti
+		}
+	}
+	tw.mu.Lock()
+	tw.replaceTablets(newTablets)
+	tw.watching = true
+	tw.mu.Unlock()
+
+	defer func() {
+		tw.mu.Lock()
+		tw.watching = false
+		tw.mu.Unlock()
+	}()
+
+	for {
+		var twd *topo.WatchTabletData
+		select {
+		case <-tw.ctx.Done():
+			return
+		case twd = <-changes:
+		}
+		if twd == nil || twd.Alias == nil {
+			// The watch is over.
+			topologyWatcherErrors.Add(topologyWatcherOpWatchTablets, 1)
+			select {
+			case <-tw.ctx.Done():
+				return
+			default:
+			}
+			if twd != nil {
+				err = twd.Err
 			}
+			log.Errorf("watch on tablets for cell: %v failed: %v", tw.cell, err)
+			return
+		}
+
+		// A deleted or filtered out tablet is removed.
+		aliasStr := topoproto.TabletAliasString(twd.Alias)
+		var newVal *tabletInfo
+		if twd.Err == nil {
+			newVal = tw.filterTablet(aliasStr, twd.Value)
 		}
+		tw.mu.Lock()
+		oldVal := tw.tablets[aliasStr]
+		tw.recordTablet(oldVal, newVal)
+		if newVal != nil {
+			tw.tablets[aliasStr] = newVal
+		} else {
+			delete(tw.tablets, aliasStr)
+		}
+		tw.refreshDone()
+		tw.mu.Unlock()
 	}
+}
 
+// filterTablet returns the tabletInfo of a tablet, or nil if the
+// tablet filter excludes it.
+func (tw *TopologyWatcher) filterTablet(aliasStr string, tablet *topodata.Tablet) *tabletInfo {
+	if !(tw.tabletFilter == nil || tw.tabletFilter.IsIncluded(tablet)) {
+		return nil
+	}
+	return &tabletInfo{
+		alias:  aliasStr,
+		tablet: tablet,
+	}
+}
+
+// replaceTablets replaces the known tablets with newTablets, and
+// tells the tabletRecorder about the differences. It has to be called
+// with mu held.
+func (tw *TopologyWatcher) replaceTablets(newTablets map[string]*tabletInfo) {
+	for alias, newVal := range newTablets {
+		tw.recordTablet(tw.tablets[alias], newVal)
+	}
 	for _, val := range tw.tablets {
 		if _, ok := newTablets[val.alias]; !ok {
-			tw.tabletRecorder.RemoveTablet(val.tablet)
-			topologyWatcherOperations.Add(topologyWatcherOpRemoveTablet, 1)
+			tw.recordTablet(val, nil)
 		}
 	}
 	tw.tablets = newTablets
+	tw.refreshDone()
+}
+
+// recordTablet tells the tabletRecorder about the change of a tablet
+// from oldVal to newVal, either of which can be nil.
+// It has to be called with mu held.
+func (tw *TopologyWatcher) recordTablet(oldVal, newVal *tabletInfo) {
+	switch {
+	case oldVal == nil && newVal == nil:
+	case oldVal == nil:
+		// trust the alias from topo and add it if it doesn't exist
+		tw.tabletRecorder.AddTablet(newVal.tablet)
+		topologyWatcherOperations.Add(topologyWatcherOpAddTablet, 1)
+	case newVal == nil:
+		tw.tabletRecorder.RemoveTablet(oldVal.tablet)
+		topologyWatcherOperations.Add(topologyWatcherOpRemoveTablet, 1)
+	default:
+		// check if the host and port have changed. If yes, replace tablet
+		oldKey := TabletToMapKey(oldVal.tablet)
+		newKey := TabletToMapKey(newVal.tablet)
+		if oldKey != newKey {
+			// This is the case where the same tablet alias is now reporting
+			// a different address key.
+			tw.tabletRecorder.ReplaceTablet(oldVal.tablet, newVal.tablet)
+			topologyWatcherOperations.Add(topologyWatcherOpReplaceTablet, 1)
+		}
+	}
+}
+
+// refreshDone is called after the tablets map changed, with mu held.
+func (tw *TopologyWatcher) refreshDone() {
 	if !tw.firstLoadDone {
 		tw.firstLoadDone = true
 		close(tw.firstLoadChan)
@@ -246,26 +368,28 @@ func (tw *TopologyWatcher) loadTablets() {
 
 	// iterate through the tablets in a stable order and compute a
 	// checksum of the tablet map
+	tabletAliasStrs := make([]string, 0, len(tw.tablets))
+	for alias := range tw.tablets {
+		tabletAliasStrs = append(tabletAliasStrs, alias)
+	}
 	sort.Strings(tabletAliasStrs)
 	var buf bytes.Buffer
 	for _, alias := range tabletAliasStrs {
-		_, ok := tw.tablets[alias]
-		if ok {
-			buf.WriteString(alias)
-		}
+		buf.WriteString(alias)
 	}
 	tw.topoChecksum = crc32.ChecksumIEEE(buf.Bytes())
 	tw.lastRefresh = time.Now()
-
-	tw.mu.Unlock()
-
 }
 
-// RefreshLag returns the time since the last refresh
+// RefreshLag returns the time since the last refresh.
+// It's 0 while the tablets are watched.
 func (tw *TopologyWatcher) RefreshLag() time.Duration {
 	tw.mu.Lock()
 	defer tw.mu.Unlock()
 
+	if tw.watching {
+		return 0
+	}
 	return time.Since(tw.lastRefresh)
 }
 
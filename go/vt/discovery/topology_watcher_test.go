@@ -17,6 +17,7 @@ limitations under the License.
 package discovery
 
 import (
+	"errors"
 	"math/rand"
 	"testing"
 	"time"
@@ -289,6 +290,128 @@ func checkWatcher(t *testing.T, refreshKnownTablets bool) {
 	tw.Stop()
 }
 
+func TestCellTabletsWatcherWatch(t *testing.T) {
+	ts, factory := memorytopo.NewServerAndFactory("aa")
+	fhc := NewFakeHealthCheck()
+	topologyWatcherOperations.ZeroAll()
+	topologyWatcherErrors.ZeroAll()
+	counts := topologyWatcherOperations.Counts()
+	filter := NewFilterByKeyspace([]string{"keyspace"})
+	tw := NewCellTabletsWatcher(context.Background(), ts, fhc, filter, "aa", 10*time.Minute, true, 5)
+	tw.watchTablets = true
+
+	// A tablet that exists before the watch starts.
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "aa",
+			Uid:  0,
+		},
+		Hostname: "host1",
+		PortMap: map[string]int32{
+			"vt": 123,
+		},
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	}
+	if err := ts.CreateTablet(context.Background(), tablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+
+	go tw.Start()
+	defer tw.Stop()
+	<-tw.firstLoadChan
+	counts = checkOpCounts(t, counts, map[string]int64{"WatchTablets": 1, "AddTablet": 1})
+	checkChecksum(t, tw, 3238442862)
+	if lag := tw.RefreshLag(); lag != 0 {
+		t.Errorf("RefreshLag() = %v while watching, want 0", lag)
+	}
+
+	// waitForTablets waits for the health check to have the given tablets.
+	waitForTablets := func(want ...*topodatapb.Tablet) {
+		t.Helper()
+		for i := 0; ; i++ {
+			allTablets := fhc.GetAllTablets()
+			ok := len(allTablets) == len(want)
+			for _, tablet := range want {
+				if got, found := allTablets[TabletToMapKey(tablet)]; !found || !proto.Equal(got, tablet) {
+					ok = false
+				}
+			}
+			if ok {
+				return
+			}
+			if i == 1000 {
+				t.Fatalf("fhc.GetAllTablets() = %+v; want %+v", allTablets, want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	waitForTablets(tablet)
+
+	// Adding a tablet, and one that's filtered out.
+	tablet2 := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "aa",
+			Uid:  2,
+		},
+		Hostname: "host2",
+		PortMap: map[string]int32{
+			"vt": 789,
+		},
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	}
+	if err := ts.CreateTablet(context.Background(), tablet2); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	otherTablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "aa",
+			Uid:  3,
+		},
+		Hostname: "host3",
+		Keyspace: "otherkeyspace",
+		Shard:    "shard",
+	}
+	if err := ts.CreateTablet(context.Background(), otherTablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	waitForTablets(tablet, tablet2)
+
+	// Changing the port replaces the tablet.
+	if _, err := ts.UpdateTabletFields(context.Background(), tablet.Alias, func(t *topodatapb.Tablet) error {
+		t.PortMap["vt"] = 456
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateTabletFields failed: %v", err)
+	}
+	tablet.PortMap["vt"] = 456
+	waitForTablets(tablet, tablet2)
+
+	// Deleting a tablet removes it.
+	if err := ts.DeleteTablet(context.Background(), tablet.Alias); err != nil {
+		t.Fatalf("DeleteTablet failed: %v", err)
+	}
+	waitForTablets(tablet2)
+	checkOpCounts(t, counts, map[string]int64{"AddTablet": 1, "ReplaceTablet": 1, "RemoveTablet": 1})
+	checkChecksum(t, tw, 789108290)
+
+	// When the watch fails, the tablets are polled instead.
+	factory.SetError(errors.New("topo down"))
+	for i := 0; topologyWatcherErrors.Counts()["WatchTablets"] == 0; i++ {
+		if i == 1000 {
+			t.Fatalf("the watch didn't fail")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	factory.SetError(nil)
+	if err := ts.CreateTablet(context.Background(), tablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	tw.loadTablets()
+	waitForTablets(tablet, tablet2)
+}
+
 func TestFilterByShard(t *testing.T) {
 	testcases := []struct {
 		filters  []string
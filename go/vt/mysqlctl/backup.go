@@ -44,6 +44,7 @@ const (
 	backupInnodbDataHomeDir     = "InnoDBData"
 	backupInnodbLogGroupHomeDir = "InnoDBLog"
 	backupData                  = "Data"
+	backupBinlogDir             = "BinlogDir"
 
 	// backupManifestFileName is the MANIFEST file name within a backup.
 	backupManifestFileName = "MANIFEST"
@@ -84,8 +85,9 @@ var (
 	backupCompressBlockSize = flag.Int("backup_storage_block_size", 250000, "if backup_storage_compress is true, backup_storage_block_size sets the byte size for each block while compressing (default is 250000).")
 
 	// backupCompressBlocks is the number of blocks that are processed
-	// once before the writer blocks
-	backupCompressBlocks = flag.Int("backup_storage_number_blocks", 2, "if backup_storage_compress is true, backup_storage_number_blocks sets the number of blocks that can be processed, at once, before the writer blocks, during compression (default is 2). It should be equal to the number of CPUs available for compression")
+	// once before the writer blocks. It is also the encoder concurrency
+	// for the zstd and lz4 compression engines.
+	backupCompressBlocks = flag.Int("backup_storage_number_blocks", 2, "if backup_storage_compress is true, backup_storage_number_blocks sets the number of blocks that can be processed, at once, before the writer blocks, during compression (default is 2). It should be equal to the number of CPUs available for compression. For the zstd and lz4 compression engines, it sets the number of concurrent compression goroutines")
 
 	backupDuration  = stats.NewGauge("backup_duration_seconds", "How long it took to complete the last backup operation (in seconds)")
 	restoreDuration = stats.NewGauge("restore_duration_seconds", "How long it took to complete the last restore operation (in seconds)")
@@ -265,6 +267,10 @@ func ShouldRestore(ctx context.Context, params RestoreParams) (bool, error) {
 // and returns ErrNoBackup. Any other error is returned.
 func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error) {
 	startTs := time.Now()
+	if !params.RestoreToPos.IsZero() && !params.RestoreToTimestamp.IsZero() {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot restore to both a position and a time")
+	}
+
 	// find the right backup handle: most recent one, with a MANIFEST
 	params.Logger.Infof("Restore: looking for a suitable backup to restore")
 	bs, err := backupstorage.GetBackupStorage()
@@ -283,6 +289,9 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 
 	metadataManager := &MetadataManager{}
 
+	if len(bhs) == 0 && params.IsPointInTime() {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no backup to restore on BackupStorage for directory %v", backupDir)
+	}
 	if len(bhs) == 0 {
 		// There are no backups (not even broken/incomplete ones).
 		params.Logger.Errorf("no backup to restore on BackupStorage for directory %v. Starting up empty.", backupDir)
@@ -309,6 +318,20 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
+	// For a point-in-time restore, make sure the binlog backups can get us
+	// there before we wipe out the current data.
+	var binlogBhs []backupstorage.BackupHandle
+	if params.IsPointInTime() {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			return nil, err
+		}
+		binlogBhs, err = findBinlogBackupsToRestore(ctx, params, bhs, bm.Position)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	re, err := GetRestoreEngine(ctx, bh)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "Failed to find restore engine")
@@ -338,6 +361,16 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "mysql_upgrade failed")
 	}
 
+	if params.IsPointInTime() {
+		params.Logger.Infof("Restore: applying %v binlog backups", len(binlogBhs))
+		pos, err := restoreBinlogBackups(ctx, params, binlogBhs, manifest.Position)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "failed to apply binlog backups")
+		}
+		params.Logger.Infof("Restore: restored to position %v", pos)
+		manifest.Position = pos
+	}
+
 	// Add backupTime and restorePosition to LocalMetadata
 	params.LocalMetadata["RestoredBackupTime"] = manifest.BackupTime
 	params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(manifest.Position)
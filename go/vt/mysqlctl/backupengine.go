@@ -92,6 +92,18 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToPos: if non-zero, restore the most recent full backup that is
+	// not past this position, then apply binlog backups up to it.
+	RestoreToPos mysql.Position
+	// RestoreToTimestamp: if non-zero, restore the most recent full backup
+	// taken at or before this time, then apply binlog backups up to it.
+	RestoreToTimestamp time.Time
+}
+
+// IsPointInTime returns true if the restore targets a position or a time
+// past the full backup, so binlog backups need to be applied.
+func (p *RestoreParams) IsPointInTime() bool {
+	return !p.RestoreToPos.IsZero() || !p.RestoreToTimestamp.IsZero()
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -188,16 +200,29 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// Incremental is true for binlog backups, which only contain the binary
+	// logs written between FromPosition and Position. They can't be restored
+	// on their own, only applied on top of a full backup.
+	Incremental bool
+
+	// FromPosition is the position at which the binary logs of an
+	// incremental backup start. It is empty for full backups.
+	FromPosition mysql.Position
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
-// It returns the most recent backup that is complete, meaning it has a valid
-// MANIFEST file.
+// It returns the most recent full backup that is complete, meaning it has a
+// valid MANIFEST file. Binlog backups are never returned.
 func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
 	var bh backupstorage.BackupHandle
 	var index int
-	// if a StartTime is provided in params, then find a backup that was taken at or before that time
-	checkBackupTime := !params.StartTime.IsZero()
+	// if a StartTime or RestoreToTimestamp is provided in params, then find a backup that was taken at or before that time
+	startTime := params.StartTime
+	if !params.RestoreToTimestamp.IsZero() {
+		startTime = params.RestoreToTimestamp
+	}
+	checkBackupTime := !startTime.IsZero()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
 
 	for index = len(bhs) - 1; index >= 0; index-- {
@@ -209,6 +234,15 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 			continue
 		}
 
+		if bm.Incremental {
+			// Binlog backups can only be applied on top of a full backup.
+			continue
+		}
+		if !params.RestoreToPos.IsZero() && !params.RestoreToPos.AtLeast(bm.Position) {
+			params.Logger.Infof("Restore: skipping backup %v/%v at position %v, which is past %v", backupDir, bh.Name(), bm.Position, params.RestoreToPos)
+			continue
+		}
+
 		var backupTime time.Time
 		if checkBackupTime {
 			backupTime, err = time.Parse(time.RFC3339, bm.BackupTime)
@@ -217,14 +251,14 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 				continue
 			}
 		}
-		if !checkBackupTime /* not snapshot */ || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
+		if !checkBackupTime /* not snapshot */ || backupTime.Equal(startTime) || backupTime.Before(startTime) {
 			params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
 			break
 		}
 	}
 	if index < 0 {
 		if checkBackupTime {
-			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
+			params.Logger.Errorf("No valid backup found before time %v", startTime.Format(BackupTimestampFormat))
 		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
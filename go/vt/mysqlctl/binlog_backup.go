@@ -0,0 +1,292 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles binlog backups, which are incremental backups made of
+// the binary logs written since the previous backup, and point-in-time
+// restores, which apply them on top of a full backup.
+
+// binlogFile is a binary log file, along with the GTID set of all the
+// transactions that were written before it.
+type binlogFile struct {
+	name          string
+	previousGTIDs mysql.Position
+}
+
+// BackupBinlogs is the main entry point for a binlog backup:
+// - it finds the position of the most recent backup of the shard
+// - it rotates the binary logs, so the ones to back up are all closed
+// - it stores the binary logs that are not covered by that backup
+// Unlike Backup, mysqld keeps running and replicating. Nothing is stored
+// if there are no new binary logs.
+func BackupBinlogs(ctx context.Context, params BackupParams) error {
+	startTs := time.Now()
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	name := fmt.Sprintf("%v.%v", params.BackupTime.UTC().Format(BackupTimestampFormat), params.TabletAlias)
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return vterrors.Wrap(err, "ListBackups failed")
+	}
+	fromPos, err := lastBackupPosition(ctx, bhs)
+	if err != nil {
+		return err
+	}
+
+	binlogs, err := listBinlogs(ctx, params.Mysqld)
+	if err != nil {
+		return err
+	}
+	names, startPos, endPos, err := selectBinlogsToBackup(binlogs, fromPos)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		params.Logger.Infof("no binary logs written since position %v, skipping binlog backup", fromPos)
+		return nil
+	}
+	params.Logger.Infof("backing up %v binary logs from position %v to %v", len(names), startPos, endPos)
+
+	fes := make([]FileEntry, len(names))
+	for i, name := range names {
+		fes[i] = FileEntry{
+			Base: backupBinlogDir,
+			Name: name,
+		}
+	}
+
+	bh, err := bs.StartBackup(ctx, backupDir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	be := &BuiltinBackupEngine{}
+	err = be.backupFileEntries(ctx, params, bh, fes, BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     endPos,
+		BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+		Incremental:  true,
+		FromPosition: startPos,
+	})
+	if err != nil {
+		params.Logger.Errorf2(err, "binlog backup is not usable, aborting it")
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			params.Logger.Errorf2(abortErr, "failed to finish backup: %v")
+		}
+		return err
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		return err
+	}
+
+	backupDuration.Set(int64(time.Since(startTs).Seconds()))
+	return nil
+}
+
+// lastBackupPosition returns the position of the most recent complete
+// backup, full or incremental.
+func lastBackupPosition(ctx context.Context, bhs []backupstorage.BackupHandle) (mysql.Position, error) {
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm, err := GetBackupManifest(ctx, bhs[i])
+		if err != nil {
+			// Incomplete backup, keep looking.
+			continue
+		}
+		return bm.Position, nil
+	}
+	return mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no complete backup found, a full backup is required before backing up binary logs")
+}
+
+// listBinlogs flushes the binary logs and returns all of them, oldest first.
+// The last one is the binary log mysqld is now writing to.
+func listBinlogs(ctx context.Context, mysqld MysqlDaemon) ([]binlogFile, error) {
+	if err := mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return nil, vterrors.Wrap(err, "cannot flush binary logs")
+	}
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot list binary logs")
+	}
+
+	binlogs := make([]binlogFile, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		name := row[0].ToString()
+		events, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW BINLOG EVENTS IN '%s' LIMIT 2", name))
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "cannot read binary log %v", name)
+		}
+		// The Previous_gtids event follows the Format_description event at
+		// the start of every binary log. Its Info column holds the GTID set.
+		var previousGTIDs string
+		found := false
+		for _, event := range events.Rows {
+			if len(event) >= 6 && event[2].ToString() == "Previous_gtids" {
+				previousGTIDs = event[5].ToString()
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary log %v has no Previous_gtids event, binlog backups require MySQL with GTIDs", name)
+		}
+		pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, previousGTIDs)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "cannot parse previous GTIDs of binary log %v", name)
+		}
+		binlogs = append(binlogs, binlogFile{
+			name:          name,
+			previousGTIDs: pos,
+		})
+	}
+	return binlogs, nil
+}
+
+// selectBinlogsToBackup returns the names of the binary logs that contain
+// transactions which are not part of fromPos, the position at which the
+// first of them starts and the position at which the last of them ends.
+// binlogs must be ordered, and the last one is the active binary log, which
+// is never selected.
+func selectBinlogsToBackup(binlogs []binlogFile, fromPos mysql.Position) (names []string, startPos, endPos mysql.Position, err error) {
+	if len(binlogs) < 2 {
+		return nil, startPos, endPos, nil
+	}
+	endPos = binlogs[len(binlogs)-1].previousGTIDs
+	for i := 0; i < len(binlogs)-1; i++ {
+		if fromPos.AtLeast(binlogs[i+1].previousGTIDs) {
+			// Everything in this binary log is already backed up.
+			continue
+		}
+		if len(names) == 0 {
+			if !fromPos.AtLeast(binlogs[i].previousGTIDs) {
+				return nil, startPos, endPos, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary logs between position %v and %v have been purged, a full backup is required", fromPos, binlogs[i].previousGTIDs)
+			}
+			startPos = binlogs[i].previousGTIDs
+		}
+		names = append(names, binlogs[i].name)
+	}
+	return names, startPos, endPos, nil
+}
+
+// findBinlogBackupsToRestore returns the binlog backups to apply, in order,
+// on top of a full backup taken at fullPos, to reach the target position or
+// time of params.
+func findBinlogBackupsToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle, fullPos mysql.Position) ([]backupstorage.BackupHandle, error) {
+	pos := fullPos
+	reached := func(backupTime time.Time) bool {
+		if !params.RestoreToPos.IsZero() {
+			return pos.AtLeast(params.RestoreToPos)
+		}
+		return !backupTime.IsZero() && !backupTime.Before(params.RestoreToTimestamp)
+	}
+
+	var result []backupstorage.BackupHandle
+	done := reached(time.Time{})
+	for _, bh := range bhs {
+		if done {
+			break
+		}
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil || !bm.Incremental {
+			continue
+		}
+		if pos.AtLeast(bm.Position) || !pos.AtLeast(bm.FromPosition) {
+			// Either nothing new, or there is a gap with what we have.
+			continue
+		}
+		backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+		if err != nil {
+			params.Logger.Warningf("Restore: skipping binlog backup %v with invalid time %v: %v", bh.Name(), bm.BackupTime, err)
+			continue
+		}
+		params.Logger.Infof("Restore: found binlog backup %v %v to apply", bh.Directory(), bh.Name())
+		result = append(result, bh)
+		pos = bm.Position
+		done = reached(backupTime)
+	}
+	if !done {
+		if !params.RestoreToPos.IsZero() {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlog backups only reach position %v, not %v", pos, params.RestoreToPos)
+		}
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlog backups only reach position %v, not time %v", pos, params.RestoreToTimestamp.Format(time.RFC3339))
+	}
+	return result, nil
+}
+
+// restoreBinlogBackups applies the binary logs of the given binlog backups
+// on top of a restored full backup taken at pos, and returns the position
+// mysqld ends up at. mysqld must be running.
+func restoreBinlogBackups(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle, pos mysql.Position) (mysql.Position, error) {
+	// Make the GTID state match the full backup, so mysqld skips the
+	// transactions it already has, and reports where it stopped.
+	if err := params.Mysqld.SetReplicationPosition(ctx, pos); err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "failed to set replication position")
+	}
+
+	tmpDir, err := ioutil.TempDir(params.Cnf.TmpDir, "restore_binlogs")
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// The binary logs are restored into tmpDir instead of the binlog
+	// directory of mysqld.
+	cnf := *params.Cnf
+	cnf.BinLogPath = path.Join(tmpDir, path.Base(params.Cnf.BinLogPath))
+	binlogParams := params
+	binlogParams.Cnf = &cnf
+
+	be := &BuiltinBackupEngine{}
+	for _, bh := range bhs {
+		var bm builtinBackupManifest
+		if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+			return mysql.Position{}, err
+		}
+		params.Logger.Infof("Restore: copying %v binary logs of binlog backup %v", len(bm.FileEntries), bh.Name())
+		if err := be.restoreFiles(ctx, binlogParams, bh, bm); err != nil {
+			return mysql.Position{}, vterrors.Wrap(err, "failed to restore binary logs")
+		}
+		for _, fe := range bm.FileEntries {
+			name := path.Join(tmpDir, fe.Name)
+			params.Logger.Infof("Restore: applying binary log %v", fe.Name)
+			if err := params.Mysqld.ApplyBinlogFile(ctx, name, params.RestoreToPos, params.RestoreToTimestamp); err != nil {
+				return mysql.Position{}, vterrors.Wrapf(err, "failed to apply binary log %v", fe.Name)
+			}
+			if err := os.Remove(name); err != nil {
+				return mysql.Position{}, err
+			}
+		}
+	}
+	return params.Mysqld.PrimaryPosition()
+}
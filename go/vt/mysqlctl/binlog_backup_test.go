@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func testGTIDPos(t *testing.T, last int) mysql.Position {
+	t.Helper()
+	if last == 0 {
+		pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, "")
+		require.NoError(t, err)
+		return pos
+	}
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, fmt.Sprintf("00000000-0000-0000-0000-000000000001:1-%d", last))
+	require.NoError(t, err)
+	return pos
+}
+
+func TestSelectBinlogsToBackup(t *testing.T) {
+	binlogs := []binlogFile{
+		{name: "bin.000001", previousGTIDs: testGTIDPos(t, 0)},
+		{name: "bin.000002", previousGTIDs: testGTIDPos(t, 10)},
+		{name: "bin.000003", previousGTIDs: testGTIDPos(t, 20)},
+		{name: "bin.000004", previousGTIDs: testGTIDPos(t, 30)},
+	}
+
+	tests := []struct {
+		name      string
+		binlogs   []binlogFile
+		fromPos   int
+		want      []string
+		wantStart int
+		wantErr   bool
+	}{
+		{
+			name:      "from the start",
+			binlogs:   binlogs,
+			fromPos:   0,
+			want:      []string{"bin.000001", "bin.000002", "bin.000003"},
+			wantStart: 0,
+		},
+		{
+			name:      "in the middle of a binlog",
+			binlogs:   binlogs,
+			fromPos:   15,
+			want:      []string{"bin.000002", "bin.000003"},
+			wantStart: 10,
+		},
+		{
+			name:      "at a binlog boundary",
+			binlogs:   binlogs,
+			fromPos:   20,
+			want:      []string{"bin.000003"},
+			wantStart: 20,
+		},
+		{
+			name:    "nothing new",
+			binlogs: binlogs,
+			fromPos: 30,
+			want:    nil,
+		},
+		{
+			name:    "purged binlogs",
+			binlogs: binlogs[2:],
+			fromPos: 15,
+			wantErr: true,
+		},
+		{
+			name:    "only the active binlog",
+			binlogs: binlogs[3:],
+			fromPos: 0,
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names, startPos, endPos, err := selectBinlogsToBackup(tt.binlogs, testGTIDPos(t, tt.fromPos))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, names)
+			if len(tt.want) > 0 {
+				assert.True(t, startPos.Equal(testGTIDPos(t, tt.wantStart)), "startPos = %v", startPos)
+				assert.True(t, endPos.Equal(testGTIDPos(t, 30)), "endPos = %v", endPos)
+			}
+		})
+	}
+}
+
+func TestFindBinlogBackupsToRestore(t *testing.T) {
+	root, err := ioutil.TempDir("", "binlog_backup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	backupDir := GetBackupDir("ks", "0")
+	start := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	writeBackup := func(hour int, bm BackupManifest) {
+		bm.BackupMethod = builtinBackupEngineName
+		bm.BackupTime = start.Add(time.Duration(hour) * time.Hour).Format(time.RFC3339)
+		name := fmt.Sprintf("%v.zone1-0000000100", start.Add(time.Duration(hour)*time.Hour).Format(BackupTimestampFormat))
+		bh, err := bs.StartBackup(ctx, backupDir, name)
+		require.NoError(t, err)
+		wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+		require.NoError(t, err)
+		data, err := json.Marshal(bm)
+		require.NoError(t, err)
+		_, err = wc.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+
+	// A full backup at 10, binlog backups up to 30, another full backup at
+	// 30, and a binlog backup up to 40.
+	writeBackup(0, BackupManifest{Position: testGTIDPos(t, 10)})
+	writeBackup(1, BackupManifest{Position: testGTIDPos(t, 20), Incremental: true, FromPosition: testGTIDPos(t, 0)})
+	writeBackup(2, BackupManifest{Position: testGTIDPos(t, 30), Incremental: true, FromPosition: testGTIDPos(t, 20)})
+	writeBackup(3, BackupManifest{Position: testGTIDPos(t, 30)})
+	writeBackup(4, BackupManifest{Position: testGTIDPos(t, 40), Incremental: true, FromPosition: testGTIDPos(t, 20)})
+
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	require.NoError(t, err)
+	require.Len(t, bhs, 5)
+
+	backupNames := func(bhs []backupstorage.BackupHandle) []string {
+		var names []string
+		for _, bh := range bhs {
+			names = append(names, bh.Name())
+		}
+		return names
+	}
+
+	tests := []struct {
+		name      string
+		params    RestoreParams
+		wantFull  string
+		wantChain []string
+		wantErr   bool
+	}{
+		{
+			name:      "to a position covered by the first full backup",
+			params:    RestoreParams{RestoreToPos: testGTIDPos(t, 25)},
+			wantFull:  bhs[0].Name(),
+			wantChain: []string{bhs[1].Name(), bhs[2].Name()},
+		},
+		{
+			name:      "to a position past the last full backup",
+			params:    RestoreParams{RestoreToPos: testGTIDPos(t, 35)},
+			wantFull:  bhs[3].Name(),
+			wantChain: []string{bhs[4].Name()},
+		},
+		{
+			name:     "to the position of a full backup",
+			params:   RestoreParams{RestoreToPos: testGTIDPos(t, 30)},
+			wantFull: bhs[3].Name(),
+		},
+		{
+			name:    "to a position that was not backed up",
+			params:  RestoreParams{RestoreToPos: testGTIDPos(t, 45)},
+			wantErr: true,
+		},
+		{
+			name:      "to a time",
+			params:    RestoreParams{RestoreToTimestamp: start.Add(90 * time.Minute)},
+			wantFull:  bhs[0].Name(),
+			wantChain: []string{bhs[1].Name(), bhs[2].Name()},
+		},
+		{
+			name:    "to a time that was not backed up",
+			params:  RestoreParams{RestoreToTimestamp: start.Add(5 * time.Hour)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			params.Logger = logutil.NewMemoryLogger()
+			params.Keyspace = "ks"
+			params.Shard = "0"
+
+			full, err := FindBackupToRestore(ctx, params, bhs)
+			require.NoError(t, err)
+			bm, err := GetBackupManifest(ctx, full)
+			require.NoError(t, err)
+
+			chain, err := findBinlogBackupsToRestore(ctx, params, bhs, bm.Position)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFull, full.Name())
+			assert.Equal(t, tt.wantChain, backupNames(chain))
+		})
+	}
+}
@@ -28,8 +28,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -81,6 +79,16 @@ type builtinBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the engine used to compress the backup files.
+	// It is empty for backups taken before the field existed, which were
+	// compressed with pgzip unless SkipCompress is set.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command used to decompress the backup
+	// files, if CompressionEngine is external. It is only informative:
+	// restores run the locally configured decompressor.
+	ExternalDecompressor string
 }
 
 // FileEntry is one file to backup
@@ -89,6 +97,7 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlogDir for files that go into the directory of Mycnf.BinLogPath
 	Base string
 
 	// Name is the file name, relative to Base
@@ -109,6 +118,8 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlogDir:
+		root = path.Dir(cnf.BinLogPath)
 	default:
 		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
 	}
@@ -137,7 +148,13 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, CompressionEngine: %v", *backupStorageHook, *backupStorageCompress, *backupCompressionEngine)
+
+	if *backupStorageCompress {
+		if err := validateCompressionEngine(*backupCompressionEngine); err != nil {
+			return false, err
+		}
+	}
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -275,7 +292,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) error {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -285,6 +302,16 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	return be.backupFileEntries(ctx, params, bh, fes, BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     replicationPosition,
+		BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+	})
+}
+
+// backupFileEntries backs up the given files with the provided concurrency,
+// then writes a MANIFEST made of base and the builtin-specific fields.
+func (be *BuiltinBackupEngine) backupFileEntries(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fes []FileEntry, base BackupManifest) (finalErr error) {
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	wg := sync.WaitGroup{}
@@ -333,20 +360,22 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}()
 
 	// JSON-encode and write the MANIFEST
+	base.FinishedTime = time.Now().UTC().Format(time.RFC3339)
 	bm := &builtinBackupManifest{
 		// Common base fields
-		BackupManifest: BackupManifest{
-			BackupMethod: builtinBackupEngineName,
-			Position:     replicationPosition,
-			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
-			FinishedTime: time.Now().UTC().Format(time.RFC3339),
-		},
+		BackupManifest: base,
 
 		// Builtin-specific fields
 		FileEntries:   fes,
 		TransformHook: *backupStorageHook,
 		SkipCompress:  !*backupStorageCompress,
 	}
+	if *backupStorageCompress {
+		bm.CompressionEngine = *backupCompressionEngine
+		if bm.CompressionEngine == ExternalCompressor {
+			bm.ExternalDecompressor = *backupExternalDecompressorCmd
+		}
+	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
 		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
@@ -407,28 +436,27 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pgzip.Writer
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
 	if *backupStorageCompress {
-		gzip, err = pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
+		compressor, err = newCompressor(ctx, *backupCompressionEngine, writer, params.Logger)
 		if err != nil {
-			return vterrors.Wrap(err, "cannot create gziper")
+			return vterrors.Wrap(err, "cannot create compressor")
 		}
-		gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
-		writer = gzip
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
+	// Copy from the source file to writer (optional compressor,
 	// optional pipe, tee, output file and hasher).
 	_, err = io.Copy(writer, source)
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -476,7 +504,7 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 		return nil, err
 	}
 
-	params.Logger.Infof("Restore: copying %v files", len(bm.FileEntries))
+	params.Logger.Infof("Restore: copying %v files, compression engine: %v", len(bm.FileEntries), compressionEngineName(bm.CompressionEngine, bm.SkipCompress))
 
 	if err := be.restoreFiles(context.Background(), params, bh, bm); err != nil {
 		// don't delete the file here because that is how we detect an interrupted restore
@@ -510,7 +538,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, bh, &fes[i], bm, name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -521,7 +549,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, bm builtinBackupManifest, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -552,11 +580,12 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
-	// and into the gunziper.
+	// and into the decompressor.
 	reader := io.TeeReader(source, hasher)
 
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
+	transformHook := bm.TransformHook
 	if transformHook != "" {
 		h := hook.NewHook(transformHook, []string{"-operation", "read"})
 		h.ExtraEnv = params.HookExtraEnv
@@ -567,22 +596,22 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if !bm.SkipCompress {
+		decompressor, err := newDecompressor(ctx, bm.CompressionEngine, bm.ExternalDecompressor, reader, params.Logger)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return err
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -6,6 +6,7 @@ package mysqlctl_test
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"path"
 	"testing"
@@ -15,8 +16,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
 	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
 	"vitess.io/vitess/go/vt/proto/topodata"
@@ -134,3 +137,95 @@ func TestExecuteBackup(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, ok)
 }
+
+func TestBackupBinlogs(t *testing.T) {
+	// Set up local backup directory and binlog directory
+	backupRoot := "testdata/binlogbackup_test"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(backupRoot, "backups")
+	oldImplementation := *backupstorage.BackupStorageImplementation
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func() { *backupstorage.BackupStorageImplementation = oldImplementation }()
+	require.NoError(t, createBackupDir(backupRoot, "binlogs"))
+	defer os.RemoveAll(backupRoot)
+	for _, name := range []string{"vt-bin.000001", "vt-bin.000002", "vt-bin.000003"} {
+		require.NoError(t, ioutil.WriteFile(path.Join(backupRoot, "binlogs", name), []byte(name), 0644))
+	}
+
+	ctx := context.Background()
+	keyspace, shard := "mykeyspace", "-80"
+	params := mysqlctl.BackupParams{
+		Logger:       logutil.NewConsoleLogger(),
+		Cnf:          &mysqlctl.Mycnf{BinLogPath: path.Join(backupRoot, "binlogs", "vt-bin")},
+		Concurrency:  2,
+		HookExtraEnv: map[string]string{},
+		Keyspace:     keyspace,
+		Shard:        shard,
+		TabletAlias:  "cell1-0000000100",
+	}
+
+	// Without a full backup, there is nothing to start from.
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	params.Mysqld = mysqld
+	params.BackupTime = time.Now().Add(-time.Hour)
+	assert.Error(t, mysqlctl.BackupBinlogs(ctx, params))
+
+	// Add a full backup, in the middle of vt-bin.000002.
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+	bh, err := bs.StartBackup(ctx, mysqlctl.GetBackupDir(keyspace, shard), params.BackupTime.UTC().Format(mysqlctl.BackupTimestampFormat)+".cell1-0000000100")
+	require.NoError(t, err)
+	wc, err := bh.AddFile(ctx, "MANIFEST", backupstorage.FileSizeUnknown)
+	require.NoError(t, err)
+	_, err = wc.Write([]byte(`{"BackupMethod": "builtin", "Position": "MySQL56/00000000-0000-0000-0000-000000000001:1-15"}`))
+	require.NoError(t, err)
+	require.NoError(t, wc.Close())
+	require.NoError(t, bh.EndBackup(ctx))
+
+	eventFields := sqltypes.MakeTestFields("Log_name|Pos|Event_type|Server_id|End_log_pos|Info", "varchar|int64|varchar|int64|int64|varchar")
+	mysqld.ExpectedExecuteSuperQueryList = []string{"FLUSH BINARY LOGS"}
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"vt-bin.000001|100",
+			"vt-bin.000002|100",
+			"vt-bin.000003|100",
+			"vt-bin.000004|100",
+		),
+		"SHOW BINLOG EVENTS IN 'vt-bin.000001' LIMIT 2": sqltypes.MakeTestResult(eventFields,
+			"vt-bin.000001|4|Format_desc|1|125|Server ver: 8.0.23, Binlog ver: 4",
+			"vt-bin.000001|125|Previous_gtids|1|156|",
+		),
+		"SHOW BINLOG EVENTS IN 'vt-bin.000002' LIMIT 2": sqltypes.MakeTestResult(eventFields,
+			"vt-bin.000002|4|Format_desc|1|125|Server ver: 8.0.23, Binlog ver: 4",
+			"vt-bin.000002|125|Previous_gtids|1|156|00000000-0000-0000-0000-000000000001:1-10",
+		),
+		"SHOW BINLOG EVENTS IN 'vt-bin.000003' LIMIT 2": sqltypes.MakeTestResult(eventFields,
+			"vt-bin.000003|4|Format_desc|1|125|Server ver: 8.0.23, Binlog ver: 4",
+			"vt-bin.000003|125|Previous_gtids|1|156|00000000-0000-0000-0000-000000000001:1-20",
+		),
+		"SHOW BINLOG EVENTS IN 'vt-bin.000004' LIMIT 2": sqltypes.MakeTestResult(eventFields,
+			"vt-bin.000004|4|Format_desc|1|125|Server ver: 8.0.23, Binlog ver: 4",
+			"vt-bin.000004|125|Previous_gtids|1|156|00000000-0000-0000-0000-000000000001:1-30",
+		),
+	}
+	params.BackupTime = time.Now()
+	require.NoError(t, mysqlctl.BackupBinlogs(ctx, params))
+
+	bhs, err := bs.ListBackups(ctx, mysqlctl.GetBackupDir(keyspace, shard))
+	require.NoError(t, err)
+	require.Len(t, bhs, 2)
+	bm, err := mysqlctl.GetBackupManifest(ctx, bhs[1])
+	require.NoError(t, err)
+	assert.True(t, bm.Incremental)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001:1-10", bm.FromPosition.String())
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001:1-30", bm.Position.String())
+
+	// The binlog backup is never restored on its own.
+	restoreBh, err := mysqlctl.FindBackupToRestore(ctx, mysqlctl.RestoreParams{
+		Logger:   logutil.NewConsoleLogger(),
+		Keyspace: keyspace,
+		Shard:    shard,
+	}, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, bhs[0].Name(), restoreBh.Name())
+}
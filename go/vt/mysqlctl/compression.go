@@ -0,0 +1,356 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/pierrec/lz4/v4"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	// PgzipCompressor is the name of the parallel gzip compression engine.
+	// It is the default, and is also used to restore backups which predate
+	// the CompressionEngine field of the MANIFEST.
+	PgzipCompressor = "pgzip"
+	// ZstdCompressor is the name of the zstd compression engine.
+	ZstdCompressor = "zstd"
+	// Lz4Compressor is the name of the lz4 compression engine.
+	Lz4Compressor = "lz4"
+	// ExternalCompressor is the name of the compression engine which pipes
+	// the backup files through an external command.
+	ExternalCompressor = "external"
+)
+
+var (
+	// backupCompressionEngine is the engine used to compress new backups,
+	// when backupStorageCompress is set. It is recorded in the MANIFEST, so
+	// restores always use the matching decompressor.
+	backupCompressionEngine = flag.String("backup_storage_compression_engine", PgzipCompressor, "if backup_storage_compress is true, the engine used to compress the backup files: pgzip, zstd, lz4 or external.")
+
+	// backupCompressionLevel is passed to the builtin compression engines.
+	backupCompressionLevel = flag.Int("backup_storage_compression_level", 1, "if backup_storage_compress is true, the compression level passed to the pgzip, zstd or lz4 engine (default is 1). For lz4, 0 selects the fast compressor and higher values select the high compression levels.")
+
+	// backupExternalCompressorCmd and backupExternalDecompressorCmd are
+	// the commands used by the external compression engine. Both read
+	// their input on stdin and write their output on stdout.
+	backupExternalCompressorCmd   = flag.String("backup_storage_external_compressor", "", "command with space separated arguments used to compress the backup files when backup_storage_compression_engine is external, e.g. 'zstd -T4 -c'.")
+	backupExternalDecompressorCmd = flag.String("backup_storage_external_decompressor", "", "command with space separated arguments used to decompress backups taken with the external compression engine. It is recorded in the MANIFEST at backup time for reference, but restores only ever run the command set here.")
+
+	// backupExternalCompressorExt is the file extension used by the
+	// xtrabackup engine for externally compressed backups.
+	backupExternalCompressorExt = flag.String("backup_storage_external_compressor_extension", "", "file extension used for backups compressed with the external compression engine, e.g. '.zst'.")
+)
+
+// compressionExtensions maps the builtin compression engines to the file
+// extension used for their output.
+var compressionExtensions = map[string]string{
+	PgzipCompressor: ".gz",
+	ZstdCompressor:  ".zst",
+	Lz4Compressor:   ".lz4",
+}
+
+// validateCompressionEngine checks that engine can be used to take a backup
+// with the current flags.
+func validateCompressionEngine(engine string) error {
+	switch engine {
+	case PgzipCompressor, ZstdCompressor, Lz4Compressor:
+		return nil
+	case ExternalCompressor:
+		if strings.TrimSpace(*backupExternalCompressorCmd) == "" {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "backup_storage_external_compressor must be set to use the %v compression engine", ExternalCompressor)
+		}
+		return nil
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported compression engine %q", engine)
+	}
+}
+
+// compressionExtension returns the file extension for backups compressed
+// with the given engine.
+func compressionExtension(engine string) string {
+	if engine == ExternalCompressor {
+		return *backupExternalCompressorExt
+	}
+	return compressionExtensions[engine]
+}
+
+// newCompressor returns a WriteCloser which compresses everything written to
+// it with the given engine and sends the result to writer. Close must be
+// called to flush the compressor; it does not close writer.
+func newCompressor(ctx context.Context, engine string, writer io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	switch engine {
+	case PgzipCompressor:
+		gz, err := pgzip.NewWriterLevel(writer, *backupCompressionLevel)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "cannot create pgzip compressor")
+		}
+		if err := gz.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks); err != nil {
+			return nil, vterrors.Wrap(err, "cannot set pgzip concurrency")
+		}
+		return gz, nil
+	case ZstdCompressor:
+		zw, err := zstd.NewWriter(writer,
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(*backupCompressionLevel)),
+			zstd.WithEncoderConcurrency(*backupCompressBlocks))
+		if err != nil {
+			return nil, vterrors.Wrap(err, "cannot create zstd compressor")
+		}
+		return zw, nil
+	case Lz4Compressor:
+		lw := lz4.NewWriter(writer)
+		level := lz4.Fast
+		if *backupCompressionLevel > 0 {
+			level = lz4.CompressionLevel(1 << (8 + *backupCompressionLevel))
+		}
+		if err := lw.Apply(lz4.CompressionLevelOption(level), lz4.ConcurrencyOption(*backupCompressBlocks)); err != nil {
+			return nil, vterrors.Wrap(err, "cannot create lz4 compressor")
+		}
+		return lw, nil
+	case ExternalCompressor:
+		return newExternalCompressor(ctx, *backupExternalCompressorCmd, writer, logger)
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported compression engine %q", engine)
+	}
+}
+
+// newDecompressor returns a ReadCloser which decompresses the data read from
+// reader with the given engine. An empty engine means the backup predates
+// the CompressionEngine MANIFEST field, and was compressed with pgzip.
+//
+// The external engine only runs the locally configured
+// backup_storage_external_decompressor: the MANIFEST comes from the backup
+// storage, so the command recorded in it, manifestCmd, is only used to tell
+// the operator what to configure.
+func newDecompressor(ctx context.Context, engine, manifestCmd string, reader io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	switch engine {
+	case "", PgzipCompressor:
+		gz, err := pgzip.NewReader(reader)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't open gzip decompressor")
+		}
+		return gz, nil
+	case ZstdCompressor:
+		zr, err := zstd.NewReader(reader)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't open zstd decompressor")
+		}
+		return zr.IOReadCloser(), nil
+	case Lz4Compressor:
+		return ioutil.NopCloser(lz4.NewReader(reader)), nil
+	case ExternalCompressor:
+		externalCmd := strings.TrimSpace(*backupExternalDecompressorCmd)
+		if externalCmd == "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "backup was compressed with an external command (the MANIFEST records %q as its decompressor); set backup_storage_external_decompressor to restore it", manifestCmd)
+		}
+		if manifestCmd != "" && strings.Join(strings.Fields(manifestCmd), " ") != strings.Join(strings.Fields(externalCmd), " ") {
+			logger.Warningf("Backup MANIFEST records %q as its decompressor, using the configured %q instead", manifestCmd, externalCmd)
+		}
+		return newExternalDecompressor(ctx, externalCmd, reader, logger)
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported compression engine %q", engine)
+	}
+}
+
+// externalCommand builds the exec.Cmd for a space separated command line.
+func externalCommand(ctx context.Context, cmdStr string) (*exec.Cmd, error) {
+	args := strings.Fields(cmdStr)
+	if len(args) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "empty external compression command")
+	}
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot find external compression command %v", args[0])
+	}
+	return exec.CommandContext(ctx, path, args[1:]...), nil
+}
+
+// externalCompressor pipes the data written to it through an external
+// command.
+type externalCompressor struct {
+	cmd    *exec.Cmd
+	cancel context.CancelFunc
+	stdin  io.WriteCloser
+	stderr *strings.Builder
+	logger logutil.Logger
+
+	// copyDone receives the result of copying the command's output to the
+	// destination writer.
+	copyDone chan error
+}
+
+func newExternalCompressor(ctx context.Context, cmdStr string, writer io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cmd, err := externalCommand(ctx, cmdStr)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		cancel()
+		return nil, vterrors.Wrap(err, "cannot create stdin pipe for external compressor")
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, vterrors.Wrap(err, "cannot create stdout pipe for external compressor")
+	}
+	stderr := &strings.Builder{}
+	cmd.Stderr = stderr
+
+	logger.Infof("Starting external compressor: %v", cmdStr)
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, vterrors.Wrap(err, "cannot start external compressor")
+	}
+
+	ec := &externalCompressor{cmd: cmd, cancel: cancel, stdin: stdin, stderr: stderr, logger: logger, copyDone: make(chan error, 1)}
+	go func() {
+		_, err := io.Copy(writer, stdout)
+		if err != nil {
+			// Nobody reads the output anymore, so the command would block
+			// once the pipe is full: kill it.
+			cancel()
+		}
+		ec.copyDone <- err
+	}()
+	return ec, nil
+}
+
+// Write is part of the io.Writer interface.
+func (ec *externalCompressor) Write(p []byte) (int, error) {
+	return ec.stdin.Write(p)
+}
+
+// Close closes the command's stdin, waits for all of its output to be
+// written to the destination, and then for it to exit.
+func (ec *externalCompressor) Close() error {
+	defer ec.cancel()
+
+	closeErr := ec.stdin.Close()
+	// The command's stdout must be drained before Wait, which closes it.
+	copyErr := <-ec.copyDone
+	waitErr := ec.cmd.Wait()
+	switch {
+	case copyErr != nil:
+		return vterrors.Wrap(copyErr, "cannot write the output of the external compressor")
+	case waitErr != nil:
+		return vterrors.Wrapf(waitErr, "external compressor failed: %v", ec.stderr.String())
+	case closeErr != nil:
+		return vterrors.Wrap(closeErr, "cannot close external compressor stdin")
+	}
+	if ec.stderr.Len() > 0 {
+		ec.logger.Infof("external compressor stderr: %v", ec.stderr.String())
+	}
+	return nil
+}
+
+// externalDecompressor reads the output of an external command which is fed
+// the compressed data on stdin.
+type externalDecompressor struct {
+	cmd       *exec.Cmd
+	cancel    context.CancelFunc
+	stdout    io.ReadCloser
+	stderr    *strings.Builder
+	logger    logutil.Logger
+	eof       bool
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func newExternalDecompressor(ctx context.Context, cmdStr string, reader io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cmd, err := externalCommand(ctx, cmdStr)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, vterrors.Wrap(err, "cannot create stdout pipe for external decompressor")
+	}
+	stderr := &strings.Builder{}
+	cmd.Stdin = reader
+	cmd.Stderr = stderr
+
+	logger.Infof("Starting external decompressor: %v", cmdStr)
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, vterrors.Wrap(err, "cannot start external decompressor")
+	}
+	return &externalDecompressor{cmd: cmd, cancel: cancel, stdout: stdout, stderr: stderr, logger: logger}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (ed *externalDecompressor) Read(p []byte) (int, error) {
+	n, err := ed.stdout.Read(p)
+	if err == io.EOF {
+		ed.eof = true
+	}
+	return n, err
+}
+
+// Close waits for the command to exit. If the output was not read to the
+// end, the command is killed instead, since it would otherwise block
+// writing to a pipe nobody reads, and Wait would never return.
+func (ed *externalDecompressor) Close() error {
+	ed.closeOnce.Do(func() {
+		defer ed.cancel()
+		if !ed.eof {
+			ed.cancel()
+			ed.cmd.Wait()
+			ed.closeErr = vterrors.Errorf(vtrpcpb.Code_ABORTED, "external decompressor closed before the end of its output")
+			return
+		}
+		if err := ed.cmd.Wait(); err != nil {
+			ed.closeErr = vterrors.Wrapf(err, "external decompressor failed: %v", ed.stderr.String())
+			return
+		}
+		if ed.stderr.Len() > 0 {
+			ed.logger.Infof("external decompressor stderr: %v", ed.stderr.String())
+		}
+	})
+	return ed.closeErr
+}
+
+// compressionEngineName returns a printable name for the engine recorded in
+// a MANIFEST.
+func compressionEngineName(engine string, skipCompress bool) string {
+	switch {
+	case skipCompress:
+		return "none"
+	case engine == "":
+		return PgzipCompressor
+	default:
+		return engine
+	}
+}
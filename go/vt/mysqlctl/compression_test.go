@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/pgzip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+)
+
+func compressionTestData() []byte {
+	return bytes.Repeat([]byte("vitess backup compression test data\n"), 10000)
+}
+
+func TestBuiltinCompressors(t *testing.T) {
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	data := compressionTestData()
+
+	for _, engine := range []string{PgzipCompressor, ZstdCompressor, Lz4Compressor} {
+		t.Run(engine, func(t *testing.T) {
+			for _, level := range []int{1, 5} {
+				*backupCompressionLevel = level
+
+				var compressed bytes.Buffer
+				compressor, err := newCompressor(ctx, engine, &compressed, logger)
+				require.NoError(t, err)
+				_, err = compressor.Write(data)
+				require.NoError(t, err)
+				require.NoError(t, compressor.Close())
+				assert.Less(t, compressed.Len(), len(data))
+
+				decompressor, err := newDecompressor(ctx, engine, "", &compressed, logger)
+				require.NoError(t, err)
+				got, err := ioutil.ReadAll(decompressor)
+				require.NoError(t, err)
+				require.NoError(t, decompressor.Close())
+				assert.Equal(t, data, got)
+			}
+		})
+	}
+	*backupCompressionLevel = 1
+}
+
+func TestLegacyGzipDecompressor(t *testing.T) {
+	// Backups taken before the CompressionEngine MANIFEST field existed were
+	// always compressed with pgzip, and must keep restoring.
+	data := compressionTestData()
+
+	var compressed bytes.Buffer
+	gz, err := pgzip.NewWriterLevel(&compressed, pgzip.BestSpeed)
+	require.NoError(t, err)
+	_, err = gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	decompressor, err := newDecompressor(context.Background(), "", "", &compressed, logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	got, err := ioutil.ReadAll(decompressor)
+	require.NoError(t, err)
+	require.NoError(t, decompressor.Close())
+	assert.Equal(t, data, got)
+}
+
+func TestExternalCompressor(t *testing.T) {
+	if _, err := exec.LookPath("gzip"); err != nil {
+		t.Skip("gzip not found in PATH")
+	}
+
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	data := compressionTestData()
+
+	*backupExternalCompressorCmd = "gzip -c -1"
+	defer func() { *backupExternalCompressorCmd = "" }()
+	require.NoError(t, validateCompressionEngine(ExternalCompressor))
+
+	var compressed bytes.Buffer
+	compressor, err := newCompressor(ctx, ExternalCompressor, &compressed, logger)
+	require.NoError(t, err)
+	_, err = compressor.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, compressor.Close())
+	assert.Less(t, compressed.Len(), len(data))
+
+	// Without a configured decompressor, the restore must fail, even if
+	// the MANIFEST records one.
+	_, err = newDecompressor(ctx, ExternalCompressor, "gzip -d -c", bytes.NewReader(compressed.Bytes()), logger)
+	assert.Error(t, err)
+
+	// The command recorded in the MANIFEST is never run, only the configured
+	// one is. cat would return the compressed data unchanged.
+	*backupExternalDecompressorCmd = "gzip -d -c"
+	defer func() { *backupExternalDecompressorCmd = "" }()
+	decompressor, err := newDecompressor(ctx, ExternalCompressor, "cat", bytes.NewReader(compressed.Bytes()), logger)
+	require.NoError(t, err)
+	got, err := ioutil.ReadAll(decompressor)
+	require.NoError(t, err)
+	require.NoError(t, decompressor.Close())
+	assert.Equal(t, data, got)
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestExternalCompressorEarlyClose(t *testing.T) {
+	if _, err := exec.LookPath("gzip"); err != nil {
+		t.Skip("gzip not found in PATH")
+	}
+
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	data := compressionTestData()
+
+	var compressed bytes.Buffer
+	compressor, err := newExternalCompressor(ctx, "gzip -c -1", &compressed, logger)
+	require.NoError(t, err)
+	_, err = compressor.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, compressor.Close())
+
+	// Closing the decompressor before reading all of its output must kill
+	// the command rather than wait for it forever: the decompressed data is
+	// bigger than a pipe buffer, so gzip blocks writing it.
+	decompressor, err := newExternalDecompressor(ctx, "gzip -d -c", bytes.NewReader(compressed.Bytes()), logger)
+	require.NoError(t, err)
+	buf := make([]byte, 10)
+	_, err = decompressor.Read(buf)
+	require.NoError(t, err)
+	assert.Error(t, decompressor.Close())
+
+	// When the destination fails, the compressor must report it rather than
+	// hang on a command that can't write its output.
+	compressor, err = newExternalCompressor(ctx, "gzip -c -1", failingWriter{}, logger)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		if _, err := compressor.Write(data); err != nil {
+			break
+		}
+	}
+	assert.Error(t, compressor.Close())
+}
+
+func TestValidateCompressionEngine(t *testing.T) {
+	for _, engine := range []string{PgzipCompressor, ZstdCompressor, Lz4Compressor} {
+		assert.NoError(t, validateCompressionEngine(engine), engine)
+	}
+
+	err := validateCompressionEngine(ExternalCompressor)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "backup_storage_external_compressor"), err.Error())
+
+	assert.Error(t, validateCompressionEngine("brotli"))
+}
+
+func TestXtrabackupFileNameExtension(t *testing.T) {
+	defer func(compress bool, engine string) {
+		*backupStorageCompress = compress
+		*backupCompressionEngine = engine
+	}(*backupStorageCompress, *backupCompressionEngine)
+
+	be := &XtrabackupEngine{}
+	tests := []struct {
+		compress bool
+		engine   string
+		want     string
+	}{
+		{compress: true, engine: PgzipCompressor, want: "backup.tar.gz"},
+		{compress: true, engine: ZstdCompressor, want: "backup.tar.zst"},
+		{compress: true, engine: Lz4Compressor, want: "backup.tar.lz4"},
+		{compress: false, engine: ZstdCompressor, want: "backup.tar"},
+	}
+	for _, tt := range tests {
+		*backupStorageCompress = tt.compress
+		*backupCompressionEngine = tt.engine
+		assert.Equal(t, tt.want, be.backupFileName())
+	}
+}
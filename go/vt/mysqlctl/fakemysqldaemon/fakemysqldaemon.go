@@ -160,6 +160,9 @@ type FakeMysqlDaemon struct {
 	// FetchSuperQueryResults is used by FetchSuperQuery
 	FetchSuperQueryMap map[string]*sqltypes.Result
 
+	// AppliedBinlogFiles records the files passed to ApplyBinlogFile.
+	AppliedBinlogFiles []string
+
 	// BinlogPlayerEnabled is used by {Enable,Disable}BinlogPlayer
 	BinlogPlayerEnabled sync2.AtomicBool
 
@@ -487,6 +490,12 @@ func (fmd *FakeMysqlDaemon) DisableBinlogPlayback() error {
 	return nil
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos mysql.Position, stopTime time.Time) error {
+	fmd.AppliedBinlogFiles = append(fmd.AppliedBinlogFiles, binlogFile)
+	return nil
+}
+
 // Close is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) Close() {
 	if fmd.appPool != nil {
@@ -18,6 +18,7 @@ package mysqlctl
 
 import (
 	"context"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -94,6 +95,10 @@ type MysqlDaemon interface {
 	// DisableBinlogPlayback disable playback of binlog events
 	DisableBinlogPlayback() error
 
+	// ApplyBinlogFile replays the events of a binary log file that were not
+	// already applied, stopping at stopTime if it is set.
+	ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos mysql.Position, stopTime time.Time) error
+
 	// Close will close this instance of Mysqld. It will wait for all dba
 	// queries to be finished.
 	Close()
@@ -1047,6 +1047,83 @@ func (mysqld *Mysqld) executeMysqlScript(connParams *mysql.ConnParams, sql io.Re
 	return nil
 }
 
+// ApplyBinlogFile pipes the output of mysqlbinlog for a binary log file into
+// the mysql command line tool, using the dba credentials. If restoreToPos is
+// set, only the transactions it contains are applied. If restoreToTimestamp
+// is set, events from that time on are not applied.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, binlogFile string, restoreToPos mysql.Position, restoreToTimestamp time.Time) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	env, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	mysqlbinlogName, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	var args []string
+	if !restoreToPos.IsZero() {
+		args = append(args, "--include-gtids="+restoreToPos.GTIDSet.String())
+	}
+	if !restoreToTimestamp.IsZero() {
+		// mysqlbinlog reads the time in the local time zone.
+		args = append(args, "--stop-datetime="+restoreToTimestamp.Local().Format("2006-01-02 15:04:05"))
+	}
+	args = append(args, binlogFile)
+	mysqlbinlogCmd := exec.CommandContext(ctx, mysqlbinlogName, args...)
+	mysqlbinlogCmd.Env = env
+	mysqlbinlogCmd.Dir = dir
+
+	mysqlName, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err != nil {
+		return err
+	}
+	cnf, err := mysqld.defaultsExtraFile(params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cnf)
+	mysqlCmd := exec.CommandContext(ctx, mysqlName, "--defaults-extra-file="+cnf)
+	mysqlCmd.Env = env
+	mysqlCmd.Dir = dir
+
+	var mysqlbinlogOut, mysqlOut bytes.Buffer
+	mysqlbinlogCmd.Stderr = &mysqlbinlogOut
+	mysqlCmd.Stdout = &mysqlOut
+	mysqlCmd.Stderr = &mysqlOut
+	if mysqlCmd.Stdin, err = mysqlbinlogCmd.StdoutPipe(); err != nil {
+		return err
+	}
+
+	log.Infof("ApplyBinlogFile: %v %v | %v", mysqlbinlogName, args, mysqlName)
+	if err := mysqlbinlogCmd.Start(); err != nil {
+		return err
+	}
+	if err := mysqlCmd.Start(); err != nil {
+		mysqlbinlogCmd.Process.Kill()
+		mysqlbinlogCmd.Wait()
+		return err
+	}
+	// mysql has to be done reading before we wait for mysqlbinlog.
+	mysqlErr := mysqlCmd.Wait()
+	mysqlbinlogErr := mysqlbinlogCmd.Wait()
+	if mysqlErr != nil {
+		return fmt.Errorf("%v: %v, output: %v", mysqlName, mysqlErr, mysqlOut.String())
+	}
+	if mysqlbinlogErr != nil {
+		return fmt.Errorf("%v: %v, output: %v", mysqlbinlogName, mysqlbinlogErr, mysqlbinlogOut.String())
+	}
+	return nil
+}
+
 // defaultsExtraFile returns the filename for a temporary config file
 // that contains the user, password and socket file to connect to
 // mysqld.  We write a temporary config file so the password is never
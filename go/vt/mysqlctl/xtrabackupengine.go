@@ -31,8 +31,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -76,7 +74,7 @@ const (
 
 // xtraBackupManifest represents a backup.
 // It stores the name of the backup file, the replication position,
-// whether the backup is compressed using gzip, and any extra
+// the compression engine used for the backup, if any, and any extra
 // command line parameters used while invoking it.
 type xtraBackupManifest struct {
 	// BackupManifest is an anonymous embedding of the base manifest struct.
@@ -98,6 +96,16 @@ type xtraBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the engine used to compress the backup file.
+	// It is empty for backups taken before the field existed, which were
+	// compressed with pgzip unless SkipCompress is set.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command used to decompress the backup
+	// file, if CompressionEngine is external. It is only informative:
+	// restores run the locally configured decompressor.
+	ExternalDecompressor string
 }
 
 func (be *XtrabackupEngine) backupFileName() string {
@@ -107,7 +115,7 @@ func (be *XtrabackupEngine) backupFileName() string {
 		fileName += *xtrabackupStreamMode
 	}
 	if *backupStorageCompress {
-		fileName += ".gz"
+		fileName += compressionExtension(*backupCompressionEngine)
 	}
 	return fileName
 }
@@ -142,6 +150,12 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	if err != nil {
 		return false, vterrors.Wrap(err, "unable to obtain master position")
 	}
+	if *backupStorageCompress {
+		if err := validateCompressionEngine(*backupCompressionEngine); err != nil {
+			return false, err
+		}
+	}
+
 	flavor := pos.GTIDSet.Flavor()
 	params.Logger.Infof("Detected MySQL flavor: %v", flavor)
 
@@ -185,6 +199,12 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 		NumStripes:      int32(numStripes),
 		StripeBlockSize: int32(*xtrabackupStripeBlockSize),
 	}
+	if *backupStorageCompress {
+		bm.CompressionEngine = *backupCompressionEngine
+		if bm.CompressionEngine == ExternalCompressor {
+			bm.ExternalDecompressor = *backupExternalDecompressorCmd
+		}
+	}
 
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -270,19 +290,18 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 
 	destWriters := []io.Writer{}
 	destBuffers := []*bufio.Writer{}
-	destCompressors := []*pgzip.Writer{}
+	destCompressors := []io.WriteCloser{}
 	for _, file := range destFiles {
 		buffer := bufio.NewWriterSize(file, writerBufferSize)
 		destBuffers = append(destBuffers, buffer)
 		writer := io.Writer(buffer)
 
-		// Create the gzip compression pipe, if necessary.
+		// Create the compression pipe, if necessary.
 		if *backupStorageCompress {
-			compressor, err := pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
+			compressor, err := newCompressor(ctx, *backupCompressionEngine, writer, params.Logger)
 			if err != nil {
-				return replicationPosition, vterrors.Wrap(err, "cannot create gzip compressor")
+				return replicationPosition, vterrors.Wrap(err, "cannot create compressor")
 			}
-			compressor.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
 			writer = compressor
 			destCompressors = append(destCompressors, compressor)
 		}
@@ -327,7 +346,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 		}
 	}()
 
-	// Copy from the stream output to destination file (optional gzip)
+	// Copy from the stream output to destination file (optional compressor)
 	blockSize := int64(*xtrabackupStripeBlockSize)
 	if blockSize < 1024 {
 		// Enforce minimum block size.
@@ -344,7 +363,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Close compressor to flush it. After that all data is sent to the buffer.
 	for _, compressor := range destCompressors {
 		if err := compressor.Close(); err != nil {
-			return replicationPosition, vterrors.Wrap(err, "cannot close gzip compressor")
+			return replicationPosition, vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -523,15 +542,15 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	}()
 
 	srcReaders := []io.Reader{}
-	srcDecompressors := []*pgzip.Reader{}
+	srcDecompressors := []io.ReadCloser{}
 	for _, file := range srcFiles {
 		reader := io.Reader(file)
 
 		// Create the decompressor if needed.
 		if compressed {
-			decompressor, err := pgzip.NewReader(reader)
+			decompressor, err := newDecompressor(ctx, bm.CompressionEngine, bm.ExternalDecompressor, reader, logger)
 			if err != nil {
-				return vterrors.Wrap(err, "can't create gzip decompressor")
+				return err
 			}
 			srcDecompressors = append(srcDecompressors, decompressor)
 			reader = decompressor
@@ -542,7 +561,7 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	defer func() {
 		for _, decompressor := range srcDecompressors {
 			if cerr := decompressor.Close(); cerr != nil {
-				logger.Errorf("failed to close gzip decompressor: %v", cerr)
+				logger.Errorf("failed to close decompressor: %v", cerr)
 			}
 		}
 	}()
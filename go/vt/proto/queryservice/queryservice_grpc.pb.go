@@ -61,6 +61,8 @@ type QueryClient interface {
 	MessageStream(ctx context.Context, in *query.MessageStreamRequest, opts ...grpc.CallOption) (Query_MessageStreamClient, error)
 	// MessageAck acks messages for a table.
 	MessageAck(ctx context.Context, in *query.MessageAckRequest, opts ...grpc.CallOption) (*query.MessageAckResponse, error)
+	// MessageNack makes messages of a table due for immediate redelivery.
+	MessageNack(ctx context.Context, in *query.MessageNackRequest, opts ...grpc.CallOption) (*query.MessageNackResponse, error)
 	ReserveExecute(ctx context.Context, in *query.ReserveExecuteRequest, opts ...grpc.CallOption) (*query.ReserveExecuteResponse, error)
 	ReserveBeginExecute(ctx context.Context, in *query.ReserveBeginExecuteRequest, opts ...grpc.CallOption) (*query.ReserveBeginExecuteResponse, error)
 	Release(ctx context.Context, in *query.ReleaseRequest, opts ...grpc.CallOption) (*query.ReleaseResponse, error)
@@ -291,6 +293,15 @@ func (c *queryClient) MessageAck(ctx context.Context, in *query.MessageAckReques
 	return out, nil
 }
 
+func (c *queryClient) MessageNack(ctx context.Context, in *query.MessageNackRequest, opts ...grpc.CallOption) (*query.MessageNackResponse, error) {
+	out := new(query.MessageNackResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/MessageNack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ReserveExecute(ctx context.Context, in *query.ReserveExecuteRequest, opts ...grpc.CallOption) (*query.ReserveExecuteResponse, error) {
 	out := new(query.ReserveExecuteResponse)
 	err := c.cc.Invoke(ctx, "/queryservice.Query/ReserveExecute", in, out, opts...)
@@ -491,6 +502,8 @@ type QueryServer interface {
 	MessageStream(*query.MessageStreamRequest, Query_MessageStreamServer) error
 	// MessageAck acks messages for a table.
 	MessageAck(context.Context, *query.MessageAckRequest) (*query.MessageAckResponse, error)
+	// MessageNack makes messages of a table due for immediate redelivery.
+	MessageNack(context.Context, *query.MessageNackRequest) (*query.MessageNackResponse, error)
 	ReserveExecute(context.Context, *query.ReserveExecuteRequest) (*query.ReserveExecuteResponse, error)
 	ReserveBeginExecute(context.Context, *query.ReserveBeginExecuteRequest) (*query.ReserveBeginExecuteResponse, error)
 	Release(context.Context, *query.ReleaseRequest) (*query.ReleaseResponse, error)
@@ -564,6 +577,9 @@ func (UnimplementedQueryServer) MessageStream(*query.MessageStreamRequest, Query
 func (UnimplementedQueryServer) MessageAck(context.Context, *query.MessageAckRequest) (*query.MessageAckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageAck not implemented")
 }
+func (UnimplementedQueryServer) MessageNack(context.Context, *query.MessageNackRequest) (*query.MessageNackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageNack not implemented")
+}
 func (UnimplementedQueryServer) ReserveExecute(context.Context, *query.ReserveExecuteRequest) (*query.ReserveExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveExecute not implemented")
 }
@@ -928,6 +944,24 @@ func _Query_MessageAck_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessageNack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.MessageNackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageNack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/MessageNack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageNack(ctx, req.(*query.MessageNackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ReserveExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(query.ReserveExecuteRequest)
 	if err := dec(in); err != nil {
@@ -1137,6 +1171,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageAck",
 			Handler:    _Query_MessageAck_Handler,
 		},
+		{
+			MethodName: "MessageNack",
+			Handler:    _Query_MessageNack_Handler,
+		},
 		{
 			MethodName: "ReserveExecute",
 			Handler:    _Query_ReserveExecute_Handler,
@@ -1053,6 +1053,268 @@ func (*ResolveTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_vtgate_proto_rawDescGZIP(), []int{9}
 }
 
+// MessageAckRequest is the request payload for MessageAck.
+type MessageAckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// caller_id identifies the caller. This is the effective caller ID,
+	// set by the application to further identify the caller.
+	CallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
+	// keyspace to target the query to.
+	Keyspace string `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// name is the message table name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// ids are the ids of the messages to ack.
+	Ids []*query.Value `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	// consumer_group is the consumer group to ack the messages for.
+	// If empty, the messages are acked for the regular subscribers.
+	ConsumerGroup string `protobuf:"bytes,5,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group,omitempty"`
+}
+
+func (x *MessageAckRequest) Reset() {
+	*x = MessageAckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vtgate_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageAckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAckRequest) ProtoMessage() {}
+
+func (x *MessageAckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vtgate_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageAckRequest.ProtoReflect.Descriptor instead.
+func (*MessageAckRequest) Descriptor() ([]byte, []int) {
+	return file_vtgate_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MessageAckRequest) GetCallerId() *vtrpc.CallerID {
+	if x != nil {
+		return x.CallerId
+	}
+	return nil
+}
+
+func (x *MessageAckRequest) GetKeyspace() string {
+	if x != nil {
+		return x.Keyspace
+	}
+	return ""
+}
+
+func (x *MessageAckRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MessageAckRequest) GetIds() []*query.Value {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MessageAckRequest) GetConsumerGroup() string {
+	if x != nil {
+		return x.ConsumerGroup
+	}
+	return ""
+}
+
+// MessageAckResponse is the returned value from MessageAck.
+type MessageAckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rows_affected is the number of messages acked.
+	RowsAffected uint64 `protobuf:"varint,1,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+}
+
+func (x *MessageAckResponse) Reset() {
+	*x = MessageAckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vtgate_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageAckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAckResponse) ProtoMessage() {}
+
+func (x *MessageAckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vtgate_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageAckResponse.ProtoReflect.Descriptor instead.
+func (*MessageAckResponse) Descriptor() ([]byte, []int) {
+	return file_vtgate_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MessageAckResponse) GetRowsAffected() uint64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
+// MessageNackRequest is the request payload for MessageNack.
+type MessageNackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// caller_id identifies the caller. This is the effective caller ID,
+	// set by the application to further identify the caller.
+	CallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
+	// keyspace to target the query to.
+	Keyspace string `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// name is the message table name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// ids are the ids of the messages to send back for redelivery.
+	Ids []*query.Value `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *MessageNackRequest) Reset() {
+	*x = MessageNackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vtgate_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageNackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageNackRequest) ProtoMessage() {}
+
+func (x *MessageNackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vtgate_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageNackRequest.ProtoReflect.Descriptor instead.
+func (*MessageNackRequest) Descriptor() ([]byte, []int) {
+	return file_vtgate_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MessageNackRequest) GetCallerId() *vtrpc.CallerID {
+	if x != nil {
+		return x.CallerId
+	}
+	return nil
+}
+
+func (x *MessageNackRequest) GetKeyspace() string {
+	if x != nil {
+		return x.Keyspace
+	}
+	return ""
+}
+
+func (x *MessageNackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MessageNackRequest) GetIds() []*query.Value {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// MessageNackResponse is the returned value from MessageNack.
+type MessageNackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rows_affected is the number of messages sent back for redelivery.
+	RowsAffected uint64 `protobuf:"varint,1,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+}
+
+func (x *MessageNackResponse) Reset() {
+	*x = MessageNackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vtgate_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageNackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageNackResponse) ProtoMessage() {}
+
+func (x *MessageNackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vtgate_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageNackResponse.ProtoReflect.Descriptor instead.
+func (*MessageNackResponse) Descriptor() ([]byte, []int) {
+	return file_vtgate_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MessageNackResponse) GetRowsAffected() uint64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
 type VStreamFlags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1062,12 +1324,35 @@ type VStreamFlags struct {
 	MinimizeSkew bool `protobuf:"varint,1,opt,name=minimize_skew,json=minimizeSkew,proto3" json:"minimize_skew,omitempty"`
 	// how often heartbeats must be sent when idle (seconds)
 	HeartbeatInterval uint32 `protobuf:"varint,2,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+	// copy_barrier holds back the shards which complete their copy phase
+	// until all the shards have completed theirs. A VGTID event with the
+	// position of every shard at the end of its copy, followed by a
+	// COPY_COMPLETED event, is then sent before any replicated event.
+	// Each shard is still copied from its own snapshot, taken at its own
+	// time: the copy is not consistent across shards, but streaming the
+	// changes from that VGTID never misses any of them. A shard waits for
+	// the others for at most vstream_copy_barrier_timeout.
+	// Use consistent_snapshot for a snapshot consistent across shards.
+	CopyBarrier bool `protobuf:"varint,3,opt,name=copy_barrier,json=copyBarrier,proto3" json:"copy_barrier,omitempty"`
+	// consistent_snapshot copies all the shards and starts streaming their
+	// changes from a snapshot consistent across shards. It implies
+	// copy_barrier. Once all the shards have completed their copy, the
+	// current position of every shard is read at the same time: it is the
+	// stop position of the shard. Each shard streams its changes up to its
+	// stop position, then waits for the others to reach theirs. A VGTID
+	// event with all the stop positions, followed by a COPY_COMPLETED
+	// event, is then sent: the data copied and streamed until then is the
+	// state of all the shards at that point in time. The positions are read
+	// concurrently, not atomically: a cross-shard transaction committing
+	// while they are read can be on either side of the snapshot on each
+	// shard. Requires GTID positions (MySQL56 or MariaDB flavors).
+	ConsistentSnapshot bool `protobuf:"varint,4,opt,name=consistent_snapshot,json=consistentSnapshot,proto3" json:"consistent_snapshot,omitempty"`
 }
 
 func (x *VStreamFlags) Reset() {
 	*x = VStreamFlags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vtgate_proto_msgTypes[10]
+		mi := &file_vtgate_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1080,7 +1365,7 @@ func (x *VStreamFlags) String() string {
 func (*VStreamFlags) ProtoMessage() {}
 
 func (x *VStreamFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_vtgate_proto_msgTypes[10]
+	mi := &file_vtgate_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,7 +1378,7 @@ func (x *VStreamFlags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamFlags.ProtoReflect.Descriptor instead.
 func (*VStreamFlags) Descriptor() ([]byte, []int) {
-	return file_vtgate_proto_rawDescGZIP(), []int{10}
+	return file_vtgate_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *VStreamFlags) GetMinimizeSkew() bool {
@@ -1110,6 +1395,20 @@ func (x *VStreamFlags) GetHeartbeatInterval() uint32 {
 	return 0
 }
 
+func (x *VStreamFlags) GetCopyBarrier() bool {
+	if x != nil {
+		return x.CopyBarrier
+	}
+	return false
+}
+
+func (x *VStreamFlags) GetConsistentSnapshot() bool {
+	if x != nil {
+		return x.ConsistentSnapshot
+	}
+	return false
+}
+
 // VStreamRequest is the payload for VStream.
 type VStreamRequest struct {
 	state         protoimpl.MessageState
@@ -1129,7 +1428,7 @@ type VStreamRequest struct {
 func (x *VStreamRequest) Reset() {
 	*x = VStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vtgate_proto_msgTypes[11]
+		mi := &file_vtgate_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1142,7 +1441,7 @@ func (x *VStreamRequest) String() string {
 func (*VStreamRequest) ProtoMessage() {}
 
 func (x *VStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vtgate_proto_msgTypes[11]
+	mi := &file_vtgate_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,7 +1454,7 @@ func (x *VStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamRequest.ProtoReflect.Descriptor instead.
 func (*VStreamRequest) Descriptor() ([]byte, []int) {
-	return file_vtgate_proto_rawDescGZIP(), []int{11}
+	return file_vtgate_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *VStreamRequest) GetCallerId() *vtrpc.CallerID {
@@ -1205,7 +1504,7 @@ type VStreamResponse struct {
 func (x *VStreamResponse) Reset() {
 	*x = VStreamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vtgate_proto_msgTypes[12]
+		mi := &file_vtgate_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1218,7 +1517,7 @@ func (x *VStreamResponse) String() string {
 func (*VStreamResponse) ProtoMessage() {}
 
 func (x *VStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vtgate_proto_msgTypes[12]
+	mi := &file_vtgate_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1530,7 @@ func (x *VStreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamResponse.ProtoReflect.Descriptor instead.
 func (*VStreamResponse) Descriptor() ([]byte, []int) {
-	return file_vtgate_proto_rawDescGZIP(), []int{12}
+	return file_vtgate_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *VStreamResponse) GetEvents() []*binlogdata.VEvent {
@@ -1259,7 +1558,7 @@ type PrepareRequest struct {
 func (x *PrepareRequest) Reset() {
 	*x = PrepareRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vtgate_proto_msgTypes[13]
+		mi := &file_vtgate_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1272,7 +1571,7 @@ func (x *PrepareRequest) String() string {
 func (*PrepareRequest) ProtoMessage() {}
 
 func (x *PrepareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vtgate_proto_msgTypes[13]
+	mi := &file_vtgate_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,7 +1584,7 @@ func (x *PrepareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareRequest.ProtoReflect.Descriptor instead.
 func (*PrepareRequest) Descriptor() ([]byte, []int) {
-	return file_vtgate_proto_rawDescGZIP(), []int{13}
+	return file_vtgate_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PrepareRequest) GetCallerId() *vtrpc.CallerID {
@@ -1328,7 +1627,7 @@ type PrepareResponse struct {
 func (x *PrepareResponse) Reset() {
 	*x = PrepareResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vtgate_proto_msgTypes[14]
+		mi := &file_vtgate_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1341,7 +1640,7 @@ func (x *PrepareResponse) String() string {
 func (*PrepareResponse) ProtoMessage() {}
 
 func (x *PrepareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vtgate_proto_msgTypes[14]
+	mi := &file_vtgate_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,7 +1653,7 @@ func (x *PrepareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareResponse.ProtoReflect.Descriptor instead.
 func (*PrepareResponse) Descriptor() ([]byte, []int) {
-	return file_vtgate_proto_rawDescGZIP(), []int{14}
+	return file_vtgate_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PrepareResponse) GetError() *vtrpc.RPCError {
@@ -1394,7 +1693,7 @@ type CloseSessionRequest struct {
 func (x *CloseSessionRequest) Reset() {
 	*x = CloseSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vtgate_proto_msgTypes[15]
+		mi := &file_vtgate_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1407,7 +1706,7 @@ func (x *CloseSessionRequest) String() string {
 func (*CloseSessionRequest) ProtoMessage() {}
 
 func (x *CloseSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vtgate_proto_msgTypes[15]
+	mi := &file_vtgate_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,7 +1719,7 @@ func (x *CloseSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseSessionRequest.ProtoReflect.Descriptor instead.
 func (*CloseSessionRequest) Descriptor() ([]byte, []int) {
-	return file_vtgate_proto_rawDescGZIP(), []int{15}
+	return file_vtgate_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CloseSessionRequest) GetCallerId() *vtrpc.CallerID {
@@ -1452,7 +1751,7 @@ type CloseSessionResponse struct {
 func (x *CloseSessionResponse) Reset() {
 	*x = CloseSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vtgate_proto_msgTypes[16]
+		mi := &file_vtgate_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1465,7 +1764,7 @@ func (x *CloseSessionResponse) String() string {
 func (*CloseSessionResponse) ProtoMessage() {}
 
 func (x *CloseSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vtgate_proto_msgTypes[16]
+	mi := &file_vtgate_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1478,7 +1777,7 @@ func (x *CloseSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseSessionResponse.ProtoReflect.Descriptor instead.
 func (*CloseSessionResponse) Descriptor() ([]byte, []int) {
-	return file_vtgate_proto_rawDescGZIP(), []int{16}
+	return file_vtgate_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CloseSessionResponse) GetError() *vtrpc.RPCError {
@@ -1503,7 +1802,7 @@ type Session_ShardSession struct {
 func (x *Session_ShardSession) Reset() {
 	*x = Session_ShardSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vtgate_proto_msgTypes[17]
+		mi := &file_vtgate_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1516,7 +1815,7 @@ func (x *Session_ShardSession) String() string {
 func (*Session_ShardSession) ProtoMessage() {}
 
 func (x *Session_ShardSession) ProtoReflect() protoreflect.Message {
-	mi := &file_vtgate_proto_msgTypes[17]
+	mi := &file_vtgate_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1761,74 +2060,108 @@ var file_vtgate_proto_rawDesc = []byte{
 	0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
 	0x64, 0x74, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x74, 0x69, 0x64,
 	0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62,
-	0x0a, 0x0c, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x53,
-	0x6b, 0x65, 0x77, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
-	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x67,
-	0x74, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x69, 0x6e, 0x6c,
-	0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x47, 0x74, 0x69, 0x64, 0x52, 0x05, 0x76, 0x67,
-	0x74, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x2a, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
-	0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x3d, 0x0a, 0x0f, 0x56,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
-	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x50,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49,
-	0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76,
-	0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x42, 0x6f,
-	0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22,
-	0x89, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x50, 0x43, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x14, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x50, 0x43, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x44, 0x0a, 0x0f, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a,
-	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x55,
-	0x4c, 0x54, 0x49, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x57, 0x4f, 0x50, 0x43, 0x10, 0x03,
-	0x2a, 0x3c, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50,
-	0x52, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0e,
-	0x0a, 0x0a, 0x41, 0x55, 0x54, 0x4f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x42, 0x36,
-	0x0a, 0x0f, 0x69, 0x6f, 0x2e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x5a, 0x23, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74,
-	0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8,
+	0x01, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x39, 0x0a, 0x12, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4e, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x52,
+	0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x3a, 0x0a, 0x13, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69,
+	0x7a, 0x65, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x6b, 0x65, 0x77, 0x12, 0x2d, 0x0a, 0x12, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x70, 0x79, 0x5f, 0x62, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x63, 0x6f, 0x70, 0x79, 0x42, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x13, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xf6,
+	0x01, 0x0a, 0x0e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x67, 0x74, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x56, 0x47, 0x74, 0x69, 0x64, 0x52, 0x05, 0x76, 0x67, 0x74, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x74, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x3d, 0x0a, 0x0f, 0x56, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x6e,
+	0x6c, 0x6f, 0x67, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x52, 0x08, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x89, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x50, 0x43, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x49, 0x44, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x76, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x50, 0x43, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x44, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49,
+	0x4e, 0x47, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x57, 0x4f, 0x50, 0x43, 0x10, 0x03, 0x2a, 0x3c, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x4e,
+	0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x52, 0x45, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x55,
+	0x54, 0x4f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x42, 0x36, 0x0a, 0x0f, 0x69, 0x6f,
+	0x2e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x23, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f,
+	0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x74, 0x67, 0x61,
+	0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1844,7 +2177,7 @@ func file_vtgate_proto_rawDescGZIP() []byte {
 }
 
 var file_vtgate_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_vtgate_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_vtgate_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_vtgate_proto_goTypes = []interface{}{
 	(TransactionMode)(0),               // 0: vtgate.TransactionMode
 	(CommitOrder)(0),                   // 1: vtgate.CommitOrder
@@ -1858,89 +2191,98 @@ var file_vtgate_proto_goTypes = []interface{}{
 	(*StreamExecuteResponse)(nil),      // 9: vtgate.StreamExecuteResponse
 	(*ResolveTransactionRequest)(nil),  // 10: vtgate.ResolveTransactionRequest
 	(*ResolveTransactionResponse)(nil), // 11: vtgate.ResolveTransactionResponse
-	(*VStreamFlags)(nil),               // 12: vtgate.VStreamFlags
-	(*VStreamRequest)(nil),             // 13: vtgate.VStreamRequest
-	(*VStreamResponse)(nil),            // 14: vtgate.VStreamResponse
-	(*PrepareRequest)(nil),             // 15: vtgate.PrepareRequest
-	(*PrepareResponse)(nil),            // 16: vtgate.PrepareResponse
-	(*CloseSessionRequest)(nil),        // 17: vtgate.CloseSessionRequest
-	(*CloseSessionResponse)(nil),       // 18: vtgate.CloseSessionResponse
-	(*Session_ShardSession)(nil),       // 19: vtgate.Session.ShardSession
-	nil,                                // 20: vtgate.Session.UserDefinedVariablesEntry
-	nil,                                // 21: vtgate.Session.SystemVariablesEntry
-	(*query.ExecuteOptions)(nil),       // 22: query.ExecuteOptions
-	(*query.QueryWarning)(nil),         // 23: query.QueryWarning
-	(*vtrpc.CallerID)(nil),             // 24: vtrpc.CallerID
-	(*query.BoundQuery)(nil),           // 25: query.BoundQuery
-	(topodata.TabletType)(0),           // 26: topodata.TabletType
-	(*vtrpc.RPCError)(nil),             // 27: vtrpc.RPCError
-	(*query.QueryResult)(nil),          // 28: query.QueryResult
-	(*query.ResultWithError)(nil),      // 29: query.ResultWithError
-	(*binlogdata.VGtid)(nil),           // 30: binlogdata.VGtid
-	(*binlogdata.Filter)(nil),          // 31: binlogdata.Filter
-	(*binlogdata.VEvent)(nil),          // 32: binlogdata.VEvent
-	(*query.Field)(nil),                // 33: query.Field
-	(*query.Target)(nil),               // 34: query.Target
-	(*topodata.TabletAlias)(nil),       // 35: topodata.TabletAlias
-	(*query.BindVariable)(nil),         // 36: query.BindVariable
+	(*MessageAckRequest)(nil),          // 12: vtgate.MessageAckRequest
+	(*MessageAckResponse)(nil),         // 13: vtgate.MessageAckResponse
+	(*MessageNackRequest)(nil),         // 14: vtgate.MessageNackRequest
+	(*MessageNackResponse)(nil),        // 15: vtgate.MessageNackResponse
+	(*VStreamFlags)(nil),               // 16: vtgate.VStreamFlags
+	(*VStreamRequest)(nil),             // 17: vtgate.VStreamRequest
+	(*VStreamResponse)(nil),            // 18: vtgate.VStreamResponse
+	(*PrepareRequest)(nil),             // 19: vtgate.PrepareRequest
+	(*PrepareResponse)(nil),            // 20: vtgate.PrepareResponse
+	(*CloseSessionRequest)(nil),        // 21: vtgate.CloseSessionRequest
+	(*CloseSessionResponse)(nil),       // 22: vtgate.CloseSessionResponse
+	(*Session_ShardSession)(nil),       // 23: vtgate.Session.ShardSession
+	nil,                                // 24: vtgate.Session.UserDefinedVariablesEntry
+	nil,                                // 25: vtgate.Session.SystemVariablesEntry
+	(*query.ExecuteOptions)(nil),       // 26: query.ExecuteOptions
+	(*query.QueryWarning)(nil),         // 27: query.QueryWarning
+	(*vtrpc.CallerID)(nil),             // 28: vtrpc.CallerID
+	(*query.BoundQuery)(nil),           // 29: query.BoundQuery
+	(topodata.TabletType)(0),           // 30: topodata.TabletType
+	(*vtrpc.RPCError)(nil),             // 31: vtrpc.RPCError
+	(*query.QueryResult)(nil),          // 32: query.QueryResult
+	(*query.ResultWithError)(nil),      // 33: query.ResultWithError
+	(*query.Value)(nil),                // 34: query.Value
+	(*binlogdata.VGtid)(nil),           // 35: binlogdata.VGtid
+	(*binlogdata.Filter)(nil),          // 36: binlogdata.Filter
+	(*binlogdata.VEvent)(nil),          // 37: binlogdata.VEvent
+	(*query.Field)(nil),                // 38: query.Field
+	(*query.Target)(nil),               // 39: query.Target
+	(*topodata.TabletAlias)(nil),       // 40: topodata.TabletAlias
+	(*query.BindVariable)(nil),         // 41: query.BindVariable
 }
 var file_vtgate_proto_depIdxs = []int32{
-	19, // 0: vtgate.Session.shard_sessions:type_name -> vtgate.Session.ShardSession
-	22, // 1: vtgate.Session.options:type_name -> query.ExecuteOptions
+	23, // 0: vtgate.Session.shard_sessions:type_name -> vtgate.Session.ShardSession
+	26, // 1: vtgate.Session.options:type_name -> query.ExecuteOptions
 	0,  // 2: vtgate.Session.transaction_mode:type_name -> vtgate.TransactionMode
-	23, // 3: vtgate.Session.warnings:type_name -> query.QueryWarning
-	19, // 4: vtgate.Session.pre_sessions:type_name -> vtgate.Session.ShardSession
-	19, // 5: vtgate.Session.post_sessions:type_name -> vtgate.Session.ShardSession
-	20, // 6: vtgate.Session.user_defined_variables:type_name -> vtgate.Session.UserDefinedVariablesEntry
-	21, // 7: vtgate.Session.system_variables:type_name -> vtgate.Session.SystemVariablesEntry
-	19, // 8: vtgate.Session.lock_session:type_name -> vtgate.Session.ShardSession
+	27, // 3: vtgate.Session.warnings:type_name -> query.QueryWarning
+	23, // 4: vtgate.Session.pre_sessions:type_name -> vtgate.Session.ShardSession
+	23, // 5: vtgate.Session.post_sessions:type_name -> vtgate.Session.ShardSession
+	24, // 6: vtgate.Session.user_defined_variables:type_name -> vtgate.Session.UserDefinedVariablesEntry
+	25, // 7: vtgate.Session.system_variables:type_name -> vtgate.Session.SystemVariablesEntry
+	23, // 8: vtgate.Session.lock_session:type_name -> vtgate.Session.ShardSession
 	3,  // 9: vtgate.Session.read_after_write:type_name -> vtgate.ReadAfterWrite
-	24, // 10: vtgate.ExecuteRequest.caller_id:type_name -> vtrpc.CallerID
+	28, // 10: vtgate.ExecuteRequest.caller_id:type_name -> vtrpc.CallerID
 	2,  // 11: vtgate.ExecuteRequest.session:type_name -> vtgate.Session
-	25, // 12: vtgate.ExecuteRequest.query:type_name -> query.BoundQuery
-	26, // 13: vtgate.ExecuteRequest.tablet_type:type_name -> topodata.TabletType
-	22, // 14: vtgate.ExecuteRequest.options:type_name -> query.ExecuteOptions
-	27, // 15: vtgate.ExecuteResponse.error:type_name -> vtrpc.RPCError
+	29, // 12: vtgate.ExecuteRequest.query:type_name -> query.BoundQuery
+	30, // 13: vtgate.ExecuteRequest.tablet_type:type_name -> topodata.TabletType
+	26, // 14: vtgate.ExecuteRequest.options:type_name -> query.ExecuteOptions
+	31, // 15: vtgate.ExecuteResponse.error:type_name -> vtrpc.RPCError
 	2,  // 16: vtgate.ExecuteResponse.session:type_name -> vtgate.Session
-	28, // 17: vtgate.ExecuteResponse.result:type_name -> query.QueryResult
-	24, // 18: vtgate.ExecuteBatchRequest.caller_id:type_name -> vtrpc.CallerID
+	32, // 17: vtgate.ExecuteResponse.result:type_name -> query.QueryResult
+	28, // 18: vtgate.ExecuteBatchRequest.caller_id:type_name -> vtrpc.CallerID
 	2,  // 19: vtgate.ExecuteBatchRequest.session:type_name -> vtgate.Session
-	25, // 20: vtgate.ExecuteBatchRequest.queries:type_name -> query.BoundQuery
-	26, // 21: vtgate.ExecuteBatchRequest.tablet_type:type_name -> topodata.TabletType
-	22, // 22: vtgate.ExecuteBatchRequest.options:type_name -> query.ExecuteOptions
-	27, // 23: vtgate.ExecuteBatchResponse.error:type_name -> vtrpc.RPCError
+	29, // 20: vtgate.ExecuteBatchRequest.queries:type_name -> query.BoundQuery
+	30, // 21: vtgate.ExecuteBatchRequest.tablet_type:type_name -> topodata.TabletType
+	26, // 22: vtgate.ExecuteBatchRequest.options:type_name -> query.ExecuteOptions
+	31, // 23: vtgate.ExecuteBatchResponse.error:type_name -> vtrpc.RPCError
 	2,  // 24: vtgate.ExecuteBatchResponse.session:type_name -> vtgate.Session
-	29, // 25: vtgate.ExecuteBatchResponse.results:type_name -> query.ResultWithError
-	24, // 26: vtgate.StreamExecuteRequest.caller_id:type_name -> vtrpc.CallerID
-	25, // 27: vtgate.StreamExecuteRequest.query:type_name -> query.BoundQuery
-	26, // 28: vtgate.StreamExecuteRequest.tablet_type:type_name -> topodata.TabletType
-	22, // 29: vtgate.StreamExecuteRequest.options:type_name -> query.ExecuteOptions
+	33, // 25: vtgate.ExecuteBatchResponse.results:type_name -> query.ResultWithError
+	28, // 26: vtgate.StreamExecuteRequest.caller_id:type_name -> vtrpc.CallerID
+	29, // 27: vtgate.StreamExecuteRequest.query:type_name -> query.BoundQuery
+	30, // 28: vtgate.StreamExecuteRequest.tablet_type:type_name -> topodata.TabletType
+	26, // 29: vtgate.StreamExecuteRequest.options:type_name -> query.ExecuteOptions
 	2,  // 30: vtgate.StreamExecuteRequest.session:type_name -> vtgate.Session
-	28, // 31: vtgate.StreamExecuteResponse.result:type_name -> query.QueryResult
-	24, // 32: vtgate.ResolveTransactionRequest.caller_id:type_name -> vtrpc.CallerID
-	24, // 33: vtgate.VStreamRequest.caller_id:type_name -> vtrpc.CallerID
-	26, // 34: vtgate.VStreamRequest.tablet_type:type_name -> topodata.TabletType
-	30, // 35: vtgate.VStreamRequest.vgtid:type_name -> binlogdata.VGtid
-	31, // 36: vtgate.VStreamRequest.filter:type_name -> binlogdata.Filter
-	12, // 37: vtgate.VStreamRequest.flags:type_name -> vtgate.VStreamFlags
-	32, // 38: vtgate.VStreamResponse.events:type_name -> binlogdata.VEvent
-	24, // 39: vtgate.PrepareRequest.caller_id:type_name -> vtrpc.CallerID
-	2,  // 40: vtgate.PrepareRequest.session:type_name -> vtgate.Session
-	25, // 41: vtgate.PrepareRequest.query:type_name -> query.BoundQuery
-	27, // 42: vtgate.PrepareResponse.error:type_name -> vtrpc.RPCError
-	2,  // 43: vtgate.PrepareResponse.session:type_name -> vtgate.Session
-	33, // 44: vtgate.PrepareResponse.fields:type_name -> query.Field
-	24, // 45: vtgate.CloseSessionRequest.caller_id:type_name -> vtrpc.CallerID
-	2,  // 46: vtgate.CloseSessionRequest.session:type_name -> vtgate.Session
-	27, // 47: vtgate.CloseSessionResponse.error:type_name -> vtrpc.RPCError
-	34, // 48: vtgate.Session.ShardSession.target:type_name -> query.Target
-	35, // 49: vtgate.Session.ShardSession.tablet_alias:type_name -> topodata.TabletAlias
-	36, // 50: vtgate.Session.UserDefinedVariablesEntry.value:type_name -> query.BindVariable
-	51, // [51:51] is the sub-list for method output_type
-	51, // [51:51] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	32, // 31: vtgate.StreamExecuteResponse.result:type_name -> query.QueryResult
+	28, // 32: vtgate.ResolveTransactionRequest.caller_id:type_name -> vtrpc.CallerID
+	28, // 33: vtgate.MessageAckRequest.caller_id:type_name -> vtrpc.CallerID
+	34, // 34: vtgate.MessageAckRequest.ids:type_name -> query.Value
+	28, // 35: vtgate.MessageNackRequest.caller_id:type_name -> vtrpc.CallerID
+	34, // 36: vtgate.MessageNackRequest.ids:type_name -> query.Value
+	28, // 37: vtgate.VStreamRequest.caller_id:type_name -> vtrpc.CallerID
+	30, // 38: vtgate.VStreamRequest.tablet_type:type_name -> topodata.TabletType
+	35, // 39: vtgate.VStreamRequest.vgtid:type_name -> binlogdata.VGtid
+	36, // 40: vtgate.VStreamRequest.filter:type_name -> binlogdata.Filter
+	16, // 41: vtgate.VStreamRequest.flags:type_name -> vtgate.VStreamFlags
+	37, // 42: vtgate.VStreamResponse.events:type_name -> binlogdata.VEvent
+	28, // 43: vtgate.PrepareRequest.caller_id:type_name -> vtrpc.CallerID
+	2,  // 44: vtgate.PrepareRequest.session:type_name -> vtgate.Session
+	29, // 45: vtgate.PrepareRequest.query:type_name -> query.BoundQuery
+	31, // 46: vtgate.PrepareResponse.error:type_name -> vtrpc.RPCError
+	2,  // 47: vtgate.PrepareResponse.session:type_name -> vtgate.Session
+	38, // 48: vtgate.PrepareResponse.fields:type_name -> query.Field
+	28, // 49: vtgate.CloseSessionRequest.caller_id:type_name -> vtrpc.CallerID
+	2,  // 50: vtgate.CloseSessionRequest.session:type_name -> vtgate.Session
+	31, // 51: vtgate.CloseSessionResponse.error:type_name -> vtrpc.RPCError
+	39, // 52: vtgate.Session.ShardSession.target:type_name -> query.Target
+	40, // 53: vtgate.Session.ShardSession.tablet_alias:type_name -> topodata.TabletAlias
+	41, // 54: vtgate.Session.UserDefinedVariablesEntry.value:type_name -> query.BindVariable
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_vtgate_proto_init() }
@@ -2070,7 +2412,7 @@ func file_vtgate_proto_init() {
 			}
 		}
 		file_vtgate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamFlags); i {
+			switch v := v.(*MessageAckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2082,7 +2424,7 @@ func file_vtgate_proto_init() {
 			}
 		}
 		file_vtgate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamRequest); i {
+			switch v := v.(*MessageAckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2094,7 +2436,7 @@ func file_vtgate_proto_init() {
 			}
 		}
 		file_vtgate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamResponse); i {
+			switch v := v.(*MessageNackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2106,7 +2448,7 @@ func file_vtgate_proto_init() {
 			}
 		}
 		file_vtgate_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrepareRequest); i {
+			switch v := v.(*MessageNackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2118,7 +2460,7 @@ func file_vtgate_proto_init() {
 			}
 		}
 		file_vtgate_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrepareResponse); i {
+			switch v := v.(*VStreamFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2130,7 +2472,7 @@ func file_vtgate_proto_init() {
 			}
 		}
 		file_vtgate_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSessionRequest); i {
+			switch v := v.(*VStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2142,7 +2484,7 @@ func file_vtgate_proto_init() {
 			}
 		}
 		file_vtgate_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSessionResponse); i {
+			switch v := v.(*VStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2154,6 +2496,54 @@ func file_vtgate_proto_init() {
 			}
 		}
 		file_vtgate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vtgate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vtgate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vtgate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vtgate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Session_ShardSession); i {
 			case 0:
 				return &v.state
@@ -2172,7 +2562,7 @@ func file_vtgate_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vtgate_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   20,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
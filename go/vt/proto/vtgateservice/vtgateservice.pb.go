@@ -44,7 +44,7 @@ var file_vtgateservice_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x1a, 0x0c, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0x8f, 0x04, 0x0a, 0x06, 0x56, 0x69, 0x74, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a,
+	0x74, 0x6f, 0x32, 0xa0, 0x05, 0x0a, 0x06, 0x56, 0x69, 0x74, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a,
 	0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74,
 	0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x17, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
@@ -64,24 +64,34 @@ var file_vtgateservice_proto_rawDesc = []byte{
 	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
 	0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x56, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76,
-	0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x72,
-	0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76,
-	0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x42, 0x0a, 0x14, 0x69, 0x6f, 0x2e, 0x76, 0x69, 0x74, 0x65, 0x73,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x5a, 0x2a, 0x76, 0x69,
-	0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f, 0x67,
-	0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x74, 0x67, 0x61, 0x74,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x12, 0x19, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x63, 0x6b, 0x12,
+	0x1a, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4e, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x74,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x56, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x56,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x42, 0x0a, 0x14, 0x69, 0x6f, 0x2e, 0x76, 0x69, 0x74, 0x65,
+	0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x5a, 0x2a, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f,
+	0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x74, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var file_vtgateservice_proto_goTypes = []interface{}{
@@ -89,34 +99,42 @@ var file_vtgateservice_proto_goTypes = []interface{}{
 	(*vtgate.ExecuteBatchRequest)(nil),        // 1: vtgate.ExecuteBatchRequest
 	(*vtgate.StreamExecuteRequest)(nil),       // 2: vtgate.StreamExecuteRequest
 	(*vtgate.ResolveTransactionRequest)(nil),  // 3: vtgate.ResolveTransactionRequest
-	(*vtgate.VStreamRequest)(nil),             // 4: vtgate.VStreamRequest
-	(*vtgate.PrepareRequest)(nil),             // 5: vtgate.PrepareRequest
-	(*vtgate.CloseSessionRequest)(nil),        // 6: vtgate.CloseSessionRequest
-	(*vtgate.ExecuteResponse)(nil),            // 7: vtgate.ExecuteResponse
-	(*vtgate.ExecuteBatchResponse)(nil),       // 8: vtgate.ExecuteBatchResponse
-	(*vtgate.StreamExecuteResponse)(nil),      // 9: vtgate.StreamExecuteResponse
-	(*vtgate.ResolveTransactionResponse)(nil), // 10: vtgate.ResolveTransactionResponse
-	(*vtgate.VStreamResponse)(nil),            // 11: vtgate.VStreamResponse
-	(*vtgate.PrepareResponse)(nil),            // 12: vtgate.PrepareResponse
-	(*vtgate.CloseSessionResponse)(nil),       // 13: vtgate.CloseSessionResponse
+	(*vtgate.MessageAckRequest)(nil),          // 4: vtgate.MessageAckRequest
+	(*vtgate.MessageNackRequest)(nil),         // 5: vtgate.MessageNackRequest
+	(*vtgate.VStreamRequest)(nil),             // 6: vtgate.VStreamRequest
+	(*vtgate.PrepareRequest)(nil),             // 7: vtgate.PrepareRequest
+	(*vtgate.CloseSessionRequest)(nil),        // 8: vtgate.CloseSessionRequest
+	(*vtgate.ExecuteResponse)(nil),            // 9: vtgate.ExecuteResponse
+	(*vtgate.ExecuteBatchResponse)(nil),       // 10: vtgate.ExecuteBatchResponse
+	(*vtgate.StreamExecuteResponse)(nil),      // 11: vtgate.StreamExecuteResponse
+	(*vtgate.ResolveTransactionResponse)(nil), // 12: vtgate.ResolveTransactionResponse
+	(*vtgate.MessageAckResponse)(nil),         // 13: vtgate.MessageAckResponse
+	(*vtgate.MessageNackResponse)(nil),        // 14: vtgate.MessageNackResponse
+	(*vtgate.VStreamResponse)(nil),            // 15: vtgate.VStreamResponse
+	(*vtgate.PrepareResponse)(nil),            // 16: vtgate.PrepareResponse
+	(*vtgate.CloseSessionResponse)(nil),       // 17: vtgate.CloseSessionResponse
 }
 var file_vtgateservice_proto_depIdxs = []int32{
 	0,  // 0: vtgateservice.Vitess.Execute:input_type -> vtgate.ExecuteRequest
 	1,  // 1: vtgateservice.Vitess.ExecuteBatch:input_type -> vtgate.ExecuteBatchRequest
 	2,  // 2: vtgateservice.Vitess.StreamExecute:input_type -> vtgate.StreamExecuteRequest
 	3,  // 3: vtgateservice.Vitess.ResolveTransaction:input_type -> vtgate.ResolveTransactionRequest
-	4,  // 4: vtgateservice.Vitess.VStream:input_type -> vtgate.VStreamRequest
-	5,  // 5: vtgateservice.Vitess.Prepare:input_type -> vtgate.PrepareRequest
-	6,  // 6: vtgateservice.Vitess.CloseSession:input_type -> vtgate.CloseSessionRequest
-	7,  // 7: vtgateservice.Vitess.Execute:output_type -> vtgate.ExecuteResponse
-	8,  // 8: vtgateservice.Vitess.ExecuteBatch:output_type -> vtgate.ExecuteBatchResponse
-	9,  // 9: vtgateservice.Vitess.StreamExecute:output_type -> vtgate.StreamExecuteResponse
-	10, // 10: vtgateservice.Vitess.ResolveTransaction:output_type -> vtgate.ResolveTransactionResponse
-	11, // 11: vtgateservice.Vitess.VStream:output_type -> vtgate.VStreamResponse
-	12, // 12: vtgateservice.Vitess.Prepare:output_type -> vtgate.PrepareResponse
-	13, // 13: vtgateservice.Vitess.CloseSession:output_type -> vtgate.CloseSessionResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	4,  // 4: vtgateservice.Vitess.MessageAck:input_type -> vtgate.MessageAckRequest
+	5,  // 5: vtgateservice.Vitess.MessageNack:input_type -> vtgate.MessageNackRequest
+	6,  // 6: vtgateservice.Vitess.VStream:input_type -> vtgate.VStreamRequest
+	7,  // 7: vtgateservice.Vitess.Prepare:input_type -> vtgate.PrepareRequest
+	8,  // 8: vtgateservice.Vitess.CloseSession:input_type -> vtgate.CloseSessionRequest
+	9,  // 9: vtgateservice.Vitess.Execute:output_type -> vtgate.ExecuteResponse
+	10, // 10: vtgateservice.Vitess.ExecuteBatch:output_type -> vtgate.ExecuteBatchResponse
+	11, // 11: vtgateservice.Vitess.StreamExecute:output_type -> vtgate.StreamExecuteResponse
+	12, // 12: vtgateservice.Vitess.ResolveTransaction:output_type -> vtgate.ResolveTransactionResponse
+	13, // 13: vtgateservice.Vitess.MessageAck:output_type -> vtgate.MessageAckResponse
+	14, // 14: vtgateservice.Vitess.MessageNack:output_type -> vtgate.MessageNackResponse
+	15, // 15: vtgateservice.Vitess.VStream:output_type -> vtgate.VStreamResponse
+	16, // 16: vtgateservice.Vitess.Prepare:output_type -> vtgate.PrepareResponse
+	17, // 17: vtgateservice.Vitess.CloseSession:output_type -> vtgate.CloseSessionResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
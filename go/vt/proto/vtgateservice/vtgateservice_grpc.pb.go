@@ -38,6 +38,14 @@ type VitessClient interface {
 	// ResolveTransaction resolves a transaction.
 	// API group: Transactions
 	ResolveTransaction(ctx context.Context, in *vtgate.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtgate.ResolveTransactionResponse, error)
+	// MessageAck acks the specified messages, for the regular subscribers
+	// or for a consumer group. The ids are routed to their shards using
+	// the table's primary vindex.
+	MessageAck(ctx context.Context, in *vtgate.MessageAckRequest, opts ...grpc.CallOption) (*vtgate.MessageAckResponse, error)
+	// MessageNack sends the specified messages back for immediate
+	// redelivery, instead of waiting for their ack wait to expire.
+	// The ids are routed to their shards using the table's primary vindex.
+	MessageNack(ctx context.Context, in *vtgate.MessageNackRequest, opts ...grpc.CallOption) (*vtgate.MessageNackResponse, error)
 	// VStream streams binlog events from the requested sources.
 	VStream(ctx context.Context, in *vtgate.VStreamRequest, opts ...grpc.CallOption) (Vitess_VStreamClient, error)
 	// Prepare is used by the MySQL server plugin as part of supporting prepared statements.
@@ -115,6 +123,24 @@ func (c *vitessClient) ResolveTransaction(ctx context.Context, in *vtgate.Resolv
 	return out, nil
 }
 
+func (c *vitessClient) MessageAck(ctx context.Context, in *vtgate.MessageAckRequest, opts ...grpc.CallOption) (*vtgate.MessageAckResponse, error) {
+	out := new(vtgate.MessageAckResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/MessageAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vitessClient) MessageNack(ctx context.Context, in *vtgate.MessageNackRequest, opts ...grpc.CallOption) (*vtgate.MessageNackResponse, error) {
+	out := new(vtgate.MessageNackResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/MessageNack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vitessClient) VStream(ctx context.Context, in *vtgate.VStreamRequest, opts ...grpc.CallOption) (Vitess_VStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Vitess_ServiceDesc.Streams[1], "/vtgateservice.Vitess/VStream", opts...)
 	if err != nil {
@@ -188,6 +214,14 @@ type VitessServer interface {
 	// ResolveTransaction resolves a transaction.
 	// API group: Transactions
 	ResolveTransaction(context.Context, *vtgate.ResolveTransactionRequest) (*vtgate.ResolveTransactionResponse, error)
+	// MessageAck acks the specified messages, for the regular subscribers
+	// or for a consumer group. The ids are routed to their shards using
+	// the table's primary vindex.
+	MessageAck(context.Context, *vtgate.MessageAckRequest) (*vtgate.MessageAckResponse, error)
+	// MessageNack sends the specified messages back for immediate
+	// redelivery, instead of waiting for their ack wait to expire.
+	// The ids are routed to their shards using the table's primary vindex.
+	MessageNack(context.Context, *vtgate.MessageNackRequest) (*vtgate.MessageNackResponse, error)
 	// VStream streams binlog events from the requested sources.
 	VStream(*vtgate.VStreamRequest, Vitess_VStreamServer) error
 	// Prepare is used by the MySQL server plugin as part of supporting prepared statements.
@@ -215,6 +249,12 @@ func (UnimplementedVitessServer) StreamExecute(*vtgate.StreamExecuteRequest, Vit
 func (UnimplementedVitessServer) ResolveTransaction(context.Context, *vtgate.ResolveTransactionRequest) (*vtgate.ResolveTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveTransaction not implemented")
 }
+func (UnimplementedVitessServer) MessageAck(context.Context, *vtgate.MessageAckRequest) (*vtgate.MessageAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageAck not implemented")
+}
+func (UnimplementedVitessServer) MessageNack(context.Context, *vtgate.MessageNackRequest) (*vtgate.MessageNackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageNack not implemented")
+}
 func (UnimplementedVitessServer) VStream(*vtgate.VStreamRequest, Vitess_VStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStream not implemented")
 }
@@ -312,6 +352,42 @@ func _Vitess_ResolveTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vitess_MessageAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.MessageAckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).MessageAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/MessageAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).MessageAck(ctx, req.(*vtgate.MessageAckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vitess_MessageNack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.MessageNackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).MessageNack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/MessageNack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).MessageNack(ctx, req.(*vtgate.MessageNackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vitess_VStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(vtgate.VStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -388,6 +464,14 @@ var Vitess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveTransaction",
 			Handler:    _Vitess_ResolveTransaction_Handler,
 		},
+		{
+			MethodName: "MessageAck",
+			Handler:    _Vitess_MessageAck_Handler,
+		},
+		{
+			MethodName: "MessageNack",
+			Handler:    _Vitess_MessageNack_Handler,
+		},
 		{
 			MethodName: "Prepare",
 			Handler:    _Vitess_Prepare_Handler,
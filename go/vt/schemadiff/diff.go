@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package schemadiff computes the difference between two table schemas, given
+as CREATE TABLE statements, without the help of a MySQL server. The result is
+the minimal ALTER TABLE statement which turns the first table into the second.
+*/
+package schemadiff
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func parseCreateTable(query string) (*sqlparser.CreateTable, error) {
+	stmt, err := sqlparser.ParseStrictDDL(query)
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := stmt.(*sqlparser.CreateTable)
+	if !ok {
+		return nil, ErrExpectedCreateTable
+	}
+	return createTable, nil
+}
+
+// DiffCreateTablesQueries compares two CREATE TABLE queries and returns the
+// ALTER TABLE statement which turns the first table into the second. It
+// returns nil when the two tables are identical.
+func DiffCreateTablesQueries(query1 string, query2 string, hints *DiffHints) (*sqlparser.AlterTable, error) {
+	create1, err := parseCreateTable(query1)
+	if err != nil {
+		return nil, err
+	}
+	create2, err := parseCreateTable(query2)
+	if err != nil {
+		return nil, err
+	}
+	return DiffTables(create1, create2, hints)
+}
+
+// DiffTables compares two CREATE TABLE statements and returns the ALTER TABLE
+// statement which turns the first table into the second. It returns nil when
+// the two tables are identical.
+func DiffTables(create1 *sqlparser.CreateTable, create2 *sqlparser.CreateTable, hints *DiffHints) (*sqlparser.AlterTable, error) {
+	from, err := NewCreateTableEntity(create1)
+	if err != nil {
+		return nil, err
+	}
+	to, err := NewCreateTableEntity(create2)
+	if err != nil {
+		return nil, err
+	}
+	return from.Diff(to, hints), nil
+}
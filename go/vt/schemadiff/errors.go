@@ -0,0 +1,28 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import "errors"
+
+var (
+	// ErrExpectedCreateTable is returned when a statement to diff is not a CREATE TABLE statement
+	ErrExpectedCreateTable = errors.New("expected a CREATE TABLE statement")
+	// ErrCreateTableLike is returned when a CREATE TABLE statement has no table definition, as in CREATE TABLE ... LIKE
+	ErrCreateTableLike = errors.New("CREATE TABLE ... LIKE is not supported")
+	// ErrNotFullyParsed is returned when a CREATE TABLE statement could only be partially parsed
+	ErrNotFullyParsed = errors.New("unable to fully parse statement")
+)
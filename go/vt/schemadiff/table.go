@@ -0,0 +1,684 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// CreateTableEntity stands for a table, as defined by a CREATE TABLE statement.
+// The definition is normalized, so that equivalent statements, such as the one
+// a user wrote and the one reported by SHOW CREATE TABLE, compare equal.
+type CreateTableEntity struct {
+	sqlparser.CreateTable
+}
+
+// NewCreateTableEntity returns a normalized entity for the given statement.
+// The statement itself is not modified.
+func NewCreateTableEntity(createTable *sqlparser.CreateTable) (*CreateTableEntity, error) {
+	if !createTable.FullyParsed {
+		return nil, ErrNotFullyParsed
+	}
+	if createTable.TableSpec == nil {
+		return nil, ErrCreateTableLike
+	}
+	c := &CreateTableEntity{CreateTable: *sqlparser.CloneRefOfCreateTable(createTable)}
+	c.normalize()
+	return c, nil
+}
+
+// Name returns the name of the table
+func (c *CreateTableEntity) Name() string {
+	return c.Table.Name.String()
+}
+
+// Diff returns the ALTER TABLE statement which turns this table into the given
+// one, or nil if both are identical. Table names are not compared: the
+// statement always applies to this table.
+func (c *CreateTableEntity) Diff(other *CreateTableEntity, hints *DiffHints) *sqlparser.AlterTable {
+	if hints == nil {
+		hints = &DiffHints{}
+	}
+	var drops, changes, adds []sqlparser.AlterOption
+
+	constraintDrops, constraintAdds := c.diffConstraints(other)
+	drops = append(drops, constraintDrops...)
+	adds = append(adds, constraintAdds...)
+
+	indexDrops, indexAdds := c.diffIndexes(other)
+	drops = append(drops, indexDrops...)
+	adds = append(adds, indexAdds...)
+
+	columnDrops, columnChanges := c.diffColumns(other)
+	drops = append(drops, columnDrops...)
+	changes = append(changes, columnChanges...)
+
+	if tableOptions := c.diffTableOptions(other, hints); len(tableOptions) > 0 {
+		changes = append(changes, tableOptions)
+	}
+
+	alterTable := &sqlparser.AlterTable{
+		Table:       c.Table,
+		FullyParsed: true,
+	}
+	alterTable.AlterOptions = append(alterTable.AlterOptions, drops...)
+	alterTable.AlterOptions = append(alterTable.AlterOptions, changes...)
+	alterTable.AlterOptions = append(alterTable.AlterOptions, adds...)
+	alterTable.PartitionSpec, alterTable.PartitionOption = c.diffPartitions(other, len(alterTable.AlterOptions) > 0)
+
+	if len(alterTable.AlterOptions) == 0 && alterTable.PartitionSpec == nil && alterTable.PartitionOption == nil {
+		return nil
+	}
+	return alterTable
+}
+
+// normalize rewrites the table definition the way MySQL reports it.
+func (c *CreateTableEntity) normalize() {
+	c.normalizeTableOptions()
+	c.normalizeColumns()
+	c.addForeignKeyIndexes()
+	c.normalizeIndexes()
+	c.normalizeConstraints()
+	c.normalizePartitions()
+}
+
+func (c *CreateTableEntity) normalizeTableOptions() {
+	for _, opt := range c.TableSpec.Options {
+		opt.Name = strings.ToLower(opt.Name)
+		switch opt.Name {
+		case "character set":
+			opt.Name = "charset"
+		}
+		switch opt.Name {
+		case "charset", "collate":
+			opt.String = strings.ToLower(opt.String)
+		}
+	}
+}
+
+// tableOption returns the option with the given (normalized) name, or nil.
+func (c *CreateTableEntity) tableOption(name string) *sqlparser.TableOption {
+	var result *sqlparser.TableOption
+	for _, opt := range c.TableSpec.Options {
+		if opt.Name == name {
+			// The last occurrence wins, as in MySQL.
+			result = opt
+		}
+	}
+	return result
+}
+
+func (c *CreateTableEntity) normalizeColumns() {
+	var charset, collate string
+	if opt := c.tableOption("charset"); opt != nil {
+		charset = opt.String
+	}
+	if opt := c.tableOption("collate"); opt != nil {
+		collate = opt.String
+	}
+
+	// Keys declared along with a column are reported as separate keys.
+	var columnKeys []*sqlparser.IndexDefinition
+	for _, col := range c.TableSpec.Columns {
+		normalizeColumnType(&col.Type)
+		if col.Type.Charset == charset {
+			col.Type.Charset = ""
+		}
+		if col.Type.Collate == collate {
+			col.Type.Collate = ""
+		}
+
+		if col.Type.Options == nil {
+			col.Type.Options = &sqlparser.ColumnTypeOptions{}
+		}
+		opts := col.Type.Options
+		if opts.Null != nil && *opts.Null {
+			opts.Null = nil
+		}
+		if _, ok := opts.Default.(*sqlparser.NullVal); ok && opts.Null == nil {
+			opts.Default = nil
+		}
+		// InnoDB ignores REFERENCES clauses on columns.
+		opts.Reference = nil
+
+		var info *sqlparser.IndexInfo
+		switch opts.KeyOpt {
+		case sqlparser.ColKeyPrimary, sqlparser.ColKey:
+			info = &sqlparser.IndexInfo{Primary: true, Unique: true}
+		case sqlparser.ColKeyUnique, sqlparser.ColKeyUniqueKey:
+			info = &sqlparser.IndexInfo{Unique: true}
+		case sqlparser.ColKeySpatialKey:
+			info = &sqlparser.IndexInfo{Spatial: true}
+		case sqlparser.ColKeyFulltextKey:
+			info = &sqlparser.IndexInfo{Fulltext: true}
+		}
+		if info != nil {
+			columnKeys = append(columnKeys, &sqlparser.IndexDefinition{
+				Info:    info,
+				Columns: []*sqlparser.IndexColumn{{Column: col.Name}},
+			})
+		}
+		opts.KeyOpt = sqlparser.ColKeyNone
+	}
+	c.TableSpec.Indexes = append(columnKeys, c.TableSpec.Indexes...)
+
+	// Primary key columns are implicitly NOT NULL.
+	for _, idx := range c.TableSpec.Indexes {
+		if !idx.Info.Primary {
+			continue
+		}
+		for _, idxCol := range idx.Columns {
+			if col := c.column(idxCol.Column); col != nil {
+				notNull := false
+				col.Type.Options.Null = &notNull
+			}
+		}
+	}
+}
+
+// column returns the column with the given name, or nil.
+func (c *CreateTableEntity) column(name sqlparser.ColIdent) *sqlparser.ColumnDefinition {
+	for _, col := range c.TableSpec.Columns {
+		if col.Name.Equal(name) {
+			return col
+		}
+	}
+	return nil
+}
+
+func normalizeColumnType(ct *sqlparser.ColumnType) {
+	ct.Type = strings.ToLower(ct.Type)
+	switch ct.Type {
+	case "integer":
+		ct.Type = "int"
+	case "bool", "boolean":
+		ct.Type = "tinyint"
+		ct.Length = sqlparser.NewIntLiteral("1")
+	case "dec", "numeric", "fixed":
+		ct.Type = "decimal"
+	case "real":
+		ct.Type = "double"
+	}
+
+	switch ct.Type {
+	case "tinyint", "smallint", "mediumint", "int", "bigint":
+		if ct.Zerofill {
+			ct.Unsigned = true
+		}
+		// Display widths are not reported since MySQL 8.0.19, except along
+		// with ZEROFILL and for TINYINT(1).
+		if ct.Length != nil && !ct.Zerofill && !(ct.Type == "tinyint" && ct.Length.Val == "1") {
+			ct.Length = nil
+		}
+	case "decimal":
+		if ct.Length == nil {
+			ct.Length = sqlparser.NewIntLiteral("10")
+		}
+		if ct.Scale == nil {
+			ct.Scale = sqlparser.NewIntLiteral("0")
+		}
+	}
+	ct.Charset = strings.ToLower(ct.Charset)
+	ct.Collate = strings.ToLower(ct.Collate)
+}
+
+// addForeignKeyIndexes adds the index MySQL implicitly creates for a foreign
+// key, unless an existing index can be used for it.
+func (c *CreateTableEntity) addForeignKeyIndexes() {
+	for _, cd := range c.TableSpec.Constraints {
+		fk, ok := cd.Details.(*sqlparser.ForeignKeyDefinition)
+		if !ok {
+			continue
+		}
+		if c.hasIndexPrefix(fk.Source) {
+			continue
+		}
+		name := cd.Name
+		if name.IsEmpty() {
+			name = fk.IndexName
+		}
+		idx := &sqlparser.IndexDefinition{Info: &sqlparser.IndexInfo{Name: name}}
+		for _, col := range fk.Source {
+			idx.Columns = append(idx.Columns, &sqlparser.IndexColumn{Column: col})
+		}
+		c.TableSpec.Indexes = append(c.TableSpec.Indexes, idx)
+	}
+}
+
+// hasIndexPrefix returns true if some index starts with the given columns.
+func (c *CreateTableEntity) hasIndexPrefix(cols sqlparser.Columns) bool {
+	for _, idx := range c.TableSpec.Indexes {
+		if len(idx.Columns) < len(cols) {
+			continue
+		}
+		match := true
+		for i, col := range cols {
+			if !idx.Columns[i].Column.Equal(col) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CreateTableEntity) normalizeIndexes() {
+	names := map[string]bool{}
+	for _, idx := range c.TableSpec.Indexes {
+		info := idx.Info
+		switch {
+		case info.Primary:
+			info.Type = "primary key"
+			info.Name = sqlparser.NewColIdent("PRIMARY")
+		case info.Unique:
+			info.Type = "unique key"
+		case info.Spatial:
+			info.Type = "spatial key"
+		case info.Fulltext:
+			info.Type = "fulltext key"
+		default:
+			info.Type = "key"
+		}
+		// The name of the constraint is the name of the index, unless both are given.
+		if info.Name.IsEmpty() {
+			info.Name = info.ConstraintName
+		}
+		info.ConstraintName = sqlparser.NewColIdent("")
+		for _, opt := range idx.Options {
+			opt.Name = strings.ToLower(opt.Name)
+		}
+		if !info.Name.IsEmpty() {
+			names[info.Name.Lowered()] = true
+		}
+	}
+
+	// Unnamed keys are named after their first column.
+	for _, idx := range c.TableSpec.Indexes {
+		if !idx.Info.Name.IsEmpty() || len(idx.Columns) == 0 {
+			continue
+		}
+		base := idx.Columns[0].Column.String()
+		name := base
+		for i := 2; names[strings.ToLower(name)]; i++ {
+			name = fmt.Sprintf("%s_%d", base, i)
+		}
+		names[strings.ToLower(name)] = true
+		idx.Info.Name = sqlparser.NewColIdent(name)
+	}
+}
+
+func (c *CreateTableEntity) normalizeConstraints() {
+	var foreignKeys, checks int
+	for _, cd := range c.TableSpec.Constraints {
+		switch details := cd.Details.(type) {
+		case *sqlparser.ForeignKeyDefinition:
+			if cd.Name.IsEmpty() {
+				foreignKeys++
+				cd.Name = sqlparser.NewColIdent(fmt.Sprintf("%s_ibfk_%d", c.Name(), foreignKeys))
+			}
+			// The index name only matters for the implicit index, see addForeignKeyIndexes.
+			details.IndexName = sqlparser.NewColIdent("")
+			if ref := details.ReferenceDefinition; ref != nil {
+				ref.OnDelete = normalizeReferenceAction(ref.OnDelete)
+				ref.OnUpdate = normalizeReferenceAction(ref.OnUpdate)
+			}
+		case *sqlparser.CheckConstraintDefinition:
+			if cd.Name.IsEmpty() {
+				checks++
+				cd.Name = sqlparser.NewColIdent(fmt.Sprintf("%s_chk_%d", c.Name(), checks))
+			}
+		}
+	}
+}
+
+// normalizeReferenceAction returns the default action for RESTRICT and NO
+// ACTION, which are the same in InnoDB.
+func normalizeReferenceAction(action sqlparser.ReferenceAction) sqlparser.ReferenceAction {
+	switch action {
+	case sqlparser.Restrict, sqlparser.NoAction:
+		return sqlparser.DefaultAction
+	}
+	return action
+}
+
+func (c *CreateTableEntity) normalizePartitions() {
+	if c.TableSpec.Partitions == nil {
+		return
+	}
+	// Partitions always use the engine of the table.
+	for _, def := range c.TableSpec.Partitions.Definitions {
+		def.Engine = ""
+	}
+}
+
+// diffTableOptions returns the table options which differ in the other table.
+// An option the other table does not have is reset to its default value, when
+// there is one.
+func (c *CreateTableEntity) diffTableOptions(other *CreateTableEntity, hints *DiffHints) sqlparser.TableOptions {
+	var result sqlparser.TableOptions
+	for _, to := range other.TableSpec.Options {
+		if other.tableOption(to.Name) != to {
+			// Only the last occurrence of an option counts.
+			continue
+		}
+		from := c.tableOption(to.Name)
+		if to.Name == "auto_increment" {
+			if autoIncrementChanged(from, to, hints) {
+				result = append(result, to)
+			}
+			continue
+		}
+		if from != nil && tableOptionsEqual(from, to) {
+			continue
+		}
+		result = append(result, to)
+	}
+	for _, from := range c.TableSpec.Options {
+		if c.tableOption(from.Name) != from || other.tableOption(from.Name) != nil {
+			continue
+		}
+		if opt := defaultTableOption(from.Name); opt != nil {
+			result = append(result, opt)
+		}
+	}
+	return result
+}
+
+func tableOptionsEqual(a, b *sqlparser.TableOption) bool {
+	if a.String != "" || b.String != "" {
+		return strings.EqualFold(a.String, b.String)
+	}
+	return sqlparser.EqualsRefOfLiteral(a.Value, b.Value) && sqlparser.EqualsTableNames(a.Tables, b.Tables)
+}
+
+func autoIncrementChanged(from, to *sqlparser.TableOption, hints *DiffHints) bool {
+	switch hints.AutoIncrementStrategy {
+	case AutoIncrementApplyAlways:
+		return from == nil || !tableOptionsEqual(from, to)
+	case AutoIncrementApplyHigher:
+		// SHOW CREATE TABLE does not report the initial value.
+		fromValue := uint64(1)
+		if from != nil && from.Value != nil {
+			fromValue, _ = strconv.ParseUint(from.Value.Val, 10, 64)
+		}
+		toValue, err := strconv.ParseUint(to.Value.Val, 10, 64)
+		return err == nil && toValue > fromValue
+	}
+	return false
+}
+
+// defaultTableOption returns the table option which resets the given one to
+// its default value, or nil if there is none.
+func defaultTableOption(name string) *sqlparser.TableOption {
+	switch name {
+	case "comment":
+		return &sqlparser.TableOption{Name: name, Value: sqlparser.NewStrLiteral("")}
+	case "avg_row_length", "checksum", "delay_key_write", "key_block_size", "max_rows", "min_rows":
+		return &sqlparser.TableOption{Name: name, Value: sqlparser.NewIntLiteral("0")}
+	case "pack_keys", "row_format", "stats_auto_recalc", "stats_persistent", "stats_sample_pages":
+		return &sqlparser.TableOption{Name: name, String: "DEFAULT"}
+	}
+	return nil
+}
+
+// diffColumns returns the columns to drop, and the columns to add or modify,
+// in the order they must be applied so that columns end up in the order of
+// the other table.
+func (c *CreateTableEntity) diffColumns(other *CreateTableEntity) (drops, changes []sqlparser.AlterOption) {
+	fromColumns := map[string]*sqlparser.ColumnDefinition{}
+	for _, col := range c.TableSpec.Columns {
+		fromColumns[col.Name.Lowered()] = col
+	}
+	toColumns := map[string]*sqlparser.ColumnDefinition{}
+	for _, col := range other.TableSpec.Columns {
+		toColumns[col.Name.Lowered()] = col
+	}
+
+	// fromPositions maps the columns both tables have to their position in
+	// this table.
+	fromPositions := map[string]int{}
+	for _, col := range c.TableSpec.Columns {
+		if _, ok := toColumns[col.Name.Lowered()]; !ok {
+			drops = append(drops, &sqlparser.DropColumn{Name: &sqlparser.ColName{Name: col.Name}})
+			continue
+		}
+		fromPositions[col.Name.Lowered()] = len(fromPositions)
+	}
+
+	// Columns which are in the same relative order in both tables stay in
+	// place, all others are moved.
+	var positions []int
+	lastExisting := -1
+	for i, col := range other.TableSpec.Columns {
+		if pos, ok := fromPositions[col.Name.Lowered()]; ok {
+			positions = append(positions, pos)
+			lastExisting = i
+		}
+	}
+	inPlace := longestIncreasingSubsequence(positions)
+
+	for i, col := range other.TableSpec.Columns {
+		var first, after *sqlparser.ColName
+		if i == 0 {
+			// A plain FIRST, the only form MySQL accepts.
+			first = &sqlparser.ColName{}
+		} else {
+			after = &sqlparser.ColName{Name: other.TableSpec.Columns[i-1].Name}
+		}
+
+		fromCol, ok := fromColumns[col.Name.Lowered()]
+		if !ok {
+			addColumn := &sqlparser.AddColumns{Columns: []*sqlparser.ColumnDefinition{col}}
+			if i < lastExisting {
+				// New columns are added last, unless an existing column follows.
+				addColumn.First = first
+				addColumn.After = after
+			}
+			changes = append(changes, addColumn)
+			continue
+		}
+
+		moved := !inPlace[fromPositions[col.Name.Lowered()]]
+		if !moved && sqlparser.String(&fromCol.Type) == sqlparser.String(&col.Type) {
+			continue
+		}
+		modifyColumn := &sqlparser.ModifyColumn{NewColDefinition: col}
+		if moved {
+			modifyColumn.First = first
+			modifyColumn.After = after
+		}
+		changes = append(changes, modifyColumn)
+	}
+	return drops, changes
+}
+
+// longestIncreasingSubsequence returns the values of a longest increasing
+// subsequence of the given distinct values.
+func longestIncreasingSubsequence(values []int) map[int]bool {
+	// lengths[i] is the length of the longest increasing subsequence which
+	// ends with values[i], and previous[i] the index of the value before it.
+	lengths := make([]int, len(values))
+	previous := make([]int, len(values))
+	best := -1
+	for i := range values {
+		lengths[i] = 1
+		previous[i] = -1
+		for j := 0; j < i; j++ {
+			if values[j] < values[i] && lengths[j]+1 > lengths[i] {
+				lengths[i] = lengths[j] + 1
+				previous[i] = j
+			}
+		}
+		if best == -1 || lengths[i] > lengths[best] {
+			best = i
+		}
+	}
+
+	result := map[int]bool{}
+	for i := best; i >= 0; i = previous[i] {
+		result[values[i]] = true
+	}
+	return result
+}
+
+// diffIndexes returns the indexes to drop and the indexes to add. An index
+// which differs is dropped, then added again.
+func (c *CreateTableEntity) diffIndexes(other *CreateTableEntity) (drops, adds []sqlparser.AlterOption) {
+	fromIndexes := map[string]*sqlparser.IndexDefinition{}
+	for _, idx := range c.TableSpec.Indexes {
+		fromIndexes[idx.Info.Name.Lowered()] = idx
+	}
+	toIndexes := map[string]*sqlparser.IndexDefinition{}
+	for _, idx := range other.TableSpec.Indexes {
+		toIndexes[idx.Info.Name.Lowered()] = idx
+	}
+
+	for _, idx := range c.TableSpec.Indexes {
+		if _, ok := toIndexes[idx.Info.Name.Lowered()]; !ok {
+			drops = append(drops, dropIndex(idx))
+		}
+	}
+	for _, idx := range other.TableSpec.Indexes {
+		fromIdx, ok := fromIndexes[idx.Info.Name.Lowered()]
+		if ok && sqlparser.String(fromIdx) == sqlparser.String(idx) {
+			continue
+		}
+		if ok {
+			drops = append(drops, dropIndex(fromIdx))
+		}
+		adds = append(adds, &sqlparser.AddIndexDefinition{IndexDefinition: idx})
+	}
+	return drops, adds
+}
+
+func dropIndex(idx *sqlparser.IndexDefinition) *sqlparser.DropKey {
+	if idx.Info.Primary {
+		return &sqlparser.DropKey{Type: sqlparser.PrimaryKeyType}
+	}
+	return &sqlparser.DropKey{Type: sqlparser.NormalKeyType, Name: idx.Info.Name}
+}
+
+// diffConstraints returns the foreign key and check constraints to drop and
+// the ones to add. A constraint which differs is dropped, then added again.
+func (c *CreateTableEntity) diffConstraints(other *CreateTableEntity) (drops, adds []sqlparser.AlterOption) {
+	fromConstraints := map[string]*sqlparser.ConstraintDefinition{}
+	for _, cd := range c.TableSpec.Constraints {
+		fromConstraints[cd.Name.Lowered()] = cd
+	}
+	toConstraints := map[string]*sqlparser.ConstraintDefinition{}
+	for _, cd := range other.TableSpec.Constraints {
+		toConstraints[cd.Name.Lowered()] = cd
+	}
+
+	for _, cd := range c.TableSpec.Constraints {
+		if _, ok := toConstraints[cd.Name.Lowered()]; !ok {
+			drops = append(drops, dropConstraint(cd))
+		}
+	}
+	for _, cd := range other.TableSpec.Constraints {
+		fromCd, ok := fromConstraints[cd.Name.Lowered()]
+		if ok && sqlparser.String(fromCd) == sqlparser.String(cd) {
+			continue
+		}
+		if ok {
+			drops = append(drops, dropConstraint(fromCd))
+		}
+		adds = append(adds, &sqlparser.AddConstraintDefinition{ConstraintDefinition: cd})
+	}
+	return drops, adds
+}
+
+func dropConstraint(cd *sqlparser.ConstraintDefinition) *sqlparser.DropKey {
+	if _, ok := cd.Details.(*sqlparser.CheckConstraintDefinition); ok {
+		return &sqlparser.DropKey{Type: sqlparser.CheckKeyType, Name: cd.Name}
+	}
+	return &sqlparser.DropKey{Type: sqlparser.ForeignKeyType, Name: cd.Name}
+}
+
+// diffPartitions returns the partitioning change, if any. Partitions of a
+// RANGE partitioned table are added or dropped when possible, otherwise the
+// table is partitioned again. MySQL does not allow adding or dropping
+// partitions along with other changes.
+func (c *CreateTableEntity) diffPartitions(other *CreateTableEntity, otherChanges bool) (*sqlparser.PartitionSpec, *sqlparser.PartitionOption) {
+	from, to := c.TableSpec.Partitions, other.TableSpec.Partitions
+	switch {
+	case from == nil && to == nil:
+		return nil, nil
+	case to == nil:
+		return &sqlparser.PartitionSpec{Action: sqlparser.RemoveAction}, nil
+	case from == nil:
+		return nil, to
+	case sqlparser.String(from) == sqlparser.String(to):
+		return nil, nil
+	}
+
+	if !otherChanges && from.Type == sqlparser.RangePartitionType && partitionScheme(from) == partitionScheme(to) {
+		if spec := diffRangePartitions(from.Definitions, to.Definitions); spec != nil {
+			return spec, nil
+		}
+	}
+	return nil, to
+}
+
+// partitionScheme returns the PARTITION BY clause without the partition definitions.
+func partitionScheme(p *sqlparser.PartitionOption) string {
+	scheme := *p
+	scheme.Definitions = nil
+	return sqlparser.String(&scheme)
+}
+
+// diffRangePartitions returns the partitions to drop from the start, or to add
+// at the end, or nil if the change is something else.
+func diffRangePartitions(from, to []*sqlparser.PartitionDefinition) *sqlparser.PartitionSpec {
+	definitionsEqual := func(a, b []*sqlparser.PartitionDefinition) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if sqlparser.String(a[i]) != sqlparser.String(b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch {
+	case len(to) < len(from):
+		dropped := len(from) - len(to)
+		if len(to) == 0 || !definitionsEqual(from[dropped:], to) {
+			return nil
+		}
+		spec := &sqlparser.PartitionSpec{Action: sqlparser.DropAction}
+		for _, def := range from[:dropped] {
+			spec.Names = append(spec.Names, def.Name)
+		}
+		return spec
+	case len(to) > len(from):
+		if !definitionsEqual(from, to[:len(from)]) {
+			return nil
+		}
+		return &sqlparser.PartitionSpec{Action: sqlparser.AddAction, Definitions: to[len(from):]}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestCreateTableDiff(t *testing.T) {
+	tt := []struct {
+		name     string
+		from     string
+		to       string
+		diff     string
+		autoinc  int
+		isError  bool
+		errorMsg string
+	}{
+		{
+			name: "identical",
+			from: "create table t (id int primary key)",
+			to:   "create table t (id int primary key)",
+		},
+		{
+			name: "identical, different table name",
+			from: "create table t1 (id int primary key)",
+			to:   "create table t2 (id int primary key)",
+		},
+		{
+			name: "identical, equivalent syntax",
+			from: "create table t (id int primary key, i integer(11) default null, b bool, key (i)) engine=InnoDB character set utf8mb4",
+			to:   "CREATE TABLE `t` (\n  `id` int NOT NULL,\n  `i` int DEFAULT NULL,\n  `b` tinyint(1),\n  PRIMARY KEY (`id`),\n  KEY `i` (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		},
+		{
+			name: "identical, explicit table charset on column",
+			from: "create table t (id int primary key, v varchar(32)) charset utf8mb4",
+			to:   "create table t (id int primary key, v varchar(32) character set utf8mb4) default charset=utf8mb4",
+		},
+		{
+			name: "identical, implicit foreign key index",
+			from: "create table t (id int primary key, parent_id int, foreign key (parent_id) references parent (id) on delete restrict)",
+			to:   "create table t (id int primary key, parent_id int, key parent_id (parent_id), constraint t_ibfk_1 foreign key (parent_id) references parent (id))",
+		},
+		{
+			name: "added column",
+			from: "create table t (id int primary key)",
+			to:   "create table t (id int primary key, i int)",
+			diff: "alter table t add column i int",
+		},
+		{
+			name: "added column in the middle",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int primary key, j int, i int)",
+			diff: "alter table t add column j int after id",
+		},
+		{
+			name: "added first column",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (j int, id int primary key, i int)",
+			diff: "alter table t add column j int first",
+		},
+		{
+			name: "dropped column",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int primary key)",
+			diff: "alter table t drop column i",
+		},
+		{
+			name: "modified column",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int primary key, i bigint not null)",
+			diff: "alter table t modify column i bigint not null",
+		},
+		{
+			name: "reordered columns",
+			from: "create table t (id int primary key, i int, j int, k int)",
+			to:   "create table t (id int primary key, k int, i int, j int)",
+			diff: "alter table t modify column k int after id",
+		},
+		{
+			name: "added key",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int primary key, i int, key i_idx (i))",
+			diff: "alter table t add key i_idx (i)",
+		},
+		{
+			name: "dropped key",
+			from: "create table t (id int primary key, i int, key i_idx (i))",
+			to:   "create table t (id int primary key, i int)",
+			diff: "alter table t drop key i_idx",
+		},
+		{
+			name: "changed key",
+			from: "create table t (id int primary key, i int, j int, key i_idx (i))",
+			to:   "create table t (id int primary key, i int, j int, unique key i_idx (i, j))",
+			diff: "alter table t drop key i_idx, add unique key i_idx (i, j)",
+		},
+		{
+			name: "changed primary key",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int, i int, primary key (id, i))",
+			diff: "alter table t drop primary key, modify column i int not null, add primary key (id, i)",
+		},
+		{
+			name: "added foreign key",
+			from: "create table t (id int primary key, parent_id int, key parent_idx (parent_id))",
+			to:   "create table t (id int primary key, parent_id int, key parent_idx (parent_id), constraint fk_parent foreign key (parent_id) references parent (id) on delete cascade)",
+			diff: "alter table t add constraint fk_parent foreign key (parent_id) references parent (id) on delete cascade",
+		},
+		{
+			name: "dropped foreign key, same index",
+			from: "create table t (id int primary key, parent_id int, constraint fk_parent foreign key (parent_id) references parent (id))",
+			to:   "create table t (id int primary key, parent_id int, key fk_parent (parent_id))",
+			diff: "alter table t drop foreign key fk_parent",
+		},
+		{
+			name: "dropped check constraint",
+			from: "create table t (id int primary key, i int, constraint chk_i check (i > 0))",
+			to:   "create table t (id int primary key, i int)",
+			diff: "alter table t drop check chk_i",
+		},
+		{
+			name: "changed table options",
+			from: "create table t (id int primary key) engine=InnoDB, character set=utf8mb4, comment='hello'",
+			to:   "create table t (id int primary key) engine=InnoDB, charset=utf8, row_format=compressed",
+			diff: "alter table t charset utf8 row_format compressed comment ''",
+		},
+		{
+			name: "auto_increment ignored",
+			from: "create table t (id int primary key) auto_increment=100",
+			to:   "create table t (id int primary key) auto_increment=300",
+		},
+		{
+			name:    "auto_increment applied when higher",
+			from:    "create table t (id int primary key) auto_increment=100",
+			to:      "create table t (id int primary key) auto_increment=300",
+			diff:    "alter table t auto_increment 300",
+			autoinc: AutoIncrementApplyHigher,
+		},
+		{
+			name:    "auto_increment not applied when lower",
+			from:    "create table t (id int primary key) auto_increment=300",
+			to:      "create table t (id int primary key) auto_increment=100",
+			autoinc: AutoIncrementApplyHigher,
+		},
+		{
+			name:    "auto_increment always applied",
+			from:    "create table t (id int primary key) auto_increment=300",
+			to:      "create table t (id int primary key) auto_increment=100",
+			diff:    "alter table t auto_increment 100",
+			autoinc: AutoIncrementApplyAlways,
+		},
+		{
+			name: "added partitioning",
+			from: "create table t (id int primary key)",
+			to:   "create table t (id int primary key) partition by hash (id) partitions 4",
+			diff: "alter table t partition by hash (id) partitions 4",
+		},
+		{
+			name: "removed partitioning",
+			from: "create table t (id int primary key) partition by hash (id) partitions 4",
+			to:   "create table t (id int primary key)",
+			diff: "alter table t remove partitioning",
+		},
+		{
+			name: "appended range partition",
+			from: "create table t (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20))",
+			to:   "create table t (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20), partition p3 values less than (30))",
+			diff: "alter table t add partition (partition p3 values less than (30))",
+		},
+		{
+			name: "rotated out range partition",
+			from: "create table t (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20))",
+			to:   "create table t (id int primary key) partition by range (id) (partition p2 values less than (20))",
+			diff: "alter table t drop partition p1",
+		},
+		{
+			name: "repartitioned",
+			from: "create table t (id int primary key) partition by hash (id) partitions 4",
+			to:   "create table t (id int primary key) partition by hash (id) partitions 8",
+			diff: "alter table t partition by hash (id) partitions 8",
+		},
+		{
+			name:     "not a create table",
+			from:     "create table t (id int primary key)",
+			to:       "create view v as select 1 from dual",
+			isError:  true,
+			errorMsg: ErrExpectedCreateTable.Error(),
+		},
+		{
+			name:     "create table like",
+			from:     "create table t (id int primary key)",
+			to:       "create table t2 like t",
+			isError:  true,
+			errorMsg: ErrCreateTableLike.Error(),
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			hints := &DiffHints{AutoIncrementStrategy: ts.autoinc}
+			alterTable, err := DiffCreateTablesQueries(ts.from, ts.to, hints)
+			if ts.isError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), ts.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			if ts.diff == "" {
+				if alterTable != nil {
+					assert.Failf(t, "unexpected diff", "%v", sqlparser.String(alterTable))
+				}
+				return
+			}
+			require.NotNil(t, alterTable)
+			diff := sqlparser.String(alterTable)
+			assert.Equal(t, ts.diff, diff)
+
+			// The diff must be valid SQL
+			_, err = sqlparser.ParseStrictDDL(diff)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLongestIncreasingSubsequence(t *testing.T) {
+	tt := []struct {
+		values []int
+		want   []int
+	}{
+		{values: nil, want: nil},
+		{values: []int{0, 1, 2, 3}, want: []int{0, 1, 2, 3}},
+		{values: []int{0, 3, 1, 2}, want: []int{0, 1, 2}},
+		{values: []int{3, 2, 1, 0}, want: []int{3}},
+	}
+	for _, ts := range tt {
+		lis := longestIncreasingSubsequence(ts.values)
+		assert.Equal(t, len(ts.want), len(lis), "values: %v", ts.values)
+		for _, v := range ts.want {
+			assert.True(t, lis[v], "values: %v, missing %d", ts.values, v)
+		}
+	}
+}
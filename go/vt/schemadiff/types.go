@@ -0,0 +1,31 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+const (
+	// AutoIncrementIgnore never generates an AUTO_INCREMENT table option
+	AutoIncrementIgnore int = iota
+	// AutoIncrementApplyHigher generates an AUTO_INCREMENT table option only when the target value is higher than the source one
+	AutoIncrementApplyHigher
+	// AutoIncrementApplyAlways generates an AUTO_INCREMENT table option whenever the target value differs from the source one
+	AutoIncrementApplyAlways
+)
+
+// DiffHints tune the way a diff is generated
+type DiffHints struct {
+	AutoIncrementStrategy int
+}
@@ -74,6 +74,9 @@ type ExecuteResult struct {
 	Sqls           []string
 	ExecutorErr    string
 	TotalTimeSpent time.Duration
+	// UUIDs are the online DDL migration UUIDs submitted by the executor, if
+	// any.
+	UUIDs []string `json:",omitempty"`
 }
 
 // ShardWithError contains information why a shard failed to execute given sql
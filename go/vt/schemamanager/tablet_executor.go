@@ -24,18 +24,22 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/logutil"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
-	"vitess.io/vitess/go/vt/wrangler"
+	"vitess.io/vitess/go/vt/vtctl/schematools"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 )
 
 // TabletExecutor applies schema changes to all tablets.
 type TabletExecutor struct {
 	requestContext       string
-	wr                   *wrangler.Wrangler
+	ts                   *topo.Server
+	tmc                  tmclient.TabletManagerClient
+	logger               logutil.Logger
 	tablets              []*topodatapb.Tablet
 	isClosed             bool
 	allowBigSchemaChange bool
@@ -46,9 +50,11 @@ type TabletExecutor struct {
 }
 
 // NewTabletExecutor creates a new TabletExecutor instance
-func NewTabletExecutor(requestContext string, wr *wrangler.Wrangler, waitReplicasTimeout time.Duration) *TabletExecutor {
+func NewTabletExecutor(requestContext string, ts *topo.Server, tmc tmclient.TabletManagerClient, logger logutil.Logger, waitReplicasTimeout time.Duration) *TabletExecutor {
 	return &TabletExecutor{
-		wr:                   wr,
+		ts:                   ts,
+		tmc:                  tmc,
+		logger:               logger,
 		isClosed:             true,
 		allowBigSchemaChange: false,
 		waitReplicasTimeout:  waitReplicasTimeout,
@@ -89,20 +95,20 @@ func (exec *TabletExecutor) Open(ctx context.Context, keyspace string) error {
 		return nil
 	}
 	exec.keyspace = keyspace
-	shardNames, err := exec.wr.TopoServer().GetShardNames(ctx, keyspace)
+	shardNames, err := exec.ts.GetShardNames(ctx, keyspace)
 	if err != nil {
 		return fmt.Errorf("unable to get shard names for keyspace: %s, error: %v", keyspace, err)
 	}
 	exec.tablets = make([]*topodatapb.Tablet, len(shardNames))
 	for i, shardName := range shardNames {
-		shardInfo, err := exec.wr.TopoServer().GetShard(ctx, keyspace, shardName)
+		shardInfo, err := exec.ts.GetShard(ctx, keyspace, shardName)
 		if err != nil {
 			return fmt.Errorf("unable to get shard info, keyspace: %s, shard: %s, error: %v", keyspace, shardName, err)
 		}
 		if !shardInfo.HasMaster() {
 			return fmt.Errorf("shard: %s does not have a master", shardName)
 		}
-		tabletInfo, err := exec.wr.TopoServer().GetTablet(ctx, shardInfo.MasterAlias)
+		tabletInfo, err := exec.ts.GetTablet(ctx, shardInfo.MasterAlias)
 		if err != nil {
 			return fmt.Errorf("unable to get master tablet info, keyspace: %s, shard: %s, error: %v", keyspace, shardName, err)
 		}
@@ -131,7 +137,7 @@ func (exec *TabletExecutor) Validate(ctx context.Context, sqls []string) error {
 
 	bigSchemaChange, err := exec.detectBigSchemaChanges(ctx, parsedDDLs)
 	if bigSchemaChange && exec.allowBigSchemaChange {
-		exec.wr.Logger().Warningf("Processing big schema change. This may cause visible MySQL downtime.")
+		exec.logger.Warningf("Processing big schema change. This may cause visible MySQL downtime.")
 		return nil
 	}
 	return err
@@ -191,7 +197,7 @@ func (exec *TabletExecutor) detectBigSchemaChanges(ctx context.Context, parsedDD
 	// Otherwise, Open should fail and executor should fail.
 	masterTabletInfo := exec.tablets[0]
 	// get database schema, excluding views.
-	dbSchema, err := exec.wr.TabletManagerClient().GetSchema(
+	dbSchema, err := exec.tmc.GetSchema(
 		ctx, masterTabletInfo, []string{}, []string{}, false)
 	if err != nil {
 		return false, fmt.Errorf("unable to get database schema, error: %v", err)
@@ -228,7 +234,7 @@ func (exec *TabletExecutor) preflightSchemaChanges(ctx context.Context, sqls []s
 	if exec.skipPreflight {
 		return nil
 	}
-	_, err := exec.wr.TabletManagerClient().PreflightSchema(ctx, exec.tablets[0], sqls)
+	_, err := exec.tmc.PreflightSchema(ctx, exec.tablets[0], sqls)
 	return err
 }
 
@@ -251,7 +257,8 @@ func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, execResu
 				if exec.ddlStrategySetting.IsSkipTopo() {
 					exec.executeOnAllTablets(ctx, execResult, onlineDDL.SQL, true)
 					if len(execResult.SuccessShards) > 0 {
-						exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
+						execResult.UUIDs = append(execResult.UUIDs, onlineDDL.UUID)
+						exec.logger.Printf("%s\n", onlineDDL.UUID)
 					}
 				} else {
 					exec.executeOnlineDDL(ctx, execResult, onlineDDL)
@@ -268,13 +275,14 @@ func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, execResu
 		}
 		if exec.ddlStrategySetting.IsSkipTopo() {
 			exec.executeOnAllTablets(ctx, execResult, onlineDDL.SQL, true)
-			exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
+			execResult.UUIDs = append(execResult.UUIDs, onlineDDL.UUID)
+			exec.logger.Printf("%s\n", onlineDDL.UUID)
 		} else {
 			exec.executeOnlineDDL(ctx, execResult, onlineDDL)
 		}
 		return nil
 	}
-	exec.wr.Logger().Infof("Received DDL request. strategy=%+v", schema.DDLStrategyDirect)
+	exec.logger.Infof("Received DDL request. strategy=%+v", schema.DDLStrategyDirect)
 	exec.executeOnAllTablets(ctx, execResult, sql, false)
 	return nil
 }
@@ -292,7 +300,7 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 
 	// Lock the keyspace so our schema change doesn't overlap with other
 	// keyspace-wide operations like resharding migrations.
-	ctx, unlock, lockErr := exec.wr.TopoServer().LockKeyspace(ctx, exec.keyspace, "ApplySchemaKeyspace")
+	ctx, unlock, lockErr := exec.ts.LockKeyspace(ctx, exec.keyspace, "ApplySchemaKeyspace")
 	if lockErr != nil {
 		execResult.ExecutorErr = lockErr.Error()
 		return &execResult
@@ -334,7 +342,7 @@ func (exec *TabletExecutor) executeOnlineDDL(
 		execResult.ExecutorErr = "Not an online DDL strategy"
 		return
 	}
-	conn, err := exec.wr.TopoServer().ConnForCell(ctx, topo.GlobalCell)
+	conn, err := exec.ts.ConnForCell(ctx, topo.GlobalCell)
 	if err != nil {
 		execResult.ExecutorErr = fmt.Sprintf("online DDL ConnForCell error:%s", err.Error())
 		return
@@ -342,9 +350,11 @@ func (exec *TabletExecutor) executeOnlineDDL(
 	err = onlineDDL.WriteTopo(ctx, conn, schema.MigrationRequestsPath())
 	if err != nil {
 		execResult.ExecutorErr = err.Error()
+	} else {
+		execResult.UUIDs = append(execResult.UUIDs, onlineDDL.UUID)
 	}
-	exec.wr.Logger().Infof("UUID=%+v", onlineDDL.UUID)
-	exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
+	exec.logger.Infof("UUID=%+v", onlineDDL.UUID)
+	exec.logger.Printf("%s\n", onlineDDL.UUID)
 }
 
 // executeOnAllTablets runs a query on all tablets, synchronously. This can be a long running operation.
@@ -386,7 +396,7 @@ func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult
 		wg.Add(1)
 		go func(result ShardResult) {
 			defer wg.Done()
-			exec.wr.ReloadSchemaShard(reloadCtx, exec.keyspace, result.Shard, result.Position, concurrency, false /* includeMaster */)
+			schematools.ReloadShard(reloadCtx, exec.ts, exec.tmc, exec.logger, exec.keyspace, result.Shard, result.Position, concurrency, false /* includeMaster */)
 		}(result)
 	}
 	wg.Wait()
@@ -403,9 +413,9 @@ func (exec *TabletExecutor) executeOneTablet(
 	var result *querypb.QueryResult
 	var err error
 	if viaQueryService {
-		result, err = exec.wr.TabletManagerClient().ExecuteQuery(ctx, tablet, []byte(sql), 10)
+		result, err = exec.tmc.ExecuteQuery(ctx, tablet, []byte(sql), 10)
 	} else {
-		result, err = exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(sql), 10, false, true)
+		result, err = exec.tmc.ExecuteFetchAsDba(ctx, tablet, false, []byte(sql), 10, false, true)
 	}
 	if err != nil {
 		errChan <- ShardWithError{Shard: tablet.Shard, Err: err.Error()}
@@ -413,7 +423,7 @@ func (exec *TabletExecutor) executeOneTablet(
 	}
 	// Get a replication position that's guaranteed to be after the schema change
 	// was applied on the master.
-	pos, err := exec.wr.TabletManagerClient().MasterPosition(ctx, tablet)
+	pos, err := exec.tmc.MasterPosition(ctx, tablet)
 	if err != nil {
 		errChan <- ShardWithError{
 			Shard: tablet.Shard,
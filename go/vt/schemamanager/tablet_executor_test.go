@@ -30,7 +30,6 @@ import (
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
-	"vitess.io/vitess/go/vt/wrangler"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -57,7 +56,6 @@ func TestTabletExecutorOpen(t *testing.T) {
 func TestTabletExecutorOpenWithEmptyMasterAlias(t *testing.T) {
 	ctx := context.Background()
 	ts := memorytopo.NewServer("test_cell")
-	wr := wrangler.New(logutil.NewConsoleLogger(), ts, newFakeTabletManagerClient())
 	tablet := &topodatapb.Tablet{
 		Alias: &topodatapb.TabletAlias{
 			Cell: "test_cell",
@@ -67,12 +65,15 @@ func TestTabletExecutorOpenWithEmptyMasterAlias(t *testing.T) {
 		Shard:    "0",
 		Type:     topodatapb.TabletType_REPLICA,
 	}
-	// This will create the Keyspace, Shard and Tablet record.
+	// Create the Keyspace, Shard and Tablet record.
 	// Since this is a replica tablet, the Shard will have no master.
-	if err := wr.InitTablet(ctx, tablet, false /*allowMasterOverride*/, true /*createShardAndKeyspace*/, false /*allowUpdate*/); err != nil {
-		t.Fatalf("InitTablet failed: %v", err)
+	if _, err := ts.GetOrCreateShard(ctx, tablet.Keyspace, tablet.Shard); err != nil {
+		t.Fatalf("GetOrCreateShard failed: %v", err)
 	}
-	executor := NewTabletExecutor("TestTabletExecutorOpenWithEmptyMasterAlias", wr, testWaitReplicasTimeout)
+	if err := ts.CreateTablet(ctx, tablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	executor := NewTabletExecutor("TestTabletExecutorOpenWithEmptyMasterAlias", ts, newFakeTabletManagerClient(), logutil.NewConsoleLogger(), testWaitReplicasTimeout)
 	if err := executor.Open(ctx, "test_keyspace"); err == nil || !strings.Contains(err.Error(), "does not have a master") {
 		t.Fatalf("executor.Open() = '%v', want error", err)
 	}
@@ -105,8 +106,7 @@ func TestTabletExecutorValidate(t *testing.T) {
 		},
 	})
 
-	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
-	executor := NewTabletExecutor("TestTabletExecutorValidate", wr, testWaitReplicasTimeout)
+	executor := NewTabletExecutor("TestTabletExecutorValidate", newFakeTopo(t), fakeTmc, logutil.NewConsoleLogger(), testWaitReplicasTimeout)
 	ctx := context.Background()
 
 	sqls := []string{
@@ -195,8 +195,7 @@ func TestTabletExecutorDML(t *testing.T) {
 		},
 	})
 
-	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
-	executor := NewTabletExecutor("TestTabletExecutorDML", wr, testWaitReplicasTimeout)
+	executor := NewTabletExecutor("TestTabletExecutorDML", newFakeTopo(t), fakeTmc, logutil.NewConsoleLogger(), testWaitReplicasTimeout)
 	ctx := context.Background()
 
 	executor.Open(ctx, "unsharded_keyspace")
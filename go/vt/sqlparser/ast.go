@@ -101,7 +101,10 @@ type (
 		IndexDefinition *IndexDefinition
 	}
 
-	// AddColumns represents a ADD COLUMN alter option
+	// AddColumns represents a ADD COLUMN alter option.
+	// First is set for FIRST. Its name is empty for a plain FIRST, the only
+	// form MySQL accepts, and set for the legacy FIRST column form. The same
+	// holds for ChangeColumn and ModifyColumn.
 	AddColumns struct {
 		Columns []*ColumnDefinition
 		First   *ColName
@@ -206,6 +209,7 @@ type (
 		Comments    Comments
 		SelectExprs SelectExprs
 		Where       *Where
+		With        *With
 		GroupBy     GroupBy
 		Having      *Where
 		OrderBy     OrderBy
@@ -238,6 +242,7 @@ type (
 	}
 	// Union represents a UNION statement.
 	Union struct {
+		With           *With
 		FirstStatement SelectStatement
 		UnionSelects   []*UnionSelect
 		OrderBy        OrderBy
@@ -245,6 +250,19 @@ type (
 		Lock           Lock
 	}
 
+	// With represents the WITH clause of a SELECT or UNION statement.
+	With struct {
+		CTEs      []*CommonTableExpr
+		Recursive bool
+	}
+
+	// CommonTableExpr is a single named subquery of a WITH clause.
+	CommonTableExpr struct {
+		TableID  TableIdent
+		Columns  Columns
+		Subquery *Subquery
+	}
+
 	// VStream represents a VSTREAM statement.
 	VStream struct {
 		Comments   Comments
@@ -437,11 +455,12 @@ type (
 
 	// AlterTable represents a ALTER TABLE statement.
 	AlterTable struct {
-		Table         TableName
-		AlterOptions  []AlterOption
-		PartitionSpec *PartitionSpec
-		Comments      Comments
-		FullyParsed   bool
+		Table           TableName
+		AlterOptions    []AlterOption
+		PartitionSpec   *PartitionSpec
+		PartitionOption *PartitionOption
+		Comments        Comments
+		FullyParsed     bool
 	}
 
 	// DropTable represents a DROP TABLE statement.
@@ -1466,6 +1485,21 @@ type PartitionDefinition struct {
 	Name     ColIdent
 	Limit    Expr
 	Maxvalue bool
+	InValues Exprs
+	Engine   string
+}
+
+// PartitionByType is an enum for PartitionOption.Type
+type PartitionByType int8
+
+// PartitionOption describes the PARTITION BY clause of a CREATE TABLE or ALTER TABLE statement
+type PartitionOption struct {
+	Linear      bool
+	Type        PartitionByType
+	Expr        Expr
+	ColList     Columns
+	Partitions  *Literal
+	Definitions []*PartitionDefinition
 }
 
 // TableOptions specifies a list of table options
@@ -1477,6 +1511,7 @@ type TableSpec struct {
 	Indexes     []*IndexDefinition
 	Constraints []*ConstraintDefinition
 	Options     TableOptions
+	Partitions  *PartitionOption
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
@@ -1908,6 +1943,42 @@ type (
 		Limit     *Limit
 	}
 
+	// WindowFuncExpr represents a function evaluated over a window of rows,
+	// e.g. ROW_NUMBER() OVER (PARTITION BY a ORDER BY b). Aggregate functions
+	// followed by an OVER clause are also represented by this struct.
+	WindowFuncExpr struct {
+		Func *FuncExpr
+		Over *WindowSpec
+	}
+
+	// WindowSpec represents the window definition of an OVER clause.
+	WindowSpec struct {
+		PartitionBy Exprs
+		OrderBy     OrderBy
+		Frame       *FrameClause
+	}
+
+	// FrameClause represents the frame of a window, e.g.
+	// ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW.
+	// If End is nil, the frame ends at the current row.
+	FrameClause struct {
+		Unit  FrameUnit
+		Start *FramePoint
+		End   *FramePoint
+	}
+
+	// FramePoint represents one of the bounds of a window frame.
+	FramePoint struct {
+		Type FramePointType
+		Expr Expr
+	}
+
+	// FrameUnit is an enum for FrameClause.Unit
+	FrameUnit int8
+
+	// FramePointType is an enum for FramePoint.Type
+	FramePointType int8
+
 	// ValuesFuncExpr represents a function call.
 	ValuesFuncExpr struct {
 		Name *ColName
@@ -2005,6 +2076,7 @@ func (*SubstrExpr) iExpr()        {}
 func (*ConvertUsingExpr) iExpr()  {}
 func (*MatchExpr) iExpr()         {}
 func (*GroupConcatExpr) iExpr()   {}
+func (*WindowFuncExpr) iExpr()    {}
 func (*Default) iExpr()           {}
 
 // Exprs represents a list of value expressions.
@@ -87,6 +87,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneComments(in)
 	case *Commit:
 		return CloneRefOfCommit(in)
+	case *CommonTableExpr:
+		return CloneRefOfCommonTableExpr(in)
 	case *ComparisonExpr:
 		return CloneRefOfComparisonExpr(in)
 	case *ConstraintDefinition:
@@ -135,6 +137,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfForce(in)
 	case *ForeignKeyDefinition:
 		return CloneRefOfForeignKeyDefinition(in)
+	case *FrameClause:
+		return CloneRefOfFrameClause(in)
+	case *FramePoint:
+		return CloneRefOfFramePoint(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
 	case GroupBy:
@@ -205,6 +211,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfParenTableExpr(in)
 	case *PartitionDefinition:
 		return CloneRefOfPartitionDefinition(in)
+	case *PartitionOption:
+		return CloneRefOfPartitionOption(in)
 	case *PartitionSpec:
 		return CloneRefOfPartitionSpec(in)
 	case Partitions:
@@ -317,6 +325,12 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfWhen(in)
 	case *Where:
 		return CloneRefOfWhere(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
+	case *WindowSpec:
+		return CloneRefOfWindowSpec(in)
+	case *With:
+		return CloneRefOfWith(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
@@ -430,6 +444,7 @@ func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
 	out.Table = CloneTableName(n.Table)
 	out.AlterOptions = CloneSliceOfAlterOption(n.AlterOptions)
 	out.PartitionSpec = CloneRefOfPartitionSpec(n.PartitionSpec)
+	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
 	out.Comments = CloneComments(n.Comments)
 	return &out
 }
@@ -593,6 +608,9 @@ func CloneRefOfColumnType(n *ColumnType) *ColumnType {
 
 // CloneColumns creates a deep clone of the input.
 func CloneColumns(n Columns) Columns {
+	if n == nil {
+		return nil
+	}
 	res := make(Columns, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -602,7 +620,10 @@ func CloneColumns(n Columns) Columns {
 
 // CloneComments creates a deep clone of the input.
 func CloneComments(n Comments) Comments {
-	res := make(Comments, 0, len(n))
+	if n == nil {
+		return nil
+	}
+	res := make(Comments, len(n))
 	copy(res, n)
 	return res
 }
@@ -616,6 +637,18 @@ func CloneRefOfCommit(n *Commit) *Commit {
 	return &out
 }
 
+// CloneRefOfCommonTableExpr creates a deep clone of the input.
+func CloneRefOfCommonTableExpr(n *CommonTableExpr) *CommonTableExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.TableID = CloneTableIdent(n.TableID)
+	out.Columns = CloneColumns(n.Columns)
+	out.Subquery = CloneRefOfSubquery(n.Subquery)
+	return &out
+}
+
 // CloneRefOfComparisonExpr creates a deep clone of the input.
 func CloneRefOfComparisonExpr(n *ComparisonExpr) *ComparisonExpr {
 	if n == nil {
@@ -838,6 +871,9 @@ func CloneRefOfExplainTab(n *ExplainTab) *ExplainTab {
 
 // CloneExprs creates a deep clone of the input.
 func CloneExprs(n Exprs) Exprs {
+	if n == nil {
+		return nil
+	}
 	res := make(Exprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -877,6 +913,27 @@ func CloneRefOfForeignKeyDefinition(n *ForeignKeyDefinition) *ForeignKeyDefiniti
 	return &out
 }
 
+// CloneRefOfFrameClause creates a deep clone of the input.
+func CloneRefOfFrameClause(n *FrameClause) *FrameClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Start = CloneRefOfFramePoint(n.Start)
+	out.End = CloneRefOfFramePoint(n.End)
+	return &out
+}
+
+// CloneRefOfFramePoint creates a deep clone of the input.
+func CloneRefOfFramePoint(n *FramePoint) *FramePoint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
 // CloneRefOfFuncExpr creates a deep clone of the input.
 func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	if n == nil {
@@ -891,6 +948,9 @@ func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 
 // CloneGroupBy creates a deep clone of the input.
 func CloneGroupBy(n GroupBy) GroupBy {
+	if n == nil {
+		return nil
+	}
 	res := make(GroupBy, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -1106,6 +1166,9 @@ func CloneRefOfNullVal(n *NullVal) *NullVal {
 
 // CloneOnDup creates a deep clone of the input.
 func CloneOnDup(n OnDup) OnDup {
+	if n == nil {
+		return nil
+	}
 	res := make(OnDup, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUpdateExpr(x))
@@ -1146,6 +1209,9 @@ func CloneRefOfOrder(n *Order) *Order {
 
 // CloneOrderBy creates a deep clone of the input.
 func CloneOrderBy(n OrderBy) OrderBy {
+	if n == nil {
+		return nil
+	}
 	res := make(OrderBy, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfOrder(x))
@@ -1209,6 +1275,20 @@ func CloneRefOfPartitionDefinition(n *PartitionDefinition) *PartitionDefinition
 	out := *n
 	out.Name = CloneColIdent(n.Name)
 	out.Limit = CloneExpr(n.Limit)
+	out.InValues = CloneExprs(n.InValues)
+	return &out
+}
+
+// CloneRefOfPartitionOption creates a deep clone of the input.
+func CloneRefOfPartitionOption(n *PartitionOption) *PartitionOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.ColList = CloneColumns(n.ColList)
+	out.Partitions = CloneRefOfLiteral(n.Partitions)
+	out.Definitions = CloneSliceOfRefOfPartitionDefinition(n.Definitions)
 	return &out
 }
 
@@ -1227,6 +1307,9 @@ func CloneRefOfPartitionSpec(n *PartitionSpec) *PartitionSpec {
 
 // ClonePartitions creates a deep clone of the input.
 func ClonePartitions(n Partitions) Partitions {
+	if n == nil {
+		return nil
+	}
 	res := make(Partitions, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -1348,6 +1431,7 @@ func CloneRefOfSelect(n *Select) *Select {
 	out.Comments = CloneComments(n.Comments)
 	out.SelectExprs = CloneSelectExprs(n.SelectExprs)
 	out.Where = CloneRefOfWhere(n.Where)
+	out.With = CloneRefOfWith(n.With)
 	out.GroupBy = CloneGroupBy(n.GroupBy)
 	out.Having = CloneRefOfWhere(n.Having)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
@@ -1358,6 +1442,9 @@ func CloneRefOfSelect(n *Select) *Select {
 
 // CloneSelectExprs creates a deep clone of the input.
 func CloneSelectExprs(n SelectExprs) SelectExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(SelectExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneSelectExpr(x))
@@ -1398,6 +1485,9 @@ func CloneRefOfSetExpr(n *SetExpr) *SetExpr {
 
 // CloneSetExprs creates a deep clone of the input.
 func CloneSetExprs(n SetExprs) SetExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(SetExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfSetExpr(x))
@@ -1529,6 +1619,9 @@ func CloneRefOfSubstrExpr(n *SubstrExpr) *SubstrExpr {
 
 // CloneTableExprs creates a deep clone of the input.
 func CloneTableExprs(n TableExprs) TableExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(TableExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneTableExpr(x))
@@ -1548,6 +1641,9 @@ func CloneTableName(n TableName) TableName {
 
 // CloneTableNames creates a deep clone of the input.
 func CloneTableNames(n TableNames) TableNames {
+	if n == nil {
+		return nil
+	}
 	res := make(TableNames, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneTableName(x))
@@ -1557,6 +1653,9 @@ func CloneTableNames(n TableNames) TableNames {
 
 // CloneTableOptions creates a deep clone of the input.
 func CloneTableOptions(n TableOptions) TableOptions {
+	if n == nil {
+		return nil
+	}
 	res := make(TableOptions, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfTableOption(x))
@@ -1574,6 +1673,7 @@ func CloneRefOfTableSpec(n *TableSpec) *TableSpec {
 	out.Indexes = CloneSliceOfRefOfIndexDefinition(n.Indexes)
 	out.Constraints = CloneSliceOfRefOfConstraintDefinition(n.Constraints)
 	out.Options = CloneTableOptions(n.Options)
+	out.Partitions = CloneRefOfPartitionOption(n.Partitions)
 	return &out
 }
 
@@ -1623,6 +1723,7 @@ func CloneRefOfUnion(n *Union) *Union {
 		return nil
 	}
 	out := *n
+	out.With = CloneRefOfWith(n.With)
 	out.FirstStatement = CloneSelectStatement(n.FirstStatement)
 	out.UnionSelects = CloneSliceOfRefOfUnionSelect(n.UnionSelects)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
@@ -1677,6 +1778,9 @@ func CloneRefOfUpdateExpr(n *UpdateExpr) *UpdateExpr {
 
 // CloneUpdateExprs creates a deep clone of the input.
 func CloneUpdateExprs(n UpdateExprs) UpdateExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(UpdateExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUpdateExpr(x))
@@ -1710,6 +1814,9 @@ func CloneRefOfVStream(n *VStream) *VStream {
 
 // CloneValTuple creates a deep clone of the input.
 func CloneValTuple(n ValTuple) ValTuple {
+	if n == nil {
+		return nil
+	}
 	res := make(ValTuple, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -1728,6 +1835,9 @@ func CloneRefOfValidation(n *Validation) *Validation {
 
 // CloneValues creates a deep clone of the input.
 func CloneValues(n Values) Values {
+	if n == nil {
+		return nil
+	}
 	res := make(Values, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneValTuple(x))
@@ -1783,6 +1893,39 @@ func CloneRefOfWhere(n *Where) *Where {
 	return &out
 }
 
+// CloneRefOfWindowFuncExpr creates a deep clone of the input.
+func CloneRefOfWindowFuncExpr(n *WindowFuncExpr) *WindowFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Func = CloneRefOfFuncExpr(n.Func)
+	out.Over = CloneRefOfWindowSpec(n.Over)
+	return &out
+}
+
+// CloneRefOfWindowSpec creates a deep clone of the input.
+func CloneRefOfWindowSpec(n *WindowSpec) *WindowSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.PartitionBy = CloneExprs(n.PartitionBy)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	out.Frame = CloneRefOfFrameClause(n.Frame)
+	return &out
+}
+
+// CloneRefOfWith creates a deep clone of the input.
+func CloneRefOfWith(n *With) *With {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.CTEs = CloneSliceOfRefOfCommonTableExpr(n.CTEs)
+	return &out
+}
+
 // CloneRefOfXorExpr creates a deep clone of the input.
 func CloneRefOfXorExpr(n *XorExpr) *XorExpr {
 	if n == nil {
@@ -2022,6 +2165,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneValTuple(in)
 	case *ValuesFuncExpr:
 		return CloneRefOfValuesFuncExpr(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
@@ -2236,6 +2381,9 @@ func CloneTableExpr(in TableExpr) TableExpr {
 
 // CloneSliceOfRefOfColumnDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfColumnDefinition(n []*ColumnDefinition) []*ColumnDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*ColumnDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfColumnDefinition(x))
@@ -2245,6 +2393,9 @@ func CloneSliceOfRefOfColumnDefinition(n []*ColumnDefinition) []*ColumnDefinitio
 
 // CloneSliceOfCollateAndCharset creates a deep clone of the input.
 func CloneSliceOfCollateAndCharset(n []CollateAndCharset) []CollateAndCharset {
+	if n == nil {
+		return nil
+	}
 	res := make([]CollateAndCharset, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneCollateAndCharset(x))
@@ -2254,6 +2405,9 @@ func CloneSliceOfCollateAndCharset(n []CollateAndCharset) []CollateAndCharset {
 
 // CloneSliceOfAlterOption creates a deep clone of the input.
 func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
+	if n == nil {
+		return nil
+	}
 	res := make([]AlterOption, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneAlterOption(x))
@@ -2263,6 +2417,9 @@ func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
 
 // CloneSliceOfColIdent creates a deep clone of the input.
 func CloneSliceOfColIdent(n []ColIdent) []ColIdent {
+	if n == nil {
+		return nil
+	}
 	res := make([]ColIdent, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -2272,6 +2429,9 @@ func CloneSliceOfColIdent(n []ColIdent) []ColIdent {
 
 // CloneSliceOfRefOfWhen creates a deep clone of the input.
 func CloneSliceOfRefOfWhen(n []*When) []*When {
+	if n == nil {
+		return nil
+	}
 	res := make([]*When, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfWhen(x))
@@ -2310,13 +2470,19 @@ func CloneRefOfColumnTypeOptions(n *ColumnTypeOptions) *ColumnTypeOptions {
 
 // CloneSliceOfString creates a deep clone of the input.
 func CloneSliceOfString(n []string) []string {
-	res := make([]string, 0, len(n))
+	if n == nil {
+		return nil
+	}
+	res := make([]string, len(n))
 	copy(res, n)
 	return res
 }
 
 // CloneSliceOfRefOfIndexColumn creates a deep clone of the input.
 func CloneSliceOfRefOfIndexColumn(n []*IndexColumn) []*IndexColumn {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexColumn, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexColumn(x))
@@ -2326,6 +2492,9 @@ func CloneSliceOfRefOfIndexColumn(n []*IndexColumn) []*IndexColumn {
 
 // CloneSliceOfRefOfIndexOption creates a deep clone of the input.
 func CloneSliceOfRefOfIndexOption(n []*IndexOption) []*IndexOption {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexOption, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexOption(x))
@@ -2346,6 +2515,9 @@ func CloneRefOfJoinCondition(n *JoinCondition) *JoinCondition {
 
 // CloneTableAndLockTypes creates a deep clone of the input.
 func CloneTableAndLockTypes(n TableAndLockTypes) TableAndLockTypes {
+	if n == nil {
+		return nil
+	}
 	res := make(TableAndLockTypes, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfTableAndLockType(x))
@@ -2355,6 +2527,9 @@ func CloneTableAndLockTypes(n TableAndLockTypes) TableAndLockTypes {
 
 // CloneSliceOfRefOfPartitionDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfPartitionDefinition(n []*PartitionDefinition) []*PartitionDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*PartitionDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfPartitionDefinition(x))
@@ -2364,6 +2539,9 @@ func CloneSliceOfRefOfPartitionDefinition(n []*PartitionDefinition) []*Partition
 
 // CloneSliceOfRefOfRenameTablePair creates a deep clone of the input.
 func CloneSliceOfRefOfRenameTablePair(n []*RenameTablePair) []*RenameTablePair {
+	if n == nil {
+		return nil
+	}
 	res := make([]*RenameTablePair, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfRenameTablePair(x))
@@ -2382,6 +2560,9 @@ func CloneRefOfBool(n *bool) *bool {
 
 // CloneSliceOfTableExpr creates a deep clone of the input.
 func CloneSliceOfTableExpr(n []TableExpr) []TableExpr {
+	if n == nil {
+		return nil
+	}
 	res := make([]TableExpr, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneTableExpr(x))
@@ -2391,6 +2572,9 @@ func CloneSliceOfTableExpr(n []TableExpr) []TableExpr {
 
 // CloneSliceOfCharacteristic creates a deep clone of the input.
 func CloneSliceOfCharacteristic(n []Characteristic) []Characteristic {
+	if n == nil {
+		return nil
+	}
 	res := make([]Characteristic, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneCharacteristic(x))
@@ -2441,6 +2625,9 @@ func CloneRefOfTableOption(n *TableOption) *TableOption {
 
 // CloneSliceOfRefOfIndexDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfIndexDefinition(n []*IndexDefinition) []*IndexDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexDefinition(x))
@@ -2450,6 +2637,9 @@ func CloneSliceOfRefOfIndexDefinition(n []*IndexDefinition) []*IndexDefinition {
 
 // CloneSliceOfRefOfConstraintDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfConstraintDefinition(n []*ConstraintDefinition) []*ConstraintDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*ConstraintDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfConstraintDefinition(x))
@@ -2459,6 +2649,9 @@ func CloneSliceOfRefOfConstraintDefinition(n []*ConstraintDefinition) []*Constra
 
 // CloneSliceOfRefOfUnionSelect creates a deep clone of the input.
 func CloneSliceOfRefOfUnionSelect(n []*UnionSelect) []*UnionSelect {
+	if n == nil {
+		return nil
+	}
 	res := make([]*UnionSelect, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUnionSelect(x))
@@ -2478,6 +2671,9 @@ func CloneRefOfVindexParam(n *VindexParam) *VindexParam {
 
 // CloneSliceOfVindexParam creates a deep clone of the input.
 func CloneSliceOfVindexParam(n []VindexParam) []VindexParam {
+	if n == nil {
+		return nil
+	}
 	res := make([]VindexParam, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneVindexParam(x))
@@ -2485,6 +2681,18 @@ func CloneSliceOfVindexParam(n []VindexParam) []VindexParam {
 	return res
 }
 
+// CloneSliceOfRefOfCommonTableExpr creates a deep clone of the input.
+func CloneSliceOfRefOfCommonTableExpr(n []*CommonTableExpr) []*CommonTableExpr {
+	if n == nil {
+		return nil
+	}
+	res := make([]*CommonTableExpr, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfCommonTableExpr(x))
+	}
+	return res
+}
+
 // CloneCollateAndCharset creates a deep clone of the input.
 func CloneCollateAndCharset(n CollateAndCharset) CollateAndCharset {
 	return *CloneRefOfCollateAndCharset(&n)
@@ -218,6 +218,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfCommit(a, b)
+	case *CommonTableExpr:
+		b, ok := inB.(*CommonTableExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCommonTableExpr(a, b)
 	case *ComparisonExpr:
 		b, ok := inB.(*ComparisonExpr)
 		if !ok {
@@ -362,6 +368,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfForeignKeyDefinition(a, b)
+	case *FrameClause:
+		b, ok := inB.(*FrameClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFrameClause(a, b)
+	case *FramePoint:
+		b, ok := inB.(*FramePoint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFramePoint(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
@@ -572,6 +590,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfPartitionDefinition(a, b)
+	case *PartitionOption:
+		b, ok := inB.(*PartitionOption)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPartitionOption(a, b)
 	case *PartitionSpec:
 		b, ok := inB.(*PartitionSpec)
 		if !ok {
@@ -908,6 +932,24 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfWhere(a, b)
+	case *WindowFuncExpr:
+		b, ok := inB.(*WindowFuncExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowFuncExpr(a, b)
+	case *WindowSpec:
+		b, ok := inB.(*WindowSpec)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowSpec(a, b)
+	case *With:
+		b, ok := inB.(*With)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWith(a, b)
 	case *XorExpr:
 		b, ok := inB.(*XorExpr)
 		if !ok {
@@ -1044,6 +1086,7 @@ func EqualsRefOfAlterTable(a, b *AlterTable) bool {
 		EqualsTableName(a.Table, b.Table) &&
 		EqualsSliceOfAlterOption(a.AlterOptions, b.AlterOptions) &&
 		EqualsRefOfPartitionSpec(a.PartitionSpec, b.PartitionSpec) &&
+		EqualsRefOfPartitionOption(a.PartitionOption, b.PartitionOption) &&
 		EqualsComments(a.Comments, b.Comments)
 }
 
@@ -1277,6 +1320,19 @@ func EqualsRefOfCommit(a, b *Commit) bool {
 	return true
 }
 
+// EqualsRefOfCommonTableExpr does deep equals between the two objects.
+func EqualsRefOfCommonTableExpr(a, b *CommonTableExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsTableIdent(a.TableID, b.TableID) &&
+		EqualsColumns(a.Columns, b.Columns) &&
+		EqualsRefOfSubquery(a.Subquery, b.Subquery)
+}
+
 // EqualsRefOfComparisonExpr does deep equals between the two objects.
 func EqualsRefOfComparisonExpr(a, b *ComparisonExpr) bool {
 	if a == b {
@@ -1593,6 +1649,31 @@ func EqualsRefOfForeignKeyDefinition(a, b *ForeignKeyDefinition) bool {
 		EqualsRefOfReferenceDefinition(a.ReferenceDefinition, b.ReferenceDefinition)
 }
 
+// EqualsRefOfFrameClause does deep equals between the two objects.
+func EqualsRefOfFrameClause(a, b *FrameClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Unit == b.Unit &&
+		EqualsRefOfFramePoint(a.Start, b.Start) &&
+		EqualsRefOfFramePoint(a.End, b.End)
+}
+
+// EqualsRefOfFramePoint does deep equals between the two objects.
+func EqualsRefOfFramePoint(a, b *FramePoint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr)
+}
+
 // EqualsRefOfFuncExpr does deep equals between the two objects.
 func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
 	if a == b {
@@ -1991,8 +2072,26 @@ func EqualsRefOfPartitionDefinition(a, b *PartitionDefinition) bool {
 		return false
 	}
 	return a.Maxvalue == b.Maxvalue &&
+		a.Engine == b.Engine &&
 		EqualsColIdent(a.Name, b.Name) &&
-		EqualsExpr(a.Limit, b.Limit)
+		EqualsExpr(a.Limit, b.Limit) &&
+		EqualsExprs(a.InValues, b.InValues)
+}
+
+// EqualsRefOfPartitionOption does deep equals between the two objects.
+func EqualsRefOfPartitionOption(a, b *PartitionOption) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Linear == b.Linear &&
+		a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr) &&
+		EqualsColumns(a.ColList, b.ColList) &&
+		EqualsRefOfLiteral(a.Partitions, b.Partitions) &&
+		EqualsSliceOfRefOfPartitionDefinition(a.Definitions, b.Definitions)
 }
 
 // EqualsRefOfPartitionSpec does deep equals between the two objects.
@@ -2159,6 +2258,7 @@ func EqualsRefOfSelect(a, b *Select) bool {
 		EqualsComments(a.Comments, b.Comments) &&
 		EqualsSelectExprs(a.SelectExprs, b.SelectExprs) &&
 		EqualsRefOfWhere(a.Where, b.Where) &&
+		EqualsRefOfWith(a.With, b.With) &&
 		EqualsGroupBy(a.GroupBy, b.GroupBy) &&
 		EqualsRefOfWhere(a.Having, b.Having) &&
 		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
@@ -2438,7 +2538,8 @@ func EqualsRefOfTableSpec(a, b *TableSpec) bool {
 	return EqualsSliceOfRefOfColumnDefinition(a.Columns, b.Columns) &&
 		EqualsSliceOfRefOfIndexDefinition(a.Indexes, b.Indexes) &&
 		EqualsSliceOfRefOfConstraintDefinition(a.Constraints, b.Constraints) &&
-		EqualsTableOptions(a.Options, b.Options)
+		EqualsTableOptions(a.Options, b.Options) &&
+		EqualsRefOfPartitionOption(a.Partitions, b.Partitions)
 }
 
 // EqualsRefOfTablespaceOperation does deep equals between the two objects.
@@ -2497,7 +2598,8 @@ func EqualsRefOfUnion(a, b *Union) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return EqualsSelectStatement(a.FirstStatement, b.FirstStatement) &&
+	return EqualsRefOfWith(a.With, b.With) &&
+		EqualsSelectStatement(a.FirstStatement, b.FirstStatement) &&
 		EqualsSliceOfRefOfUnionSelect(a.UnionSelects, b.UnionSelects) &&
 		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
 		EqualsRefOfLimit(a.Limit, b.Limit) &&
@@ -2686,6 +2788,43 @@ func EqualsRefOfWhere(a, b *Where) bool {
 		EqualsExpr(a.Expr, b.Expr)
 }
 
+// EqualsRefOfWindowFuncExpr does deep equals between the two objects.
+func EqualsRefOfWindowFuncExpr(a, b *WindowFuncExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfFuncExpr(a.Func, b.Func) &&
+		EqualsRefOfWindowSpec(a.Over, b.Over)
+}
+
+// EqualsRefOfWindowSpec does deep equals between the two objects.
+func EqualsRefOfWindowSpec(a, b *WindowSpec) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExprs(a.PartitionBy, b.PartitionBy) &&
+		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
+		EqualsRefOfFrameClause(a.Frame, b.Frame)
+}
+
+// EqualsRefOfWith does deep equals between the two objects.
+func EqualsRefOfWith(a, b *With) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Recursive == b.Recursive &&
+		EqualsSliceOfRefOfCommonTableExpr(a.CTEs, b.CTEs)
+}
+
 // EqualsRefOfXorExpr does deep equals between the two objects.
 func EqualsRefOfXorExpr(a, b *XorExpr) bool {
 	if a == b {
@@ -3226,6 +3365,12 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfValuesFuncExpr(a, b)
+	case *WindowFuncExpr:
+		b, ok := inB.(*WindowFuncExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowFuncExpr(a, b)
 	case *XorExpr:
 		b, ok := inB.(*XorExpr)
 		if !ok {
@@ -4054,6 +4199,19 @@ func EqualsSliceOfVindexParam(a, b []VindexParam) bool {
 	return true
 }
 
+// EqualsSliceOfRefOfCommonTableExpr does deep equals between the two objects.
+func EqualsSliceOfRefOfCommonTableExpr(a, b []*CommonTableExpr) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfCommonTableExpr(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsCollateAndCharset does deep equals between the two objects.
 func EqualsCollateAndCharset(a, b CollateAndCharset) bool {
 	return a.IsDefault == b.IsDefault &&
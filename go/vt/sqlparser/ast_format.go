@@ -24,7 +24,7 @@ import (
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "select %v", node.Comments)
+	buf.astPrintf(node, "%vselect %v", node.With, node.Comments)
 
 	if node.Distinct {
 		buf.WriteString(DistinctStr)
@@ -64,7 +64,7 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "%v", node.FirstStatement)
+	buf.astPrintf(node, "%v%v", node.With, node.FirstStatement)
 	for _, us := range node.UnionSelects {
 		buf.astPrintf(node, "%v", us)
 	}
@@ -80,6 +80,28 @@ func (node *UnionSelect) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.astPrintf(node, "%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteByte(' ')
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v%v as %v", node.TableID, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node *VStream) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "vstream %v%v from %v",
@@ -279,7 +301,14 @@ func (node *PartitionSpec) Format(buf *TrackedBuffer) {
 		}
 		buf.astPrintf(node, ")")
 	case AddAction:
-		buf.astPrintf(node, "%s (%v)", AddStr, node.Definitions[0])
+		buf.astPrintf(node, "%s (", AddStr)
+		for i, pd := range node.Definitions {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", pd)
+		}
+		buf.WriteString(")")
 	case DropAction:
 		buf.astPrintf(node, "%s ", DropPartitionStr)
 		for i, n := range node.Names {
@@ -396,10 +425,54 @@ func (node *PartitionSpec) Format(buf *TrackedBuffer) {
 
 // Format formats the node
 func (node *PartitionDefinition) Format(buf *TrackedBuffer) {
-	if !node.Maxvalue {
-		buf.astPrintf(node, "partition %v values less than (%v)", node.Name, node.Limit)
+	buf.astPrintf(node, "partition %v", node.Name)
+	if node.Maxvalue {
+		buf.WriteString(" values less than (maxvalue)")
+	} else if node.Limit != nil {
+		buf.astPrintf(node, " values less than (%v)", node.Limit)
+	} else if node.InValues != nil {
+		buf.astPrintf(node, " values in (%v)", node.InValues)
+	}
+	if node.Engine != "" {
+		buf.astPrintf(node, " engine %s", node.Engine)
+	}
+}
+
+// Format formats the node.
+func (node *PartitionOption) Format(buf *TrackedBuffer) {
+	buf.WriteString("partition by ")
+	if node.Linear {
+		buf.WriteString("linear ")
+	}
+	switch node.Type {
+	case HashPartitionType:
+		buf.WriteString("hash")
+	case KeyPartitionType:
+		buf.WriteString("key")
+	case RangePartitionType:
+		buf.WriteString("range")
+	case ListPartitionType:
+		buf.WriteString("list")
+	}
+	if node.Expr != nil {
+		buf.astPrintf(node, " (%v)", node.Expr)
+	} else if node.Type == KeyPartitionType {
+		buf.astPrintf(node, " %v", node.ColList)
 	} else {
-		buf.astPrintf(node, "partition %v values less than (maxvalue)", node.Name)
+		buf.astPrintf(node, " columns %v", node.ColList)
+	}
+	if node.Partitions != nil {
+		buf.astPrintf(node, " partitions %v", node.Partitions)
+	}
+	if node.Definitions != nil {
+		buf.WriteString(" (")
+		for i, pd := range node.Definitions {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", pd)
+		}
+		buf.WriteString(")")
 	}
 }
 
@@ -434,6 +507,9 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 			buf.astPrintf(ts, " (%v)", opt.Tables)
 		}
 	}
+	if ts.Partitions != nil {
+		buf.astPrintf(ts, "\n%v", ts.Partitions)
+	}
 }
 
 // Format formats the node.
@@ -511,22 +587,22 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Options.Comment != nil {
 		buf.astPrintf(ct, " %s %v", keywordStrings[COMMENT_KEYWORD], ct.Options.Comment)
 	}
-	if ct.Options.KeyOpt == colKeyPrimary {
+	if ct.Options.KeyOpt == ColKeyPrimary {
 		buf.astPrintf(ct, " %s %s", keywordStrings[PRIMARY], keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeyUnique {
+	if ct.Options.KeyOpt == ColKeyUnique {
 		buf.astPrintf(ct, " %s", keywordStrings[UNIQUE])
 	}
-	if ct.Options.KeyOpt == colKeyUniqueKey {
+	if ct.Options.KeyOpt == ColKeyUniqueKey {
 		buf.astPrintf(ct, " %s %s", keywordStrings[UNIQUE], keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeySpatialKey {
+	if ct.Options.KeyOpt == ColKeySpatialKey {
 		buf.astPrintf(ct, " %s %s", keywordStrings[SPATIAL], keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeyFulltextKey {
+	if ct.Options.KeyOpt == ColKeyFulltextKey {
 		buf.astPrintf(ct, " %s %s", keywordStrings[FULLTEXT], keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKey {
+	if ct.Options.KeyOpt == ColKey {
 		buf.astPrintf(ct, " %s", keywordStrings[KEY])
 	}
 	if ct.Options.Reference != nil {
@@ -1116,6 +1192,53 @@ func (node *GroupConcatExpr) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *WindowFuncExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v over %v", node.Func, node.Over)
+}
+
+// Format formats the node.
+func (node *WindowSpec) Format(buf *TrackedBuffer) {
+	buf.WriteByte('(')
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		buf.astPrintf(node, "partition by %v", node.PartitionBy)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("order by ")
+		for i, order := range node.OrderBy {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", order)
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.Frame)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.astPrintf(node, "%v ", node.Expr)
+	}
+	buf.WriteString(node.Type.ToString())
+}
+
 // Format formats the node.
 func (node *ValuesFuncExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "values(%v)", node.Name)
@@ -1529,6 +1652,9 @@ func (node *AlterTable) Format(buf *TrackedBuffer) {
 	if node.PartitionSpec != nil {
 		buf.astPrintf(node, "%s %v", prefix, node.PartitionSpec)
 	}
+	if node.PartitionOption != nil {
+		buf.astPrintf(node, " %v", node.PartitionOption)
+	}
 }
 
 // Format formats the node.
@@ -1547,7 +1673,10 @@ func (node *AddColumns) Format(buf *TrackedBuffer) {
 	if len(node.Columns) == 1 {
 		buf.astPrintf(node, "add column %v", node.Columns[0])
 		if node.First != nil {
-			buf.astPrintf(node, " first %v", node.First)
+			buf.WriteString(" first")
+			if !node.First.Name.IsEmpty() {
+				buf.astPrintf(node, " %v", node.First)
+			}
 		}
 		if node.After != nil {
 			buf.astPrintf(node, " after %v", node.After)
@@ -1583,7 +1712,10 @@ func (node *AlterColumn) Format(buf *TrackedBuffer) {
 func (node *ChangeColumn) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "change column %v %v", node.OldColumn, node.NewColDefinition)
 	if node.First != nil {
-		buf.astPrintf(node, " first %v", node.First)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.astPrintf(node, " %v", node.First)
+		}
 	}
 	if node.After != nil {
 		buf.astPrintf(node, " after %v", node.After)
@@ -1594,7 +1726,10 @@ func (node *ChangeColumn) Format(buf *TrackedBuffer) {
 func (node *ModifyColumn) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "modify column %v", node.NewColDefinition)
 	if node.First != nil {
-		buf.astPrintf(node, " first %v", node.First)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.astPrintf(node, " %v", node.First)
+		}
 	}
 	if node.After != nil {
 		buf.astPrintf(node, " after %v", node.After)
@@ -25,6 +25,7 @@ import (
 
 // formatFast formats the node.
 func (node *Select) formatFast(buf *TrackedBuffer) {
+	node.With.formatFast(buf)
 	buf.WriteString("select ")
 	node.Comments.formatFast(buf)
 
@@ -78,6 +79,7 @@ func (node *ParenSelect) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *Union) formatFast(buf *TrackedBuffer) {
+	node.With.formatFast(buf)
 	node.FirstStatement.formatFast(buf)
 	for _, us := range node.UnionSelects {
 		us.formatFast(buf)
@@ -102,6 +104,32 @@ func (node *UnionSelect) formatFast(buf *TrackedBuffer) {
 	}
 }
 
+// formatFast formats the node.
+func (node *With) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.WriteString(prefix)
+		cte.formatFast(buf)
+		prefix = ", "
+	}
+	buf.WriteByte(' ')
+}
+
+// formatFast formats the node.
+func (node *CommonTableExpr) formatFast(buf *TrackedBuffer) {
+	node.TableID.formatFast(buf)
+	node.Columns.formatFast(buf)
+	buf.WriteString(" as ")
+	node.Subquery.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node *VStream) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("vstream ")
@@ -417,8 +445,13 @@ func (node *PartitionSpec) formatFast(buf *TrackedBuffer) {
 	case AddAction:
 		buf.WriteString(AddStr)
 		buf.WriteString(" (")
-		node.Definitions[0].formatFast(buf)
-		buf.WriteByte(')')
+		for i, pd := range node.Definitions {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			pd.formatFast(buf)
+		}
+		buf.WriteString(")")
 	case DropAction:
 		buf.WriteString(DropPartitionStr)
 		buf.WriteByte(' ')
@@ -558,16 +591,65 @@ func (node *PartitionSpec) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node
 func (node *PartitionDefinition) formatFast(buf *TrackedBuffer) {
-	if !node.Maxvalue {
-		buf.WriteString("partition ")
-		node.Name.formatFast(buf)
+	buf.WriteString("partition ")
+	node.Name.formatFast(buf)
+	if node.Maxvalue {
+		buf.WriteString(" values less than (maxvalue)")
+	} else if node.Limit != nil {
 		buf.WriteString(" values less than (")
 		node.Limit.formatFast(buf)
 		buf.WriteByte(')')
+	} else if node.InValues != nil {
+		buf.WriteString(" values in (")
+		node.InValues.formatFast(buf)
+		buf.WriteByte(')')
+	}
+	if node.Engine != "" {
+		buf.WriteString(" engine ")
+		buf.WriteString(node.Engine)
+	}
+}
+
+// formatFast formats the node.
+func (node *PartitionOption) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("partition by ")
+	if node.Linear {
+		buf.WriteString("linear ")
+	}
+	switch node.Type {
+	case HashPartitionType:
+		buf.WriteString("hash")
+	case KeyPartitionType:
+		buf.WriteString("key")
+	case RangePartitionType:
+		buf.WriteString("range")
+	case ListPartitionType:
+		buf.WriteString("list")
+	}
+	if node.Expr != nil {
+		buf.WriteString(" (")
+		node.Expr.formatFast(buf)
+		buf.WriteByte(')')
+	} else if node.Type == KeyPartitionType {
+		buf.WriteByte(' ')
+		node.ColList.formatFast(buf)
 	} else {
-		buf.WriteString("partition ")
-		node.Name.formatFast(buf)
-		buf.WriteString(" values less than (maxvalue)")
+		buf.WriteString(" columns ")
+		node.ColList.formatFast(buf)
+	}
+	if node.Partitions != nil {
+		buf.WriteString(" partitions ")
+		node.Partitions.formatFast(buf)
+	}
+	if node.Definitions != nil {
+		buf.WriteString(" (")
+		for i, pd := range node.Definitions {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			pd.formatFast(buf)
+		}
+		buf.WriteString(")")
 	}
 }
 
@@ -611,6 +693,10 @@ func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
 			buf.WriteByte(')')
 		}
 	}
+	if ts.Partitions != nil {
+		buf.WriteByte('\n')
+		ts.Partitions.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -735,35 +821,35 @@ func (ct *ColumnType) formatFast(buf *TrackedBuffer) {
 		buf.WriteByte(' ')
 		ct.Options.Comment.formatFast(buf)
 	}
-	if ct.Options.KeyOpt == colKeyPrimary {
+	if ct.Options.KeyOpt == ColKeyPrimary {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[PRIMARY])
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeyUnique {
+	if ct.Options.KeyOpt == ColKeyUnique {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[UNIQUE])
 	}
-	if ct.Options.KeyOpt == colKeyUniqueKey {
+	if ct.Options.KeyOpt == ColKeyUniqueKey {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[UNIQUE])
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeySpatialKey {
+	if ct.Options.KeyOpt == ColKeySpatialKey {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[SPATIAL])
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeyFulltextKey {
+	if ct.Options.KeyOpt == ColKeyFulltextKey {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[FULLTEXT])
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKey {
+	if ct.Options.KeyOpt == ColKey {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
@@ -1508,6 +1594,64 @@ func (node *GroupConcatExpr) formatFast(buf *TrackedBuffer) {
 	}
 }
 
+// formatFast formats the node.
+func (node *WindowFuncExpr) formatFast(buf *TrackedBuffer) {
+	buf.printExpr(node, node.Func, true)
+	buf.WriteString(" over ")
+	node.Over.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *WindowSpec) formatFast(buf *TrackedBuffer) {
+	buf.WriteByte('(')
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		buf.WriteString("partition by ")
+		node.PartitionBy.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("order by ")
+		for i, order := range node.OrderBy {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			order.formatFast(buf)
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.WriteString(prefix)
+		node.Frame.formatFast(buf)
+	}
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *FrameClause) formatFast(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.WriteString(node.Unit.ToString())
+		buf.WriteByte(' ')
+		node.Start.formatFast(buf)
+		return
+	}
+	buf.WriteString(node.Unit.ToString())
+	buf.WriteString(" between ")
+	node.Start.formatFast(buf)
+	buf.WriteString(" and ")
+	node.End.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FramePoint) formatFast(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		node.Expr.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(node.Type.ToString())
+}
+
 // formatFast formats the node.
 func (node *ValuesFuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("values(")
@@ -2026,6 +2170,10 @@ func (node *AlterTable) formatFast(buf *TrackedBuffer) {
 		buf.WriteByte(' ')
 		node.PartitionSpec.formatFast(buf)
 	}
+	if node.PartitionOption != nil {
+		buf.WriteByte(' ')
+		node.PartitionOption.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -2047,8 +2195,11 @@ func (node *AddColumns) formatFast(buf *TrackedBuffer) {
 		buf.WriteString("add column ")
 		node.Columns[0].formatFast(buf)
 		if node.First != nil {
-			buf.WriteString(" first ")
-			node.First.formatFast(buf)
+			buf.WriteString(" first")
+			if !node.First.Name.IsEmpty() {
+				buf.WriteByte(' ')
+				node.First.formatFast(buf)
+			}
 		}
 		if node.After != nil {
 			buf.WriteString(" after ")
@@ -2096,8 +2247,11 @@ func (node *ChangeColumn) formatFast(buf *TrackedBuffer) {
 	buf.WriteByte(' ')
 	node.NewColDefinition.formatFast(buf)
 	if node.First != nil {
-		buf.WriteString(" first ")
-		node.First.formatFast(buf)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.WriteByte(' ')
+			node.First.formatFast(buf)
+		}
 	}
 	if node.After != nil {
 		buf.WriteString(" after ")
@@ -2110,8 +2264,11 @@ func (node *ModifyColumn) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("modify column ")
 	node.NewColDefinition.formatFast(buf)
 	if node.First != nil {
-		buf.WriteString(" first ")
-		node.First.formatFast(buf)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.WriteByte(' ')
+			node.First.formatFast(buf)
+		}
 	}
 	if node.After != nil {
 		buf.WriteString(" after ")
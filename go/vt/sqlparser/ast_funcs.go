@@ -92,13 +92,13 @@ type TableOption struct {
 type ColumnKeyOption int
 
 const (
-	colKeyNone ColumnKeyOption = iota
-	colKeyPrimary
-	colKeySpatialKey
-	colKeyFulltextKey
-	colKeyUnique
-	colKeyUniqueKey
-	colKey
+	ColKeyNone ColumnKeyOption = iota
+	ColKeyPrimary
+	ColKeySpatialKey
+	ColKeyFulltextKey
+	ColKeyUnique
+	ColKeyUniqueKey
+	ColKey
 )
 
 // ReferenceAction indicates the action takes by a referential constraint e.g.
@@ -843,7 +843,12 @@ func Unionize(lhs, rhs SelectStatement, distinct bool, by OrderBy, limit *Limit,
 		return union
 	}
 
-	return &Union{FirstStatement: lhs, UnionSelects: []*UnionSelect{{Distinct: distinct, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
+	// A WITH clause in front of the first SELECT is visible to the whole UNION
+	var with *With
+	if sel, isSelect := lhs.(*Select); isSelect {
+		with, sel.With = sel.With, nil
+	}
+	return &Union{With: with, FirstStatement: lhs, UnionSelects: []*UnionSelect{{Distinct: distinct, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
 }
 
 // ToString returns the string associated with the DDLAction Enum
@@ -909,6 +914,36 @@ func (ignore Ignore) ToString() string {
 }
 
 // ToString returns the string associated with the type of lock
+// ToString returns the string associated with the FrameUnit Enum
+func (unit FrameUnit) ToString() string {
+	switch unit {
+	case RowsUnit:
+		return RowsStr
+	case RangeUnit:
+		return RangeStr
+	default:
+		return "Unknown FrameUnit"
+	}
+}
+
+// ToString returns the string associated with the FramePointType Enum
+func (ty FramePointType) ToString() string {
+	switch ty {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return PrecedingStr
+	case ExprFollowingType:
+		return FollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
 func (lock Lock) ToString() string {
 	switch lock {
 	case NoLock:
@@ -1278,6 +1313,8 @@ func (key DropKeyType) ToString() string {
 		return ForeignKeyTypeStr
 	case NormalKeyType:
 		return NormalKeyTypeStr
+	case CheckKeyType:
+		return CheckKeyTypeStr
 	default:
 		return "Unknown DropKeyType"
 	}
@@ -127,6 +127,17 @@ func TestUpdate(t *testing.T) {
 	assert.Equal(t, "update t set a = 1 where b = 2 and c = 3", String(upd))
 }
 
+func TestUnionWith(t *testing.T) {
+	tree, err := Parse("with t as (select a from s) select a from t union select b from u")
+	require.NoError(t, err)
+
+	union, ok := tree.(*Union)
+	require.True(t, ok)
+	require.NotNil(t, union.With)
+	assert.Equal(t, "t", union.With.CTEs[0].TableID.String())
+	assert.Nil(t, union.FirstStatement.(*Select).With)
+}
+
 func TestRemoveHints(t *testing.T) {
 	for _, query := range []string{
 		"select * from t use index (i)",
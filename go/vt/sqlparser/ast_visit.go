@@ -86,6 +86,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitComments(in, f)
 	case *Commit:
 		return VisitRefOfCommit(in, f)
+	case *CommonTableExpr:
+		return VisitRefOfCommonTableExpr(in, f)
 	case *ComparisonExpr:
 		return VisitRefOfComparisonExpr(in, f)
 	case *ConstraintDefinition:
@@ -134,6 +136,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfForce(in, f)
 	case *ForeignKeyDefinition:
 		return VisitRefOfForeignKeyDefinition(in, f)
+	case *FrameClause:
+		return VisitRefOfFrameClause(in, f)
+	case *FramePoint:
+		return VisitRefOfFramePoint(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
 	case GroupBy:
@@ -204,6 +210,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfParenTableExpr(in, f)
 	case *PartitionDefinition:
 		return VisitRefOfPartitionDefinition(in, f)
+	case *PartitionOption:
+		return VisitRefOfPartitionOption(in, f)
 	case *PartitionSpec:
 		return VisitRefOfPartitionSpec(in, f)
 	case Partitions:
@@ -316,6 +324,12 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfWhen(in, f)
 	case *Where:
 		return VisitRefOfWhere(in, f)
+	case *WindowFuncExpr:
+		return VisitRefOfWindowFuncExpr(in, f)
+	case *WindowSpec:
+		return VisitRefOfWindowSpec(in, f)
+	case *With:
+		return VisitRefOfWith(in, f)
 	case *XorExpr:
 		return VisitRefOfXorExpr(in, f)
 	default:
@@ -466,6 +480,9 @@ func VisitRefOfAlterTable(in *AlterTable, f Visit) error {
 	if err := VisitRefOfPartitionSpec(in.PartitionSpec, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfPartitionOption(in.PartitionOption, f); err != nil {
+		return err
+	}
 	if err := VisitComments(in.Comments, f); err != nil {
 		return err
 	}
@@ -721,6 +738,24 @@ func VisitRefOfCommit(in *Commit, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCommonTableExpr(in *CommonTableExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableIdent(in.TableID, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfSubquery(in.Subquery, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfComparisonExpr(in *ComparisonExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1071,6 +1106,33 @@ func VisitRefOfForeignKeyDefinition(in *ForeignKeyDefinition, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfFrameClause(in *FrameClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.Start, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.End, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfFramePoint(in *FramePoint, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1503,6 +1565,32 @@ func VisitRefOfPartitionDefinition(in *PartitionDefinition, f Visit) error {
 	if err := VisitExpr(in.Limit, f); err != nil {
 		return err
 	}
+	if err := VisitExprs(in.InValues, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPartitionOption(in *PartitionOption, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.ColList, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Partitions, f); err != nil {
+		return err
+	}
+	for _, el := range in.Definitions {
+		if err := VisitRefOfPartitionDefinition(el, f); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 func VisitRefOfPartitionSpec(in *PartitionSpec, f Visit) error {
@@ -1695,6 +1783,9 @@ func VisitRefOfSelect(in *Select, f Visit) error {
 	if err := VisitRefOfWhere(in.Where, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfWith(in.With, f); err != nil {
+		return err
+	}
 	if err := VisitGroupBy(in.GroupBy, f); err != nil {
 		return err
 	}
@@ -2021,6 +2112,9 @@ func VisitRefOfTableSpec(in *TableSpec, f Visit) error {
 	if err := VisitTableOptions(in.Options, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfPartitionOption(in.Partitions, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfTablespaceOperation(in *TablespaceOperation, f Visit) error {
@@ -2078,6 +2172,9 @@ func VisitRefOfUnion(in *Union, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitRefOfWith(in.With, f); err != nil {
+		return err
+	}
 	if err := VisitSelectStatement(in.FirstStatement, f); err != nil {
 		return err
 	}
@@ -2312,6 +2409,53 @@ func VisitRefOfWhere(in *Where, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfWindowFuncExpr(in *WindowFuncExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfFuncExpr(in.Func, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpec(in.Over, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfWindowSpec(in *WindowSpec, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExprs(in.PartitionBy, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFrameClause(in.Frame, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfWith(in *With, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.CTEs {
+		if err := VisitRefOfCommonTableExpr(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfXorExpr(in *XorExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2540,6 +2684,8 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitValTuple(in, f)
 	case *ValuesFuncExpr:
 		return VisitRefOfValuesFuncExpr(in, f)
+	case *WindowFuncExpr:
+		return VisitRefOfWindowFuncExpr(in, f)
 	case *XorExpr:
 		return VisitRefOfXorExpr(in, f)
 	default:
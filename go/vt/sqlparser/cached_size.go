@@ -174,7 +174,7 @@ func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(89)
+		size += int64(97)
 	}
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
@@ -189,6 +189,8 @@ func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	}
 	// field PartitionSpec *vitess.io/vitess/go/vt/sqlparser.PartitionSpec
 	size += cached.PartitionSpec.CachedSize(true)
+	// field PartitionOption *vitess.io/vitess/go/vt/sqlparser.PartitionOption
+	size += cached.PartitionOption.CachedSize(true)
 	// field Comments vitess.io/vitess/go/vt/sqlparser.Comments
 	{
 		size += int64(cap(cached.Comments)) * int64(16)
@@ -538,6 +540,27 @@ func (cached *ColumnTypeOptions) CachedSize(alloc bool) int64 {
 	size += cached.Reference.CachedSize(true)
 	return size
 }
+func (cached *CommonTableExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field TableID vitess.io/vitess/go/vt/sqlparser.TableIdent
+	size += cached.TableID.CachedSize(false)
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += int64(cap(cached.Columns)) * int64(40)
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Subquery *vitess.io/vitess/go/vt/sqlparser.Subquery
+	size += cached.Subquery.CachedSize(true)
+	return size
+}
 func (cached *ComparisonExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -968,6 +991,34 @@ func (cached *ForeignKeyDefinition) CachedSize(alloc bool) int64 {
 	size += cached.ReferenceDefinition.CachedSize(true)
 	return size
 }
+func (cached *FrameClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Start *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.Start.CachedSize(true)
+	// field End *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.End.CachedSize(true)
+	return size
+}
+func (cached *FramePoint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *FuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1475,7 +1526,7 @@ func (cached *PartitionDefinition) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(57)
+		size += int64(104)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -1483,6 +1534,47 @@ func (cached *PartitionDefinition) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.Limit.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field InValues vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += int64(cap(cached.InValues)) * int64(16)
+		for _, elem := range cached.InValues {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Engine string
+	size += int64(len(cached.Engine))
+	return size
+}
+func (cached *PartitionOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field ColList vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += int64(cap(cached.ColList)) * int64(40)
+		for _, elem := range cached.ColList {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Partitions *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Partitions.CachedSize(true)
+	// field Definitions []*vitess.io/vitess/go/vt/sqlparser.PartitionDefinition
+	{
+		size += int64(cap(cached.Definitions)) * int64(8)
+		for _, elem := range cached.Definitions {
+			size += elem.CachedSize(true)
+		}
+	}
 	return size
 }
 func (cached *PartitionSpec) CachedSize(alloc bool) int64 {
@@ -1672,7 +1764,7 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(184)
 	}
 	// field Cache *bool
 	size += int64(1)
@@ -1703,6 +1795,8 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	// field Where *vitess.io/vitess/go/vt/sqlparser.Where
 	size += cached.Where.CachedSize(true)
+	// field With *vitess.io/vitess/go/vt/sqlparser.With
+	size += cached.With.CachedSize(true)
 	// field GroupBy vitess.io/vitess/go/vt/sqlparser.GroupBy
 	{
 		size += int64(cap(cached.GroupBy)) * int64(16)
@@ -2076,7 +2170,7 @@ func (cached *TableSpec) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(104)
 	}
 	// field Columns []*vitess.io/vitess/go/vt/sqlparser.ColumnDefinition
 	{
@@ -2106,6 +2200,8 @@ func (cached *TableSpec) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field Partitions *vitess.io/vitess/go/vt/sqlparser.PartitionOption
+	size += cached.Partitions.CachedSize(true)
 	return size
 }
 func (cached *TablespaceOperation) CachedSize(alloc bool) int64 {
@@ -2172,8 +2268,10 @@ func (cached *Union) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(73)
+		size += int64(81)
 	}
+	// field With *vitess.io/vitess/go/vt/sqlparser.With
+	size += cached.With.CachedSize(true)
 	// field FirstStatement vitess.io/vitess/go/vt/sqlparser.SelectStatement
 	if cc, ok := cached.FirstStatement.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -2398,6 +2496,65 @@ func (cached *Where) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *WindowFuncExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Func *vitess.io/vitess/go/vt/sqlparser.FuncExpr
+	size += cached.Func.CachedSize(true)
+	// field Over *vitess.io/vitess/go/vt/sqlparser.WindowSpec
+	size += cached.Over.CachedSize(true)
+	return size
+}
+func (cached *WindowSpec) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field PartitionBy vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += int64(cap(cached.PartitionBy)) * int64(16)
+		for _, elem := range cached.PartitionBy {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
+	{
+		size += int64(cap(cached.OrderBy)) * int64(8)
+		for _, elem := range cached.OrderBy {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Frame *vitess.io/vitess/go/vt/sqlparser.FrameClause
+	size += cached.Frame.CachedSize(true)
+	return size
+}
+func (cached *With) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(25)
+	}
+	// field CTEs []*vitess.io/vitess/go/vt/sqlparser.CommonTableExpr
+	{
+		size += int64(cap(cached.CTEs)) * int64(8)
+		for _, elem := range cached.CTEs {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *XorExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
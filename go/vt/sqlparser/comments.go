@@ -36,6 +36,12 @@ const (
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
 	// DirectiveIgnoreMaxMemoryRows skips memory row validation when set.
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
+	// DirectiveResultCacheTTL caches the result of a select in vttablet for
+	// the specified number of milliseconds.
+	DirectiveResultCacheTTL = "RESULT_CACHE_TTL_MS"
+	// DirectiveConsumerGroup streams the messages of the named consumer
+	// group of a message table. Only supported for STREAM.
+	DirectiveConsumerGroup = "CONSUMER_GROUP"
 )
 
 func isNonSpace(r rune) bool {
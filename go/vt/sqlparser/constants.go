@@ -246,6 +246,18 @@ const (
 	PrimaryKeyTypeStr = "primary key"
 	ForeignKeyTypeStr = "foreign key"
 	NormalKeyTypeStr  = "key"
+	CheckKeyTypeStr   = "check"
+
+	// FrameUnit strings
+	RowsStr  = "rows"
+	RangeStr = "range"
+
+	// FramePointType strings
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
 
 	// LockOptionType strings
 	NoneTypeStr      = "none"
@@ -309,6 +321,21 @@ const (
 	ShareModeLock
 )
 
+// Constants for Enum Type - FrameUnit
+const (
+	RowsUnit FrameUnit = iota
+	RangeUnit
+)
+
+// Constants for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constants for Enum Type - WhereType
 const (
 	WhereClause WhereType = iota
@@ -436,6 +463,14 @@ const (
 	UpgradeAction
 )
 
+// Constant for Enum Type - PartitionByType
+const (
+	HashPartitionType PartitionByType = iota
+	KeyPartitionType
+	RangePartitionType
+	ListPartitionType
+)
+
 // Constant for Enum Type - ExplainType
 const (
 	EmptyType ExplainType = iota
@@ -508,6 +543,7 @@ const (
 	PrimaryKeyType DropKeyType = iota
 	ForeignKeyType
 	NormalKeyType
+	CheckKeyType
 )
 
 // LockOptionType constants
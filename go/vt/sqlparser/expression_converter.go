@@ -18,6 +18,9 @@ package sqlparser
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
@@ -44,7 +47,17 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			return evalengine.NewLiteralIntFromBytes([]byte("1"))
 		}
 		return evalengine.NewLiteralIntFromBytes([]byte("0"))
+	case *NullVal:
+		return evalengine.NewLiteralNull(), nil
 	case *BinaryExpr:
+		if node.Operator == PlusOp || node.Operator == MinusOp {
+			if interval, ok := node.Right.(*IntervalExpr); ok {
+				return convertDateAdd(node.Left, interval, node.Operator == MinusOp)
+			}
+			if interval, ok := node.Left.(*IntervalExpr); ok && node.Operator == PlusOp {
+				return convertDateAdd(node.Right, interval, false)
+			}
+		}
 		var op evalengine.BinaryExpr
 		switch node.Operator {
 		case PlusOp:
@@ -55,23 +68,339 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			op = &evalengine.Multiplication{}
 		case DivOp:
 			op = &evalengine.Division{}
+		case IntDivOp:
+			op = &evalengine.IntegerDivision{}
+		case ModOp:
+			op = &evalengine.Modulo{}
+		case BitAndOp:
+			op = &evalengine.BitwiseAnd{}
+		case BitOrOp:
+			op = &evalengine.BitwiseOr{}
+		case BitXorOp:
+			op = &evalengine.BitwiseXor{}
+		case ShiftLeftOp:
+			op = &evalengine.ShiftLeft{}
+		case ShiftRightOp:
+			op = &evalengine.ShiftRight{}
 		default:
 			return nil, ErrExprNotSupported
 		}
-		left, err := Convert(node.Left)
+		return convertBinaryOp(op, node.Left, node.Right)
+	case *ComparisonExpr:
+		return convertComparison(node)
+	case *RangeCond:
+		// x BETWEEN a AND b is x >= a AND x <= b
+		from, err := convertBinaryOp(&evalengine.GreaterEqual{}, node.Left, node.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := convertBinaryOp(&evalengine.LessEqual{}, node.Left, node.To)
+		if err != nil {
+			return nil, err
+		}
+		if node.Operator == NotBetweenOp {
+			return &evalengine.UnaryOp{
+				Expr:  &evalengine.Not{},
+				Inner: &evalengine.BinaryOp{Expr: &evalengine.And{}, Left: from, Right: to},
+			}, nil
+		}
+		return &evalengine.BinaryOp{Expr: &evalengine.And{}, Left: from, Right: to}, nil
+	case *AndExpr:
+		return convertBinaryOp(&evalengine.And{}, node.Left, node.Right)
+	case *OrExpr:
+		return convertBinaryOp(&evalengine.Or{}, node.Left, node.Right)
+	case *XorExpr:
+		return convertBinaryOp(&evalengine.Xor{}, node.Left, node.Right)
+	case *NotExpr:
+		return convertUnaryOp(&evalengine.Not{}, node.Expr)
+	case *IsExpr:
+		inner, err := Convert(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		var op evalengine.IsOp
+		switch node.Right {
+		case IsNullOp:
+			op = evalengine.IsNullOp
+		case IsNotNullOp:
+			op = evalengine.IsNotNullOp
+		case IsTrueOp:
+			op = evalengine.IsTrueOp
+		case IsNotTrueOp:
+			op = evalengine.IsNotTrueOp
+		case IsFalseOp:
+			op = evalengine.IsFalseOp
+		case IsNotFalseOp:
+			op = evalengine.IsNotFalseOp
+		default:
+			return nil, ErrExprNotSupported
+		}
+		return &evalengine.IsExpr{Inner: inner, Op: op}, nil
+	case *UnaryExpr:
+		return convertUnaryExpr(node)
+	case *CollateExpr:
+		inner, err := Convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		expr, ok := evalengine.NewCollateExpr(inner, node.Charset)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		return expr, nil
+	case *ConvertExpr:
+		if node.Type.Scale != nil {
+			return nil, ErrExprNotSupported
+		}
+		length := -1
+		if node.Type.Length != nil {
+			l, err := strconv.Atoi(node.Type.Length.Val)
+			if err != nil {
+				return nil, ErrExprNotSupported
+			}
+			length = l
+		}
+		inner, err := Convert(node.Expr)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Convert(node.Right)
+		expr, ok := evalengine.NewConvertExpr(inner, node.Type.Type, length, node.Type.Charset)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		return expr, nil
+	case *SubstrExpr:
+		if node.StrVal == nil {
+			return nil, ErrExprNotSupported
+		}
+		args := []Expr{node.StrVal, node.From}
+		if node.To != nil {
+			args = append(args, node.To)
+		}
+		return convertCall("substr", args)
+	case *FuncExpr:
+		return convertFuncExpr(node)
+	case *TimestampFuncExpr:
+		if !evalengine.IsIntervalUnit(node.Unit) {
+			return nil, ErrExprNotSupported
+		}
+		switch node.Name {
+		case "timestampadd":
+			return convertDateAdd(node.Expr2, &IntervalExpr{Expr: node.Expr1, Unit: node.Unit}, false)
+		case "timestampdiff":
+			left, err := Convert(node.Expr1)
+			if err != nil {
+				return nil, err
+			}
+			right, err := Convert(node.Expr2)
+			if err != nil {
+				return nil, err
+			}
+			return &evalengine.TimestampDiff{Unit: strings.ToUpper(node.Unit), Left: left, Right: right}, nil
+		}
+	case *CaseExpr:
+		caseExpr := &evalengine.CaseExpr{}
+		var err error
+		if node.Expr != nil {
+			if caseExpr.Base, err = Convert(node.Expr); err != nil {
+				return nil, err
+			}
+		}
+		for _, when := range node.Whens {
+			cond, err := Convert(when.Cond)
+			if err != nil {
+				return nil, err
+			}
+			val, err := Convert(when.Val)
+			if err != nil {
+				return nil, err
+			}
+			caseExpr.Whens = append(caseExpr.Whens, evalengine.WhenThen{When: cond, Then: val})
+		}
+		if node.Else != nil {
+			if caseExpr.Else, err = Convert(node.Else); err != nil {
+				return nil, err
+			}
+		}
+		return caseExpr, nil
+	}
+	return nil, ErrExprNotSupported
+}
+
+func convertBinaryOp(op evalengine.BinaryExpr, l, r Expr) (evalengine.Expr, error) {
+	left, err := Convert(l)
+	if err != nil {
+		return nil, err
+	}
+	right, err := Convert(r)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.BinaryOp{
+		Expr:  op,
+		Left:  left,
+		Right: right,
+	}, nil
+}
+
+func convertUnaryOp(op evalengine.UnaryExpr, e Expr) (evalengine.Expr, error) {
+	inner, err := Convert(e)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.UnaryOp{
+		Expr:  op,
+		Inner: inner,
+	}, nil
+}
+
+func convertComparison(node *ComparisonExpr) (evalengine.Expr, error) {
+	var op evalengine.BinaryExpr
+	switch node.Operator {
+	case EqualOp:
+		op = &evalengine.Equals{}
+	case NotEqualOp:
+		op = &evalengine.NotEquals{}
+	case NullSafeEqualOp:
+		op = &evalengine.NullSafeEquals{}
+	case LessThanOp:
+		op = &evalengine.LessThan{}
+	case LessEqualOp:
+		op = &evalengine.LessEqual{}
+	case GreaterThanOp:
+		op = &evalengine.GreaterThan{}
+	case GreaterEqualOp:
+		op = &evalengine.GreaterEqual{}
+	case LikeOp, NotLikeOp:
+		like := &evalengine.Like{Negate: node.Operator == NotLikeOp}
+		if node.Escape != nil {
+			escape, ok := node.Escape.(*Literal)
+			if !ok || escape.Type != StrVal || utf8.RuneCountInString(escape.Val) != 1 {
+				return nil, ErrExprNotSupported
+			}
+			like.Escape, _ = utf8.DecodeRuneInString(escape.Val)
+		}
+		op = like
+	case InOp, NotInOp:
+		tuple, ok := node.Right.(ValTuple)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		left, err := Convert(node.Left)
 		if err != nil {
 			return nil, err
 		}
-		return &evalengine.BinaryOp{
-			Expr:  op,
-			Left:  left,
-			Right: right,
-		}, nil
+		in := &evalengine.InExpr{Left: left, Negate: node.Operator == NotInOp}
+		for _, e := range tuple {
+			right, err := Convert(e)
+			if err != nil {
+				return nil, err
+			}
+			in.Right = append(in.Right, right)
+		}
+		return in, nil
+	default:
+		return nil, ErrExprNotSupported
+	}
+	return convertBinaryOp(op, node.Left, node.Right)
+}
 
+func convertUnaryExpr(node *UnaryExpr) (evalengine.Expr, error) {
+	switch node.Operator {
+	case UPlusOp:
+		return Convert(node.Expr)
+	case UMinusOp:
+		return convertUnaryOp(&evalengine.Negate{}, node.Expr)
+	case TildaOp:
+		return convertUnaryOp(&evalengine.BitwiseNot{}, node.Expr)
+	case BangOp:
+		return convertUnaryOp(&evalengine.Not{}, node.Expr)
+	case BinaryOp:
+		inner, err := Convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		expr, _ := evalengine.NewConvertExpr(inner, "BINARY", -1, "")
+		return expr, nil
+	case UBinaryOp, Utf8mb4Op, Utf8Op, Latin1Op:
+		// charset introducers can only precede a string literal
+		lit, ok := node.Expr.(*Literal)
+		if !ok || lit.Type != StrVal {
+			return nil, ErrExprNotSupported
+		}
+		charset := strings.TrimSpace(strings.TrimPrefix(node.Operator.ToString(), "_"))
+		expr, ok := evalengine.NewLiteralStringWithCharset(lit.Bytes(), charset)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		return expr, nil
 	}
 	return nil, ErrExprNotSupported
 }
+
+func convertFuncExpr(node *FuncExpr) (evalengine.Expr, error) {
+	if !node.Qualifier.IsEmpty() || node.Distinct {
+		return nil, ErrExprNotSupported
+	}
+	var args []Expr
+	for _, selectExpr := range node.Exprs {
+		aliased, ok := selectExpr.(*AliasedExpr)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		args = append(args, aliased.Expr)
+	}
+	name := node.Name.Lowered()
+	switch name {
+	case "date_add", "date_sub", "adddate", "subdate":
+		if len(args) != 2 {
+			break
+		}
+		interval, ok := args[1].(*IntervalExpr)
+		if !ok {
+			if name == "date_add" || name == "date_sub" {
+				return nil, ErrExprNotSupported
+			}
+			// ADDDATE(date, days) and SUBDATE(date, days)
+			interval = &IntervalExpr{Expr: args[1], Unit: "day"}
+		}
+		return convertDateAdd(args[0], interval, name == "date_sub" || name == "subdate")
+	}
+	return convertCall(name, args)
+}
+
+func convertCall(name string, args []Expr) (evalengine.Expr, error) {
+	var exprs []evalengine.Expr
+	for _, arg := range args {
+		expr, err := Convert(arg)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	call, err := evalengine.NewCallExpr(name, exprs)
+	if err == evalengine.ErrUnsupportedFunction {
+		return nil, ErrExprNotSupported
+	}
+	return call, err
+}
+
+func convertDateAdd(date Expr, interval *IntervalExpr, subtract bool) (evalengine.Expr, error) {
+	if !evalengine.IsIntervalUnit(interval.Unit) {
+		return nil, ErrExprNotSupported
+	}
+	left, err := Convert(date)
+	if err != nil {
+		return nil, err
+	}
+	right, err := Convert(interval.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.DateAdd{
+		Date:     left,
+		Interval: right,
+		Unit:     strings.ToUpper(interval.Unit),
+		Subtract: subtract,
+	}, nil
+}
@@ -70,6 +70,234 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: "null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 + null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 / 0",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "7 div 2",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "-7 % 3",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "6 & 3",
+		expected:   sqltypes.NewUint64(2),
+	}, {
+		expression: "1 << 4",
+		expected:   sqltypes.NewUint64(16),
+	}, {
+		expression: "~0",
+		expected:   sqltypes.NewUint64(18446744073709551615),
+	}, {
+		expression: "-(2)",
+		expected:   sqltypes.NewInt64(-2),
+	}, {
+		expression: "'10abc' + 5",
+		expected:   sqltypes.NewFloat64(15),
+	}, {
+		expression: "1 = 1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 = null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "null <=> null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' = 'ABC'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' = 'ABC ' collate utf8mb4_bin",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "_binary 'abc' = 'ABC'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "'10' < 9",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "'2021-01-01' < date('2021-01-02')",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "2 between 1 and 3",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "2 not between 1 and 3",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "2 in (1, 2)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "3 in (1, null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "3 not in (1, 2)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'Vitess' like 'v%s'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'10%' like '10!%' escape '!'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' not like 'a_'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 and null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "0 and null",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "1 or null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 xor 1",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "not 0",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null is null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "0 is not false",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "case 2 when 1 then 'one' when 2 then 'two' end",
+		expected:   sqltypes.NewVarBinary("two"),
+	}, {
+		expression: "case when 1 > 2 then 'a' else 'b' end",
+		expected:   sqltypes.NewVarBinary("b"),
+	}, {
+		expression: "if(null, 1, 2)",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "ifnull(null, 'x')",
+		expected:   sqltypes.NewVarBinary("x"),
+	}, {
+		expression: "coalesce(null, null, 3)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "nullif(1, 1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "concat('a', 1, 'b')",
+		expected:   sqltypes.NewVarBinary("a1b"),
+	}, {
+		expression: "concat_ws(',', 'a', null, 'b')",
+		expected:   sqltypes.NewVarBinary("a,b"),
+	}, {
+		expression: "upper('vitess')",
+		expected:   sqltypes.NewVarBinary("VITESS"),
+	}, {
+		expression: "char_length('ñandú')",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "length('ñandú')",
+		expected:   sqltypes.NewInt64(7),
+	}, {
+		expression: "substring('vitess', 3)",
+		expected:   sqltypes.NewVarBinary("tess"),
+	}, {
+		expression: "substr('vitess' from -4 for 2)",
+		expected:   sqltypes.NewVarBinary("te"),
+	}, {
+		expression: "substring_index('a.b.c', '.', -2)",
+		expected:   sqltypes.NewVarBinary("b.c"),
+	}, {
+		expression: "locate('B', 'abcb', 3)",
+		expected:   sqltypes.NewInt64(4),
+	}, {
+		expression: "lpad('hi', 5, 'ab')",
+		expected:   sqltypes.NewVarBinary("abahi"),
+	}, {
+		expression: "replace('aAa', 'a', 'b')",
+		expected:   sqltypes.NewVarBinary("bAb"),
+	}, {
+		expression: "hex('abc')",
+		expected:   sqltypes.NewVarBinary("616263"),
+	}, {
+		expression: "hex(255)",
+		expected:   sqltypes.NewVarBinary("FF"),
+	}, {
+		expression: "strcmp('a', 'B')",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "field('b', 'a', 'b')",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "md5('vitess')",
+		expected:   sqltypes.NewVarBinary("be96275ac480ad99325e8166d21dadb5"),
+	}, {
+		expression: "abs(-3)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "round(2.675, 2)",
+		expected:   sqltypes.NewFloat64(2.68),
+	}, {
+		expression: "round(-2.5)",
+		expected:   sqltypes.NewFloat64(-3),
+	}, {
+		expression: "round(1250, -2)",
+		expected:   sqltypes.NewInt64(1300),
+	}, {
+		expression: "truncate(1.999, 1)",
+		expected:   sqltypes.NewFloat64(1.9),
+	}, {
+		expression: "floor(-1.5)",
+		expected:   sqltypes.NewFloat64(-2),
+	}, {
+		expression: "mod(10, 3)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "pow(2, 10)",
+		expected:   sqltypes.NewFloat64(1024),
+	}, {
+		expression: "sqrt(-1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "greatest(1, 3, 2)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "least('b', 'a')",
+		expected:   sqltypes.NewVarBinary("a"),
+	}, {
+		expression: "cast('12abc' as signed)",
+		expected:   sqltypes.NewInt64(12),
+	}, {
+		expression: "cast(-1 as unsigned)",
+		expected:   sqltypes.NewUint64(18446744073709551615),
+	}, {
+		expression: "convert('vitess', char(3))",
+		expected:   sqltypes.NewVarBinary("vit"),
+	}, {
+		expression: "date_add('2021-01-31', interval 1 month)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-02-28")),
+	}, {
+		expression: "'2021-01-01 10:00:00' - interval 90 minute",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-01 08:30:00")),
+	}, {
+		expression: "adddate('2021-01-01', 2)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-01-03")),
+	}, {
+		expression: "timestampdiff(month, '2021-01-31', '2021-02-28')",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "datediff('2021-03-01', '2021-02-01')",
+		expected:   sqltypes.NewInt64(28),
+	}, {
+		expression: "dayofweek('2021-06-15')",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "date_format('2021-06-15 13:04:05', '%W %D %M %Y %H:%i')",
+		expected:   sqltypes.NewVarBinary("Tuesday 15th June 2021 13:04"),
 	}}
 
 	for _, test := range tests {
@@ -100,3 +328,58 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		err        string
+	}{{
+		expression: "left('vitess')",
+		err:        "Incorrect parameter count in the call to native function 'left'",
+	}, {
+		expression: "'a' collate utf8mb4_bin = 'A' collate utf8mb4_general_ci",
+		err:        "Illegal mix of collations (utf8mb4_bin,EXPLICIT) and (utf8mb4_general_ci,EXPLICIT) for operation '='",
+	}, {
+		expression: "abs(-9223372036854775807 - 1)",
+		err:        "BIGINT value is out of range in 'abs(-9223372036854775808)'",
+	}, {
+		expression: "pow(10, 400)",
+		err:        "DOUBLE value is out of range in 'pow(10,400)'",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := Parse("select " + test.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			expr, err := Convert(astExpr)
+			if err == nil {
+				_, err = expr.Evaluate(evalengine.ExpressionEnv{})
+			}
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestConvertNotSupported(t *testing.T) {
+	tests := []string{
+		"now()",
+		"unknown_function(1)",
+		"col = 1",
+		"1 in ::list",
+		"'a' regexp 'b'",
+		"_utf8mb4 col",
+		"cast(1 as decimal(10, 2))",
+		"'a' collate unknown_collation",
+		"date_add('2021-01-01', 1)",
+	}
+	for _, expression := range tests {
+		t.Run(expression, func(t *testing.T) {
+			stmt, err := Parse("select " + expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			_, err = Convert(astExpr)
+			require.Equal(t, ErrExprNotSupported, err)
+		})
+	}
+}
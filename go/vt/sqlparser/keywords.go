@@ -142,6 +142,7 @@ var keywords = []keyword{
 	{"create", CREATE},
 	{"cross", CROSS},
 	{"csv", CSV},
+	{"current", CURRENT},
 	{"current_date", CURRENT_DATE},
 	{"current_time", CURRENT_TIME},
 	{"current_timestamp", CURRENT_TIMESTAMP},
@@ -215,6 +216,7 @@ var keywords = []keyword{
 	{"float4", UNUSED},
 	{"float8", UNUSED},
 	{"flush", FLUSH},
+	{"following", FOLLOWING},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
@@ -291,9 +293,10 @@ var keywords = []keyword{
 	{"level", LEVEL},
 	{"like", LIKE},
 	{"limit", LIMIT},
-	{"linear", UNUSED},
+	{"linear", LINEAR},
 	{"lines", LINES},
 	{"linestring", LINESTRING},
+	{"list", LIST},
 	{"load", LOAD},
 	{"local", LOCAL},
 	{"localtime", LOCALTIME},
@@ -354,31 +357,33 @@ var keywords = []keyword{
 	{"out", UNUSED},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
 	{"partition", PARTITION},
 	{"partitioning", PARTITIONING},
+	{"partitions", PARTITIONS},
 	{"password", PASSWORD},
 	{"percent_rank", UNUSED},
 	{"plugins", PLUGINS},
 	{"point", POINT},
 	{"polygon", POLYGON},
+	{"preceding", PRECEDING},
 	{"precision", UNUSED},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
 	{"query", QUERY},
-	{"range", UNUSED},
+	{"range", RANGE},
 	{"rank", UNUSED},
 	{"read", READ},
 	{"reads", UNUSED},
 	{"read_write", UNUSED},
 	{"real", REAL},
 	{"rebuild", REBUILD},
-	{"recursive", UNUSED},
+	{"recursive", RECURSIVE},
 	{"redundant", REDUNDANT},
 	{"references", REFERENCES},
 	{"regexp", REGEXP},
@@ -401,8 +406,10 @@ var keywords = []keyword{
 	{"right", RIGHT},
 	{"rlike", REGEXP},
 	{"rollback", ROLLBACK},
+	{"row", ROW},
 	{"row_format", ROW_FORMAT},
 	{"row_number", UNUSED},
+	{"rows", ROWS},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
 	{"schema", SCHEMA},
@@ -472,6 +479,7 @@ var keywords = []keyword{
 	{"triggers", TRIGGERS},
 	{"true", TRUE},
 	{"truncate", TRUNCATE},
+	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
 	{"undo", UNUSED},
@@ -513,7 +521,7 @@ var keywords = []keyword{
 	{"when", WHEN},
 	{"where", WHERE},
 	{"while", UNUSED},
-	{"window", UNUSED},
+	{"window", WINDOW},
 	{"with", WITH},
 	{"without", WITHOUT},
 	{"work", WORK},
@@ -167,6 +167,45 @@ var (
 		input: "select * from t1 where exists (select a from t2 union select b from t3)",
 	}, {
 		input: "select 1 from dual union select 2 from dual union all select 3 from dual union select 4 from dual union all select 5 from dual",
+	}, {
+		input: "with t as (select a from s) select * from t",
+	}, {
+		input: "with t(a, b) as (select x, y from s), u as (select a from t where b = 1) select * from u join t on u.a = t.a",
+	}, {
+		input:  "WITH RECURSIVE cte (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM cte WHERE n < 5) SELECT * FROM cte",
+		output: "with recursive cte(n) as (select 1 from dual union all select n + 1 from cte where n < 5) select * from cte",
+	}, {
+		input:  "with t as (select a from s) select a from t union select b from u order by a",
+		output: "with t as (select a from s) select a from t union select b from u order by a asc",
+	}, {
+		input: "select * from t1 where col in (with t as (select a from s) select a from t)",
+	}, {
+		input: "select a from `recursive`",
+	}, {
+		input:  "select a, row_number() over (partition by b order by c) from t",
+		output: "select a, row_number() over (partition by b order by c asc) from t",
+	}, {
+		input:  "select rank() over (order by a desc, b), dense_rank() over (order by a) from t",
+		output: "select rank() over (order by a desc, b asc), dense_rank() over (order by a asc) from t",
+	}, {
+		input: "select lag(a, 1, 0) over (partition by b, c), lead(a) over () from t",
+	}, {
+		input:  "select sum(a) over (partition by b order by c rows between unbounded preceding and current row) from t",
+		output: "select sum(a) over (partition by b order by c asc rows between unbounded preceding and current row) from t",
+	}, {
+		input: "select count(*) over (rows 2 preceding), avg(a) over (rows between :n preceding and 1 following) from t",
+	}, {
+		input:  "select first_value(a) over (order by d range between interval 1 day preceding and unbounded following) from t",
+		output: "select first_value(a) over (order by d asc range between interval 1 day preceding and unbounded following) from t",
+	}, {
+		input:  "select a from t where row_number < 3 and `over` = `rows`",
+		output: "select a from t where `row_number` < 3 and `over` = `rows`",
+	}, {
+		input:  "select rows from t where rows > 70",
+		output: "select `rows` from t where `rows` > 70",
+	}, {
+		input:  "select row from t where row > 70",
+		output: "select `row` from t where `row` > 70",
 	}, {
 		input: "(select 1 from dual) order by 1 asc limit 2",
 	}, {
@@ -941,6 +980,9 @@ var (
 	}, {
 		input:  "alter table a add foo int references simple (a) on delete restrict first v",
 		output: "alter table a add column foo int references simple (a) on delete restrict first v",
+	}, {
+		input:  "alter table a add foo int references simple (a) on delete restrict first",
+		output: "alter table a add column foo int references simple (a) on delete restrict first",
 	}, {
 		input:  "alter table a lock default, lock = none, lock shared, lock exclusive",
 		output: "alter table a lock default, lock none, lock shared, lock exclusive",
@@ -959,6 +1001,8 @@ var (
 		input: "alter table a change column s foo int default 1 after x",
 	}, {
 		input: "alter table a modify column foo int default 1 first x",
+	}, {
+		input: "alter table a modify column foo int default 1 first",
 	}, {
 		input:  "alter table a add foo varchar(255) generated always as (concat(bar, ' ', baz)) stored",
 		output: "alter table a add column foo varchar(255) as (concat(bar, ' ', baz)) stored",
@@ -1016,6 +1060,8 @@ var (
 		output: "alter table a reorganize partition b into (partition c values less than (:v1), partition d values less than (maxvalue))",
 	}, {
 		input: "alter table a algorithm = default, lock none, add partition (partition d values less than (maxvalue))",
+	}, {
+		input: "alter table a add partition (partition p5 values less than (500), partition p6 values less than (600))",
 	}, {
 		input: "alter table a discard partition all tablespace",
 	}, {
@@ -1054,9 +1100,16 @@ var (
 		input:  "alter table t2 add primary key `zzz` (id)",
 		output: "alter table t2 add primary key (id)",
 	}, {
-		input:      "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+		input: "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+	}, {
+		input: "alter table a add column c int partition by hash (id) partitions 4",
+	}, {
+		input:      "alter table a partition by foo (id)",
 		output:     "alter table a",
 		partialDDL: true,
+	}, {
+		input:  "alter table a partition by list columns (c) (partition p0 values in (1, 2) engine = InnoDB, partition p1 values in (3))",
+		output: "alter table a partition by list columns (c) (partition p0 values in (1, 2) engine InnoDB, partition p1 values in (3))",
 	}, {
 		input:      "create database a garbage values",
 		output:     "create database a",
@@ -1101,6 +1154,10 @@ var (
 		output: "alter table a add column id int",
 	}, {
 		input: "alter table a add column id int first id2",
+	}, {
+		input: "alter table a add column id int first",
+	}, {
+		input: "alter table a add column id int first after id2",
 	}, {
 		input: "alter table a add column id int after id2",
 	}, {
@@ -1113,9 +1170,7 @@ var (
 	}, {
 		input: "alter table a add check (ch_1) not enforced",
 	}, {
-		input:      "alter table a drop check ch_1",
-		output:     "alter table a",
-		partialDDL: true,
+		input: "alter table a drop check ch_1",
 	}, {
 		input: "alter table a drop foreign key kx",
 	}, {
@@ -2395,6 +2450,12 @@ func TestConvert(t *testing.T) {
 	}, {
 		input:  "select convert from t",
 		output: "syntax error at position 20 near 'from'",
+	}, {
+		input:  "select a over (order by b) from t",
+		output: "syntax error at position 14 near 'over'",
+	}, {
+		input:  "select sum(a) over (rows between current and 1 following) from t",
+		output: "syntax error at position 45 near 'and'",
 	}, {
 		input:  "select cast('foo', decimal) from t",
 		output: "syntax error at position 19",
@@ -3102,6 +3163,39 @@ func TestCreateTable(t *testing.T) {
 			output: `create table t1 (
 	id int(11)
 ) ENGINE FOOBAR`,
+		}, {
+			input: "create table t1 (id int) partition by linear hash (id) partitions 4",
+			output: `create table t1 (
+	id int
+)
+partition by linear hash (id) partitions 4`,
+		}, {
+			input: "create table t1 (id int, name varchar(10)) partition by key (id, name)",
+			output: `create table t1 (
+	id int,
+	` + "`name`" + ` varchar(10)
+)
+partition by key (id, ` + "`name`" + `)`,
+		}, {
+			input: "CREATE TABLE `t1` (\n  `id` int NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4\n/*!50100 PARTITION BY RANGE (`id`)\n(PARTITION p0 VALUES LESS THAN (10) ENGINE = InnoDB,\n PARTITION p1 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */",
+			output: `create table t1 (
+	id int not null
+) ENGINE InnoDB,
+  CHARSET utf8mb4
+partition by range (id) (partition p0 values less than (10) engine InnoDB, partition p1 values less than (maxvalue) engine InnoDB)`,
+		}, {
+			input: "create table t1 (a int, b date) partition by range columns (b) (partition p0 values less than ('2021-01-01'), partition p1 values less than (maxvalue))",
+			output: `create table t1 (
+	a int,
+	b date
+)
+partition by range columns (b) (partition p0 values less than ('2021-01-01'), partition p1 values less than (maxvalue))`,
+		}, {
+			input: "create table t1 (a int) partition by list (a) (partition p0 values in (1, 3, 5), partition p1 values in (2, 4, 6))",
+			output: `create table t1 (
+	a int
+)
+partition by list (a) (partition p0 values in (1, 3, 5), partition p1 values in (2, 4, 6))`,
 		},
 	}
 	for _, test := range createTableQueries {
@@ -3188,6 +3282,12 @@ var (
 	}{{
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
+	}, {
+		input:  "select rank() over w from t",
+		output: "unsupported: named windows at position 21 near 'w'",
+	}, {
+		input:  "select a from t window w as (order by a)",
+		output: "unsupported: named windows at position 41",
 	}, {
 		input:  "select 0xH from t",
 		output: "syntax error at position 10 near '0x'",
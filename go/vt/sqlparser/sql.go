@@ -150,361 +150,369 @@ const VIRTUAL = 57447
 const STORED = 57448
 const LOWER_THAN_CHARSET = 57449
 const CHARSET = 57450
-const UNIQUE = 57451
-const KEY = 57452
-const OR = 57453
-const XOR = 57454
-const AND = 57455
-const NOT = 57456
-const BETWEEN = 57457
-const CASE = 57458
-const WHEN = 57459
-const THEN = 57460
-const ELSE = 57461
-const END = 57462
-const LE = 57463
-const GE = 57464
-const NE = 57465
-const NULL_SAFE_EQUAL = 57466
-const IS = 57467
-const LIKE = 57468
-const REGEXP = 57469
-const IN = 57470
-const SHIFT_LEFT = 57471
-const SHIFT_RIGHT = 57472
-const DIV = 57473
-const MOD = 57474
-const UNARY = 57475
-const COLLATE = 57476
-const BINARY = 57477
-const UNDERSCORE_BINARY = 57478
-const UNDERSCORE_UTF8MB4 = 57479
-const UNDERSCORE_UTF8 = 57480
-const UNDERSCORE_LATIN1 = 57481
-const INTERVAL = 57482
-const JSON_EXTRACT_OP = 57483
-const JSON_UNQUOTE_EXTRACT_OP = 57484
-const CREATE = 57485
-const ALTER = 57486
-const DROP = 57487
-const RENAME = 57488
-const ANALYZE = 57489
-const ADD = 57490
-const FLUSH = 57491
-const CHANGE = 57492
-const MODIFY = 57493
-const REVERT = 57494
-const SCHEMA = 57495
-const TABLE = 57496
-const INDEX = 57497
-const VIEW = 57498
-const TO = 57499
-const IGNORE = 57500
-const IF = 57501
-const PRIMARY = 57502
-const COLUMN = 57503
-const SPATIAL = 57504
-const FULLTEXT = 57505
-const KEY_BLOCK_SIZE = 57506
-const CHECK = 57507
-const INDEXES = 57508
-const ACTION = 57509
-const CASCADE = 57510
-const CONSTRAINT = 57511
-const FOREIGN = 57512
-const NO = 57513
-const REFERENCES = 57514
-const RESTRICT = 57515
-const SHOW = 57516
-const DESCRIBE = 57517
-const EXPLAIN = 57518
-const DATE = 57519
-const ESCAPE = 57520
-const REPAIR = 57521
-const OPTIMIZE = 57522
-const TRUNCATE = 57523
-const COALESCE = 57524
-const EXCHANGE = 57525
-const REBUILD = 57526
-const PARTITIONING = 57527
-const REMOVE = 57528
-const MAXVALUE = 57529
-const PARTITION = 57530
-const REORGANIZE = 57531
-const LESS = 57532
-const THAN = 57533
-const PROCEDURE = 57534
-const TRIGGER = 57535
-const VINDEX = 57536
-const VINDEXES = 57537
-const DIRECTORY = 57538
-const NAME = 57539
-const UPGRADE = 57540
-const STATUS = 57541
-const VARIABLES = 57542
-const WARNINGS = 57543
-const CASCADED = 57544
-const DEFINER = 57545
-const OPTION = 57546
-const SQL = 57547
-const UNDEFINED = 57548
-const SEQUENCE = 57549
-const MERGE = 57550
-const TEMPORARY = 57551
-const TEMPTABLE = 57552
-const INVOKER = 57553
-const SECURITY = 57554
-const FIRST = 57555
-const AFTER = 57556
-const LAST = 57557
-const VITESS_MIGRATION = 57558
-const CANCEL = 57559
-const RETRY = 57560
-const COMPLETE = 57561
-const BEGIN = 57562
-const START = 57563
-const TRANSACTION = 57564
-const COMMIT = 57565
-const ROLLBACK = 57566
-const SAVEPOINT = 57567
-const RELEASE = 57568
-const WORK = 57569
-const BIT = 57570
-const TINYINT = 57571
-const SMALLINT = 57572
-const MEDIUMINT = 57573
-const INT = 57574
-const INTEGER = 57575
-const BIGINT = 57576
-const INTNUM = 57577
-const REAL = 57578
-const DOUBLE = 57579
-const FLOAT_TYPE = 57580
-const DECIMAL = 57581
-const NUMERIC = 57582
-const TIME = 57583
-const TIMESTAMP = 57584
-const DATETIME = 57585
-const YEAR = 57586
-const CHAR = 57587
-const VARCHAR = 57588
-const BOOL = 57589
-const CHARACTER = 57590
-const VARBINARY = 57591
-const NCHAR = 57592
-const TEXT = 57593
-const TINYTEXT = 57594
-const MEDIUMTEXT = 57595
-const LONGTEXT = 57596
-const BLOB = 57597
-const TINYBLOB = 57598
-const MEDIUMBLOB = 57599
-const LONGBLOB = 57600
-const JSON = 57601
-const ENUM = 57602
-const GEOMETRY = 57603
-const POINT = 57604
-const LINESTRING = 57605
-const POLYGON = 57606
-const GEOMETRYCOLLECTION = 57607
-const MULTIPOINT = 57608
-const MULTILINESTRING = 57609
-const MULTIPOLYGON = 57610
-const NULLX = 57611
-const AUTO_INCREMENT = 57612
-const APPROXNUM = 57613
-const SIGNED = 57614
-const UNSIGNED = 57615
-const ZEROFILL = 57616
-const CODE = 57617
-const COLLATION = 57618
-const COLUMNS = 57619
-const DATABASES = 57620
-const ENGINES = 57621
-const EVENT = 57622
-const EXTENDED = 57623
-const FIELDS = 57624
-const FULL = 57625
-const FUNCTION = 57626
-const GTID_EXECUTED = 57627
-const KEYSPACES = 57628
-const OPEN = 57629
-const PLUGINS = 57630
-const PRIVILEGES = 57631
-const PROCESSLIST = 57632
-const SCHEMAS = 57633
-const TABLES = 57634
-const TRIGGERS = 57635
-const USER = 57636
-const VGTID_EXECUTED = 57637
-const VITESS_KEYSPACES = 57638
-const VITESS_METADATA = 57639
-const VITESS_MIGRATIONS = 57640
-const VITESS_SHARDS = 57641
-const VITESS_TABLETS = 57642
-const VSCHEMA = 57643
-const NAMES = 57644
-const GLOBAL = 57645
-const SESSION = 57646
-const ISOLATION = 57647
-const LEVEL = 57648
-const READ = 57649
-const WRITE = 57650
-const ONLY = 57651
-const REPEATABLE = 57652
-const COMMITTED = 57653
-const UNCOMMITTED = 57654
-const SERIALIZABLE = 57655
-const CURRENT_TIMESTAMP = 57656
-const DATABASE = 57657
-const CURRENT_DATE = 57658
-const CURRENT_TIME = 57659
-const LOCALTIME = 57660
-const LOCALTIMESTAMP = 57661
-const CURRENT_USER = 57662
-const UTC_DATE = 57663
-const UTC_TIME = 57664
-const UTC_TIMESTAMP = 57665
-const REPLACE = 57666
-const CONVERT = 57667
-const CAST = 57668
-const SUBSTR = 57669
-const SUBSTRING = 57670
-const GROUP_CONCAT = 57671
-const SEPARATOR = 57672
-const TIMESTAMPADD = 57673
-const TIMESTAMPDIFF = 57674
-const MATCH = 57675
-const AGAINST = 57676
-const BOOLEAN = 57677
-const LANGUAGE = 57678
-const WITH = 57679
-const QUERY = 57680
-const EXPANSION = 57681
-const WITHOUT = 57682
-const VALIDATION = 57683
-const UNUSED = 57684
-const ARRAY = 57685
-const CUME_DIST = 57686
-const DESCRIPTION = 57687
-const DENSE_RANK = 57688
-const EMPTY = 57689
-const EXCEPT = 57690
-const FIRST_VALUE = 57691
-const GROUPING = 57692
-const GROUPS = 57693
-const JSON_TABLE = 57694
-const LAG = 57695
-const LAST_VALUE = 57696
-const LATERAL = 57697
-const LEAD = 57698
-const MEMBER = 57699
-const NTH_VALUE = 57700
-const NTILE = 57701
-const OF = 57702
-const OVER = 57703
-const PERCENT_RANK = 57704
-const RANK = 57705
-const RECURSIVE = 57706
-const ROW_NUMBER = 57707
-const SYSTEM = 57708
-const WINDOW = 57709
-const ACTIVE = 57710
-const ADMIN = 57711
-const BUCKETS = 57712
-const CLONE = 57713
-const COMPONENT = 57714
-const DEFINITION = 57715
-const ENFORCED = 57716
-const EXCLUDE = 57717
-const FOLLOWING = 57718
-const GEOMCOLLECTION = 57719
-const GET_MASTER_PUBLIC_KEY = 57720
-const HISTOGRAM = 57721
-const HISTORY = 57722
-const INACTIVE = 57723
-const INVISIBLE = 57724
-const LOCKED = 57725
-const MASTER_COMPRESSION_ALGORITHMS = 57726
-const MASTER_PUBLIC_KEY_PATH = 57727
-const MASTER_TLS_CIPHERSUITES = 57728
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57729
-const NESTED = 57730
-const NETWORK_NAMESPACE = 57731
-const NOWAIT = 57732
-const NULLS = 57733
-const OJ = 57734
-const OLD = 57735
-const OPTIONAL = 57736
-const ORDINALITY = 57737
-const ORGANIZATION = 57738
-const OTHERS = 57739
-const PATH = 57740
-const PERSIST = 57741
-const PERSIST_ONLY = 57742
-const PRECEDING = 57743
-const PRIVILEGE_CHECKS_USER = 57744
-const PROCESS = 57745
-const RANDOM = 57746
-const REFERENCE = 57747
-const REQUIRE_ROW_FORMAT = 57748
-const RESOURCE = 57749
-const RESPECT = 57750
-const RESTART = 57751
-const RETAIN = 57752
-const REUSE = 57753
-const ROLE = 57754
-const SECONDARY = 57755
-const SECONDARY_ENGINE = 57756
-const SECONDARY_LOAD = 57757
-const SECONDARY_UNLOAD = 57758
-const SKIP = 57759
-const SRID = 57760
-const THREAD_PRIORITY = 57761
-const TIES = 57762
-const UNBOUNDED = 57763
-const VCPU = 57764
-const VISIBLE = 57765
-const FORMAT = 57766
-const TREE = 57767
-const VITESS = 57768
-const TRADITIONAL = 57769
-const LOCAL = 57770
-const LOW_PRIORITY = 57771
-const NO_WRITE_TO_BINLOG = 57772
-const LOGS = 57773
-const ERROR = 57774
-const GENERAL = 57775
-const HOSTS = 57776
-const OPTIMIZER_COSTS = 57777
-const USER_RESOURCES = 57778
-const SLOW = 57779
-const CHANNEL = 57780
-const RELAY = 57781
-const EXPORT = 57782
-const AVG_ROW_LENGTH = 57783
-const CONNECTION = 57784
-const CHECKSUM = 57785
-const DELAY_KEY_WRITE = 57786
-const ENCRYPTION = 57787
-const ENGINE = 57788
-const INSERT_METHOD = 57789
-const MAX_ROWS = 57790
-const MIN_ROWS = 57791
-const PACK_KEYS = 57792
-const PASSWORD = 57793
-const FIXED = 57794
-const DYNAMIC = 57795
-const COMPRESSED = 57796
-const REDUNDANT = 57797
-const COMPACT = 57798
-const ROW_FORMAT = 57799
-const STATS_AUTO_RECALC = 57800
-const STATS_PERSISTENT = 57801
-const STATS_SAMPLE_PAGES = 57802
-const STORAGE = 57803
-const MEMORY = 57804
-const DISK = 57805
+const AFTER = 57451
+const REMOVE = 57452
+const FIRST_WITHOUT_COLUMN = 57453
+const UNIQUE = 57454
+const KEY = 57455
+const OR = 57456
+const XOR = 57457
+const AND = 57458
+const NOT = 57459
+const BETWEEN = 57460
+const CASE = 57461
+const WHEN = 57462
+const THEN = 57463
+const ELSE = 57464
+const END = 57465
+const LE = 57466
+const GE = 57467
+const NE = 57468
+const NULL_SAFE_EQUAL = 57469
+const IS = 57470
+const LIKE = 57471
+const REGEXP = 57472
+const IN = 57473
+const SHIFT_LEFT = 57474
+const SHIFT_RIGHT = 57475
+const DIV = 57476
+const MOD = 57477
+const UNARY = 57478
+const COLLATE = 57479
+const BINARY = 57480
+const UNDERSCORE_BINARY = 57481
+const UNDERSCORE_UTF8MB4 = 57482
+const UNDERSCORE_UTF8 = 57483
+const UNDERSCORE_LATIN1 = 57484
+const INTERVAL = 57485
+const JSON_EXTRACT_OP = 57486
+const JSON_UNQUOTE_EXTRACT_OP = 57487
+const CREATE = 57488
+const ALTER = 57489
+const DROP = 57490
+const RENAME = 57491
+const ANALYZE = 57492
+const ADD = 57493
+const FLUSH = 57494
+const CHANGE = 57495
+const MODIFY = 57496
+const REVERT = 57497
+const SCHEMA = 57498
+const TABLE = 57499
+const INDEX = 57500
+const VIEW = 57501
+const TO = 57502
+const IGNORE = 57503
+const IF = 57504
+const PRIMARY = 57505
+const COLUMN = 57506
+const SPATIAL = 57507
+const FULLTEXT = 57508
+const KEY_BLOCK_SIZE = 57509
+const CHECK = 57510
+const INDEXES = 57511
+const ACTION = 57512
+const CASCADE = 57513
+const CONSTRAINT = 57514
+const FOREIGN = 57515
+const NO = 57516
+const REFERENCES = 57517
+const RESTRICT = 57518
+const SHOW = 57519
+const DESCRIBE = 57520
+const EXPLAIN = 57521
+const DATE = 57522
+const ESCAPE = 57523
+const REPAIR = 57524
+const OPTIMIZE = 57525
+const TRUNCATE = 57526
+const COALESCE = 57527
+const EXCHANGE = 57528
+const REBUILD = 57529
+const PARTITIONING = 57530
+const MAXVALUE = 57531
+const PARTITION = 57532
+const REORGANIZE = 57533
+const LESS = 57534
+const THAN = 57535
+const PROCEDURE = 57536
+const TRIGGER = 57537
+const LINEAR = 57538
+const LIST = 57539
+const PARTITIONS = 57540
+const VINDEX = 57541
+const VINDEXES = 57542
+const DIRECTORY = 57543
+const NAME = 57544
+const UPGRADE = 57545
+const STATUS = 57546
+const VARIABLES = 57547
+const WARNINGS = 57548
+const CASCADED = 57549
+const DEFINER = 57550
+const OPTION = 57551
+const SQL = 57552
+const UNDEFINED = 57553
+const SEQUENCE = 57554
+const MERGE = 57555
+const TEMPORARY = 57556
+const TEMPTABLE = 57557
+const INVOKER = 57558
+const SECURITY = 57559
+const FIRST = 57560
+const LAST = 57561
+const VITESS_MIGRATION = 57562
+const CANCEL = 57563
+const RETRY = 57564
+const COMPLETE = 57565
+const BEGIN = 57566
+const START = 57567
+const TRANSACTION = 57568
+const COMMIT = 57569
+const ROLLBACK = 57570
+const SAVEPOINT = 57571
+const RELEASE = 57572
+const WORK = 57573
+const BIT = 57574
+const TINYINT = 57575
+const SMALLINT = 57576
+const MEDIUMINT = 57577
+const INT = 57578
+const INTEGER = 57579
+const BIGINT = 57580
+const INTNUM = 57581
+const REAL = 57582
+const DOUBLE = 57583
+const FLOAT_TYPE = 57584
+const DECIMAL = 57585
+const NUMERIC = 57586
+const TIME = 57587
+const TIMESTAMP = 57588
+const DATETIME = 57589
+const YEAR = 57590
+const CHAR = 57591
+const VARCHAR = 57592
+const BOOL = 57593
+const CHARACTER = 57594
+const VARBINARY = 57595
+const NCHAR = 57596
+const TEXT = 57597
+const TINYTEXT = 57598
+const MEDIUMTEXT = 57599
+const LONGTEXT = 57600
+const BLOB = 57601
+const TINYBLOB = 57602
+const MEDIUMBLOB = 57603
+const LONGBLOB = 57604
+const JSON = 57605
+const ENUM = 57606
+const GEOMETRY = 57607
+const POINT = 57608
+const LINESTRING = 57609
+const POLYGON = 57610
+const GEOMETRYCOLLECTION = 57611
+const MULTIPOINT = 57612
+const MULTILINESTRING = 57613
+const MULTIPOLYGON = 57614
+const NULLX = 57615
+const AUTO_INCREMENT = 57616
+const APPROXNUM = 57617
+const SIGNED = 57618
+const UNSIGNED = 57619
+const ZEROFILL = 57620
+const CODE = 57621
+const COLLATION = 57622
+const COLUMNS = 57623
+const DATABASES = 57624
+const ENGINES = 57625
+const EVENT = 57626
+const EXTENDED = 57627
+const FIELDS = 57628
+const FULL = 57629
+const FUNCTION = 57630
+const GTID_EXECUTED = 57631
+const KEYSPACES = 57632
+const OPEN = 57633
+const PLUGINS = 57634
+const PRIVILEGES = 57635
+const PROCESSLIST = 57636
+const SCHEMAS = 57637
+const TABLES = 57638
+const TRIGGERS = 57639
+const USER = 57640
+const VGTID_EXECUTED = 57641
+const VITESS_KEYSPACES = 57642
+const VITESS_METADATA = 57643
+const VITESS_MIGRATIONS = 57644
+const VITESS_SHARDS = 57645
+const VITESS_TABLETS = 57646
+const VSCHEMA = 57647
+const NAMES = 57648
+const GLOBAL = 57649
+const SESSION = 57650
+const ISOLATION = 57651
+const LEVEL = 57652
+const READ = 57653
+const WRITE = 57654
+const ONLY = 57655
+const REPEATABLE = 57656
+const COMMITTED = 57657
+const UNCOMMITTED = 57658
+const SERIALIZABLE = 57659
+const CURRENT_TIMESTAMP = 57660
+const DATABASE = 57661
+const CURRENT_DATE = 57662
+const CURRENT_TIME = 57663
+const LOCALTIME = 57664
+const LOCALTIMESTAMP = 57665
+const CURRENT_USER = 57666
+const UTC_DATE = 57667
+const UTC_TIME = 57668
+const UTC_TIMESTAMP = 57669
+const REPLACE = 57670
+const CONVERT = 57671
+const CAST = 57672
+const SUBSTR = 57673
+const SUBSTRING = 57674
+const GROUP_CONCAT = 57675
+const SEPARATOR = 57676
+const TIMESTAMPADD = 57677
+const TIMESTAMPDIFF = 57678
+const MATCH = 57679
+const AGAINST = 57680
+const BOOLEAN = 57681
+const LANGUAGE = 57682
+const WITH = 57683
+const QUERY = 57684
+const EXPANSION = 57685
+const WITHOUT = 57686
+const VALIDATION = 57687
+const ROWS = 57688
+const ROW = 57689
+const RANGE = 57690
+const CURRENT = 57691
+const UNUSED = 57692
+const ARRAY = 57693
+const CUME_DIST = 57694
+const DESCRIPTION = 57695
+const DENSE_RANK = 57696
+const EMPTY = 57697
+const EXCEPT = 57698
+const FIRST_VALUE = 57699
+const GROUPING = 57700
+const GROUPS = 57701
+const JSON_TABLE = 57702
+const LAG = 57703
+const LAST_VALUE = 57704
+const LATERAL = 57705
+const LEAD = 57706
+const MEMBER = 57707
+const NTH_VALUE = 57708
+const NTILE = 57709
+const OF = 57710
+const OVER = 57711
+const PERCENT_RANK = 57712
+const RANK = 57713
+const RECURSIVE = 57714
+const ROW_NUMBER = 57715
+const SYSTEM = 57716
+const WINDOW = 57717
+const ACTIVE = 57718
+const ADMIN = 57719
+const BUCKETS = 57720
+const CLONE = 57721
+const COMPONENT = 57722
+const DEFINITION = 57723
+const ENFORCED = 57724
+const EXCLUDE = 57725
+const FOLLOWING = 57726
+const GEOMCOLLECTION = 57727
+const GET_MASTER_PUBLIC_KEY = 57728
+const HISTOGRAM = 57729
+const HISTORY = 57730
+const INACTIVE = 57731
+const INVISIBLE = 57732
+const LOCKED = 57733
+const MASTER_COMPRESSION_ALGORITHMS = 57734
+const MASTER_PUBLIC_KEY_PATH = 57735
+const MASTER_TLS_CIPHERSUITES = 57736
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57737
+const NESTED = 57738
+const NETWORK_NAMESPACE = 57739
+const NOWAIT = 57740
+const NULLS = 57741
+const OJ = 57742
+const OLD = 57743
+const OPTIONAL = 57744
+const ORDINALITY = 57745
+const ORGANIZATION = 57746
+const OTHERS = 57747
+const PATH = 57748
+const PERSIST = 57749
+const PERSIST_ONLY = 57750
+const PRECEDING = 57751
+const PRIVILEGE_CHECKS_USER = 57752
+const PROCESS = 57753
+const RANDOM = 57754
+const REFERENCE = 57755
+const REQUIRE_ROW_FORMAT = 57756
+const RESOURCE = 57757
+const RESPECT = 57758
+const RESTART = 57759
+const RETAIN = 57760
+const REUSE = 57761
+const ROLE = 57762
+const SECONDARY = 57763
+const SECONDARY_ENGINE = 57764
+const SECONDARY_LOAD = 57765
+const SECONDARY_UNLOAD = 57766
+const SKIP = 57767
+const SRID = 57768
+const THREAD_PRIORITY = 57769
+const TIES = 57770
+const UNBOUNDED = 57771
+const VCPU = 57772
+const VISIBLE = 57773
+const FORMAT = 57774
+const TREE = 57775
+const VITESS = 57776
+const TRADITIONAL = 57777
+const LOCAL = 57778
+const LOW_PRIORITY = 57779
+const NO_WRITE_TO_BINLOG = 57780
+const LOGS = 57781
+const ERROR = 57782
+const GENERAL = 57783
+const HOSTS = 57784
+const OPTIMIZER_COSTS = 57785
+const USER_RESOURCES = 57786
+const SLOW = 57787
+const CHANNEL = 57788
+const RELAY = 57789
+const EXPORT = 57790
+const AVG_ROW_LENGTH = 57791
+const CONNECTION = 57792
+const CHECKSUM = 57793
+const DELAY_KEY_WRITE = 57794
+const ENCRYPTION = 57795
+const ENGINE = 57796
+const INSERT_METHOD = 57797
+const MAX_ROWS = 57798
+const MIN_ROWS = 57799
+const PACK_KEYS = 57800
+const PASSWORD = 57801
+const FIXED = 57802
+const DYNAMIC = 57803
+const COMPRESSED = 57804
+const REDUNDANT = 57805
+const COMPACT = 57806
+const ROW_FORMAT = 57807
+const STATS_AUTO_RECALC = 57808
+const STATS_PERSISTENT = 57809
+const STATS_SAMPLE_PAGES = 57810
+const STORAGE = 57811
+const MEMORY = 57812
+const DISK = 57813
 
 var yyToknames = [...]string{
 	"$end",
@@ -618,6 +626,9 @@ var yyToknames = [...]string{
 	"STORED",
 	"LOWER_THAN_CHARSET",
 	"CHARSET",
+	"AFTER",
+	"REMOVE",
+	"FIRST_WITHOUT_COLUMN",
 	"UNIQUE",
 	"KEY",
 	"OR",
@@ -709,7 +720,6 @@ var yyToknames = [...]string{
 	"EXCHANGE",
 	"REBUILD",
 	"PARTITIONING",
-	"REMOVE",
 	"MAXVALUE",
 	"PARTITION",
 	"REORGANIZE",
@@ -717,6 +727,9 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"LINEAR",
+	"LIST",
+	"PARTITIONS",
 	"VINDEX",
 	"VINDEXES",
 	"DIRECTORY",
@@ -737,7 +750,6 @@ var yyToknames = [...]string{
 	"INVOKER",
 	"SECURITY",
 	"FIRST",
-	"AFTER",
 	"LAST",
 	"VITESS_MIGRATION",
 	"CANCEL",
@@ -865,6 +877,10 @@ var yyToknames = [...]string{
 	"EXPANSION",
 	"WITHOUT",
 	"VALIDATION",
+	"ROWS",
+	"ROW",
+	"RANGE",
+	"CURRENT",
 	"UNUSED",
 	"ARRAY",
 	"CUME_DIST",
@@ -1001,3154 +1017,3333 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 45,
-	1, 112,
-	481, 112,
-	-2, 118,
 	-1, 46,
-	111, 118,
-	150, 118,
-	265, 118,
-	-2, 341,
-	-1, 53,
-	33, 490,
-	172, 490,
-	183, 490,
-	216, 504,
-	217, 504,
-	-2, 492,
-	-1, 58,
-	174, 514,
-	-2, 512,
-	-1, 84,
-	57, 582,
-	-2, 590,
-	-1, 97,
-	171, 956,
+	1, 119,
+	489, 119,
+	-2, 125,
+	-1, 47,
+	111, 125,
+	153, 125,
+	269, 125,
+	-2, 349,
+	-1, 54,
+	33, 521,
+	175, 521,
+	186, 521,
+	221, 535,
+	222, 535,
+	-2, 523,
+	-1, 59,
+	177, 545,
+	-2, 543,
+	-1, 88,
+	57, 613,
+	-2, 621,
+	-1, 101,
+	174, 1013,
+	-2, 98,
+	-1, 103,
+	1, 120,
+	489, 120,
+	-2, 125,
+	-1, 113,
+	115, 251,
+	180, 251,
+	-2, 342,
+	-1, 132,
+	111, 125,
+	153, 125,
+	269, 125,
+	-2, 358,
+	-1, 580,
+	160, 1034,
+	-2, 1030,
+	-1, 581,
+	160, 1035,
+	-2, 1031,
+	-1, 605,
+	57, 614,
+	-2, 626,
+	-1, 606,
+	57, 615,
+	-2, 627,
+	-1, 627,
+	128, 1391,
 	-2, 91,
-	-1, 99,
-	1, 113,
-	481, 113,
-	-2, 118,
-	-1, 109,
-	112, 244,
-	177, 244,
-	-2, 335,
-	-1, 128,
-	111, 118,
-	150, 118,
-	265, 118,
-	-2, 350,
-	-1, 570,
-	157, 977,
-	-2, 973,
-	-1, 571,
-	157, 978,
-	-2, 974,
-	-1, 590,
-	57, 583,
-	-2, 595,
-	-1, 591,
-	57, 584,
-	-2, 596,
-	-1, 612,
-	125, 1328,
-	-2, 84,
-	-1, 613,
-	125, 1209,
-	-2, 85,
-	-1, 619,
-	125, 1260,
-	-2, 950,
-	-1, 759,
-	125, 1143,
-	-2, 947,
-	-1, 795,
-	182, 38,
-	187, 38,
-	-2, 255,
-	-1, 872,
-	1, 388,
-	481, 388,
-	-2, 118,
-	-1, 1114,
-	1, 285,
-	481, 285,
-	-2, 118,
-	-1, 1117,
-	23, 137,
-	-2, 139,
-	-1, 1190,
-	112, 244,
-	177, 244,
-	-2, 335,
-	-1, 1199,
-	182, 39,
-	187, 39,
-	-2, 256,
-	-1, 1409,
-	157, 982,
-	-2, 976,
-	-1, 1500,
-	75, 66,
-	83, 66,
-	-2, 70,
-	-1, 1521,
-	1, 286,
-	481, 286,
-	-2, 118,
-	-1, 1954,
-	5, 843,
-	18, 843,
-	20, 843,
-	31, 843,
-	84, 843,
-	-2, 622,
-	-1, 2188,
-	47, 918,
-	-2, 912,
+	-1, 628,
+	128, 1268,
+	-2, 92,
+	-1, 634,
+	128, 1320,
+	-2, 1007,
+	-1, 775,
+	128, 1201,
+	-2, 1004,
+	-1, 813,
+	185, 38,
+	190, 38,
+	-2, 262,
+	-1, 891,
+	1, 398,
+	489, 398,
+	-2, 125,
+	-1, 1140,
+	111, 125,
+	153, 125,
+	269, 125,
+	-2, 292,
+	-1, 1143,
+	23, 144,
+	-2, 146,
+	-1, 1217,
+	115, 251,
+	180, 251,
+	-2, 342,
+	-1, 1226,
+	185, 39,
+	190, 39,
+	-2, 263,
+	-1, 1439,
+	160, 1039,
+	-2, 1033,
+	-1, 1536,
+	75, 73,
+	83, 73,
+	-2, 77,
+	-1, 1557,
+	111, 125,
+	153, 125,
+	269, 125,
+	-2, 293,
+	-1, 2017,
+	5, 900,
+	18, 900,
+	20, 900,
+	31, 900,
+	84, 900,
+	-2, 653,
+	-1, 2297,
+	47, 975,
+	-2, 969,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 30143
+const yyLast = 31919
 
 var yyAct = [...]int{
-	570, 2108, 2284, 2013, 2241, 2228, 2218, 2189, 2254, 1778,
-	933, 83, 3, 2165, 2135, 1740, 1589, 1785, 2105, 1707,
-	1934, 1786, 528, 1446, 1015, 1069, 1062, 1830, 1741, 1931,
-	1554, 1935, 1810, 511, 1518, 542, 1874, 883, 1834, 1574,
-	1559, 513, 1727, 1811, 1539, 1893, 825, 1812, 137, 165,
-	583, 2127, 165, 762, 476, 165, 1497, 1946, 1096, 81,
-	492, 1667, 165, 1587, 1620, 912, 617, 1197, 123, 1403,
-	165, 1561, 1306, 1804, 1395, 790, 1106, 1573, 1099, 592,
-	1090, 1479, 1067, 1448, 1092, 1054, 1072, 1215, 601, 1486,
-	504, 33, 492, 1429, 577, 492, 165, 492, 515, 1372,
-	951, 1303, 614, 1089, 769, 793, 1571, 1462, 766, 1406,
-	1204, 796, 791, 1289, 770, 792, 1105, 1311, 1103, 1502,
-	79, 1540, 1079, 140, 1028, 8, 1550, 100, 803, 101,
-	1166, 1031, 7, 868, 6, 931, 1854, 1853, 106, 1171,
-	1189, 78, 1618, 107, 1275, 499, 1881, 1882, 167, 168,
-	169, 1443, 1444, 2137, 505, 1361, 1360, 1359, 1358, 1357,
-	1356, 1345, 502, 1349, 503, 778, 2273, 1705, 2185, 102,
-	763, 578, 827, 599, 603, 450, 2084, 830, 773, 1982,
-	84, 2161, 2160, 829, 828, 841, 842, 108, 845, 846,
-	847, 848, 2300, 2251, 851, 852, 853, 854, 855, 856,
-	857, 858, 859, 860, 861, 862, 863, 864, 865, 500,
-	611, 2103, 2292, 618, 2104, 2299, 806, 86, 87, 88,
-	89, 90, 91, 102, 785, 97, 80, 784, 162, 783,
-	952, 445, 2211, 1566, 1657, 831, 832, 833, 2109, 807,
-	555, 2250, 561, 562, 559, 560, 952, 558, 557, 556,
-	1606, 576, 161, 1910, 1564, 2210, 1180, 563, 564, 2046,
-	1961, 1962, 843, 1829, 35, 838, 1861, 72, 39, 40,
-	1860, 1513, 1514, 1445, 1706, 1107, 103, 1108, 125, 161,
-	782, 1960, 877, 878, 1880, 871, 1655, 102, 1771, 145,
-	1512, 1770, 929, 902, 1772, 962, 919, 1503, 921, 890,
-	907, 908, 574, 103, 891, 573, 777, 903, 779, 1794,
-	890, 962, 889, 479, 888, 891, 145, 896, 1533, 1532,
-	135, 2215, 2015, 2037, 2035, 124, 479, 490, 867, 1350,
-	1351, 1352, 1348, 488, 918, 920, 780, 1295, 494, 1563,
-	71, 1058, 1588, 142, 1835, 143, 1857, 1894, 479, 1626,
-	1191, 1192, 134, 133, 160, 1432, 1621, 1775, 167, 168,
-	169, 1631, 1629, 1630, 782, 844, 774, 2009, 911, 2298,
-	142, 1290, 143, 776, 775, 2010, 925, 782, 866, 1265,
-	873, 160, 1869, 904, 479, 958, 909, 786, 950, 928,
-	1896, 1625, 2274, 897, 2017, 1633, 910, 1634, 2016, 1635,
-	1623, 958, 1627, 905, 906, 1636, 850, 2157, 129, 1193,
-	136, 849, 1190, 2098, 130, 131, 814, 1781, 812, 146,
-	780, 1266, 916, 1267, 1590, 1183, 917, 1480, 151, 823,
-	822, 1624, 821, 820, 819, 818, 922, 817, 816, 586,
-	870, 811, 781, 787, 824, 165, 146, 165, 1503, 1790,
-	165, 2295, 1898, 1981, 1902, 151, 1897, 915, 1895, 2290,
-	767, 767, 1782, 1900, 1873, 799, 798, 480, 923, 767,
-	2288, 1304, 1899, 765, 1572, 605, 900, 492, 492, 492,
-	480, 1870, 1296, 1989, 1784, 1901, 1903, 1779, 1203, 886,
-	1612, 892, 893, 894, 895, 492, 492, 1300, 805, 938,
-	1788, 1789, 480, 834, 1856, 1780, 1919, 1918, 944, 1708,
-	1710, 924, 1565, 930, 815, 1917, 813, 1178, 1859, 805,
-	1177, 1176, 1846, 1301, 1174, 869, 781, 957, 954, 955,
-	956, 961, 963, 960, 138, 959, 2209, 926, 480, 781,
-	2216, 449, 953, 957, 954, 955, 956, 961, 963, 960,
-	1876, 959, 99, 1202, 444, 1875, 1868, 804, 953, 1867,
-	2196, 138, 808, 798, 1608, 1787, 1876, 2066, 1656, 1000,
-	1001, 1875, 809, 1686, 165, 2242, 840, 1790, 804, 1294,
-	1959, 1732, 1683, 1675, 1277, 1276, 1278, 1279, 1280, 132,
-	810, 1060, 1598, 1508, 1519, 1083, 969, 805, 1013, 73,
-	988, 126, 492, 1059, 127, 165, 887, 165, 165, 998,
-	492, 879, 1709, 935, 936, 899, 492, 881, 978, 1767,
-	614, 988, 947, 913, 876, 2286, 901, 1458, 2287, 945,
-	2285, 946, 505, 1312, 1343, 1016, 167, 168, 169, 885,
-	1397, 1026, 2174, 977, 976, 986, 987, 979, 980, 981,
-	982, 983, 984, 985, 978, 968, 804, 988, 1055, 967,
-	965, 808, 798, 1088, 2205, 1291, 805, 1292, 826, 1912,
-	1293, 809, 1073, 1065, 1068, 1783, 968, 966, 967, 965,
-	1030, 1033, 1035, 1037, 1038, 1040, 1042, 1043, 1034, 1036,
-	1944, 1039, 1041, 1622, 1044, 968, 1607, 1297, 805, 1071,
-	1109, 94, 1398, 948, 1430, 139, 144, 141, 147, 148,
-	149, 150, 152, 153, 154, 155, 872, 1823, 1052, 1000,
-	1001, 156, 157, 158, 159, 804, 2140, 839, 1000, 1001,
-	1969, 618, 139, 144, 141, 147, 148, 149, 150, 152,
-	153, 154, 155, 167, 168, 169, 914, 1799, 156, 157,
-	158, 159, 95, 1430, 1379, 1693, 1313, 804, 1968, 884,
-	1594, 1061, 165, 798, 801, 802, 1167, 767, 1377, 1378,
-	1376, 795, 799, 1463, 1464, 1175, 805, 977, 976, 986,
-	987, 979, 980, 981, 982, 983, 984, 985, 978, 1214,
-	794, 988, 1213, 1201, 492, 1605, 1199, 981, 982, 983,
-	984, 985, 978, 1600, 1208, 988, 1600, 1603, 1212, 1800,
-	965, 492, 492, 814, 492, 812, 492, 492, 2293, 492,
-	492, 492, 492, 492, 492, 2279, 968, 1604, 2267, 1681,
-	1602, 1964, 1181, 1182, 492, 804, 1668, 1680, 165, 1248,
-	71, 798, 801, 802, 1076, 767, 2294, 1195, 1209, 795,
-	799, 1284, 1375, 2280, 165, 1660, 1661, 1662, 1188, 2083,
-	1104, 2175, 966, 967, 965, 492, 2296, 165, 966, 967,
-	965, 1207, 2234, 1243, 1244, 2232, 604, 2082, 1302, 1987,
-	968, 1808, 165, 1807, 2236, 2237, 968, 1245, 1788, 1789,
-	1251, 1252, 1682, 2233, 1569, 1285, 1257, 1258, 165, 1217,
-	1282, 1218, 1272, 1220, 1222, 165, 1173, 1226, 1228, 1230,
-	1232, 1234, 1283, 1206, 165, 165, 165, 165, 165, 165,
-	165, 165, 165, 492, 492, 492, 1198, 1186, 1270, 1185,
-	1205, 1205, 1184, 1269, 1316, 1268, 2297, 1261, 167, 168,
-	169, 1320, 1774, 1322, 1323, 1324, 1325, 1259, 1308, 1921,
-	1329, 165, 609, 1787, 1314, 1315, 1246, 1253, 1250, 1179,
-	1809, 1281, 1249, 1271, 1344, 1790, 606, 607, 1319, 1224,
-	966, 967, 965, 2283, 1310, 1326, 1327, 1328, 1914, 1460,
-	2282, 1305, 2281, 966, 967, 965, 2268, 2262, 968, 1396,
-	966, 967, 965, 1367, 1369, 1370, 102, 1922, 1399, 2260,
-	784, 968, 783, 2124, 167, 168, 169, 1373, 968, 1355,
-	2080, 1368, 492, 167, 168, 169, 1318, 1582, 167, 168,
-	169, 2054, 1580, 1967, 1407, 1923, 979, 980, 981, 982,
-	983, 984, 985, 978, 1400, 1401, 988, 1817, 1805, 1651,
-	1616, 1615, 1413, 1459, 1452, 1309, 492, 492, 1273, 587,
-	1260, 1256, 1255, 1362, 1363, 1364, 1365, 165, 1339, 1340,
-	1341, 1254, 927, 1418, 1421, 2012, 1996, 2248, 80, 1431,
-	1374, 492, 1996, 2203, 966, 967, 965, 2155, 165, 2154,
-	1409, 492, 1451, 1996, 2198, 165, 1408, 165, 1996, 2197,
-	2107, 1016, 968, 1837, 1407, 165, 165, 2179, 587, 2101,
-	587, 82, 492, 1996, 2099, 492, 1498, 1820, 1416, 1417,
-	1453, 1728, 614, 1600, 587, 614, 492, 1527, 1437, 1438,
-	1465, 2064, 587, 1979, 1978, 1975, 1976, 1414, 1415, 1975,
-	1974, 1420, 1423, 1424, 1728, 1410, 986, 987, 979, 980,
-	981, 982, 983, 984, 985, 978, 505, 587, 988, 1943,
-	1409, 1471, 587, 1473, 1501, 1601, 1477, 1436, 1503, 1855,
-	1439, 1440, 2085, 1522, 1170, 1839, 1832, 1833, 1471, 1523,
-	2061, 492, 1483, 587, 1541, 1542, 1543, 1575, 1576, 1577,
-	587, 1483, 1579, 1581, 964, 587, 1761, 1504, 1504, 1170,
-	1169, 1526, 1115, 1114, 1503, 492, 1556, 1932, 1517, 2204,
-	35, 492, 1208, 1475, 1943, 1208, 1943, 1208, 1482, 1562,
-	964, 1600, 2086, 2087, 2088, 1599, 71, 1506, 35, 1510,
-	1509, 35, 1472, 618, 1735, 1996, 618, 1977, 1525, 1483,
-	1511, 1524, 977, 976, 986, 987, 979, 980, 981, 982,
-	983, 984, 985, 978, 1698, 492, 988, 1396, 1586, 1736,
-	1505, 1505, 1396, 1396, 1697, 580, 1471, 1558, 1507, 1503,
-	1600, 1483, 1534, 1583, 1535, 1536, 1537, 1538, 571, 1593,
-	2142, 1461, 1596, 1441, 1597, 1557, 71, 1814, 1353, 1568,
-	1546, 1547, 1548, 1549, 1239, 1567, 1578, 1570, 165, 1552,
-	1553, 1299, 1101, 1471, 71, 165, 789, 71, 1609, 806,
-	165, 165, 788, 2167, 165, 1592, 165, 1557, 2106, 1610,
-	1591, 2077, 165, 2072, 1595, 1172, 1555, 166, 2011, 165,
-	166, 1611, 807, 166, 1971, 1840, 1613, 1614, 493, 1551,
-	166, 71, 1205, 1545, 1240, 1241, 1242, 871, 166, 1544,
-	531, 530, 533, 534, 535, 536, 1619, 165, 492, 532,
-	1287, 537, 1646, 1647, 1200, 1196, 1168, 1649, 96, 2264,
-	493, 2014, 1813, 493, 166, 493, 1650, 972, 2151, 975,
-	1411, 1412, 1947, 1948, 2168, 989, 990, 991, 992, 993,
-	994, 995, 1566, 973, 974, 971, 977, 976, 986, 987,
-	979, 980, 981, 982, 983, 984, 985, 978, 2089, 2229,
-	988, 1236, 1994, 1639, 1488, 1491, 1492, 1493, 1489, 1814,
-	1490, 1494, 1373, 1993, 1947, 1948, 1454, 1992, 1950, 1932,
-	977, 976, 986, 987, 979, 980, 981, 982, 983, 984,
-	985, 978, 1824, 1640, 988, 1346, 2276, 1488, 1491, 1492,
-	1493, 1489, 165, 1490, 1494, 2090, 2091, 1752, 1237, 1238,
-	165, 1750, 1753, 1953, 1654, 1952, 1751, 597, 593, 1754,
-	1749, 1492, 1493, 1748, 1677, 2249, 1924, 1717, 1070, 2190,
-	2192, 2065, 594, 165, 1999, 1374, 1663, 1726, 2193, 2278,
-	1714, 1725, 2220, 2253, 165, 165, 165, 165, 165, 2255,
-	2219, 1737, 1721, 2223, 2187, 1298, 165, 1074, 1075, 596,
-	165, 595, 578, 165, 165, 1676, 572, 165, 165, 165,
-	1715, 1759, 1792, 1530, 1672, 1673, 1818, 1426, 1716, 1694,
-	1773, 1733, 1692, 836, 1742, 835, 1055, 1704, 1063, 1730,
-	2024, 1427, 1813, 1712, 1879, 1690, 937, 1456, 1848, 1064,
-	1847, 1798, 103, 2059, 1720, 1463, 1464, 1990, 1718, 1719,
-	1068, 1643, 2200, 1731, 2162, 1791, 1496, 1632, 1729, 1762,
-	581, 582, 1659, 1764, 584, 1744, 1745, 492, 1747, 2261,
-	1776, 2259, 165, 2258, 1797, 1308, 1801, 1802, 1803, 165,
-	1795, 1796, 1760, 2224, 1755, 492, 1765, 1743, 1768, 1724,
-	1746, 492, 2222, 2058, 82, 1208, 1208, 1723, 1995, 1777,
-	1562, 492, 1584, 597, 593, 585, 2057, 1927, 1728, 1843,
-	1816, 2266, 2265, 1852, 1687, 1684, 1084, 1077, 594, 1806,
-	2266, 2194, 1966, 1457, 165, 165, 165, 165, 165, 580,
-	1836, 80, 1815, 85, 77, 1821, 1, 2231, 462, 1442,
-	165, 165, 1053, 590, 591, 596, 1851, 595, 475, 1850,
-	2227, 1274, 1188, 1825, 1826, 1827, 1264, 2110, 2164, 2002,
-	1560, 797, 1841, 1842, 128, 1409, 1520, 1521, 2244, 93,
-	760, 1408, 92, 1849, 800, 898, 492, 1585, 2102, 1793,
-	1531, 1121, 1396, 1119, 1120, 1118, 1890, 976, 986, 987,
-	979, 980, 981, 982, 983, 984, 985, 978, 1871, 1123,
-	988, 1122, 1891, 1117, 1892, 1347, 489, 1495, 163, 1110,
-	1078, 1877, 492, 166, 1878, 166, 1911, 837, 166, 452,
-	1980, 1342, 1617, 165, 458, 996, 1883, 1722, 1905, 1769,
-	615, 608, 1938, 492, 2217, 2186, 2188, 2136, 2191, 492,
-	492, 2184, 2277, 1890, 1889, 493, 493, 493, 2252, 2199,
-	1528, 1933, 1455, 1066, 2056, 1670, 1904, 1926, 1691, 1671,
-	1025, 1428, 165, 493, 493, 1093, 514, 1450, 1366, 529,
-	1678, 1679, 1936, 526, 527, 1466, 1685, 1742, 1734, 1688,
-	1689, 970, 1913, 1942, 1930, 512, 506, 1695, 1085, 1696,
-	1487, 165, 1699, 1700, 1701, 1702, 1703, 1485, 1484, 1920,
-	1641, 1097, 1949, 1955, 1951, 1957, 1713, 1958, 1945, 1091,
-	1470, 1529, 1858, 2008, 949, 589, 501, 1928, 1956, 1988,
-	772, 1425, 2173, 1658, 1963, 165, 2045, 1941, 2043, 588,
-	1972, 1973, 61, 492, 38, 496, 2049, 2272, 940, 598,
-	32, 492, 166, 31, 30, 29, 28, 165, 23, 22,
-	21, 20, 19, 1757, 1758, 25, 1984, 165, 18, 1983,
-	1985, 1986, 2003, 17, 2048, 16, 98, 48, 2001, 45,
-	493, 165, 43, 166, 165, 166, 166, 1998, 493, 1562,
-	2000, 105, 2005, 2025, 493, 104, 1997, 46, 2006, 977,
-	976, 986, 987, 979, 980, 981, 982, 983, 984, 985,
-	978, 42, 874, 988, 27, 2020, 26, 15, 2019, 14,
-	13, 12, 11, 10, 9, 2022, 2023, 977, 976, 986,
-	987, 979, 980, 981, 982, 983, 984, 985, 978, 2033,
-	5, 988, 4, 943, 24, 1014, 2, 0, 0, 0,
-	0, 0, 2028, 0, 977, 976, 986, 987, 979, 980,
-	981, 982, 983, 984, 985, 978, 0, 0, 988, 0,
-	0, 0, 0, 2060, 0, 0, 0, 2055, 2068, 0,
-	0, 0, 2069, 0, 0, 0, 0, 0, 0, 0,
-	0, 2074, 0, 0, 0, 1742, 0, 0, 0, 2076,
-	165, 0, 0, 165, 165, 165, 492, 492, 0, 2075,
-	0, 0, 0, 2047, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2111, 492, 492, 492, 2079,
-	0, 2081, 0, 2096, 0, 0, 505, 0, 1887, 1888,
-	166, 0, 2117, 2070, 2030, 2031, 2071, 2032, 0, 2073,
-	2034, 0, 2036, 0, 0, 0, 0, 0, 0, 0,
-	0, 492, 492, 492, 165, 2115, 0, 0, 0, 0,
-	0, 0, 493, 0, 0, 492, 0, 492, 0, 0,
-	0, 0, 0, 492, 0, 2143, 2116, 2133, 492, 493,
-	493, 0, 493, 2139, 493, 493, 2145, 493, 493, 493,
-	493, 493, 493, 2141, 1939, 0, 2123, 1936, 0, 2134,
-	2148, 1936, 493, 2131, 2132, 2150, 166, 492, 0, 0,
-	492, 2156, 0, 0, 2152, 1954, 2153, 0, 2159, 2147,
-	2166, 2158, 166, 0, 0, 2149, 0, 0, 0, 0,
-	0, 0, 0, 493, 2163, 166, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2138, 505, 0, 0,
-	166, 0, 541, 2183, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2195, 0, 0, 166, 0, 492, 165,
-	0, 0, 0, 166, 2202, 0, 0, 0, 1936, 0,
-	492, 0, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 493, 493, 493, 0, 2206, 2221, 492, 0, 2214,
-	0, 164, 0, 0, 448, 492, 492, 487, 0, 2230,
-	2225, 2042, 2238, 0, 448, 2166, 2245, 2235, 2243, 166,
-	0, 540, 448, 0, 2257, 2256, 0, 0, 0, 0,
-	0, 0, 0, 2263, 0, 0, 1742, 0, 0, 602,
-	602, 0, 0, 2269, 0, 2027, 0, 0, 448, 2029,
-	0, 0, 2275, 0, 0, 0, 0, 0, 0, 0,
-	2038, 2039, 0, 0, 0, 0, 0, 0, 0, 2289,
-	0, 0, 0, 0, 0, 0, 2053, 2291, 0, 0,
-	493, 491, 0, 0, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 2062, 2063, 508, 0, 2067, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 479, 616, 493, 493, 764, 0, 771, 0,
-	0, 0, 0, 0, 2041, 166, 0, 977, 976, 986,
-	987, 979, 980, 981, 982, 983, 984, 985, 978, 493,
-	0, 988, 0, 0, 0, 0, 166, 0, 0, 493,
-	0, 0, 467, 166, 0, 166, 0, 0, 2100, 0,
-	0, 466, 0, 166, 166, 0, 0, 0, 0, 0,
-	493, 0, 464, 493, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 493, 0, 0, 0, 0, 0,
-	0, 0, 35, 36, 37, 72, 39, 40, 0, 0,
-	0, 0, 0, 0, 0, 0, 2128, 0, 0, 0,
-	461, 0, 76, 0, 2040, 0, 41, 67, 68, 474,
-	65, 69, 0, 0, 0, 0, 0, 0, 0, 66,
-	0, 0, 0, 0, 472, 0, 0, 0, 0, 493,
-	977, 976, 986, 987, 979, 980, 981, 982, 983, 984,
-	985, 978, 0, 0, 988, 0, 0, 0, 54, 0,
-	0, 0, 0, 493, 0, 0, 480, 0, 71, 493,
-	0, 0, 0, 0, 2169, 2170, 2171, 2172, 0, 2176,
-	0, 2177, 2178, 2180, 0, 0, 0, 2181, 2182, 0,
-	0, 0, 0, 0, 451, 0, 453, 468, 0, 482,
-	0, 481, 457, 0, 455, 459, 469, 460, 0, 454,
-	0, 465, 0, 493, 456, 470, 471, 486, 485, 473,
-	0, 463, 483, 0, 0, 0, 0, 0, 0, 2208,
-	977, 976, 986, 987, 979, 980, 981, 982, 983, 984,
-	985, 978, 0, 0, 988, 0, 44, 47, 50, 49,
-	52, 0, 64, 0, 0, 70, 166, 0, 0, 0,
-	0, 0, 0, 166, 0, 0, 0, 0, 166, 166,
-	0, 0, 166, 0, 166, 0, 0, 53, 75, 74,
-	166, 0, 62, 63, 51, 0, 0, 166, 0, 0,
-	0, 0, 0, 0, 0, 2270, 2271, 448, 0, 448,
-	0, 0, 448, 0, 0, 0, 0, 0, 0, 0,
-	1884, 0, 0, 0, 0, 166, 493, 0, 0, 0,
-	0, 0, 0, 55, 56, 0, 57, 58, 59, 60,
-	977, 976, 986, 987, 979, 980, 981, 982, 983, 984,
-	985, 978, 0, 0, 988, 0, 484, 1669, 977, 976,
-	986, 987, 979, 980, 981, 982, 983, 984, 985, 978,
-	0, 0, 988, 0, 477, 0, 0, 977, 976, 986,
-	987, 979, 980, 981, 982, 983, 984, 985, 978, 478,
-	0, 988, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 616, 616,
-	616, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	166, 0, 0, 0, 0, 0, 939, 941, 166, 0,
-	0, 0, 0, 0, 0, 0, 448, 73, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 166, 602, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 166, 166, 166, 166, 166, 448, 0, 448,
-	1100, 0, 0, 0, 166, 0, 0, 0, 166, 0,
-	0, 166, 166, 0, 0, 166, 166, 166, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
-	1010, 1011, 0, 1081, 0, 0, 0, 0, 0, 0,
-	0, 616, 0, 0, 0, 493, 0, 1111, 0, 0,
-	166, 0, 0, 0, 0, 0, 0, 166, 0, 0,
-	0, 0, 0, 493, 0, 0, 0, 0, 0, 493,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 493,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 166, 166, 166, 166, 166, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 166, 166,
-	0, 0, 0, 0, 448, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 493, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1211,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	493, 0, 0, 0, 1211, 1211, 0, 0, 0, 0,
-	448, 166, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 493, 0, 0, 0, 0, 1262, 493, 493, 0,
-	0, 0, 0, 0, 0, 764, 0, 0, 0, 448,
-	0, 0, 0, 0, 0, 0, 0, 0, 1210, 0,
-	166, 0, 1216, 1216, 1307, 1216, 0, 1216, 1216, 0,
-	1225, 1216, 1216, 1216, 1216, 1216, 0, 0, 0, 0,
-	448, 0, 0, 1210, 1210, 764, 0, 448, 161, 166,
-	0, 0, 0, 0, 0, 0, 1330, 1331, 448, 448,
-	448, 448, 448, 448, 448, 0, 0, 0, 0, 0,
-	0, 0, 103, 0, 0, 0, 1286, 0, 0, 0,
-	0, 0, 0, 166, 0, 145, 0, 0, 0, 0,
-	0, 493, 0, 448, 0, 0, 0, 0, 0, 493,
-	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
-	1056, 0, 0, 0, 0, 166, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 166,
-	0, 0, 166, 0, 616, 616, 616, 0, 0, 142,
-	0, 143, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 0, 0, 0, 0, 602, 1307, 0, 0, 0,
-	602, 602, 447, 0, 602, 602, 602, 0, 0, 0,
-	1211, 0, 495, 0, 0, 0, 0, 0, 0, 0,
-	575, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	602, 602, 602, 602, 602, 0, 0, 0, 0, 1262,
-	0, 0, 0, 0, 0, 0, 768, 0, 0, 0,
-	0, 0, 0, 0, 0, 146, 0, 0, 0, 0,
-	448, 0, 0, 1402, 151, 616, 1307, 448, 0, 448,
-	0, 0, 0, 0, 0, 0, 0, 448, 448, 1210,
-	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
-	0, 166, 166, 166, 493, 493, 1371, 1434, 1435, 1380,
-	1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390,
-	1391, 1392, 1393, 1394, 493, 493, 493, 0, 0, 543,
-	34, 0, 1467, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1081, 0, 0, 616, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 493,
-	493, 493, 166, 616, 34, 0, 616, 0, 1433, 0,
-	0, 0, 0, 493, 0, 493, 0, 764, 0, 0,
-	138, 493, 0, 0, 0, 0, 493, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 579,
-	0, 0, 0, 0, 0, 493, 0, 0, 493, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 771, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 764, 0, 0, 0,
-	0, 0, 771, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 493, 166, 0, 0,
-	448, 0, 0, 0, 0, 0, 0, 448, 493, 0,
-	0, 0, 448, 448, 0, 0, 448, 0, 1644, 0,
-	0, 0, 0, 0, 448, 493, 764, 1138, 0, 0,
-	0, 448, 0, 493, 493, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 448,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 139, 144, 141, 147, 148, 149, 150, 152, 153,
-	154, 155, 0, 0, 0, 0, 0, 156, 157, 158,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 602, 602, 0,
-	0, 0, 0, 0, 0, 875, 0, 880, 0, 1653,
-	882, 0, 0, 0, 0, 0, 0, 0, 602, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1126, 0,
-	0, 0, 0, 0, 448, 0, 0, 0, 0, 0,
-	0, 0, 1262, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 602, 448, 0, 0, 0, 0,
-	0, 1139, 0, 0, 0, 1211, 448, 448, 448, 448,
-	448, 0, 0, 0, 0, 0, 0, 0, 1756, 0,
-	0, 0, 448, 0, 0, 448, 448, 0, 0, 448,
-	1766, 1307, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1664, 1665, 1666, 0, 0, 0, 0,
-	0, 0, 1152, 1155, 1156, 1157, 1158, 1159, 1160, 0,
-	1161, 1162, 1163, 1164, 1165, 1140, 1141, 1142, 1143, 1124,
-	1125, 1153, 0, 1127, 1210, 1128, 1129, 1130, 1131, 1132,
-	1133, 1134, 1135, 1136, 1137, 1144, 1145, 1146, 1147, 1148,
-	1149, 1150, 1151, 0, 448, 1087, 0, 0, 1098, 0,
-	0, 1828, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1211, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1307, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 932, 932, 932, 0,
-	0, 0, 0, 0, 0, 0, 448, 448, 448, 448,
-	448, 0, 0, 0, 0, 0, 34, 0, 1819, 1154,
-	0, 0, 448, 448, 0, 0, 0, 0, 0, 997,
-	999, 0, 0, 0, 0, 0, 1831, 0, 0, 0,
-	1210, 0, 1838, 0, 0, 0, 0, 0, 0, 0,
-	616, 0, 1844, 0, 0, 0, 0, 602, 0, 0,
-	1012, 0, 0, 0, 1017, 1018, 1019, 1020, 1021, 1022,
-	1023, 1024, 0, 1027, 1029, 1032, 1032, 1032, 1029, 1032,
-	1032, 1029, 1032, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
-	0, 0, 0, 0, 0, 1057, 0, 0, 0, 34,
-	0, 0, 0, 0, 0, 448, 0, 0, 0, 0,
-	0, 0, 1116, 0, 0, 0, 0, 0, 1211, 0,
-	0, 0, 0, 0, 0, 0, 1094, 616, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 448, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 448, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 616, 0, 0, 1210, 1247, 0,
-	1940, 1216, 0, 0, 1885, 1886, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 448, 0, 1906,
-	1907, 0, 1908, 1909, 0, 0, 0, 1288, 1211, 0,
-	0, 0, 0, 1915, 1916, 0, 0, 0, 0, 448,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 448,
-	0, 0, 0, 0, 0, 0, 0, 0, 1317, 0,
-	0, 0, 0, 448, 0, 1321, 448, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1332, 1333, 1334, 1335,
-	1336, 1337, 1338, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 764, 0, 0, 1210, 0, 0,
-	0, 0, 1831, 0, 0, 0, 0, 0, 0, 0,
-	0, 1098, 0, 0, 0, 0, 0, 1965, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1211, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	103, 0, 125, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 145, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 448, 0, 0, 448, 448, 448, 0, 0,
-	0, 0, 0, 0, 135, 0, 0, 0, 0, 124,
-	0, 0, 0, 0, 0, 1210, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 142, 0, 143,
-	2026, 0, 0, 0, 112, 113, 134, 133, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1474, 0,
-	0, 0, 0, 0, 0, 1478, 1262, 1481, 0, 0,
-	0, 0, 932, 932, 932, 0, 1500, 1831, 2097, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2112, 2113, 2114,
-	0, 0, 129, 110, 136, 117, 109, 0, 130, 131,
-	0, 0, 0, 146, 0, 0, 0, 0, 0, 0,
-	0, 0, 151, 118, 0, 0, 0, 2078, 0, 0,
-	0, 0, 2129, 2129, 2129, 0, 0, 121, 119, 114,
-	115, 116, 120, 0, 0, 0, 2144, 111, 2146, 0,
-	0, 0, 0, 0, 1831, 0, 122, 0, 0, 1831,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 448, 0, 0, 0, 0, 0, 0, 1831, 0,
-	0, 616, 0, 0, 0, 0, 2118, 2119, 2120, 2121,
-	2122, 0, 0, 0, 2125, 2126, 0, 1211, 0, 161,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1187, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 103, 0, 125, 0, 0, 0, 0,
-	0, 0, 0, 0, 1499, 0, 145, 0, 0, 1831,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2212, 0, 0, 0, 0, 0, 0, 1098, 0,
-	0, 0, 0, 0, 0, 1628, 1210, 135, 2226, 0,
-	1637, 1638, 124, 132, 1642, 0, 616, 616, 0, 0,
-	0, 0, 1645, 0, 0, 126, 0, 0, 127, 1648,
-	142, 0, 143, 0, 0, 0, 0, 1191, 1192, 134,
-	133, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1652, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2239, 0, 0,
-	0, 0, 0, 0, 0, 129, 1193, 136, 0, 1190,
-	0, 130, 131, 0, 0, 0, 146, 0, 0, 0,
-	0, 0, 0, 0, 0, 151, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
-	144, 141, 147, 148, 149, 150, 152, 153, 154, 155,
-	0, 0, 0, 0, 0, 156, 157, 158, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1763, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	580, 2191, 2069, 2425, 1712, 2084, 2374, 2354, 2304, 2342,
+	952, 2390, 2198, 2265, 1829, 2315, 1629, 1789, 87, 3,
+	1836, 552, 1036, 1479, 1997, 2298, 1752, 1083, 1087, 1998,
+	1837, 1477, 1790, 537, 1575, 1776, 1994, 1486, 1590, 1932,
+	2217, 2225, 522, 844, 1861, 1891, 1595, 2251, 1554, 902,
+	169, 1610, 520, 169, 1630, 485, 169, 778, 1862, 2009,
+	1097, 501, 1863, 169, 1423, 1955, 1533, 1708, 1627, 1224,
+	1334, 169, 1122, 169, 931, 1433, 1609, 141, 1661, 127,
+	1597, 1855, 804, 1132, 808, 1125, 1515, 1197, 1522, 513,
+	1116, 607, 1490, 1115, 83, 1092, 501, 1118, 524, 501,
+	169, 501, 1100, 1076, 1460, 1400, 629, 591, 970, 785,
+	1331, 1436, 782, 1607, 1243, 596, 1497, 814, 1317, 1586,
+	811, 809, 810, 786, 1131, 632, 1538, 1231, 589, 81,
+	33, 821, 1129, 1339, 887, 34, 1105, 144, 104, 110,
+	105, 587, 8, 7, 1192, 6, 599, 80, 508, 1912,
+	1911, 950, 111, 1050, 2068, 2070, 1576, 1659, 1303, 2183,
+	1939, 1940, 1474, 1475, 2227, 85, 1216, 1053, 1389, 1388,
+	2071, 1387, 1386, 846, 1385, 1384, 1373, 794, 171, 172,
+	173, 1377, 789, 779, 106, 2413, 860, 861, 1750, 864,
+	865, 866, 867, 614, 618, 870, 871, 872, 873, 874,
+	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
+	849, 459, 592, 509, 112, 511, 2294, 512, 2384, 88,
+	2045, 2161, 2276, 971, 2202, 2274, 2272, 2203, 626, 2229,
+	1032, 1622, 1621, 2270, 2100, 824, 2101, 2262, 2261, 848,
+	971, 106, 800, 2383, 799, 2202, 825, 2275, 2203, 847,
+	2273, 2271, 633, 36, 850, 851, 852, 90, 91, 92,
+	93, 94, 95, 801, 2187, 101, 2443, 2188, 166, 2444,
+	2387, 454, 857, 2335, 82, 1602, 1698, 2199, 36, 2435,
+	2192, 86, 1647, 2386, 2334, 1972, 2123, 165, 1476, 1206,
+	981, 586, 36, 2204, 1820, 1919, 1600, 1819, 1887, 1918,
+	1821, 862, 2234, 2024, 2025, 106, 600, 981, 1549, 1550,
+	938, 107, 940, 129, 2204, 1751, 1784, 890, 565, 2023,
+	571, 572, 569, 570, 149, 568, 567, 566, 36, 73,
+	1938, 74, 40, 41, 1696, 573, 574, 1548, 165, 1539,
+	798, 1785, 896, 897, 886, 921, 584, 937, 939, 926,
+	927, 1378, 1379, 1380, 73, 139, 1133, 793, 1134, 795,
+	128, 909, 107, 583, 948, 909, 910, 73, 73, 1845,
+	910, 73, 1569, 1568, 908, 149, 907, 2339, 146, 977,
+	147, 488, 969, 1620, 1599, 1218, 1219, 138, 137, 164,
+	922, 2114, 915, 2086, 488, 488, 977, 2112, 796, 499,
+	1376, 497, 503, 1080, 73, 1323, 171, 172, 173, 1628,
+	1915, 1662, 798, 885, 2414, 798, 1826, 790, 1672, 1670,
+	1671, 2442, 2080, 863, 792, 791, 1293, 1318, 1667, 146,
+	2081, 147, 488, 944, 928, 930, 802, 935, 924, 925,
+	164, 936, 892, 1927, 929, 1677, 133, 1220, 140, 869,
+	1217, 941, 134, 135, 169, 868, 169, 150, 2252, 169,
+	947, 2088, 1664, 2256, 1666, 923, 155, 916, 2087, 803,
+	1294, 1295, 934, 796, 833, 1714, 889, 942, 1674, 831,
+	1675, 1631, 1676, 1668, 805, 2181, 501, 501, 501, 73,
+	1841, 783, 1539, 2433, 1516, 842, 817, 1209, 841, 2044,
+	2200, 840, 839, 797, 501, 501, 1665, 823, 150, 838,
+	905, 837, 911, 912, 913, 914, 836, 155, 835, 830,
+	843, 2200, 2439, 783, 963, 976, 973, 974, 975, 980,
+	982, 979, 919, 978, 949, 2429, 1931, 783, 1230, 489,
+	972, 781, 976, 973, 974, 975, 980, 982, 979, 943,
+	978, 1917, 489, 489, 1324, 945, 2180, 972, 1601, 1463,
+	816, 888, 1332, 805, 1753, 1755, 822, 1608, 620, 2333,
+	2070, 826, 816, 142, 834, 797, 828, 1928, 797, 832,
+	2201, 827, 1653, 1886, 169, 2071, 2052, 1884, 1328, 169,
+	489, 957, 853, 859, 1914, 1981, 1980, 1934, 829, 1979,
+	2340, 2201, 1933, 1229, 1204, 72, 1305, 1304, 1306, 1307,
+	1308, 1203, 1086, 2322, 1697, 1202, 823, 501, 1017, 1904,
+	169, 1329, 169, 169, 142, 501, 906, 2375, 136, 1200,
+	72, 501, 898, 72, 458, 629, 823, 895, 954, 955,
+	130, 1934, 453, 131, 72, 103, 1933, 601, 966, 964,
+	1322, 965, 1085, 1649, 2283, 996, 995, 1005, 1006, 998,
+	999, 1000, 1001, 1002, 1003, 1004, 997, 75, 1926, 1007,
+	1754, 1925, 1019, 1020, 918, 822, 1493, 2308, 1731, 2143,
+	72, 1077, 2022, 1781, 1037, 920, 823, 1719, 1114, 1038,
+	1639, 1544, 1109, 2427, 1034, 822, 2428, 900, 2426, 1101,
+	1555, 816, 819, 820, 997, 783, 1007, 1007, 1816, 813,
+	817, 932, 987, 1728, 98, 1094, 1099, 984, 1371, 1052,
+	1055, 1057, 1059, 1060, 1062, 1064, 1065, 812, 904, 2327,
+	1082, 1074, 845, 987, 1056, 1058, 602, 1061, 1063, 1319,
+	1066, 1320, 1956, 2007, 1321, 822, 1663, 858, 143, 148,
+	145, 151, 152, 153, 154, 156, 157, 158, 159, 1860,
+	2360, 1407, 1325, 2358, 160, 161, 162, 163, 99, 985,
+	986, 984, 2362, 2363, 1135, 1405, 1406, 1404, 169, 1839,
+	1840, 633, 1193, 1340, 2359, 1958, 967, 987, 1648, 1974,
+	1874, 1201, 985, 986, 984, 1461, 823, 1738, 1461, 143,
+	148, 145, 151, 152, 153, 154, 156, 157, 158, 159,
+	987, 2330, 501, 891, 1226, 160, 161, 162, 163, 171,
+	172, 173, 1235, 1425, 2232, 2032, 1239, 1019, 1020, 1242,
+	501, 501, 2031, 501, 1635, 501, 501, 933, 501, 501,
+	501, 501, 501, 501, 1838, 1207, 1208, 1241, 1960, 1240,
+	1964, 903, 1959, 501, 1957, 822, 1841, 169, 1276, 1962,
+	826, 816, 1019, 1020, 1228, 828, 1892, 1236, 1961, 1222,
+	827, 1646, 1644, 169, 2284, 1000, 1001, 1002, 1003, 1004,
+	997, 1963, 1965, 1007, 501, 833, 169, 1215, 1426, 831,
+	2436, 1234, 2405, 1271, 1272, 1727, 1289, 1330, 171, 172,
+	173, 169, 1850, 985, 986, 984, 1279, 1280, 2027, 1341,
+	1273, 1976, 1285, 1286, 985, 986, 984, 169, 2437, 823,
+	1726, 987, 1102, 1199, 169, 1701, 1702, 1703, 1725, 1882,
+	2440, 2317, 987, 169, 169, 169, 169, 169, 169, 169,
+	169, 169, 501, 501, 501, 1245, 1213, 1246, 1233, 1248,
+	1250, 1232, 1232, 1254, 1256, 1258, 1260, 1262, 1225, 1211,
+	1881, 2160, 985, 986, 984, 1344, 2419, 1851, 2159, 1130,
+	169, 2050, 1348, 1212, 1350, 1351, 1352, 1353, 822, 1859,
+	987, 1357, 1342, 1343, 816, 819, 820, 1641, 783, 985,
+	986, 984, 813, 817, 2420, 1372, 1347, 1858, 986, 984,
+	2441, 1605, 1313, 1354, 1355, 1356, 619, 987, 1424, 1333,
+	1274, 1645, 1401, 1641, 1205, 987, 1298, 1427, 998, 999,
+	1000, 1001, 1002, 1003, 1004, 997, 1336, 1495, 1007, 106,
+	800, 501, 799, 501, 73, 1984, 1312, 1643, 1297, 1296,
+	1498, 1499, 1383, 1287, 1281, 1437, 1403, 1310, 171, 172,
+	173, 1346, 1823, 996, 995, 1005, 1006, 998, 999, 1000,
+	1001, 1002, 1003, 1004, 997, 624, 2423, 1007, 501, 501,
+	1278, 1277, 1428, 1429, 1300, 1395, 1397, 1398, 1252, 169,
+	2422, 2421, 169, 1985, 2406, 501, 1449, 1452, 2398, 1402,
+	2396, 1494, 1462, 1367, 1368, 1369, 1396, 1311, 1443, 501,
+	621, 622, 1482, 2214, 169, 1438, 2157, 501, 1309, 2131,
+	2030, 169, 1709, 169, 1986, 171, 172, 173, 2083, 1618,
+	1437, 169, 169, 1500, 1868, 985, 986, 984, 501, 1856,
+	1692, 501, 985, 986, 984, 1299, 1657, 1656, 629, 1534,
+	1483, 629, 501, 987, 1337, 1301, 1468, 1469, 1288, 1037,
+	987, 1445, 1446, 1284, 1038, 1451, 1454, 1455, 1283, 1282,
+	1439, 946, 171, 172, 173, 1440, 1616, 1895, 602, 1444,
+	1005, 1006, 998, 999, 1000, 1001, 1002, 1003, 1004, 997,
+	1513, 1467, 1007, 602, 1470, 1471, 171, 172, 173, 2247,
+	1577, 1578, 1579, 1509, 1537, 1761, 2381, 501, 82, 1559,
+	2246, 1488, 2190, 1611, 1612, 1613, 983, 602, 1615, 1617,
+	1484, 1761, 2310, 1501, 1761, 2309, 2288, 602, 1558, 1761,
+	602, 1871, 501, 1761, 2184, 1777, 1562, 1777, 501, 1235,
+	1592, 1540, 1235, 1563, 1235, 1439, 1570, 1995, 1571, 1572,
+	1573, 1574, 1640, 1511, 1641, 602, 2006, 1598, 2141, 602,
+	1542, 2042, 2041, 1546, 1582, 1583, 1584, 1585, 2038, 2039,
+	1545, 2038, 2037, 1561, 1560, 541, 540, 543, 544, 545,
+	546, 1507, 602, 501, 542, 1424, 547, 602, 2006, 1626,
+	1424, 1424, 1539, 1913, 633, 1196, 1897, 633, 1889, 1890,
+	1519, 602, 1761, 1760, 1541, 1519, 1540, 2006, 1634, 1196,
+	1195, 1637, 1543, 1638, 1832, 1593, 1141, 1140, 1588, 1589,
+	84, 1518, 1642, 1810, 1604, 1508, 169, 2138, 1606, 1614,
+	1603, 1539, 2349, 169, 2326, 983, 1761, 2040, 169, 169,
+	1650, 2162, 169, 1519, 169, 1547, 1743, 1633, 1593, 1652,
+	169, 824, 1651, 1632, 1654, 1655, 1742, 169, 1267, 1833,
+	1507, 86, 825, 602, 1641, 1623, 1496, 1081, 1636, 1541,
+	1472, 1381, 1232, 1327, 1519, 1127, 807, 1539, 1641, 806,
+	73, 1835, 2267, 1084, 1830, 169, 501, 1507, 581, 2154,
+	2149, 2163, 2164, 2165, 1865, 2166, 1507, 1198, 1660, 1591,
+	1839, 1840, 1687, 1688, 2082, 1831, 2034, 1690, 1268, 1269,
+	1270, 1864, 1898, 1587, 1581, 1580, 1691, 1315, 996, 995,
+	1005, 1006, 998, 999, 1000, 1001, 1002, 1003, 1004, 997,
+	1227, 1194, 1007, 100, 1264, 890, 1401, 73, 170, 2010,
+	2011, 170, 2167, 2168, 170, 2085, 2268, 1602, 2402, 502,
+	1680, 170, 2355, 2057, 2416, 2056, 2055, 2013, 1865, 170,
+	1995, 170, 1875, 1681, 1374, 1838, 995, 1005, 1006, 998,
+	999, 1000, 1001, 1002, 1003, 1004, 997, 1841, 2016, 1007,
+	1801, 1265, 1266, 169, 502, 1802, 2015, 502, 170, 502,
+	1798, 169, 1524, 1527, 1528, 1529, 1525, 1797, 1526, 1530,
+	1695, 2385, 2010, 2011, 1524, 1527, 1528, 1529, 1525, 1987,
+	1526, 1530, 1799, 1402, 1757, 1722, 1765, 1800, 1098, 169,
+	1704, 612, 608, 1803, 2142, 1528, 1529, 1762, 2299, 2301,
+	169, 169, 169, 169, 169, 1775, 609, 2302, 2418, 1774,
+	1770, 2389, 169, 2344, 1786, 2391, 169, 2296, 2347, 169,
+	169, 2343, 1326, 169, 169, 169, 1716, 1717, 1720, 1763,
+	1782, 1095, 1096, 611, 1808, 610, 1822, 1764, 582, 1721,
+	1843, 1791, 1566, 1869, 1737, 1457, 1834, 855, 1735, 854,
+	1077, 592, 1088, 2095, 956, 1779, 1749, 1849, 1864, 1458,
+	1937, 1491, 1906, 1089, 1759, 1905, 107, 2136, 1498, 1499,
+	2053, 517, 1768, 1769, 1684, 1441, 1442, 2350, 2312, 2263,
+	1842, 1780, 1532, 501, 1485, 1811, 1846, 1847, 169, 1813,
+	1778, 1793, 1794, 1792, 1796, 169, 1795, 594, 595, 1804,
+	1673, 1700, 1773, 1809, 1848, 501, 1852, 1853, 1854, 1814,
+	1772, 1817, 501, 597, 2397, 1827, 1235, 1235, 2395, 1825,
+	2394, 2348, 501, 1867, 1828, 2346, 1489, 2135, 1598, 2064,
+	1901, 1947, 1624, 1210, 1910, 598, 84, 2134, 612, 608,
+	1990, 1777, 1888, 1857, 1732, 169, 169, 169, 169, 169,
+	1336, 1893, 1729, 609, 2404, 2403, 2243, 1866, 1110, 1103,
+	2404, 169, 169, 82, 2306, 1872, 2029, 1492, 1876, 1877,
+	1878, 86, 2303, 89, 1880, 2228, 79, 1908, 605, 606,
+	611, 1909, 610, 1215, 1, 1899, 1900, 2357, 471, 1473,
+	1438, 1075, 484, 2353, 1302, 1292, 2193, 2264, 1619, 1907,
+	501, 1824, 1596, 815, 132, 1556, 1557, 1424, 2377, 97,
+	1951, 996, 995, 1005, 1006, 998, 999, 1000, 1001, 1002,
+	1003, 1004, 997, 776, 96, 1007, 818, 917, 1625, 2186,
+	1953, 1844, 1567, 1929, 1147, 1145, 2126, 501, 1146, 1144,
+	1149, 1935, 501, 1148, 1936, 1439, 1143, 1375, 498, 1531,
+	167, 1136, 169, 1941, 1946, 1104, 856, 461, 2043, 1370,
+	1658, 1952, 501, 467, 1015, 1771, 1818, 630, 501, 501,
+	623, 1966, 1951, 1967, 1950, 2001, 1973, 2341, 2295, 2297,
+	2226, 2300, 1996, 2293, 2417, 2388, 2311, 1999, 1564, 1091,
+	2133, 169, 996, 995, 1005, 1006, 998, 999, 1000, 1001,
+	1002, 1003, 1004, 997, 1989, 2005, 1007, 1791, 1736, 1047,
+	1459, 1119, 170, 523, 170, 1481, 1394, 170, 1954, 538,
+	535, 536, 169, 1502, 1783, 2018, 989, 2020, 521, 2021,
+	515, 1111, 1523, 1521, 1520, 2014, 1682, 1123, 2012, 2008,
+	1117, 1506, 1565, 1916, 502, 502, 502, 2079, 968, 604,
+	2051, 1982, 510, 788, 1456, 2282, 169, 2019, 1699, 2122,
+	603, 62, 502, 502, 39, 505, 2412, 959, 613, 32,
+	501, 31, 30, 29, 28, 2035, 2036, 23, 1993, 501,
+	22, 21, 20, 2004, 2026, 169, 19, 25, 2048, 2049,
+	18, 17, 16, 102, 49, 169, 46, 44, 109, 108,
+	2074, 47, 2047, 43, 893, 2046, 27, 26, 15, 169,
+	14, 13, 169, 12, 11, 2066, 2073, 10, 9, 5,
+	4, 2096, 962, 24, 2072, 2099, 2098, 2065, 1713, 1598,
+	2067, 1031, 539, 2077, 1035, 2, 0, 0, 616, 2076,
+	0, 0, 170, 0, 0, 0, 0, 170, 0, 2090,
+	2102, 0, 0, 0, 0, 2091, 0, 0, 0, 0,
+	0, 0, 550, 2105, 0, 2093, 2094, 1711, 0, 0,
+	0, 0, 0, 1715, 0, 502, 0, 0, 170, 2110,
+	170, 170, 0, 502, 0, 1723, 1724, 0, 0, 502,
+	0, 1730, 0, 0, 1733, 1734, 0, 0, 0, 0,
+	2132, 0, 1740, 0, 1741, 514, 0, 1744, 1745, 1746,
+	1747, 1748, 0, 2137, 0, 0, 0, 0, 0, 2146,
+	0, 1758, 0, 500, 2107, 2108, 0, 2109, 0, 1791,
+	2111, 0, 2113, 169, 0, 2152, 169, 169, 169, 0,
+	501, 0, 501, 2153, 501, 501, 2145, 0, 2104, 0,
+	0, 0, 2156, 0, 2158, 0, 0, 0, 631, 2151,
+	0, 780, 0, 787, 0, 0, 501, 501, 501, 501,
+	0, 0, 0, 1806, 1807, 0, 0, 2174, 0, 2176,
+	0, 2178, 2179, 0, 0, 0, 2189, 0, 0, 2207,
+	0, 0, 0, 0, 2182, 2125, 0, 1021, 1022, 1023,
+	1024, 1025, 1026, 1027, 1028, 1029, 1030, 0, 501, 501,
+	501, 169, 0, 2205, 0, 0, 0, 0, 0, 0,
+	0, 0, 501, 2206, 501, 0, 0, 0, 0, 0,
+	501, 0, 0, 0, 0, 501, 170, 0, 2231, 2235,
+	1999, 0, 2223, 0, 1999, 0, 2224, 2237, 2233, 2221,
+	2222, 996, 995, 1005, 1006, 998, 999, 1000, 1001, 1002,
+	1003, 1004, 997, 169, 0, 1007, 0, 2240, 0, 1894,
+	502, 0, 2242, 501, 0, 0, 0, 2248, 0, 0,
+	0, 0, 0, 2266, 0, 2244, 2258, 2245, 502, 502,
+	0, 502, 2269, 502, 502, 2260, 502, 502, 502, 502,
+	502, 502, 0, 0, 0, 2254, 0, 2259, 0, 0,
+	501, 502, 0, 0, 0, 170, 0, 0, 2213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2292, 170, 0, 0, 0, 0, 1999, 0, 0, 2307,
+	169, 2239, 502, 0, 170, 0, 0, 2241, 501, 169,
+	2319, 2120, 1945, 0, 0, 0, 1948, 1949, 501, 170,
+	2323, 0, 2325, 2324, 0, 0, 0, 0, 0, 501,
+	0, 0, 0, 0, 0, 170, 0, 2313, 2314, 2119,
+	0, 2318, 170, 2320, 0, 2328, 0, 0, 0, 501,
+	0, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	502, 502, 502, 2345, 2351, 2372, 2356, 501, 501, 2365,
+	2366, 2364, 2361, 2367, 2368, 2338, 2369, 2266, 2378, 0,
+	2376, 0, 0, 2382, 0, 0, 0, 2002, 170, 1791,
+	501, 2393, 2392, 0, 0, 0, 0, 0, 2399, 0,
+	0, 2401, 0, 0, 0, 0, 0, 0, 2017, 0,
+	0, 2407, 0, 0, 0, 0, 2411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2415, 0, 0,
+	996, 995, 1005, 1006, 998, 999, 1000, 1001, 1002, 1003,
+	1004, 997, 0, 0, 1007, 2424, 0, 0, 2430, 502,
+	2118, 502, 2431, 2432, 1942, 0, 0, 2434, 996, 995,
+	1005, 1006, 998, 999, 1000, 1001, 1002, 1003, 1004, 997,
+	0, 0, 1007, 0, 996, 995, 1005, 1006, 998, 999,
+	1000, 1001, 1002, 1003, 1004, 997, 502, 502, 1007, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 0, 0,
+	170, 0, 0, 502, 0, 0, 0, 0, 631, 631,
+	631, 0, 0, 0, 0, 988, 0, 502, 0, 0,
+	0, 0, 170, 0, 0, 502, 958, 960, 0, 170,
+	0, 170, 0, 0, 0, 0, 0, 0, 0, 170,
+	170, 0, 0, 0, 0, 0, 502, 0, 0, 502,
+	2103, 0, 514, 0, 0, 2106, 0, 0, 0, 0,
+	502, 1048, 0, 0, 1710, 0, 2115, 2116, 0, 996,
+	995, 1005, 1006, 998, 999, 1000, 1001, 1002, 1003, 1004,
+	997, 0, 2130, 1007, 996, 995, 1005, 1006, 998, 999,
+	1000, 1001, 1002, 1003, 1004, 997, 1090, 1093, 1007, 0,
+	2139, 2140, 0, 0, 2144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 502, 0, 0, 0, 0,
+	0, 1399, 0, 0, 1408, 1409, 1410, 1411, 1412, 1413,
+	1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1107,
+	502, 0, 0, 0, 0, 0, 502, 631, 0, 0,
+	0, 0, 0, 1137, 0, 0, 0, 0, 0, 0,
+	2175, 0, 2177, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2185, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1464, 0, 0, 0,
+	0, 502, 991, 0, 994, 0, 0, 553, 35, 0,
+	1008, 1009, 1010, 1011, 1012, 1013, 1014, 0, 992, 993,
+	990, 996, 995, 1005, 1006, 998, 999, 1000, 1001, 1002,
+	1003, 1004, 997, 0, 0, 1007, 0, 2218, 0, 0,
+	0, 0, 0, 35, 170, 0, 0, 0, 0, 0,
+	0, 170, 0, 0, 0, 0, 170, 170, 0, 0,
+	170, 0, 170, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 0, 0, 0, 170, 0, 0, 0, 0,
+	0, 0, 0, 2249, 2250, 0, 2253, 0, 2255, 593,
+	0, 0, 0, 0, 0, 0, 0, 0, 2117, 0,
+	0, 0, 0, 170, 502, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2278, 2279,
+	2280, 2281, 0, 2285, 0, 2286, 2287, 2289, 0, 0,
+	0, 2290, 2291, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 780, 996, 995, 1005, 1006, 998,
+	999, 1000, 1001, 1002, 1003, 1004, 997, 1237, 0, 1007,
+	0, 0, 1244, 1244, 0, 1244, 0, 1244, 1244, 0,
+	1253, 1244, 1244, 1244, 1244, 1244, 0, 0, 0, 0,
+	0, 0, 0, 1237, 1237, 780, 0, 0, 0, 0,
+	0, 0, 2332, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 0, 0, 0, 0, 0, 0, 0, 170,
+	0, 0, 0, 1338, 0, 0, 1314, 996, 995, 1005,
+	1006, 998, 999, 1000, 1001, 1002, 1003, 1004, 997, 0,
+	0, 1007, 0, 2373, 0, 0, 0, 170, 0, 0,
+	0, 0, 0, 0, 1078, 0, 0, 0, 170, 170,
+	170, 170, 170, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 170, 0, 165, 170, 170, 0,
+	0, 170, 170, 170, 631, 631, 631, 2408, 0, 0,
+	2409, 2410, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 1390, 1391, 1392, 1393, 0, 456, 0, 0,
+	0, 0, 0, 149, 0, 0, 0, 504, 0, 0,
+	0, 0, 0, 0, 0, 585, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 502, 0, 0, 2438, 0, 170, 0, 0, 0,
+	0, 0, 0, 170, 784, 0, 0, 1705, 1706, 1707,
+	1447, 1448, 0, 502, 0, 0, 0, 146, 0, 147,
+	502, 0, 0, 1430, 0, 1432, 0, 631, 164, 0,
+	502, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1237, 0, 0, 0, 0, 0, 514, 0,
+	0, 0, 0, 170, 170, 170, 170, 170, 0, 0,
+	1465, 1466, 0, 0, 0, 0, 0, 0, 0, 170,
+	170, 0, 0, 0, 0, 0, 0, 1487, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1503, 0, 0, 0, 0, 150, 0, 0, 1107,
+	0, 0, 631, 0, 1553, 155, 0, 0, 502, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	631, 0, 0, 631, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 780, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 502, 0, 0, 0, 0,
+	502, 0, 0, 951, 951, 951, 0, 0, 0, 0,
+	170, 0, 0, 1594, 0, 0, 0, 0, 0, 0,
+	502, 0, 0, 35, 0, 0, 502, 502, 0, 0,
+	0, 0, 0, 0, 0, 0, 1016, 1018, 0, 787,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 142, 0, 780, 0, 0, 0, 1033, 0,
+	787, 0, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046,
+	170, 1049, 1051, 1054, 1054, 1054, 1051, 1054, 1054, 1051,
+	1054, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 0, 0,
+	0, 0, 0, 1079, 0, 0, 0, 0, 0, 0,
+	0, 35, 0, 0, 170, 780, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 502, 0,
+	0, 0, 0, 0, 0, 0, 0, 502, 0, 1120,
+	0, 0, 0, 170, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 0, 0, 0, 0, 0, 0,
+	0, 1943, 1944, 0, 0, 0, 0, 170, 0, 0,
+	170, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1968, 1969, 0, 1970, 1971, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1977, 1978, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 894, 0,
+	899, 0, 0, 901, 0, 0, 0, 0, 1694, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 143, 148, 145,
+	151, 152, 153, 154, 156, 157, 158, 159, 0, 165,
+	0, 0, 0, 160, 161, 162, 163, 0, 0, 0,
+	1214, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 107, 0, 129, 0, 0, 0, 0,
+	1739, 0, 0, 0, 2028, 0, 149, 0, 0, 0,
+	0, 170, 0, 0, 170, 170, 170, 0, 502, 0,
+	502, 0, 502, 502, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1766, 1767, 1093, 0, 139, 0, 0,
+	0, 0, 128, 0, 502, 502, 502, 502, 0, 0,
+	1223, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	146, 0, 147, 0, 0, 0, 0, 1218, 1219, 138,
+	137, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1237, 0, 0, 0, 0, 502, 502, 502, 170,
+	0, 0, 0, 0, 1113, 0, 0, 1124, 0, 0,
+	502, 0, 502, 0, 0, 0, 0, 0, 502, 0,
+	0, 0, 0, 502, 2097, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 1220,
+	140, 0, 1217, 0, 134, 135, 0, 0, 0, 150,
+	0, 170, 0, 0, 0, 0, 0, 0, 155, 0,
+	0, 502, 0, 0, 0, 0, 0, 0, 0, 551,
+	0, 0, 0, 0, 0, 1870, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 951,
+	951, 951, 0, 0, 0, 0, 0, 1487, 502, 0,
+	0, 1237, 0, 0, 1896, 0, 0, 0, 0, 0,
+	0, 0, 631, 0, 1902, 0, 2155, 0, 0, 168,
+	0, 0, 457, 0, 0, 496, 0, 0, 170, 0,
+	0, 0, 457, 0, 0, 0, 502, 170, 0, 0,
+	457, 0, 590, 0, 0, 0, 502, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 502, 0, 0,
+	617, 617, 1142, 0, 0, 142, 0, 0, 0, 457,
+	0, 0, 0, 0, 0, 0, 0, 502, 0, 0,
+	1431, 0, 0, 0, 0, 0, 0, 1975, 0, 0,
+	0, 0, 631, 0, 0, 502, 502, 0, 0, 2208,
+	2209, 2210, 2211, 2212, 0, 0, 0, 2215, 2216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 502, 0,
+	136, 0, 0, 0, 0, 0, 1991, 0, 0, 1244,
+	0, 0, 130, 0, 1983, 131, 0, 0, 0, 0,
+	0, 1275, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 631, 0, 0, 1237, 0, 0,
+	2003, 1244, 0, 0, 0, 0, 0, 0, 1535, 0,
+	1316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2277, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1345, 0, 0, 0, 0, 0, 0, 1349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1360,
+	1361, 1362, 1363, 1364, 1365, 1366, 0, 0, 0, 0,
+	0, 0, 2060, 0, 2062, 0, 0, 0, 0, 0,
+	143, 148, 145, 151, 152, 153, 154, 156, 157, 158,
+	159, 0, 0, 0, 1124, 0, 160, 161, 162, 163,
+	0, 0, 780, 0, 0, 1237, 0, 0, 0, 0,
+	0, 1487, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 514, 0, 0, 0,
+	0, 0, 0, 2370, 0, 0, 0, 0, 0, 0,
+	165, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2124, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 149, 0, 514,
+	0, 0, 0, 0, 0, 0, 2147, 0, 0, 2148,
+	0, 0, 2150, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 1237,
+	0, 0, 0, 128, 0, 0, 0, 0, 1510, 0,
+	0, 0, 0, 0, 0, 1514, 0, 1517, 0, 0,
+	0, 146, 0, 147, 0, 0, 1536, 2173, 116, 117,
+	138, 137, 164, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 457, 0, 457, 0, 0, 457, 0,
+	0, 0, 1487, 0, 1487, 0, 1487, 1487, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2194, 2195,
+	2196, 2197, 0, 0, 0, 0, 0, 0, 0, 133,
+	114, 140, 121, 113, 0, 134, 135, 0, 0, 0,
+	150, 0, 0, 2230, 514, 1718, 0, 0, 593, 155,
+	122, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2219, 2219, 2219, 0, 125, 123, 118, 119, 120, 124,
+	0, 0, 0, 115, 2236, 0, 2238, 0, 0, 0,
+	0, 0, 1487, 0, 0, 126, 1756, 1487, 0, 0,
+	0, 0, 0, 1018, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 457, 0, 0, 1120, 0, 590, 0,
+	0, 0, 0, 1787, 1788, 631, 0, 1120, 1120, 1120,
+	1120, 1120, 0, 0, 617, 0, 0, 0, 0, 0,
+	0, 0, 0, 1535, 0, 0, 1120, 0, 0, 457,
+	1120, 457, 1126, 0, 0, 0, 142, 0, 0, 0,
+	1124, 0, 2305, 0, 0, 0, 514, 1669, 0, 0,
+	0, 0, 1678, 1679, 0, 0, 1683, 0, 0, 0,
+	0, 0, 0, 0, 1686, 0, 0, 0, 0, 0,
+	0, 1689, 0, 0, 0, 0, 0, 0, 0, 0,
+	1487, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2331, 136, 0, 0, 0, 0, 0, 0, 0, 1693,
+	0, 2336, 0, 130, 0, 0, 131, 0, 1883, 1885,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1237,
+	0, 2352, 0, 0, 0, 0, 0, 0, 0, 0,
+	1903, 0, 0, 0, 0, 0, 0, 0, 0, 631,
+	631, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 457, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 143, 148, 145, 151, 152, 153, 154, 156, 157,
+	158, 159, 0, 0, 0, 0, 0, 160, 161, 162,
+	163, 0, 0, 0, 1238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1238, 1238, 0, 0, 1812, 0, 457, 0, 0, 0,
+	0, 0, 0, 2000, 0, 35, 0, 0, 0, 0,
+	0, 0, 1290, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 457, 0, 0, 1120, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 457, 0, 0, 0,
+	0, 0, 1873, 457, 0, 0, 0, 0, 0, 0,
+	0, 0, 1358, 1359, 457, 457, 457, 457, 457, 457,
+	457, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	172, 173, 0, 0, 0, 0, 0, 0, 2058, 2059,
+	0, 0, 2061, 0, 2063, 0, 0, 0, 0, 457,
+	0, 0, 0, 0, 0, 488, 0, 0, 0, 1920,
+	1921, 1922, 1923, 1924, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1124, 1930, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 476, 0,
+	0, 0, 0, 0, 0, 0, 0, 475, 0, 0,
+	0, 0, 0, 617, 1335, 0, 0, 0, 473, 617,
+	617, 0, 0, 617, 617, 617, 0, 0, 0, 1238,
+	2121, 0, 0, 0, 0, 0, 0, 2127, 2128, 2129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 617,
+	617, 617, 617, 617, 0, 470, 0, 0, 1290, 0,
+	0, 590, 0, 0, 0, 0, 1988, 483, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 132, 0, 0, 0,
-	0, 0, 1822, 0, 0, 0, 0, 1674, 126, 0,
-	579, 127, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 481, 0, 457, 0, 0, 0, 0, 0, 1335,
+	457, 0, 457, 0, 0, 0, 0, 0, 0, 0,
+	457, 457, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2033, 0, 0, 0,
+	0, 460, 0, 462, 477, 0, 491, 0, 490, 466,
+	0, 464, 468, 478, 469, 0, 463, 0, 474, 0,
+	0, 465, 479, 480, 495, 494, 482, 0, 472, 492,
+	2054, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1711, 0, 0,
-	0, 0, 0, 0, 1862, 1863, 1864, 1865, 1866, 0,
+	0, 0, 0, 0, 0, 0, 2000, 0, 35, 2075,
+	2000, 0, 0, 0, 0, 0, 0, 0, 0, 2078,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1098, 1872, 1094, 0, 0, 0, 0, 0, 0, 1738,
-	1739, 0, 0, 1094, 1094, 1094, 1094, 1094, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1499,
-	0, 0, 1094, 0, 0, 0, 1094, 0, 0, 0,
-	0, 0, 139, 144, 141, 147, 148, 149, 150, 152,
-	153, 154, 155, 0, 0, 0, 0, 0, 156, 157,
-	158, 159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2089, 0, 0, 2092, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2257,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1925, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1845, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2000, 0, 0, 457, 0, 493, 0, 0,
+	0, 0, 457, 0, 0, 0, 0, 457, 457, 2316,
+	0, 457, 2316, 1685, 2321, 486, 0, 0, 0, 457,
+	0, 35, 0, 0, 1164, 0, 457, 0, 0, 0,
+	487, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2169, 0, 0,
+	2170, 2171, 2172, 0, 457, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1970, 0, 0, 0, 0, 0, 0, 0, 0,
+	35, 2316, 2316, 0, 0, 0, 2316, 0, 2316, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1991, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2400, 0,
+	0, 0, 0, 0, 617, 617, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2004, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2007, 0, 0,
+	0, 0, 0, 0, 0, 0, 617, 0, 0, 0,
+	0, 36, 37, 38, 74, 40, 41, 0, 1152, 0,
+	0, 0, 457, 0, 0, 0, 0, 0, 0, 0,
+	1290, 78, 0, 0, 0, 42, 68, 69, 0, 66,
+	70, 0, 0, 0, 0, 0, 0, 0, 67, 0,
+	0, 0, 0, 0, 0, 0, 0, 617, 457, 0,
+	0, 1165, 0, 0, 0, 0, 0, 0, 1238, 457,
+	457, 457, 457, 457, 0, 0, 0, 55, 0, 0,
+	0, 1805, 0, 0, 0, 457, 0, 73, 457, 457,
+	0, 0, 457, 1815, 1335, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2018, 0, 0, 2021, 0, 0, 0, 0, 0,
+	0, 0, 0, 1178, 1181, 1182, 1183, 1184, 1185, 1186,
+	0, 1187, 1188, 1189, 1190, 1191, 1166, 1167, 1168, 1169,
+	1150, 1151, 1179, 2329, 1153, 0, 1154, 1155, 1156, 1157,
+	1158, 1159, 1160, 1161, 1162, 1163, 1170, 1171, 1172, 1173,
+	1174, 1175, 1176, 1177, 0, 0, 0, 457, 0, 0,
+	0, 0, 0, 0, 1879, 0, 0, 0, 45, 48,
+	51, 50, 53, 0, 65, 0, 0, 71, 1238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1335,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 54,
+	77, 76, 0, 0, 63, 64, 52, 0, 0, 0,
+	0, 0, 0, 0, 457, 457, 457, 457, 457, 0,
+	1180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	457, 457, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 56, 57, 0, 58,
+	59, 60, 61, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 617, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1937, 0, 34, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1094, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 457, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1238, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	75, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	457, 0, 0, 72, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 457, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2092, 0, 0, 2093, 2094, 2095, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 457, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 457, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 457, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 457, 0,
+	0, 457, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2044, 0, 0, 0, 0, 0, 0, 2050, 2051, 2052,
+	0, 0, 0, 0, 0, 0, 1238, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 457, 0, 0, 457, 457, 457, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2207,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1290, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1937, 0, 34, 0, 1937, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1290, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1937, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2201, 0, 0,
-	0, 0, 34, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 742, 728, 393, 0, 677, 745,
-	648, 665, 755, 668, 671, 711, 627, 690, 317, 662,
-	34, 652, 623, 658, 624, 650, 679, 224, 647, 730,
-	693, 744, 275, 221, 629, 653, 331, 667, 176, 713,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 751, 279, 700, 0, 378, 302,
-	0, 0, 0, 681, 734, 688, 724, 676, 712, 637,
-	699, 746, 663, 708, 747, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 2246, 2247,
-	0, 0, 0, 0, 0, 198, 0, 205, 705, 741,
-	660, 707, 219, 263, 226, 218, 395, 752, 733, 0,
-	191, 743, 683, 710, 758, 622, 702, 0, 625, 628,
-	754, 737, 656, 229, 0, 0, 0, 0, 0, 0,
-	0, 680, 689, 721, 674, 0, 0, 0, 0, 0,
-	0, 0, 0, 654, 0, 698, 0, 0, 0, 633,
-	626, 0, 0, 0, 0, 678, 0, 0, 0, 636,
-	0, 655, 722, 0, 620, 247, 630, 303, 0, 726,
-	736, 675, 427, 740, 673, 672, 717, 634, 732, 666,
-	274, 632, 271, 171, 187, 0, 664, 313, 352, 358,
-	731, 651, 659, 210, 657, 356, 327, 412, 194, 237,
-	349, 332, 354, 697, 715, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 934, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 646, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 727, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 719, 757, 326, 357, 200, 414, 377,
-	641, 645, 639, 640, 691, 692, 642, 748, 749, 750,
-	723, 635, 0, 643, 644, 0, 729, 738, 739, 696,
-	170, 184, 277, 753, 346, 240, 440, 421, 417, 621,
-	638, 216, 649, 0, 0, 661, 669, 670, 682, 684,
-	685, 686, 687, 695, 703, 704, 706, 714, 716, 718,
-	720, 725, 735, 756, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 694, 701, 287, 234, 252,
-	262, 709, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	742, 728, 393, 0, 677, 745, 648, 665, 755, 668,
-	671, 711, 627, 690, 317, 662, 0, 652, 623, 658,
-	624, 650, 679, 224, 647, 730, 693, 744, 275, 221,
-	629, 653, 331, 667, 176, 713, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	751, 279, 700, 0, 378, 302, 0, 0, 0, 681,
-	734, 688, 724, 676, 712, 637, 699, 746, 663, 708,
-	747, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 705, 741, 660, 707, 219, 263,
-	226, 218, 395, 752, 733, 0, 191, 743, 683, 710,
-	758, 622, 702, 0, 625, 628, 754, 737, 656, 229,
-	0, 0, 0, 0, 0, 0, 0, 680, 689, 721,
-	674, 0, 0, 0, 0, 0, 0, 1929, 0, 654,
-	0, 698, 0, 0, 0, 633, 626, 0, 0, 0,
-	0, 678, 0, 0, 0, 636, 0, 655, 722, 0,
-	620, 247, 630, 303, 0, 726, 736, 675, 427, 740,
-	673, 672, 717, 634, 732, 666, 274, 632, 271, 171,
-	187, 0, 664, 313, 352, 358, 731, 651, 659, 210,
-	657, 356, 327, 412, 194, 237, 349, 332, 354, 697,
-	715, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	934, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	646, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 727, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 719,
-	757, 326, 357, 200, 414, 377, 641, 645, 639, 640,
-	691, 692, 642, 748, 749, 750, 723, 635, 0, 643,
-	644, 0, 729, 738, 739, 696, 170, 184, 277, 753,
-	346, 240, 440, 421, 417, 621, 638, 216, 649, 0,
-	0, 661, 669, 670, 682, 684, 685, 686, 687, 695,
-	703, 704, 706, 714, 716, 718, 720, 725, 735, 756,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 694, 701, 287, 234, 252, 262, 709, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 742, 728, 393, 0,
-	677, 745, 648, 665, 755, 668, 671, 711, 627, 690,
-	317, 662, 0, 652, 623, 658, 624, 650, 679, 224,
-	647, 730, 693, 744, 275, 221, 629, 653, 331, 667,
-	176, 713, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 751, 279, 700, 0,
-	378, 302, 0, 0, 0, 681, 734, 688, 724, 676,
-	712, 637, 699, 746, 663, 708, 747, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	705, 741, 660, 707, 219, 263, 226, 218, 395, 752,
-	733, 0, 191, 743, 683, 710, 758, 622, 702, 0,
-	625, 628, 754, 737, 656, 229, 0, 0, 0, 0,
-	0, 0, 0, 680, 689, 721, 674, 0, 0, 0,
-	0, 0, 0, 1767, 0, 654, 0, 698, 0, 0,
-	0, 633, 626, 0, 0, 0, 0, 678, 0, 0,
-	0, 636, 0, 655, 722, 0, 620, 247, 630, 303,
-	0, 726, 736, 675, 427, 740, 673, 672, 717, 634,
-	732, 666, 274, 632, 271, 171, 187, 0, 664, 313,
-	352, 358, 731, 651, 659, 210, 657, 356, 327, 412,
-	194, 237, 349, 332, 354, 697, 715, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 934, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 646, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 727,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 719, 757, 326, 357, 200,
-	414, 377, 641, 645, 639, 640, 691, 692, 642, 748,
-	749, 750, 723, 635, 0, 643, 644, 0, 729, 738,
-	739, 696, 170, 184, 277, 753, 346, 240, 440, 421,
-	417, 621, 638, 216, 649, 0, 0, 661, 669, 670,
-	682, 684, 685, 686, 687, 695, 703, 704, 706, 714,
-	716, 718, 720, 725, 735, 756, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 694, 701, 287,
-	234, 252, 262, 709, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 742, 728, 393, 0, 677, 745, 648, 665,
-	755, 668, 671, 711, 627, 690, 317, 662, 0, 652,
-	623, 658, 624, 650, 679, 224, 647, 730, 693, 744,
-	275, 221, 629, 653, 331, 667, 176, 713, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 751, 279, 700, 0, 378, 302, 0, 0,
-	0, 681, 734, 688, 724, 676, 712, 637, 699, 746,
-	663, 708, 747, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 705, 741, 660, 707,
-	219, 263, 226, 218, 395, 752, 733, 0, 191, 743,
-	683, 710, 758, 622, 702, 0, 625, 628, 754, 737,
-	656, 229, 0, 0, 0, 0, 0, 0, 0, 680,
-	689, 721, 674, 0, 0, 0, 0, 0, 0, 1476,
-	0, 654, 0, 698, 0, 0, 0, 633, 626, 0,
-	0, 0, 0, 678, 0, 0, 0, 636, 0, 655,
-	722, 0, 620, 247, 630, 303, 0, 726, 736, 675,
-	427, 740, 673, 672, 717, 634, 732, 666, 274, 632,
-	271, 171, 187, 0, 664, 313, 352, 358, 731, 651,
-	659, 210, 657, 356, 327, 412, 194, 237, 349, 332,
-	354, 697, 715, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 934, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 646, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 727, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 719, 757, 326, 357, 200, 414, 377, 641, 645,
-	639, 640, 691, 692, 642, 748, 749, 750, 723, 635,
-	0, 643, 644, 0, 729, 738, 739, 696, 170, 184,
-	277, 753, 346, 240, 440, 421, 417, 621, 638, 216,
-	649, 0, 0, 661, 669, 670, 682, 684, 685, 686,
-	687, 695, 703, 704, 706, 714, 716, 718, 720, 725,
-	735, 756, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 694, 701, 287, 234, 252, 262, 709,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 742, 728,
-	393, 0, 677, 745, 648, 665, 755, 668, 671, 711,
-	627, 690, 317, 662, 0, 652, 623, 658, 624, 650,
-	679, 224, 647, 730, 693, 744, 275, 221, 629, 653,
-	331, 667, 176, 713, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 751, 279,
-	700, 0, 378, 302, 0, 0, 0, 681, 734, 688,
-	724, 676, 712, 637, 699, 746, 663, 708, 747, 265,
-	207, 175, 314, 379, 239, 71, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 705, 741, 660, 707, 219, 263, 226, 218,
-	395, 752, 733, 0, 191, 743, 683, 710, 758, 622,
-	702, 0, 625, 628, 754, 737, 656, 229, 0, 0,
-	0, 0, 0, 0, 0, 680, 689, 721, 674, 0,
-	0, 0, 0, 0, 0, 0, 0, 654, 0, 698,
-	0, 0, 0, 633, 626, 0, 0, 0, 0, 678,
-	0, 0, 0, 636, 0, 655, 722, 0, 620, 247,
-	630, 303, 0, 726, 736, 675, 427, 740, 673, 672,
-	717, 634, 732, 666, 274, 632, 271, 171, 187, 0,
-	664, 313, 352, 358, 731, 651, 659, 210, 657, 356,
-	327, 412, 194, 237, 349, 332, 354, 697, 715, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 934, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 646, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 727, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 719, 757, 326,
-	357, 200, 414, 377, 641, 645, 639, 640, 691, 692,
-	642, 748, 749, 750, 723, 635, 0, 643, 644, 0,
-	729, 738, 739, 696, 170, 184, 277, 753, 346, 240,
-	440, 421, 417, 621, 638, 216, 649, 0, 0, 661,
-	669, 670, 682, 684, 685, 686, 687, 695, 703, 704,
-	706, 714, 716, 718, 720, 725, 735, 756, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 694,
-	701, 287, 234, 252, 262, 709, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 742, 728, 393, 0, 677, 745,
-	648, 665, 755, 668, 671, 711, 627, 690, 317, 662,
-	0, 652, 623, 658, 624, 650, 679, 224, 647, 730,
-	693, 744, 275, 221, 629, 653, 331, 667, 176, 713,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 751, 279, 700, 0, 378, 302,
-	0, 0, 0, 681, 734, 688, 724, 676, 712, 637,
-	699, 746, 663, 708, 747, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 0, 205, 705, 741,
-	660, 707, 219, 263, 226, 218, 395, 752, 733, 0,
-	191, 743, 683, 710, 758, 622, 702, 0, 625, 628,
-	754, 737, 656, 229, 0, 0, 0, 0, 0, 0,
-	0, 680, 689, 721, 674, 0, 0, 0, 0, 0,
-	0, 0, 0, 654, 0, 698, 0, 0, 0, 633,
-	626, 0, 0, 0, 0, 678, 0, 0, 0, 636,
-	0, 655, 722, 0, 620, 247, 630, 303, 0, 726,
-	736, 675, 427, 740, 673, 672, 717, 634, 732, 666,
-	274, 632, 271, 171, 187, 0, 664, 313, 352, 358,
-	731, 651, 659, 210, 657, 356, 327, 412, 194, 237,
-	349, 332, 354, 697, 715, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 934, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 646, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 727, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 719, 757, 326, 357, 200, 414, 377,
-	641, 645, 639, 640, 691, 692, 642, 748, 749, 750,
-	723, 635, 0, 643, 644, 0, 729, 738, 739, 696,
-	170, 184, 277, 753, 346, 240, 440, 421, 417, 621,
-	638, 216, 649, 0, 0, 661, 669, 670, 682, 684,
-	685, 686, 687, 695, 703, 704, 706, 714, 716, 718,
-	720, 725, 735, 756, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 694, 701, 287, 234, 252,
-	262, 709, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	742, 728, 393, 0, 677, 745, 648, 665, 755, 668,
-	671, 711, 627, 690, 317, 662, 0, 652, 623, 658,
-	624, 650, 679, 224, 647, 730, 693, 744, 275, 221,
-	629, 653, 331, 667, 176, 713, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	751, 279, 700, 0, 378, 302, 0, 0, 0, 681,
-	734, 688, 724, 676, 712, 637, 699, 746, 663, 708,
-	747, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 705, 741, 660, 707, 219, 263,
-	226, 218, 395, 752, 733, 0, 759, 743, 683, 710,
-	758, 622, 702, 0, 625, 628, 754, 737, 656, 229,
-	0, 0, 0, 0, 0, 0, 0, 680, 689, 721,
-	674, 0, 0, 0, 0, 0, 0, 0, 0, 654,
-	0, 698, 0, 0, 0, 633, 626, 0, 0, 0,
-	0, 678, 0, 0, 0, 636, 0, 655, 722, 0,
-	620, 247, 630, 303, 0, 726, 736, 675, 427, 740,
-	673, 672, 717, 634, 732, 666, 274, 632, 271, 171,
-	187, 0, 664, 313, 352, 358, 731, 651, 659, 210,
-	657, 356, 327, 412, 194, 237, 349, 332, 354, 697,
-	715, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	631, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	646, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 727, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 619, 613, 612, 272, 281, 719,
-	757, 326, 357, 200, 414, 377, 641, 645, 639, 640,
-	691, 692, 642, 748, 749, 750, 723, 635, 0, 643,
-	644, 0, 729, 738, 739, 696, 170, 184, 277, 753,
-	346, 240, 440, 421, 417, 621, 638, 216, 649, 0,
-	0, 661, 669, 670, 682, 684, 685, 686, 687, 695,
-	703, 704, 706, 714, 716, 718, 720, 725, 735, 756,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 694, 701, 287, 234, 252, 262, 709, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 742, 728, 393, 0,
-	677, 745, 648, 665, 755, 668, 671, 711, 627, 690,
-	317, 662, 0, 652, 623, 658, 624, 650, 679, 224,
-	647, 730, 693, 744, 275, 221, 629, 653, 331, 667,
-	176, 713, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 751, 279, 700, 0,
-	378, 302, 0, 0, 0, 681, 734, 688, 724, 676,
-	712, 637, 699, 746, 663, 708, 747, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	705, 741, 660, 707, 219, 263, 226, 218, 395, 752,
-	733, 0, 759, 743, 683, 710, 758, 622, 702, 0,
-	625, 628, 754, 737, 656, 229, 0, 0, 0, 0,
-	0, 0, 0, 680, 689, 721, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 654, 0, 698, 0, 0,
-	0, 633, 626, 0, 0, 0, 0, 678, 0, 0,
-	0, 636, 0, 655, 722, 0, 620, 247, 630, 303,
-	0, 726, 736, 675, 427, 740, 673, 672, 717, 634,
-	732, 666, 274, 632, 271, 171, 187, 0, 664, 313,
-	352, 358, 731, 651, 659, 210, 657, 356, 327, 412,
-	194, 237, 349, 332, 354, 697, 715, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	1102, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 631, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 646, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 727,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	619, 613, 612, 272, 281, 719, 757, 326, 357, 200,
-	414, 377, 641, 645, 639, 640, 691, 692, 642, 748,
-	749, 750, 723, 635, 0, 643, 644, 0, 729, 738,
-	739, 696, 170, 184, 277, 753, 346, 240, 440, 421,
-	417, 621, 638, 216, 649, 0, 0, 661, 669, 670,
-	682, 684, 685, 686, 687, 695, 703, 704, 706, 714,
-	716, 718, 720, 725, 735, 756, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 694, 701, 287,
-	234, 252, 262, 709, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 742, 728, 393, 0, 677, 745, 648, 665,
-	755, 668, 671, 711, 627, 690, 317, 662, 0, 652,
-	623, 658, 624, 650, 679, 224, 647, 730, 693, 744,
-	275, 221, 629, 653, 331, 667, 176, 713, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 751, 279, 700, 0, 378, 302, 0, 0,
-	0, 681, 734, 688, 724, 676, 712, 637, 699, 746,
-	663, 708, 747, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 705, 741, 660, 707,
-	219, 263, 226, 218, 395, 752, 733, 0, 759, 743,
-	683, 710, 758, 622, 702, 0, 625, 628, 754, 737,
-	656, 229, 0, 0, 0, 0, 0, 0, 0, 680,
-	689, 721, 674, 0, 0, 0, 0, 0, 0, 0,
-	0, 654, 0, 698, 0, 0, 0, 633, 626, 0,
-	0, 0, 0, 678, 0, 0, 0, 636, 0, 655,
-	722, 0, 620, 247, 630, 303, 0, 726, 736, 675,
-	427, 740, 673, 672, 717, 634, 732, 666, 274, 632,
-	271, 171, 187, 0, 664, 313, 352, 358, 731, 651,
-	659, 210, 657, 356, 327, 412, 194, 237, 349, 332,
-	354, 697, 715, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 610, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 631, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 646, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 727, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 619, 613, 612, 272,
-	281, 719, 757, 326, 357, 200, 414, 377, 641, 645,
-	639, 640, 691, 692, 642, 748, 749, 750, 723, 635,
-	0, 643, 644, 0, 729, 738, 739, 696, 170, 184,
-	277, 753, 346, 240, 440, 421, 417, 621, 638, 216,
-	649, 0, 0, 661, 669, 670, 682, 684, 685, 686,
-	687, 695, 703, 704, 706, 714, 716, 718, 720, 725,
-	735, 756, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 694, 701, 287, 234, 252, 262, 709,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 1404, 0, 510, 0, 0, 0, 224,
-	509, 0, 0, 0, 275, 221, 0, 1405, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 553, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 544, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 71, 0, 0, 167, 168, 169, 531,
-	530, 533, 534, 535, 536, 0, 0, 198, 532, 205,
-	537, 538, 539, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 507, 524,
-	0, 552, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 521, 522, 600, 0, 0, 0, 568, 0, 523,
-	0, 0, 516, 517, 519, 518, 520, 525, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 567, 0, 0, 427, 0, 0, 565, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 555, 566, 561, 562, 559, 560, 554, 558,
-	557, 556, 569, 546, 547, 548, 549, 551, 0, 563,
-	564, 550, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 510,
-	0, 0, 0, 224, 509, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	553, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 544, 545, 0, 0, 0, 0, 0, 0, 1515,
-	0, 265, 207, 175, 314, 379, 239, 71, 0, 0,
-	167, 168, 169, 531, 530, 533, 534, 535, 536, 0,
-	0, 198, 532, 205, 537, 538, 539, 1516, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 507, 524, 0, 552, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 521, 522, 0, 0, 0,
-	0, 568, 0, 523, 0, 0, 516, 517, 519, 518,
-	520, 525, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 567, 0, 0, 427, 0,
-	0, 565, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 555, 566, 561, 562,
-	559, 560, 554, 558, 557, 556, 569, 546, 547, 548,
-	549, 551, 0, 563, 564, 550, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 510, 0, 0, 0, 224, 509, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 553, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 544, 545, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 71, 0, 587, 167, 168, 169, 531, 530, 533,
-	534, 535, 536, 0, 0, 198, 532, 205, 537, 538,
-	539, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 507, 524, 0, 552,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 521,
-	522, 0, 0, 0, 0, 568, 0, 523, 0, 0,
-	516, 517, 519, 518, 520, 525, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 567,
-	0, 0, 427, 0, 0, 565, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	555, 566, 561, 562, 559, 560, 554, 558, 557, 556,
-	569, 546, 547, 548, 549, 551, 0, 563, 564, 550,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 510, 0, 0,
-	0, 224, 509, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 553, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 544,
-	545, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 71, 0, 0, 167, 168,
-	169, 531, 530, 533, 534, 535, 536, 0, 0, 198,
-	532, 205, 537, 538, 539, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	507, 524, 0, 552, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 521, 522, 600, 0, 0, 0, 568,
-	0, 523, 0, 0, 516, 517, 519, 518, 520, 525,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 567, 0, 0, 427, 0, 0, 565,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 555, 566, 561, 562, 559, 560,
-	554, 558, 557, 556, 569, 546, 547, 548, 549, 551,
-	0, 563, 564, 550, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 510, 0, 0, 0, 224, 509, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 553, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 544, 545, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 71,
-	0, 0, 167, 168, 169, 531, 1422, 533, 534, 535,
-	536, 0, 0, 198, 532, 205, 537, 538, 539, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 507, 524, 0, 552, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 521, 522, 600,
-	0, 0, 0, 568, 0, 523, 0, 0, 516, 517,
-	519, 518, 520, 525, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 567, 0, 0,
-	427, 0, 0, 565, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 555, 566,
-	561, 562, 559, 560, 554, 558, 557, 556, 569, 546,
-	547, 548, 549, 551, 0, 563, 564, 550, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 510, 0, 0, 0, 224,
-	509, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 553, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 544, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 71, 0, 0, 167, 168, 169, 531,
-	1419, 533, 534, 535, 536, 0, 0, 198, 532, 205,
-	537, 538, 539, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 507, 524,
-	0, 552, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 521, 522, 600, 0, 0, 0, 568, 0, 523,
-	0, 0, 516, 517, 519, 518, 520, 525, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 567, 0, 0, 427, 0, 0, 565, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 555, 566, 561, 562, 559, 560, 554, 558,
-	557, 556, 569, 546, 547, 548, 549, 551, 0, 563,
-	564, 550, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 580, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	510, 0, 0, 0, 224, 509, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 553, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 544, 545, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
-	0, 167, 168, 169, 531, 530, 533, 534, 535, 536,
-	0, 0, 198, 532, 205, 537, 538, 539, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 507, 524, 0, 552, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 521, 522, 0, 0,
-	0, 0, 568, 0, 523, 0, 0, 516, 517, 519,
-	518, 520, 525, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 567, 0, 0, 427,
-	0, 0, 565, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 555, 566, 561,
-	562, 559, 560, 554, 558, 557, 556, 569, 546, 547,
-	548, 549, 551, 0, 563, 564, 550, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 510, 0, 0, 0, 224, 509,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 553, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 544, 545, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 71, 0, 0, 167, 168, 169, 531, 530,
-	533, 534, 535, 536, 0, 0, 198, 532, 205, 537,
-	538, 539, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 507, 524, 0,
-	552, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	521, 522, 0, 0, 0, 0, 568, 0, 523, 0,
-	0, 516, 517, 519, 518, 520, 525, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	567, 0, 0, 427, 0, 0, 565, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 555, 566, 561, 562, 559, 560, 554, 558, 557,
-	556, 569, 546, 547, 548, 549, 551, 0, 563, 564,
-	550, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 553,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	544, 545, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 71, 0, 0, 167,
-	168, 169, 531, 530, 533, 534, 535, 536, 0, 0,
-	198, 532, 205, 537, 538, 539, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 524, 0, 552, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 521, 522, 0, 0, 0, 0,
-	568, 0, 523, 0, 0, 516, 517, 519, 518, 520,
-	525, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 567, 0, 0, 427, 0, 0,
-	565, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 2240, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 555, 566, 561, 562, 559,
-	560, 554, 558, 557, 556, 569, 546, 547, 548, 549,
-	551, 0, 563, 564, 550, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 553, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 544, 545, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	71, 0, 587, 167, 168, 169, 531, 530, 533, 534,
-	535, 536, 0, 0, 198, 532, 205, 537, 538, 539,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 524, 0, 552, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 521, 522,
-	0, 0, 0, 0, 568, 0, 523, 0, 0, 516,
-	517, 519, 518, 520, 525, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 567, 0,
-	0, 427, 0, 0, 565, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 555,
-	566, 561, 562, 559, 560, 554, 558, 557, 556, 569,
-	546, 547, 548, 549, 551, 0, 563, 564, 550, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 553, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 544, 545,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 71, 0, 0, 167, 168, 169,
-	531, 530, 533, 534, 535, 536, 0, 0, 198, 532,
-	205, 537, 538, 539, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	524, 0, 552, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 521, 522, 0, 0, 0, 0, 568, 0,
-	523, 0, 0, 516, 517, 519, 518, 520, 525, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 567, 0, 0, 427, 0, 0, 565, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 555, 566, 561, 562, 559, 560, 554,
-	558, 557, 556, 569, 546, 547, 548, 549, 551, 0,
-	563, 564, 550, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 977, 976, 986, 987, 979, 980, 981, 982,
-	983, 984, 985, 978, 0, 0, 988, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 805, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 804, 427, 0, 0, 0, 0, 0, 801,
-	802, 274, 767, 271, 171, 187, 795, 799, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 1080, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 1082, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 966, 967,
-	965, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 968, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 35, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 71, 0, 587, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
-	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 1449, 0, 0, 0,
-	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
-	169, 0, 1263, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1290,
+	0, 0, 0, 0, 0, 0, 0, 0, 457, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	758, 744, 402, 0, 692, 761, 663, 680, 771, 683,
+	686, 726, 642, 705, 323, 677, 1238, 667, 638, 673,
+	639, 665, 694, 229, 662, 746, 708, 760, 280, 226,
+	644, 668, 337, 682, 180, 728, 378, 214, 290, 287,
+	407, 240, 232, 228, 212, 264, 296, 335, 396, 329,
+	767, 284, 715, 0, 387, 308, 0, 0, 0, 696,
+	750, 703, 739, 691, 727, 652, 714, 762, 678, 723,
+	763, 270, 211, 179, 320, 388, 244, 0, 0, 0,
+	171, 172, 173, 0, 2379, 2380, 0, 0, 0, 0,
+	0, 202, 0, 209, 720, 757, 675, 722, 224, 268,
+	231, 223, 404, 768, 749, 0, 195, 178, 361, 0,
+	759, 698, 725, 774, 637, 717, 0, 640, 643, 770,
+	753, 671, 234, 0, 0, 0, 0, 0, 0, 0,
+	695, 704, 736, 689, 0, 0, 0, 0, 0, 0,
+	0, 0, 669, 0, 713, 0, 0, 0, 648, 641,
+	0, 0, 0, 0, 693, 0, 0, 0, 651, 0,
+	670, 737, 0, 635, 252, 645, 309, 0, 742, 752,
+	690, 436, 756, 688, 687, 732, 649, 748, 681, 279,
+	647, 276, 175, 191, 0, 679, 319, 359, 365, 747,
+	666, 674, 215, 672, 363, 333, 421, 198, 242, 356,
+	338, 712, 730, 362, 285, 409, 351, 419, 0, 289,
+	339, 437, 438, 222, 313, 427, 400, 433, 448, 192,
+	219, 327, 393, 424, 384, 306, 405, 406, 275, 383,
+	250, 283, 445, 190, 371, 206, 183, 395, 417, 203,
+	374, 0, 0, 450, 185, 415, 392, 303, 272, 273,
+	184, 0, 355, 227, 248, 217, 322, 412, 413, 216,
+	451, 194, 432, 187, 953, 431, 315, 408, 416, 304,
+	295, 186, 414, 302, 294, 278, 238, 259, 349, 288,
+	350, 260, 311, 310, 312, 0, 181, 0, 389, 425,
+	452, 199, 200, 201, 661, 237, 241, 247, 249, 255,
+	256, 263, 281, 326, 348, 346, 352, 743, 403, 420,
+	428, 435, 441, 442, 446, 443, 444, 447, 314, 262,
+	385, 277, 286, 734, 773, 332, 364, 204, 423, 386,
+	656, 660, 654, 655, 706, 707, 657, 764, 765, 766,
+	738, 650, 0, 658, 659, 0, 745, 754, 755, 711,
+	174, 188, 282, 769, 353, 245, 449, 430, 376, 375,
+	741, 213, 426, 636, 653, 221, 664, 0, 0, 676,
+	684, 685, 697, 699, 700, 701, 702, 710, 718, 719,
+	721, 729, 731, 733, 735, 740, 751, 772, 176, 177,
+	189, 197, 207, 220, 235, 243, 253, 258, 261, 265,
+	266, 269, 274, 292, 297, 298, 299, 300, 316, 317,
+	318, 321, 324, 325, 328, 330, 331, 334, 341, 342,
+	343, 344, 345, 347, 354, 358, 366, 367, 368, 369,
+	370, 372, 373, 379, 380, 381, 382, 390, 394, 410,
+	411, 422, 434, 439, 254, 418, 440, 0, 291, 709,
+	716, 293, 239, 257, 267, 724, 429, 391, 193, 360,
+	246, 182, 210, 196, 218, 233, 236, 271, 301, 307,
+	336, 340, 251, 230, 208, 357, 205, 377, 397, 398,
+	399, 401, 305, 225, 758, 744, 402, 0, 692, 761,
+	663, 680, 771, 683, 686, 726, 642, 705, 323, 677,
+	0, 667, 638, 673, 639, 665, 694, 229, 662, 746,
+	708, 760, 280, 226, 644, 668, 337, 682, 180, 728,
+	378, 214, 290, 287, 407, 240, 232, 228, 212, 264,
+	296, 335, 396, 329, 767, 284, 715, 0, 387, 308,
+	0, 0, 0, 696, 750, 703, 739, 691, 727, 652,
+	714, 762, 678, 723, 763, 270, 211, 179, 320, 388,
+	244, 0, 0, 0, 171, 172, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 202, 0, 209, 720, 757,
+	675, 722, 224, 268, 231, 223, 404, 768, 749, 0,
+	195, 178, 361, 0, 759, 698, 725, 774, 637, 717,
+	0, 640, 643, 770, 753, 671, 234, 0, 0, 0,
+	0, 0, 0, 0, 695, 704, 736, 689, 0, 0,
+	0, 0, 0, 0, 1992, 0, 669, 0, 713, 0,
+	0, 0, 648, 641, 0, 0, 0, 0, 693, 0,
+	0, 0, 651, 0, 670, 737, 0, 635, 252, 645,
+	309, 0, 742, 752, 690, 436, 756, 688, 687, 732,
+	649, 748, 681, 279, 647, 276, 175, 191, 0, 679,
+	319, 359, 365, 747, 666, 674, 215, 672, 363, 333,
+	421, 198, 242, 356, 338, 712, 730, 362, 285, 409,
+	351, 419, 0, 289, 339, 437, 438, 222, 313, 427,
+	400, 433, 448, 192, 219, 327, 393, 424, 384, 306,
+	405, 406, 275, 383, 250, 283, 445, 190, 371, 206,
+	183, 395, 417, 203, 374, 0, 0, 450, 185, 415,
+	392, 303, 272, 273, 184, 0, 355, 227, 248, 217,
+	322, 412, 413, 216, 451, 194, 432, 187, 953, 431,
+	315, 408, 416, 304, 295, 186, 414, 302, 294, 278,
+	238, 259, 349, 288, 350, 260, 311, 310, 312, 0,
+	181, 0, 389, 425, 452, 199, 200, 201, 661, 237,
+	241, 247, 249, 255, 256, 263, 281, 326, 348, 346,
+	352, 743, 403, 420, 428, 435, 441, 442, 446, 443,
+	444, 447, 314, 262, 385, 277, 286, 734, 773, 332,
+	364, 204, 423, 386, 656, 660, 654, 655, 706, 707,
+	657, 764, 765, 766, 738, 650, 0, 658, 659, 0,
+	745, 754, 755, 711, 174, 188, 282, 769, 353, 245,
+	449, 430, 376, 375, 741, 213, 426, 636, 653, 221,
+	664, 0, 0, 676, 684, 685, 697, 699, 700, 701,
+	702, 710, 718, 719, 721, 729, 731, 733, 735, 740,
+	751, 772, 176, 177, 189, 197, 207, 220, 235, 243,
+	253, 258, 261, 265, 266, 269, 274, 292, 297, 298,
+	299, 300, 316, 317, 318, 321, 324, 325, 328, 330,
+	331, 334, 341, 342, 343, 344, 345, 347, 354, 358,
+	366, 367, 368, 369, 370, 372, 373, 379, 380, 381,
+	382, 390, 394, 410, 411, 422, 434, 439, 254, 418,
+	440, 0, 291, 709, 716, 293, 239, 257, 267, 724,
+	429, 391, 193, 360, 246, 182, 210, 196, 218, 233,
+	236, 271, 301, 307, 336, 340, 251, 230, 208, 357,
+	205, 377, 397, 398, 399, 401, 305, 225, 758, 744,
+	402, 0, 692, 761, 663, 680, 771, 683, 686, 726,
+	642, 705, 323, 677, 0, 667, 638, 673, 639, 665,
+	694, 229, 662, 746, 708, 760, 280, 226, 644, 668,
+	337, 682, 180, 728, 378, 214, 290, 287, 407, 240,
+	232, 228, 212, 264, 296, 335, 396, 329, 767, 284,
+	715, 0, 387, 308, 0, 0, 0, 696, 750, 703,
+	739, 691, 727, 652, 714, 762, 678, 723, 763, 270,
+	211, 179, 320, 388, 244, 0, 0, 0, 171, 172,
+	173, 0, 0, 0, 0, 0, 0, 0, 0, 202,
+	0, 209, 720, 757, 675, 722, 224, 268, 231, 223,
+	404, 768, 749, 0, 195, 178, 361, 0, 759, 698,
+	725, 774, 637, 717, 0, 640, 643, 770, 753, 671,
+	234, 0, 0, 0, 0, 0, 0, 0, 695, 704,
+	736, 689, 0, 0, 0, 0, 0, 0, 1816, 0,
+	669, 0, 713, 0, 0, 0, 648, 641, 0, 0,
+	0, 0, 693, 0, 0, 0, 651, 0, 670, 737,
+	0, 635, 252, 645, 309, 0, 742, 752, 690, 436,
+	756, 688, 687, 732, 649, 748, 681, 279, 647, 276,
+	175, 191, 0, 679, 319, 359, 365, 747, 666, 674,
+	215, 672, 363, 333, 421, 198, 242, 356, 338, 712,
+	730, 362, 285, 409, 351, 419, 0, 289, 339, 437,
+	438, 222, 313, 427, 400, 433, 448, 192, 219, 327,
+	393, 424, 384, 306, 405, 406, 275, 383, 250, 283,
+	445, 190, 371, 206, 183, 395, 417, 203, 374, 0,
+	0, 450, 185, 415, 392, 303, 272, 273, 184, 0,
+	355, 227, 248, 217, 322, 412, 413, 216, 451, 194,
+	432, 187, 953, 431, 315, 408, 416, 304, 295, 186,
+	414, 302, 294, 278, 238, 259, 349, 288, 350, 260,
+	311, 310, 312, 0, 181, 0, 389, 425, 452, 199,
+	200, 201, 661, 237, 241, 247, 249, 255, 256, 263,
+	281, 326, 348, 346, 352, 743, 403, 420, 428, 435,
+	441, 442, 446, 443, 444, 447, 314, 262, 385, 277,
+	286, 734, 773, 332, 364, 204, 423, 386, 656, 660,
+	654, 655, 706, 707, 657, 764, 765, 766, 738, 650,
+	0, 658, 659, 0, 745, 754, 755, 711, 174, 188,
+	282, 769, 353, 245, 449, 430, 376, 375, 741, 213,
+	426, 636, 653, 221, 664, 0, 0, 676, 684, 685,
+	697, 699, 700, 701, 702, 710, 718, 719, 721, 729,
+	731, 733, 735, 740, 751, 772, 176, 177, 189, 197,
+	207, 220, 235, 243, 253, 258, 261, 265, 266, 269,
+	274, 292, 297, 298, 299, 300, 316, 317, 318, 321,
+	324, 325, 328, 330, 331, 334, 341, 342, 343, 344,
+	345, 347, 354, 358, 366, 367, 368, 369, 370, 372,
+	373, 379, 380, 381, 382, 390, 394, 410, 411, 422,
+	434, 439, 254, 418, 440, 0, 291, 709, 716, 293,
+	239, 257, 267, 724, 429, 391, 193, 360, 246, 182,
+	210, 196, 218, 233, 236, 271, 301, 307, 336, 340,
+	251, 230, 208, 357, 205, 377, 397, 398, 399, 401,
+	305, 225, 758, 744, 402, 0, 692, 761, 663, 680,
+	771, 683, 686, 726, 642, 705, 323, 677, 0, 667,
+	638, 673, 639, 665, 694, 229, 662, 746, 708, 760,
+	280, 226, 644, 668, 337, 682, 180, 728, 378, 214,
+	290, 287, 407, 240, 232, 228, 212, 264, 296, 335,
+	396, 329, 767, 284, 715, 0, 387, 308, 0, 0,
+	0, 696, 750, 703, 739, 691, 727, 652, 714, 762,
+	678, 723, 763, 270, 211, 179, 320, 388, 244, 0,
+	0, 0, 171, 172, 173, 0, 0, 0, 0, 0,
+	0, 0, 0, 202, 0, 209, 720, 757, 675, 722,
+	224, 268, 231, 223, 404, 768, 749, 0, 195, 178,
+	361, 0, 759, 698, 725, 774, 637, 717, 0, 640,
+	643, 770, 753, 671, 234, 0, 0, 0, 0, 0,
+	0, 0, 695, 704, 736, 689, 0, 0, 0, 0,
+	0, 0, 1512, 0, 669, 0, 713, 0, 0, 0,
+	648, 641, 0, 0, 0, 0, 693, 0, 0, 0,
+	651, 0, 670, 737, 0, 635, 252, 645, 309, 0,
+	742, 752, 690, 436, 756, 688, 687, 732, 649, 748,
+	681, 279, 647, 276, 175, 191, 0, 679, 319, 359,
+	365, 747, 666, 674, 215, 672, 363, 333, 421, 198,
+	242, 356, 338, 712, 730, 362, 285, 409, 351, 419,
+	0, 289, 339, 437, 438, 222, 313, 427, 400, 433,
+	448, 192, 219, 327, 393, 424, 384, 306, 405, 406,
+	275, 383, 250, 283, 445, 190, 371, 206, 183, 395,
+	417, 203, 374, 0, 0, 450, 185, 415, 392, 303,
+	272, 273, 184, 0, 355, 227, 248, 217, 322, 412,
+	413, 216, 451, 194, 432, 187, 953, 431, 315, 408,
+	416, 304, 295, 186, 414, 302, 294, 278, 238, 259,
+	349, 288, 350, 260, 311, 310, 312, 0, 181, 0,
+	389, 425, 452, 199, 200, 201, 661, 237, 241, 247,
+	249, 255, 256, 263, 281, 326, 348, 346, 352, 743,
+	403, 420, 428, 435, 441, 442, 446, 443, 444, 447,
+	314, 262, 385, 277, 286, 734, 773, 332, 364, 204,
+	423, 386, 656, 660, 654, 655, 706, 707, 657, 764,
+	765, 766, 738, 650, 0, 658, 659, 0, 745, 754,
+	755, 711, 174, 188, 282, 769, 353, 245, 449, 430,
+	376, 375, 741, 213, 426, 636, 653, 221, 664, 0,
+	0, 676, 684, 685, 697, 699, 700, 701, 702, 710,
+	718, 719, 721, 729, 731, 733, 735, 740, 751, 772,
+	176, 177, 189, 197, 207, 220, 235, 243, 253, 258,
+	261, 265, 266, 269, 274, 292, 297, 298, 299, 300,
+	316, 317, 318, 321, 324, 325, 328, 330, 331, 334,
+	341, 342, 343, 344, 345, 347, 354, 358, 366, 367,
+	368, 369, 370, 372, 373, 379, 380, 381, 382, 390,
+	394, 410, 411, 422, 434, 439, 254, 418, 440, 0,
+	291, 709, 716, 293, 239, 257, 267, 724, 429, 391,
+	193, 360, 246, 182, 210, 196, 218, 233, 236, 271,
+	301, 307, 336, 340, 251, 230, 208, 357, 205, 377,
+	397, 398, 399, 401, 305, 225, 758, 744, 402, 0,
+	692, 761, 663, 680, 771, 683, 686, 726, 642, 705,
+	323, 677, 0, 667, 638, 673, 639, 665, 694, 229,
+	662, 746, 708, 760, 280, 226, 644, 668, 337, 682,
+	180, 728, 378, 214, 290, 287, 407, 240, 232, 228,
+	212, 264, 296, 335, 396, 329, 767, 284, 715, 0,
+	387, 308, 0, 0, 0, 696, 750, 703, 739, 691,
+	727, 652, 714, 762, 678, 723, 763, 270, 211, 179,
+	320, 388, 244, 73, 0, 0, 171, 172, 173, 0,
+	0, 0, 0, 0, 0, 0, 0, 202, 0, 209,
+	720, 757, 675, 722, 224, 268, 231, 223, 404, 768,
+	749, 0, 195, 178, 361, 0, 759, 698, 725, 774,
+	637, 717, 0, 640, 643, 770, 753, 671, 234, 0,
+	0, 0, 0, 0, 0, 0, 695, 704, 736, 689,
+	0, 0, 0, 0, 0, 0, 0, 0, 669, 0,
+	713, 0, 0, 0, 648, 641, 0, 0, 0, 0,
+	693, 0, 0, 0, 651, 0, 670, 737, 0, 635,
+	252, 645, 309, 0, 742, 752, 690, 436, 756, 688,
+	687, 732, 649, 748, 681, 279, 647, 276, 175, 191,
+	0, 679, 319, 359, 365, 747, 666, 674, 215, 672,
+	363, 333, 421, 198, 242, 356, 338, 712, 730, 362,
+	285, 409, 351, 419, 0, 289, 339, 437, 438, 222,
+	313, 427, 400, 433, 448, 192, 219, 327, 393, 424,
+	384, 306, 405, 406, 275, 383, 250, 283, 445, 190,
+	371, 206, 183, 395, 417, 203, 374, 0, 0, 450,
+	185, 415, 392, 303, 272, 273, 184, 0, 355, 227,
+	248, 217, 322, 412, 413, 216, 451, 194, 432, 187,
+	953, 431, 315, 408, 416, 304, 295, 186, 414, 302,
+	294, 278, 238, 259, 349, 288, 350, 260, 311, 310,
+	312, 0, 181, 0, 389, 425, 452, 199, 200, 201,
+	661, 237, 241, 247, 249, 255, 256, 263, 281, 326,
+	348, 346, 352, 743, 403, 420, 428, 435, 441, 442,
+	446, 443, 444, 447, 314, 262, 385, 277, 286, 734,
+	773, 332, 364, 204, 423, 386, 656, 660, 654, 655,
+	706, 707, 657, 764, 765, 766, 738, 650, 0, 658,
+	659, 0, 745, 754, 755, 711, 174, 188, 282, 769,
+	353, 245, 449, 430, 376, 375, 741, 213, 426, 636,
+	653, 221, 664, 0, 0, 676, 684, 685, 697, 699,
+	700, 701, 702, 710, 718, 719, 721, 729, 731, 733,
+	735, 740, 751, 772, 176, 177, 189, 197, 207, 220,
+	235, 243, 253, 258, 261, 265, 266, 269, 274, 292,
+	297, 298, 299, 300, 316, 317, 318, 321, 324, 325,
+	328, 330, 331, 334, 341, 342, 343, 344, 345, 347,
+	354, 358, 366, 367, 368, 369, 370, 372, 373, 379,
+	380, 381, 382, 390, 394, 410, 411, 422, 434, 439,
+	254, 418, 440, 0, 291, 709, 716, 293, 239, 257,
+	267, 724, 429, 391, 193, 360, 246, 182, 210, 196,
+	218, 233, 236, 271, 301, 307, 336, 340, 251, 230,
+	208, 357, 205, 377, 397, 398, 399, 401, 305, 225,
+	758, 744, 402, 0, 692, 761, 663, 680, 771, 683,
+	686, 726, 642, 705, 323, 677, 0, 667, 638, 673,
+	639, 665, 694, 229, 662, 746, 708, 760, 280, 226,
+	644, 668, 337, 682, 180, 728, 378, 214, 290, 287,
+	407, 240, 232, 228, 212, 264, 296, 335, 396, 329,
+	767, 284, 715, 0, 387, 308, 0, 0, 0, 696,
+	750, 703, 739, 691, 727, 652, 714, 762, 678, 723,
+	763, 270, 211, 179, 320, 388, 244, 0, 0, 0,
+	171, 172, 173, 0, 0, 0, 0, 0, 0, 0,
+	0, 202, 0, 209, 720, 757, 675, 722, 224, 268,
+	231, 223, 404, 768, 749, 0, 195, 178, 361, 0,
+	759, 698, 725, 774, 637, 717, 0, 640, 643, 770,
+	753, 671, 234, 0, 0, 0, 0, 0, 0, 0,
+	695, 704, 736, 689, 0, 0, 0, 0, 0, 0,
+	0, 0, 669, 0, 713, 0, 0, 0, 648, 641,
+	0, 0, 0, 0, 693, 0, 0, 0, 651, 0,
+	670, 737, 0, 635, 252, 645, 309, 0, 742, 752,
+	690, 436, 756, 688, 687, 732, 649, 748, 681, 279,
+	647, 276, 175, 191, 0, 679, 319, 359, 365, 747,
+	666, 674, 215, 672, 363, 333, 421, 198, 242, 356,
+	338, 712, 730, 362, 285, 409, 351, 419, 0, 289,
+	339, 437, 438, 222, 313, 427, 400, 433, 448, 192,
+	219, 327, 393, 424, 384, 306, 405, 406, 275, 383,
+	250, 283, 445, 190, 371, 206, 183, 395, 417, 203,
+	374, 0, 0, 450, 185, 415, 392, 303, 272, 273,
+	184, 0, 355, 227, 248, 217, 322, 412, 413, 216,
+	451, 194, 432, 187, 953, 431, 315, 408, 416, 304,
+	295, 186, 414, 302, 294, 278, 238, 259, 349, 288,
+	350, 260, 311, 310, 312, 0, 181, 0, 389, 425,
+	452, 199, 200, 201, 661, 237, 241, 247, 249, 255,
+	256, 263, 281, 326, 348, 346, 352, 743, 403, 420,
+	428, 435, 441, 442, 446, 443, 444, 447, 314, 262,
+	385, 277, 286, 734, 773, 332, 364, 204, 423, 386,
+	656, 660, 654, 655, 706, 707, 657, 764, 765, 766,
+	738, 650, 0, 658, 659, 0, 745, 754, 755, 711,
+	174, 188, 282, 769, 353, 245, 449, 430, 376, 375,
+	741, 213, 426, 636, 653, 221, 664, 0, 0, 676,
+	684, 685, 697, 699, 700, 701, 702, 710, 718, 719,
+	721, 729, 731, 733, 735, 740, 751, 772, 176, 177,
+	189, 197, 207, 220, 235, 243, 253, 258, 261, 265,
+	266, 269, 274, 292, 297, 298, 299, 300, 316, 317,
+	318, 321, 324, 325, 328, 330, 331, 334, 341, 342,
+	343, 344, 345, 347, 354, 358, 366, 367, 368, 369,
+	370, 372, 373, 379, 380, 381, 382, 390, 394, 410,
+	411, 422, 434, 439, 254, 418, 440, 0, 291, 709,
+	716, 293, 239, 257, 267, 724, 429, 391, 193, 360,
+	246, 182, 210, 196, 218, 233, 236, 271, 301, 307,
+	336, 340, 251, 230, 208, 357, 205, 377, 397, 398,
+	399, 401, 305, 225, 758, 744, 402, 0, 692, 761,
+	663, 680, 771, 683, 686, 726, 642, 705, 323, 677,
+	0, 667, 638, 673, 639, 665, 694, 229, 662, 746,
+	708, 760, 280, 226, 644, 668, 337, 682, 180, 728,
+	378, 214, 290, 287, 407, 240, 232, 228, 212, 264,
+	296, 335, 396, 329, 767, 284, 715, 0, 387, 308,
+	0, 0, 0, 696, 750, 703, 739, 691, 727, 652,
+	714, 762, 678, 723, 763, 270, 211, 179, 320, 388,
+	244, 0, 0, 0, 171, 172, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 202, 0, 209, 720, 757,
+	675, 722, 224, 268, 231, 223, 404, 768, 749, 0,
+	775, 178, 361, 0, 759, 698, 725, 774, 637, 717,
+	0, 640, 643, 770, 753, 671, 234, 0, 0, 0,
+	0, 0, 0, 0, 695, 704, 736, 689, 0, 0,
+	0, 0, 0, 0, 0, 0, 669, 0, 713, 0,
+	0, 0, 648, 641, 0, 0, 0, 0, 693, 0,
+	0, 0, 651, 0, 670, 737, 0, 635, 252, 645,
+	309, 0, 742, 752, 690, 436, 756, 688, 687, 732,
+	649, 748, 681, 279, 647, 276, 175, 191, 0, 679,
+	319, 359, 365, 747, 666, 674, 215, 672, 363, 333,
+	421, 198, 242, 356, 338, 712, 730, 362, 285, 409,
+	351, 419, 0, 289, 339, 437, 438, 222, 313, 427,
+	400, 433, 448, 192, 219, 327, 393, 424, 384, 306,
+	405, 406, 275, 383, 250, 283, 445, 190, 371, 206,
+	183, 395, 417, 203, 374, 0, 0, 450, 185, 415,
+	392, 303, 272, 273, 184, 0, 355, 227, 248, 217,
+	322, 412, 413, 216, 451, 194, 432, 187, 646, 431,
+	315, 408, 416, 304, 295, 186, 414, 302, 294, 278,
+	238, 259, 349, 288, 350, 260, 311, 310, 312, 0,
+	181, 0, 389, 425, 452, 199, 200, 201, 661, 237,
+	241, 247, 249, 255, 256, 263, 281, 326, 348, 346,
+	352, 743, 403, 420, 428, 435, 441, 442, 446, 443,
+	444, 447, 634, 628, 627, 277, 286, 734, 773, 332,
+	364, 204, 423, 386, 656, 660, 654, 655, 706, 707,
+	657, 764, 765, 766, 738, 650, 0, 658, 659, 0,
+	745, 754, 755, 711, 174, 188, 282, 769, 353, 245,
+	449, 430, 376, 375, 741, 213, 426, 636, 653, 221,
+	664, 0, 0, 676, 684, 685, 697, 699, 700, 701,
+	702, 710, 718, 719, 721, 729, 731, 733, 735, 740,
+	751, 772, 176, 177, 189, 197, 207, 220, 235, 243,
+	253, 258, 261, 265, 266, 269, 274, 292, 297, 298,
+	299, 300, 316, 317, 318, 321, 324, 325, 328, 330,
+	331, 334, 341, 342, 343, 344, 345, 347, 354, 358,
+	366, 367, 368, 369, 370, 372, 373, 379, 380, 381,
+	382, 390, 394, 410, 411, 422, 434, 439, 254, 418,
+	440, 0, 291, 709, 716, 293, 239, 257, 267, 724,
+	429, 391, 193, 360, 246, 182, 210, 196, 218, 233,
+	236, 271, 301, 307, 336, 340, 251, 230, 208, 357,
+	205, 377, 397, 398, 399, 401, 305, 225, 758, 744,
+	402, 0, 692, 761, 663, 680, 771, 683, 686, 726,
+	642, 705, 323, 677, 0, 667, 638, 673, 639, 665,
+	694, 229, 662, 746, 708, 760, 280, 226, 644, 668,
+	337, 682, 180, 728, 378, 214, 290, 287, 407, 240,
+	232, 228, 212, 264, 296, 335, 396, 329, 767, 284,
+	715, 0, 387, 308, 0, 0, 0, 696, 750, 703,
+	739, 691, 727, 652, 714, 762, 678, 723, 763, 270,
+	211, 179, 320, 388, 244, 0, 0, 0, 171, 172,
+	173, 0, 0, 0, 0, 0, 0, 0, 0, 202,
+	0, 209, 720, 757, 675, 722, 224, 268, 231, 223,
+	404, 768, 749, 0, 775, 178, 361, 0, 759, 698,
+	725, 774, 637, 717, 0, 640, 643, 770, 753, 671,
+	234, 0, 0, 0, 0, 0, 0, 0, 695, 704,
+	736, 689, 0, 0, 0, 0, 0, 0, 0, 0,
+	669, 0, 713, 0, 0, 0, 648, 641, 0, 0,
+	0, 0, 693, 0, 0, 0, 651, 0, 670, 737,
+	0, 635, 252, 645, 309, 0, 742, 752, 690, 436,
+	756, 688, 687, 732, 649, 748, 681, 279, 647, 276,
+	175, 191, 0, 679, 319, 359, 365, 747, 666, 674,
+	215, 672, 363, 333, 421, 198, 242, 356, 338, 712,
+	730, 362, 285, 409, 351, 419, 0, 289, 339, 437,
+	438, 222, 313, 427, 400, 433, 448, 192, 219, 327,
+	393, 424, 384, 306, 405, 406, 275, 383, 250, 283,
+	445, 190, 371, 206, 183, 395, 1128, 203, 374, 0,
+	0, 450, 185, 415, 392, 303, 272, 273, 184, 0,
+	355, 227, 248, 217, 322, 412, 413, 216, 451, 194,
+	432, 187, 646, 431, 315, 408, 416, 304, 295, 186,
+	414, 302, 294, 278, 238, 259, 349, 288, 350, 260,
+	311, 310, 312, 0, 181, 0, 389, 425, 452, 199,
+	200, 201, 661, 237, 241, 247, 249, 255, 256, 263,
+	281, 326, 348, 346, 352, 743, 403, 420, 428, 435,
+	441, 442, 446, 443, 444, 447, 634, 628, 627, 277,
+	286, 734, 773, 332, 364, 204, 423, 386, 656, 660,
+	654, 655, 706, 707, 657, 764, 765, 766, 738, 650,
+	0, 658, 659, 0, 745, 754, 755, 711, 174, 188,
+	282, 769, 353, 245, 449, 430, 376, 375, 741, 213,
+	426, 636, 653, 221, 664, 0, 0, 676, 684, 685,
+	697, 699, 700, 701, 702, 710, 718, 719, 721, 729,
+	731, 733, 735, 740, 751, 772, 176, 177, 189, 197,
+	207, 220, 235, 243, 253, 258, 261, 265, 266, 269,
+	274, 292, 297, 298, 299, 300, 316, 317, 318, 321,
+	324, 325, 328, 330, 331, 334, 341, 342, 343, 344,
+	345, 347, 354, 358, 366, 367, 368, 369, 370, 372,
+	373, 379, 380, 381, 382, 390, 394, 410, 411, 422,
+	434, 439, 254, 418, 440, 0, 291, 709, 716, 293,
+	239, 257, 267, 724, 429, 391, 193, 360, 246, 182,
+	210, 196, 218, 233, 236, 271, 301, 307, 336, 340,
+	251, 230, 208, 357, 205, 377, 397, 398, 399, 401,
+	305, 225, 758, 744, 402, 0, 692, 761, 663, 680,
+	771, 683, 686, 726, 642, 705, 323, 677, 0, 667,
+	638, 673, 639, 665, 694, 229, 662, 746, 708, 760,
+	280, 226, 644, 668, 337, 682, 180, 728, 378, 214,
+	290, 287, 407, 240, 232, 228, 212, 264, 296, 335,
+	396, 329, 767, 284, 715, 0, 387, 308, 0, 0,
+	0, 696, 750, 703, 739, 691, 727, 652, 714, 762,
+	678, 723, 763, 270, 211, 179, 320, 388, 244, 0,
+	0, 0, 171, 172, 173, 0, 0, 0, 0, 0,
+	0, 0, 0, 202, 0, 209, 720, 757, 675, 722,
+	224, 268, 231, 223, 404, 768, 749, 0, 775, 178,
+	361, 0, 759, 698, 725, 774, 637, 717, 0, 640,
+	643, 770, 753, 671, 234, 0, 0, 0, 0, 0,
+	0, 0, 695, 704, 736, 689, 0, 0, 0, 0,
+	0, 0, 0, 0, 669, 0, 713, 0, 0, 0,
+	648, 641, 0, 0, 0, 0, 693, 0, 0, 0,
+	651, 0, 670, 737, 0, 635, 252, 645, 309, 0,
+	742, 752, 690, 436, 756, 688, 687, 732, 649, 748,
+	681, 279, 647, 276, 175, 191, 0, 679, 319, 359,
+	365, 747, 666, 674, 215, 672, 363, 333, 421, 198,
+	242, 356, 338, 712, 730, 362, 285, 409, 351, 419,
+	0, 289, 339, 437, 438, 222, 313, 427, 400, 433,
+	448, 192, 219, 327, 393, 424, 384, 306, 405, 406,
+	275, 383, 250, 283, 445, 190, 371, 206, 183, 395,
+	625, 203, 374, 0, 0, 450, 185, 415, 392, 303,
+	272, 273, 184, 0, 355, 227, 248, 217, 322, 412,
+	413, 216, 451, 194, 432, 187, 646, 431, 315, 408,
+	416, 304, 295, 186, 414, 302, 294, 278, 238, 259,
+	349, 288, 350, 260, 311, 310, 312, 0, 181, 0,
+	389, 425, 452, 199, 200, 201, 661, 237, 241, 247,
+	249, 255, 256, 263, 281, 326, 348, 346, 352, 743,
+	403, 420, 428, 435, 441, 442, 446, 443, 444, 447,
+	634, 628, 627, 277, 286, 734, 773, 332, 364, 204,
+	423, 386, 656, 660, 654, 655, 706, 707, 657, 764,
+	765, 766, 738, 650, 0, 658, 659, 0, 745, 754,
+	755, 711, 174, 188, 282, 769, 353, 245, 449, 430,
+	376, 375, 741, 213, 426, 636, 653, 221, 664, 0,
+	0, 676, 684, 685, 697, 699, 700, 701, 702, 710,
+	718, 719, 721, 729, 731, 733, 735, 740, 751, 772,
+	176, 177, 189, 197, 207, 220, 235, 243, 253, 258,
+	261, 265, 266, 269, 274, 292, 297, 298, 299, 300,
+	316, 317, 318, 321, 324, 325, 328, 330, 331, 334,
+	341, 342, 343, 344, 345, 347, 354, 358, 366, 367,
+	368, 369, 370, 372, 373, 379, 380, 381, 382, 390,
+	394, 410, 411, 422, 434, 439, 254, 418, 440, 0,
+	291, 709, 716, 293, 239, 257, 267, 724, 429, 391,
+	193, 360, 246, 182, 210, 196, 218, 233, 236, 271,
+	301, 307, 336, 340, 251, 230, 208, 357, 205, 377,
+	397, 398, 399, 401, 305, 225, 402, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 323, 0,
+	0, 1434, 0, 519, 0, 0, 0, 229, 518, 0,
+	0, 0, 280, 226, 0, 1435, 337, 0, 180, 0,
+	378, 214, 290, 287, 407, 240, 232, 228, 212, 264,
+	296, 335, 396, 329, 563, 284, 0, 0, 387, 308,
+	0, 0, 0, 0, 0, 554, 555, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 211, 179, 320, 388,
+	244, 73, 0, 0, 171, 172, 173, 541, 540, 543,
+	544, 545, 546, 0, 0, 202, 542, 209, 547, 548,
+	549, 0, 224, 268, 231, 223, 404, 0, 0, 0,
+	195, 178, 361, 0, 0, 0, 0, 0, 0, 516,
+	533, 0, 562, 0, 0, 0, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 530, 531, 615, 0, 0, 0, 578, 0,
+	532, 0, 0, 525, 526, 528, 527, 529, 534, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	309, 0, 577, 0, 0, 436, 0, 0, 575, 0,
+	0, 0, 0, 279, 0, 276, 175, 191, 0, 0,
+	319, 359, 365, 0, 0, 0, 215, 0, 363, 333,
+	421, 198, 242, 356, 338, 0, 0, 362, 285, 409,
+	351, 419, 0, 289, 339, 437, 438, 222, 313, 427,
+	400, 433, 448, 192, 219, 327, 393, 424, 384, 306,
+	405, 406, 275, 383, 250, 283, 445, 190, 371, 206,
+	183, 395, 417, 203, 374, 0, 0, 450, 185, 415,
+	392, 303, 272, 273, 184, 0, 355, 227, 248, 217,
+	322, 412, 413, 216, 451, 194, 432, 187, 0, 431,
+	315, 408, 416, 304, 295, 186, 414, 302, 294, 278,
+	238, 259, 349, 288, 350, 260, 311, 310, 312, 0,
+	181, 0, 389, 425, 452, 199, 200, 201, 0, 237,
+	241, 247, 249, 255, 256, 263, 281, 326, 348, 346,
+	352, 0, 403, 420, 428, 435, 441, 442, 446, 443,
+	444, 447, 314, 262, 385, 277, 286, 0, 0, 332,
+	364, 204, 423, 386, 565, 576, 571, 572, 569, 570,
+	564, 568, 567, 566, 579, 556, 557, 558, 559, 561,
+	0, 573, 574, 560, 174, 188, 282, 0, 353, 245,
+	449, 430, 376, 375, 0, 213, 426, 0, 0, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 177, 189, 197, 207, 220, 235, 243,
+	253, 258, 261, 265, 266, 269, 274, 292, 297, 298,
+	299, 300, 316, 317, 318, 321, 324, 325, 328, 330,
+	331, 334, 341, 342, 343, 344, 345, 347, 354, 358,
+	366, 367, 368, 369, 370, 372, 373, 379, 380, 381,
+	382, 390, 394, 410, 411, 422, 434, 439, 254, 418,
+	440, 0, 291, 0, 0, 293, 239, 257, 267, 0,
+	429, 391, 193, 360, 246, 182, 210, 196, 218, 233,
+	236, 271, 301, 307, 336, 340, 251, 230, 208, 357,
+	205, 377, 397, 398, 399, 401, 305, 225, 402, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	323, 0, 0, 0, 0, 519, 0, 0, 0, 229,
+	518, 0, 0, 0, 280, 226, 0, 0, 337, 0,
+	180, 0, 378, 214, 290, 287, 407, 240, 232, 228,
+	212, 264, 296, 335, 396, 329, 563, 284, 0, 0,
+	387, 308, 0, 0, 0, 0, 0, 554, 555, 0,
+	0, 0, 0, 0, 0, 1551, 0, 270, 211, 179,
+	320, 388, 244, 73, 0, 0, 171, 172, 173, 541,
+	540, 543, 544, 545, 546, 0, 0, 202, 542, 209,
+	547, 548, 549, 1552, 224, 268, 231, 223, 404, 0,
+	0, 0, 195, 178, 361, 0, 0, 0, 0, 0,
+	0, 516, 533, 0, 562, 0, 0, 0, 234, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 530, 531, 0, 0, 0, 0,
+	578, 0, 532, 0, 0, 525, 526, 528, 527, 529,
+	534, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 309, 0, 577, 0, 0, 436, 0, 0,
+	575, 0, 0, 0, 0, 279, 0, 276, 175, 191,
+	0, 0, 319, 359, 365, 0, 0, 0, 215, 0,
+	363, 333, 421, 198, 242, 356, 338, 0, 0, 362,
+	285, 409, 351, 419, 0, 289, 339, 437, 438, 222,
+	313, 427, 400, 433, 448, 192, 219, 327, 393, 424,
+	384, 306, 405, 406, 275, 383, 250, 283, 445, 190,
+	371, 206, 183, 395, 417, 203, 374, 0, 0, 450,
+	185, 415, 392, 303, 272, 273, 184, 0, 355, 227,
+	248, 217, 322, 412, 413, 216, 451, 194, 432, 187,
+	0, 431, 315, 408, 416, 304, 295, 186, 414, 302,
+	294, 278, 238, 259, 349, 288, 350, 260, 311, 310,
+	312, 0, 181, 0, 389, 425, 452, 199, 200, 201,
+	0, 237, 241, 247, 249, 255, 256, 263, 281, 326,
+	348, 346, 352, 0, 403, 420, 428, 435, 441, 442,
+	446, 443, 444, 447, 314, 262, 385, 277, 286, 0,
+	0, 332, 364, 204, 423, 386, 565, 576, 571, 572,
+	569, 570, 564, 568, 567, 566, 579, 556, 557, 558,
+	559, 561, 0, 573, 574, 560, 174, 188, 282, 0,
+	353, 245, 449, 430, 376, 375, 0, 213, 426, 0,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 176, 177, 189, 197, 207, 220,
+	235, 243, 253, 258, 261, 265, 266, 269, 274, 292,
+	297, 298, 299, 300, 316, 317, 318, 321, 324, 325,
+	328, 330, 331, 334, 341, 342, 343, 344, 345, 347,
+	354, 358, 366, 367, 368, 369, 370, 372, 373, 379,
+	380, 381, 382, 390, 394, 410, 411, 422, 434, 439,
+	254, 418, 440, 0, 291, 0, 0, 293, 239, 257,
+	267, 0, 429, 391, 193, 360, 246, 182, 210, 196,
+	218, 233, 236, 271, 301, 307, 336, 340, 251, 230,
+	208, 357, 205, 377, 397, 398, 399, 401, 305, 225,
+	86, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 0, 0, 0, 519, 0,
+	0, 0, 229, 518, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 563,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	554, 555, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 73, 0, 0, 171,
+	172, 173, 541, 540, 543, 544, 545, 546, 0, 0,
+	202, 542, 209, 547, 548, 549, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	0, 0, 0, 0, 516, 533, 0, 562, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 530, 531, 0,
+	0, 0, 0, 578, 0, 532, 0, 0, 525, 526,
+	528, 527, 529, 534, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 309, 0, 577, 0, 0,
+	436, 0, 0, 575, 0, 0, 0, 0, 279, 0,
+	276, 175, 191, 0, 0, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 0, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 565,
+	576, 571, 572, 569, 570, 564, 568, 567, 566, 579,
+	556, 557, 558, 559, 561, 0, 573, 574, 560, 174,
+	188, 282, 72, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 254, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 0, 0, 0,
+	519, 0, 0, 0, 229, 518, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 563, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 554, 555, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 73, 0,
+	602, 171, 172, 173, 541, 540, 543, 544, 545, 546,
+	0, 0, 202, 542, 209, 547, 548, 549, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 0, 0, 0, 516, 533, 0, 562,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 530,
+	531, 0, 0, 0, 0, 578, 0, 532, 0, 0,
+	525, 526, 528, 527, 529, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 577,
+	0, 0, 436, 0, 0, 575, 0, 0, 0, 0,
+	279, 0, 276, 175, 191, 0, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 0, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 565, 576, 571, 572, 569, 570, 564, 568, 567,
+	566, 579, 556, 557, 558, 559, 561, 0, 573, 574,
+	560, 174, 188, 282, 0, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 519, 0, 0, 0, 229, 518, 0, 0,
+	0, 280, 226, 0, 0, 337, 0, 180, 0, 378,
+	214, 290, 287, 407, 240, 232, 228, 212, 264, 296,
+	335, 396, 329, 563, 284, 0, 0, 387, 308, 0,
+	0, 0, 0, 0, 554, 555, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 211, 179, 320, 388, 244,
+	73, 0, 0, 171, 172, 173, 541, 540, 543, 544,
+	545, 546, 0, 0, 202, 542, 209, 547, 548, 549,
+	0, 224, 268, 231, 223, 404, 0, 0, 0, 195,
+	178, 361, 0, 0, 0, 0, 0, 0, 516, 533,
+	0, 562, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 530, 531, 615, 0, 0, 0, 578, 0, 532,
+	0, 0, 525, 526, 528, 527, 529, 534, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 309,
+	0, 577, 0, 0, 436, 0, 0, 575, 0, 0,
+	0, 0, 279, 0, 276, 175, 191, 0, 0, 319,
+	359, 365, 0, 0, 0, 215, 0, 363, 333, 421,
+	198, 242, 356, 338, 0, 0, 362, 285, 409, 351,
+	419, 0, 289, 339, 437, 438, 222, 313, 427, 400,
+	433, 448, 192, 219, 327, 393, 424, 384, 306, 405,
+	406, 275, 383, 250, 283, 445, 190, 371, 206, 183,
+	395, 417, 203, 374, 0, 0, 450, 185, 415, 392,
+	303, 272, 273, 184, 0, 355, 227, 248, 217, 322,
+	412, 413, 216, 451, 194, 432, 187, 0, 431, 315,
+	408, 416, 304, 295, 186, 414, 302, 294, 278, 238,
+	259, 349, 288, 350, 260, 311, 310, 312, 0, 181,
+	0, 389, 425, 452, 199, 200, 201, 0, 237, 241,
+	247, 249, 255, 256, 263, 281, 326, 348, 346, 352,
+	0, 403, 420, 428, 435, 441, 442, 446, 443, 444,
+	447, 314, 262, 385, 277, 286, 0, 0, 332, 364,
+	204, 423, 386, 565, 576, 571, 572, 569, 570, 564,
+	568, 567, 566, 579, 556, 557, 558, 559, 561, 0,
+	573, 574, 560, 174, 188, 282, 0, 353, 245, 449,
+	430, 376, 375, 0, 213, 426, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 177, 189, 197, 207, 220, 235, 243, 253,
+	258, 261, 265, 266, 269, 274, 292, 297, 298, 299,
+	300, 316, 317, 318, 321, 324, 325, 328, 330, 331,
+	334, 341, 342, 343, 344, 345, 347, 354, 358, 366,
+	367, 368, 369, 370, 372, 373, 379, 380, 381, 382,
+	390, 394, 410, 411, 422, 434, 439, 254, 418, 440,
+	0, 291, 0, 0, 293, 239, 257, 267, 0, 429,
+	391, 193, 360, 246, 182, 210, 196, 218, 233, 236,
+	271, 301, 307, 336, 340, 251, 230, 208, 357, 205,
+	377, 397, 398, 399, 401, 305, 225, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 519, 0, 0, 0, 229, 518,
+	0, 0, 0, 280, 226, 0, 0, 337, 0, 180,
+	0, 378, 214, 290, 287, 407, 240, 232, 228, 212,
+	264, 296, 335, 396, 329, 563, 284, 0, 0, 387,
+	308, 0, 0, 0, 0, 0, 554, 555, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 211, 179, 320,
+	388, 244, 73, 0, 0, 171, 172, 173, 541, 1453,
+	543, 544, 545, 546, 0, 0, 202, 542, 209, 547,
+	548, 549, 0, 224, 268, 231, 223, 404, 0, 0,
+	0, 195, 178, 361, 0, 0, 0, 0, 0, 0,
+	516, 533, 0, 562, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 530, 531, 615, 0, 0, 0, 578,
+	0, 532, 0, 0, 525, 526, 528, 527, 529, 534,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	0, 309, 0, 577, 0, 0, 436, 0, 0, 575,
+	0, 0, 0, 0, 279, 0, 276, 175, 191, 0,
+	0, 319, 359, 365, 0, 0, 0, 215, 0, 363,
+	333, 421, 198, 242, 356, 338, 0, 0, 362, 285,
+	409, 351, 419, 0, 289, 339, 437, 438, 222, 313,
+	427, 400, 433, 448, 192, 219, 327, 393, 424, 384,
+	306, 405, 406, 275, 383, 250, 283, 445, 190, 371,
+	206, 183, 395, 417, 203, 374, 0, 0, 450, 185,
+	415, 392, 303, 272, 273, 184, 0, 355, 227, 248,
+	217, 322, 412, 413, 216, 451, 194, 432, 187, 0,
+	431, 315, 408, 416, 304, 295, 186, 414, 302, 294,
+	278, 238, 259, 349, 288, 350, 260, 311, 310, 312,
+	0, 181, 0, 389, 425, 452, 199, 200, 201, 0,
+	237, 241, 247, 249, 255, 256, 263, 281, 326, 348,
+	346, 352, 0, 403, 420, 428, 435, 441, 442, 446,
+	443, 444, 447, 314, 262, 385, 277, 286, 0, 0,
+	332, 364, 204, 423, 386, 565, 576, 571, 572, 569,
+	570, 564, 568, 567, 566, 579, 556, 557, 558, 559,
+	561, 0, 573, 574, 560, 174, 188, 282, 0, 353,
+	245, 449, 430, 376, 375, 0, 213, 426, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 177, 189, 197, 207, 220, 235,
+	243, 253, 258, 261, 265, 266, 269, 274, 292, 297,
+	298, 299, 300, 316, 317, 318, 321, 324, 325, 328,
+	330, 331, 334, 341, 342, 343, 344, 345, 347, 354,
+	358, 366, 367, 368, 369, 370, 372, 373, 379, 380,
+	381, 382, 390, 394, 410, 411, 422, 434, 439, 254,
+	418, 440, 0, 291, 0, 0, 293, 239, 257, 267,
+	0, 429, 391, 193, 360, 246, 182, 210, 196, 218,
+	233, 236, 271, 301, 307, 336, 340, 251, 230, 208,
+	357, 205, 377, 397, 398, 399, 401, 305, 225, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 0, 0, 0, 519, 0, 0, 0,
+	229, 518, 0, 0, 0, 280, 226, 0, 0, 337,
+	0, 180, 0, 378, 214, 290, 287, 407, 240, 232,
+	228, 212, 264, 296, 335, 396, 329, 563, 284, 0,
+	0, 387, 308, 0, 0, 0, 0, 0, 554, 555,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 211,
+	179, 320, 388, 244, 73, 0, 0, 171, 172, 173,
+	541, 1450, 543, 544, 545, 546, 0, 0, 202, 542,
+	209, 547, 548, 549, 0, 224, 268, 231, 223, 404,
+	0, 0, 0, 195, 178, 361, 0, 0, 0, 0,
+	0, 0, 516, 533, 0, 562, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 530, 531, 615, 0, 0,
+	0, 578, 0, 532, 0, 0, 525, 526, 528, 527,
+	529, 534, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 309, 0, 577, 0, 0, 436, 0,
+	0, 575, 0, 0, 0, 0, 279, 0, 276, 175,
+	191, 0, 0, 319, 359, 365, 0, 0, 0, 215,
+	0, 363, 333, 421, 198, 242, 356, 338, 0, 0,
+	362, 285, 409, 351, 419, 0, 289, 339, 437, 438,
+	222, 313, 427, 400, 433, 448, 192, 219, 327, 393,
+	424, 384, 306, 405, 406, 275, 383, 250, 283, 445,
+	190, 371, 206, 183, 395, 417, 203, 374, 0, 0,
+	450, 185, 415, 392, 303, 272, 273, 184, 0, 355,
+	227, 248, 217, 322, 412, 413, 216, 451, 194, 432,
+	187, 0, 431, 315, 408, 416, 304, 295, 186, 414,
+	302, 294, 278, 238, 259, 349, 288, 350, 260, 311,
+	310, 312, 0, 181, 0, 389, 425, 452, 199, 200,
+	201, 0, 237, 241, 247, 249, 255, 256, 263, 281,
+	326, 348, 346, 352, 0, 403, 420, 428, 435, 441,
+	442, 446, 443, 444, 447, 314, 262, 385, 277, 286,
+	0, 0, 332, 364, 204, 423, 386, 565, 576, 571,
+	572, 569, 570, 564, 568, 567, 566, 579, 556, 557,
+	558, 559, 561, 0, 573, 574, 560, 174, 188, 282,
+	0, 353, 245, 449, 430, 376, 375, 0, 213, 426,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 177, 189, 197, 207,
+	220, 235, 243, 253, 258, 261, 265, 266, 269, 274,
+	292, 297, 298, 299, 300, 316, 317, 318, 321, 324,
+	325, 328, 330, 331, 334, 341, 342, 343, 344, 345,
+	347, 354, 358, 366, 367, 368, 369, 370, 372, 373,
+	379, 380, 381, 382, 390, 394, 410, 411, 422, 434,
+	439, 254, 418, 440, 0, 291, 0, 0, 293, 239,
+	257, 267, 0, 429, 391, 193, 360, 246, 182, 210,
+	196, 218, 233, 236, 271, 301, 307, 336, 340, 251,
+	230, 208, 357, 205, 377, 397, 398, 399, 401, 305,
+	225, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 0, 0, 0, 519, 0,
+	0, 0, 229, 518, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 563,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	554, 555, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 73, 0, 0, 171,
+	172, 173, 541, 540, 543, 544, 545, 546, 0, 0,
+	202, 542, 209, 547, 548, 549, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	0, 0, 0, 0, 516, 533, 0, 562, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 530, 531, 0,
+	0, 0, 0, 578, 0, 532, 0, 0, 525, 526,
+	528, 527, 529, 534, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 309, 0, 577, 0, 0,
+	436, 0, 0, 575, 0, 0, 0, 0, 279, 0,
+	276, 175, 191, 0, 0, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 0, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 565,
+	576, 571, 572, 569, 570, 564, 568, 567, 566, 579,
+	556, 557, 558, 559, 561, 0, 573, 574, 560, 174,
+	188, 282, 0, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 254, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 563, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 554, 555, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 73, 0,
+	0, 171, 172, 173, 541, 540, 543, 544, 545, 546,
+	0, 0, 202, 542, 209, 547, 548, 549, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 0, 0, 0, 0, 533, 0, 562,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 530,
+	531, 0, 0, 0, 0, 578, 0, 532, 0, 0,
+	525, 526, 528, 527, 529, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 577,
+	0, 0, 436, 0, 0, 575, 0, 0, 0, 0,
+	279, 0, 276, 175, 191, 0, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 2371, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 565, 576, 571, 572, 569, 570, 564, 568, 567,
+	566, 579, 556, 557, 558, 559, 561, 0, 573, 574,
+	560, 174, 188, 282, 0, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 280, 226, 0, 0, 337, 0, 180, 0, 378,
+	214, 290, 287, 407, 240, 232, 228, 212, 264, 296,
+	335, 396, 329, 563, 284, 0, 0, 387, 308, 0,
+	0, 0, 0, 0, 554, 555, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 211, 179, 320, 388, 244,
+	73, 0, 602, 171, 172, 173, 541, 540, 543, 544,
+	545, 546, 0, 0, 202, 542, 209, 547, 548, 549,
+	0, 224, 268, 231, 223, 404, 0, 0, 0, 195,
+	178, 361, 0, 0, 0, 0, 0, 0, 0, 533,
+	0, 562, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 530, 531, 0, 0, 0, 0, 578, 0, 532,
+	0, 0, 525, 526, 528, 527, 529, 534, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 309,
+	0, 577, 0, 0, 436, 0, 0, 575, 0, 0,
+	0, 0, 279, 0, 276, 175, 191, 0, 0, 319,
+	359, 365, 0, 0, 0, 215, 0, 363, 333, 421,
+	198, 242, 356, 338, 0, 0, 362, 285, 409, 351,
+	419, 0, 289, 339, 437, 438, 222, 313, 427, 400,
+	433, 448, 192, 219, 327, 393, 424, 384, 306, 405,
+	406, 275, 383, 250, 283, 445, 190, 371, 206, 183,
+	395, 417, 203, 374, 0, 0, 450, 185, 415, 392,
+	303, 272, 273, 184, 0, 355, 227, 248, 217, 322,
+	412, 413, 216, 451, 194, 432, 187, 0, 431, 315,
+	408, 416, 304, 295, 186, 414, 302, 294, 278, 238,
+	259, 349, 288, 350, 260, 311, 310, 312, 0, 181,
+	0, 389, 425, 452, 199, 200, 201, 0, 237, 241,
+	247, 249, 255, 256, 263, 281, 326, 348, 346, 352,
+	0, 403, 420, 428, 435, 441, 442, 446, 443, 444,
+	447, 314, 262, 385, 277, 286, 0, 0, 332, 364,
+	204, 423, 386, 565, 576, 571, 572, 569, 570, 564,
+	568, 567, 566, 579, 556, 557, 558, 559, 561, 0,
+	573, 574, 560, 174, 188, 282, 0, 353, 245, 449,
+	430, 376, 375, 0, 213, 426, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 177, 189, 197, 207, 220, 235, 243, 253,
+	258, 261, 265, 266, 269, 274, 292, 297, 298, 299,
+	300, 316, 317, 318, 321, 324, 325, 328, 330, 331,
+	334, 341, 342, 343, 344, 345, 347, 354, 358, 366,
+	367, 368, 369, 370, 372, 373, 379, 380, 381, 382,
+	390, 394, 410, 411, 422, 434, 439, 254, 418, 440,
+	0, 291, 0, 0, 293, 239, 257, 267, 0, 429,
+	391, 193, 360, 246, 182, 210, 196, 218, 233, 236,
+	271, 301, 307, 336, 340, 251, 230, 208, 357, 205,
+	377, 397, 398, 399, 401, 305, 225, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	0, 0, 0, 280, 226, 0, 0, 337, 0, 180,
+	0, 378, 214, 290, 287, 407, 240, 232, 228, 212,
+	264, 296, 335, 396, 329, 563, 284, 0, 0, 387,
+	308, 0, 0, 0, 0, 0, 554, 555, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 211, 179, 320,
+	388, 244, 73, 0, 0, 171, 172, 173, 541, 540,
+	543, 544, 545, 546, 0, 0, 202, 542, 209, 547,
+	548, 549, 0, 224, 268, 231, 223, 404, 0, 0,
+	0, 195, 178, 361, 0, 0, 0, 0, 0, 0,
+	0, 533, 0, 562, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 530, 531, 0, 0, 0, 0, 578,
+	0, 532, 0, 0, 525, 526, 528, 527, 529, 534,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	0, 309, 0, 577, 0, 0, 436, 0, 0, 575,
+	0, 0, 0, 0, 279, 0, 276, 175, 191, 0,
+	0, 319, 359, 365, 0, 0, 0, 215, 0, 363,
+	333, 421, 198, 242, 356, 338, 0, 0, 362, 285,
+	409, 351, 419, 0, 289, 339, 437, 438, 222, 313,
+	427, 400, 433, 448, 192, 219, 327, 393, 424, 384,
+	306, 405, 406, 275, 383, 250, 283, 445, 190, 371,
+	206, 183, 395, 417, 203, 374, 0, 0, 450, 185,
+	415, 392, 303, 272, 273, 184, 0, 355, 227, 248,
+	217, 322, 412, 413, 216, 451, 194, 432, 187, 0,
+	431, 315, 408, 416, 304, 295, 186, 414, 302, 294,
+	278, 238, 259, 349, 288, 350, 260, 311, 310, 312,
+	0, 181, 0, 389, 425, 452, 199, 200, 201, 0,
+	237, 241, 247, 249, 255, 256, 263, 281, 326, 348,
+	346, 352, 0, 403, 420, 428, 435, 441, 442, 446,
+	443, 444, 447, 314, 262, 385, 277, 286, 0, 0,
+	332, 364, 204, 423, 386, 565, 576, 571, 572, 569,
+	570, 564, 568, 567, 566, 579, 556, 557, 558, 559,
+	561, 0, 573, 574, 560, 174, 188, 282, 0, 353,
+	245, 449, 430, 376, 375, 0, 213, 426, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 177, 189, 197, 207, 220, 235,
+	243, 253, 258, 261, 265, 266, 269, 274, 292, 297,
+	298, 299, 300, 316, 317, 318, 321, 324, 325, 328,
+	330, 331, 334, 341, 342, 343, 344, 345, 347, 354,
+	358, 366, 367, 368, 369, 370, 372, 373, 379, 380,
+	381, 382, 390, 394, 410, 411, 422, 434, 439, 254,
+	418, 440, 0, 291, 0, 0, 293, 239, 257, 267,
+	0, 429, 391, 193, 360, 246, 182, 210, 196, 218,
+	233, 236, 271, 301, 307, 336, 340, 251, 230, 208,
+	357, 205, 377, 397, 398, 399, 401, 305, 225, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 280, 226, 0, 0, 337,
+	0, 180, 0, 378, 214, 290, 287, 407, 240, 232,
+	228, 212, 264, 296, 335, 396, 329, 0, 284, 0,
+	0, 387, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 211,
+	179, 320, 388, 244, 0, 0, 0, 171, 172, 173,
+	0, 0, 0, 0, 0, 0, 0, 0, 202, 0,
+	209, 0, 0, 0, 0, 224, 268, 231, 223, 404,
+	0, 0, 0, 195, 178, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 996, 995, 1005, 1006, 998, 999, 1000, 1001, 1002,
+	1003, 1004, 997, 0, 0, 1007, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 309, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 276, 175,
+	191, 0, 0, 319, 359, 365, 0, 0, 0, 215,
+	0, 363, 333, 421, 198, 242, 356, 338, 0, 0,
+	362, 285, 409, 351, 419, 0, 289, 339, 437, 438,
+	222, 313, 427, 400, 433, 448, 192, 219, 327, 393,
+	424, 384, 306, 405, 406, 275, 383, 250, 283, 445,
+	190, 371, 206, 183, 395, 417, 203, 374, 0, 0,
+	450, 185, 415, 392, 303, 272, 273, 184, 0, 355,
+	227, 248, 217, 322, 412, 413, 216, 451, 194, 432,
+	187, 0, 431, 315, 408, 416, 304, 295, 186, 414,
+	302, 294, 278, 238, 259, 349, 288, 350, 260, 311,
+	310, 312, 0, 181, 0, 389, 425, 452, 199, 200,
+	201, 0, 237, 241, 247, 249, 255, 256, 263, 281,
+	326, 348, 346, 352, 0, 403, 420, 428, 435, 441,
+	442, 446, 443, 444, 447, 314, 262, 385, 277, 286,
+	0, 0, 332, 364, 204, 423, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 188, 282,
+	0, 353, 245, 449, 430, 376, 375, 0, 213, 426,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 177, 189, 197, 207,
+	220, 235, 243, 253, 258, 261, 265, 266, 269, 274,
+	292, 297, 298, 299, 300, 316, 317, 318, 321, 324,
+	325, 328, 330, 331, 334, 341, 342, 343, 344, 345,
+	347, 354, 358, 366, 367, 368, 369, 370, 372, 373,
+	379, 380, 381, 382, 390, 394, 410, 411, 422, 434,
+	439, 254, 418, 440, 0, 291, 0, 0, 293, 239,
+	257, 267, 0, 429, 391, 193, 360, 246, 182, 210,
+	196, 218, 233, 236, 271, 301, 307, 336, 340, 251,
+	230, 208, 357, 205, 377, 397, 398, 399, 401, 305,
+	225, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 0,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 0, 0, 0, 171,
+	172, 173, 0, 0, 0, 0, 0, 0, 0, 0,
+	202, 0, 209, 0, 0, 0, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	823, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 309, 0, 0, 0, 822,
+	436, 0, 0, 0, 0, 0, 819, 820, 279, 783,
+	276, 175, 191, 813, 817, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 0, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	188, 282, 0, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 254, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 0, 0, 1106,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 0, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 0, 0,
+	0, 171, 172, 173, 0, 1108, 0, 0, 0, 0,
+	0, 0, 202, 0, 209, 0, 0, 0, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 985, 986, 984, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 987, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 0,
+	0, 0, 436, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 276, 175, 191, 0, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 0, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 188, 282, 0, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 36, 402, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 323, 0,
 	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 1447, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	761, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 767,
-	271, 171, 187, 765, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 1449, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	1263, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 0, 0, 587,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
-	0, 0, 2130, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 35, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 71, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 1468, 0, 0, 1469, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
+	0, 0, 280, 226, 0, 0, 337, 0, 180, 0,
+	378, 214, 290, 287, 407, 240, 232, 228, 212, 264,
+	296, 335, 396, 329, 0, 284, 0, 0, 387, 308,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 211, 179, 320, 388,
+	244, 73, 0, 602, 171, 172, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 202, 0, 209, 0, 0,
+	0, 0, 224, 268, 231, 223, 404, 0, 0, 0,
+	195, 178, 361, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	309, 0, 0, 0, 0, 436, 0, 0, 0, 0,
+	0, 0, 0, 279, 0, 276, 175, 191, 0, 0,
+	319, 359, 365, 0, 0, 0, 215, 0, 363, 333,
+	421, 198, 242, 356, 338, 0, 0, 362, 285, 409,
+	351, 419, 0, 289, 339, 437, 438, 222, 313, 427,
+	400, 433, 448, 192, 219, 327, 393, 424, 384, 306,
+	405, 406, 275, 383, 250, 283, 445, 190, 371, 206,
+	183, 395, 417, 203, 374, 0, 0, 450, 185, 415,
+	392, 303, 272, 273, 184, 0, 355, 227, 248, 217,
+	322, 412, 413, 216, 451, 194, 432, 187, 0, 431,
+	315, 408, 416, 304, 295, 186, 414, 302, 294, 278,
+	238, 259, 349, 288, 350, 260, 311, 310, 312, 0,
+	181, 0, 389, 425, 452, 199, 200, 201, 0, 237,
+	241, 247, 249, 255, 256, 263, 281, 326, 348, 346,
+	352, 0, 403, 420, 428, 435, 441, 442, 446, 443,
+	444, 447, 314, 262, 385, 277, 286, 0, 0, 332,
+	364, 204, 423, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 174, 188, 282, 72, 353, 245,
+	449, 430, 376, 375, 0, 213, 426, 0, 0, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 177, 189, 197, 207, 220, 235, 243,
+	253, 258, 261, 265, 266, 269, 274, 292, 297, 298,
+	299, 300, 316, 317, 318, 321, 324, 325, 328, 330,
+	331, 334, 341, 342, 343, 344, 345, 347, 354, 358,
+	366, 367, 368, 369, 370, 372, 373, 379, 380, 381,
+	382, 390, 394, 410, 411, 422, 434, 439, 254, 418,
+	440, 0, 291, 0, 0, 293, 239, 257, 267, 0,
+	429, 391, 193, 360, 246, 182, 210, 196, 218, 233,
+	236, 271, 301, 307, 336, 340, 251, 230, 208, 357,
+	205, 377, 397, 398, 399, 401, 305, 225, 36, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 280, 226, 0, 0, 337,
+	0, 180, 0, 378, 214, 290, 287, 407, 240, 232,
+	228, 212, 264, 296, 335, 396, 329, 0, 284, 0,
+	0, 387, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 211,
+	179, 320, 388, 244, 73, 0, 0, 171, 172, 173,
+	0, 0, 0, 0, 0, 0, 0, 0, 202, 0,
+	209, 0, 0, 0, 0, 224, 268, 231, 223, 404,
+	0, 0, 0, 195, 178, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 309, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 276, 175,
+	191, 0, 0, 319, 359, 365, 0, 0, 0, 215,
+	0, 363, 333, 421, 198, 242, 356, 338, 0, 0,
+	362, 285, 409, 351, 419, 0, 289, 339, 437, 438,
+	222, 313, 427, 400, 433, 448, 192, 219, 327, 393,
+	424, 384, 306, 405, 406, 275, 383, 250, 283, 445,
+	190, 371, 206, 183, 395, 417, 203, 374, 0, 0,
+	450, 185, 415, 392, 303, 272, 273, 184, 0, 355,
+	227, 248, 217, 322, 412, 413, 216, 451, 194, 432,
+	187, 0, 431, 315, 408, 416, 304, 295, 186, 414,
+	302, 294, 278, 238, 259, 349, 288, 350, 260, 311,
+	310, 312, 0, 181, 0, 389, 425, 452, 199, 200,
+	201, 0, 237, 241, 247, 249, 255, 256, 263, 281,
+	326, 348, 346, 352, 0, 403, 420, 428, 435, 441,
+	442, 446, 443, 444, 447, 314, 262, 385, 277, 286,
+	0, 0, 332, 364, 204, 423, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 188, 282,
+	72, 353, 245, 449, 430, 376, 375, 0, 213, 426,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 177, 189, 197, 207,
+	220, 235, 243, 253, 258, 261, 265, 266, 269, 274,
+	292, 297, 298, 299, 300, 316, 317, 318, 321, 324,
+	325, 328, 330, 331, 334, 341, 342, 343, 344, 345,
+	347, 354, 358, 366, 367, 368, 369, 370, 372, 373,
+	379, 380, 381, 382, 390, 394, 410, 411, 422, 434,
+	439, 254, 418, 440, 0, 291, 0, 0, 293, 239,
+	257, 267, 0, 429, 391, 193, 360, 246, 182, 210,
+	196, 218, 233, 236, 271, 301, 307, 336, 340, 251,
+	230, 208, 357, 205, 377, 397, 398, 399, 401, 305,
+	225, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 0, 0, 1480, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 0,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 0, 0, 0, 171,
+	172, 173, 0, 1291, 0, 0, 0, 0, 0, 0,
+	202, 0, 209, 0, 0, 0, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 309, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	276, 175, 191, 0, 0, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 1478, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	188, 282, 0, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 254, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 0, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 0, 0,
+	0, 171, 172, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 202, 0, 209, 0, 0, 0, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 777, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 0,
+	0, 0, 436, 0, 0, 0, 0, 0, 0, 0,
+	279, 783, 276, 175, 191, 781, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 0, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 188, 282, 0, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 1480, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 280, 226, 0, 0, 337, 0, 180, 0, 378,
+	214, 290, 287, 407, 240, 232, 228, 212, 264, 296,
+	335, 396, 329, 0, 284, 0, 0, 387, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 211, 179, 320, 388, 244,
+	0, 0, 0, 171, 172, 173, 0, 1291, 0, 0,
+	0, 0, 0, 0, 202, 0, 209, 0, 0, 0,
+	0, 224, 268, 231, 223, 404, 0, 0, 0, 195,
+	178, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 309,
+	0, 0, 0, 0, 436, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 276, 175, 191, 0, 0, 319,
+	359, 365, 0, 0, 0, 215, 0, 363, 333, 421,
+	198, 242, 356, 338, 0, 0, 362, 285, 409, 351,
+	419, 0, 289, 339, 437, 438, 222, 313, 427, 400,
+	433, 448, 192, 219, 327, 393, 424, 384, 306, 405,
+	406, 275, 383, 250, 283, 445, 190, 371, 206, 183,
+	395, 417, 203, 374, 0, 0, 450, 185, 415, 392,
+	303, 272, 273, 184, 0, 355, 227, 248, 217, 322,
+	412, 413, 216, 451, 194, 432, 187, 0, 431, 315,
+	408, 416, 304, 295, 186, 414, 302, 294, 278, 238,
+	259, 349, 288, 350, 260, 311, 310, 312, 0, 181,
+	0, 389, 425, 452, 199, 200, 201, 0, 237, 241,
+	247, 249, 255, 256, 263, 281, 326, 348, 346, 352,
+	0, 403, 420, 428, 435, 441, 442, 446, 443, 444,
+	447, 314, 262, 385, 277, 286, 0, 0, 332, 364,
+	204, 423, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 188, 282, 0, 353, 245, 449,
+	430, 376, 375, 0, 213, 426, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 177, 189, 197, 207, 220, 235, 243, 253,
+	258, 261, 265, 266, 269, 274, 292, 297, 298, 299,
+	300, 316, 317, 318, 321, 324, 325, 328, 330, 331,
+	334, 341, 342, 343, 344, 345, 347, 354, 358, 366,
+	367, 368, 369, 370, 372, 373, 379, 380, 381, 382,
+	390, 394, 410, 411, 422, 434, 439, 254, 418, 440,
+	0, 291, 0, 0, 293, 239, 257, 267, 0, 429,
+	391, 193, 360, 246, 182, 210, 196, 218, 233, 236,
+	271, 301, 307, 336, 340, 251, 230, 208, 357, 205,
+	377, 397, 398, 399, 401, 305, 225, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
 	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 1113, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 1112, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 280, 226, 0, 0, 337, 0, 180,
+	0, 378, 214, 290, 287, 407, 240, 232, 228, 212,
+	264, 296, 335, 396, 329, 0, 284, 0, 0, 387,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 211, 179, 320,
+	388, 244, 0, 0, 602, 171, 172, 173, 0, 0,
+	0, 0, 0, 0, 0, 0, 202, 0, 209, 0,
+	0, 0, 0, 224, 268, 231, 223, 404, 0, 0,
+	0, 195, 178, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	0, 309, 0, 0, 0, 0, 436, 0, 0, 0,
+	2220, 0, 0, 0, 279, 0, 276, 175, 191, 0,
+	0, 319, 359, 365, 0, 0, 0, 215, 0, 363,
+	333, 421, 198, 242, 356, 338, 0, 0, 362, 285,
+	409, 351, 419, 0, 289, 339, 437, 438, 222, 313,
+	427, 400, 433, 448, 192, 219, 327, 393, 424, 384,
+	306, 405, 406, 275, 383, 250, 283, 445, 190, 371,
+	206, 183, 395, 417, 203, 374, 0, 0, 450, 185,
+	415, 392, 303, 272, 273, 184, 0, 355, 227, 248,
+	217, 322, 412, 413, 216, 451, 194, 432, 187, 0,
+	431, 315, 408, 416, 304, 295, 186, 414, 302, 294,
+	278, 238, 259, 349, 288, 350, 260, 311, 310, 312,
+	0, 181, 0, 389, 425, 452, 199, 200, 201, 0,
+	237, 241, 247, 249, 255, 256, 263, 281, 326, 348,
+	346, 352, 0, 403, 420, 428, 435, 441, 442, 446,
+	443, 444, 447, 314, 262, 385, 277, 286, 0, 0,
+	332, 364, 204, 423, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 188, 282, 0, 353,
+	245, 449, 430, 376, 375, 0, 213, 426, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 177, 189, 197, 207, 220, 235,
+	243, 253, 258, 261, 265, 266, 269, 274, 292, 297,
+	298, 299, 300, 316, 317, 318, 321, 324, 325, 328,
+	330, 331, 334, 341, 342, 343, 344, 345, 347, 354,
+	358, 366, 367, 368, 369, 370, 372, 373, 379, 380,
+	381, 382, 390, 394, 410, 411, 422, 434, 439, 254,
+	418, 440, 0, 291, 0, 0, 293, 239, 257, 267,
+	0, 429, 391, 193, 360, 246, 182, 210, 196, 218,
+	233, 236, 271, 301, 307, 336, 340, 251, 230, 208,
+	357, 205, 377, 397, 398, 399, 401, 305, 225, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 280, 226, 0, 0, 337,
+	0, 180, 0, 378, 214, 290, 287, 407, 240, 232,
+	228, 212, 264, 296, 335, 396, 329, 0, 284, 0,
+	0, 387, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 211,
+	179, 320, 388, 244, 0, 0, 0, 171, 172, 173,
+	0, 0, 1504, 0, 0, 1505, 0, 0, 202, 0,
+	209, 0, 0, 0, 0, 224, 268, 231, 223, 404,
+	0, 0, 0, 195, 178, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 309, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 276, 175,
+	191, 0, 0, 319, 359, 365, 0, 0, 0, 215,
+	0, 363, 333, 421, 198, 242, 356, 338, 0, 0,
+	362, 285, 409, 351, 419, 0, 289, 339, 437, 438,
+	222, 313, 427, 400, 433, 448, 192, 219, 327, 393,
+	424, 384, 306, 405, 406, 275, 383, 250, 283, 445,
+	190, 371, 206, 183, 395, 417, 203, 374, 0, 0,
+	450, 185, 415, 392, 303, 272, 273, 184, 0, 355,
+	227, 248, 217, 322, 412, 413, 216, 451, 194, 432,
+	187, 0, 431, 315, 408, 416, 304, 295, 186, 414,
+	302, 294, 278, 238, 259, 349, 288, 350, 260, 311,
+	310, 312, 0, 181, 0, 389, 425, 452, 199, 200,
+	201, 0, 237, 241, 247, 249, 255, 256, 263, 281,
+	326, 348, 346, 352, 0, 403, 420, 428, 435, 441,
+	442, 446, 443, 444, 447, 314, 262, 385, 277, 286,
+	0, 0, 332, 364, 204, 423, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 188, 282,
+	0, 353, 245, 449, 430, 376, 375, 0, 213, 426,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 177, 189, 197, 207,
+	220, 235, 243, 253, 258, 261, 265, 266, 269, 274,
+	292, 297, 298, 299, 300, 316, 317, 318, 321, 324,
+	325, 328, 330, 331, 334, 341, 342, 343, 344, 345,
+	347, 354, 358, 366, 367, 368, 369, 370, 372, 373,
+	379, 380, 381, 382, 390, 394, 410, 411, 422, 434,
+	439, 254, 418, 440, 0, 291, 0, 0, 293, 239,
+	257, 267, 0, 429, 391, 193, 360, 246, 182, 210,
+	196, 218, 233, 236, 271, 301, 307, 336, 340, 251,
+	230, 208, 357, 205, 377, 397, 398, 399, 401, 305,
+	225, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 1139, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 0,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 0, 0, 0, 171,
+	172, 173, 0, 1138, 0, 0, 0, 0, 0, 0,
+	202, 0, 209, 0, 0, 0, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 309, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	276, 175, 191, 0, 0, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 0, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	188, 282, 0, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 254, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 0, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 0, 0,
+	0, 171, 172, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 202, 0, 209, 0, 0, 0, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 0,
+	0, 0, 436, 0, 0, 0, 2337, 0, 0, 0,
+	279, 0, 276, 175, 191, 0, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 0, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 188, 282, 0, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
 	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 2213,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 2130, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 71, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 1263, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
+	0, 280, 226, 0, 0, 337, 0, 180, 0, 378,
+	214, 290, 287, 407, 240, 232, 228, 212, 264, 296,
+	335, 396, 329, 0, 284, 0, 0, 387, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 211, 179, 320, 388, 244,
+	0, 0, 0, 171, 172, 173, 0, 1291, 0, 0,
+	0, 0, 0, 0, 202, 0, 209, 0, 0, 0,
+	0, 224, 268, 231, 223, 404, 0, 0, 0, 195,
+	178, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 309,
+	0, 0, 0, 0, 436, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 276, 175, 191, 0, 0, 319,
+	359, 365, 0, 0, 0, 215, 0, 363, 333, 421,
+	198, 242, 356, 338, 0, 0, 362, 285, 409, 351,
+	419, 0, 289, 339, 437, 438, 222, 313, 427, 400,
+	433, 448, 192, 219, 327, 393, 424, 384, 306, 405,
+	406, 275, 383, 250, 283, 445, 190, 371, 206, 183,
+	395, 417, 203, 374, 0, 0, 450, 185, 415, 392,
+	303, 272, 273, 184, 0, 355, 227, 248, 217, 322,
+	412, 413, 216, 451, 194, 432, 187, 0, 431, 315,
+	408, 416, 304, 295, 186, 414, 302, 294, 278, 238,
+	259, 349, 288, 350, 260, 311, 310, 312, 0, 181,
+	0, 389, 425, 452, 199, 200, 201, 0, 237, 241,
+	247, 249, 255, 256, 263, 281, 326, 348, 346, 352,
+	0, 403, 420, 428, 435, 441, 442, 446, 443, 444,
+	447, 314, 262, 385, 277, 286, 0, 0, 332, 364,
+	204, 423, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 188, 282, 0, 353, 245, 449,
+	430, 376, 375, 0, 213, 426, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 177, 189, 197, 207, 220, 235, 243, 253,
+	258, 261, 265, 266, 269, 274, 292, 297, 298, 299,
+	300, 316, 317, 318, 321, 324, 325, 328, 330, 331,
+	334, 341, 342, 343, 344, 345, 347, 354, 358, 366,
+	367, 368, 369, 370, 372, 373, 379, 380, 381, 382,
+	390, 394, 410, 411, 422, 434, 439, 254, 418, 440,
+	0, 291, 0, 0, 293, 239, 257, 267, 0, 429,
+	391, 193, 360, 246, 182, 210, 196, 218, 233, 236,
+	271, 301, 307, 336, 340, 251, 230, 208, 357, 205,
+	377, 397, 398, 399, 401, 305, 225, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
 	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 1082, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 280, 226, 0, 0, 337, 0, 180,
+	0, 378, 214, 290, 287, 407, 240, 232, 228, 212,
+	264, 296, 335, 396, 329, 0, 284, 0, 0, 387,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 211, 179, 320,
+	388, 244, 0, 0, 0, 171, 172, 173, 0, 0,
+	0, 0, 0, 0, 0, 0, 202, 0, 209, 0,
+	0, 0, 0, 224, 268, 231, 223, 404, 0, 0,
+	0, 195, 178, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	0, 309, 0, 0, 0, 0, 436, 0, 0, 0,
+	2220, 0, 0, 0, 279, 0, 276, 175, 191, 0,
+	0, 319, 359, 365, 0, 0, 0, 215, 0, 363,
+	333, 421, 198, 242, 356, 338, 0, 0, 362, 285,
+	409, 351, 419, 0, 289, 339, 437, 438, 222, 313,
+	427, 400, 433, 448, 192, 219, 327, 393, 424, 384,
+	306, 405, 406, 275, 383, 250, 283, 445, 190, 371,
+	206, 183, 395, 417, 203, 374, 0, 0, 450, 185,
+	415, 392, 303, 272, 273, 184, 0, 355, 227, 248,
+	217, 322, 412, 413, 216, 451, 194, 432, 187, 0,
+	431, 315, 408, 416, 304, 295, 186, 414, 302, 294,
+	278, 238, 259, 349, 288, 350, 260, 311, 310, 312,
+	0, 181, 0, 389, 425, 452, 199, 200, 201, 0,
+	237, 241, 247, 249, 255, 256, 263, 281, 326, 348,
+	346, 352, 0, 403, 420, 428, 435, 441, 442, 446,
+	443, 444, 447, 314, 262, 385, 277, 286, 0, 0,
+	332, 364, 204, 423, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 188, 282, 0, 353,
+	245, 449, 430, 376, 375, 0, 213, 426, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 177, 189, 197, 207, 220, 235,
+	243, 253, 258, 261, 265, 266, 269, 274, 292, 297,
+	298, 299, 300, 316, 317, 318, 321, 324, 325, 328,
+	330, 331, 334, 341, 342, 343, 344, 345, 347, 354,
+	358, 366, 367, 368, 369, 370, 372, 373, 379, 380,
+	381, 382, 390, 394, 410, 411, 422, 434, 439, 254,
+	418, 440, 0, 291, 0, 0, 293, 239, 257, 267,
+	0, 429, 391, 193, 360, 246, 182, 210, 196, 218,
+	233, 236, 271, 301, 307, 336, 340, 251, 230, 208,
+	357, 205, 377, 397, 398, 399, 401, 305, 225, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 280, 226, 0, 0, 337,
+	0, 180, 0, 378, 214, 290, 287, 407, 240, 232,
+	228, 212, 264, 296, 335, 396, 329, 0, 284, 0,
+	0, 387, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 211,
+	179, 320, 388, 244, 73, 0, 0, 171, 172, 173,
+	0, 0, 0, 0, 0, 0, 0, 0, 202, 0,
+	209, 0, 0, 0, 0, 224, 268, 231, 223, 404,
+	0, 0, 0, 195, 178, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 309, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 276, 175,
+	191, 0, 0, 319, 359, 365, 0, 0, 0, 215,
+	0, 363, 333, 421, 198, 242, 356, 338, 0, 0,
+	362, 285, 409, 351, 419, 0, 289, 339, 437, 438,
+	222, 313, 427, 400, 433, 448, 192, 219, 327, 393,
+	424, 384, 306, 405, 406, 275, 383, 250, 283, 445,
+	190, 371, 206, 183, 395, 417, 203, 374, 0, 0,
+	450, 185, 415, 392, 303, 272, 273, 184, 0, 355,
+	227, 248, 217, 322, 412, 413, 216, 451, 194, 432,
+	187, 0, 431, 315, 408, 416, 304, 295, 186, 414,
+	302, 294, 278, 238, 259, 349, 288, 350, 260, 311,
+	310, 312, 0, 181, 0, 389, 425, 452, 199, 200,
+	201, 0, 237, 241, 247, 249, 255, 256, 263, 281,
+	326, 348, 346, 352, 0, 403, 420, 428, 435, 441,
+	442, 446, 443, 444, 447, 314, 262, 385, 277, 286,
+	0, 0, 332, 364, 204, 423, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 188, 282,
+	0, 353, 245, 449, 430, 376, 375, 0, 213, 426,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 177, 189, 197, 207,
+	220, 235, 243, 253, 258, 261, 265, 266, 269, 274,
+	292, 297, 298, 299, 300, 316, 317, 318, 321, 324,
+	325, 328, 330, 331, 334, 341, 342, 343, 344, 345,
+	347, 354, 358, 366, 367, 368, 369, 370, 372, 373,
+	379, 380, 381, 382, 390, 394, 410, 411, 422, 434,
+	439, 254, 418, 440, 0, 291, 0, 0, 293, 239,
+	257, 267, 0, 429, 391, 193, 360, 246, 182, 210,
+	196, 218, 233, 236, 271, 301, 307, 336, 340, 251,
+	230, 208, 357, 205, 377, 397, 398, 399, 401, 305,
+	225, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 0,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 0, 0, 0, 171,
+	172, 173, 0, 1108, 0, 0, 0, 0, 0, 0,
+	202, 0, 209, 0, 0, 0, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 309, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	276, 175, 191, 0, 0, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 0, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	188, 282, 0, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 254, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 0, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 0, 0,
+	0, 171, 172, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 202, 0, 209, 0, 0, 0, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 0,
+	0, 0, 436, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 276, 175, 191, 0, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 0, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 188, 282, 1382, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 1263,
 	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 1354, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 1235, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 1233, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 1231, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 1229,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 1227, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
+	0, 280, 226, 0, 0, 337, 0, 180, 0, 378,
+	214, 290, 287, 407, 240, 232, 228, 212, 264, 296,
+	335, 396, 329, 0, 284, 0, 0, 387, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 211, 179, 320, 388, 244,
+	0, 0, 0, 171, 172, 173, 0, 0, 0, 0,
+	0, 0, 0, 0, 202, 0, 209, 0, 0, 0,
+	0, 224, 268, 231, 223, 404, 0, 0, 0, 195,
+	178, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 309,
+	0, 0, 0, 0, 436, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 276, 175, 191, 0, 0, 319,
+	359, 365, 0, 0, 0, 215, 0, 363, 333, 421,
+	198, 242, 356, 338, 0, 0, 362, 285, 409, 351,
+	419, 0, 289, 339, 437, 438, 222, 313, 427, 400,
+	433, 448, 192, 219, 327, 393, 424, 384, 306, 405,
+	406, 275, 383, 250, 283, 445, 190, 371, 206, 183,
+	395, 417, 203, 374, 0, 0, 450, 185, 415, 392,
+	303, 272, 273, 184, 0, 355, 227, 248, 217, 322,
+	412, 413, 216, 451, 194, 432, 187, 0, 431, 315,
+	408, 416, 304, 295, 186, 414, 302, 294, 278, 238,
+	259, 349, 288, 350, 260, 311, 310, 312, 0, 181,
+	0, 389, 425, 452, 199, 200, 201, 0, 237, 241,
+	247, 249, 255, 256, 263, 281, 326, 348, 346, 352,
+	0, 403, 420, 428, 435, 441, 442, 446, 443, 444,
+	447, 314, 262, 385, 277, 286, 0, 0, 332, 364,
+	204, 423, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 188, 282, 0, 353, 245, 449,
+	430, 376, 375, 0, 213, 426, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 177, 189, 197, 207, 220, 235, 243, 253,
+	258, 261, 265, 266, 269, 274, 292, 297, 298, 299,
+	300, 316, 317, 318, 321, 324, 325, 328, 330, 331,
+	334, 341, 342, 343, 344, 345, 347, 354, 358, 366,
+	367, 368, 369, 370, 372, 373, 379, 380, 381, 382,
+	390, 394, 410, 411, 422, 434, 439, 254, 418, 440,
+	0, 291, 0, 0, 293, 239, 257, 267, 0, 429,
+	391, 193, 360, 246, 182, 210, 196, 218, 233, 236,
+	271, 301, 307, 336, 340, 251, 230, 208, 357, 205,
+	377, 397, 398, 399, 401, 305, 225, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 1261, 0, 0, 0, 0, 0, 0, 229, 0,
+	0, 0, 0, 280, 226, 0, 0, 337, 0, 180,
+	0, 378, 214, 290, 287, 407, 240, 232, 228, 212,
+	264, 296, 335, 396, 329, 0, 284, 0, 0, 387,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 211, 179, 320,
+	388, 244, 0, 0, 0, 171, 172, 173, 0, 0,
+	0, 0, 0, 0, 0, 0, 202, 0, 209, 0,
+	0, 0, 0, 224, 268, 231, 223, 404, 0, 0,
+	0, 195, 178, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	0, 309, 0, 0, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 279, 0, 276, 175, 191, 0,
+	0, 319, 359, 365, 0, 0, 0, 215, 0, 363,
+	333, 421, 198, 242, 356, 338, 0, 0, 362, 285,
+	409, 351, 419, 0, 289, 339, 437, 438, 222, 313,
+	427, 400, 433, 448, 192, 219, 327, 393, 424, 384,
+	306, 405, 406, 275, 383, 250, 283, 445, 190, 371,
+	206, 183, 395, 417, 203, 374, 0, 0, 450, 185,
+	415, 392, 303, 272, 273, 184, 0, 355, 227, 248,
+	217, 322, 412, 413, 216, 451, 194, 432, 187, 0,
+	431, 315, 408, 416, 304, 295, 186, 414, 302, 294,
+	278, 238, 259, 349, 288, 350, 260, 311, 310, 312,
+	0, 181, 0, 389, 425, 452, 199, 200, 201, 0,
+	237, 241, 247, 249, 255, 256, 263, 281, 326, 348,
+	346, 352, 0, 403, 420, 428, 435, 441, 442, 446,
+	443, 444, 447, 314, 262, 385, 277, 286, 0, 0,
+	332, 364, 204, 423, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 188, 282, 0, 353,
+	245, 449, 430, 376, 375, 0, 213, 426, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 177, 189, 197, 207, 220, 235,
+	243, 253, 258, 261, 265, 266, 269, 274, 292, 297,
+	298, 299, 300, 316, 317, 318, 321, 324, 325, 328,
+	330, 331, 334, 341, 342, 343, 344, 345, 347, 354,
+	358, 366, 367, 368, 369, 370, 372, 373, 379, 380,
+	381, 382, 390, 394, 410, 411, 422, 434, 439, 254,
+	418, 440, 0, 291, 0, 0, 293, 239, 257, 267,
+	0, 429, 391, 193, 360, 246, 182, 210, 196, 218,
+	233, 236, 271, 301, 307, 336, 340, 251, 230, 208,
+	357, 205, 377, 397, 398, 399, 401, 305, 225, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 1259, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 280, 226, 0, 0, 337,
+	0, 180, 0, 378, 214, 290, 287, 407, 240, 232,
+	228, 212, 264, 296, 335, 396, 329, 0, 284, 0,
+	0, 387, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 211,
+	179, 320, 388, 244, 0, 0, 0, 171, 172, 173,
+	0, 0, 0, 0, 0, 0, 0, 0, 202, 0,
+	209, 0, 0, 0, 0, 224, 268, 231, 223, 404,
+	0, 0, 0, 195, 178, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 309, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 276, 175,
+	191, 0, 0, 319, 359, 365, 0, 0, 0, 215,
+	0, 363, 333, 421, 198, 242, 356, 338, 0, 0,
+	362, 285, 409, 351, 419, 0, 289, 339, 437, 438,
+	222, 313, 427, 400, 433, 448, 192, 219, 327, 393,
+	424, 384, 306, 405, 406, 275, 383, 250, 283, 445,
+	190, 371, 206, 183, 395, 417, 203, 374, 0, 0,
+	450, 185, 415, 392, 303, 272, 273, 184, 0, 355,
+	227, 248, 217, 322, 412, 413, 216, 451, 194, 432,
+	187, 0, 431, 315, 408, 416, 304, 295, 186, 414,
+	302, 294, 278, 238, 259, 349, 288, 350, 260, 311,
+	310, 312, 0, 181, 0, 389, 425, 452, 199, 200,
+	201, 0, 237, 241, 247, 249, 255, 256, 263, 281,
+	326, 348, 346, 352, 0, 403, 420, 428, 435, 441,
+	442, 446, 443, 444, 447, 314, 262, 385, 277, 286,
+	0, 0, 332, 364, 204, 423, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 188, 282,
+	0, 353, 245, 449, 430, 376, 375, 0, 213, 426,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 177, 189, 197, 207,
+	220, 235, 243, 253, 258, 261, 265, 266, 269, 274,
+	292, 297, 298, 299, 300, 316, 317, 318, 321, 324,
+	325, 328, 330, 331, 334, 341, 342, 343, 344, 345,
+	347, 354, 358, 366, 367, 368, 369, 370, 372, 373,
+	379, 380, 381, 382, 390, 394, 410, 411, 422, 434,
+	439, 254, 418, 440, 0, 291, 0, 0, 293, 239,
+	257, 267, 0, 429, 391, 193, 360, 246, 182, 210,
+	196, 218, 233, 236, 271, 301, 307, 336, 340, 251,
+	230, 208, 357, 205, 377, 397, 398, 399, 401, 305,
+	225, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 1257, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 0,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 0, 0, 0, 171,
+	172, 173, 0, 0, 0, 0, 0, 0, 0, 0,
+	202, 0, 209, 0, 0, 0, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 309, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	276, 175, 191, 0, 0, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 0, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	188, 282, 0, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 254, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 1255, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 0, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 0, 0,
+	0, 171, 172, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 202, 0, 209, 0, 0, 0, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 0,
+	0, 0, 436, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 276, 175, 191, 0, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 0, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 188, 282, 0, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 1251,
 	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 1223, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 1221, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 1219, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
+	0, 280, 226, 0, 0, 337, 0, 180, 0, 378,
+	214, 290, 287, 407, 240, 232, 228, 212, 264, 296,
+	335, 396, 329, 0, 284, 0, 0, 387, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 211, 179, 320, 388, 244,
+	0, 0, 0, 171, 172, 173, 0, 0, 0, 0,
+	0, 0, 0, 0, 202, 0, 209, 0, 0, 0,
+	0, 224, 268, 231, 223, 404, 0, 0, 0, 195,
+	178, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 309,
+	0, 0, 0, 0, 436, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 276, 175, 191, 0, 0, 319,
+	359, 365, 0, 0, 0, 215, 0, 363, 333, 421,
+	198, 242, 356, 338, 0, 0, 362, 285, 409, 351,
+	419, 0, 289, 339, 437, 438, 222, 313, 427, 400,
+	433, 448, 192, 219, 327, 393, 424, 384, 306, 405,
+	406, 275, 383, 250, 283, 445, 190, 371, 206, 183,
+	395, 417, 203, 374, 0, 0, 450, 185, 415, 392,
+	303, 272, 273, 184, 0, 355, 227, 248, 217, 322,
+	412, 413, 216, 451, 194, 432, 187, 0, 431, 315,
+	408, 416, 304, 295, 186, 414, 302, 294, 278, 238,
+	259, 349, 288, 350, 260, 311, 310, 312, 0, 181,
+	0, 389, 425, 452, 199, 200, 201, 0, 237, 241,
+	247, 249, 255, 256, 263, 281, 326, 348, 346, 352,
+	0, 403, 420, 428, 435, 441, 442, 446, 443, 444,
+	447, 314, 262, 385, 277, 286, 0, 0, 332, 364,
+	204, 423, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 188, 282, 0, 353, 245, 449,
+	430, 376, 375, 0, 213, 426, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 177, 189, 197, 207, 220, 235, 243, 253,
+	258, 261, 265, 266, 269, 274, 292, 297, 298, 299,
+	300, 316, 317, 318, 321, 324, 325, 328, 330, 331,
+	334, 341, 342, 343, 344, 345, 347, 354, 358, 366,
+	367, 368, 369, 370, 372, 373, 379, 380, 381, 382,
+	390, 394, 410, 411, 422, 434, 439, 254, 418, 440,
+	0, 291, 0, 0, 293, 239, 257, 267, 0, 429,
+	391, 193, 360, 246, 182, 210, 196, 218, 233, 236,
+	271, 301, 307, 336, 340, 251, 230, 208, 357, 205,
+	377, 397, 398, 399, 401, 305, 225, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 1249, 0, 0, 0, 0, 0, 0, 229, 0,
+	0, 0, 0, 280, 226, 0, 0, 337, 0, 180,
+	0, 378, 214, 290, 287, 407, 240, 232, 228, 212,
+	264, 296, 335, 396, 329, 0, 284, 0, 0, 387,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 211, 179, 320,
+	388, 244, 0, 0, 0, 171, 172, 173, 0, 0,
+	0, 0, 0, 0, 0, 0, 202, 0, 209, 0,
+	0, 0, 0, 224, 268, 231, 223, 404, 0, 0,
+	0, 195, 178, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	0, 309, 0, 0, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 279, 0, 276, 175, 191, 0,
+	0, 319, 359, 365, 0, 0, 0, 215, 0, 363,
+	333, 421, 198, 242, 356, 338, 0, 0, 362, 285,
+	409, 351, 419, 0, 289, 339, 437, 438, 222, 313,
+	427, 400, 433, 448, 192, 219, 327, 393, 424, 384,
+	306, 405, 406, 275, 383, 250, 283, 445, 190, 371,
+	206, 183, 395, 417, 203, 374, 0, 0, 450, 185,
+	415, 392, 303, 272, 273, 184, 0, 355, 227, 248,
+	217, 322, 412, 413, 216, 451, 194, 432, 187, 0,
+	431, 315, 408, 416, 304, 295, 186, 414, 302, 294,
+	278, 238, 259, 349, 288, 350, 260, 311, 310, 312,
+	0, 181, 0, 389, 425, 452, 199, 200, 201, 0,
+	237, 241, 247, 249, 255, 256, 263, 281, 326, 348,
+	346, 352, 0, 403, 420, 428, 435, 441, 442, 446,
+	443, 444, 447, 314, 262, 385, 277, 286, 0, 0,
+	332, 364, 204, 423, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 188, 282, 0, 353,
+	245, 449, 430, 376, 375, 0, 213, 426, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 177, 189, 197, 207, 220, 235,
+	243, 253, 258, 261, 265, 266, 269, 274, 292, 297,
+	298, 299, 300, 316, 317, 318, 321, 324, 325, 328,
+	330, 331, 334, 341, 342, 343, 344, 345, 347, 354,
+	358, 366, 367, 368, 369, 370, 372, 373, 379, 380,
+	381, 382, 390, 394, 410, 411, 422, 434, 439, 254,
+	418, 440, 0, 291, 0, 0, 293, 239, 257, 267,
+	0, 429, 391, 193, 360, 246, 182, 210, 196, 218,
+	233, 236, 271, 301, 307, 336, 340, 251, 230, 208,
+	357, 205, 377, 397, 398, 399, 401, 305, 225, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 1247, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 280, 226, 0, 0, 337,
+	0, 180, 0, 378, 214, 290, 287, 407, 240, 232,
+	228, 212, 264, 296, 335, 396, 329, 0, 284, 0,
+	0, 387, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 211,
+	179, 320, 388, 244, 0, 0, 0, 171, 172, 173,
+	0, 0, 0, 0, 0, 0, 0, 0, 202, 0,
+	209, 0, 0, 0, 0, 224, 268, 231, 223, 404,
+	0, 0, 0, 195, 178, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 309, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 276, 175,
+	191, 0, 0, 319, 359, 365, 0, 0, 0, 215,
+	0, 363, 333, 421, 198, 242, 356, 338, 0, 0,
+	362, 285, 409, 351, 419, 0, 289, 339, 437, 438,
+	222, 313, 427, 400, 433, 448, 192, 219, 327, 393,
+	424, 384, 306, 405, 406, 275, 383, 250, 283, 445,
+	190, 371, 206, 183, 395, 417, 203, 374, 0, 0,
+	450, 185, 415, 392, 303, 272, 273, 184, 0, 355,
+	227, 248, 217, 322, 412, 413, 216, 451, 194, 432,
+	187, 0, 431, 315, 408, 416, 304, 295, 186, 414,
+	302, 294, 278, 238, 259, 349, 288, 350, 260, 311,
+	310, 312, 0, 181, 0, 389, 425, 452, 199, 200,
+	201, 0, 237, 241, 247, 249, 255, 256, 263, 281,
+	326, 348, 346, 352, 0, 403, 420, 428, 435, 441,
+	442, 446, 443, 444, 447, 314, 262, 385, 277, 286,
+	0, 0, 332, 364, 204, 423, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 188, 282,
+	0, 353, 245, 449, 430, 376, 375, 0, 213, 426,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 177, 189, 197, 207,
+	220, 235, 243, 253, 258, 261, 265, 266, 269, 274,
+	292, 297, 298, 299, 300, 316, 317, 318, 321, 324,
+	325, 328, 330, 331, 334, 341, 342, 343, 344, 345,
+	347, 354, 358, 366, 367, 368, 369, 370, 372, 373,
+	379, 380, 381, 382, 390, 394, 410, 411, 422, 434,
+	439, 254, 418, 440, 0, 291, 0, 0, 293, 239,
+	257, 267, 0, 429, 391, 193, 360, 246, 182, 210,
+	196, 218, 233, 236, 271, 301, 307, 336, 340, 251,
+	230, 208, 357, 205, 377, 397, 398, 399, 401, 305,
+	225, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 0,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 1221, 0, 0, 171,
+	172, 173, 0, 0, 0, 0, 0, 0, 0, 0,
+	202, 0, 209, 0, 0, 0, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 309, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	276, 175, 191, 0, 0, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 0, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	188, 282, 0, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 254, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 1121, 0,
+	0, 0, 0, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 0, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 0, 0,
+	0, 171, 172, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 202, 0, 209, 0, 0, 0, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 0,
+	0, 0, 436, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 276, 175, 191, 0, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 0, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 188, 282, 0, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 1112, 229, 0, 0, 0,
+	0, 280, 226, 0, 0, 337, 0, 180, 0, 378,
+	214, 290, 287, 407, 240, 232, 228, 212, 264, 296,
+	335, 396, 329, 0, 284, 0, 0, 387, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 211, 179, 320, 388, 244,
+	0, 0, 0, 171, 172, 173, 0, 0, 0, 0,
+	0, 0, 0, 0, 202, 0, 209, 0, 0, 0,
+	0, 224, 268, 231, 223, 404, 0, 0, 0, 195,
+	178, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 309,
+	0, 0, 0, 0, 436, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 276, 175, 191, 0, 0, 319,
+	359, 365, 0, 0, 0, 215, 0, 363, 333, 421,
+	198, 242, 356, 338, 0, 0, 362, 285, 409, 351,
+	419, 0, 289, 339, 437, 438, 222, 313, 427, 400,
+	433, 448, 192, 219, 327, 393, 424, 384, 306, 405,
+	406, 275, 383, 250, 283, 445, 190, 371, 206, 183,
+	395, 417, 203, 374, 0, 0, 450, 185, 415, 392,
+	303, 272, 273, 184, 0, 355, 227, 248, 217, 322,
+	412, 413, 216, 451, 194, 432, 187, 0, 431, 315,
+	408, 416, 304, 295, 186, 414, 302, 294, 278, 238,
+	259, 349, 288, 350, 260, 311, 310, 312, 0, 181,
+	0, 389, 425, 452, 199, 200, 201, 0, 237, 241,
+	247, 249, 255, 256, 263, 281, 326, 348, 346, 352,
+	0, 403, 420, 428, 435, 441, 442, 446, 443, 444,
+	447, 314, 262, 385, 277, 286, 0, 0, 332, 364,
+	204, 423, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 188, 282, 0, 353, 245, 449,
+	430, 376, 375, 0, 213, 426, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 177, 189, 197, 207, 220, 235, 243, 253,
+	258, 261, 265, 266, 269, 274, 292, 297, 298, 299,
+	300, 316, 317, 318, 321, 324, 325, 328, 330, 331,
+	334, 341, 342, 343, 344, 345, 347, 354, 358, 366,
+	367, 368, 369, 370, 372, 373, 379, 380, 381, 382,
+	390, 394, 410, 411, 422, 434, 439, 254, 418, 440,
+	0, 291, 0, 0, 293, 239, 257, 267, 0, 429,
+	391, 193, 360, 246, 182, 210, 196, 218, 233, 236,
+	271, 301, 307, 336, 340, 251, 230, 208, 357, 205,
+	377, 397, 398, 399, 401, 305, 225, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
 	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	1194, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 1095, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 280, 226, 0, 0, 337, 0, 180,
+	0, 378, 214, 290, 287, 407, 240, 232, 228, 212,
+	264, 296, 335, 396, 329, 0, 284, 0, 0, 387,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 211, 179, 320,
+	388, 244, 0, 0, 0, 171, 172, 173, 0, 961,
+	0, 0, 0, 0, 0, 0, 202, 0, 209, 0,
+	0, 0, 0, 224, 268, 231, 223, 404, 0, 0,
+	0, 195, 178, 361, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	0, 309, 0, 0, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 279, 0, 276, 175, 191, 0,
+	0, 319, 359, 365, 0, 0, 0, 215, 0, 363,
+	333, 421, 198, 242, 356, 338, 0, 0, 362, 285,
+	409, 351, 419, 0, 289, 339, 437, 438, 222, 313,
+	427, 400, 433, 448, 192, 219, 327, 393, 424, 384,
+	306, 405, 406, 275, 383, 250, 283, 445, 190, 371,
+	206, 183, 395, 417, 203, 374, 0, 0, 450, 185,
+	415, 392, 303, 272, 273, 184, 0, 355, 227, 248,
+	217, 322, 412, 413, 216, 451, 194, 432, 187, 0,
+	431, 315, 408, 416, 304, 295, 186, 414, 302, 294,
+	278, 238, 259, 349, 288, 350, 260, 311, 310, 312,
+	0, 181, 0, 389, 425, 452, 199, 200, 201, 0,
+	237, 241, 247, 249, 255, 256, 263, 281, 326, 348,
+	346, 352, 0, 403, 420, 428, 435, 441, 442, 446,
+	443, 444, 447, 314, 262, 385, 277, 286, 0, 0,
+	332, 364, 204, 423, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 188, 282, 0, 353,
+	245, 449, 430, 376, 375, 0, 213, 426, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 177, 189, 197, 207, 220, 235,
+	243, 253, 258, 261, 265, 266, 269, 274, 292, 297,
+	298, 299, 300, 316, 317, 318, 321, 324, 325, 328,
+	330, 331, 334, 341, 342, 343, 344, 345, 347, 354,
+	358, 366, 367, 368, 369, 370, 372, 373, 379, 380,
+	381, 382, 390, 394, 410, 411, 422, 434, 439, 254,
+	418, 440, 0, 291, 0, 0, 293, 239, 257, 267,
+	0, 429, 391, 193, 360, 246, 182, 210, 196, 218,
+	233, 236, 271, 301, 307, 336, 340, 251, 230, 208,
+	357, 205, 377, 397, 398, 399, 401, 305, 225, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 280, 226, 0, 0, 337,
+	0, 180, 0, 378, 214, 290, 287, 407, 240, 232,
+	228, 212, 264, 296, 335, 396, 329, 0, 284, 0,
+	0, 387, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 211,
+	179, 320, 388, 244, 0, 0, 0, 171, 172, 173,
+	0, 0, 0, 0, 0, 0, 0, 0, 202, 0,
+	209, 0, 0, 0, 0, 224, 268, 231, 223, 404,
+	0, 0, 0, 195, 178, 361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 309, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 276, 175,
+	191, 0, 0, 319, 359, 365, 0, 0, 0, 215,
+	0, 363, 333, 421, 198, 242, 356, 338, 0, 0,
+	362, 285, 409, 351, 419, 0, 289, 339, 437, 438,
+	222, 313, 427, 400, 433, 448, 192, 219, 327, 393,
+	424, 384, 306, 405, 406, 275, 383, 250, 283, 445,
+	190, 371, 206, 183, 395, 417, 203, 374, 0, 0,
+	450, 185, 415, 392, 303, 272, 273, 184, 0, 355,
+	227, 248, 217, 322, 412, 413, 216, 451, 194, 432,
+	187, 0, 431, 315, 408, 416, 304, 295, 186, 414,
+	302, 294, 278, 238, 259, 349, 288, 350, 260, 311,
+	310, 312, 0, 181, 0, 389, 425, 452, 199, 200,
+	201, 0, 237, 241, 247, 249, 255, 256, 263, 281,
+	326, 348, 346, 352, 0, 403, 420, 428, 435, 441,
+	442, 446, 443, 444, 447, 314, 262, 385, 277, 286,
+	0, 0, 332, 364, 204, 423, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 188, 282,
+	0, 353, 245, 449, 430, 376, 375, 0, 213, 426,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 588, 0, 0, 0, 176, 177, 189, 197, 207,
+	220, 235, 243, 253, 258, 261, 265, 266, 269, 274,
+	292, 297, 298, 299, 300, 316, 317, 318, 321, 324,
+	325, 328, 330, 331, 334, 341, 342, 343, 344, 345,
+	347, 354, 358, 366, 367, 368, 369, 370, 372, 373,
+	379, 380, 381, 382, 390, 394, 410, 411, 422, 434,
+	439, 254, 418, 440, 0, 291, 0, 0, 293, 239,
+	257, 267, 0, 429, 391, 193, 360, 246, 182, 210,
+	196, 218, 233, 236, 271, 301, 307, 336, 340, 251,
+	230, 208, 357, 205, 377, 397, 398, 399, 401, 305,
+	225, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 280, 226, 0,
+	0, 337, 0, 180, 0, 378, 214, 290, 287, 407,
+	240, 232, 228, 212, 264, 296, 335, 396, 329, 0,
+	284, 0, 0, 387, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 211, 179, 320, 388, 244, 0, 0, 0, 171,
+	172, 173, 0, 0, 0, 0, 0, 0, 0, 0,
+	202, 0, 209, 0, 0, 0, 0, 224, 268, 231,
+	223, 404, 0, 0, 0, 195, 178, 361, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 507, 0, 252, 0, 309, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	276, 175, 191, 0, 0, 319, 359, 365, 0, 0,
+	0, 215, 0, 363, 333, 421, 198, 242, 356, 338,
+	0, 0, 362, 285, 409, 351, 419, 0, 289, 339,
+	437, 438, 222, 313, 427, 400, 433, 448, 192, 219,
+	327, 393, 424, 384, 306, 405, 406, 275, 383, 250,
+	283, 445, 190, 371, 206, 183, 395, 417, 203, 374,
+	0, 0, 450, 185, 415, 392, 303, 272, 273, 184,
+	0, 355, 227, 248, 217, 322, 412, 413, 216, 451,
+	194, 432, 187, 0, 431, 315, 408, 416, 304, 295,
+	186, 414, 302, 294, 278, 238, 259, 349, 288, 350,
+	260, 311, 310, 312, 0, 181, 0, 389, 425, 452,
+	199, 200, 201, 0, 237, 241, 247, 249, 255, 256,
+	263, 281, 326, 348, 346, 352, 0, 403, 420, 428,
+	435, 441, 442, 446, 443, 444, 447, 314, 262, 385,
+	277, 286, 0, 0, 332, 364, 204, 423, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	188, 282, 0, 353, 245, 449, 430, 376, 375, 0,
+	213, 426, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 176, 177, 189,
+	197, 207, 220, 235, 243, 253, 258, 261, 265, 266,
+	269, 274, 292, 297, 298, 299, 300, 316, 317, 318,
+	321, 324, 325, 328, 330, 331, 334, 341, 342, 343,
+	344, 345, 347, 354, 358, 366, 367, 368, 369, 370,
+	372, 373, 379, 380, 381, 382, 390, 394, 410, 411,
+	422, 434, 439, 506, 418, 440, 0, 291, 0, 0,
+	293, 239, 257, 267, 0, 429, 391, 193, 360, 246,
+	182, 210, 196, 218, 233, 236, 271, 301, 307, 336,
+	340, 251, 230, 208, 357, 205, 377, 397, 398, 399,
+	401, 305, 225, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 280,
+	226, 0, 0, 337, 0, 180, 0, 378, 214, 290,
+	287, 407, 240, 232, 228, 212, 264, 296, 335, 396,
+	329, 0, 284, 0, 0, 387, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 211, 179, 320, 388, 244, 0, 0,
+	0, 171, 172, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 202, 0, 209, 0, 0, 0, 0, 224,
+	268, 231, 223, 404, 0, 0, 0, 195, 178, 361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 309, 0, 0,
+	455, 0, 436, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 276, 175, 191, 0, 0, 319, 359, 365,
+	0, 0, 0, 215, 0, 363, 333, 421, 198, 242,
+	356, 338, 0, 0, 362, 285, 409, 351, 419, 0,
+	289, 339, 437, 438, 222, 313, 427, 400, 433, 448,
+	192, 219, 327, 393, 424, 384, 306, 405, 406, 275,
+	383, 250, 283, 445, 190, 371, 206, 183, 395, 417,
+	203, 374, 0, 0, 450, 185, 415, 392, 303, 272,
+	273, 184, 0, 355, 227, 248, 217, 322, 412, 413,
+	216, 451, 194, 432, 187, 0, 431, 315, 408, 416,
+	304, 295, 186, 414, 302, 294, 278, 238, 259, 349,
+	288, 350, 260, 311, 310, 312, 0, 181, 0, 389,
+	425, 452, 199, 200, 201, 0, 237, 241, 247, 249,
+	255, 256, 263, 281, 326, 348, 346, 352, 0, 403,
+	420, 428, 435, 441, 442, 446, 443, 444, 447, 314,
+	262, 385, 277, 286, 0, 0, 332, 364, 204, 423,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 188, 282, 0, 353, 245, 449, 430, 376,
+	375, 0, 213, 426, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	177, 189, 197, 207, 220, 235, 243, 253, 258, 261,
+	265, 266, 269, 274, 292, 297, 298, 299, 300, 316,
+	317, 318, 321, 324, 325, 328, 330, 331, 334, 341,
+	342, 343, 344, 345, 347, 354, 358, 366, 367, 368,
+	369, 370, 372, 373, 379, 380, 381, 382, 390, 394,
+	410, 411, 422, 434, 439, 254, 418, 440, 0, 291,
+	0, 0, 293, 239, 257, 267, 0, 429, 391, 193,
+	360, 246, 182, 210, 196, 218, 233, 236, 271, 301,
+	307, 336, 340, 251, 230, 208, 357, 205, 377, 397,
+	398, 399, 401, 305, 225, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
 	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 1086, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 942,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
+	0, 280, 226, 0, 0, 337, 0, 180, 0, 378,
+	214, 290, 287, 407, 240, 232, 228, 212, 264, 296,
+	335, 396, 329, 0, 284, 0, 0, 387, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 211, 179, 320, 388, 244,
+	0, 0, 0, 171, 172, 173, 0, 0, 0, 0,
+	0, 0, 0, 0, 202, 0, 209, 0, 0, 0,
+	0, 224, 268, 231, 223, 404, 0, 0, 0, 195,
+	178, 361, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 309,
+	0, 0, 0, 0, 436, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 276, 175, 191, 0, 0, 319,
+	359, 365, 0, 0, 0, 215, 0, 363, 333, 421,
+	198, 242, 356, 338, 0, 0, 362, 285, 409, 351,
+	419, 0, 289, 339, 437, 438, 222, 313, 427, 400,
+	433, 448, 192, 219, 327, 393, 424, 384, 306, 405,
+	406, 275, 383, 250, 283, 445, 190, 371, 206, 183,
+	395, 417, 203, 374, 0, 0, 450, 185, 415, 392,
+	303, 272, 273, 184, 0, 355, 227, 248, 217, 322,
+	412, 413, 216, 451, 194, 432, 187, 0, 431, 315,
+	408, 416, 304, 295, 186, 414, 302, 294, 278, 238,
+	259, 349, 288, 350, 260, 311, 310, 312, 0, 181,
+	0, 389, 425, 452, 199, 200, 201, 0, 237, 241,
+	247, 249, 255, 256, 263, 281, 326, 348, 346, 352,
+	0, 403, 420, 428, 435, 441, 442, 446, 443, 444,
+	447, 314, 262, 385, 277, 286, 0, 0, 332, 364,
+	204, 423, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 188, 282, 0, 353, 245, 449,
+	430, 376, 375, 0, 213, 426, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 177, 189, 197, 207, 220, 235, 243, 253,
+	258, 261, 265, 266, 269, 274, 292, 297, 298, 299,
+	300, 316, 317, 318, 321, 324, 325, 328, 330, 331,
+	334, 341, 342, 343, 344, 345, 347, 354, 358, 366,
+	367, 368, 369, 370, 372, 373, 379, 380, 381, 382,
+	390, 394, 410, 411, 422, 434, 439, 254, 418, 440,
+	0, 291, 0, 0, 293, 239, 257, 267, 0, 429,
+	391, 193, 360, 246, 182, 210, 196, 218, 233, 236,
+	271, 301, 307, 336, 340, 251, 230, 208, 357, 205,
+	377, 397, 398, 399, 401, 305, 225, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
 	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 498, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 497, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 446,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220,
+	0, 0, 0, 280, 226, 0, 0, 337, 0, 180,
+	0, 378, 214, 290, 287, 407, 240, 232, 228, 212,
+	264, 296, 335, 396, 329, 0, 284, 0, 0, 387,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 211, 179, 320,
+	388, 244, 0, 0, 0, 171, 172, 173, 0, 0,
+	0, 0, 0, 0, 0, 0, 202, 0, 209, 0,
+	0, 0, 0, 224, 268, 231, 223, 404, 0, 0,
+	0, 195, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	0, 309, 0, 0, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 279, 0, 276, 175, 191, 0,
+	0, 319, 359, 365, 0, 0, 0, 215, 0, 363,
+	333, 421, 198, 242, 356, 338, 0, 0, 362, 285,
+	409, 351, 419, 0, 289, 339, 437, 438, 222, 313,
+	427, 400, 433, 448, 192, 219, 327, 393, 424, 384,
+	306, 405, 406, 275, 383, 250, 283, 445, 190, 371,
+	206, 183, 395, 417, 203, 374, 0, 0, 450, 185,
+	415, 392, 303, 272, 273, 184, 0, 355, 227, 248,
+	217, 322, 412, 413, 216, 451, 194, 432, 187, 0,
+	431, 315, 408, 416, 304, 295, 186, 414, 302, 294,
+	278, 238, 259, 349, 288, 350, 260, 311, 310, 312,
+	0, 181, 0, 389, 425, 452, 199, 200, 201, 0,
+	237, 241, 247, 249, 255, 256, 263, 281, 326, 348,
+	346, 352, 0, 403, 420, 428, 435, 441, 442, 446,
+	443, 444, 447, 314, 262, 385, 277, 286, 0, 0,
+	332, 364, 204, 423, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 188, 282, 0, 353,
+	245, 449, 430, 376, 375, 0, 213, 426, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 177, 189, 197, 207, 220, 235,
+	243, 253, 258, 261, 265, 266, 269, 274, 292, 297,
+	298, 299, 300, 316, 317, 318, 321, 324, 325, 328,
+	330, 331, 334, 341, 342, 343, 344, 345, 347, 354,
+	358, 366, 367, 368, 369, 370, 372, 373, 379, 380,
+	381, 382, 390, 394, 410, 411, 422, 434, 439, 254,
+	418, 440, 0, 291, 0, 0, 293, 239, 257, 267,
+	0, 429, 391, 193, 360, 246, 182, 210, 196, 218,
+	233, 236, 271, 301, 307, 336, 340, 251, 230, 208,
+	357, 205, 377, 397, 398, 399, 401, 305, 225,
 }
 
 var yyPact = [...]int{
-	2386, -1000, -340, 1626, -1000, -1000, -1000, -1000, -1000, -1000,
+	5035, -1000, -342, 1678, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1578, 1212, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 619, 1276, -1000, 1513, 4081, -1000, 29662, 383,
-	-1000, 29188, 370, 2201, 29662, -1000, 94, -1000, 83, 29662,
-	96, 28714, -1000, -1000, -283, 13070, 1464, -4, -7, 29662,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1249,
-	1539, 1546, 1588, 1063, 1582, -1000, 11173, 11173, 300, 300,
-	300, 9277, -1000, -1000, 17337, 29662, 29662, 194, -1000, 1513,
-	-1000, -1000, 237, -1000, 240, 1219, -1000, 1213, -1000, 585,
-	385, 236, 311, 309, 233, 232, 230, 229, 228, 227,
-	225, 224, 242, -1000, 543, 543, -174, -175, 3053, 288,
-	288, 288, 329, 1492, 1490, -1000, 553, -1000, 543, 543,
-	215, 543, 543, 543, 543, 198, 193, 543, 543, 543,
-	543, 543, 543, 543, 543, 543, 543, 543, 543, 543,
-	543, 543, 207, 1513, 165, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1640, 1685, 272, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 632, 1341, -1000, 1557, 3915, -1000, 30948,
+	468, -1000, 30466, 460, 4444, 30948, -1000, 158, -1000, 151,
+	30948, 156, 29984, -1000, -1000, -238, 13594, 1516, 50, 33,
+	30948, -1000, 29502, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1345, 1596, 1615, 1638, 1640, -1000, 1193, 1637, -1000,
+	12148, 12148, 390, 390, 390, 9737, -1000, -1000, 18416, 30948,
+	30948, 242, -1000, 1557, -1000, -1000, 283, -1000, 356, 1286,
+	-1000, 1283, -1000, 520, 391, 312, 372, 367, 311, 309,
+	304, 302, 295, 294, 291, 288, 315, -1000, 604, 604,
+	-113, -123, 2911, 379, 379, 379, 415, 1536, 1534, -1000,
+	570, -1000, 604, 604, 270, 604, 604, 604, 604, 237,
+	231, 604, 604, 604, 604, 604, 604, 604, 604, 604,
+	604, 604, 604, 604, 604, 604, 239, 1557, 222, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4176,26 +4371,27 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 29662, 110, 29662, -1000, 460, 29662,
-	626, 626, 5, 626, 626, 626, 626, 101, 443, -16,
-	-1000, 91, 187, 84, 152, 611, 125, 63, -1000, -1000,
-	159, 611, 973, 80, -1000, 626, 7373, 7373, 7373, -1000,
-	1506, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 325,
-	-1000, -1000, -1000, -1000, 29662, 28240, 258, 578, -1000, -1000,
-	-1000, 79, -1000, -1000, 1127, 563, -1000, 13070, 1250, 1134,
-	1134, -1000, -1000, 411, -1000, -1000, 14492, 14492, 14492, 14492,
-	14492, 14492, 14492, 14492, 14492, 14492, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1134, 441, -1000, 12596, 1134, 1134, 1134, 1134, 1134, 1134,
-	1134, 1134, 13070, 1134, 1134, 1134, 1134, 1134, 1134, 1134,
-	1134, 1134, 1134, 1134, 1134, 1134, 1134, 1134, 1134, 1134,
-	-1000, -1000, -1000, 29662, -1000, 1134, 108, 1578, -1000, 1212,
-	-1000, -1000, -1000, 1508, 13070, 13070, 1578, -1000, 1411, 11173,
-	-1000, -1000, 1436, -1000, -1000, -1000, -1000, -1000, 749, 1605,
-	-1000, 15914, 438, 1604, 27766, -1000, 21130, 27292, 1209, 8801,
-	-47, -1000, -1000, -1000, 575, 19708, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 30948, 167, 30948, -1000, 537, 30948, 715,
+	715, 63, 715, 715, 715, 715, 171, 499, 32, -1000,
+	169, 217, 128, 214, 699, 136, 93, -1000, -1000, 211,
+	699, 1072, 147, -1000, 715, 7801, 7801, 7801, -1000, 1544,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 414, -1000,
+	-1000, -1000, -1000, 30948, 29020, 322, 658, -1000, -1000, -1000,
+	69, -1000, -1000, 1242, 797, -1000, 13594, 2532, 1288, 1288,
+	-1000, -1000, 511, -1000, -1000, 15040, 15040, 15040, 15040, 15040,
+	15040, 15040, 15040, 15040, 15040, -156, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1506, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1288, 534, -1000, 11184, 1288, 1288, 1288, 1288, 1288, 1288,
+	1288, 1288, 13594, 1288, 1288, 1288, 1288, 1288, 1288, 1288,
+	1288, 1288, 1288, 1288, 1288, 1288, 1288, 1288, 1288, 1288,
+	-1000, -1000, -1000, 30948, -1000, 1288, 166, 1274, 30948, -1000,
+	1291, 1640, -1000, 272, -1000, -1000, 1552, 13594, 13594, 1615,
+	1490, 1640, -1000, 1451, 12148, -1000, -1000, 1490, -1000, -1000,
+	-1000, -1000, -1000, 827, 1667, -1000, 16486, 532, 1666, 28538,
+	-1000, 22272, 28056, 1282, 9253, 30, -1000, -1000, -1000, 646,
+	20344, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1544, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4208,196 +4404,211 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1109, 29662, -1000, -1000, 3437, 919, -1000, 1274, -1000, 1106,
-	-1000, 1233, 1259, 353, 919, 349, 348, 345, -1000, -85,
-	-1000, -1000, -1000, -1000, -1000, 543, 543, 223, 4081, 4344,
-	-1000, -1000, -1000, 26818, 1273, 919, -1000, 1272, -1000, 680,
-	376, 406, 406, 919, -1000, -1000, 29662, 919, 679, 676,
-	29662, 29662, -1000, 26344, -1000, 25870, 25396, 879, 29662, 24922,
-	24448, 23974, 23500, 23026, -1000, 1372, -1000, 1255, -1000, -1000,
-	-1000, 29662, 29662, 29662, 202, -1000, -1000, 29662, 919, -1000,
-	-1000, 872, 868, 543, 543, 867, 972, 963, 962, 543,
-	543, 857, 961, 21604, 191, 845, 843, 838, 873, 959,
-	115, 871, 822, 805, 29662, 1268, 29662, -1000, 151, 505,
-	248, 572, 1513, 1453, 1208, 323, 352, 919, 295, 295,
-	-1000, 7849, -1000, -1000, 956, 13070, -1000, 621, 611, 611,
-	-1000, -1000, -1000, -1000, -1000, -1000, 626, 29662, 621, -1000,
-	-1000, -1000, 611, 626, 29662, 626, 626, 626, 626, 611,
-	611, 611, 626, 29662, 29662, 29662, 29662, 29662, 29662, 29662,
-	29662, 29662, 7373, 7373, 7373, 501, 626, -287, -1000, 1361,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 90, -1000,
-	-1000, -1000, -1000, -1000, 1626, -1000, -1000, -1000, -113, 1195,
-	22552, -1000, -288, -289, -290, -291, -1000, -1000, -1000, -292,
-	-293, -1000, -1000, -1000, 13070, 13070, 13070, 13070, 894, 523,
-	14492, 758, 635, 14492, 14492, 14492, 14492, 14492, 14492, 14492,
-	14492, 14492, 14492, 14492, 14492, 14492, 14492, 14492, 551, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 919, -1000, 1623,
-	1252, 1252, 450, 450, 450, 450, 450, 450, 450, 450,
-	450, 14966, 9751, 7849, 1063, 1101, 1578, 11173, 11173, 13070,
-	13070, 12121, 11647, 11173, 1495, 583, 563, 29662, -1000, 965,
-	-1000, -1000, 14018, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 29662, 29662, 11173, 11173, 11173,
-	11173, 11173, -1000, 1190, -1000, -173, 16863, 13070, 955, 1546,
-	1063, 1436, 1510, 1613, 492, 960, 1188, -1000, 748, 1546,
-	19234, 1210, -1000, 1436, -1000, -1000, -1000, 29662, -1000, -1000,
-	22078, -1000, -1000, 6897, 29662, 222, 29662, -1000, 1178, 1373,
-	-1000, -1000, -1000, 1533, 18760, 29662, 1176, 1175, -1000, -1000,
-	436, 8325, -47, -1000, 8325, 1147, -1000, -33, -54, 10225,
-	444, -1000, -1000, -1000, 3053, 15440, 1033, 1475, 29, -1000,
-	-1000, -1000, 1233, -1000, 1233, 1233, 1233, 1233, 202, 202,
-	202, 202, -1000, -1000, -1000, -1000, -1000, 1257, 1251, -1000,
-	1233, 1233, 1233, 1233, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1223, 30948, -1000, -1000,
+	4894, 1101, -1000, 1339, -1000, 1216, -1000, 1305, 1347, 455,
+	1101, 440, 436, 429, -1000, -56, -1000, -1000, -1000, -1000,
+	-1000, 604, 604, 292, -1000, 1636, 3915, 3384, -1000, -1000,
+	-1000, 27574, 1288, 1101, -1000, 1338, -1000, 748, 423, 500,
+	500, 1101, -1000, -1000, 30948, 1101, 733, 731, 1101, 30948,
+	30948, -1000, 27092, -1000, 26610, 26128, 988, 30948, 25646, 25164,
+	24682, 24200, 23718, -1000, 1395, -1000, 1319, -1000, -1000, -1000,
+	30948, 30948, 30948, 284, -1000, -1000, 30948, 1101, -1000, -1000,
+	981, 980, 604, 604, 954, 1070, 1069, 1064, 604, 604,
+	953, 1059, 21308, 235, 949, 948, 926, 1045, 1056, 129,
+	1018, 1007, 912, 30948, 1325, 30948, -1000, 202, 576, 316,
+	634, 1557, 1500, 1280, 411, 447, 1101, 383, 383, -1000,
+	8285, -1000, -1000, 1055, 13594, -1000, 771, 699, 699, -1000,
+	-1000, -1000, -1000, -1000, -1000, 715, 30948, 771, -1000, -1000,
+	-1000, 699, 715, 30948, 715, 715, 715, 715, 699, 699,
+	699, 715, 30948, 30948, 30948, 30948, 30948, 30948, 30948, 30948,
+	30948, 7801, 7801, 7801, 582, 715, -280, -1000, 1380, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 154, -1000, -1000,
+	-1000, -1000, -1000, 1678, -1000, -1000, -1000, -99, 1278, 23236,
+	-1000, -281, -282, -284, -285, -1000, -1000, -1000, -287, -288,
+	-1000, -1000, -1000, 13594, 13594, 13594, 13594, 976, 577, 15040,
+	952, 639, 15040, 15040, 15040, 15040, 15040, 15040, 15040, 15040,
+	15040, 15040, 15040, 15040, 15040, 15040, 15040, 734, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1101, -1000, 275, 1177,
+	1177, 553, 553, 553, 553, 553, 553, 553, 553, 553,
+	15522, -1000, 22272, 10219, 8285, 1193, 1123, 1640, 1685, 12148,
+	12148, 13594, 13594, 13112, 12630, 12148, 1543, 674, 797, 30948,
+	-1000, 1099, -1000, -1000, 14558, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 30948, 30948, 12148,
+	12148, 12148, 12148, 12148, -1000, 1277, -1000, -166, 17934, 13594,
+	1051, 30948, 1274, 1581, 30948, 1615, 1193, 1554, 1677, 538,
+	1008, 1273, -1000, 1015, 1552, -1000, -1000, 1615, 19862, 1303,
+	-1000, 1490, -1000, 30948, -1000, -1000, 22754, -1000, -1000, 7317,
+	30948, 287, 30948, -1000, 1281, 1430, -1000, -1000, -1000, 1579,
+	17452, 30948, 1284, 1219, -1000, -1000, 531, 8769, 30, -1000,
+	8769, 1252, -1000, 10, -21, 10701, 547, -1000, -1000, -1000,
+	2911, 16004, 1149, 1524, 79, -1000, -1000, -1000, 1305, -1000,
+	1305, 1305, 1305, 1305, 284, 284, 284, 284, -1000, -1000,
+	-1000, -1000, -1000, 1323, 1322, -1000, 1305, 1305, 1305, 1305,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1247, 1247, 1247, 1234, 1234, 279, -1000, 13070, 158,
-	29662, 1520, 804, 151, 298, 1307, 919, 919, 919, 298,
-	-1000, 933, 928, -1000, 1180, -1000, -1000, 1585, -1000, -1000,
-	663, 708, 706, 484, 29662, 112, 219, -1000, 278, -1000,
-	29662, 919, 647, 406, 919, -1000, 919, -1000, -1000, -1000,
-	-1000, 435, -1000, -1000, 919, 1177, -1000, 1128, 723, 700,
-	720, 688, 1177, -1000, -1000, -104, 1177, -1000, 1177, -1000,
-	1177, -1000, 1177, -1000, 1177, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 534, 29662, 112, 551, -1000, 316, -1000,
-	-1000, 551, 551, -1000, -1000, -1000, -1000, 952, 951, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1321, 1321, 1321,
+	1307, 1307, 338, -1000, 13594, 200, 30948, 1563, 911, 202,
+	388, 1362, 1101, 1101, 1101, 388, -1000, 1077, 1030, -1000,
+	18, 1272, -1000, -1000, 1635, -1000, -1000, 803, 782, 778,
+	680, 30948, 174, 274, -1000, 306, -1000, 30948, 1101, 718,
+	500, 1101, -1000, 1101, -1000, -1000, -1000, -1000, 530, -1000,
+	-1000, 1101, -1000, 1271, -1000, 1285, 930, 765, 904, 764,
+	1271, -1000, -1000, -76, 1271, -1000, 1271, -1000, 1271, -1000,
+	1271, -1000, 1271, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 623, 30948, 174, 734, -1000, 405, -1000, -1000, 734,
+	734, -1000, -1000, -1000, -1000, 1048, 1047, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -336, 29662, -1000, 134,
-	568, 189, 220, 178, 29662, 127, 1536, 172, 192, 29662,
-	29662, 295, 1359, 29662, 1527, 29662, -1000, -1000, -1000, -1000,
-	563, 29662, -1000, -1000, 626, 626, -1000, -1000, 29662, 626,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 626, -1000,
+	-1000, -1000, -1000, -1000, -329, 30948, -1000, 184, 618, 246,
+	290, 254, 30948, 180, 1599, 250, 227, 30948, 30948, 383,
+	1379, 30948, 1570, 30948, -1000, -1000, -1000, -1000, 797, 30948,
+	-1000, -1000, 715, 715, -1000, -1000, 30948, 715, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 715, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 950, -1000, -1000, 29662, 29662, -1000, -1000,
-	-1000, -1000, -1000, 95, -38, 214, -1000, -1000, -1000, -1000,
-	1542, -1000, 563, 523, 544, 694, -1000, -1000, 756, -1000,
-	-1000, 2512, -1000, -1000, -1000, -1000, 758, 14492, 14492, 14492,
-	641, 2512, 2531, 998, 1550, 450, 655, 655, 471, 471,
-	471, 471, 471, 886, 886, -1000, -1000, -1000, -1000, 965,
-	-1000, -1000, -1000, 965, 11173, 11173, 1173, 1134, 426, -1000,
-	1249, -1000, -1000, 1546, 1068, 1068, 754, 869, 570, 1603,
-	1068, 561, 1602, 1068, 1068, 11173, -1000, -1000, 632, -1000,
-	13070, 965, -1000, 1096, 1171, 1161, 1068, 965, 965, 1068,
-	1068, 29662, -1000, -278, -1000, -51, 437, 1134, -1000, 21604,
-	965, 1127, -1000, 1508, -1000, -1000, 1470, -1000, 1408, 13070,
-	13070, 13070, -1000, -1000, -1000, 1508, 1577, -1000, 1426, 1422,
-	1595, 11173, 21130, 1436, -1000, -1000, -1000, 424, 1595, 1194,
-	1134, -1000, 29662, 21130, 21130, 21130, 21130, 21130, -1000, 1399,
-	1396, -1000, 1387, 1383, 1395, 29662, -1000, 1089, 1063, 18760,
-	222, 1111, 21130, 29662, -1000, -1000, 21130, 29662, 6421, -1000,
-	1147, -47, -36, -1000, -1000, -1000, -1000, 563, -1000, 853,
-	-1000, 274, -1000, 287, -1000, -1000, -1000, -1000, 388, 1532,
-	1473, 18, -1000, -1000, -1000, 202, 202, -1000, -1000, 444,
-	658, 444, 444, 444, 949, 949, -1000, -1000, -1000, -1000,
-	-1000, 793, -1000, -1000, -1000, 791, -1000, -1000, 876, 1331,
-	158, -1000, -1000, 543, 948, 1479, 29662, -1000, -1000, 1023,
-	134, 29662, 600, 1358, -1000, 1307, 1307, 1307, 29662, -1000,
-	-1000, -1000, -1000, 247, 29662, 1083, -1000, 113, 29662, 1009,
-	29662, -1000, 1081, 1243, 919, 919, -1000, -1000, 7849, -1000,
-	29662, 1134, -1000, -1000, -1000, -1000, 351, 1511, 1509, 112,
-	113, 444, 919, -1000, -1000, -1000, -1000, -1000, -343, 1075,
-	331, 117, 181, 29662, 29662, 29662, 29662, 29662, 394, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 168, 307, -1000, 29662,
-	29662, 381, -1000, -1000, -1000, 611, -1000, -1000, 611, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1503, -40, -311, -1000, -308,
-	-1000, -1000, -1000, -1000, 641, 2512, 2494, -1000, 14492, 14492,
-	-1000, -1000, 1068, 1068, 11173, 7849, 1578, 1508, -1000, -1000,
-	196, 551, 196, 14492, 14492, -1000, 14492, 14492, -1000, -98,
-	1085, 545, -1000, 13070, 856, -1000, -1000, 14492, 14492, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 343, 335,
-	334, 29662, -1000, -1000, -1000, 908, 936, 1406, 563, 563,
-	-1000, -1000, 29662, -1000, -1000, -1000, -1000, 1593, 13070, -1000,
-	1146, -1000, 5945, 1546, 1345, 29662, 1134, 1626, 16389, 29662,
-	1121, -1000, 565, 1373, 1298, 1344, 1340, -1000, -1000, -1000,
-	-1000, 1391, -1000, 1389, -1000, -1000, -1000, -1000, -1000, 1063,
-	1595, 21130, 1098, -1000, 1098, -1000, 423, -1000, -1000, -1000,
-	-43, -68, -1000, -1000, -1000, 3053, -1000, -1000, -1000, -1000,
-	732, 14492, 1612, -1000, 934, -1000, -1000, 645, 617, -1000,
-	29662, 1242, -1000, -1000, -1000, 444, 444, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1046, -1000, 1042, 1144, 1040, 62,
-	-1000, 1199, 1501, 543, 543, -1000, 789, -1000, 919, -1000,
-	-1000, 310, -1000, 1523, 29662, 1343, 1339, 1328, -1000, 1581,
-	1142, -1000, 29662, -1000, -1000, 29662, -1000, -1000, 1419, 158,
-	29662, -1000, -1000, -1000, -1000, 219, 29662, -1000, 1252, 113,
-	-1000, -1000, -1000, -1000, -1000, -1000, 29662, 147, -1000, 1236,
-	979, -1000, 1286, -1000, -1000, -1000, -1000, 111, 183, -1000,
-	29662, 365, 1331, 29662, -1000, -1000, -1000, 626, 626, -1000,
-	1499, -1000, 919, -1000, 14492, 2512, 2512, -1000, -1000, 965,
-	-1000, 1546, -1000, 965, 1233, 1233, -1000, 1233, 1234, -1000,
-	1233, 74, 1233, 73, 965, 965, 2394, 2304, 2191, 1808,
-	1134, -88, -1000, 563, 13070, 1781, 1753, 1134, 1134, 1134,
-	1030, 932, 202, -1000, -1000, -1000, 1591, 1576, 563, -1000,
-	-1000, -1000, 1515, 1123, 1087, -1000, -1000, 10699, 1038, 1416,
-	410, 1030, 1578, 29662, 13070, -1000, -1000, 13070, 1231, -1000,
-	13070, -1000, -1000, -1000, 1578, 1578, 1098, -1000, -1000, 477,
-	-1000, -1000, -1000, -1000, -1000, 2512, -91, -1000, -1000, -1000,
-	1229, 14492, -1000, -1000, 202, 921, 202, 787, -1000, 769,
-	-1000, -1000, -215, -1000, -1000, 1133, 1369, -1000, -1000, 29662,
-	-1000, -1000, 29662, 29662, 29662, 29662, 29662, -1000, -1000, 206,
-	-1000, 1020, 1016, -1000, -143, -1000, -1000, 1226, -1000, -1000,
-	-1000, 1006, -1000, -116, 919, 29662, 29662, 29662, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2512, -1000, 1508, -1000,
-	-1000, 273, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	14492, 14492, 14492, 14492, 14492, 1546, 914, 563, 14492, 14492,
-	18285, 20656, 20656, 17811, 202, 16, -1000, 13070, 13070, 613,
-	-1000, 1134, -1000, 1215, 29662, 1134, 29662, -1000, 1546, -1000,
-	563, 563, 29662, 563, 1546, -1000, -1000, 29662, 1284, 444,
-	-1000, 444, 995, 993, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1226, -1000, -1000, -1000, 1142, -1000, 199, 260,
-	-1000, 219, -1000, -176, -177, 1531, 29662, -1000, -1000, 7849,
-	-1000, -1000, 1221, 1299, -1000, -1000, -1000, -1000, 1096, 1096,
-	1096, 1096, 507, 965, -1000, 1096, 1096, 1014, -1000, -1000,
-	-1000, 1014, 1014, 437, -273, -1000, 1450, 1424, 563, 1127,
-	1611, -1000, 1134, 1626, 403, 1087, -1000, -1000, 1005, -1000,
-	1000, -1000, -1000, -1000, -1000, -1000, 1529, 1134, -1000, -1000,
-	-1000, -1000, 1212, 989, 1116, -1000, 539, 29662, 29662, -1000,
-	-1000, -1000, -1000, 965, 184, -123, -1000, -1000, -1000, 20182,
-	-1000, -1000, -1000, -1000, 16, 272, -1000, 1437, 1424, -1000,
-	1575, 1446, 1566, -1000, 29662, 1087, 29662, -1000, 1325, 776,
-	1212, 13544, 221, -1000, 7849, 5469, 983, -1000, -1000, 1405,
-	-112, -163, -1000, -1000, 1432, 1439, 1439, 1437, -1000, 1556,
-	1554, -1000, 910, 1552, 898, 1066, -1000, 1285, -1000, 1601,
-	-1000, -1000, -1000, 729, 897, -1000, -1000, -1000, 221, 1096,
-	965, -1000, -53, -1000, -1000, -1000, -1000, -1000, 1286, -1000,
-	1376, -1000, 1427, 751, -1000, -1000, -1000, -1000, 893, 891,
-	-1000, 884, -1000, -1000, 1610, 440, 440, -1000, -1000, -1000,
-	-1000, -1000, 277, -1000, -1000, -116, -142, -1000, 744, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 267, 837, -1000,
-	148, -1000, -140, -1000, -1000, -1000, -1000, -1000, -1000, -164,
-	-1000,
+	-1000, 1041, -1000, -1000, 30948, 30948, -1000, -1000, -1000, -1000,
+	-1000, 86, 6, 256, -1000, -1000, -1000, -1000, 1601, -1000,
+	797, 577, 880, 598, -1000, -1000, 826, -1000, -1000, 2656,
+	-1000, -1000, -1000, -1000, 952, 15040, 15040, 15040, 914, 2656,
+	2405, 1029, 1316, 553, 730, 730, 554, 554, 554, 554,
+	554, 875, 875, -1000, -1000, -1000, -1000, 1099, -1000, -1000,
+	-1000, 268, -1000, 1099, 12148, 12148, 1267, 1288, 527, -1000,
+	1345, -1000, -1000, 1615, 1640, 1188, 1188, 845, 872, 701,
+	1660, 1188, 666, 1652, 1188, 1188, 12148, -1000, -1000, 671,
+	-1000, 13594, 1099, -1000, 1269, 1263, 1253, 1188, 1099, 1099,
+	1188, 1188, 30948, -1000, -265, -1000, -14, 492, 1288, -1000,
+	21308, 1099, 1242, -1000, -1000, 1288, 1209, -1000, 1552, -1000,
+	-1000, 1509, -1000, 1447, 13594, 13594, 13594, -1000, -1000, -1000,
+	1554, 1552, 1610, -1000, 1474, 1470, 1648, 12148, 22272, 1490,
+	-1000, -1000, -1000, 523, 1648, 286, 1288, -1000, 30948, 22272,
+	22272, 22272, 22272, 22272, -1000, 1423, 1416, -1000, 1438, 1406,
+	1449, 30948, -1000, 1207, 1193, 17452, 287, 1238, 22272, 30948,
+	-1000, -1000, 22272, 30948, 6833, -1000, 1252, 30, -34, -1000,
+	-1000, -1000, -1000, 797, -1000, 963, 277, 333, -1000, 352,
+	-1000, -1000, -1000, -1000, 1275, 1577, 1521, 74, -1000, -1000,
+	-1000, 284, 284, -1000, -1000, 547, 813, 547, 547, 547,
+	1040, 1040, -1000, -1000, -1000, -1000, -1000, 907, -1000, -1000,
+	-1000, 889, -1000, -1000, 675, 1370, 200, -1000, -1000, 604,
+	1035, 1526, 30948, -1000, -1000, 1137, 184, 30948, 670, 1378,
+	-1000, 1362, 1362, 1362, 30948, -1000, -1000, -1000, -1000, 844,
+	289, 285, -1000, 282, 30948, 1205, -1000, 754, 31430, 1084,
+	-1000, 30948, -1000, 1202, 1320, 1101, 1101, -1000, -1000, 8285,
+	-1000, 30948, 1288, -1000, -1000, -1000, -1000, 445, 1556, 1553,
+	174, 754, 547, 1101, -1000, -1000, -1000, -1000, -1000, -338,
+	1199, 418, 176, 210, 30948, 30948, 30948, 30948, 30948, 503,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 224, 400, -1000,
+	30948, 30948, 453, -1000, -1000, -1000, 699, -1000, -1000, 699,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1549, 2, -305, -1000,
+	-302, -1000, -1000, -1000, -1000, 914, 2656, 2295, -1000, 15040,
+	15040, -1000, 1099, 1640, 1634, -1000, 1188, 1188, 12148, 8285,
+	1640, 1552, 1615, -1000, -1000, 588, 734, 588, 15040, 15040,
+	-1000, 15040, 15040, -1000, -70, 1294, 662, -1000, 13594, 786,
+	-1000, -1000, 15040, 15040, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 424, 421, 420, 30948, -1000, -1000, -1000,
+	-1000, 30948, -1000, 994, 1025, 1439, 797, 797, -1000, -1000,
+	-1000, 30948, -1000, -1000, -1000, -1000, 1646, 13594, -1000, 1250,
+	-1000, 6349, 1615, 1376, 30948, 1288, 1678, 16969, 30948, 1214,
+	-1000, 615, 1430, 1355, 1373, 1418, -1000, -1000, -1000, -1000,
+	1412, -1000, 1404, -1000, -1000, -1000, -1000, -1000, 1193, 1648,
+	22272, 1212, -1000, 1212, -1000, 522, -1000, -1000, -1000, -9,
+	-29, -1000, -1000, -1000, -1000, -1000, 2911, -1000, -1000, -1000,
+	-1000, 809, 15040, 1676, -1000, 1021, -1000, -1000, 716, 709,
+	-1000, 30948, 1314, -1000, -1000, -1000, 547, 547, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1178, -1000, 1175, 1244, 1168,
+	100, -1000, 1306, 1547, 604, 604, -1000, 881, -1000, 1101,
+	-1000, -1000, 410, -1000, 1566, 30948, 1372, 1371, 1369, -1000,
+	1288, 1288, -1000, 13594, 1288, 13594, 1288, 1632, 1243, 30948,
+	-1000, -1000, 30948, -1000, -1000, 274, 99, 200, 30948, -1000,
+	-1000, -1000, -1000, 274, 30948, -1000, 1177, 754, -1000, -1000,
+	-1000, -1000, -1000, -1000, 30948, 197, -1000, 1312, 1032, -1000,
+	1360, -1000, -1000, -1000, -1000, 177, 245, -1000, 30948, 409,
+	1370, 30948, -1000, -1000, -1000, 715, 715, -1000, 1542, -1000,
+	1101, -1000, 15040, 2656, 2656, -1000, -129, 13594, -1000, -1000,
+	1099, -1000, 1615, -1000, 1552, 1099, 1305, 1305, -1000, 1305,
+	1307, -1000, 1305, 143, 1305, 137, 1099, 1099, 2728, 2390,
+	2279, 2251, 1288, -65, -1000, 797, 13594, 2032, 1673, 1288,
+	1288, 1288, 1161, -1000, 1020, 284, -1000, -1000, -1000, 1642,
+	1630, 797, -1000, -1000, -1000, 1559, 1163, 1234, -1000, -1000,
+	11666, 1165, 1459, 519, 1161, 1640, 30948, 13594, -1000, -1000,
+	13594, 1298, -1000, 13594, -1000, -1000, -1000, 1640, 1640, 1212,
+	-1000, -1000, 563, -1000, -1000, -1000, -1000, -1000, 2656, -17,
+	-1000, -1000, -1000, 1297, 15040, -1000, -1000, 284, 1017, 284,
+	878, -1000, 871, -1000, -1000, -178, -1000, -1000, 1302, 1356,
+	-1000, -1000, 30948, -1000, -1000, 30948, 30948, 30948, 13594, 30948,
+	652, 30948, 652, 30948, 30948, -1000, -1000, -1000, 347, -1000,
+	604, -312, -1000, 1140, 1084, -94, -1000, -1000, 1291, -1000,
+	-1000, -1000, 1118, -1000, -78, 30948, 30948, 30948, 30948, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2656, -1000, 155,
+	-1000, -1000, 1242, -1000, 1552, -1000, -1000, -1000, 321, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 15040, 15040, 15040,
+	15040, 15040, 1615, 1014, 797, 15040, 15040, 19380, 21790, 21790,
+	18898, 284, 67, -163, 13594, 13594, 708, -1000, 1288, -1000,
+	247, 30948, 1288, 30948, -1000, 1615, -1000, 797, 797, 30948,
+	797, 1615, -1000, -1000, 30948, 1592, 547, -1000, 547, 1116,
+	1105, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1291,
+	-1000, -1000, -1000, 652, 1136, 243, 1136, 243, 1136, 1243,
+	253, 1288, 21308, 604, 298, -1000, -1000, -124, -125, 1576,
+	-1000, -1000, 8285, -1000, -1000, 1290, 1361, -1000, -1000, 134,
+	-131, -175, -176, -179, 15040, -1000, -1000, -1000, 1269, 1269,
+	1269, 1269, 516, 1099, -1000, 1269, 1269, 1133, -1000, -1000,
+	-1000, 1133, 1133, 492, -233, -1000, 1493, 1473, -1000, 30948,
+	797, 1242, 1674, -1000, 1288, 1678, 517, 1234, -1000, -1000,
+	1131, -1000, 1128, -1000, -1000, -1000, -1000, -1000, 1575, 243,
+	243, 1288, 841, 243, 1288, 243, 407, 13594, -1000, 21308,
+	-1000, -1000, -1000, 272, 1241, -1000, 601, 30948, 30948, 692,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 15522, -1000, -1000,
+	-1000, -1000, 1099, 213, -86, -1000, -1000, -1000, 20826, -1000,
+	-1000, -1000, -1000, 67, 328, -1000, 1488, 1473, -1000, 1628,
+	1491, 1624, -1000, 1239, -1000, 1574, 30948, 1234, 30948, -1000,
+	1368, 664, 272, 1288, 1288, -1000, 274, -1000, 1288, -1000,
+	1288, 14076, -316, 1123, -1000, 269, 8285, 5865, 1112, -1000,
+	134, -183, -1000, 1431, -74, -90, -1000, -1000, 1480, 1485,
+	1485, 1488, -1000, 1623, 1621, -1000, 1001, 1617, 999, 30948,
+	1288, 1195, -1000, 1364, -1000, 1664, -1000, -1000, -1000, 793,
+	995, -1000, -1000, -1000, 269, -1000, -1000, 1084, -1000, -1000,
+	1269, 1099, -1000, -316, -1000, -39, -1000, -1000, -1000, -1000,
+	-1000, 1360, -1000, -1000, -1000, -1000, 1384, -1000, 1476, 892,
+	-1000, -1000, -1000, -1000, 992, 991, -1000, 977, -1000, -1000,
+	268, -1000, 1670, 505, 505, -1000, -1000, -1000, -1000, -316,
+	-316, -1000, 308, -1000, -1000, -78, -79, -1000, 816, -1000,
+	-1000, -1000, -1000, -1000, 1099, -1000, -1000, -1000, 335, 901,
+	-1000, -1000, -1000, 195, -1000, -93, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -91, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1936, 1935, 11, 91, 94, 1934, 1933, 1932, 1930,
-	134, 132, 125, 1914, 1913, 1912, 1911, 1910, 1909, 1907,
-	1906, 1904, 1902, 1901, 1887, 68, 140, 43, 47, 143,
-	1885, 1881, 32, 1872, 1869, 1867, 129, 127, 552, 1866,
-	123, 1865, 1863, 1858, 1855, 1852, 1851, 1850, 1849, 1848,
-	1846, 1845, 1844, 1843, 1840, 180, 1839, 1838, 4, 1837,
-	36, 1835, 1834, 1832, 1829, 1826, 100, 1823, 1822, 1821,
-	120, 1820, 1816, 61, 109, 69, 86, 1815, 1814, 79,
-	133, 1813, 64, 113, 1812, 1811, 88, 1810, 56, 103,
-	80, 1809, 57, 1808, 1802, 58, 1801, 1800, 1798, 89,
-	1797, 1790, 3120, 1788, 78, 84, 19, 42, 1786, 1785,
-	1781, 1778, 33, 2295, 1775, 1774, 22, 1773, 1769, 131,
-	1768, 99, 24, 1767, 20, 35, 31, 1766, 98, 1765,
-	41, 63, 38, 1761, 93, 1760, 1758, 1757, 1754, 25,
-	1753, 82, 107, 50, 1752, 1750, 1749, 8, 14, 1748,
-	1742, 1741, 1738, 1737, 1736, 7, 1735, 6, 1734, 26,
-	1732, 27, 18, 51, 81, 87, 29, 15, 1731, 121,
-	1730, 28, 118, 76, 116, 1729, 1727, 1725, 876, 153,
-	1724, 1722, 37, 1721, 101, 106, 1720, 178, 1719, 1717,
-	66, 1268, 2221, 10, 122, 1710, 1709, 2152, 72, 83,
-	23, 1708, 65, 1707, 1706, 1705, 145, 135, 74, 860,
-	45, 1703, 1701, 1699, 1685, 1684, 1683, 1681, 139, 44,
-	34, 126, 30, 1680, 1679, 1678, 73, 53, 1677, 115,
-	112, 75, 128, 1675, 117, 110, 67, 1674, 46, 1672,
-	1670, 1669, 1668, 48, 1667, 1666, 1664, 1661, 114, 104,
-	71, 39, 1660, 40, 77, 111, 108, 1659, 16, 138,
-	13, 1658, 1, 0, 1657, 3, 130, 165, 105, 1656,
-	1651, 2, 1650, 5, 1648, 1642, 85, 1639, 1638, 1637,
-	17, 21, 9, 1636, 1634, 3289, 355, 119, 1633, 124,
+	0, 1955, 1954, 18, 130, 107, 135, 141, 128, 1952,
+	1951, 4, 1948, 1946, 1945, 12, 1943, 1942, 1940, 1939,
+	145, 143, 142, 1938, 1937, 1934, 1933, 1931, 1930, 1928,
+	1927, 1926, 1924, 1923, 1921, 79, 166, 58, 62, 152,
+	1919, 1918, 44, 1917, 1916, 1914, 140, 138, 645, 1913,
+	137, 1912, 1911, 1910, 1907, 1906, 1902, 1901, 1900, 1897,
+	1894, 1893, 1892, 1891, 1889, 219, 1888, 1887, 6, 1886,
+	39, 1885, 1884, 1881, 1880, 1879, 108, 1878, 1875, 1874,
+	129, 1873, 1872, 67, 111, 75, 102, 1869, 1868, 91,
+	134, 1867, 78, 118, 1863, 1862, 1958, 1861, 66, 93,
+	90, 1860, 59, 1859, 1858, 72, 1857, 1856, 1854, 88,
+	1853, 1852, 2894, 1851, 85, 97, 26, 35, 1850, 1848,
+	1846, 1844, 52, 1591, 1843, 1841, 33, 1840, 1839, 167,
+	1836, 105, 22, 1835, 24, 21, 29, 1833, 98, 1831,
+	42, 68, 45, 1830, 104, 1829, 1828, 1824, 1810, 60,
+	1809, 95, 116, 115, 92, 1808, 1806, 11, 41, 1805,
+	1804, 1803, 1801, 1800, 1799, 25, 1798, 9, 1797, 28,
+	1795, 37, 27, 40, 86, 114, 36, 17, 1790, 156,
+	1787, 32, 132, 83, 124, 1786, 1785, 1784, 1006, 164,
+	1783, 1780, 49, 1779, 110, 113, 1778, 182, 1777, 1776,
+	125, 1378, 1982, 10, 136, 1775, 1771, 3579, 70, 23,
+	31, 1770, 74, 1769, 1768, 1767, 148, 151, 64, 969,
+	65, 1766, 1763, 1760, 1759, 1758, 1755, 1754, 87, 34,
+	48, 119, 38, 1752, 1751, 1749, 81, 57, 1748, 122,
+	121, 84, 131, 1747, 133, 127, 69, 1746, 43, 1744,
+	1743, 1729, 1728, 77, 1726, 1725, 1724, 1723, 123, 109,
+	80, 51, 1722, 46, 76, 117, 112, 16, 54, 139,
+	1721, 82, 15, 1718, 47, 2, 13, 1717, 1, 0,
+	1716, 5, 144, 177, 120, 1715, 1714, 3, 1713, 7,
+	1712, 1711, 103, 1709, 1708, 1707, 20, 30, 14, 1704,
+	1696, 2657, 559, 1695, 126, 1694, 1693, 153, 1692, 8,
 }
 
-//line sql.y:5330
+//line sql.y:5652
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -4544,6 +4755,16 @@ func (st *yySymType) createTableUnion() *CreateTable {
 	return v
 }
 
+func (st *yySymType) cteUnion() *CommonTableExpr {
+	v, _ := st.union.(*CommonTableExpr)
+	return v
+}
+
+func (st *yySymType) ctesUnion() []*CommonTableExpr {
+	v, _ := st.union.([]*CommonTableExpr)
+	return v
+}
+
 func (st *yySymType) derivedTableUnion() *DerivedTable {
 	v, _ := st.union.(*DerivedTable)
 	return v
@@ -4564,6 +4785,21 @@ func (st *yySymType) exprsUnion() Exprs {
 	return v
 }
 
+func (st *yySymType) frameClauseUnion() *FrameClause {
+	v, _ := st.union.(*FrameClause)
+	return v
+}
+
+func (st *yySymType) framePointUnion() *FramePoint {
+	v, _ := st.union.(*FramePoint)
+	return v
+}
+
+func (st *yySymType) frameUnitUnion() FrameUnit {
+	v, _ := st.union.(FrameUnit)
+	return v
+}
+
 func (st *yySymType) ignoreUnion() Ignore {
 	v, _ := st.union.(Ignore)
 	return v
@@ -4699,6 +4935,11 @@ func (st *yySymType) partSpecsUnion() []*PartitionSpec {
 	return v
 }
 
+func (st *yySymType) partitionOptionUnion() *PartitionOption {
+	v, _ := st.union.(*PartitionOption)
+	return v
+}
+
 func (st *yySymType) partitionsUnion() Partitions {
 	v, _ := st.union.(Partitions)
 	return v
@@ -4844,148 +5085,164 @@ func (st *yySymType) whensUnion() []*When {
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *WindowSpec {
+	v, _ := st.union.(*WindowSpec)
+	return v
+}
+
+func (st *yySymType) withUnion() *With {
+	v, _ := st.union.(*With)
+	return v
+}
+
 var yyR1 = [...]int{
-	0, 283, 284, 284, 1, 1, 1, 1, 1, 1,
+	0, 299, 300, 300, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 263, 263, 263, 266, 266,
-	21, 50, 3, 3, 3, 3, 2, 2, 8, 9,
-	4, 5, 5, 10, 10, 62, 62, 11, 12, 12,
-	12, 12, 287, 287, 97, 97, 95, 95, 96, 96,
-	164, 164, 13, 14, 14, 174, 174, 173, 173, 173,
-	175, 175, 175, 175, 209, 209, 15, 15, 15, 15,
-	15, 71, 71, 265, 265, 264, 262, 262, 261, 261,
-	260, 23, 24, 33, 33, 33, 33, 34, 35, 267,
-	267, 239, 39, 39, 38, 38, 38, 38, 40, 40,
-	37, 37, 36, 36, 241, 241, 228, 228, 240, 240,
-	240, 240, 240, 240, 240, 227, 227, 85, 85, 145,
-	145, 145, 145, 145, 145, 145, 145, 279, 279, 146,
-	146, 146, 146, 146, 146, 280, 280, 280, 280, 211,
-	211, 211, 211, 214, 214, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 213, 213, 213, 213, 213, 215,
-	215, 215, 215, 215, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 217,
-	217, 217, 217, 217, 217, 217, 217, 226, 226, 218,
-	218, 221, 221, 222, 222, 222, 223, 223, 223, 224,
-	224, 219, 219, 219, 219, 220, 220, 220, 229, 253,
-	253, 252, 252, 250, 250, 250, 250, 238, 238, 247,
-	247, 247, 247, 247, 237, 237, 233, 233, 233, 234,
-	234, 235, 235, 232, 232, 236, 236, 249, 249, 248,
-	230, 230, 231, 231, 255, 281, 281, 281, 281, 282,
-	282, 256, 272, 273, 271, 271, 271, 271, 271, 60,
-	60, 60, 186, 186, 186, 245, 245, 244, 244, 244,
-	246, 246, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	181, 181, 181, 270, 270, 270, 270, 270, 270, 269,
-	269, 269, 242, 242, 242, 268, 268, 131, 131, 132,
-	132, 30, 30, 30, 30, 30, 30, 29, 29, 29,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 31, 31, 26, 26, 26, 26, 26, 26,
-	26, 26, 26, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 225, 225, 225, 257, 257, 258,
-	258, 17, 22, 22, 18, 18, 18, 18, 19, 19,
-	41, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 274, 274,
-	180, 180, 188, 188, 179, 179, 202, 202, 202, 182,
-	182, 182, 183, 183, 278, 278, 278, 43, 43, 45,
-	45, 46, 47, 47, 204, 204, 205, 205, 48, 49,
-	61, 61, 61, 61, 61, 61, 63, 63, 63, 7,
-	7, 7, 7, 57, 57, 57, 6, 6, 44, 44,
-	51, 275, 275, 276, 277, 277, 277, 277, 52, 54,
-	20, 20, 20, 20, 20, 20, 78, 78, 66, 66,
-	66, 66, 66, 66, 66, 66, 66, 66, 66, 66,
-	72, 72, 72, 67, 67, 288, 55, 56, 56, 70,
-	70, 70, 64, 64, 64, 69, 69, 69, 75, 75,
-	77, 77, 77, 77, 77, 79, 79, 79, 79, 79,
-	79, 79, 74, 74, 76, 76, 76, 76, 195, 195,
-	195, 194, 194, 87, 87, 88, 88, 89, 89, 90,
-	90, 90, 129, 105, 105, 162, 162, 161, 161, 163,
-	163, 163, 163, 165, 165, 91, 91, 91, 91, 92,
-	92, 93, 93, 94, 94, 203, 203, 200, 200, 200,
-	199, 199, 98, 98, 98, 100, 99, 99, 99, 99,
-	101, 101, 103, 103, 102, 102, 104, 106, 106, 106,
-	106, 106, 107, 107, 86, 86, 86, 86, 86, 86,
-	86, 86, 177, 177, 109, 109, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 120, 120, 120, 120,
-	120, 120, 110, 110, 110, 110, 110, 110, 110, 73,
-	73, 121, 121, 121, 128, 122, 122, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
-	113, 117, 117, 117, 117, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 289, 289, 119, 118, 118,
-	118, 118, 118, 118, 118, 68, 68, 68, 68, 68,
-	208, 208, 208, 210, 210, 210, 210, 210, 210, 210,
-	210, 210, 210, 210, 210, 210, 135, 135, 65, 65,
-	133, 133, 134, 136, 136, 130, 130, 130, 112, 112,
-	112, 112, 112, 112, 112, 112, 114, 114, 114, 137,
-	137, 138, 138, 139, 139, 140, 140, 141, 142, 142,
-	142, 143, 143, 143, 143, 32, 32, 32, 32, 32,
-	27, 27, 27, 27, 28, 28, 28, 80, 80, 80,
-	80, 82, 82, 81, 81, 58, 58, 59, 59, 59,
-	83, 83, 84, 84, 84, 84, 159, 159, 159, 144,
-	144, 144, 144, 151, 151, 151, 147, 147, 149, 149,
-	149, 150, 150, 150, 148, 156, 156, 158, 158, 157,
-	157, 153, 153, 154, 154, 155, 155, 155, 152, 152,
-	111, 111, 111, 111, 111, 160, 160, 160, 160, 166,
-	166, 124, 124, 126, 126, 125, 127, 167, 167, 171,
-	168, 168, 172, 172, 172, 172, 172, 169, 169, 170,
-	170, 196, 196, 196, 176, 176, 187, 187, 184, 184,
-	185, 185, 178, 178, 189, 189, 189, 53, 123, 123,
-	254, 254, 251, 192, 192, 193, 193, 197, 197, 201,
-	201, 198, 198, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 285, 286, 206, 207,
-	207, 207,
+	1, 1, 1, 1, 1, 279, 279, 279, 282, 282,
+	31, 60, 3, 3, 3, 3, 3, 2, 2, 2,
+	6, 6, 7, 7, 8, 18, 19, 4, 5, 5,
+	20, 20, 72, 72, 21, 22, 22, 22, 22, 304,
+	304, 107, 107, 105, 105, 106, 106, 174, 174, 23,
+	24, 24, 184, 184, 183, 183, 183, 185, 185, 185,
+	185, 219, 219, 25, 25, 25, 25, 25, 81, 81,
+	281, 281, 280, 278, 278, 277, 277, 276, 33, 34,
+	43, 43, 43, 43, 44, 45, 283, 283, 249, 49,
+	49, 48, 48, 48, 48, 50, 50, 47, 47, 46,
+	46, 251, 251, 238, 238, 250, 250, 250, 250, 250,
+	250, 250, 237, 237, 95, 95, 155, 155, 155, 155,
+	155, 155, 155, 155, 295, 295, 156, 156, 156, 156,
+	156, 156, 296, 296, 296, 296, 221, 221, 221, 221,
+	224, 224, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 223, 223, 223, 223, 223, 225, 225, 225, 225,
+	225, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 226, 226, 227, 227, 227, 227,
+	227, 227, 227, 227, 236, 236, 228, 228, 231, 231,
+	232, 232, 232, 233, 233, 233, 234, 234, 229, 229,
+	229, 229, 230, 230, 230, 239, 263, 263, 262, 262,
+	260, 260, 260, 260, 248, 248, 257, 257, 257, 257,
+	257, 247, 247, 243, 243, 243, 244, 244, 245, 245,
+	242, 242, 246, 246, 259, 259, 258, 240, 240, 241,
+	241, 265, 297, 297, 297, 297, 298, 298, 266, 288,
+	289, 287, 287, 287, 287, 287, 70, 70, 70, 196,
+	196, 196, 255, 255, 254, 254, 254, 256, 256, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 191, 191, 191,
+	286, 286, 286, 286, 286, 286, 285, 285, 285, 252,
+	252, 252, 284, 284, 141, 141, 141, 142, 142, 40,
+	40, 40, 40, 40, 40, 39, 39, 39, 35, 35,
+	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+	35, 41, 41, 36, 36, 36, 36, 36, 36, 36,
+	36, 36, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 269, 269, 269, 269, 269, 269, 269, 269,
+	269, 269, 269, 269, 269, 269, 269, 269, 269, 269,
+	269, 269, 269, 269, 235, 235, 235, 267, 267, 268,
+	268, 268, 268, 268, 275, 275, 275, 270, 270, 271,
+	271, 271, 271, 271, 271, 305, 273, 273, 274, 274,
+	272, 272, 27, 32, 32, 28, 28, 28, 28, 29,
+	29, 51, 52, 52, 52, 52, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 290,
+	290, 190, 190, 198, 198, 189, 189, 212, 212, 212,
+	192, 192, 192, 193, 193, 294, 294, 294, 53, 53,
+	55, 55, 56, 57, 57, 214, 214, 215, 215, 58,
+	59, 71, 71, 71, 71, 71, 71, 73, 73, 73,
+	17, 17, 17, 17, 67, 67, 67, 16, 16, 54,
+	54, 61, 291, 291, 292, 293, 293, 293, 293, 62,
+	64, 30, 30, 30, 30, 30, 30, 88, 88, 76,
+	76, 76, 76, 76, 76, 76, 76, 76, 76, 76,
+	76, 82, 82, 82, 77, 77, 306, 65, 66, 66,
+	80, 80, 80, 74, 74, 74, 79, 79, 79, 85,
+	85, 87, 87, 87, 87, 87, 89, 89, 89, 89,
+	89, 89, 89, 84, 84, 86, 86, 86, 86, 205,
+	205, 205, 204, 204, 97, 97, 98, 98, 99, 99,
+	100, 100, 100, 139, 115, 115, 172, 172, 171, 171,
+	173, 173, 173, 173, 175, 175, 101, 101, 101, 101,
+	102, 102, 103, 103, 104, 104, 213, 213, 210, 210,
+	210, 209, 209, 108, 108, 108, 110, 109, 109, 109,
+	109, 111, 111, 113, 113, 112, 112, 114, 116, 116,
+	116, 116, 116, 117, 117, 96, 96, 96, 96, 96,
+	96, 96, 96, 187, 187, 119, 119, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 130, 130, 130,
+	130, 130, 130, 120, 120, 120, 120, 120, 120, 120,
+	83, 83, 131, 131, 131, 138, 132, 132, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 127, 127, 127, 127, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 307, 307, 129,
+	9, 10, 10, 303, 303, 308, 308, 309, 11, 12,
+	12, 13, 13, 13, 14, 14, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 128, 128, 128, 128, 128,
+	128, 128, 78, 78, 78, 78, 78, 218, 218, 218,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 145, 145, 75, 75, 143, 143, 144,
+	146, 146, 140, 140, 140, 122, 122, 122, 122, 122,
+	122, 122, 122, 124, 124, 124, 147, 147, 148, 148,
+	149, 149, 150, 150, 151, 152, 152, 152, 153, 153,
+	153, 153, 42, 42, 42, 42, 42, 37, 37, 37,
+	37, 38, 38, 38, 90, 90, 90, 90, 92, 92,
+	91, 91, 68, 68, 69, 69, 69, 93, 93, 94,
+	94, 94, 94, 169, 169, 169, 154, 154, 154, 154,
+	161, 161, 161, 157, 157, 159, 159, 159, 160, 160,
+	160, 158, 166, 166, 168, 168, 167, 167, 163, 163,
+	164, 164, 165, 165, 165, 162, 162, 121, 121, 121,
+	121, 121, 170, 170, 170, 170, 176, 176, 134, 134,
+	136, 136, 135, 137, 177, 177, 181, 178, 178, 182,
+	182, 182, 182, 182, 179, 179, 180, 180, 206, 206,
+	206, 186, 186, 197, 197, 194, 194, 195, 195, 188,
+	188, 199, 199, 199, 63, 133, 133, 264, 264, 261,
+	202, 202, 203, 203, 207, 207, 211, 211, 208, 208,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 301,
+	302, 216, 217, 217, 217,
 }
 
 var yyR2 = [...]int{
@@ -4993,100 +5250,107 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
-	2, 3, 5, 6, 6, 7, 4, 6, 5, 7,
-	8, 1, 3, 7, 8, 1, 1, 9, 9, 8,
-	7, 7, 1, 1, 1, 3, 1, 3, 1, 3,
-	0, 4, 3, 5, 4, 1, 3, 3, 2, 2,
-	2, 2, 2, 1, 1, 1, 2, 2, 6, 12,
-	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
-	3, 6, 4, 7, 8, 8, 8, 6, 3, 1,
-	1, 4, 0, 1, 1, 1, 2, 2, 0, 1,
-	4, 4, 4, 4, 2, 4, 1, 3, 1, 1,
-	3, 4, 3, 3, 3, 4, 9, 0, 2, 0,
-	2, 3, 3, 4, 2, 3, 2, 1, 1, 0,
-	2, 2, 3, 3, 2, 2, 1, 2, 1, 3,
-	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
-	2, 2, 2, 2, 4, 4, 2, 2, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 6, 6, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
-	3, 0, 5, 0, 3, 5, 0, 1, 1, 0,
-	1, 0, 2, 2, 2, 0, 2, 2, 5, 0,
-	1, 1, 2, 1, 3, 2, 3, 0, 1, 4,
-	3, 3, 4, 2, 0, 2, 1, 1, 1, 1,
-	1, 0, 1, 1, 1, 0, 1, 1, 3, 3,
-	3, 1, 3, 1, 7, 5, 6, 6, 7, 0,
-	1, 5, 3, 3, 1, 1, 2, 2, 2, 0,
-	1, 1, 0, 1, 2, 0, 1, 1, 3, 2,
-	1, 2, 3, 3, 4, 4, 3, 3, 3, 3,
-	4, 4, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 4, 5,
-	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 0, 2, 0,
-	2, 0, 1, 5, 1, 3, 7, 1, 3, 3,
-	1, 2, 2, 2, 5, 5, 5, 6, 6, 5,
-	5, 2, 2, 2, 2, 3, 3, 3, 4, 1,
-	3, 5, 1, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 2, 4, 4, 2, 11, 3, 6,
+	2, 3, 5, 6, 6, 6, 7, 4, 5, 6,
+	2, 3, 1, 3, 4, 5, 7, 9, 1, 3,
+	7, 8, 1, 1, 9, 9, 8, 7, 7, 1,
+	1, 1, 3, 1, 3, 1, 3, 0, 4, 3,
+	5, 4, 1, 3, 3, 2, 2, 2, 2, 2,
+	1, 1, 1, 2, 2, 6, 12, 2, 0, 2,
+	0, 2, 1, 0, 2, 1, 3, 3, 6, 4,
+	7, 8, 8, 8, 6, 3, 1, 1, 5, 0,
+	1, 1, 1, 2, 2, 0, 1, 4, 4, 4,
+	4, 2, 4, 1, 3, 1, 1, 3, 4, 3,
+	3, 3, 4, 9, 0, 2, 0, 2, 3, 3,
+	4, 2, 3, 2, 1, 1, 0, 2, 2, 3,
+	3, 2, 2, 1, 2, 1, 3, 1, 1, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
+	2, 4, 4, 2, 2, 3, 3, 3, 3, 1,
+	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
+	0, 3, 5, 0, 1, 1, 0, 1, 0, 2,
+	2, 2, 0, 2, 2, 5, 0, 1, 1, 2,
+	1, 3, 2, 3, 0, 1, 4, 3, 3, 4,
+	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 0, 1, 1, 3, 3, 3, 1, 3,
+	1, 7, 5, 6, 6, 7, 0, 1, 5, 3,
+	3, 1, 1, 2, 2, 2, 0, 1, 1, 0,
+	1, 2, 0, 1, 1, 3, 2, 1, 2, 3,
+	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 4, 5, 0, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 1, 0, 1, 2, 0, 2, 0,
+	1, 5, 1, 3, 7, 1, 3, 3, 1, 2,
+	2, 2, 5, 5, 5, 6, 6, 5, 5, 2,
+	2, 2, 2, 3, 3, 3, 4, 3, 1, 3,
+	5, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	2, 2, 2, 4, 4, 2, 3, 11, 3, 6,
 	8, 6, 6, 6, 13, 8, 6, 10, 5, 5,
 	5, 5, 5, 3, 7, 4, 4, 4, 4, 3,
 	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 0, 2, 2, 1, 3, 8,
-	8, 3, 3, 5, 7, 7, 6, 5, 3, 2,
-	3, 3, 3, 7, 3, 3, 3, 3, 4, 7,
-	5, 2, 4, 4, 4, 4, 4, 5, 5, 4,
+	3, 3, 3, 2, 0, 2, 2, 1, 3, 9,
+	9, 7, 8, 3, 0, 3, 4, 0, 1, 9,
+	9, 8, 9, 8, 9, 1, 0, 1, 0, 2,
+	0, 3, 3, 3, 5, 7, 7, 6, 5, 3,
+	2, 3, 3, 3, 7, 3, 3, 3, 3, 4,
+	7, 5, 2, 4, 4, 4, 4, 4, 5, 5,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	2, 4, 2, 4, 5, 4, 4, 4, 4, 4,
-	3, 3, 5, 2, 3, 3, 3, 3, 1, 1,
-	0, 1, 0, 1, 1, 1, 0, 2, 2, 0,
-	2, 2, 0, 2, 0, 1, 1, 2, 1, 1,
-	2, 1, 1, 5, 0, 1, 0, 1, 2, 3,
-	0, 3, 3, 3, 3, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 3, 3, 2, 2,
-	3, 1, 3, 2, 1, 2, 1, 2, 2, 4,
-	3, 3, 6, 4, 7, 6, 1, 3, 2, 2,
-	2, 2, 1, 1, 1, 3, 2, 1, 1, 1,
-	0, 1, 1, 0, 3, 0, 2, 0, 2, 1,
-	2, 2, 0, 1, 1, 0, 1, 1, 0, 1,
-	0, 1, 2, 3, 4, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 1, 2, 3, 5, 0, 1,
-	2, 1, 1, 0, 2, 1, 3, 1, 1, 1,
-	3, 3, 3, 3, 7, 0, 3, 1, 3, 1,
-	1, 3, 3, 1, 3, 4, 4, 4, 3, 2,
-	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
-	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
-	2, 2, 2, 1, 1, 3, 3, 0, 5, 4,
-	5, 5, 0, 2, 1, 3, 3, 3, 2, 3,
-	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
-	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
-	2, 1, 1, 1, 3, 1, 3, 1, 1, 1,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
-	1, 4, 5, 5, 6, 4, 4, 6, 6, 6,
-	8, 8, 8, 8, 9, 8, 5, 4, 2, 2,
+	4, 2, 4, 2, 4, 5, 4, 4, 4, 4,
+	4, 3, 3, 5, 2, 3, 3, 3, 3, 1,
+	1, 0, 1, 0, 1, 1, 1, 0, 2, 2,
+	0, 2, 2, 0, 2, 0, 1, 1, 2, 1,
+	1, 2, 1, 1, 5, 0, 1, 0, 1, 2,
+	3, 0, 3, 3, 3, 3, 1, 1, 1, 1,
+	1, 1, 1, 1, 0, 1, 1, 3, 3, 2,
+	2, 3, 1, 3, 2, 1, 2, 1, 2, 2,
+	4, 3, 3, 6, 4, 7, 6, 1, 3, 2,
+	2, 2, 2, 1, 1, 1, 3, 2, 1, 1,
+	1, 0, 1, 1, 0, 3, 0, 2, 0, 2,
+	1, 2, 2, 0, 1, 1, 0, 1, 1, 0,
+	1, 0, 1, 2, 3, 4, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 1, 2, 3, 5, 0,
+	1, 2, 1, 1, 0, 2, 1, 3, 1, 1,
+	1, 3, 3, 3, 3, 7, 0, 3, 1, 3,
+	1, 1, 3, 3, 1, 3, 4, 4, 4, 3,
+	2, 4, 0, 1, 0, 2, 0, 1, 0, 1,
+	2, 1, 1, 1, 2, 2, 1, 2, 3, 2,
+	3, 2, 2, 2, 1, 1, 3, 3, 0, 5,
+	4, 5, 5, 0, 2, 1, 3, 3, 3, 2,
+	3, 1, 2, 0, 3, 1, 1, 3, 3, 4,
+	4, 5, 3, 4, 5, 6, 2, 1, 2, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 3, 1, 3, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 3, 1, 1,
+	1, 1, 1, 4, 5, 5, 6, 4, 4, 6,
+	6, 6, 8, 8, 8, 8, 9, 8, 5, 4,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
-	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
-	1, 1, 1, 2, 3, 3, 1, 2, 2, 1,
-	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
-	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
-	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
-	1, 0, 2, 4, 4, 0, 2, 2, 1, 1,
-	3, 3, 3, 3, 3, 3, 3, 0, 3, 3,
-	3, 0, 3, 1, 1, 0, 4, 0, 1, 1,
-	0, 3, 1, 3, 2, 1, 0, 2, 4, 0,
-	9, 3, 5, 0, 3, 3, 0, 1, 0, 2,
-	2, 0, 2, 2, 2, 0, 2, 1, 2, 3,
-	3, 0, 2, 1, 2, 3, 4, 3, 0, 1,
-	2, 1, 5, 4, 4, 1, 3, 3, 5, 0,
-	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
-	1, 3, 3, 3, 3, 3, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 0, 2,
-	0, 3, 0, 1, 0, 1, 1, 5, 0, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 0,
+	2, 2, 2, 2, 2, 8, 8, 0, 2, 3,
+	2, 4, 2, 0, 2, 1, 3, 5, 3, 0,
+	3, 0, 2, 5, 1, 1, 2, 2, 2, 2,
+	2, 2, 2, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 0, 3, 4, 7, 3, 1, 1, 1,
+	2, 3, 3, 1, 2, 2, 1, 2, 1, 2,
+	2, 1, 2, 0, 1, 0, 2, 1, 2, 4,
+	0, 2, 1, 3, 5, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 0, 3, 0, 2,
+	0, 3, 1, 3, 2, 0, 1, 1, 0, 2,
+	4, 4, 0, 2, 2, 1, 1, 3, 3, 3,
+	3, 3, 3, 3, 0, 3, 3, 3, 0, 3,
+	1, 1, 0, 4, 0, 1, 1, 0, 3, 1,
+	3, 2, 1, 0, 2, 4, 0, 9, 3, 5,
+	0, 3, 3, 0, 1, 0, 2, 2, 0, 2,
+	2, 2, 0, 2, 1, 2, 3, 3, 0, 2,
+	1, 2, 3, 4, 3, 0, 1, 2, 1, 5,
+	4, 4, 1, 3, 3, 5, 0, 5, 1, 3,
+	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
+	3, 3, 3, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 0, 2, 0, 3, 0,
+	1, 0, 1, 1, 5, 0, 1, 0, 1, 2,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5128,492 +5392,519 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 0,
-	1, 1,
+	1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -283, -1, -3, -8, -9, -10, -11, -12, -13,
-	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
-	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -54, -4, -285, 6, 7, 8, -62, 10,
-	11, 30, -23, -33, 160, -34, -24, 161, -35, 163,
-	162, 198, 164, 191, 72, 237, 238, 240, 241, 242,
-	243, -63, 196, 197, 166, 34, 43, 31, 32, 35,
-	169, 82, 9, 341, 193, 192, 26, -284, 481, -70,
-	5, -139, 16, -3, -55, -288, -55, -55, -55, -55,
-	-55, -55, -239, -241, 82, 133, 82, -55, -39, -38,
-	-37, -36, -40, 29, -30, -31, -259, -29, -26, 165,
-	162, 206, 103, 104, 198, 199, 200, 164, 182, 197,
-	201, 196, 215, -25, 78, 31, 354, 357, -246, 161,
-	167, 168, 342, 106, 105, 73, 163, -243, 287, 458,
-	-40, 460, 96, 98, 459, 42, 172, 461, 462, 463,
-	464, 181, 465, 466, 467, 468, 474, 475, 476, 477,
-	107, 5, -55, -201, -197, -263, -191, 85, 86, 87,
-	351, 184, 385, 386, 231, 78, 39, 287, 458, 237,
-	251, 245, 272, 264, 352, 387, 234, 185, 219, 455,
-	262, 111, 460, 388, 199, 292, 293, 294, 96, 240,
-	328, 473, 236, 389, 471, 98, 459, 77, 49, 42,
-	194, 260, 256, 461, 220, 390, 362, 213, 106, 103,
-	480, 34, 254, 48, 28, 470, 105, 47, 462, 124,
-	391, 463, 296, 277, 449, 46, 297, 200, 392, 81,
-	356, 457, 298, 255, 299, 230, 469, 166, 393, 441,
-	300, 301, 450, 394, 278, 282, 395, 320, 302, 50,
-	396, 397, 451, 104, 398, 76, 464, 249, 250, 399,
-	228, 183, 322, 276, 181, 33, 303, 353, 232, 56,
-	207, 323, 44, 280, 43, 445, 400, 448, 275, 271,
-	51, 401, 402, 403, 404, 465, 274, 248, 270, 479,
-	225, 466, 60, 168, 284, 283, 285, 214, 319, 267,
-	405, 406, 407, 188, 79, 408, 257, 19, 409, 410,
-	304, 221, 411, 54, 412, 413, 326, 197, 414, 52,
-	467, 37, 202, 468, 415, 416, 417, 418, 419, 306,
-	420, 305, 279, 281, 209, 307, 355, 421, 253, 201,
-	472, 422, 189, 456, 203, 206, 196, 327, 190, 423,
-	424, 425, 426, 427, 235, 428, 429, 241, 474, 41,
-	430, 431, 432, 433, 229, 224, 321, 330, 59, 80,
-	289, 434, 454, 247, 222, 435, 238, 53, 475, 476,
-	477, 216, 478, 7, 309, 107, 226, 227, 45, 268,
-	208, 436, 437, 258, 259, 273, 246, 269, 239, 442,
-	210, 310, 198, 438, 329, 223, 290, 359, 215, 311,
-	453, 358, 266, 263, 217, 439, 312, 173, 211, 212,
-	440, 443, 313, 314, 316, 317, 233, 315, 318, 218,
-	357, 244, 261, 291, 171, -55, 171, -102, -197, 171,
-	-169, 293, -188, 295, 308, 303, 313, 301, -180, 304,
-	306, 209, -278, 320, 171, 310, 160, 151, 296, 305,
-	314, 315, 233, 318, 218, -274, -263, 463, 478, 111,
-	265, 300, 298, 321, 445, 317, 316, -197, 239, -204,
-	244, -192, -263, -191, 242, -102, -61, 441, 164, -206,
-	-206, -72, 445, 447, -122, -86, -108, 117, -113, 29,
-	24, -112, -109, -130, -127, -128, 151, 152, 154, 153,
-	155, 140, 141, 148, 118, 156, -117, -115, -116, -118,
+	-1000, -299, -1, -3, -18, -19, -20, -21, -22, -23,
+	-24, -25, -26, -27, -28, -29, -51, -52, -53, -55,
+	-56, -57, -58, -59, -16, -54, -30, -31, -60, -61,
+	-62, -63, -64, -4, -6, -301, 6, 7, 8, -72,
+	10, 11, 30, -33, -43, 163, -44, -34, 164, -45,
+	166, 165, 201, 167, 194, 72, 241, 242, 244, 245,
+	246, 247, -73, 199, 200, 169, 34, 43, 31, 32,
+	35, 172, 358, 82, 9, 345, 196, 195, 26, -300,
+	489, -80, 5, -149, 16, -4, 6, -3, -65, -306,
+	-65, -65, -65, -65, -65, -65, -249, -251, 82, 136,
+	82, -65, -49, -48, -47, -46, -50, 29, -40, -41,
+	-269, -39, -36, 168, 165, 208, 103, 104, 201, 202,
+	203, 167, 185, 200, 204, 199, 220, -35, 78, 31,
+	358, 361, -256, 164, 170, 171, 346, 106, 105, 73,
+	166, -253, 291, 466, -50, 468, 96, 98, 467, 42,
+	175, 469, 470, 471, 472, 184, 473, 474, 475, 476,
+	482, 483, 484, 485, 107, 5, -65, -211, -207, -279,
+	-201, 85, 86, 87, 355, 187, 393, 394, 112, 78,
+	39, 291, 466, 241, 255, 249, 276, 268, 356, 395,
+	238, 188, 224, 463, 266, 111, 468, 396, 202, 296,
+	297, 298, 96, 244, 332, 481, 240, 397, 479, 98,
+	467, 77, 49, 366, 42, 197, 264, 260, 469, 225,
+	398, 370, 218, 106, 103, 488, 34, 258, 48, 28,
+	478, 105, 47, 470, 127, 399, 471, 300, 281, 457,
+	46, 301, 203, 400, 81, 360, 465, 302, 259, 303,
+	235, 477, 169, 401, 449, 304, 305, 458, 402, 282,
+	286, 403, 324, 306, 50, 404, 405, 459, 104, 406,
+	76, 472, 253, 254, 407, 233, 186, 326, 280, 184,
+	33, 307, 357, 236, 56, 209, 327, 44, 284, 214,
+	43, 453, 408, 456, 279, 275, 51, 409, 410, 411,
+	412, 473, 278, 252, 274, 487, 230, 474, 60, 171,
+	288, 287, 289, 219, 323, 271, 413, 414, 415, 191,
+	79, 416, 261, 19, 417, 418, 308, 226, 419, 54,
+	420, 421, 330, 200, 422, 52, 475, 37, 205, 215,
+	476, 423, 424, 425, 426, 427, 310, 428, 309, 283,
+	285, 211, 311, 359, 429, 257, 204, 480, 430, 192,
+	464, 113, 208, 199, 331, 193, 431, 432, 433, 434,
+	435, 239, 436, 437, 245, 364, 363, 482, 41, 438,
+	439, 440, 441, 234, 229, 325, 334, 59, 80, 293,
+	442, 462, 251, 227, 443, 242, 53, 483, 484, 485,
+	221, 486, 7, 313, 107, 231, 232, 45, 272, 210,
+	444, 445, 262, 263, 277, 250, 273, 243, 450, 212,
+	314, 201, 446, 333, 228, 294, 367, 220, 315, 461,
+	362, 270, 267, 222, 447, 316, 176, 216, 217, 448,
+	451, 317, 318, 320, 321, 237, 319, 322, 223, 361,
+	248, 265, 295, 174, -65, 174, -112, -207, 174, -179,
+	297, -198, 299, 312, 307, 317, 305, -190, 308, 310,
+	211, -294, 324, 174, 314, 163, 154, 300, 309, 318,
+	319, 237, 322, 223, -290, -279, 471, 486, 111, 269,
+	304, 302, 325, 453, 321, 320, -207, 243, -214, 248,
+	-202, -279, -201, 246, -112, -71, 449, 167, -216, -216,
+	-82, 453, 455, -132, -96, -118, 120, -123, 29, 24,
+	-122, -119, -140, -137, -138, 154, 155, 157, 156, 158,
+	143, 144, 151, 121, 159, -127, -125, -126, -128, -9,
 	89, 88, 97, 90, 91, 92, 93, 99, 100, 101,
-	-192, -197, -125, -285, 66, 67, 342, 343, 344, 345,
-	350, 346, 120, 55, 337, 331, 340, 339, 338, 335,
-	336, 333, 334, 348, 349, 176, 332, 170, 146, 341,
-	-263, -191, 42, 309, 309, -102, -55, -5, -4, -285,
-	6, 21, 22, -143, 18, 17, -286, 84, -64, -77,
-	61, 62, -79, 22, 36, 65, 63, 21, -56, -76,
-	142, -86, -197, -76, -178, 175, -178, -178, -168, -209,
-	239, -172, 321, 320, -193, -170, -192, -190, -169, 319,
-	165, 360, 116, 23, 25, 119, 151, 17, 120, 35,
-	167, 265, 182, 150, 178, 342, 160, 70, 361, 333,
-	334, 331, 337, 344, 345, 332, 295, 29, 11, 363,
-	26, 192, 22, 36, 144, 162, 123, 195, 24, 193,
-	101, 366, 20, 73, 187, 12, 180, 38, 14, 367,
-	368, 15, 176, 175, 135, 172, 68, 9, 156, 27,
-	132, 64, 369, 113, 370, 371, 372, 373, 66, 133,
-	18, 335, 336, 31, 446, 374, 350, 204, 146, 71,
-	57, 447, 117, 375, 376, 99, 377, 102, 74, 452,
-	114, 16, 69, 40, 378, 205, 379, 177, 380, 324,
-	381, 134, 163, 341, 67, 382, 170, 308, 6, 347,
-	30, 191, 179, 109, 65, 383, 171, 122, 348, 349,
-	174, 100, 5, 112, 32, 10, 72, 75, 338, 339,
-	340, 55, 108, 354, 121, 13, 384, 325, 115, 111,
-	-240, 133, -227, -231, -192, 186, -256, 182, -102, -249,
-	-248, -192, -71, -187, 172, 180, 179, 112, -267, 114,
-	226, 332, 170, -36, -37, -169, 150, 203, 83, 83,
-	-231, -230, -229, -268, 205, 186, -255, -247, 178, 187,
-	-237, 179, 180, -232, 172, 113, -268, -232, 177, 187,
-	205, 205, 107, 205, 107, 205, 205, 205, 205, 205,
-	205, 205, 205, 205, 202, -238, 125, -238, 358, 358,
-	-243, -268, -268, -268, 174, 33, 33, -189, -232, 174,
-	23, -238, -238, -169, 150, -238, -238, -238, -238, 213,
-	213, -238, -238, -238, -238, -238, -238, -238, -238, -238,
-	-238, -238, -238, -238, -238, -238, 171, -267, -80, 318,
-	233, 78, -38, 215, -22, -102, -187, 172, 173, -267,
-	-102, 157, -102, -182, 133, 13, -182, -179, 309, 307,
-	294, 299, -182, -182, -182, -182, 216, 292, -233, 172,
-	33, 183, 309, 216, 292, 216, 217, 216, 217, 302,
-	312, 216, -202, 12, 135, 332, 297, 301, 209, 171,
-	210, 173, 311, -263, 448, 217, -202, 89, 309, 212,
-	-182, -207, -285, -193, 265, -207, -207, 30, 174, -192,
-	-57, -192, 89, -7, -3, -11, -10, -12, 125, -78,
-	309, -66, 151, 463, 449, 450, 451, 448, 306, 456,
-	454, 452, 216, 453, 83, 116, 114, 115, 132, -86,
-	-110, 135, 117, 133, 134, 119, 137, 136, 147, 140,
-	141, 142, 143, 144, 145, 146, 138, 139, 150, 125,
-	126, 127, 128, 129, 130, 131, -177, -285, -128, -285,
-	158, 159, -113, -113, -113, -113, -113, -113, -113, -113,
-	-113, -113, -285, 157, -2, -122, -4, -285, -285, -285,
-	-285, -285, -285, -285, -285, -135, -86, -285, -289, -285,
-	-289, -119, -285, -289, -119, -289, -119, -289, -289, -119,
-	-289, -119, -289, -289, -119, -285, -285, -285, -285, -285,
-	-285, -285, -206, -275, -276, -105, -102, -285, 233, -139,
-	-3, -55, -159, 20, 31, -86, -140, -141, -86, -139,
-	57, -74, -76, -79, 61, 62, 95, 12, -195, -194,
-	23, -192, 89, 157, 12, -103, 27, -102, -88, -89,
-	-90, -91, -105, -129, -285, 12, -95, -96, -102, -104,
-	-197, 83, 239, -172, -209, -174, -173, 322, 324, 125,
-	-196, -192, 89, 29, 84, 83, -102, -211, -214, -216,
-	-215, -217, -212, -213, 262, 263, 151, 266, 268, 269,
-	270, 271, 272, 273, 274, 275, 276, 277, 30, 194,
-	258, 259, 260, 261, 278, 279, 280, 281, 282, 283,
-	284, 285, 245, 264, 352, 246, 247, 248, 249, 250,
-	251, 253, 254, 255, 256, 257, -266, -263, 82, 84,
-	83, -218, 82, -80, 171, -263, 172, 172, 172, -55,
-	341, -238, -238, 202, -29, -26, -259, 16, -25, -26,
-	165, 103, 104, 162, 82, -227, 82, -236, -266, -263,
-	82, 113, 177, 112, -235, -232, -235, -236, -263, -130,
-	-192, -197, -263, 113, 113, -165, -192, -165, -165, 21,
-	-165, 21, -165, 21, 90, -192, -165, 21, -165, 21,
-	-165, 21, -165, 21, -165, 21, 29, 76, 77, 29,
-	79, 80, 81, -130, -130, -227, -169, -102, -263, 90,
-	90, -238, -238, 90, 89, 89, 89, -238, -238, 90,
-	89, -199, -197, 89, -269, 188, 230, 232, 90, 90,
-	90, 90, 29, 89, -270, 29, 470, 469, 471, 472,
-	473, 90, 29, 90, 29, 90, -192, 82, -102, -83,
-	220, 160, 162, 165, 74, 89, 234, 125, 42, 83,
-	174, 171, -263, -184, 176, -184, -198, -197, -190, 89,
-	-86, -234, 12, 135, -202, -202, -182, -102, -234, -202,
-	-182, -102, -182, -182, -182, -182, -202, -202, -202, -182,
-	-197, -197, -102, -102, -102, -102, -102, -102, -102, -207,
-	-207, -207, -183, 133, -182, 448, 74, -205, 242, 276,
-	442, 443, 444, 83, 354, -95, 448, 448, 448, 448,
-	448, 448, -86, -86, -86, -86, -120, 99, 117, 100,
-	101, -113, -121, -125, -128, 94, 135, 133, 134, 119,
-	-113, -113, -113, -113, -113, -113, -113, -113, -113, -113,
-	-113, -113, -113, -113, -113, -208, -263, 89, 151, -263,
-	-112, -112, -192, -75, 22, 36, -74, -193, -198, -190,
-	-70, -286, -286, -139, -74, -74, -86, -86, -130, 89,
-	-74, -130, 89, -74, -74, -69, 22, 36, -133, -134,
-	121, -130, -286, -113, -192, -192, -74, -75, -75, -74,
-	-74, 83, -277, 324, 325, 446, -200, 205, -199, 23,
-	-123, -122, 89, -143, -286, -144, 27, 10, 135, 83,
-	19, 83, -142, 25, 26, -143, -114, -192, 90, 93,
-	-87, 83, 12, -79, -102, -194, 142, -198, -102, -164,
-	205, -102, 30, 83, -98, -100, -99, -101, 64, 68,
-	70, 65, 66, 67, 71, -203, 23, -88, -3, -285,
-	-102, -95, -287, 83, 12, 75, -287, 83, 157, -172,
-	-174, 83, 323, 325, 326, 74, 102, -86, -220, 150,
-	-245, -244, -243, -227, -229, -230, -231, 84, -145, -85,
-	38, -223, 290, 289, -218, -218, -218, -218, -218, -219,
-	-169, -219, -219, -219, 82, 82, -218, -218, -218, -218,
-	-221, 82, -221, -221, -222, 82, -222, -256, -86, -253,
-	-252, -250, -251, 181, 96, 354, 75, -248, -142, 90,
-	-83, -185, 176, -254, -251, -263, -263, -263, -185, -263,
-	89, -263, 89, 83, 17, -228, -227, -131, 230, -258,
-	205, -255, -249, -236, 113, -235, -236, -236, 157, -263,
-	83, 27, 107, 107, 107, 107, 354, 162, 30, -227,
-	-131, -208, 174, -208, -208, 89, 89, -181, 478, -95,
-	-82, 222, 125, 211, 211, 171, 171, 224, -102, 235,
-	236, 234, 21, 223, 225, 227, 213, -102, -102, -184,
-	74, -97, -102, 24, -197, -102, -182, -182, -102, -182,
-	-182, 89, -102, -192, -66, 324, 354, 20, -67, 20,
-	99, 100, 101, -121, -113, -113, -113, -73, 195, 116,
-	-286, -286, -74, -74, -285, 157, -5, -143, -286, -286,
-	83, 75, 23, 12, 12, -286, 12, 12, -286, -286,
-	-74, -136, -134, 123, -86, -286, -286, 83, 83, -286,
-	-286, -286, -286, -286, -276, 445, 325, -106, 72, 175,
-	73, -285, -199, -286, -159, 40, 48, 59, -86, -86,
-	-141, -159, -176, 20, 12, 55, 55, -107, 13, -76,
-	-88, -79, 157, -107, -111, 30, 55, -3, -285, -285,
-	-167, -171, -130, -89, -90, -90, -89, -90, 64, 64,
-	64, 69, 64, 69, 64, -99, -197, -286, -286, -3,
-	-164, 75, -88, -102, -88, -104, -197, 142, -173, -175,
-	327, 324, 330, -263, 89, 83, -243, -231, -282, 99,
-	117, 29, 74, 287, 96, -280, -281, 177, 112, 113,
-	189, 23, 39, -224, 291, -219, -219, -220, -263, 89,
-	151, -220, -220, -220, -226, 89, -226, 90, 90, 84,
-	-32, -27, -28, 31, 78, -250, -238, 89, 37, -192,
-	84, -82, -102, 117, 74, -254, -254, -254, -197, 16,
-	-161, -192, 83, 84, -132, 231, -130, 84, -192, 84,
-	82, -236, -236, -193, -192, -285, 171, 29, 29, -131,
-	-132, -220, -263, 480, 479, 84, 173, 229, -84, 337,
-	89, 85, -102, -102, -102, -102, -102, 165, 162, 214,
-	174, -95, -102, 83, -60, 190, 185, -202, -202, 31,
-	324, 457, 455, -73, 116, -113, -113, -286, -286, -75,
-	-193, -139, -159, -210, 151, 262, 194, 260, 256, 276,
-	267, 289, 258, 290, -208, -210, -113, -113, -113, -113,
-	351, -139, 124, -86, 122, -113, -113, 172, 172, 172,
-	-165, 41, 89, 89, 60, -102, -137, 14, -86, 142,
-	-143, -166, 74, -167, -124, -126, -125, -285, -160, -286,
-	-192, -165, -107, 83, 125, -93, -92, 74, 75, -94,
-	74, -92, 64, 64, -286, -107, -88, -107, -107, 157,
-	324, 328, 329, -243, 99, -113, 10, 89, 113, 113,
-	-102, 82, -220, -220, 84, 83, 84, 83, 84, 83,
-	-186, 391, 117, -28, -27, -238, -238, 90, -263, 173,
-	24, -102, 74, 74, 74, 17, 83, -227, -130, 55,
-	-253, -161, -257, -258, -102, -112, -132, -102, -81, 220,
-	228, 82, 86, -265, 75, 211, 287, 211, -102, -60,
-	-32, -102, -182, -182, 31, -263, -113, -286, -143, -286,
-	-218, -218, -218, -222, -218, 250, -218, 250, -286, -286,
-	20, 20, 20, 20, -285, -65, 347, -86, 83, 83,
-	-285, -285, -285, -286, 89, -219, -138, 15, 17, 28,
-	-166, 83, -286, -286, 83, 55, 157, -286, -139, -171,
-	-86, -86, 82, -86, -139, -107, -116, 82, -113, -219,
-	89, -219, 90, 90, 391, 29, 79, 80, 81, 29,
-	76, 77, -102, -102, -102, -102, -161, -192, 207, 84,
-	-286, 83, -225, 354, 357, -162, 82, 84, -262, 354,
-	-264, -263, -192, -192, -192, -159, -219, -263, -113, -113,
-	-113, -113, -113, -143, 89, -113, -113, -163, -286, -192,
-	177, -163, -163, -200, -219, -148, -153, -179, -86, -122,
-	113, -126, 55, -3, -192, -124, -192, -143, -161, -143,
-	-161, 84, -220, -220, 84, 84, -162, 208, -281, -258,
-	358, 358, 23, -161, -261, -260, -193, 82, 75, -286,
-	-286, -286, -286, -68, 135, 354, -286, -286, -286, 83,
-	-286, -286, -286, -106, -151, 441, -156, 44, -154, -155,
-	45, -152, 46, 54, 10, -124, 157, 84, 84, -146,
-	23, -285, -3, 84, 83, 125, -161, -102, -286, 352,
-	71, 355, -192, 177, -148, 49, 268, -158, -157, 53,
-	45, -155, 17, 47, 17, -167, -192, -272, -273, 74,
-	-282, -279, 99, 117, 96, -280, 108, 109, -3, -113,
-	204, -58, 354, -260, -242, -193, 89, 90, 84, 60,
-	353, 356, -149, 51, -147, 50, -147, -157, 17, 17,
-	89, 17, 89, -273, 74, 11, 10, 99, 89, -58,
-	-286, -286, -59, 219, 445, -265, 60, -150, 52, 74,
-	102, 89, 89, 89, -271, 190, 185, 188, 30, -271,
-	182, -262, 354, 74, 102, 184, 29, 99, 221, 355,
-	356,
+	-202, -207, -135, -301, 66, 67, 346, 347, 348, 349,
+	354, 350, 123, 55, 341, 335, 344, 343, 342, 339,
+	340, 337, 338, 352, 353, 179, 336, 173, 149, 345,
+	-279, -201, 42, 313, 313, -112, -65, -7, 389, -8,
+	-207, -5, -4, -301, 21, 22, -153, 18, 17, -149,
+	-65, -302, 84, -74, -87, 61, 62, -89, 22, 36,
+	65, 63, 21, -66, -86, 145, -96, -207, -86, -188,
+	178, -188, -188, -178, -219, 243, -182, 325, 324, -203,
+	-180, -202, -200, -179, 323, 168, 368, 119, 23, 25,
+	122, 154, 17, 123, 35, 170, 269, 185, 153, 181,
+	346, 163, 70, 369, 337, 338, 335, 341, 348, 349,
+	336, 299, 29, 11, 371, 26, 195, 22, 36, 147,
+	165, 126, 198, 24, 196, 101, 374, 20, 73, 190,
+	12, 183, 38, 14, 375, 376, 15, 179, 178, 138,
+	175, 68, 9, 159, 27, 135, 64, 377, 116, 378,
+	379, 380, 381, 66, 136, 18, 339, 340, 31, 454,
+	382, 354, 206, 149, 71, 57, 455, 120, 383, 384,
+	99, 385, 102, 74, 460, 117, 16, 69, 40, 386,
+	207, 387, 180, 388, 328, 389, 137, 166, 345, 67,
+	390, 365, 173, 312, 6, 351, 30, 194, 182, 109,
+	65, 391, 174, 125, 352, 353, 177, 100, 5, 115,
+	32, 10, 72, 75, 342, 343, 344, 55, 108, 358,
+	124, 13, 392, 329, 118, 111, -250, 136, -237, -241,
+	-202, 189, -266, 185, -112, -259, -258, -202, -81, -197,
+	175, 183, 182, 115, -283, 117, 231, 336, 173, -46,
+	-47, -179, 153, 113, -271, 207, 83, 83, -241, -240,
+	-239, -284, 207, 189, -265, -257, 181, 190, -247, 182,
+	183, -242, 175, 116, -284, -242, 180, 190, 185, 207,
+	207, 107, 207, 107, 207, 207, 207, 207, 207, 207,
+	207, 207, 207, 205, -248, 128, -248, 362, 362, -253,
+	-284, -284, -284, 177, 33, 33, -199, -242, 177, 23,
+	-248, -248, -179, 153, -248, -248, -248, -248, 218, 218,
+	-248, -248, -248, -248, -248, -248, -248, -248, -248, -248,
+	-248, -248, -248, -248, -248, 174, -283, -90, 322, 237,
+	78, -48, 220, -32, -112, -197, 175, 176, -283, -112,
+	160, -112, -192, 136, 13, -192, -189, 313, 311, 298,
+	303, -192, -192, -192, -192, 221, 296, -243, 175, 33,
+	186, 313, 221, 296, 221, 222, 221, 222, 306, 316,
+	221, -212, 12, 138, 336, 301, 305, 211, 174, 212,
+	176, 315, -279, 456, 222, -212, 89, 313, 217, -192,
+	-217, -301, -203, 269, -217, -217, 30, 177, -202, -67,
+	-202, 89, -17, -3, -21, -20, -22, 128, -88, 313,
+	-76, 154, 471, 457, 458, 459, 456, 310, 464, 462,
+	460, 221, 461, 83, 119, 117, 118, 135, -96, -120,
+	138, 120, 136, 137, 122, 140, 139, 150, 143, 144,
+	145, 146, 147, 148, 149, 141, 142, 153, 128, 129,
+	130, 131, 132, 133, 134, -187, -301, -138, -301, 161,
+	162, -123, -123, -123, -123, -123, -123, -123, -123, -123,
+	-123, -10, 386, -301, 160, -2, -132, -4, -6, -301,
+	-301, -301, -301, -301, -301, -301, -301, -145, -96, -301,
+	-307, -301, -307, -129, -301, -307, -129, -307, -129, -307,
+	-307, -129, -307, -129, -307, -307, -129, -301, -301, -301,
+	-301, -301, -301, -301, -216, -291, -292, -115, -112, -301,
+	237, 83, -7, -172, 82, -149, -3, -169, 20, 31,
+	-96, -150, -151, -96, -153, 61, 62, -149, 57, -84,
+	-86, -89, 95, 12, -205, -204, 23, -202, 89, 160,
+	12, -113, 27, -112, -98, -99, -100, -101, -115, -139,
+	-301, 12, -105, -106, -112, -114, -207, 83, 243, -182,
+	-219, -184, -183, 326, 328, 128, -206, -202, 89, 29,
+	84, 83, -112, -221, -224, -226, -225, -227, -222, -223,
+	266, 267, 154, 270, 272, 273, 274, 275, 276, 277,
+	278, 279, 280, 281, 30, 197, 262, 263, 264, 265,
+	282, 283, 284, 285, 286, 287, 288, 289, 249, 268,
+	356, 250, 251, 252, 253, 254, 255, 257, 258, 259,
+	260, 261, -282, -279, 82, 84, 83, -228, 82, -90,
+	174, -279, 175, 175, 175, -65, 345, -248, -248, 205,
+	17, -39, -36, -269, 16, -35, -36, 168, 103, 104,
+	165, 82, -237, -301, -246, -282, -279, 82, 116, 180,
+	115, -245, -242, -245, -246, -279, -140, -202, -207, -279,
+	116, 116, -279, -175, -202, -175, -175, 21, -175, 21,
+	-175, 21, 90, -202, -175, 21, -175, 21, -175, 21,
+	-175, 21, -175, 21, 29, 76, 77, 29, 79, 80,
+	81, -140, -140, -237, -179, -112, -279, 90, 90, -248,
+	-248, 90, 89, 89, 89, -248, -248, 90, 89, -209,
+	-207, 89, -285, 191, 235, 236, 90, 90, 90, 90,
+	29, 89, -286, 29, 478, 477, 479, 480, 481, 90,
+	29, 90, 29, 90, -202, 82, -112, -93, 225, 163,
+	165, 168, 74, 89, 238, 128, 42, 83, 177, 174,
+	-279, -194, 179, -194, -208, -207, -200, 89, -96, -244,
+	12, 138, -212, -212, -192, -112, -244, -212, -192, -112,
+	-192, -192, -192, -192, -212, -212, -212, -192, -207, -207,
+	-112, -112, -112, -112, -112, -112, -112, -217, -217, -217,
+	-193, 136, -192, 456, 74, -215, 246, 280, 450, 451,
+	452, 83, 358, -105, 456, 456, 456, 456, 456, 456,
+	-96, -96, -96, -96, -130, 99, 120, 100, 101, -123,
+	-131, -135, -138, 94, 138, 136, 137, 122, -123, -123,
+	-123, -123, -123, -123, -123, -123, -123, -123, -123, -123,
+	-123, -123, -123, -218, -279, 89, 154, -279, -122, -122,
+	-202, -301, -202, -85, 22, 36, -84, -203, -208, -200,
+	-80, -302, -302, -149, -4, -84, -84, -96, -96, -140,
+	89, -84, -140, 89, -84, -84, -79, 22, 36, -143,
+	-144, 124, -140, -302, -123, -202, -202, -84, -85, -85,
+	-84, -84, 83, -293, 328, 329, 454, -210, 207, -209,
+	23, -133, -132, 89, -8, 23, -171, -202, -153, -302,
+	-154, 27, 10, 138, 83, 19, 83, -152, 25, 26,
+	-169, -153, -124, -202, 90, 93, -97, 83, 12, -89,
+	-112, -204, 145, -208, -112, -174, 207, -112, 30, 83,
+	-108, -110, -109, -111, 64, 68, 70, 65, 66, 67,
+	71, -213, 23, -98, -3, -301, -112, -105, -304, 83,
+	12, 75, -304, 83, 160, -182, -184, 83, 327, 329,
+	330, 74, 102, -96, -230, 153, -255, -254, -253, -237,
+	-239, -240, -241, 84, -155, -95, 38, -233, 294, 293,
+	-228, -228, -228, -228, -228, -229, -179, -229, -229, -229,
+	82, 82, -228, -228, -228, -228, -231, 82, -231, -231,
+	-232, 82, -232, -266, -96, -263, -262, -260, -261, 184,
+	96, 358, 75, -258, -152, 90, -93, -195, 179, -264,
+	-261, -279, -279, -279, -195, -279, 89, -279, 89, -273,
+	365, 214, 213, 83, 17, -238, -237, -141, 235, -267,
+	-268, 207, -265, -259, -246, 116, -245, -246, -246, 160,
+	-279, 83, 27, 107, 107, 107, 107, 358, 165, 30,
+	-237, -141, -218, 177, -218, -218, 89, 89, -191, 486,
+	-105, -92, 227, 128, 216, 216, 174, 174, 229, -112,
+	239, 240, 238, 21, 228, 230, 232, 218, -112, -112,
+	-194, 74, -107, -112, 24, -207, -112, -192, -192, -112,
+	-192, -192, 89, -112, -202, -76, 328, 358, 20, -77,
+	20, 99, 100, 101, -131, -123, -123, -123, -83, 198,
+	119, -302, -11, -12, 207, -302, -84, -84, -301, 160,
+	-5, -153, -149, -302, -302, 83, 75, 23, 12, 12,
+	-302, 12, 12, -302, -302, -84, -146, -144, 126, -96,
+	-302, -302, 83, 83, -302, -302, -302, -302, -302, -292,
+	453, 329, -116, 72, 178, 73, -301, -209, -302, -138,
+	84, 83, -169, 40, 48, 59, -96, -96, -151, -154,
+	-169, -186, 20, 12, 55, 55, -117, 13, -86, -98,
+	-89, 160, -117, -121, 30, 55, -3, -301, -301, -177,
+	-181, -140, -99, -100, -100, -99, -100, 64, 64, 64,
+	69, 64, 69, 64, -109, -207, -302, -302, -3, -174,
+	75, -98, -112, -98, -114, -207, 145, -183, -185, 331,
+	328, 334, -279, 89, -270, -271, 83, -253, -241, -298,
+	99, 120, 29, 74, 291, 96, -296, -297, 180, 115,
+	116, 192, 23, 39, -234, 295, -229, -229, -230, -279,
+	89, 154, -230, -230, -230, -236, 89, -236, 90, 90,
+	84, -42, -37, -38, 31, 78, -260, -248, 89, 37,
+	-202, 84, -92, -112, 120, 74, -264, -264, -264, -207,
+	-305, 116, 85, -301, 298, -301, 298, 16, -171, 83,
+	84, -142, 112, -140, -302, 83, -202, 84, 82, -246,
+	-246, -203, -202, -301, 174, 29, 29, -141, -142, -230,
+	-279, 488, 487, 84, 176, 234, -94, 341, 89, 85,
+	-112, -112, -112, -112, -112, 168, 165, 219, 177, -105,
+	-112, 83, -70, 193, 188, -212, -212, 31, 328, 465,
+	463, -83, 119, -123, -123, -302, -149, 17, -302, -302,
+	-85, -203, -149, -169, -153, -220, 154, 266, 197, 264,
+	260, 280, 271, 293, 262, 294, -218, -220, -123, -123,
+	-123, -123, 355, -149, 127, -96, 125, -123, -123, 175,
+	175, 175, -175, -202, 41, 89, 89, 60, -112, -147,
+	14, -96, 145, -153, -176, 74, -177, -134, -136, -135,
+	-301, -170, -302, -202, -175, -117, 83, 128, -103, -102,
+	74, 75, -104, 74, -102, 64, 64, -302, -117, -98,
+	-117, -117, 160, 328, 332, 333, -253, 99, -123, 10,
+	89, 116, 116, -112, 82, -230, -230, 84, 83, 84,
+	83, 84, 83, -196, 399, 120, -38, -37, -248, -248,
+	90, -279, 176, 24, -112, 74, 74, 74, -301, -301,
+	-96, -301, -96, -301, 17, -237, -140, -268, 55, -275,
+	471, 486, -263, -171, -267, -112, -122, -142, -112, -91,
+	225, 233, 82, 86, -281, 75, 216, 291, 216, -112,
+	-70, -42, -112, -192, -192, 31, -279, -123, -13, -14,
+	363, 365, -132, -302, -153, -169, -302, -228, -228, -228,
+	-232, -228, 254, -228, 254, -302, -302, 20, 20, 20,
+	20, -301, -75, 351, -96, 83, 83, -301, -301, -301,
+	-302, 89, -229, -148, 15, 17, 28, -176, 83, -302,
+	-302, 83, 55, 160, -302, -149, -181, -96, -96, 82,
+	-96, -149, -117, -126, 82, -123, -229, 89, -229, 90,
+	90, 399, 29, 79, 80, 81, 29, 76, 77, -112,
+	-112, -112, -112, -96, -171, -302, -171, -302, -171, -171,
+	209, 138, -248, 471, 84, -302, -235, 358, 361, -172,
+	84, -278, 358, -280, -202, -202, -202, -202, -15, 122,
+	366, 446, 90, 93, 159, -169, -229, -279, -123, -123,
+	-123, -123, -123, -153, 89, -123, -123, -173, -302, -202,
+	180, -173, -173, -210, -229, -158, -163, -189, -303, 392,
+	-96, -132, 116, -136, 55, -3, -202, -134, -202, -153,
+	-171, -153, -171, 84, -230, -230, 84, 84, -172, -302,
+	-302, -274, 215, -302, -274, -302, 210, -301, -209, -248,
+	-297, 362, 362, 23, -277, -276, -203, 82, 75, -15,
+	364, 426, 401, 426, 401, 426, 401, -123, -302, -302,
+	-302, -302, -78, 138, 358, -302, -302, -302, 83, -302,
+	-302, -302, -116, -161, 449, -166, 44, -164, -165, 45,
+	-162, 46, 54, -308, -309, -202, 10, -134, 160, 84,
+	84, -156, 23, -274, -274, -272, -301, 90, -274, -272,
+	-274, -301, 206, -132, -209, -3, 83, 128, -171, -112,
+	119, -202, -302, 356, 71, 359, -202, 180, -158, 49,
+	272, -168, -167, 53, 45, -165, 17, 47, 17, 83,
+	23, -177, -202, -288, -289, 74, -298, -295, 99, 120,
+	96, -296, 108, 109, -3, -272, -272, -267, -272, -272,
+	-123, 206, -275, -302, -68, 358, -276, -252, -203, 89,
+	90, 84, -15, 426, 401, 60, 357, 360, -159, 51,
+	-157, 50, -157, -167, 17, 17, 89, 17, 89, -309,
+	-301, -289, 74, 11, 10, 99, 89, -68, -302, -302,
+	-302, -275, -69, 224, 453, -281, 60, -160, 52, 74,
+	102, 89, 89, 89, -11, -287, 193, 188, 191, 30,
+	-287, -275, -275, 185, -278, 358, 74, 102, -302, 187,
+	29, 99, 226, 359, 360,
 }
 
 var yyDef = [...]int{
 	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 843, 0, 575, 575, 575, 575, 575,
-	575, 575, 0, 0, 575, -2, -2, 575, 979, 0,
-	575, 0, 0, -2, 508, 509, 0, 511, -2, 0,
-	0, 520, 1398, 1398, 570, 0, 0, 0, 0, 0,
-	575, 1396, 55, 56, 526, 527, 528, 1, 3, 0,
-	579, 851, 0, 0, -2, 577, 0, 0, 962, 962,
-	962, 0, 86, 87, 0, 0, 0, -2, 90, -2,
-	114, 115, 0, 119, 383, 344, 386, 342, 372, -2,
-	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 347, 237, 237, 0, 0, -2, 335,
-	335, 335, 0, 0, 0, 369, 964, 290, 237, 237,
-	0, 237, 237, 237, 237, 0, 0, 237, 237, 237,
-	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 867, 118, 980, 977, 978, 35, 36, 37,
-	1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131,
-	1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141,
-	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151,
-	1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161,
-	1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
-	1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
-	1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
-	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
-	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
-	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
-	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
-	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
-	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
-	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
-	1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
-	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301,
-	1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311,
-	1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
-	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
-	1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341,
-	1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351,
-	1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361,
-	1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371,
-	1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
-	1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391,
-	1392, 1393, 1394, 1395, 0, 956, 0, 439, 664, 0,
-	499, 499, 0, 499, 499, 499, 499, 0, 0, 0,
-	451, 0, 0, 0, 0, 496, 0, 0, 470, 472,
-	0, 496, 0, 0, 483, 499, 1399, 1399, 1399, 947,
-	0, 493, 491, 505, 506, 488, 489, 507, 510, 0,
-	515, 518, 973, 974, 0, 533, 0, 1201, 525, 538,
-	539, 0, 571, 572, 40, 715, 674, 0, 680, 682,
-	0, 717, 718, 719, 720, 721, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 747, 748, 749, 750,
-	828, 829, 830, 831, 832, 833, 834, 835, 684, 685,
-	825, 0, 936, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 816, 0, 785, 785, 785, 785, 785, 785,
-	785, 785, 785, 0, 0, 0, 0, 0, 0, 0,
-	-2, -2, 1398, 0, 548, 0, 0, 843, 51, 0,
-	575, 580, 581, 886, 0, 0, 843, 1397, 0, 0,
-	-2, -2, 591, 597, 598, 599, 600, 601, 576, 0,
-	604, 608, 0, 0, 0, 963, 0, 0, 72, 0,
-	1360, 940, -2, -2, 0, 0, 975, 976, 949, -2,
-	983, 984, 985, 986, 987, 988, 989, 990, 991, 992,
-	993, 994, 995, 996, 997, 998, 999, 1000, 1001, 1002,
-	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012,
-	1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022,
-	1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
-	1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042,
-	1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052,
-	1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062,
-	1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072,
-	1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082,
-	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
-	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
-	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
-	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, -2,
-	0, 0, 128, 129, 0, 38, 263, 0, 124, 0,
-	257, 209, 867, 0, 0, 0, 0, 0, 575, 0,
-	957, 109, 110, 116, 117, 237, 237, 0, 118, 118,
-	351, 352, 353, 0, 0, -2, 261, 0, 336, 0,
-	0, 251, 251, 255, 253, 254, 0, 0, 0, 0,
-	0, 0, 363, 0, 364, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 423, 0, 238, 0, 381, 382,
-	291, 0, 0, 0, 0, 361, 362, 0, 0, 965,
-	966, 0, 0, 237, 237, 0, 0, 0, 0, 237,
-	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 108, 880, 0,
-	0, 0, -2, 0, 431, 0, 0, 0, 958, 958,
-	438, 0, 440, 441, 0, 0, 442, 0, 496, 496,
-	494, 495, 444, 445, 446, 447, 499, 0, 0, 246,
-	247, 248, 496, 499, 0, 499, 499, 499, 499, 496,
-	496, 496, 499, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1399, 1399, 1399, 502, 499, 0, 480, 481,
-	484, 485, 1400, 1401, 994, 486, 487, 948, 516, 519,
-	536, 534, 535, 537, 529, 530, 531, 532, 0, 550,
-	551, 556, 0, 0, 0, 0, 562, 563, 564, 0,
-	0, 567, 568, 569, 0, 0, 0, 0, 0, 678,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 702,
-	703, 704, 705, 706, 707, 708, 681, 0, 695, 0,
-	0, 0, 737, 738, 739, 740, 741, 742, 743, 744,
-	745, 0, 588, 0, 0, 0, 843, 0, 0, 0,
-	0, 0, 0, 0, 585, 0, 817, 0, 768, 0,
-	769, 777, 0, 770, 778, 771, 779, 772, 773, 780,
-	774, 781, 775, 776, 782, 0, 0, 0, 588, 588,
-	0, 0, 41, 540, 541, 0, 647, 968, 0, 851,
-	0, 590, 889, 0, 0, 852, 844, 845, 848, 851,
-	0, 613, 602, 592, 595, 596, 578, 0, 605, 609,
-	0, 611, 612, 0, 0, 70, 0, 663, 0, 615,
-	617, 618, 619, 645, 0, 0, 0, 0, 66, 68,
-	664, 0, 1360, 946, 0, 74, 75, 0, 0, 0,
-	225, 951, 952, 953, -2, 244, 0, -2, 216, 160,
-	161, 162, 209, 164, 209, 209, 209, 209, 221, 221,
-	221, 221, 192, 193, 194, 195, 196, 0, 0, 179,
-	209, 209, 209, 209, 199, 200, 201, 202, 203, 204,
-	205, 206, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 211, 211, 211, 213, 213, 0, 39, 0, 229,
-	0, 848, 0, 880, 960, 970, 0, 0, 0, 960,
-	92, 0, 0, 384, 345, 373, 385, 0, 348, 349,
-	-2, 0, 0, 335, 0, 337, 0, 245, 0, -2,
-	0, 255, 0, 251, 255, 252, 255, 243, 256, 365,
-	825, 0, 366, 367, 0, 403, 633, 0, 0, 0,
-	0, 0, 409, 410, 411, 0, 413, 414, 415, 416,
-	417, 418, 419, 420, 421, 422, 374, 375, 376, 377,
-	378, 379, 380, 0, 0, 337, 0, 370, 0, 292,
-	293, 0, 0, 296, 297, 298, 299, 0, 0, 302,
-	303, 304, 650, 651, 305, 329, 330, 331, 306, 307,
-	308, 309, 310, 311, 312, 323, 324, 325, 326, 327,
-	328, 313, 314, 315, 316, 317, 320, 0, 102, 871,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 958, 0, 0, 0, 0, 665, 981, 982, 500,
-	501, 0, 249, 250, 499, 499, 448, 471, 0, 499,
-	452, 473, 453, 455, 454, 456, 475, 476, 499, 459,
-	497, 498, 460, 461, 462, 463, 464, 465, 466, 467,
-	468, 469, 477, 0, 478, 479, 0, 0, 517, 521,
-	522, 523, 524, 0, 0, 553, 558, 559, 560, 561,
-	573, 566, 716, 675, 676, 677, 679, 696, 0, 698,
-	700, 686, 687, 711, 712, 713, 0, 0, 0, 0,
-	709, 691, 0, 722, 723, 724, 725, 726, 727, 728,
-	729, 730, 731, 732, 733, 736, 800, 801, 802, 0,
-	734, 735, 746, 0, 0, 0, 589, 826, 0, -2,
-	0, 714, 935, 851, 0, 0, 0, 0, 719, 828,
-	0, 719, 828, 0, 0, 0, 586, 587, 823, 820,
-	0, 0, 786, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 543, 544, 546, 0, 667, 0, 648, 0,
-	0, 969, 549, 886, 52, 42, 0, 887, 0, 0,
-	0, 0, 847, 849, 850, 886, 0, 836, 0, 0,
-	672, 0, 0, 593, 48, 610, 606, 0, 672, 0,
-	0, 662, 0, 0, 0, 0, 0, 0, 652, 0,
-	0, 655, 0, 0, 0, 0, 646, 0, 0, 0,
-	-2, 0, 0, 0, 62, 63, 0, 0, 0, 941,
-	73, 0, 0, 78, 79, 942, 943, 944, 945, 0,
-	111, -2, 287, 130, 132, 133, 134, 125, 269, 0,
-	0, 219, 217, 218, 163, 221, 221, 186, 187, 225,
-	0, 225, 225, 225, 0, 0, 180, 181, 182, 183,
-	174, 0, 175, 176, 177, 0, 178, 262, 0, 855,
-	230, 231, 233, 237, 0, 0, 0, 258, 259, 0,
-	871, 0, 0, 0, 971, 970, 970, 970, 0, 120,
-	121, 122, 123, 118, 0, 0, 126, 339, 0, 0,
-	0, 260, 0, 0, 255, 255, 240, 241, 0, 368,
-	0, 0, 405, 406, 407, 408, 0, 0, 0, 337,
-	339, 225, 0, 294, 295, 300, 301, 318, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 398,
-	399, 400, 401, 868, 869, 870, 0, 0, 432, 0,
-	0, 279, 64, 959, 437, 496, 458, 474, 496, 450,
-	457, 503, 482, 513, 557, 0, 0, 0, 565, 0,
-	697, 699, 701, 688, 709, 692, 0, 689, 0, 0,
-	683, 751, 0, 0, 588, 0, 843, 886, 755, 756,
-	0, 0, 0, 0, 0, 793, 0, 0, 794, 0,
-	843, 0, 821, 0, 0, 767, 787, 0, 0, 788,
-	789, 790, 791, 792, 542, 545, 547, 623, 0, 0,
-	0, 0, 649, 967, 44, 0, 0, 0, 853, 854,
-	846, 43, 0, 954, 955, 837, 838, 839, 0, 603,
-	614, 594, 0, 851, 929, 0, 0, 921, 0, 0,
-	672, 937, 0, 616, 641, 643, 0, 638, 653, 654,
-	656, 0, 658, 0, 660, 661, 620, 621, 622, 0,
-	672, 0, 672, 67, 672, 69, 0, 666, 76, 77,
-	0, 0, 83, 226, 227, 118, 289, 131, 135, 140,
-	0, 0, 0, 144, 0, 146, 270, 0, 156, 158,
-	0, 0, 138, 159, 220, 225, 225, 188, 222, 223,
-	224, 189, 190, 191, 0, 207, 0, 0, 0, 282,
-	88, 859, 858, 237, 237, 232, 0, 235, 0, 972,
-	210, 0, 101, 0, 0, 0, 0, 0, 107, 0,
-	343, 627, 0, 354, 355, 0, 338, 402, 0, 229,
-	0, 239, 242, 826, 634, 0, 0, 356, 0, 339,
-	359, 360, 371, 321, 322, 319, 0, 0, 881, 882,
-	0, 885, 93, 391, 393, 392, 396, 0, 0, 389,
-	0, 279, 855, 0, 436, 280, 281, 499, 499, 552,
-	0, 555, 0, 690, 0, 710, 693, 752, 753, 0,
-	827, 851, 46, 0, 209, 209, 806, 209, 213, 809,
-	209, 811, 209, 814, 0, 0, 0, 0, 0, 0,
-	0, 818, 766, 824, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 891, 888, 45, 841, 0, 673, 607,
-	49, 53, 0, 929, 920, 931, 933, 0, 0, 0,
-	925, 0, 843, 0, 0, 635, 642, 0, 0, 636,
-	0, 637, 657, 659, -2, 843, 672, 60, 61, 0,
-	80, 81, 82, 288, 141, 142, 0, 145, 155, 157,
-	0, 0, 184, 185, 221, 0, 221, 0, 214, 0,
-	271, 283, 0, 856, 857, 0, 0, 234, 236, 0,
-	961, 103, 0, 0, 0, 0, 0, 127, 340, 0,
-	228, 0, 0, 427, 424, 357, 358, 625, 872, 873,
-	874, 0, 884, 96, 0, 0, 0, 0, 433, 434,
-	435, 65, 443, 449, 554, 574, 694, 754, 886, 757,
-	803, 221, 807, 808, 810, 812, 813, 815, 759, 758,
-	0, 0, 0, 0, 0, 851, 0, 822, 0, 0,
-	0, 0, 0, 647, 221, 911, 50, 0, 0, 0,
-	54, 0, 934, 0, 0, 0, 0, 71, 851, 938,
-	939, 639, 0, 644, 851, 59, 143, 0, 0, 225,
-	208, 225, 0, 0, 284, 860, 861, 862, 863, 864,
-	865, 866, 625, 104, 105, 106, 346, 628, 0, 0,
-	404, 0, 412, 0, 0, 0, 0, 883, 390, 0,
-	94, 95, 0, 0, 395, 47, 804, 805, 0, 0,
-	0, 0, 795, 0, 819, 0, 0, 0, 669, 629,
-	630, 0, 0, 667, 893, 892, 905, 918, 842, 840,
-	0, 932, 0, 924, 927, 923, 926, 57, 0, 58,
-	0, 149, 197, 198, 212, 215, 0, 0, 264, 428,
-	425, 426, 0, 0, 97, 98, 0, 0, 0, 760,
-	762, 761, 763, 0, 0, 0, 765, 783, 784, 0,
-	668, 670, 671, 624, 911, 0, 904, 0, -2, 913,
-	0, 0, 0, 919, 0, 922, 0, 640, 265, 269,
-	0, 0, 875, 626, 0, 0, 0, 397, 764, 0,
-	0, 0, 631, 632, 898, 896, 896, 906, 907, 0,
-	0, 914, 0, 0, 0, 930, 928, 266, 267, 0,
-	136, 150, 151, 0, 0, 154, 147, 148, 875, 0,
-	0, 387, 877, 99, 100, 332, 333, 334, 93, 796,
-	0, 799, 901, 0, 894, 897, 895, 908, 0, 0,
-	915, 0, 917, 268, 0, 0, 0, 152, 153, 89,
-	429, 430, 0, 878, 879, 96, 797, 890, 0, 899,
-	900, 909, 910, 916, 272, 274, 275, 0, 0, 273,
-	0, 394, 0, 902, 903, 276, 277, 278, 876, 0,
-	798,
+	31, 32, 33, 900, 0, 0, 606, 606, 606, 606,
+	606, 606, 606, 0, 0, 606, -2, -2, 606, 1036,
+	0, 606, 0, 0, -2, 539, 540, 0, 542, -2,
+	0, 0, 551, 1461, 1461, 601, 0, 0, 0, 0,
+	0, 606, 0, 1459, 62, 63, 557, 558, 559, 1,
+	3, 0, 610, 908, 0, 900, 606, 0, -2, 608,
+	0, 0, 1019, 1019, 1019, 0, 93, 94, 0, 0,
+	0, -2, 97, -2, 121, 122, 0, 126, 392, 352,
+	395, 350, 381, -2, 342, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 355, 244, 244,
+	0, 0, -2, 342, 342, 342, 0, 0, 0, 378,
+	1021, 297, 244, 244, 0, 244, 244, 244, 244, 0,
+	0, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 924, 125, 1037, 1034,
+	1035, 35, 36, 37, 1180, 1181, 1182, 1183, 1184, 1185,
+	1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195,
+	1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205,
+	1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215,
+	1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225,
+	1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235,
+	1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245,
+	1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255,
+	1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275,
+	1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
+	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
+	1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305,
+	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
+	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
+	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
+	1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345,
+	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355,
+	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
+	1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375,
+	1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385,
+	1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395,
+	1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405,
+	1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415,
+	1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425,
+	1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435,
+	1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445,
+	1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455,
+	1456, 1457, 1458, 0, 1013, 0, 470, 695, 0, 530,
+	530, 0, 530, 530, 530, 530, 0, 0, 0, 482,
+	0, 0, 0, 0, 527, 0, 0, 501, 503, 0,
+	527, 0, 0, 514, 530, 1462, 1462, 1462, 1004, 0,
+	524, 522, 536, 537, 519, 520, 538, 541, 0, 546,
+	549, 1030, 1031, 0, 564, 0, 1260, 556, 569, 570,
+	0, 602, 603, 40, 746, 705, 0, 711, 713, 0,
+	748, 749, 750, 751, 752, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 778, 779, 780, 781, 782,
+	885, 886, 887, 888, 889, 890, 891, 892, 715, 716,
+	882, 0, 993, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 873, 0, 817, 817, 817, 817, 817, 817,
+	817, 817, 817, 0, 0, 0, 0, 0, 0, 0,
+	-2, -2, 1461, 0, 579, 0, 0, 50, 0, 52,
+	656, 900, 58, 0, 611, 612, 943, 0, 0, 908,
+	621, 900, 1460, 0, 0, -2, -2, 622, 628, 629,
+	630, 631, 632, 607, 0, 635, 639, 0, 0, 0,
+	1020, 0, 0, 79, 0, 1423, 997, -2, -2, 0,
+	0, 1032, 1033, 1006, -2, 1040, 1041, 1042, 1043, 1044,
+	1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054,
+	1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064,
+	1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074,
+	1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084,
+	1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094,
+	1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104,
+	1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114,
+	1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124,
+	1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134,
+	1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144,
+	1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154,
+	1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164,
+	1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174,
+	1175, 1176, 1177, 1178, 1179, -2, 0, 0, 135, 136,
+	0, 38, 270, 0, 131, 0, 264, 216, 924, 0,
+	0, 0, 0, 0, 606, 0, 1014, 116, 117, 123,
+	124, 244, 244, 0, 396, 0, 125, 125, 359, 360,
+	361, 0, 0, -2, 268, 0, 343, 0, 0, 258,
+	258, 262, 260, 261, 0, 0, 0, 0, 0, 0,
+	0, 371, 0, 372, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 433, 0, 245, 0, 390, 391, 298,
+	0, 0, 0, 0, 369, 370, 0, 0, 1022, 1023,
+	0, 0, 244, 244, 0, 0, 0, 0, 244, 244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 115, 937, 0, 0,
+	0, -2, 0, 462, 0, 0, 0, 1015, 1015, 469,
+	0, 471, 472, 0, 0, 473, 0, 527, 527, 525,
+	526, 475, 476, 477, 478, 530, 0, 0, 253, 254,
+	255, 527, 530, 0, 530, 530, 530, 530, 527, 527,
+	527, 530, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1462, 1462, 1462, 533, 530, 0, 511, 512, 515,
+	516, 1463, 1464, 1051, 517, 518, 1005, 547, 550, 567,
+	565, 566, 568, 560, 561, 562, 563, 0, 581, 582,
+	587, 0, 0, 0, 0, 593, 594, 595, 0, 0,
+	598, 599, 600, 0, 0, 0, 0, 0, 709, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 733, 734,
+	735, 736, 737, 738, 739, 712, 0, 726, 0, 0,
+	0, 768, 769, 770, 771, 772, 773, 774, 775, 776,
+	0, 820, 0, 619, 0, 0, 0, 900, 0, 0,
+	0, 0, 0, 0, 0, 0, 616, 0, 874, 0,
+	800, 0, 801, 809, 0, 802, 810, 803, 811, 804,
+	805, 812, 806, 813, 807, 808, 814, 0, 0, 0,
+	619, 619, 0, 0, 41, 571, 572, 0, 678, 1025,
+	0, 0, 51, 0, 0, 908, 0, 946, 0, 0,
+	909, 901, 902, 905, 943, 626, 627, 908, 0, 644,
+	633, 623, 609, 0, 636, 640, 0, 642, 643, 0,
+	0, 77, 0, 694, 0, 646, 648, 649, 650, 676,
+	0, 0, 0, 0, 73, 75, 695, 0, 1423, 1003,
+	0, 81, 82, 0, 0, 0, 232, 1008, 1009, 1010,
+	-2, 251, 0, -2, 223, 167, 168, 169, 216, 171,
+	216, 216, 216, 216, 228, 228, 228, 228, 199, 200,
+	201, 202, 203, 0, 0, 186, 216, 216, 216, 216,
+	206, 207, 208, 209, 210, 211, 212, 213, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 218, 218, 218,
+	220, 220, 0, 39, 0, 236, 0, 905, 0, 937,
+	1017, 1027, 0, 0, 0, 1017, 99, 0, 0, 393,
+	456, 353, 382, 394, 0, 356, 357, -2, 0, 0,
+	342, 0, 344, 0, 252, 0, -2, 0, 262, 0,
+	258, 262, 259, 262, 250, 263, 373, 882, 0, 374,
+	375, 0, 377, 413, 664, 0, 0, 0, 0, 0,
+	419, 420, 421, 0, 423, 424, 425, 426, 427, 428,
+	429, 430, 431, 432, 383, 384, 385, 386, 387, 388,
+	389, 0, 0, 344, 0, 379, 0, 299, 300, 0,
+	0, 303, 304, 305, 306, 0, 0, 309, 310, 311,
+	681, 682, 312, 336, 337, 338, 313, 314, 315, 316,
+	317, 318, 319, 330, 331, 332, 333, 334, 335, 320,
+	321, 322, 323, 324, 327, 0, 109, 928, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1015,
+	0, 0, 0, 0, 696, 1038, 1039, 531, 532, 0,
+	256, 257, 530, 530, 479, 502, 0, 530, 483, 504,
+	484, 486, 485, 487, 506, 507, 530, 490, 528, 529,
+	491, 492, 493, 494, 495, 496, 497, 498, 499, 500,
+	508, 0, 509, 510, 0, 0, 548, 552, 553, 554,
+	555, 0, 0, 584, 589, 590, 591, 592, 604, 597,
+	747, 706, 707, 708, 710, 727, 0, 729, 731, 717,
+	718, 742, 743, 744, 0, 0, 0, 0, 740, 722,
+	0, 753, 754, 755, 756, 757, 758, 759, 760, 761,
+	762, 763, 764, 767, 857, 858, 859, 0, 765, 766,
+	777, 829, 822, 0, 0, 0, 620, 883, 0, -2,
+	0, 745, 992, 908, 900, 0, 0, 0, 0, 750,
+	885, 0, 750, 885, 0, 0, 0, 617, 618, 880,
+	877, 0, 0, 818, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 574, 575, 577, 0, 698, 0, 679,
+	0, 0, 1026, 580, 53, 0, 0, 658, 943, 59,
+	42, 0, 944, 0, 0, 0, 0, 904, 906, 907,
+	946, 943, 0, 893, 0, 0, 703, 0, 0, 624,
+	55, 641, 637, 0, 703, 0, 0, 693, 0, 0,
+	0, 0, 0, 0, 683, 0, 0, 686, 0, 0,
+	0, 0, 677, 0, 0, 0, -2, 0, 0, 0,
+	69, 70, 0, 0, 0, 998, 80, 0, 0, 85,
+	86, 999, 1000, 1001, 1002, 0, 447, -2, 294, 137,
+	139, 140, 141, 132, 276, 0, 0, 226, 224, 225,
+	170, 228, 228, 193, 194, 232, 0, 232, 232, 232,
+	0, 0, 187, 188, 189, 190, 181, 0, 182, 183,
+	184, 0, 185, 269, 0, 912, 237, 238, 240, 244,
+	0, 0, 0, 265, 266, 0, 928, 0, 0, 0,
+	1028, 1027, 1027, 1027, 0, 127, 128, 129, 130, 0,
+	0, 0, 457, 125, 0, 0, 133, 347, 345, 0,
+	437, 0, 267, 0, 0, 262, 262, 247, 248, 0,
+	376, 0, 0, 415, 416, 417, 418, 0, 0, 0,
+	344, 347, 232, 0, 301, 302, 307, 308, 325, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	408, 409, 410, 411, 925, 926, 927, 0, 0, 463,
+	0, 0, 286, 71, 1016, 468, 527, 489, 505, 527,
+	481, 488, 534, 513, 544, 588, 0, 0, 0, 596,
+	0, 728, 730, 732, 719, 740, 723, 0, 720, 0,
+	0, 714, 0, 900, 0, 783, 0, 0, 619, 0,
+	900, 943, 908, 787, 788, 0, 0, 0, 0, 0,
+	850, 0, 0, 851, 0, 900, 0, 878, 0, 0,
+	799, 819, 0, 0, 845, 846, 847, 848, 849, 573,
+	576, 578, 654, 0, 0, 0, 0, 680, 1024, 54,
+	657, 0, 45, 0, 0, 0, 910, 911, 903, 43,
+	44, 0, 1011, 1012, 894, 895, 896, 0, 634, 645,
+	625, 0, 908, 986, 0, 0, 978, 0, 0, 703,
+	994, 0, 647, 672, 674, 0, 669, 684, 685, 687,
+	0, 689, 0, 691, 692, 651, 652, 653, 0, 703,
+	0, 703, 74, 703, 76, 0, 697, 83, 84, 0,
+	0, 90, 233, 234, 118, 448, 125, 296, 138, 142,
+	147, 0, 0, 0, 151, 0, 153, 277, 0, 163,
+	165, 0, 0, 145, 166, 227, 232, 232, 195, 229,
+	230, 231, 196, 197, 198, 0, 214, 0, 0, 0,
+	289, 95, 916, 915, 244, 244, 239, 0, 242, 0,
+	1029, 217, 0, 108, 0, 0, 0, 0, 0, 114,
+	0, 0, 455, 0, 0, 0, 0, 0, 351, 0,
+	362, 363, 0, 346, 412, 0, 444, 236, 0, 246,
+	249, 883, 665, 0, 0, 364, 0, 347, 367, 368,
+	380, 328, 329, 326, 0, 0, 938, 939, 0, 942,
+	100, 401, 403, 402, 406, 0, 0, 399, 0, 286,
+	912, 0, 467, 287, 288, 530, 530, 583, 0, 586,
+	0, 721, 0, 741, 724, 821, 831, 0, 784, 785,
+	0, 884, 908, 47, 943, 0, 216, 216, 863, 216,
+	220, 866, 216, 868, 216, 871, 0, 0, 0, 0,
+	0, 0, 0, 875, 798, 881, 0, 0, 0, 0,
+	0, 0, 0, 659, 0, 228, 948, 945, 46, 898,
+	0, 704, 638, 56, 60, 0, 986, 977, 988, 990,
+	0, 0, 0, 982, 0, 900, 0, 0, 666, 673,
+	0, 0, 667, 0, 668, 688, 690, -2, 900, 703,
+	67, 68, 0, 87, 88, 89, 295, 148, 149, 0,
+	152, 162, 164, 0, 0, 191, 192, 228, 0, 228,
+	0, 221, 0, 278, 290, 0, 913, 914, 0, 0,
+	241, 243, 0, 1018, 110, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 134, 348, 438, 0, 443,
+	244, 0, 235, 0, 0, 434, 365, 366, 656, 929,
+	930, 931, 0, 941, 103, 0, 0, 0, 0, 464,
+	465, 466, 72, 474, 480, 585, 605, 725, 828, 0,
+	834, 835, 830, 786, 943, 48, 789, 860, 228, 864,
+	865, 867, 869, 870, 872, 791, 790, 0, 0, 0,
+	0, 0, 908, 0, 879, 0, 0, 0, 0, 0,
+	678, 228, 968, 823, 0, 0, 0, 61, 0, 991,
+	0, 0, 0, 0, 78, 908, 995, 996, 670, 0,
+	675, 908, 66, 150, 0, 0, 232, 215, 232, 0,
+	0, 291, 917, 918, 919, 920, 921, 922, 923, 656,
+	111, 112, 113, 0, 0, 458, 0, 458, 0, 354,
+	0, 0, 0, 244, 0, 414, 422, 0, 0, 0,
+	940, 400, 0, 101, 102, 0, 0, 405, 832, 0,
+	0, 0, 0, 0, 0, 49, 861, 862, 0, 0,
+	0, 0, 852, 0, 876, 0, 0, 0, 700, 660,
+	661, 0, 0, 698, 950, 949, 962, 975, 57, 0,
+	899, 897, 0, 989, 0, 981, 984, 980, 983, 64,
+	0, 65, 0, 156, 204, 205, 219, 222, 0, 458,
+	458, 460, 0, 458, 460, 458, 0, 0, 445, 0,
+	271, 435, 436, 0, 104, 105, 0, 0, 0, 0,
+	836, 837, 838, 839, 840, 841, 842, 0, 792, 794,
+	793, 795, 0, 0, 0, 797, 815, 816, 0, 699,
+	701, 702, 655, 968, 0, 961, 0, -2, 970, 0,
+	0, 0, 976, 824, 825, 0, 0, 979, 0, 671,
+	272, 276, 0, 460, 460, 451, 0, 459, 460, 453,
+	460, 0, 444, 0, 446, 932, 0, 0, 0, 407,
+	0, 0, 796, 0, 0, 0, 662, 663, 955, 953,
+	953, 963, 964, 0, 0, 971, 0, 0, 0, 0,
+	0, 987, 985, 273, 274, 0, 143, 157, 158, 0,
+	0, 161, 154, 155, 932, 449, 450, 0, 452, 454,
+	0, 0, 441, 444, 397, 934, 106, 107, 339, 340,
+	341, 100, 833, 843, 844, 853, 0, 856, 958, 0,
+	951, 954, 952, 965, 0, 0, 972, 0, 974, 826,
+	829, 275, 0, 0, 0, 159, 160, 96, 461, 444,
+	444, 442, 0, 935, 936, 103, 854, 947, 0, 956,
+	957, 966, 967, 973, 0, 279, 281, 282, 0, 0,
+	280, 439, 440, 0, 404, 0, 959, 960, 827, 283,
+	284, 285, 933, 0, 855,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 118, 3, 3, 3, 145, 137, 3,
-	82, 84, 142, 140, 83, 141, 157, 143, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 481,
-	126, 125, 127, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 121, 3, 3, 3, 148, 140, 3,
+	82, 84, 145, 143, 83, 144, 160, 146, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 489,
+	129, 128, 130, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 147, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 150, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 136, 3, 148,
+	3, 3, 3, 3, 139, 3, 151,
 }
 
 var yyTok2 = [...]int{
@@ -5628,10 +5919,10 @@ var yyTok2 = [...]int{
 	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 119, 120, 121, 122, 123, 124, 128,
-	129, 130, 131, 132, 133, 134, 135, 138, 139, 144,
-	146, 149, 150, 151, 152, 153, 154, 155, 156, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	115, 116, 117, 118, 119, 120, 122, 123, 124, 125,
+	126, 127, 131, 132, 133, 134, 135, 136, 137, 138,
+	141, 142, 147, 149, 152, 153, 154, 155, 156, 157,
+	158, 159, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
 	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
@@ -5687,7 +5978,8 @@ var yyTok3 = [...]int{
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
 	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
 	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
-	57805, 480, 0,
+	57805, 480, 57806, 481, 57807, 482, 57808, 483, 57809, 484,
+	57810, 485, 57811, 486, 57812, 487, 57813, 488, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -6037,68 +6329,68 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:435
+//line sql.y:463
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:440
+//line sql.y:468
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:441
+//line sql.y:469
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:445
+//line sql.y:473
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:478
+//line sql.y:506
 		{
 			setParseTree(yylex, nil)
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:484
+//line sql.y:512
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:488
+//line sql.y:516
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:492
+//line sql.y:520
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
 	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:497
+//line sql.y:525
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:501
+//line sql.y:529
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:507
+//line sql.y:535
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -6106,7 +6398,7 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:513
+//line sql.y:541
 		{
 			yyLOCAL = &Load{}
 		}
@@ -6114,7 +6406,7 @@ yydefault:
 	case 42:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:519
+//line sql.y:547
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -6127,31 +6419,45 @@ yydefault:
 	case 43:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:528
+//line sql.y:556
+		{
+			sel := yyDollar[2].selStmtUnion().(*Select)
+			sel.With = yyDollar[1].withUnion()
+			sel.OrderBy = yyDollar[3].orderByUnion()
+			sel.Limit = yyDollar[4].limitUnion()
+			sel.Lock = yyDollar[5].lockUnion()
+			sel.Into = yyDollar[6].selectIntoUnion()
+			yyLOCAL = sel
+		}
+		yyVAL.union = yyLOCAL
+	case 44:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:566
 		{
 			yyLOCAL = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmtUnion()}, OrderBy: yyDollar[4].orderByUnion(), Limit: yyDollar[5].limitUnion(), Lock: yyDollar[6].lockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:532
+//line sql.y:570
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:536
+//line sql.y:574
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:559
+//line sql.y:597
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -6160,58 +6466,110 @@ yydefault:
 			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 47:
+	case 48:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:605
+		{
+			sel := yyDollar[2].selStmtUnion().(*Select)
+			sel.With = yyDollar[1].withUnion()
+			sel.OrderBy = yyDollar[3].orderByUnion()
+			sel.Limit = yyDollar[4].limitUnion()
+			sel.Lock = yyDollar[5].lockUnion()
+			yyLOCAL = sel
+		}
+		yyVAL.union = yyLOCAL
+	case 49:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:567
+//line sql.y:614
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 50:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:620
+		{
+			yyLOCAL = &With{CTEs: yyDollar[2].ctesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:624
+		{
+			yyLOCAL = &With{CTEs: yyDollar[3].ctesUnion(), Recursive: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*CommonTableExpr
+//line sql.y:630
+		{
+			yyLOCAL = []*CommonTableExpr{yyDollar[1].cteUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 53:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:634
+		{
+			yySLICE := (*[]*CommonTableExpr)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].cteUnion())
+		}
+	case 54:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *CommonTableExpr
+//line sql.y:640
+		{
+			yyLOCAL = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columnsUnion(), Subquery: yyDollar[4].subqueryUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 55:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:573
+//line sql.y:646
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 56:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:579
+//line sql.y:652
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 57:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:587
+//line sql.y:660
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:593
+//line sql.y:666
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:597
+//line sql.y:670
 		{
 			yyLOCAL = &ParenSelect{Select: yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:604
+//line sql.y:677
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -6224,10 +6582,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 61:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:616
+//line sql.y:689
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -6238,258 +6596,258 @@ yydefault:
 			yyLOCAL = &Insert{Action: yyDollar[1].insertActionUnion(), Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitionsUnion(), Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprsUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:628
+//line sql.y:701
 		{
 			yyLOCAL = InsertAct
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:632
+//line sql.y:705
 		{
 			yyLOCAL = ReplaceAct
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:638
+//line sql.y:711
 		{
 			yyLOCAL = &Update{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: yyDollar[4].tableExprsUnion(), Exprs: yyDollar[6].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 65:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:644
+//line sql.y:717
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 66:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:648
+//line sql.y:721
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 67:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:652
+//line sql.y:725
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 68:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:656
+//line sql.y:729
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:661
+//line sql.y:734
 		{
 		}
-	case 63:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:662
+//line sql.y:735
 		{
 		}
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:666
+//line sql.y:739
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:670
+//line sql.y:743
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
 		}
-	case 66:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:676
+//line sql.y:749
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:680
+//line sql.y:753
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 68:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:686
+//line sql.y:759
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:690
+//line sql.y:763
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 70:
+	case 77:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:695
+//line sql.y:768
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 78:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:699
+//line sql.y:772
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:705
+//line sql.y:778
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 80:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:711
+//line sql.y:784
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 81:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:715
+//line sql.y:788
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:721
+//line sql.y:794
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:725
+//line sql.y:798
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
 		}
-	case 77:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:731
+//line sql.y:804
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:735
+//line sql.y:808
 		{
 			yyLOCAL = ReadWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:739
+//line sql.y:812
 		{
 			yyLOCAL = ReadOnly
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:745
+//line sql.y:818
 		{
 			yyLOCAL = RepeatableRead
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:749
+//line sql.y:822
 		{
 			yyLOCAL = ReadCommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:753
+//line sql.y:826
 		{
 			yyLOCAL = ReadUncommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:757
+//line sql.y:830
 		{
 			yyLOCAL = Serializable
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:763
+//line sql.y:836
 		{
 			yyLOCAL = SessionScope
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:767
+//line sql.y:840
 		{
 			yyLOCAL = GlobalScope
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:773
+//line sql.y:846
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:779
+//line sql.y:852
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -6497,10 +6855,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 95:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:786
+//line sql.y:859
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -6510,358 +6868,359 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 96:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:795
+//line sql.y:868
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:799
+//line sql.y:872
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
 			yyLOCAL = yyDollar[1].createDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 98:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:806
+//line sql.y:879
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 99:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:810
+//line sql.y:883
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 100:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:815
+//line sql.y:888
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 94:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:819
+//line sql.y:892
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 95:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:825
+//line sql.y:898
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 96:
+	case 103:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:830
+//line sql.y:903
 		{
 			var v []VindexParam
 			yyLOCAL = v
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:835
+//line sql.y:908
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:841
+//line sql.y:914
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:846
+//line sql.y:919
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
-	case 100:
+	case 107:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:852
+//line sql.y:925
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 101:
+	case 108:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:858
+//line sql.y:931
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 109:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:865
+//line sql.y:938
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 110:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:872
+//line sql.y:945
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 111:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:877
+//line sql.y:950
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 112:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:882
+//line sql.y:955
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 113:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:887
+//line sql.y:960
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 114:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:894
+//line sql.y:967
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:901
+//line sql.y:974
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 118:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:912
+//line sql.y:985
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
+			yyLOCAL.Partitions = yyDollar[5].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 119:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:918
+//line sql.y:992
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:922
+//line sql.y:996
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:928
+//line sql.y:1002
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:932
+//line sql.y:1006
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:936
+//line sql.y:1010
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 117:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:940
+//line sql.y:1014
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 118:
+	case 125:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:946
+//line sql.y:1020
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:950
+//line sql.y:1024
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:956
+//line sql.y:1030
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 121:
+	case 128:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:960
+//line sql.y:1034
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 122:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:966
+//line sql.y:1040
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 123:
+	case 130:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:970
+//line sql.y:1044
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 124:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:977
+//line sql.y:1051
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:981
+//line sql.y:1055
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:987
+//line sql.y:1061
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:991
+//line sql.y:1065
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
 		}
-	case 128:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:997
+//line sql.y:1071
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1002
+//line sql.y:1076
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1007
+//line sql.y:1081
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
-	case 131:
+	case 138:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1011
+//line sql.y:1085
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
-	case 132:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1016
+//line sql.y:1090
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
-	case 133:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1020
+//line sql.y:1094
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 134:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1024
+//line sql.y:1098
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 135:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1030
+//line sql.y:1104
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.Reference = yyDollar[4].referenceDefinitionUnion()
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 143:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1036
+//line sql.y:1110
 		{
 			yyDollar[2].columnType.Options = yyDollar[8].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.As = yyDollar[6].exprUnion()
@@ -6869,1741 +7228,1757 @@ yydefault:
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 144:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1044
+//line sql.y:1118
 		{
 			yyVAL.str = ""
 		}
-	case 138:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1048
+//line sql.y:1122
 		{
 			yyVAL.str = ""
 		}
-	case 139:
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1057
+//line sql.y:1131
 		{
-			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
+			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ColKeyNone, Comment: nil, As: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1061
+//line sql.y:1135
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1067
+//line sql.y:1141
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1073
+//line sql.y:1147
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1078
+//line sql.y:1152
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1083
+//line sql.y:1157
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1088
+//line sql.y:1162
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1093
+//line sql.y:1167
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1100
+//line sql.y:1174
 		{
 			yyLOCAL = VirtualStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1104
+//line sql.y:1178
 		{
 			yyLOCAL = StoredStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1109
+//line sql.y:1183
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1113
+//line sql.y:1187
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1118
+//line sql.y:1192
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1124
+//line sql.y:1198
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1130
+//line sql.y:1204
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1135
+//line sql.y:1209
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1142
+//line sql.y:1216
 		{
-			yyLOCAL = colKeyPrimary
+			yyLOCAL = ColKeyPrimary
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1146
+//line sql.y:1220
 		{
-			yyLOCAL = colKeyUnique
+			yyLOCAL = ColKeyUnique
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1150
+//line sql.y:1224
 		{
-			yyLOCAL = colKeyUniqueKey
+			yyLOCAL = ColKeyUniqueKey
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1154
+//line sql.y:1228
 		{
-			yyLOCAL = colKey
+			yyLOCAL = ColKey
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1160
+//line sql.y:1234
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
 			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
 		}
-	case 163:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1171
+//line sql.y:1245
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
-	case 164:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1176
+//line sql.y:1250
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 165:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1182
+//line sql.y:1256
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 166:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1260
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 167:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1190
+//line sql.y:1264
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 168:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1194
+//line sql.y:1268
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 169:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1198
+//line sql.y:1272
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 170:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1202
+//line sql.y:1276
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 171:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1280
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 172:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1284
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 173:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1288
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 174:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1220
+//line sql.y:1294
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 175:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1226
+//line sql.y:1300
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 176:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1232
+//line sql.y:1306
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 177:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1238
+//line sql.y:1312
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 178:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1244
+//line sql.y:1318
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 179:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1252
+//line sql.y:1326
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 180:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1256
+//line sql.y:1330
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 181:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1260
+//line sql.y:1334
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 182:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1338
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 183:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1268
+//line sql.y:1342
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 184:
+	case 191:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1274
+//line sql.y:1348
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 185:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1278
+//line sql.y:1352
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 186:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1282
+//line sql.y:1356
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 187:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1286
+//line sql.y:1360
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 188:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1290
+//line sql.y:1364
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 189:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1294
+//line sql.y:1368
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 190:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1298
+//line sql.y:1372
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 191:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1302
+//line sql.y:1376
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 192:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1306
+//line sql.y:1380
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 193:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1310
+//line sql.y:1384
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 194:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1314
+//line sql.y:1388
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 195:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1318
+//line sql.y:1392
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 196:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1322
+//line sql.y:1396
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 197:
+	case 204:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1326
+//line sql.y:1400
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 198:
+	case 205:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1331
+//line sql.y:1405
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 199:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1337
+//line sql.y:1411
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 200:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1341
+//line sql.y:1415
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 201:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1345
+//line sql.y:1419
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 202:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1349
+//line sql.y:1423
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 203:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1353
+//line sql.y:1427
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 204:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1357
+//line sql.y:1431
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 205:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1361
+//line sql.y:1435
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 206:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1365
+//line sql.y:1439
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 207:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1371
+//line sql.y:1445
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
-	case 208:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1376
+//line sql.y:1450
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
-	case 209:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1381
+//line sql.y:1455
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 217:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1385
+//line sql.y:1459
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1390
+//line sql.y:1464
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 212:
+	case 219:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1394
+//line sql.y:1468
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 213:
+	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1402
+//line sql.y:1476
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 214:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1406
+//line sql.y:1480
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 			}
 		}
-	case 215:
+	case 222:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1412
+//line sql.y:1486
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 216:
+	case 223:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1420
+//line sql.y:1494
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1424
+//line sql.y:1498
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1428
+//line sql.y:1502
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1433
+//line sql.y:1507
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1437
+//line sql.y:1511
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 228:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1442
+//line sql.y:1516
 		{
 			yyVAL.str = ""
 		}
-	case 222:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1446
+//line sql.y:1520
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 223:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1450
+//line sql.y:1524
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 224:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1454
+//line sql.y:1528
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 225:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1459
+//line sql.y:1533
 		{
 			yyVAL.str = ""
 		}
-	case 226:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1463
+//line sql.y:1537
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 227:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1467
+//line sql.y:1541
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 228:
+	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1474
+//line sql.y:1548
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 236:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1479
+//line sql.y:1553
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1483
+//line sql.y:1557
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1489
+//line sql.y:1563
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1493
+//line sql.y:1567
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
 		}
-	case 233:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1499
+//line sql.y:1573
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1503
+//line sql.y:1577
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1508
+//line sql.y:1582
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 243:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1512
+//line sql.y:1586
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1518
+//line sql.y:1592
 		{
 			yyVAL.str = ""
 		}
-	case 238:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1522
+//line sql.y:1596
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 239:
+	case 246:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1528
+//line sql.y:1602
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1532
+//line sql.y:1606
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1536
+//line sql.y:1610
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 249:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1540
+//line sql.y:1614
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 250:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1544
+//line sql.y:1618
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1549
+//line sql.y:1623
 		{
 			yyVAL.str = ""
 		}
-	case 245:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1553
+//line sql.y:1627
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 246:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1559
+//line sql.y:1633
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 247:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1563
+//line sql.y:1637
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 248:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1567
+//line sql.y:1641
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 249:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1574
+//line sql.y:1648
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 250:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1578
+//line sql.y:1652
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 251:
+	case 258:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1583
+//line sql.y:1657
 		{
 			yyVAL.str = "key"
 		}
-	case 252:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1587
+//line sql.y:1661
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 253:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1593
+//line sql.y:1667
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 254:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1597
+//line sql.y:1671
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 255:
+	case 262:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1602
+//line sql.y:1676
 		{
 			yyVAL.str = ""
 		}
-	case 256:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1606
+//line sql.y:1680
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 257:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1612
+//line sql.y:1686
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1616
+//line sql.y:1690
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
 		}
-	case 259:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1622
+//line sql.y:1696
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1628
+//line sql.y:1702
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1632
+//line sql.y:1706
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1638
+//line sql.y:1712
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1642
+//line sql.y:1716
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 271:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1648
+//line sql.y:1722
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 272:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1654
+//line sql.y:1728
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 273:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1658
+//line sql.y:1732
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 274:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1662
+//line sql.y:1736
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 275:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1666
+//line sql.y:1740
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1671
+//line sql.y:1745
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1675
+//line sql.y:1749
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 278:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1681
+//line sql.y:1755
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1687
+//line sql.y:1761
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1693
+//line sql.y:1767
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1699
+//line sql.y:1773
 		{
 			yyLOCAL = Restrict
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1703
+//line sql.y:1777
 		{
 			yyLOCAL = Cascade
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1707
+//line sql.y:1781
 		{
 			yyLOCAL = NoAction
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1711
+//line sql.y:1785
 		{
 			yyLOCAL = SetDefault
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1715
+//line sql.y:1789
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 286:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1720
+//line sql.y:1794
 		{
 			yyVAL.str = ""
 		}
-	case 280:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1724
+//line sql.y:1798
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 281:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1728
+//line sql.y:1802
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 282:
+	case 289:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1733
+//line sql.y:1807
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1737
+//line sql.y:1811
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1741
+//line sql.y:1815
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 292:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1746
+//line sql.y:1820
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1750
+//line sql.y:1824
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1756
+//line sql.y:1830
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1760
+//line sql.y:1834
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
-	case 289:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1764
+//line sql.y:1838
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 290:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1770
+//line sql.y:1844
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1774
+//line sql.y:1848
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 292:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1780
+//line sql.y:1854
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1784
+//line sql.y:1858
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1788
+//line sql.y:1862
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1792
+//line sql.y:1866
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1796
+//line sql.y:1870
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1800
+//line sql.y:1874
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1804
+//line sql.y:1878
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1808
+//line sql.y:1882
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 307:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1812
+//line sql.y:1886
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1816
+//line sql.y:1890
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1820
+//line sql.y:1894
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1824
+//line sql.y:1898
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1828
+//line sql.y:1902
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1832
+//line sql.y:1906
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1836
+//line sql.y:1910
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1840
+//line sql.y:1914
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1844
+//line sql.y:1918
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1848
+//line sql.y:1922
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1852
+//line sql.y:1926
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1856
+//line sql.y:1930
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1860
+//line sql.y:1934
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1864
+//line sql.y:1938
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1868
+//line sql.y:1942
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1872
+//line sql.y:1946
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1876
+//line sql.y:1950
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1880
+//line sql.y:1954
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 325:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1884
+//line sql.y:1958
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 326:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1888
+//line sql.y:1962
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1893
+//line sql.y:1967
 		{
 			yyVAL.str = ""
 		}
-	case 321:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1897
+//line sql.y:1971
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 322:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1901
+//line sql.y:1975
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 332:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1920
+//line sql.y:1994
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 333:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1924
+//line sql.y:1998
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 334:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1928
+//line sql.y:2002
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 335:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1933
+//line sql.y:2007
 		{
 			yyVAL.str = ""
 		}
-	case 337:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1939
+//line sql.y:2016
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *ColName
+//line sql.y:2020
+		{
+			yyLOCAL = &ColName{}
+		}
+		yyVAL.union = yyLOCAL
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1943
+//line sql.y:2024
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 347:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1948
+//line sql.y:2029
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1952
+//line sql.y:2033
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1957
+//line sql.y:2038
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1961
+//line sql.y:2042
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 351:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1965
+//line sql.y:2046
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 344:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1969
+//line sql.y:2050
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1973
+//line sql.y:2054
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 346:
+	case 354:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1977
+//line sql.y:2058
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1983
+//line sql.y:2064
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1987
+//line sql.y:2068
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 349:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1991
+//line sql.y:2072
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 350:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1997
+//line sql.y:2078
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2001
+//line sql.y:2082
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2005
+//line sql.y:2086
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2009
+//line sql.y:2090
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 362:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2013
+//line sql.y:2094
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 363:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2017
+//line sql.y:2098
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 364:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2021
+//line sql.y:2102
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 365:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2025
+//line sql.y:2106
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 366:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2029
+//line sql.y:2110
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].colNameUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 367:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2033
+//line sql.y:2114
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 368:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2037
+//line sql.y:2118
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2041
+//line sql.y:2122
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2045
+//line sql.y:2126
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2049
+//line sql.y:2130
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2053
+//line sql.y:2134
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2057
+//line sql.y:2138
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2061
+//line sql.y:2142
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2065
+//line sql.y:2146
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 376:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2069
+//line sql.y:2150
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 377:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL AlterOption
+//line sql.y:2154
+		{
+			yyLOCAL = &DropKey{Type: CheckKeyType, Name: yyDollar[3].colIdent}
+		}
+		yyVAL.union = yyLOCAL
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2073
+//line sql.y:2158
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2077
+//line sql.y:2162
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 380:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2081
+//line sql.y:2166
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2087
+//line sql.y:2172
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2091
+//line sql.y:2176
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 374:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2097
+//line sql.y:2182
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2101
+//line sql.y:2186
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2105
+//line sql.y:2190
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2109
+//line sql.y:2194
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2113
+//line sql.y:2198
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2117
+//line sql.y:2202
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2121
+//line sql.y:2206
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2125
+//line sql.y:2210
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2129
+//line sql.y:2214
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 392:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2135
+//line sql.y:2220
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2141
+//line sql.y:2226
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8611,10 +8986,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 394:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2148
+//line sql.y:2233
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8622,28 +8997,39 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2155
+//line sql.y:2240
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 396:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2246
+		{
+			yyDollar[1].alterTableUnion().FullyParsed = true
+			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
+			yyDollar[1].alterTableUnion().PartitionOption = yyDollar[3].partitionOptionUnion()
+			yyLOCAL = yyDollar[1].alterTableUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 397:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2161
+//line sql.y:2253
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2165
+//line sql.y:2257
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8651,10 +9037,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 399:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2172
+//line sql.y:2264
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8662,10 +9048,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 400:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2179
+//line sql.y:2271
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -8678,10 +9064,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 401:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2191
+//line sql.y:2283
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -8692,26 +9078,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 402:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2201
+//line sql.y:2293
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 403:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2205
+//line sql.y:2297
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 404:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2209
+//line sql.y:2301
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -8725,10 +9111,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 405:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2222
+//line sql.y:2314
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -8739,18 +9125,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 406:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2232
+//line sql.y:2324
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 407:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2236
+//line sql.y:2328
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -8762,10 +9148,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 408:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2247
+//line sql.y:2339
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -8773,10 +9159,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 409:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2254
+//line sql.y:2346
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -8784,10 +9170,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 410:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2261
+//line sql.y:2353
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -8795,282 +9181,445 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 411:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2268
+//line sql.y:2360
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 412:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2276
+//line sql.y:2368
 		{
-			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
+			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: yyDollar[4].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2280
+//line sql.y:2372
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 414:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2284
+//line sql.y:2376
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 415:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2288
+//line sql.y:2380
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2292
+//line sql.y:2384
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2296
+//line sql.y:2388
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 418:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2300
+//line sql.y:2392
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2304
+//line sql.y:2396
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2308
+//line sql.y:2400
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2312
+//line sql.y:2404
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 422:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2316
+//line sql.y:2408
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2320
+//line sql.y:2412
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2324
+//line sql.y:2416
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2328
+//line sql.y:2420
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2332
+//line sql.y:2424
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2336
+//line sql.y:2428
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2340
+//line sql.y:2432
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2344
+//line sql.y:2436
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2348
+//line sql.y:2440
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2352
+//line sql.y:2444
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2356
+//line sql.y:2448
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2360
+//line sql.y:2452
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 434:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2365
+//line sql.y:2457
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2369
+//line sql.y:2461
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 436:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2373
+//line sql.y:2465
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2380
+//line sql.y:2472
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2384
+//line sql.y:2476
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 429:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 439:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2390
+//line sql.y:2482
 		{
-			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion(), Engine: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 440:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2394
+//line sql.y:2486
 		{
-			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true, Engine: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:2400
+	case 441:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2490
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true, Engine: yyDollar[7].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 442:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2494
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, InValues: yyDollar[6].exprsUnion(), Engine: yyDollar[8].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 443:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2498
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Engine: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 444:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2503
+		{
+			yyVAL.str = ""
+		}
+	case 445:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2507
+		{
+			yyVAL.str = yyDollar[3].tableIdent.String()
+		}
+	case 446:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2511
+		{
+			yyVAL.str = yyDollar[4].tableIdent.String()
+		}
+	case 447:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2516
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 448:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2520
+		{
+			yyLOCAL = yyDollar[1].partitionOptionUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 449:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2526
+		{
+			yyLOCAL = &PartitionOption{Linear: yyDollar[3].booleanUnion(), Type: HashPartitionType, Expr: yyDollar[6].exprUnion(), Partitions: yyDollar[8].literalUnion(), Definitions: yyDollar[9].partDefsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 450:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2530
+		{
+			yyLOCAL = &PartitionOption{Linear: yyDollar[3].booleanUnion(), Type: KeyPartitionType, ColList: yyDollar[6].columnsUnion(), Partitions: yyDollar[8].literalUnion(), Definitions: yyDollar[9].partDefsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 451:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2534
+		{
+			yyLOCAL = &PartitionOption{Type: RangePartitionType, Expr: yyDollar[5].exprUnion(), Partitions: yyDollar[7].literalUnion(), Definitions: yyDollar[8].partDefsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 452:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2538
+		{
+			yyLOCAL = &PartitionOption{Type: RangePartitionType, ColList: yyDollar[6].columnsUnion(), Partitions: yyDollar[8].literalUnion(), Definitions: yyDollar[9].partDefsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 453:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2542
+		{
+			yyLOCAL = &PartitionOption{Type: ListPartitionType, Expr: yyDollar[5].exprUnion(), Partitions: yyDollar[7].literalUnion(), Definitions: yyDollar[8].partDefsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 454:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2546
+		{
+			yyLOCAL = &PartitionOption{Type: ListPartitionType, ColList: yyDollar[6].columnsUnion(), Partitions: yyDollar[8].literalUnion(), Definitions: yyDollar[9].partDefsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 455:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2554
+		{
+			if NewColIdent(yyDollar[1].str).Lowered() != "hash" {
+				yylex.Error("syntax error")
+				return 1
+			}
+		}
+	case 456:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:2562
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 457:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:2566
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 458:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:2571
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 459:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:2575
+		{
+			yyLOCAL = NewIntLiteral(yyDollar[2].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 460:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*PartitionDefinition
+//line sql.y:2580
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 461:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*PartitionDefinition
+//line sql.y:2584
+		{
+			yyLOCAL = yyDollar[2].partDefsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 462:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2590
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2406
+//line sql.y:2596
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2410
+//line sql.y:2600
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 434:
+	case 465:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2416
+//line sql.y:2606
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 466:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2420
+//line sql.y:2610
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -9080,395 +9629,395 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 467:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2429
+//line sql.y:2619
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 468:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2433
+//line sql.y:2623
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2439
+//line sql.y:2629
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2443
+//line sql.y:2633
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2448
+//line sql.y:2638
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2454
+//line sql.y:2644
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2458
+//line sql.y:2648
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 474:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2462
+//line sql.y:2652
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2466
+//line sql.y:2656
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2470
+//line sql.y:2660
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2474
+//line sql.y:2664
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2478
+//line sql.y:2668
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2482
+//line sql.y:2672
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 480:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2486
+//line sql.y:2676
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 481:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2490
+//line sql.y:2680
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2494
+//line sql.y:2684
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2498
+//line sql.y:2688
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2502
+//line sql.y:2692
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2506
+//line sql.y:2696
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 486:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2510
+//line sql.y:2700
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 487:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2514
+//line sql.y:2704
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 488:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2518
+//line sql.y:2708
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 489:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2522
+//line sql.y:2712
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2526
+//line sql.y:2716
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 491:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2530
+//line sql.y:2720
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 492:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2534
+//line sql.y:2724
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 493:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2538
+//line sql.y:2728
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 494:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2542
+//line sql.y:2732
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 495:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2546
+//line sql.y:2736
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 496:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2550
+//line sql.y:2740
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 497:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2554
+//line sql.y:2744
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2558
+//line sql.y:2748
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 499:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2562
+//line sql.y:2752
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 500:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2566
+//line sql.y:2756
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2570
+//line sql.y:2760
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2574
+//line sql.y:2764
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2578
+//line sql.y:2768
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 504:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2582
+//line sql.y:2772
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 505:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2586
+//line sql.y:2776
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 506:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2590
+//line sql.y:2780
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 507:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2594
+//line sql.y:2784
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 508:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2598
+//line sql.y:2788
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 509:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2603
+//line sql.y:2793
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2607
+//line sql.y:2797
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2611
+//line sql.y:2801
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2615
+//line sql.y:2805
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2619
+//line sql.y:2809
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2623
+//line sql.y:2813
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2628
+//line sql.y:2818
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -9476,1234 +10025,1234 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2642
+//line sql.y:2832
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2646
+//line sql.y:2836
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2650
+//line sql.y:2840
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2656
+//line sql.y:2846
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 489:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2660
+//line sql.y:2850
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 490:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2666
+//line sql.y:2856
 		{
 			yyVAL.str = ""
 		}
-	case 491:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2670
+//line sql.y:2860
 		{
 			yyVAL.str = "extended "
 		}
-	case 492:
+	case 523:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2676
+//line sql.y:2866
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2680
+//line sql.y:2870
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2686
+//line sql.y:2876
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 495:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2690
+//line sql.y:2880
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 496:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2696
+//line sql.y:2886
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 497:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2700
+//line sql.y:2890
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 498:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2704
+//line sql.y:2894
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 499:
+	case 530:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2710
+//line sql.y:2900
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2714
+//line sql.y:2904
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2718
+//line sql.y:2908
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 533:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2724
+//line sql.y:2914
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2728
+//line sql.y:2918
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 535:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2734
+//line sql.y:2924
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 505:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2738
+//line sql.y:2928
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 506:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2742
+//line sql.y:2932
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 507:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2748
+//line sql.y:2938
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 539:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2752
+//line sql.y:2942
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2758
+//line sql.y:2948
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2762
+//line sql.y:2952
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2768
+//line sql.y:2958
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2774
+//line sql.y:2964
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 544:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2778
+//line sql.y:2968
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 545:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2783
+//line sql.y:2973
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 515:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2785
+//line sql.y:2975
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 516:
+	case 547:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2788
+//line sql.y:2978
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 517:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2790
+//line sql.y:2980
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 518:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2795
+//line sql.y:2985
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2801
+//line sql.y:2991
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 551:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2806
+//line sql.y:2996
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2810
+//line sql.y:3000
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2814
+//line sql.y:3004
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 554:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2818
+//line sql.y:3008
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 555:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2822
+//line sql.y:3012
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2826
+//line sql.y:3016
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2832
+//line sql.y:3022
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 527:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2836
+//line sql.y:3026
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 528:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2840
+//line sql.y:3030
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 529:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2846
+//line sql.y:3036
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2850
+//line sql.y:3040
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2854
+//line sql.y:3044
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2858
+//line sql.y:3048
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2863
+//line sql.y:3053
 		{
 			yyVAL.str = ""
 		}
-	case 534:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2867
+//line sql.y:3057
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 535:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2871
+//line sql.y:3061
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 536:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2877
+//line sql.y:3067
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2881
+//line sql.y:3071
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 569:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2887
+//line sql.y:3077
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2891
+//line sql.y:3081
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 571:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2897
+//line sql.y:3087
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:2903
+//line sql.y:3093
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 573:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2907
+//line sql.y:3097
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 543:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:2913
+//line sql.y:3103
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2919
+//line sql.y:3109
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2923
+//line sql.y:3113
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2927
+//line sql.y:3117
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2931
+//line sql.y:3121
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2937
+//line sql.y:3127
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2943
+//line sql.y:3133
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2949
+//line sql.y:3139
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2953
+//line sql.y:3143
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 583:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2957
+//line sql.y:3147
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2961
+//line sql.y:3151
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 585:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2965
+//line sql.y:3155
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 586:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2969
+//line sql.y:3159
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2975
+//line sql.y:3165
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 557:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2979
+//line sql.y:3169
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 558:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2985
+//line sql.y:3175
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 559:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2989
+//line sql.y:3179
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 560:
+	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2993
+//line sql.y:3183
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 561:
+	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2997
+//line sql.y:3187
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 562:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3001
+//line sql.y:3191
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 563:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3005
+//line sql.y:3195
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 564:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3009
+//line sql.y:3199
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 565:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3013
+//line sql.y:3203
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 566:
+	case 597:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3017
+//line sql.y:3207
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 567:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3021
+//line sql.y:3211
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 568:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3025
+//line sql.y:3215
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 569:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3029
+//line sql.y:3219
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 570:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3034
+//line sql.y:3224
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3038
+//line sql.y:3228
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3042
+//line sql.y:3232
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 604:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3047
+//line sql.y:3237
 		{
 			yyVAL.str = ""
 		}
-	case 574:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3051
+//line sql.y:3241
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 575:
+	case 606:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3056
+//line sql.y:3246
 		{
 			setAllowComments(yylex, true)
 		}
-	case 576:
+	case 607:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3060
+//line sql.y:3250
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 577:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3066
+//line sql.y:3256
 		{
 			yyVAL.strs = nil
 		}
-	case 578:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3070
+//line sql.y:3260
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 579:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3076
+//line sql.y:3266
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3080
+//line sql.y:3270
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3084
+//line sql.y:3274
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 613:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3089
+//line sql.y:3279
 		{
 			yyVAL.str = ""
 		}
-	case 583:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3283
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 584:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3097
+//line sql.y:3287
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 585:
+	case 616:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3102
+//line sql.y:3292
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3106
+//line sql.y:3296
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3110
+//line sql.y:3300
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3115
+//line sql.y:3305
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3119
+//line sql.y:3309
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3124
+//line sql.y:3314
 		{
 			yyVAL.strs = nil
 		}
-	case 591:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3128
+//line sql.y:3318
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 592:
+	case 623:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3132
+//line sql.y:3322
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 593:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3136
+//line sql.y:3326
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 594:
+	case 625:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3140
+//line sql.y:3330
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 595:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3146
+//line sql.y:3336
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 596:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3150
+//line sql.y:3340
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 597:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3344
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 598:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3158
+//line sql.y:3348
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 599:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3162
+//line sql.y:3352
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 600:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3166
+//line sql.y:3356
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 601:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3170
+//line sql.y:3360
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 602:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3176
+//line sql.y:3366
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3180
+//line sql.y:3370
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 604:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3186
+//line sql.y:3376
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3190
+//line sql.y:3380
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3194
+//line sql.y:3384
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 638:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3198
+//line sql.y:3388
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 639:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3203
+//line sql.y:3393
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 609:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3207
+//line sql.y:3397
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 610:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3211
+//line sql.y:3401
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 612:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3218
+//line sql.y:3408
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 613:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3223
+//line sql.y:3413
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3227
+//line sql.y:3417
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3233
+//line sql.y:3423
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3237
+//line sql.y:3427
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 619:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3247
+//line sql.y:3437
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3251
+//line sql.y:3441
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3255
+//line sql.y:3445
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3261
+//line sql.y:3451
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3267
+//line sql.y:3457
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 655:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3271
+//line sql.y:3461
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3276
+//line sql.y:3466
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3280
+//line sql.y:3470
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3286
+//line sql.y:3476
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3290
+//line sql.y:3480
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 629:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3296
+//line sql.y:3486
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3300
+//line sql.y:3490
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3304
+//line sql.y:3494
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 632:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3308
+//line sql.y:3498
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 633:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3314
+//line sql.y:3504
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3318
+//line sql.y:3508
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 635:
+	case 666:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3331
+//line sql.y:3521
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 667:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3335
+//line sql.y:3525
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 668:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3339
+//line sql.y:3529
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3343
+//line sql.y:3533
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3349
+//line sql.y:3539
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 640:
+	case 671:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3351
+//line sql.y:3541
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 641:
+	case 672:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3355
+//line sql.y:3545
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 642:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3357
+//line sql.y:3547
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 643:
+	case 674:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3361
+//line sql.y:3551
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 644:
+	case 675:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3363
+//line sql.y:3553
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 645:
+	case 676:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3366
+//line sql.y:3556
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3368
+//line sql.y:3558
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 647:
+	case 678:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3371
+//line sql.y:3561
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 648:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3375
+//line sql.y:3565
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 649:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3379
+//line sql.y:3569
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 651:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3386
+//line sql.y:3576
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 652:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3392
+//line sql.y:3582
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3396
+//line sql.y:3586
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3400
+//line sql.y:3590
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3406
+//line sql.y:3596
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 687:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3412
+//line sql.y:3602
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3416
+//line sql.y:3606
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3420
+//line sql.y:3610
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3424
+//line sql.y:3614
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3430
+//line sql.y:3620
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3434
+//line sql.y:3624
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -10712,668 +11261,668 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3444
+//line sql.y:3634
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 663:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3448
+//line sql.y:3638
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 664:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3454
+//line sql.y:3644
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 665:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3458
+//line sql.y:3648
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 666:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3464
+//line sql.y:3654
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 667:
+	case 698:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3469
+//line sql.y:3659
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 699:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3473
+//line sql.y:3663
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 700:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3477
+//line sql.y:3667
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 701:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3481
+//line sql.y:3671
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 702:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3485
+//line sql.y:3675
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 703:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3490
+//line sql.y:3680
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3494
+//line sql.y:3684
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3500
+//line sql.y:3690
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3504
+//line sql.y:3694
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3508
+//line sql.y:3698
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3512
+//line sql.y:3702
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3516
+//line sql.y:3706
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3520
+//line sql.y:3710
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3524
+//line sql.y:3714
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 712:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3528
+//line sql.y:3718
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 713:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3534
+//line sql.y:3724
 		{
 			yyVAL.str = ""
 		}
-	case 683:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3538
+//line sql.y:3728
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 684:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3544
+//line sql.y:3734
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3548
+//line sql.y:3738
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 717:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3554
+//line sql.y:3744
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3558
+//line sql.y:3748
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 719:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3562
+//line sql.y:3752
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 720:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3566
+//line sql.y:3756
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 721:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3570
+//line sql.y:3760
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3574
+//line sql.y:3764
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 723:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3578
+//line sql.y:3768
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 724:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3582
+//line sql.y:3772
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: BetweenOp, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 725:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3586
+//line sql.y:3776
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: NotBetweenOp, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3590
+//line sql.y:3780
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3596
+//line sql.y:3786
 		{
 			yyLOCAL = IsNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3600
+//line sql.y:3790
 		{
 			yyLOCAL = IsNotNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 729:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3604
+//line sql.y:3794
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3608
+//line sql.y:3798
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3612
+//line sql.y:3802
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3616
+//line sql.y:3806
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3622
+//line sql.y:3812
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3626
+//line sql.y:3816
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3630
+//line sql.y:3820
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3634
+//line sql.y:3824
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3638
+//line sql.y:3828
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3642
+//line sql.y:3832
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3646
+//line sql.y:3836
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 740:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3651
+//line sql.y:3841
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3655
+//line sql.y:3845
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3661
+//line sql.y:3851
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3665
+//line sql.y:3855
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3669
+//line sql.y:3859
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:3676
+//line sql.y:3866
 		{
 			yyLOCAL = &Subquery{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:3682
+//line sql.y:3872
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3686
+//line sql.y:3876
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 717:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3692
+//line sql.y:3882
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3696
+//line sql.y:3886
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3700
+//line sql.y:3890
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3704
+//line sql.y:3894
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3708
+//line sql.y:3898
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3712
+//line sql.y:3902
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3716
+//line sql.y:3906
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3720
+//line sql.y:3910
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3724
+//line sql.y:3914
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3728
+//line sql.y:3918
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3732
+//line sql.y:3922
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3736
+//line sql.y:3926
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3740
+//line sql.y:3930
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3744
+//line sql.y:3934
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3748
+//line sql.y:3938
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3752
+//line sql.y:3942
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3756
+//line sql.y:3946
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3760
+//line sql.y:3950
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3764
+//line sql.y:3954
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3768
+//line sql.y:3958
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 768:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3772
+//line sql.y:3962
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 769:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3776
+//line sql.y:3966
 		{
 			yyLOCAL = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3780
+//line sql.y:3970
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 771:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3784
+//line sql.y:3974
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 772:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3788
+//line sql.y:3978
 		{
 			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 773:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3792
+//line sql.y:3982
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 774:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3796
+//line sql.y:3986
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3800
+//line sql.y:3990
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 776:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3804
+//line sql.y:3994
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 777:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3808
+//line sql.y:3998
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -11382,666 +11931,844 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 783:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3826
+//line sql.y:4017
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 784:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3830
+//line sql.y:4021
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 785:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3834
+//line sql.y:4025
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 786:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3838
+//line sql.y:4029
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 787:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3848
+//line sql.y:4039
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 788:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3852
+//line sql.y:4043
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 789:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3856
+//line sql.y:4047
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 790:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3860
+//line sql.y:4051
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 791:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3864
+//line sql.y:4055
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 792:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3868
+//line sql.y:4059
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 793:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3872
+//line sql.y:4063
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 794:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3876
+//line sql.y:4067
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 795:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4071
+		{
+			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 796:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4075
+		{
+			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 797:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4079
+		{
+			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 798:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4083
+		{
+			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 799:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4087
+		{
+			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 800:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4091
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 801:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4101
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
+		}
+		yyVAL.union = yyLOCAL
+	case 802:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4105
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
+		}
+		yyVAL.union = yyLOCAL
+	case 803:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4109
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
+		}
+		yyVAL.union = yyLOCAL
+	case 804:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4114
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
+		}
+		yyVAL.union = yyLOCAL
+	case 805:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4119
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
+		}
+		yyVAL.union = yyLOCAL
+	case 806:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4124
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
+		}
+		yyVAL.union = yyLOCAL
+	case 807:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4130
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
+		}
+		yyVAL.union = yyLOCAL
+	case 808:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4135
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
+		}
+		yyVAL.union = yyLOCAL
+	case 809:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4140
+		{
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 810:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4144
+		{
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 811:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4148
+		{
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4153
+		{
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4158
+		{
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 814:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4163
+		{
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 815:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3880
+//line sql.y:4167
 		{
-			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
+			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
-		yyDollar = yyS[yypt-9 : yypt+1]
+	case 816:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3884
+//line sql.y:4171
 		{
-			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
+			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 819:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3888
+//line sql.y:4181
 		{
-			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
+			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 820:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3892
+//line sql.y:4192
 		{
-			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
+			funcExpr, ok := yyDollar[1].exprUnion().(*FuncExpr)
+			if !ok || funcExpr.Qualifier.String() != "" {
+				yylex.Error("syntax error: OVER is only allowed after a window or aggregate function")
+				return 1
+			}
+			yyLOCAL = &WindowFuncExpr{Func: funcExpr, Over: yyDollar[2].windowSpecUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 821:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3896
+		var yyLOCAL *WindowSpec
+//line sql.y:4203
 		{
-			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
+			yyLOCAL = yyDollar[3].windowSpecUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 822:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3900
+//line sql.y:4207
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
+			yylex.Error("unsupported: named windows")
+			return 1
 		}
-		yyVAL.union = yyLOCAL
-	case 769:
+	case 823:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4217
+		{
+		}
+	case 824:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3910
+//line sql.y:4220
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
+			yylex.Error("unsupported: named windows")
+			return 1
+		}
+	case 828:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *WindowSpec
+//line sql.y:4234
+		{
+			yyLOCAL = &WindowSpec{PartitionBy: yyDollar[1].exprsUnion(), OrderBy: yyDollar[2].orderByUnion(), Frame: yyDollar[3].frameClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3914
+	case 829:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4239
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3918
+	case 830:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4243
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
+			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3923
+	case 831:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4248
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 832:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3928
+		var yyLOCAL *FrameClause
+//line sql.y:4252
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitUnion(), Start: yyDollar[2].framePointUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3933
+	case 833:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4256
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitUnion(), Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3939
+	case 834:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnit
+//line sql.y:4262
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
+			yyLOCAL = RowsUnit
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3944
+	case 835:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnit
+//line sql.y:4266
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
+			yyLOCAL = RangeUnit
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 836:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3949
+		var yyLOCAL *FramePoint
+//line sql.y:4272
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: CurrentRowType}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3953
+		var yyLOCAL *FramePoint
+//line sql.y:4276
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: UnboundedPrecedingType}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3957
+		var yyLOCAL *FramePoint
+//line sql.y:4280
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: UnboundedFollowingType}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3962
+		var yyLOCAL *FramePoint
+//line sql.y:4284
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: NewIntLiteral(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 840:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3967
+		var yyLOCAL *FramePoint
+//line sql.y:4288
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: NewIntLiteral(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 841:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3972
+		var yyLOCAL *FramePoint
+//line sql.y:4292
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: NewArgument(yyDollar[1].str[1:])}
+			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3976
+	case 842:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4297
 		{
-			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: NewArgument(yyDollar[1].str[1:])}
+			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3980
+	case 843:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4302
 		{
-			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3990
+	case 844:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4306
 		{
-			yyLOCAL = yyDollar[2].exprUnion()
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 845:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4000
+//line sql.y:4316
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 846:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4004
+//line sql.y:4320
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 847:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4008
+//line sql.y:4324
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 848:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4012
+//line sql.y:4328
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 849:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4016
+//line sql.y:4332
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 850:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4020
+//line sql.y:4336
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 851:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4024
+//line sql.y:4340
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4030
+//line sql.y:4346
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4034
+//line sql.y:4350
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 854:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4038
+//line sql.y:4354
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 855:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4042
+//line sql.y:4358
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 856:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4046
+//line sql.y:4362
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4052
+//line sql.y:4368
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 801:
+	case 858:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4056
+//line sql.y:4372
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 802:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4060
+//line sql.y:4376
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 803:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4066
+//line sql.y:4382
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4070
+//line sql.y:4386
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 862:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4074
+//line sql.y:4390
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4078
+//line sql.y:4394
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 864:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4082
+//line sql.y:4398
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 865:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4086
+//line sql.y:4402
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4092
+//line sql.y:4408
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4096
+//line sql.y:4412
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 868:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4100
+//line sql.y:4416
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 869:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4104
+//line sql.y:4420
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4108
+//line sql.y:4424
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 871:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4112
+//line sql.y:4428
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4116
+//line sql.y:4432
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 873:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4121
+//line sql.y:4437
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 874:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4125
+//line sql.y:4441
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 875:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4130
+//line sql.y:4446
 		{
 			yyVAL.str = string("")
 		}
-	case 819:
+	case 876:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4134
+//line sql.y:4450
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 820:
+	case 877:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4140
+//line sql.y:4456
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4144
+//line sql.y:4460
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 822:
+	case 879:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4150
+//line sql.y:4466
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 880:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4155
+//line sql.y:4471
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 881:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4159
+//line sql.y:4475
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4165
+//line sql.y:4481
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4169
+//line sql.y:4485
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 884:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4173
+//line sql.y:4489
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4179
+//line sql.y:4495
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4183
+//line sql.y:4499
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4187
+//line sql.y:4503
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4191
+//line sql.y:4507
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4195
+//line sql.y:4511
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 890:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4199
+//line sql.y:4515
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4203
+//line sql.y:4519
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4208
+//line sql.y:4524
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4214
+//line sql.y:4530
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -12051,707 +12778,707 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 894:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4223
+//line sql.y:4539
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 895:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4227
+//line sql.y:4543
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 896:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4233
+//line sql.y:4549
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4237
+//line sql.y:4553
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 898:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4242
+//line sql.y:4558
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 899:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4246
+//line sql.y:4562
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 900:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4251
+//line sql.y:4567
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 901:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4255
+//line sql.y:4571
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4261
+//line sql.y:4577
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 903:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4265
+//line sql.y:4581
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 847:
+	case 904:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4271
+//line sql.y:4587
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 905:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4276
+//line sql.y:4592
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4280
+//line sql.y:4596
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4284
+//line sql.y:4600
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 908:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4289
+//line sql.y:4605
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 909:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4293
+//line sql.y:4609
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 910:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4297
+//line sql.y:4613
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 911:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4301
+//line sql.y:4617
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 912:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4306
+//line sql.y:4622
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 913:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4310
+//line sql.y:4626
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 914:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4314
+//line sql.y:4630
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 915:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4318
+//line sql.y:4634
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 916:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4322
+//line sql.y:4638
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4329
+//line sql.y:4645
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 918:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4333
+//line sql.y:4649
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4337
+//line sql.y:4653
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 920:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4341
+//line sql.y:4657
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4347
+//line sql.y:4663
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 922:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4351
+//line sql.y:4667
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4355
+//line sql.y:4671
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 924:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4360
+//line sql.y:4676
 		{
 			yyVAL.str = ""
 		}
-	case 868:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4364
+//line sql.y:4680
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 869:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4368
+//line sql.y:4684
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 870:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4372
+//line sql.y:4688
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 871:
+	case 928:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4377
+//line sql.y:4693
 		{
 			yyVAL.str = ""
 		}
-	case 872:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4381
+//line sql.y:4697
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 873:
+	case 930:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4387
+//line sql.y:4703
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 874:
+	case 931:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4391
+//line sql.y:4707
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 875:
+	case 932:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4396
+//line sql.y:4712
 		{
 			yyVAL.str = ""
 		}
-	case 876:
+	case 933:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4400
+//line sql.y:4716
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 877:
+	case 934:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4405
+//line sql.y:4721
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 878:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4409
+//line sql.y:4725
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 879:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4413
+//line sql.y:4729
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 880:
+	case 937:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4418
+//line sql.y:4734
 		{
 			yyVAL.str = ""
 		}
-	case 881:
+	case 938:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4422
+//line sql.y:4738
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 882:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4428
+//line sql.y:4744
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 883:
+	case 940:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4432
+//line sql.y:4748
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 884:
+	case 941:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4436
+//line sql.y:4752
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 885:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4440
+//line sql.y:4756
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 886:
+	case 943:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4445
+//line sql.y:4761
 		{
 			yyLOCAL = NoLock
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 944:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4449
+//line sql.y:4765
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 945:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4453
+//line sql.y:4769
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 946:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4458
+//line sql.y:4774
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 947:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4462
+//line sql.y:4778
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4466
+//line sql.y:4782
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 949:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4470
+//line sql.y:4786
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 950:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4475
+//line sql.y:4791
 		{
 			yyVAL.str = ""
 		}
-	case 894:
+	case 951:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4479
+//line sql.y:4795
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 895:
+	case 952:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4483
+//line sql.y:4799
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 896:
+	case 953:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4488
+//line sql.y:4804
 		{
 			yyVAL.str = ""
 		}
-	case 897:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4492
+//line sql.y:4808
 		{
 			yyVAL.str = " header"
 		}
-	case 898:
+	case 955:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4497
+//line sql.y:4813
 		{
 			yyVAL.str = ""
 		}
-	case 899:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4501
+//line sql.y:4817
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 900:
+	case 957:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4505
+//line sql.y:4821
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 901:
+	case 958:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4510
+//line sql.y:4826
 		{
 			yyVAL.str = ""
 		}
-	case 902:
+	case 959:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4514
+//line sql.y:4830
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 903:
+	case 960:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4518
+//line sql.y:4834
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 904:
+	case 961:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4524
+//line sql.y:4840
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 905:
+	case 962:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4529
+//line sql.y:4845
 		{
 			yyVAL.str = ""
 		}
-	case 906:
+	case 963:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4533
+//line sql.y:4849
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 907:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4539
+//line sql.y:4855
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 908:
+	case 965:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4543
+//line sql.y:4859
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 909:
+	case 966:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4549
+//line sql.y:4865
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 910:
+	case 967:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4553
+//line sql.y:4869
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 911:
+	case 968:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4558
+//line sql.y:4874
 		{
 			yyVAL.str = ""
 		}
-	case 912:
+	case 969:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4562
+//line sql.y:4878
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 913:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4568
+//line sql.y:4884
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 914:
+	case 971:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4572
+//line sql.y:4888
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 915:
+	case 972:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4578
+//line sql.y:4894
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 916:
+	case 973:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4582
+//line sql.y:4898
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 917:
+	case 974:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4586
+//line sql.y:4902
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 918:
+	case 975:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4591
+//line sql.y:4907
 		{
 			yyVAL.str = ""
 		}
-	case 919:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4595
+//line sql.y:4911
 		{
 			yyVAL.str = " optionally"
 		}
-	case 920:
+	case 977:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4608
+//line sql.y:4924
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4612
+//line sql.y:4928
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 979:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4616
+//line sql.y:4932
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 980:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4620
+//line sql.y:4936
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 981:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4624
+//line sql.y:4940
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4630
+//line sql.y:4946
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 983:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4634
+//line sql.y:4950
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 984:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4638
+//line sql.y:4954
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 928:
+	case 985:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4642
+//line sql.y:4958
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 929:
+	case 986:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4647
+//line sql.y:4963
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 987:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4651
+//line sql.y:4967
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:4657
+//line sql.y:4973
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 989:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4661
+//line sql.y:4977
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 933:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4667
+//line sql.y:4983
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4671
+//line sql.y:4987
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 992:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4677
+//line sql.y:4993
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4683
+//line sql.y:4999
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -12760,329 +13487,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4693
+//line sql.y:5009
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 995:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4697
+//line sql.y:5013
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 939:
+	case 996:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:4703
+//line sql.y:5019
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:4709
+//line sql.y:5025
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 998:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4713
+//line sql.y:5029
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 942:
+	case 999:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4719
+//line sql.y:5035
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1000:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4723
+//line sql.y:5039
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1001:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4727
+//line sql.y:5043
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1002:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4731
+//line sql.y:5047
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1003:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4735
+//line sql.y:5051
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1005:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4743
+//line sql.y:5059
 		{
 			yyVAL.str = "charset"
 		}
-	case 951:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4753
+//line sql.y:5069
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4757
+//line sql.y:5073
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4761
+//line sql.y:5077
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1013:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4770
+//line sql.y:5086
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4772
+//line sql.y:5088
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4775
+//line sql.y:5091
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1016:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4777
+//line sql.y:5093
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1017:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4780
+//line sql.y:5096
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1018:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4782
+//line sql.y:5098
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1019:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4785
+//line sql.y:5101
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4787
+//line sql.y:5103
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1021:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4790
+//line sql.y:5106
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 965:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4792
+//line sql.y:5108
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 966:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4794
+//line sql.y:5110
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 967:
+	case 1024:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:4798
+//line sql.y:5114
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1025:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4803
+//line sql.y:5119
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4807
+//line sql.y:5123
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1027:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4812
+//line sql.y:5128
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1028:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4814
+//line sql.y:5130
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1029:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:4818
+//line sql.y:5134
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1030:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4824
+//line sql.y:5140
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 974:
+	case 1031:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4828
+//line sql.y:5144
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 976:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4835
+//line sql.y:5151
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 977:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4841
+//line sql.y:5157
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 978:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4845
+//line sql.y:5161
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 979:
+	case 1036:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4851
+//line sql.y:5167
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 980:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4855
+//line sql.y:5171
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 982:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4862
+//line sql.y:5178
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1396:
+	case 1459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5300
+//line sql.y:5622
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1397:
+	case 1460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5309
+//line sql.y:5631
 		{
 			decNesting(yylex)
 		}
-	case 1398:
+	case 1461:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5314
+//line sql.y:5636
 		{
 			skipToEnd(yylex)
 		}
-	case 1399:
+	case 1462:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5319
+//line sql.y:5641
 		{
 			skipToEnd(yylex)
 		}
-	case 1400:
+	case 1463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5323
+//line sql.y:5645
 		{
 			skipToEnd(yylex)
 		}
-	case 1401:
+	case 1464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5327
+//line sql.y:5649
 		{
 			skipToEnd(yylex)
 		}
@@ -100,6 +100,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   indexColumn   *IndexColumn
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
+  partitionOption *PartitionOption
   showFilter    *ShowFilter
   optLike       *OptLike
   selectInto	  *SelectInto
@@ -113,6 +114,12 @@ func bindVariable(yylex yyLexer, bvar string) {
   constraintDefinition *ConstraintDefinition
   revertMigration *RevertMigration
   alterMigration  *AlterMigration
+  with          *With
+  cte           *CommonTableExpr
+  windowSpec    *WindowSpec
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  frameUnit     FrameUnit
 
   whens         []*When
   columnDefinitions []*ColumnDefinition
@@ -125,6 +132,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   vindexParams  []VindexParam
   partDefs      []*PartitionDefinition
   partSpecs     []*PartitionSpec
+  ctes          []*CommonTableExpr
   characteristics []Characteristic
   selectExpr    SelectExpr
   columns       Columns
@@ -187,6 +195,8 @@ func bindVariable(yylex yyLexer, bvar string) {
 // * NOTE: If you change anything here, update precedence.go as well *
 %nonassoc <str> LOWER_THAN_CHARSET
 %nonassoc <str> CHARSET
+%nonassoc <str> AFTER REMOVE
+%nonassoc <str> FIRST_WITHOUT_COLUMN
 // Resolve column attribute ambiguity.
 %right <str> UNIQUE KEY
 %left <str> OR
@@ -217,11 +227,12 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> REVERT
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK INDEXES
 %token <str> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
-%token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE COALESCE EXCHANGE REBUILD PARTITIONING REMOVE
+%token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE COALESCE EXCHANGE REBUILD PARTITIONING
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <str> LINEAR LIST PARTITIONS
 %token <str> VINDEX VINDEXES DIRECTORY NAME UPGRADE
 %token <str> STATUS VARIABLES WARNINGS CASCADED DEFINER OPTION SQL UNDEFINED
-%token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST AFTER LAST
+%token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST LAST
 
 // Migration tokens
 %token <str> VITESS_MIGRATION CANCEL RETRY COMPLETE
@@ -262,6 +273,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
 
+// Window functions
+%token <str> ROWS ROW RANGE CURRENT
+
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <str> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
 %token <str> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
@@ -286,6 +300,15 @@ func bindVariable(yylex yyLexer, bvar string) {
 
 %type <statement> command
 %type <selStmt> simple_select select_statement base_select union_rhs
+%type <with> with_clause
+%type <ctes> with_list
+%type <cte> common_table_expr
+%type <expr> function_call_window
+%type <windowSpec> over_clause window_spec
+%type <exprs> window_partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <frameUnit> frame_unit
+%type <framePoint> frame_point
 %type <statement> explain_statement explainable_statement
 %type <statement> stream_statement vstream_statement insert_statement update_statement delete_statement set_statement set_transaction_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement do_statement
@@ -412,6 +435,11 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <partDefs> partition_definitions
 %type <partDef> partition_definition
 %type <partSpec> partition_operation
+%type <partitionOption> partitions_options_opt partition_option
+%type <partDefs> partition_definitions_opt
+%type <boolean> linear_opt
+%type <literal> partitions_num_opt
+%type <str> partition_engine_opt
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
 %type <colIdent> id_or_var vindex_type vindex_type_opt id_or_var_opt
@@ -524,6 +552,16 @@ select_statement:
     sel.Into = $5
     $$ = sel
   }
+| with_clause base_select order_by_opt limit_opt lock_opt into_option
+  {
+    sel := $2.(*Select)
+    sel.With = $1
+    sel.OrderBy = $3
+    sel.Limit = $4
+    sel.Lock = $5
+    sel.Into = $6
+    $$ = sel
+  }
 | openb select_statement closeb order_by_opt limit_opt lock_opt
   {
     $$ = &Union{FirstStatement: &ParenSelect{Select: $2}, OrderBy: $4, Limit:$5, Lock:$6}
@@ -563,11 +601,46 @@ simple_select:
     sel.Lock = $4
     $$ = sel
   }
+| with_clause base_select order_by_opt limit_opt lock_opt
+  {
+    sel := $2.(*Select)
+    sel.With = $1
+    sel.OrderBy = $3
+    sel.Limit = $4
+    sel.Lock = $5
+    $$ = sel
+  }
 | simple_select union_op union_rhs order_by_opt limit_opt lock_opt
   {
     $$ = Unionize($1, $3, $2, $4, $5, $6)
   }
 
+with_clause:
+  WITH with_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE with_list
+  {
+    $$ = &With{CTEs: $3, Recursive: true}
+  }
+
+with_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| with_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{TableID: $1, Columns: $2, Subquery: $4}
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -582,8 +655,8 @@ vstream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8          9
+  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
     $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
   }
@@ -821,7 +894,7 @@ vindex_type_opt:
   }
 
 vindex_type:
-  id_or_var
+  sql_id
   {
     $$ = $1
   }
@@ -908,10 +981,11 @@ database_or_schema:
 | SCHEMA
 
 table_spec:
-  '(' table_column_list ')' table_option_list_opt
+  '(' table_column_list ')' table_option_list_opt partitions_options_opt
   {
     $$ = $2
     $$.Options = $4
+    $$.Partitions = $5
   }
 
 create_options_opt:
@@ -1055,7 +1129,7 @@ generated_always_opt:
 // was specific (as stated in the MySQL guide) and did not accept arbitrary order options. For example NOT NULL DEFAULT 1 and not DEFAULT 1 NOT NULL
 column_attribute_list_opt:
   {
-    $$ = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
+    $$ = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ColKeyNone, Comment: nil, As: nil}
   }
 | column_attribute_list_opt NULL
   {
@@ -1140,19 +1214,19 @@ generated_column_attribute_list_opt:
 keys:
   PRIMARY KEY
   {
-    $$ = colKeyPrimary
+    $$ = ColKeyPrimary
   }
 | UNIQUE
   {
-    $$ = colKeyUnique
+    $$ = ColKeyUnique
   }
 | UNIQUE KEY
   {
-    $$ = colKeyUniqueKey
+    $$ = ColKeyUniqueKey
   }
 | KEY
   {
-    $$ = colKey
+    $$ = ColKey
   }
 
 column_type:
@@ -1935,10 +2009,17 @@ column_opt:
   }
 | COLUMN
 
+// A plain FIRST can be followed by AFTER or REMOVE PARTITIONING, which are
+// also valid column names. The %prec override below makes the parser read
+// them as keywords, as MySQL does.
 first_opt:
   {
     $$ = nil
   }
+| FIRST %prec FIRST_WITHOUT_COLUMN
+  {
+    $$ = &ColName{}
+  }
 | FIRST column_name
   {
     $$ = $2
@@ -2069,6 +2150,10 @@ alter_option:
   {
     $$ = &DropKey{Type:ForeignKeyType, Name:$4}
   }
+| DROP CHECK id_or_var
+  {
+    $$ = &DropKey{Type:CheckKeyType, Name:$3}
+  }
 | FORCE
   {
     $$ = &Force{}
@@ -2157,6 +2242,13 @@ alter_statement:
     $1.PartitionSpec = $2
     $$ = $1
   }
+| alter_table_prefix alter_commands_list partition_option
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $1.PartitionOption = $3
+    $$ = $1
+  }
 | ALTER comment_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
   {
     $$ = &AlterView{ViewName: $7.ToViewName(), Algorithm:$3, Definer: $4 ,Security:$5, Columns:$8, Select: $10, CheckOption: $11 }
@@ -2272,9 +2364,9 @@ alter_statement:
   }
 
 partition_operation:
-  ADD PARTITION '(' partition_definition ')'
+  ADD PARTITION openb partition_definitions closeb
   {
-    $$ = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{$4}}
+    $$ = &PartitionSpec{Action: AddAction, Definitions: $4}
   }
 | DROP PARTITION partition_list
   {
@@ -2386,13 +2478,111 @@ partition_definitions:
   }
 
 partition_definition:
-  PARTITION sql_id VALUES LESS THAN openb value_expression closeb
+  PARTITION sql_id VALUES LESS THAN openb value_expression closeb partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Limit: $7, Engine: $9}
+  }
+| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true, Engine: $9}
+  }
+| PARTITION sql_id VALUES LESS THAN MAXVALUE partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true, Engine: $7}
+  }
+| PARTITION sql_id VALUES IN openb expression_list closeb partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, InValues: $6, Engine: $8}
+  }
+| PARTITION sql_id partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Engine: $3}
+  }
+
+partition_engine_opt:
+  {
+    $$ = ""
+  }
+| ENGINE equal_opt table_alias
+  {
+    $$ = $3.String()
+  }
+| STORAGE ENGINE equal_opt table_alias
+  {
+    $$ = $4.String()
+  }
+
+partitions_options_opt:
+  {
+    $$ = nil
+  }
+| partition_option
+  {
+    $$ = $1
+  }
+
+partition_option:
+  PARTITION BY linear_opt hash_keyword openb expression closeb partitions_num_opt partition_definitions_opt
+  {
+    $$ = &PartitionOption{Linear: $3, Type: HashPartitionType, Expr: $6, Partitions: $8, Definitions: $9}
+  }
+| PARTITION BY linear_opt KEY openb column_list closeb partitions_num_opt partition_definitions_opt
+  {
+    $$ = &PartitionOption{Linear: $3, Type: KeyPartitionType, ColList: $6, Partitions: $8, Definitions: $9}
+  }
+| PARTITION BY RANGE openb expression closeb partitions_num_opt partition_definitions_opt
+  {
+    $$ = &PartitionOption{Type: RangePartitionType, Expr: $5, Partitions: $7, Definitions: $8}
+  }
+| PARTITION BY RANGE COLUMNS openb column_list closeb partitions_num_opt partition_definitions_opt
+  {
+    $$ = &PartitionOption{Type: RangePartitionType, ColList: $6, Partitions: $8, Definitions: $9}
+  }
+| PARTITION BY LIST openb expression closeb partitions_num_opt partition_definitions_opt
+  {
+    $$ = &PartitionOption{Type: ListPartitionType, Expr: $5, Partitions: $7, Definitions: $8}
+  }
+| PARTITION BY LIST COLUMNS openb column_list closeb partitions_num_opt partition_definitions_opt
+  {
+    $$ = &PartitionOption{Type: ListPartitionType, ColList: $6, Partitions: $8, Definitions: $9}
+  }
+
+// HASH is not a keyword, so that vindexes named hash keep being formatted
+// without quotes.
+hash_keyword:
+  ID
+  {
+    if NewColIdent($1).Lowered() != "hash" {
+      yylex.Error("syntax error")
+      return 1
+    }
+  }
+
+linear_opt:
+  {
+    $$ = false
+  }
+| LINEAR
+  {
+    $$ = true
+  }
+
+partitions_num_opt:
+  {
+    $$ = nil
+  }
+| PARTITIONS INTEGRAL
+  {
+    $$ = NewIntLiteral($2)
+  }
+
+partition_definitions_opt:
   {
-    $$ = &PartitionDefinition{Name: $2, Limit: $7}
+    $$ = nil
   }
-| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb
+| openb partition_definitions closeb
   {
-    $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
+    $$ = $2
   }
 
 rename_statement:
@@ -3816,6 +4006,7 @@ value_expression:
 | function_call_keyword
 | function_call_nonkeyword
 | function_call_conflict
+| function_call_window
 
 /*
   Regular function calls without special token or syntax, guaranteed to not
@@ -3991,6 +4182,131 @@ func_datetime_precision:
     $$ = $2
   }
 
+/*
+  Window functions, and aggregate functions used as window functions.
+  The names of the window functions are not reserved by this grammar,
+  so they are parsed as regular function calls followed by an OVER clause.
+*/
+function_call_window:
+  function_call_generic over_clause
+  {
+    funcExpr, ok := $1.(*FuncExpr)
+    if !ok || funcExpr.Qualifier.String() != "" {
+      yylex.Error("syntax error: OVER is only allowed after a window or aggregate function")
+      return 1
+    }
+    $$ = &WindowFuncExpr{Func: funcExpr, Over: $2}
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = $3
+  }
+| OVER sql_id
+  {
+    yylex.Error("unsupported: named windows")
+    return 1
+  }
+
+/*
+  Named windows are not supported. The WINDOW clause is parsed so that
+  queries using it get a clear error, rather than a syntax error.
+*/
+window_clause_opt:
+  {
+  }
+| WINDOW window_definition_list
+  {
+    yylex.Error("unsupported: named windows")
+    return 1
+  }
+
+window_definition_list:
+  window_definition
+| window_definition_list ',' window_definition
+
+window_definition:
+  sql_id AS openb window_spec closeb
+
+window_spec:
+  window_partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpec{PartitionBy: $1, OrderBy: $2, Frame: $3}
+  }
+
+window_partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsUnit
+  }
+| RANGE
+  {
+    $$ = RangeUnit
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| INTEGRAL PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: NewIntLiteral($1)}
+  }
+| INTEGRAL FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: NewIntLiteral($1)}
+  }
+| VALUE_ARG PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: NewArgument($1[1:])}
+    bindVariable(yylex, $1[1:])
+  }
+| VALUE_ARG FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: NewArgument($1[1:])}
+    bindVariable(yylex, $1[1:])
+  }
+| INTERVAL value_expression sql_id PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: &IntervalExpr{Expr: $2, Unit: $3.String()}}
+  }
+| INTERVAL value_expression sql_id FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: &IntervalExpr{Expr: $2, Unit: $3.String()}}
+  }
+
 /*
   Function calls using non reserved keywords with *normal* syntax forms. Because
   the names are non-reserved, they need a dedicated rule so as not to conflict
@@ -4978,6 +5294,7 @@ reserved_keyword:
 | REPLACE
 | RIGHT
 | ROW_NUMBER
+| RANGE
 | SCHEMA
 | SCHEMAS
 | SELECT
@@ -5059,6 +5376,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -5134,6 +5452,7 @@ non_reserved_keyword:
 | LEVEL
 | LINES
 | LINESTRING
+| LIST
 | LOAD
 | LOCAL
 | LOCKED
@@ -5183,6 +5502,7 @@ non_reserved_keyword:
 | PACK_KEYS
 | PARSER
 | PARTITIONING
+| PARTITIONS
 | PASSWORD
 | PATH
 | PERSIST
@@ -5218,6 +5538,8 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
 | ROW_FORMAT
 | S3
 | SECONDARY
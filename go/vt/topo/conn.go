@@ -154,6 +154,36 @@ type Conn interface {
 	// filePath is a path relative to the root directory of the cell.
 	Watch(ctx context.Context, filePath string) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc)
 
+	// WatchRecursive starts watching all the files under a
+	// directory in the provided cell, recursively. It returns the
+	// current value of all the files, a 'changes' channel to read
+	// the changes from, and a 'cancel' function to call to stop
+	// the watch. If the initial read fails, err is set, and
+	// 'current'/'changes'/'cancel' are nil. The directory doesn't
+	// need to exist yet. The provided context is only used to
+	// setup the current watch, and not after WatchRecursive()
+	// returns.
+	//
+	// Each record on the 'changes' channel has the Path of the
+	// file it's about:
+	// - Err is nil when the file was created or updated, and
+	//   Contents / Version are set.
+	// - Err is ErrNoNode when the file was deleted. The watch
+	//   goes on.
+	// Any other error ends the watch, and the channel is closed
+	// right after that record. Like for Watch, calling 'cancel'
+	// eventually results in a final ErrInterrupted record, and
+	// 'changes' has to be drained of all events.
+	//
+	// The same guarantees as Watch apply to each file: the
+	// 'changes' channel may return twice the same Version /
+	// Contents, or skip intermediate versions of a file, but it
+	// eventually converges to the current state of the directory.
+	//
+	// dirPath is a path relative to the root directory of the cell,
+	// and the returned Paths are relative to it too.
+	WatchRecursive(ctx context.Context, dirPath string) (current []*WatchDataRecursive, changes <-chan *WatchDataRecursive, cancel CancelFunc, err error)
+
 	//
 	// Master election methods. This is meant to have a small
 	// number of processes elect a master within a group. The
@@ -270,6 +300,17 @@ type WatchData struct {
 	Err error
 }
 
+// WatchDataRecursive is the structure returned by the WatchRecursive() API.
+// It has the same fields as WatchData, and the Path of the file they
+// are about, since a whole directory is watched.
+type WatchDataRecursive struct {
+	// Path is the path of the file, relative to the root
+	// directory of the cell.
+	Path string
+
+	WatchData
+}
+
 // MasterParticipation is the object returned by NewMasterParticipation.
 // Sample usage:
 //
@@ -19,6 +19,7 @@ package consultopo
 import (
 	"flag"
 	"path"
+	"strings"
 	"time"
 
 	"context"
@@ -127,3 +128,126 @@ func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <
 
 	return wd, notifications, topo.CancelFunc(watchCancel)
 }
+
+// WatchRecursive is part of the topo.Conn interface.
+func (s *Server) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	// Initial list.
+	nodePath := path.Join(s.root, dirPath) + "/"
+	if nodePath == "//" {
+		// Special case where s.root is "/", dirPath is empty,
+		// we would end up with "//". in that case, we want "/".
+		nodePath = "/"
+	}
+	pairs, meta, err := s.kv.List(nodePath, nil)
+	if err != nil {
+		return nil, nil, nil, convertError(err, nodePath)
+	}
+
+	// Remember the version of all the files, to find out
+	// which ones changed on each poll.
+	versions := make(map[string]uint64, len(pairs))
+	var initial []*topo.WatchDataRecursive
+	for _, pair := range pairs {
+		versions[pair.Key] = pair.ModifyIndex
+		initial = append(initial, &topo.WatchDataRecursive{
+			Path: s.relativePath(pair.Key),
+			WatchData: topo.WatchData{
+				Contents: pair.Value,
+				Version:  ConsulVersion(pair.ModifyIndex),
+			},
+		})
+	}
+
+	// Create a context, will be used to cancel the watch.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+
+	// Create the notifications channel, send updates to it.
+	notifications := make(chan *topo.WatchDataRecursive, 10)
+	go func() {
+		defer close(notifications)
+
+		var getCtx context.Context
+		// Initialize to no-op function to avoid having to check for nil.
+		cancelGetCtx := func() {}
+
+		defer cancelGetCtx()
+
+		waitIndex := meta.LastIndex
+		for {
+			// Wait/poll until something changes under the
+			// directory. The List returns the current
+			// contents at the end of WaitTime if nothing
+			// changed, so the versions have to be compared.
+			opts := &api.QueryOptions{
+				WaitIndex: waitIndex,
+				WaitTime:  *watchPollDuration,
+			}
+
+			// Make a new Context for just this one List() call,
+			// see Watch for the details.
+			cancelGetCtx()
+			getCtx, cancelGetCtx = context.WithTimeout(watchCtx, 2*opts.WaitTime)
+
+			pairs, meta, err := s.kv.List(nodePath, opts.WithContext(getCtx))
+			if err != nil {
+				// Serious error or context timeout/cancelled.
+				notifications <- &topo.WatchDataRecursive{
+					Path:      dirPath,
+					WatchData: topo.WatchData{Err: convertError(err, nodePath)},
+				}
+				cancelGetCtx()
+				return
+			}
+			waitIndex = meta.LastIndex
+
+			// Send the new and changed files.
+			seen := make(map[string]bool, len(pairs))
+			for _, pair := range pairs {
+				seen[pair.Key] = true
+				if version, ok := versions[pair.Key]; ok && version == pair.ModifyIndex {
+					continue
+				}
+				versions[pair.Key] = pair.ModifyIndex
+				notifications <- &topo.WatchDataRecursive{
+					Path: s.relativePath(pair.Key),
+					WatchData: topo.WatchData{
+						Contents: pair.Value,
+						Version:  ConsulVersion(pair.ModifyIndex),
+					},
+				}
+			}
+
+			// And the deleted ones.
+			for key := range versions {
+				if seen[key] {
+					continue
+				}
+				delete(versions, key)
+				filePath := s.relativePath(key)
+				notifications <- &topo.WatchDataRecursive{
+					Path:      filePath,
+					WatchData: topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)},
+				}
+			}
+
+			// See if the watch was canceled.
+			select {
+			case <-watchCtx.Done():
+				notifications <- &topo.WatchDataRecursive{
+					Path:      dirPath,
+					WatchData: topo.WatchData{Err: convertError(watchCtx.Err(), nodePath)},
+				}
+				cancelGetCtx()
+				return
+			default:
+			}
+		}
+	}()
+
+	return initial, notifications, topo.CancelFunc(watchCancel), nil
+}
+
+// relativePath returns the path of a key relative to the root of the cell.
+func (s *Server) relativePath(key string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(key, s.root), "/")
+}
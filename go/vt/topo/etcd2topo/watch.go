@@ -18,6 +18,7 @@ package etcd2topo
 
 import (
 	"path"
+	"strings"
 	"time"
 
 	"context"
@@ -139,3 +140,125 @@ func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <
 
 	return wd, notifications, topo.CancelFunc(outerCancel)
 }
+
+// WatchRecursive is part of the topo.Conn interface.
+func (s *Server) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	nodePath := path.Join(s.root, dirPath)
+	if !strings.HasSuffix(nodePath, "/") {
+		nodePath = nodePath + "/"
+	}
+
+	// Get the initial version of all the files.
+	initial, err := s.cli.Get(ctx, nodePath, clientv3.WithPrefix())
+	if err != nil {
+		return nil, nil, nil, convertError(err, nodePath)
+	}
+	var initialwd []*topo.WatchDataRecursive
+	for _, kv := range initial.Kvs {
+		initialwd = append(initialwd, &topo.WatchDataRecursive{
+			Path: s.relativePath(kv.Key),
+			WatchData: topo.WatchData{
+				Contents: kv.Value,
+				Version:  EtcdVersion(kv.ModRevision),
+			},
+		})
+	}
+
+	// Create an outer context that will be canceled on return and will cancel all inner watches.
+	outerCtx, outerCancel := context.WithCancel(context.Background())
+
+	// Create a context, will be used to cancel the watch on retry.
+	watchCtx, watchCancel := context.WithCancel(outerCtx)
+
+	// Create the Watcher. We start watching from the response we
+	// got, as the server may not have more history.
+	watcher := s.cli.Watch(watchCtx, nodePath, clientv3.WithRev(initial.Header.Revision), clientv3.WithPrefix())
+	if watcher == nil {
+		watchCancel()
+		outerCancel()
+		return nil, nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "WatchRecursive failed")
+	}
+
+	// Create the notifications channel, send updates to it.
+	notifications := make(chan *topo.WatchDataRecursive, 10)
+	go func() {
+		defer close(notifications)
+
+		var currVersion = initial.Header.Revision
+		var watchRetries int
+		for {
+			select {
+
+			case <-watchCtx.Done():
+				// This includes context cancellation errors.
+				notifications <- &topo.WatchDataRecursive{
+					Path:      dirPath,
+					WatchData: topo.WatchData{Err: convertError(watchCtx.Err(), nodePath)},
+				}
+				return
+			case wresp, ok := <-watcher:
+				if !ok {
+					if watchRetries > 10 {
+						time.Sleep(time.Duration(watchRetries) * time.Second)
+					}
+					watchRetries++
+					// Cancel inner context on retry and create new one.
+					watchCancel()
+					watchCtx, watchCancel = context.WithCancel(outerCtx)
+					newWatcher := s.cli.Watch(watchCtx, nodePath, clientv3.WithRev(currVersion), clientv3.WithPrefix())
+					if newWatcher == nil {
+						log.Warningf("recursive watch %v failed and get a nil channel returned, currVersion: %v", nodePath, currVersion)
+					} else {
+						watcher = newWatcher
+					}
+					continue
+				}
+
+				watchRetries = 0
+
+				if wresp.Canceled {
+					// Final notification.
+					notifications <- &topo.WatchDataRecursive{
+						Path:      dirPath,
+						WatchData: topo.WatchData{Err: convertError(wresp.Err(), nodePath)},
+					}
+					return
+				}
+
+				currVersion = wresp.Header.GetRevision()
+
+				for _, ev := range wresp.Events {
+					filePath := s.relativePath(ev.Kv.Key)
+					switch ev.Type {
+					case mvccpb.PUT:
+						notifications <- &topo.WatchDataRecursive{
+							Path: filePath,
+							WatchData: topo.WatchData{
+								Contents: ev.Kv.Value,
+								Version:  EtcdVersion(ev.Kv.ModRevision),
+							},
+						}
+					case mvccpb.DELETE:
+						notifications <- &topo.WatchDataRecursive{
+							Path:      filePath,
+							WatchData: topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)},
+						}
+					default:
+						notifications <- &topo.WatchDataRecursive{
+							Path:      dirPath,
+							WatchData: topo.WatchData{Err: vterrors.Errorf(vtrpc.Code_INTERNAL, "unexpected event received: %v", ev)},
+						}
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return initialwd, notifications, topo.CancelFunc(outerCancel), nil
+}
+
+// relativePath returns the path of a key relative to the root of the cell.
+func (s *Server) relativePath(key []byte) string {
+	return strings.TrimPrefix(strings.TrimPrefix(string(key), s.root), "/")
+}
@@ -163,6 +163,11 @@ func (c *TeeConn) Watch(ctx context.Context, filePath string) (*topo.WatchData,
 	return c.primary.Watch(ctx, filePath)
 }
 
+// WatchRecursive is part of the topo.Conn interface
+func (c *TeeConn) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	return c.primary.WatchRecursive(ctx, dirPath)
+}
+
 //
 // Lock management.
 //
@@ -18,6 +18,9 @@ package k8stopo
 
 import (
 	"context"
+	"path/filepath"
+	"strings"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/tools/cache"
@@ -127,3 +130,137 @@ func closeOnDone(ctx context.Context, filePath string, informerChan chan struct{
 	close(informerChan)
 	close(changes)
 }
+
+// WatchRecursive is part of the topo.Conn interface.
+func (s *Server) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	log.Info("Starting Kubernetes topo WatchRecursive on ", dirPath)
+
+	nodePath := filepath.Join(s.root, dirPath)
+
+	// get current, from the cache of the tree
+	children, err := s.memberIndexer.ByIndex("by_parent", nodePath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var current []*topo.WatchDataRecursive
+	versions := make(map[string]string, len(children))
+	for _, obj := range children {
+		vtn := obj.(*vtv1beta1.VitessTopoNode)
+		out, err := unpackValue([]byte(vtn.Data.Value))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		versions[vtn.Data.Key] = vtn.GetResourceVersion()
+		current = append(current, &topo.WatchDataRecursive{
+			Path: s.relativePath(vtn.Data.Key),
+			WatchData: topo.WatchData{
+				Contents: out,
+				Version:  KubernetesVersion(vtn.GetResourceVersion()),
+			},
+		})
+	}
+
+	// Create a context, will be used to cancel the watch.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+
+	// Create the changes channel. mu protects it from being
+	// written to once closed.
+	changes := make(chan *topo.WatchDataRecursive, 10)
+	mu := sync.Mutex{}
+	closed := false
+	send := func(wd *topo.WatchDataRecursive) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !closed {
+			changes <- wd
+		}
+	}
+
+	// Create a signal channel for non-interrupt shutdowns
+	gracefulShutdown := make(chan struct{})
+	stopped := false
+
+	// changed sends the new version of a node under the directory.
+	changed := func(vtn *vtv1beta1.VitessTopoNode) {
+		if stopped || !strings.HasPrefix(vtn.Data.Key, nodePath+"/") {
+			return
+		}
+		if versions[vtn.Data.Key] == vtn.GetResourceVersion() {
+			// We already returned this version.
+			return
+		}
+		versions[vtn.Data.Key] = vtn.GetResourceVersion()
+		out, err := unpackValue([]byte(vtn.Data.Value))
+		if err != nil {
+			send(&topo.WatchDataRecursive{Path: dirPath, WatchData: topo.WatchData{Err: err}})
+			stopped = true
+			close(gracefulShutdown)
+			return
+		}
+		send(&topo.WatchDataRecursive{
+			Path: s.relativePath(vtn.Data.Key),
+			WatchData: topo.WatchData{
+				Contents: out,
+				Version:  KubernetesVersion(vtn.GetResourceVersion()),
+			},
+		})
+	}
+
+	// Create the informer / indexer to watch all the resources.
+	// The events are filtered by key.
+	restClient := s.vtKubeClient.TopoV1beta1().RESTClient()
+	listwatch := cache.NewListWatchFromClient(restClient, "vitesstoponodes", s.namespace, fields.Everything())
+
+	_, memberInformer := cache.NewIndexerInformer(listwatch, &vtv1beta1.VitessTopoNode{}, 0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				changed(obj.(*vtv1beta1.VitessTopoNode))
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				changed(newObj.(*vtv1beta1.VitessTopoNode))
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				vtn, ok := obj.(*vtv1beta1.VitessTopoNode)
+				if !ok || stopped || !strings.HasPrefix(vtn.Data.Key, nodePath+"/") {
+					return
+				}
+				delete(versions, vtn.Data.Key)
+				filePath := s.relativePath(vtn.Data.Key)
+				send(&topo.WatchDataRecursive{
+					Path:      filePath,
+					WatchData: topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)},
+				})
+			},
+		}, cache.Indexers{})
+
+	// create control chan for informer and start it
+	informerChan := make(chan struct{})
+	go memberInformer.Run(informerChan)
+
+	// Handle interrupts
+	go func() {
+		select {
+		case <-watchCtx.Done():
+			send(&topo.WatchDataRecursive{
+				Path:      dirPath,
+				WatchData: topo.WatchData{Err: topo.NewError(topo.Interrupted, dirPath)},
+			})
+		case <-gracefulShutdown:
+		}
+		close(informerChan)
+		mu.Lock()
+		closed = true
+		close(changes)
+		mu.Unlock()
+	}()
+
+	return current, changes, topo.CancelFunc(watchCancel), nil
+}
+
+// relativePath returns the path of a key relative to the root of the cell.
+func (s *Server) relativePath(key string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(key, s.root), "/")
+}
@@ -64,6 +64,17 @@ type Factory struct {
 	// err is used for testing purposes to force queries / watches
 	// to return the given error
 	err error
+	// recursiveWatches has the WatchRecursive watches, by index.
+	// They're not attached to the directory nodes, as these are
+	// deleted with their last file.
+	recursiveWatches map[int]*recursiveWatch
+}
+
+// recursiveWatch is a watch on all the files under a directory.
+type recursiveWatch struct {
+	cell    string
+	dirPath string
+	ch      chan *topo.WatchDataRecursive
 }
 
 // HasGlobalReadOnlyCell is part of the topo.Factory interface.
@@ -95,6 +106,15 @@ func (f *Factory) SetError(err error) {
 		for _, node := range f.cells {
 			node.PropagateWatchError(err)
 		}
+		// Recursive watches end on errors.
+		for i, w := range f.recursiveWatches {
+			w.ch <- &topo.WatchDataRecursive{
+				Path:      w.dirPath,
+				WatchData: topo.WatchData{Err: err},
+			}
+			close(w.ch)
+			delete(f.recursiveWatches, i)
+		}
 	}
 }
 
@@ -171,8 +191,9 @@ func (n *node) PropagateWatchError(err error) {
 // in case of a problem.
 func NewServerAndFactory(cells ...string) (*topo.Server, *Factory) {
 	f := &Factory{
-		cells:      make(map[string]*node),
-		generation: uint64(rand.Int63n(1 << 60)),
+		cells:            make(map[string]*node),
+		generation:       uint64(rand.Int63n(1 << 60)),
+		recursiveWatches: make(map[int]*recursiveWatch),
 	}
 	f.cells[topo.GlobalCell] = f.newDirectory(topo.GlobalCell, nil)
 
@@ -273,6 +294,24 @@ func (f *Factory) getOrCreatePath(cell, filePath string) *node {
 	return n
 }
 
+// notifyRecursiveWatches sends the change of a file to the
+// recursive watches of its parent directories.
+func (f *Factory) notifyRecursiveWatches(cell, filePath string, wd topo.WatchData) {
+	filePath = strings.Trim(filePath, "/")
+	for _, w := range f.recursiveWatches {
+		if w.cell != cell || !strings.HasPrefix(filePath, w.dirPath) {
+			continue
+		}
+		if w.dirPath != "" && (len(filePath) == len(w.dirPath) || filePath[len(w.dirPath)] != '/') {
+			continue
+		}
+		w.ch <- &topo.WatchDataRecursive{
+			Path:      filePath,
+			WatchData: wd,
+		}
+	}
+}
+
 // recursiveDelete deletes a node and its parent directory if empty.
 func (f *Factory) recursiveDelete(n *node) {
 	parent := n.parent
@@ -18,6 +18,8 @@ package memorytopo
 
 import (
 	"fmt"
+	"path"
+	"strings"
 
 	"context"
 
@@ -70,3 +72,63 @@ func (c *Conn) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-c
 	}
 	return current, notifications, cancel
 }
+
+// WatchRecursive is part of the topo.Conn interface.
+func (c *Conn) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	c.factory.mu.Lock()
+	defer c.factory.mu.Unlock()
+
+	if c.factory.err != nil {
+		return nil, nil, nil, c.factory.err
+	}
+
+	dirPath = strings.Trim(dirPath, "/")
+	var current []*topo.WatchDataRecursive
+	if n := c.factory.nodeByPath(c.cell, dirPath); n != nil {
+		if !n.isDirectory() {
+			return nil, nil, nil, fmt.Errorf("cannot recursively watch file %v in cell %v", dirPath, c.cell)
+		}
+		current = n.files(dirPath, current)
+	}
+
+	notifications := make(chan *topo.WatchDataRecursive, 100)
+	watchIndex := nextWatchIndex
+	nextWatchIndex++
+	c.factory.recursiveWatches[watchIndex] = &recursiveWatch{
+		cell:    c.cell,
+		dirPath: dirPath,
+		ch:      notifications,
+	}
+
+	cancel := func() {
+		c.factory.mu.Lock()
+		defer c.factory.mu.Unlock()
+
+		if w, ok := c.factory.recursiveWatches[watchIndex]; ok {
+			delete(c.factory.recursiveWatches, watchIndex)
+			w.ch <- &topo.WatchDataRecursive{
+				Path:      dirPath,
+				WatchData: topo.WatchData{Err: topo.NewError(topo.Interrupted, "watch")},
+			}
+			close(w.ch)
+		}
+	}
+	return current, notifications, cancel, nil
+}
+
+// files appends the files under the node, recursively, to result.
+func (n *node) files(nodePath string, result []*topo.WatchDataRecursive) []*topo.WatchDataRecursive {
+	if !n.isDirectory() {
+		return append(result, &topo.WatchDataRecursive{
+			Path: nodePath,
+			WatchData: topo.WatchData{
+				Contents: n.contents,
+				Version:  NodeVersion(n.version),
+			},
+		})
+	}
+	for name, child := range n.children {
+		result = child.files(path.Join(nodePath, name), result)
+	}
+	return result
+}
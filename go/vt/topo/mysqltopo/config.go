@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"strings"
+)
+
+const (
+	// Path components
+	locksPath     = "locks"
+	electionsPath = "elections"
+)
+
+// createTables creates the tables if they don't exist yet.
+// lease_expiry is only set for ephemeral files, and is the
+// unix timestamp at which they expire.
+var createTables = []string{
+	`create table if not exists topo_files (
+  path varbinary(512) not null,
+  contents longblob not null,
+  version bigint not null,
+  lease_expiry bigint,
+  primary key (path)
+) engine=InnoDB`,
+	`create table if not exists topo_revision (
+  id int not null,
+  revision bigint not null,
+  primary key (id)
+) engine=InnoDB`,
+	"insert ignore into topo_revision(id, revision) values (1, 0)",
+}
+
+const (
+	incrementRevision = "update topo_revision set revision = revision + 1 where id = 1"
+	readRevision      = "select revision from topo_revision where id = 1"
+	// pollRevision also returns the time of the database, which
+	// tells the watches when the ephemeral files they read expire.
+	pollRevision = "select revision, cast(unix_timestamp() as signed) from topo_revision where id = 1"
+
+	insertFile          = "insert into topo_files(path, contents, version) values (%a, %a, %a)"
+	insertEphemeralFile = "insert into topo_files(path, contents, version, lease_expiry) values (%a, %a, %a, unix_timestamp() + %a)"
+	upsertFile          = "insert into topo_files(path, contents, version) values (%a, %a, %a) on duplicate key update contents = values(contents), version = values(version)"
+	updateFile          = "update topo_files set contents = %a, version = %a where path = %a and version = %a"
+	readFile            = "select contents, version, lease_expiry from topo_files where path = %a and (lease_expiry is null or lease_expiry >= unix_timestamp())"
+	deleteFile          = "delete from topo_files where path = %a"
+	deleteFileVersion   = "delete from topo_files where path = %a and version = %a"
+	listFiles           = "select path, lease_expiry is not null from topo_files where path like %a and (lease_expiry is null or lease_expiry >= unix_timestamp()) order by path"
+	readFiles           = "select path, contents, version, lease_expiry from topo_files where path like %a and (lease_expiry is null or lease_expiry >= unix_timestamp())"
+
+	// extendLease doesn't bring an expired file back to life.
+	extendLease = "update topo_files set lease_expiry = unix_timestamp() + %a where path = %a and lease_expiry >= unix_timestamp()"
+	checkLease  = "select 1 from topo_files where path = %a and lease_expiry >= unix_timestamp()"
+	// readOlderLeases returns the older ephemeral files of a directory,
+	// and whether they expired.
+	readOlderLeases    = "select path, lease_expiry < unix_timestamp() from topo_files where path like %a and version < %a"
+	deleteExpiredFiles = "delete from topo_files where path like %a and lease_expiry < unix_timestamp()"
+	readOldestLease    = "select contents from topo_files where path like %a and lease_expiry >= unix_timestamp() order by version limit 1"
+)
+
+// likePrefix returns the LIKE pattern that matches the paths starting
+// with prefix.
+func likePrefix(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix) + "%"
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mysqltopo
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// ListDir is part of the topo.Conn interface.
+func (s *Server) ListDir(ctx context.Context, dirPath string, full bool) ([]topo.DirEntry, error) {
+	nodePath := path.Join(s.root, dirPath) + "/"
+	if nodePath == "//" {
+		// Special case where s.root is "/", dirPath is empty,
+		// we would end up with "//". in that case, we want "/".
+		nodePath = "/"
+	}
+	query, err := sqlparser.ParseAndBind(listFiles, sqltypes.StringBindVariable(likePrefix(nodePath)))
+	if err != nil {
+		return nil, err
+	}
+	qr, err := s.exec(ctx, nodePath, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		// No file starts with this prefix, means the directory
+		// doesn't exist.
+		return nil, topo.NewError(topo.NoNode, nodePath)
+	}
+
+	prefixLen := len(nodePath)
+	var result []topo.DirEntry
+	for _, row := range qr.Rows {
+		p := row[0].ToString()
+
+		// Remove the prefix, base path.
+		if !strings.HasPrefix(p, nodePath) {
+			return nil, ErrBadResponse
+		}
+		p = p[prefixLen:]
+		ephemeral := row[1].ToString() == "1"
+
+		// Keep only the part until the first '/'.
+		t := topo.TypeFile
+		if i := strings.Index(p, "/"); i >= 0 {
+			p = p[:i]
+			t = topo.TypeDirectory
+		}
+
+		// Remove duplicates, add to list. All the files of a
+		// directory are next to each other. A directory is
+		// ephemeral if all its files are.
+		if len(result) > 0 && result[len(result)-1].Name == p {
+			if full {
+				result[len(result)-1].Ephemeral = result[len(result)-1].Ephemeral && ephemeral
+			}
+			continue
+		}
+		e := topo.DirEntry{
+			Name: p,
+		}
+		if full {
+			e.Type = t
+			e.Ephemeral = ephemeral
+		}
+		result = append(result, e)
+	}
+
+	// The paths are sorted with their separators, so the
+	// names may not be.
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mysqltopo
+
+import (
+	"context"
+	"path"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// NewMasterParticipation is part of the topo.Server interface
+func (s *Server) NewMasterParticipation(name, id string) (topo.MasterParticipation, error) {
+	return &mysqlMasterParticipation{
+		s:    s,
+		name: name,
+		id:   id,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}, nil
+}
+
+// mysqlMasterParticipation implements topo.MasterParticipation.
+//
+// We use a directory (in global election path, with the name) with
+// ephemeral files in it, that contains the id.  The oldest version
+// wins the election.
+type mysqlMasterParticipation struct {
+	// s is our parent mysql topo Server
+	s *Server
+
+	// name is the name of this MasterParticipation
+	name string
+
+	// id is the process's current id.
+	id string
+
+	// stop is a channel closed when Stop is called.
+	stop chan struct{}
+
+	// done is a channel closed when we're done processing the Stop
+	done chan struct{}
+}
+
+// WaitForMastership is part of the topo.MasterParticipation interface.
+func (mp *mysqlMasterParticipation) WaitForMastership() (context.Context, error) {
+	// If Stop was already called, mp.done is closed, so we are interrupted.
+	select {
+	case <-mp.done:
+		return nil, topo.NewError(topo.Interrupted, "mastership")
+	default:
+	}
+
+	electionPath := path.Join(electionsPath, mp.name)
+	var ld *mysqlLockDescriptor
+
+	// We use a cancelable context here. If stop is closed,
+	// we just cancel that context.
+	lockCtx, lockCancel := context.WithCancel(context.Background())
+	go func() {
+		<-mp.stop
+		if ld != nil {
+			if err := ld.Unlock(context.Background()); err != nil {
+				log.Errorf("failed to unlock electionPath %v: %v", electionPath, err)
+			}
+		}
+		lockCancel()
+		close(mp.done)
+	}()
+
+	// Try to get the mastership, by getting a lock.
+	var err error
+	ld, err = mp.s.lock(lockCtx, electionPath, mp.id)
+	if err != nil {
+		// It can be that we were interrupted.
+		return nil, err
+	}
+
+	// If the lease of our lock file is lost, we are not the master
+	// any more: cancel the returned context too.
+	go func() {
+		select {
+		case <-ld.lost:
+			log.Errorf("lost the lease of electionPath %v, giving up mastership", electionPath)
+			lockCancel()
+		case <-lockCtx.Done():
+		}
+	}()
+
+	// We got the lock. Return the lockContext. If Stop() is called,
+	// it will cancel the lockCtx, and cancel the returned context.
+	return lockCtx, nil
+}
+
+// Stop is part of the topo.MasterParticipation interface
+func (mp *mysqlMasterParticipation) Stop() {
+	close(mp.stop)
+	<-mp.done
+}
+
+// GetCurrentMasterID is part of the topo.MasterParticipation interface
+func (mp *mysqlMasterParticipation) GetCurrentMasterID(ctx context.Context) (string, error) {
+	electionPath := path.Join(mp.s.root, electionsPath, mp.name)
+
+	// Get the oldest live file in the directory.
+	query, err := sqlparser.ParseAndBind(readOldestLease, sqltypes.StringBindVariable(likePrefix(electionPath+"/")))
+	if err != nil {
+		return "", err
+	}
+	qr, err := mp.s.exec(ctx, electionPath, query)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) == 0 {
+		// No file starts with this prefix, means nobody is the master.
+		return "", nil
+	}
+	return qr.Rows[0][0].ToString(), nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mysqltopo
+
+import (
+	"context"
+	"errors"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Errors specific to this package.
+var (
+	// ErrBadResponse is returned from this package if the database doesn't
+	// return the data a query promises, like when the revision row is
+	// missing.
+	ErrBadResponse = errors.New("mysql query returned success, but response is missing required data")
+)
+
+// convertError converts a database error into a topo error. All errors
+// are either application-level errors, or context errors.
+func convertError(err error, nodePath string) error {
+	if err == nil {
+		return nil
+	}
+
+	if sqlErr, ok := err.(*mysql.SQLError); ok {
+		switch sqlErr.Number() {
+		case mysql.ERDupEntry:
+			return topo.NewError(topo.NodeExists, nodePath)
+		case mysql.ERLockWaitTimeout:
+			return topo.NewError(topo.Timeout, nodePath)
+		}
+		return err
+	}
+
+	switch err {
+	case context.Canceled:
+		return topo.NewError(topo.Interrupted, nodePath)
+	case context.DeadlineExceeded:
+		return topo.NewError(topo.Timeout, nodePath)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mysqltopo
+
+import (
+	"context"
+	"path"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Create is part of the topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	var version int64
+	err := s.withTransaction(ctx, nodePath, func(conn *dbconnpool.PooledDBConnection) error {
+		revision, err := nextRevision(conn)
+		if err != nil {
+			return err
+		}
+		// The primary key makes the insert fail if the file exists.
+		query, err := sqlparser.ParseAndBind(insertFile,
+			sqltypes.StringBindVariable(nodePath),
+			sqltypes.BytesBindVariable(contents),
+			sqltypes.Int64BindVariable(revision))
+		if err != nil {
+			return err
+		}
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return err
+		}
+		version = revision
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MySQLVersion(version), nil
+}
+
+// Update is part of the topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	var newVersion int64
+	err := s.withTransaction(ctx, nodePath, func(conn *dbconnpool.PooledDBConnection) error {
+		revision, err := nextRevision(conn)
+		if err != nil {
+			return err
+		}
+		if version == nil {
+			// No version specified. We can use a simple unconditional upsert.
+			query, err := sqlparser.ParseAndBind(upsertFile,
+				sqltypes.StringBindVariable(nodePath),
+				sqltypes.BytesBindVariable(contents),
+				sqltypes.Int64BindVariable(revision))
+			if err != nil {
+				return err
+			}
+			if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+				return err
+			}
+			newVersion = revision
+			return nil
+		}
+
+		// Only update the file if its version is what we expect.
+		query, err := sqlparser.ParseAndBind(updateFile,
+			sqltypes.BytesBindVariable(contents),
+			sqltypes.Int64BindVariable(revision),
+			sqltypes.StringBindVariable(nodePath),
+			sqltypes.Int64BindVariable(int64(version.(MySQLVersion))))
+		if err != nil {
+			return err
+		}
+		qr, err := conn.ExecuteFetch(query, 0, false)
+		if err != nil {
+			return err
+		}
+		if qr.RowsAffected == 0 {
+			return versionMismatchError(conn, nodePath)
+		}
+		newVersion = revision
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MySQLVersion(newVersion), nil
+}
+
+// Get is part of the topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	contents, version, _, err := s.get(ctx, nodePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return contents, MySQLVersion(version), nil
+}
+
+// get returns the contents and version of the file at nodePath,
+// and its lease expiry if it is an ephemeral file, 0 otherwise.
+func (s *Server) get(ctx context.Context, nodePath string) ([]byte, int64, int64, error) {
+	query, err := sqlparser.ParseAndBind(readFile, sqltypes.StringBindVariable(nodePath))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	qr, err := s.exec(ctx, nodePath, query)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if len(qr.Rows) != 1 {
+		return nil, 0, 0, topo.NewError(topo.NoNode, nodePath)
+	}
+	version, err := qr.Rows[0][1].ToInt64()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	leaseExpiry, err := toLeaseExpiry(qr.Rows[0][2])
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return qr.Rows[0][0].ToBytes(), version, leaseExpiry, nil
+}
+
+// toLeaseExpiry returns the value of a lease_expiry column, 0 if it is null.
+func toLeaseExpiry(value sqltypes.Value) (int64, error) {
+	if value.IsNull() {
+		return 0, nil
+	}
+	return value.ToInt64()
+}
+
+// Delete is part of the topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	nodePath := path.Join(s.root, filePath)
+
+	return s.delete(ctx, nodePath, version)
+}
+
+// delete deletes the file at nodePath. It is used by both Delete()
+// and unlocking.
+func (s *Server) delete(ctx context.Context, nodePath string, version topo.Version) error {
+	return s.withTransaction(ctx, nodePath, func(conn *dbconnpool.PooledDBConnection) error {
+		// The revision changes so the watches notice the deletion.
+		if _, err := nextRevision(conn); err != nil {
+			return err
+		}
+		binds := []*querypb.BindVariable{sqltypes.StringBindVariable(nodePath)}
+		query := deleteFile
+		if version != nil {
+			binds = append(binds, sqltypes.Int64BindVariable(int64(version.(MySQLVersion))))
+			query = deleteFileVersion
+		}
+		query, err := sqlparser.ParseAndBind(query, binds...)
+		if err != nil {
+			return err
+		}
+		qr, err := conn.ExecuteFetch(query, 0, false)
+		if err != nil {
+			return err
+		}
+		if qr.RowsAffected == 0 {
+			if version == nil {
+				return topo.NewError(topo.NoNode, nodePath)
+			}
+			return versionMismatchError(conn, nodePath)
+		}
+		return nil
+	})
+}
+
+// versionMismatchError returns the error of a conditional write that
+// didn't match the file: ErrBadVersion if the file exists with another
+// version, ErrNoNode if it doesn't exist.
+func versionMismatchError(conn *dbconnpool.PooledDBConnection, nodePath string) error {
+	query, err := sqlparser.ParseAndBind(readFile, sqltypes.StringBindVariable(nodePath))
+	if err != nil {
+		return err
+	}
+	qr, err := conn.ExecuteFetch(query, 1, false)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		return topo.NewError(topo.NoNode, nodePath)
+	}
+	return topo.NewError(topo.BadVersion, nodePath)
+}
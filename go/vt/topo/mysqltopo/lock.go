@@ -0,0 +1,245 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mysqltopo
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	leaseTTL = flag.Int("topo_mysql_lease_ttl", 30, "Lease TTL in seconds for locks and master election. The client keeps extending the lease while it holds them.")
+)
+
+// newUniqueEphemeralFile creates a new ephemeral file in the provided
+// directory. It returns the path and version of the file.
+// Errors returned are converted to topo errors.
+func (s *Server) newUniqueEphemeralFile(ctx context.Context, nodePath string, contents string) (string, int64, error) {
+	var newPath string
+	var version int64
+	err := s.withTransaction(ctx, nodePath, func(conn *dbconnpool.PooledDBConnection) error {
+		revision, err := nextRevision(conn)
+		if err != nil {
+			return err
+		}
+		// Use the revision as the file name, so it's guaranteed unique.
+		newPath = fmt.Sprintf("%v/%v", nodePath, revision)
+		query, err := sqlparser.ParseAndBind(insertEphemeralFile,
+			sqltypes.StringBindVariable(newPath),
+			sqltypes.StringBindVariable(contents),
+			sqltypes.Int64BindVariable(revision),
+			sqltypes.Int64BindVariable(int64(*leaseTTL)))
+		if err != nil {
+			return err
+		}
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return err
+		}
+		version = revision
+		return nil
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return newPath, version, nil
+}
+
+// waitOnOlderFiles waits until there is no file in the provided
+// directory with a version smaller than the provided version.
+// The expired files are deleted. It returns true only if there is
+// no more other older files.
+func (s *Server) waitOnOlderFiles(ctx context.Context, nodePath string, version int64) (bool, error) {
+	// Get the files that are blocking us, if any.
+	query, err := sqlparser.ParseAndBind(readOlderLeases,
+		sqltypes.StringBindVariable(likePrefix(nodePath+"/")),
+		sqltypes.Int64BindVariable(version))
+	if err != nil {
+		return false, err
+	}
+	qr, err := s.exec(ctx, nodePath, query)
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) == 0 {
+		// No older file, we're done waiting.
+		return true, nil
+	}
+	for _, row := range qr.Rows {
+		if row[1].ToString() == "1" {
+			// The owner of this file is gone.
+			log.Warningf("Deleting expired lock files in %v", nodePath)
+			return false, s.deleteExpiredFiles(ctx, nodePath)
+		}
+	}
+
+	// Wait before checking again.
+	select {
+	case <-ctx.Done():
+		return false, convertError(ctx.Err(), nodePath)
+	case <-time.After(*pollInterval):
+	}
+	return false, nil
+}
+
+// deleteExpiredFiles deletes the expired ephemeral files in the
+// provided directory.
+func (s *Server) deleteExpiredFiles(ctx context.Context, nodePath string) error {
+	return s.withTransaction(ctx, nodePath, func(conn *dbconnpool.PooledDBConnection) error {
+		// The revision changes so the watches notice the deletion.
+		if _, err := nextRevision(conn); err != nil {
+			return err
+		}
+		query, err := sqlparser.ParseAndBind(deleteExpiredFiles, sqltypes.StringBindVariable(likePrefix(nodePath+"/")))
+		if err != nil {
+			return err
+		}
+		_, err = conn.ExecuteFetch(query, 0, false)
+		return err
+	})
+}
+
+// mysqlLockDescriptor implements topo.LockDescriptor.
+type mysqlLockDescriptor struct {
+	s        *Server
+	nodePath string
+
+	// stop is closed when the lock is released, to stop
+	// extending the lease.
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	// lost is closed when the lease could not be extended
+	// because the lock file is gone or expired.
+	lost chan struct{}
+}
+
+// Lock is part of the topo.Conn interface.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	// We list the directory first to make sure it exists.
+	if _, err := s.ListDir(ctx, dirPath, false /*full*/); err != nil {
+		return nil, err
+	}
+
+	ld, err := s.lock(ctx, dirPath, contents)
+	if err != nil {
+		return nil, err
+	}
+	return ld, nil
+}
+
+// lock is used by both Lock() and master election.
+func (s *Server) lock(ctx context.Context, nodePath, contents string) (*mysqlLockDescriptor, error) {
+	nodePath = path.Join(s.root, nodePath, locksPath)
+
+	// Create an ephemeral file in the locks directory, and
+	// keep its lease going.
+	key, version, err := s.newUniqueEphemeralFile(ctx, nodePath, contents)
+	if err != nil {
+		return nil, err
+	}
+	ld := &mysqlLockDescriptor{
+		s:        s,
+		nodePath: key,
+		stop:     make(chan struct{}),
+		lost:     make(chan struct{}),
+	}
+	go ld.extendLease()
+
+	// Wait until all older files in the locks directory are gone.
+	for {
+		done, err := s.waitOnOlderFiles(ctx, nodePath, version)
+		if err != nil {
+			// We had an error waiting on the older files.
+			// Delete our file, so we don't leave it behind
+			// for *leaseTTL time.
+			if uerr := ld.Unlock(context.Background()); uerr != nil {
+				log.Warningf("Unlock(%v) failed, may have left it behind: %v", key, uerr)
+			}
+			return nil, err
+		}
+		if done {
+			// No more older files, we're it!
+			return ld, nil
+		}
+	}
+}
+
+// extendLease extends the lease of the lock file until the lock
+// is released. If the lock file is gone or expired, the lock is
+// lost: it closes ld.lost and returns.
+func (ld *mysqlLockDescriptor) extendLease() {
+	ticker := time.NewTicker(time.Duration(*leaseTTL) * time.Second / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ld.stop:
+			return
+		case <-ticker.C:
+		}
+		query, err := sqlparser.ParseAndBind(extendLease,
+			sqltypes.Int64BindVariable(int64(*leaseTTL)),
+			sqltypes.StringBindVariable(ld.nodePath))
+		if err != nil {
+			log.Errorf("Cannot extend the lease of %v: %v", ld.nodePath, err)
+			continue
+		}
+		qr, err := ld.s.exec(context.Background(), ld.nodePath, query)
+		if err != nil {
+			log.Warningf("Cannot extend the lease of %v, will retry: %v", ld.nodePath, err)
+			continue
+		}
+		if qr.RowsAffected == 0 {
+			log.Errorf("Lock file %v is gone or expired, the lock is lost", ld.nodePath)
+			close(ld.lost)
+			return
+		}
+	}
+}
+
+// Check is part of the topo.LockDescriptor interface.
+// We make sure the lease is still active.
+func (ld *mysqlLockDescriptor) Check(ctx context.Context) error {
+	query, err := sqlparser.ParseAndBind(checkLease, sqltypes.StringBindVariable(ld.nodePath))
+	if err != nil {
+		return err
+	}
+	qr, err := ld.s.exec(ctx, ld.nodePath, query)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		return topo.NewError(topo.NoNode, ld.nodePath)
+	}
+	return nil
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *mysqlLockDescriptor) Unlock(ctx context.Context) error {
+	ld.stopOnce.Do(func() {
+		close(ld.stop)
+	})
+	return ld.s.delete(ctx, ld.nodePath, nil)
+}
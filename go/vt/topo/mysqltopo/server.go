@@ -0,0 +1,236 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package mysqltopo implements topo.Server with a MySQL database as the backend.
+The database can also be an unsharded Vitess keyspace, accessed through vtgate.
+
+All the files of all the cells are rows of the topo_files table, keyed by
+their full path. Intermediate directories don't exist as such: a directory
+exists as long as there is a file under it.
+
+Every write increments the single revision of the topo_revision table in
+the same transaction, and the file gets the new revision as its version,
+like with etcd. Versions are thus never reused, even across a delete and
+a create of the same file. The price is that the row of the revision is
+locked until the commit: all the writes of all the cells are serialized,
+and their throughput is at most one write per commit latency of the
+database. That is plenty for the topology, which is mostly read, but a
+single database shouldn't back many busy clusters.
+
+Locks and master elections use ephemeral files: files with a lease
+expiration time, that their owner keeps extending while it holds them.
+Expired files are ignored by reads, until they get deleted.
+
+Watches poll the revision, and read the watched files again when it has
+changed. Expiring doesn't change the revision, so the watches also read
+their files again once the lease of one of them has passed.
+
+We follow these conventions within this package:
+
+  - Call convertError(err) on any errors returned from the database.
+    Functions defined in this package can be assumed to have already converted
+    errors as necessary.
+*/
+package mysqltopo
+
+import (
+	"context"
+	"flag"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	dbName       = flag.String("topo_mysql_db_name", "vt_topo", "name of the database that stores the topology in the mysql topo server")
+	dbUser       = flag.String("topo_mysql_user", "vt_topo", "user to connect to the mysql topo server as")
+	dbPassword   = flag.String("topo_mysql_password", "", "password to connect to the mysql topo server with")
+	poolSize     = flag.Int("topo_mysql_pool_size", 10, "size of the connection pool to the mysql topo server")
+	pollInterval = flag.Duration("topo_mysql_poll_interval", 1*time.Second, "how often watches and waiting locks poll the mysql topo server for changes")
+)
+
+// maxRows is the maximum number of files a query reads.
+const maxRows = 100000
+
+// Factory is the mysql topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return NewServer(serverAddr, root)
+}
+
+// Server is the implementation of topo.Server for MySQL.
+type Server struct {
+	// pool is the pool of connections to the database.
+	pool *dbconnpool.ConnectionPool
+
+	// root is the root path for this client.
+	root string
+}
+
+// NewServer returns a new mysqltopo.Server. serverAddr is either
+// host:port, or the path of a unix socket. The tables are created
+// in the database if they don't exist yet.
+func NewServer(serverAddr, root string) (*Server, error) {
+	params := &mysql.ConnParams{
+		Uname:  *dbUser,
+		Pass:   *dbPassword,
+		DbName: *dbName,
+		// Count the matched rows, not the changed ones: extending
+		// a lease within the same second doesn't change the row.
+		Flags: mysql.CapabilityClientFoundRows,
+	}
+	if strings.HasPrefix(serverAddr, "/") {
+		params.UnixSocket = serverAddr
+	} else {
+		host, port, err := net.SplitHostPort(serverAddr)
+		if err != nil {
+			return nil, err
+		}
+		params.Host = host
+		if params.Port, err = strconv.Atoi(port); err != nil {
+			return nil, err
+		}
+	}
+
+	pool := dbconnpool.NewConnectionPool("", *poolSize, 0, 0)
+	pool.Open(dbconfigs.New(params))
+	s := &Server{
+		pool: pool,
+		root: root,
+	}
+	for _, query := range createTables {
+		if _, err := s.exec(context.Background(), root, query); err != nil {
+			pool.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Close implements topo.Server.Close.
+// It will nil out the pool, so any attempt to re-use this server
+// will panic.
+func (s *Server) Close() {
+	s.pool.Close()
+	s.pool = nil
+}
+
+// getConn returns a connection of the pool.
+func (s *Server) getConn(ctx context.Context, nodePath string) (*dbconnpool.PooledDBConnection, error) {
+	conn, err := s.pool.Get(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			// The pool errors don't tell apart
+			// timeouts and interruptions.
+			err = ctx.Err()
+		}
+		return nil, convertError(err, nodePath)
+	}
+	return conn, nil
+}
+
+// exec executes a query on a connection of the pool.
+func (s *Server) exec(ctx context.Context, nodePath, query string) (*sqltypes.Result, error) {
+	conn, err := s.getConn(ctx, nodePath)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	qr, err := conn.ExecuteFetch(query, maxRows, false)
+	if err != nil {
+		return nil, convertError(err, nodePath)
+	}
+	return qr, nil
+}
+
+// withTransaction runs f in a transaction on a connection of the pool.
+// The transaction is committed if f succeeds, and rolled back otherwise.
+func (s *Server) withTransaction(ctx context.Context, nodePath string, f func(conn *dbconnpool.PooledDBConnection) error) error {
+	conn, err := s.getConn(ctx, nodePath)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+	if _, err := conn.ExecuteFetch("begin", 0, false); err != nil {
+		return convertError(err, nodePath)
+	}
+	if err := f(conn); err != nil {
+		conn.ExecuteFetch("rollback", 0, false)
+		return convertError(err, nodePath)
+	}
+	if _, err := conn.ExecuteFetch("commit", 0, false); err != nil {
+		return convertError(err, nodePath)
+	}
+	return nil
+}
+
+// nextRevision increments the revision, and returns the new value.
+// It has to be called in a transaction: the revision row stays
+// locked until the end of it, which orders all the writes, and
+// serializes them (see the package doc).
+func nextRevision(conn *dbconnpool.PooledDBConnection) (int64, error) {
+	if _, err := conn.ExecuteFetch(incrementRevision, 0, false); err != nil {
+		return 0, err
+	}
+	qr, err := conn.ExecuteFetch(readRevision, 1, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) != 1 {
+		return 0, ErrBadResponse
+	}
+	return qr.Rows[0][0].ToInt64()
+}
+
+// pollRevision returns the current revision, and the current
+// unix timestamp of the database.
+func (s *Server) pollRevision(ctx context.Context, nodePath string) (int64, int64, error) {
+	qr, err := s.exec(ctx, nodePath, pollRevision)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(qr.Rows) != 1 {
+		return 0, 0, ErrBadResponse
+	}
+	revision, err := qr.Rows[0][0].ToInt64()
+	if err != nil {
+		return 0, 0, err
+	}
+	now, err := qr.Rows[0][1].ToInt64()
+	if err != nil {
+		return 0, 0, err
+	}
+	return revision, now, nil
+}
+
+func init() {
+	topo.RegisterFactory("mysql", Factory{})
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mysqltopo
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vttestpb "vitess.io/vitess/go/vt/proto/vttest"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/test"
+	"vitess.io/vitess/go/vt/vttest"
+)
+
+var connParams mysql.ConnParams
+
+func TestMySQLTopo(t *testing.T) {
+	// Poll often, so the watch tests don't take too long.
+	*pollInterval = 100 * time.Millisecond
+
+	testIndex := 0
+	newServer := func() *topo.Server {
+		// Each test will use its own sub-directories.
+		testRoot := fmt.Sprintf("/test-%v", testIndex)
+		testIndex++
+
+		// Create the server on the new root.
+		ts, err := topo.OpenServer("mysql", connParams.UnixSocket, path.Join(testRoot, topo.GlobalCell))
+		if err != nil {
+			t.Fatalf("OpenServer() failed: %v", err)
+		}
+
+		// Create the CellInfo.
+		if err := ts.CreateCellInfo(context.Background(), test.LocalCellName, &topodatapb.CellInfo{
+			ServerAddress: connParams.UnixSocket,
+			Root:          path.Join(testRoot, test.LocalCellName),
+		}); err != nil {
+			t.Fatalf("CreateCellInfo() failed: %v", err)
+		}
+
+		return ts
+	}
+
+	// Run the TopoServerTestSuite tests.
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		return newServer()
+	})
+
+	// Run mysql-specific tests.
+	ts := newServer()
+	testKeyspaceLock(t, ts)
+	ts.Close()
+
+	ts = newServer()
+	testElectionLeaseLoss(t, ts)
+	ts.Close()
+
+	ts = newServer()
+	testWatchLeaseExpiry(t, ts)
+	ts.Close()
+}
+
+// testKeyspaceLock tests the lease extension of the locks.
+// The lease granularity is in seconds, so we have to wait
+// a long time in this test.
+func testKeyspaceLock(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	keyspacePath := path.Join(topo.KeyspacesPath, "test_keyspace")
+	if err := ts.CreateKeyspace(ctx, "test_keyspace", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace: %v", err)
+	}
+
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell failed: %v", err)
+	}
+
+	// Short TTL, make sure it doesn't expire.
+	*leaseTTL = 1
+	defer func() { *leaseTTL = 30 }()
+	lockDescriptor, err := conn.Lock(ctx, keyspacePath, "short ttl")
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	time.Sleep(3 * time.Second)
+	if err := lockDescriptor.Check(ctx); err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+
+	// Another lock has to wait for the first one.
+	lockCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if _, err := conn.Lock(lockCtx, keyspacePath, "blocked"); !topo.IsErrType(err, topo.Timeout) {
+		t.Fatalf("Lock(blocked) returned %v, expected a Timeout", err)
+	}
+
+	if err := lockDescriptor.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock failed: %v", err)
+	}
+}
+
+// testElectionLeaseLoss tests that losing the lease of the election
+// lock file cancels the mastership context, and that the expired
+// file isn't listed any more.
+func testElectionLeaseLoss(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell failed: %v", err)
+	}
+	s := conn.(*Server)
+
+	*leaseTTL = 1
+	defer func() { *leaseTTL = 30 }()
+	mp, err := s.NewMasterParticipation("lease_loss", "id1")
+	if err != nil {
+		t.Fatalf("NewMasterParticipation failed: %v", err)
+	}
+	defer mp.Stop()
+	masterCtx, err := mp.WaitForMastership()
+	if err != nil {
+		t.Fatalf("WaitForMastership failed: %v", err)
+	}
+
+	// Expire the lock file behind the back of its owner.
+	locksDir := path.Join(electionsPath, "lease_loss", locksPath)
+	if _, err := s.exec(ctx, locksDir, fmt.Sprintf("update topo_files set lease_expiry = 0 where path like '%v/%%'", path.Join(s.root, locksDir))); err != nil {
+		t.Fatalf("expiring the lock file failed: %v", err)
+	}
+	if _, err := s.ListDir(ctx, locksDir, false /*full*/); !topo.IsErrType(err, topo.NoNode) {
+		t.Fatalf("ListDir(%v) returned %v, expected NoNode", locksDir, err)
+	}
+
+	select {
+	case <-masterCtx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("mastership context wasn't canceled after the lease was lost")
+	}
+}
+
+// testWatchLeaseExpiry tests that a recursive watch notices that
+// a lock file expired, which doesn't change the revision.
+func testWatchLeaseExpiry(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	keyspacePath := path.Join(topo.KeyspacesPath, "test_keyspace")
+	if err := ts.CreateKeyspace(ctx, "test_keyspace", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace: %v", err)
+	}
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell failed: %v", err)
+	}
+	s := conn.(*Server)
+
+	// The watch reads the file again when the lease it read expires.
+	*leaseTTL = 2
+	defer func() { *leaseTTL = 30 }()
+	if _, err := conn.Lock(ctx, keyspacePath, "expiring"); err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	locksDir := path.Join(keyspacePath, locksPath)
+	initial, changes, cancel, err := s.WatchRecursive(ctx, locksDir)
+	if err != nil {
+		t.Fatalf("WatchRecursive failed: %v", err)
+	}
+	defer cancel()
+	if len(initial) != 1 {
+		t.Fatalf("WatchRecursive returned %v files, expected the lock file", len(initial))
+	}
+
+	// Expire the lock file behind the back of its owner,
+	// so its lease doesn't get extended any more.
+	if _, err := s.exec(ctx, locksDir, fmt.Sprintf("update topo_files set lease_expiry = 0 where path like '%v/%%'", path.Join(s.root, locksDir))); err != nil {
+		t.Fatalf("expiring the lock file failed: %v", err)
+	}
+
+	select {
+	case wd := <-changes:
+		if wd.Path != initial[0].Path || !topo.IsErrType(wd.Err, topo.NoNode) {
+			t.Fatalf("WatchRecursive sent %v %v, expected NoNode for %v", wd.Path, wd.Err, initial[0].Path)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("WatchRecursive didn't notice the expiry of the lock file")
+	}
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	exitCode := func() int {
+		// Launch MySQL.
+		// We need a Keyspace in the topology, so the DbName is set.
+		// We need a Shard too, so the database 'vttest' is created.
+		cfg := vttest.Config{
+			Topology: &vttestpb.VTTestTopology{
+				Keyspaces: []*vttestpb.Keyspace{
+					{
+						Name: "vttest",
+						Shards: []*vttestpb.Shard{
+							{
+								Name:           "0",
+								DbNameOverride: "vttest",
+							},
+						},
+					},
+				},
+			},
+			OnlyMySQL: true,
+		}
+		defer os.RemoveAll(cfg.SchemaDir)
+		cluster := vttest.LocalCluster{
+			Config: cfg,
+		}
+		if err := cluster.Setup(); err != nil {
+			fmt.Fprintf(os.Stderr, "could not launch mysql: %v\n", err)
+			return 1
+		}
+		defer cluster.TearDown()
+
+		connParams = cluster.MySQLConnParams()
+		*dbName = "vttest"
+		*dbUser = connParams.Uname
+		*dbPassword = connParams.Pass
+
+		return m.Run()
+	}()
+	os.Exit(exitCode)
+}
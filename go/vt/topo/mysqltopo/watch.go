@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mysqltopo
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Watch is part of the topo.Conn interface.
+func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	nodePath := path.Join(s.root, filePath)
+
+	// Get the revision before the file, so we don't miss
+	// any change.
+	revision, _, err := s.pollRevision(ctx, nodePath)
+	if err != nil {
+		return &topo.WatchData{Err: err}, nil, nil
+	}
+	contents, version, leaseExpiry, err := s.get(ctx, nodePath)
+	if err != nil {
+		return &topo.WatchData{Err: err}, nil, nil
+	}
+	wd := &topo.WatchData{
+		Contents: contents,
+		Version:  MySQLVersion(version),
+	}
+
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+
+	// Create the notifications channel, send updates to it.
+	notifications := make(chan *topo.WatchData, 10)
+	go func() {
+		defer close(notifications)
+
+		ticker := time.NewTicker(*pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-watchCtx.Done():
+				// This includes context cancellation errors.
+				notifications <- &topo.WatchData{
+					Err: convertError(watchCtx.Err(), nodePath),
+				}
+				return
+			case <-ticker.C:
+			}
+
+			// Only read the file if something changed, or if its lease
+			// expired: expiring doesn't change the revision.
+			newRevision, now, err := s.pollRevision(watchCtx, nodePath)
+			if err != nil {
+				log.Warningf("watch %v failed to get the revision, will retry: %v", nodePath, err)
+				continue
+			}
+			if newRevision == revision && !expired(leaseExpiry, now) {
+				continue
+			}
+			contents, newVersion, newLeaseExpiry, err := s.get(watchCtx, nodePath)
+			if topo.IsErrType(err, topo.NoNode) {
+				// Node is gone, send a final notice.
+				notifications <- &topo.WatchData{Err: err}
+				return
+			}
+			if err != nil {
+				log.Warningf("watch %v failed to read the file, will retry: %v", nodePath, err)
+				continue
+			}
+			revision = newRevision
+			leaseExpiry = newLeaseExpiry
+			if newVersion == version {
+				continue
+			}
+			version = newVersion
+			notifications <- &topo.WatchData{
+				Contents: contents,
+				Version:  MySQLVersion(version),
+			}
+		}
+	}()
+
+	return wd, notifications, topo.CancelFunc(watchCancel)
+}
+
+// WatchRecursive is part of the topo.Conn interface.
+func (s *Server) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	nodePath := path.Join(s.root, dirPath)
+	if !strings.HasSuffix(nodePath, "/") {
+		nodePath = nodePath + "/"
+	}
+
+	// Get the revision before the files, so we don't miss
+	// any change.
+	revision, _, err := s.pollRevision(ctx, nodePath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	files, leaseExpiry, err := s.readFiles(ctx, nodePath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var initialwd []*topo.WatchDataRecursive
+	for _, filePath := range sortedPaths(files) {
+		initialwd = append(initialwd, &topo.WatchDataRecursive{
+			Path:      filePath,
+			WatchData: *files[filePath],
+		})
+	}
+
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+
+	// Create the notifications channel, send updates to it.
+	notifications := make(chan *topo.WatchDataRecursive, 10)
+	go func() {
+		defer close(notifications)
+
+		ticker := time.NewTicker(*pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-watchCtx.Done():
+				// This includes context cancellation errors.
+				notifications <- &topo.WatchDataRecursive{
+					Path:      dirPath,
+					WatchData: topo.WatchData{Err: convertError(watchCtx.Err(), nodePath)},
+				}
+				return
+			case <-ticker.C:
+			}
+
+			// Only read the files if something changed, or if the
+			// lease of one of them expired.
+			newRevision, now, err := s.pollRevision(watchCtx, nodePath)
+			if err != nil {
+				log.Warningf("recursive watch %v failed to get the revision, will retry: %v", nodePath, err)
+				continue
+			}
+			if newRevision == revision && !expired(leaseExpiry, now) {
+				continue
+			}
+			newFiles, newLeaseExpiry, err := s.readFiles(watchCtx, nodePath)
+			if err != nil {
+				log.Warningf("recursive watch %v failed to read the files, will retry: %v", nodePath, err)
+				continue
+			}
+			revision = newRevision
+			leaseExpiry = newLeaseExpiry
+
+			// Send the files that were created or updated,
+			// then the ones that were deleted.
+			for _, filePath := range sortedPaths(newFiles) {
+				wd := newFiles[filePath]
+				if old, ok := files[filePath]; ok && old.Version == wd.Version {
+					continue
+				}
+				notifications <- &topo.WatchDataRecursive{
+					Path:      filePath,
+					WatchData: *wd,
+				}
+			}
+			for _, filePath := range sortedPaths(files) {
+				if _, ok := newFiles[filePath]; ok {
+					continue
+				}
+				notifications <- &topo.WatchDataRecursive{
+					Path:      filePath,
+					WatchData: topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)},
+				}
+			}
+			files = newFiles
+		}
+	}()
+
+	return initialwd, notifications, topo.CancelFunc(watchCancel), nil
+}
+
+// readFiles returns the files under the provided directory, by
+// path relative to the root of the cell, and the earliest lease
+// expiry of the ephemeral ones, 0 if there is none.
+func (s *Server) readFiles(ctx context.Context, nodePath string) (map[string]*topo.WatchData, int64, error) {
+	query, err := sqlparser.ParseAndBind(readFiles, sqltypes.StringBindVariable(likePrefix(nodePath)))
+	if err != nil {
+		return nil, 0, err
+	}
+	qr, err := s.exec(ctx, nodePath, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	files := make(map[string]*topo.WatchData, len(qr.Rows))
+	var nextExpiry int64
+	for _, row := range qr.Rows {
+		version, err := row[2].ToInt64()
+		if err != nil {
+			return nil, 0, err
+		}
+		leaseExpiry, err := toLeaseExpiry(row[3])
+		if err != nil {
+			return nil, 0, err
+		}
+		if leaseExpiry != 0 && (nextExpiry == 0 || leaseExpiry < nextExpiry) {
+			nextExpiry = leaseExpiry
+		}
+		files[s.relativePath(row[0].ToString())] = &topo.WatchData{
+			Contents: row[1].ToBytes(),
+			Version:  MySQLVersion(version),
+		}
+	}
+	return files, nextExpiry, nil
+}
+
+// expired returns true if a lease expiry read by a watch is in the past.
+// The lease may have been extended since: the watch then reads the file
+// again for nothing, and gets the new lease expiry.
+func expired(leaseExpiry, now int64) bool {
+	return leaseExpiry != 0 && leaseExpiry < now
+}
+
+// relativePath returns the path of a file relative to the root of the cell.
+func (s *Server) relativePath(nodePath string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(nodePath, s.root), "/")
+}
+
+// sortedPaths returns the paths of the files in order.
+func sortedPaths(files map[string]*topo.WatchData) []string {
+	paths := make([]string, 0, len(files))
+	for filePath := range files {
+		paths = append(paths, filePath)
+	}
+	sort.Strings(paths)
+	return paths
+}
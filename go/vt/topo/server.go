@@ -76,6 +76,7 @@ const (
 	SrvVSchemaFile       = "SrvVSchema"
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
+	RangeMapsFile        = "RangeMaps"
 	ExternalClustersFile = "ExternalClusters"
 )
 
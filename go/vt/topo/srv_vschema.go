@@ -198,6 +198,14 @@ func (ts *Server) RebuildSrvVSchema(ctx context.Context, cells []string) error {
 	}
 	srvVSchema.RoutingRules = rr
 
+	rm, err := ts.GetRangeMaps(ctx)
+	if err != nil {
+		return fmt.Errorf("GetRangeMaps failed: %v", err)
+	}
+	if len(rm.RangeMaps) > 0 {
+		srvVSchema.RangeMaps = &vschemapb.RangeMaps{RangeMaps: rm.RangeMaps}
+	}
+
 	// now save the SrvVSchema in all cells in parallel
 	for _, cell := range cells {
 		wg.Add(1)
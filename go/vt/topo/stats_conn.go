@@ -138,6 +138,18 @@ func (st *StatsConn) Watch(ctx context.Context, filePath string) (current *Watch
 	return st.conn.Watch(ctx, filePath)
 }
 
+// WatchRecursive is part of the Conn interface
+func (st *StatsConn) WatchRecursive(ctx context.Context, dirPath string) (current []*WatchDataRecursive, changes <-chan *WatchDataRecursive, cancel CancelFunc, err error) {
+	startTime := time.Now()
+	statsKey := []string{"WatchRecursive", st.cell}
+	defer topoStatsConnTimings.Record(statsKey, startTime)
+	current, changes, cancel, err = st.conn.WatchRecursive(ctx, dirPath)
+	if err != nil {
+		topoStatsConnErrors.Add(statsKey, int64(1))
+	}
+	return current, changes, cancel, err
+}
+
 // NewMasterParticipation is part of the Conn interface
 func (st *StatsConn) NewMasterParticipation(name, id string) (MasterParticipation, error) {
 	startTime := time.Now()
@@ -86,6 +86,14 @@ func (st *fakeConn) Watch(ctx context.Context, filePath string) (current *WatchD
 	return current, changes, cancel
 }
 
+// WatchRecursive is part of the Conn interface
+func (st *fakeConn) WatchRecursive(ctx context.Context, dirPath string) (current []*WatchDataRecursive, changes <-chan *WatchDataRecursive, cancel CancelFunc, err error) {
+	if dirPath == "error" {
+		return current, changes, cancel, fmt.Errorf("dummy error")
+	}
+	return current, changes, cancel, err
+}
+
 // NewMasterParticipation is part of the Conn interface
 func (st *fakeConn) NewMasterParticipation(name, id string) (mp MasterParticipation, err error) {
 	if name == "error" {
@@ -275,6 +283,33 @@ func TestStatsConnTopoWatch(t *testing.T) {
 
 }
 
+//TestStatsConnTopoWatchRecursive emits stats on WatchRecursive
+func TestStatsConnTopoWatchRecursive(t *testing.T) {
+	conn := &fakeConn{}
+	statsConn := NewStatsConn("global", conn)
+	ctx := context.Background()
+
+	statsConn.WatchRecursive(ctx, "")
+	timingCounts := topoStatsConnTimings.Counts()["WatchRecursive.global"]
+	if got, want := timingCounts, int64(1); got != want {
+		t.Errorf("stats were not properly recorded: got = %d, want = %d", got, want)
+	}
+
+	// error is zero before getting an error
+	errorCount := topoStatsConnErrors.Counts()["WatchRecursive.global"]
+	if got, want := errorCount, int64(0); got != want {
+		t.Errorf("stats were not properly recorded: got = %d, want = %d", got, want)
+	}
+
+	statsConn.WatchRecursive(ctx, "error")
+
+	// error stats gets emitted
+	errorCount = topoStatsConnErrors.Counts()["WatchRecursive.global"]
+	if got, want := errorCount, int64(1); got != want {
+		t.Errorf("stats were not properly recorded: got = %d, want = %d", got, want)
+	}
+}
+
 //TestStatsConnTopoNewMasterParticipation emits stats on NewMasterParticipation
 func TestStatsConnTopoNewMasterParticipation(t *testing.T) {
 	conn := &fakeConn{}
@@ -473,6 +473,102 @@ func (ts *Server) GetTabletsByCell(ctx context.Context, cell string) ([]*topodat
 	return result, nil
 }
 
+// WatchTabletData is returned / streamed by WatchTablets.
+// For the tablets, Alias is set, and exactly one of Value or Err:
+// Err is ErrNoNode when the tablet was deleted. A record with only
+// Err set is the last one of the watch.
+type WatchTabletData struct {
+	Alias *topodatapb.TabletAlias
+	Value *topodatapb.Tablet
+	Err   error
+}
+
+// WatchTablets watches all the tablets in a cell. It returns the
+// current tablets, and streams their changes, see Conn.WatchRecursive.
+// The cell doesn't need to have tablets yet.
+func (ts *Server) WatchTablets(ctx context.Context, cell string) ([]*WatchTabletData, <-chan *WatchTabletData, CancelFunc, error) {
+	conn, err := ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	current, wdChannel, cancel, err := conn.WatchRecursive(ctx, TabletsPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var tablets []*WatchTabletData
+	for _, wd := range current {
+		twd, err := tabletWatchData(wd)
+		if err != nil {
+			// Cancel the watch, drain channel.
+			cancel()
+			for range wdChannel {
+			}
+			return nil, nil, nil, vterrors.Wrapf(err, "error unpacking initial Tablet object")
+		}
+		if twd != nil {
+			tablets = append(tablets, twd)
+		}
+	}
+
+	changes := make(chan *WatchTabletData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying WatchRecursive() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil && !IsErrType(wd.Err, NoNode) {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchTabletData{Err: wd.Err}
+				return
+			}
+
+			twd, err := tabletWatchData(wd)
+			if err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchTabletData{Err: vterrors.Wrapf(err, "error unpacking Tablet object")}
+				return
+			}
+			if twd != nil {
+				changes <- twd
+			}
+		}
+	}()
+
+	return tablets, changes, cancel, nil
+}
+
+// tabletWatchData translates a WatchRecursive record of the tablets
+// directory. It returns nil for the files that aren't tablet records.
+func tabletWatchData(wd *WatchDataRecursive) (*WatchTabletData, error) {
+	dir, file := path.Split(wd.Path)
+	dir = path.Clean(dir)
+	if file != TabletFile || path.Dir(dir) != TabletsPath {
+		return nil, nil
+	}
+	alias, err := topoproto.ParseTabletAlias(path.Base(dir))
+	if err != nil {
+		return nil, nil
+	}
+	if wd.Err != nil {
+		return &WatchTabletData{Alias: alias, Err: wd.Err}, nil
+	}
+	value := &topodatapb.Tablet{}
+	if err := proto.Unmarshal(wd.Contents, value); err != nil {
+		return nil, err
+	}
+	return &WatchTabletData{Alias: alias, Value: value}, nil
+}
+
 // ParseServingTabletType parses the tablet type into the enum, and makes sure
 // that the enum is of serving type (MASTER, REPLICA, RDONLY/BATCH).
 //
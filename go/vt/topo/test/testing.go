@@ -91,6 +91,11 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	checkRoutingRules(t, ts)
 	ts.Close()
 
+	t.Log("=== checkRangeMaps")
+	ts = factory()
+	checkRangeMaps(t, ts)
+	ts.Close()
+
 	t.Log("=== checkElection")
 	ts = factory()
 	checkElection(t, ts)
@@ -111,4 +116,9 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	checkWatch(t, ts)
 	checkWatchInterrupt(t, ts)
 	ts.Close()
+
+	t.Log("=== checkWatchRecursive")
+	ts = factory()
+	checkWatchRecursive(t, ts)
+	ts.Close()
 }
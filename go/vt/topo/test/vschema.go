@@ -115,3 +115,36 @@ func checkRoutingRules(t *testing.T, ts *topo.Server) {
 		t.Errorf("GetRoutingRules: %v, want %v", got, want)
 	}
 }
+
+// checkRangeMaps runs the tests on the range maps part of the API
+func checkRangeMaps(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	got, err := ts.GetRangeMaps(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.RangeMaps)
+
+	want := &vschemapb.RangeMaps{
+		RangeMaps: map[string]*vschemapb.RangeMap{
+			"tenants": {
+				Ranges: []*vschemapb.IdRange{{
+					Start:      1,
+					End:        100,
+					KeyspaceId: "80",
+				}},
+			},
+		},
+	}
+	require.NoError(t, ts.SaveRangeMaps(ctx, want))
+
+	got, err = ts.GetRangeMaps(ctx)
+	require.NoError(t, err)
+	if !proto.Equal(got, want) {
+		t.Errorf("GetRangeMaps: %v, want %v", got, want)
+	}
+
+	require.NoError(t, ts.SaveRangeMaps(ctx, &vschemapb.RangeMaps{}))
+	got, err = ts.GetRangeMaps(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.RangeMaps)
+}
@@ -216,3 +216,123 @@ func checkWatchInterrupt(t *testing.T, ts *topo.Server) {
 	// And calling cancel() again should just work.
 	cancel()
 }
+
+// waitForRecursiveChange waits for the given contents of filePath
+// to show up on a WatchRecursive channel, skipping the older versions
+// of the file. nil contents mean the file has to be deleted.
+func waitForRecursiveChange(t *testing.T, changes <-chan *topo.WatchDataRecursive, filePath string, contents []byte) {
+	t.Helper()
+	timeout := time.After(30 * time.Second)
+	for {
+		var wd *topo.WatchDataRecursive
+		var ok bool
+		select {
+		case wd, ok = <-changes:
+		case <-timeout:
+			t.Fatalf("timed out waiting for a change of %v", filePath)
+		}
+		if !ok {
+			t.Fatalf("watch channel unexpectedly closed")
+		}
+		if wd.Path != filePath {
+			t.Fatalf("got a change for %v while waiting for %v: %v", wd.Path, filePath, wd)
+		}
+		if contents == nil {
+			if topo.IsErrType(wd.Err, topo.NoNode) {
+				return
+			}
+			if wd.Err != nil {
+				t.Fatalf("watch failed: %v", wd.Err)
+			}
+			// an older version of the file
+			continue
+		}
+		if wd.Err != nil {
+			t.Fatalf("watch failed: %v", wd.Err)
+		}
+		if string(wd.Contents) == string(contents) {
+			return
+		}
+	}
+}
+
+// checkWatchRecursive tests we can watch all the files of a directory.
+func checkWatchRecursive(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	conn, err := ts.ConnForCell(ctx, LocalCellName)
+	if err != nil {
+		t.Fatalf("ConnForCell(test) failed: %v", err)
+	}
+
+	// The directory doesn't exist yet, watching it works.
+	current, changes, cancel, err := conn.WatchRecursive(ctx, "keyspaces/test_keyspace")
+	if err != nil {
+		t.Fatalf("WatchRecursive failed: %v", err)
+	}
+	if len(current) != 0 {
+		t.Fatalf("got unexpected initial files: %v", current)
+	}
+
+	// Create, update and delete a file, all the changes
+	// should be sent.
+	const filePath = "keyspaces/test_keyspace/SrvKeyspace"
+	if _, err := conn.Create(ctx, filePath, []byte("a")); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	waitForRecursiveChange(t, changes, filePath, []byte("a"))
+	if _, err := conn.Update(ctx, filePath, []byte("b"), nil); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	waitForRecursiveChange(t, changes, filePath, []byte("b"))
+
+	// A file in a sibling directory with the same prefix isn't
+	// sent, but one in a sub-directory is.
+	if _, err := conn.Create(ctx, "keyspaces/test_keyspace2/SrvKeyspace", []byte("c")); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	const subFilePath = "keyspaces/test_keyspace/shards/0/Shard"
+	if _, err := conn.Create(ctx, subFilePath, []byte("d")); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	waitForRecursiveChange(t, changes, subFilePath, []byte("d"))
+
+	if err := conn.Delete(ctx, filePath, nil); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	waitForRecursiveChange(t, changes, filePath, nil)
+
+	// A new watch returns the remaining file.
+	current2, changes2, cancel2, err := conn.WatchRecursive(ctx, "keyspaces/test_keyspace")
+	if err != nil {
+		t.Fatalf("WatchRecursive failed: %v", err)
+	}
+	if len(current2) != 1 || current2[0].Path != subFilePath || string(current2[0].Contents) != "d" || current2[0].Err != nil {
+		t.Fatalf("got unexpected initial files: %v", current2)
+	}
+	cancel2()
+	for range changes2 {
+	}
+
+	// Cancel the first watch, we should get topo.ErrInterrupted.
+	cancel()
+	for {
+		wd, ok := <-changes
+		if !ok {
+			t.Fatalf("watch channel unexpectedly closed")
+		}
+		if topo.IsErrType(wd.Err, topo.Interrupted) {
+			break
+		}
+		if wd.Err != nil && !topo.IsErrType(wd.Err, topo.NoNode) {
+			t.Fatalf("bad error returned for cancellation: %v", wd.Err)
+		}
+	}
+
+	// Now the channel should be closed.
+	if wd, ok := <-changes; ok {
+		t.Fatalf("got unexpected event after error: %v", wd)
+	}
+
+	// And calling cancel() again should just work.
+	cancel()
+}
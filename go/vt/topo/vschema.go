@@ -128,3 +128,39 @@ func (ts *Server) GetRoutingRules(ctx context.Context) (*vschemapb.RoutingRules,
 	}
 	return rr, nil
 }
+
+// SaveRangeMaps saves the range maps into the topo.
+func (ts *Server) SaveRangeMaps(ctx context.Context, rangeMaps *vschemapb.RangeMaps) error {
+	data, err := proto.Marshal(rangeMaps)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		// No range maps, remove them.
+		if err := ts.globalCell.Delete(ctx, RangeMapsFile, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+
+	_, err = ts.globalCell.Update(ctx, RangeMapsFile, data, nil)
+	return err
+}
+
+// GetRangeMaps fetches the range maps from the topo.
+func (ts *Server) GetRangeMaps(ctx context.Context) (*vschemapb.RangeMaps, error) {
+	rm := &vschemapb.RangeMaps{}
+	data, _, err := ts.globalCell.Get(ctx, RangeMapsFile)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return rm, nil
+		}
+		return nil, err
+	}
+	err = proto.Unmarshal(data, rm)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "bad range maps data: %q", data)
+	}
+	return rm, nil
+}
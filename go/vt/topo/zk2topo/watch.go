@@ -19,10 +19,13 @@ package zk2topo
 import (
 	"fmt"
 	"path"
+	"strings"
 	"sync"
 
 	"context"
 
+	"github.com/z-division/go-zookeeper/zk"
+
 	"vitess.io/vitess/go/vt/vterrors"
 
 	"vitess.io/vitess/go/vt/topo"
@@ -108,3 +111,281 @@ func (zs *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData,
 
 	return wd, c, cancel
 }
+
+// WatchRecursive is part of the topo.Conn interface.
+//
+// Zookeeper only has one-time watches on single nodes, so this keeps
+// a children watch on all the nodes under dirPath, and a data watch
+// on all the files, that is the nodes without children, as ListDir
+// reports them. Each fired watch resyncs its node.
+func (zs *Server) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	w := &recursiveWatcher{
+		zs:            zs,
+		dirPath:       dirPath,
+		root:          path.Join(zs.root, dirPath),
+		files:         make(map[string]int32),
+		childWatched:  make(map[string]bool),
+		dataWatched:   make(map[string]bool),
+		events:        make(chan recursiveWatchEvent, 10),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+		notifications: make(chan *topo.WatchDataRecursive, 10),
+	}
+
+	// Get the initial state, and set the initial watches.
+	var current []*topo.WatchDataRecursive
+	w.send = func(wd *topo.WatchDataRecursive) {
+		current = append(current, wd)
+	}
+	if err := w.sync(ctx, w.root); err != nil {
+		close(w.stop)
+		return nil, nil, nil, err
+	}
+
+	// mu protects the stop channel, see Watch.
+	mu := sync.Mutex{}
+	stop := w.stop
+	cancel := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if stop != nil {
+			close(stop)
+			stop = nil
+		}
+	}
+
+	w.send = func(wd *topo.WatchDataRecursive) {
+		w.notifications <- wd
+	}
+	go w.run()
+
+	return current, w.notifications, cancel, nil
+}
+
+// recursiveWatchEvent is a fired watch of a recursiveWatcher.
+type recursiveWatchEvent struct {
+	zkPath string
+	data   bool
+	event  zk.Event
+	ok     bool
+}
+
+// recursiveWatcher has the state of a WatchRecursive.
+// Only its run goroutine uses it once the watch is set.
+type recursiveWatcher struct {
+	zs      *Server
+	dirPath string
+	// root is the zk path of dirPath.
+	root string
+
+	// files has the version of the files we know of, by zk path.
+	files map[string]int32
+	// childWatched and dataWatched have the nodes with a set
+	// children or data (or exists) watch.
+	childWatched map[string]bool
+	dataWatched  map[string]bool
+
+	// events receives the fired watches.
+	events chan recursiveWatchEvent
+	// stop is closed by the cancel func, and done when run returns.
+	stop chan struct{}
+	done chan struct{}
+
+	notifications chan *topo.WatchDataRecursive
+	send          func(wd *topo.WatchDataRecursive)
+}
+
+func (w *recursiveWatcher) run() {
+	defer close(w.notifications)
+	defer close(w.done)
+
+	// The context of the zk calls, canceled when the watch is.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-w.stop:
+			cancel()
+		case <-w.done:
+		}
+	}()
+
+	for {
+		var ev recursiveWatchEvent
+		select {
+		case ev = <-w.events:
+		case <-w.stop:
+			// user is not interested any more
+			w.sendError(topo.NewError(topo.Interrupted, "watch"))
+			return
+		}
+
+		if !ev.ok {
+			w.sendError(fmt.Errorf("watch on %v was closed", ev.zkPath))
+			return
+		}
+		if ev.event.Err != nil {
+			w.sendError(vterrors.Wrapf(ev.event.Err, "received a non-OK event for %v", ev.zkPath))
+			return
+		}
+
+		if ev.data {
+			delete(w.dataWatched, ev.zkPath)
+		} else {
+			delete(w.childWatched, ev.zkPath)
+		}
+		if err := w.sync(ctx, ev.zkPath); err != nil {
+			select {
+			case <-w.stop:
+				w.sendError(topo.NewError(topo.Interrupted, "watch"))
+			default:
+				w.sendError(err)
+			}
+			return
+		}
+	}
+}
+
+// sync reads the node at zkPath and its new descendants, sends
+// their changes, and sets the missing watches on them.
+func (w *recursiveWatcher) sync(ctx context.Context, zkPath string) error {
+	var children []string
+	var err error
+	if w.childWatched[zkPath] {
+		children, _, err = w.zs.conn.Children(ctx, zkPath)
+	} else {
+		var watch <-chan zk.Event
+		children, _, watch, err = w.zs.conn.ChildrenW(ctx, zkPath)
+		if err == nil {
+			w.childWatched[zkPath] = true
+			w.forward(zkPath, false, watch)
+		}
+	}
+	if err == zk.ErrNoNode {
+		return w.deleted(ctx, zkPath)
+	}
+	if err != nil {
+		return convertError(err, zkPath)
+	}
+
+	if len(children) > 0 || zkPath == w.root {
+		// This is a directory. If it used to be a file,
+		// that file is gone.
+		if _, ok := w.files[zkPath]; ok {
+			delete(w.files, zkPath)
+			w.sendDeleted(zkPath)
+		}
+		for _, child := range children {
+			childPath := path.Join(zkPath, child)
+			if w.childWatched[childPath] {
+				// We already know about it.
+				continue
+			}
+			if err := w.sync(ctx, childPath); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// This is a file.
+	var data []byte
+	var stat *zk.Stat
+	if w.dataWatched[zkPath] {
+		data, stat, err = w.zs.conn.Get(ctx, zkPath)
+	} else {
+		var watch <-chan zk.Event
+		data, stat, watch, err = w.zs.conn.GetW(ctx, zkPath)
+		if err == nil {
+			w.dataWatched[zkPath] = true
+			w.forward(zkPath, true, watch)
+		}
+	}
+	if err == zk.ErrNoNode {
+		return w.deleted(ctx, zkPath)
+	}
+	if err != nil {
+		return convertError(err, zkPath)
+	}
+	if version, ok := w.files[zkPath]; ok && version == stat.Version {
+		return nil
+	}
+	w.files[zkPath] = stat.Version
+	w.send(&topo.WatchDataRecursive{
+		Path: w.relativePath(zkPath),
+		WatchData: topo.WatchData{
+			Contents: data,
+			Version:  ZKVersion(stat.Version),
+		},
+	})
+	return nil
+}
+
+// deleted handles a node that doesn't exist any more: all the
+// files we know of under it are gone. If it's the watched
+// directory itself, we wait for it to be created again.
+func (w *recursiveWatcher) deleted(ctx context.Context, zkPath string) error {
+	for filePath := range w.files {
+		if filePath == zkPath || strings.HasPrefix(filePath, zkPath+"/") {
+			delete(w.files, filePath)
+			w.sendDeleted(filePath)
+		}
+	}
+	if zkPath != w.root || w.dataWatched[zkPath] {
+		return nil
+	}
+
+	exists, _, watch, err := w.zs.conn.ExistsW(ctx, zkPath)
+	if err != nil {
+		return convertError(err, zkPath)
+	}
+	w.dataWatched[zkPath] = true
+	w.forward(zkPath, true, watch)
+	if exists {
+		// It was created again in the meantime.
+		return w.sync(ctx, zkPath)
+	}
+	return nil
+}
+
+// forward sends the event of a watch to the events channel,
+// until the watch is stopped.
+func (w *recursiveWatcher) forward(zkPath string, data bool, watch <-chan zk.Event) {
+	go func() {
+		var ev recursiveWatchEvent
+		select {
+		case ev.event, ev.ok = <-watch:
+		case <-w.stop:
+			return
+		case <-w.done:
+			return
+		}
+		ev.zkPath = zkPath
+		ev.data = data
+		select {
+		case w.events <- ev:
+		case <-w.stop:
+		case <-w.done:
+		}
+	}()
+}
+
+func (w *recursiveWatcher) sendDeleted(zkPath string) {
+	filePath := w.relativePath(zkPath)
+	w.send(&topo.WatchDataRecursive{
+		Path:      filePath,
+		WatchData: topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)},
+	})
+}
+
+func (w *recursiveWatcher) sendError(err error) {
+	w.send(&topo.WatchDataRecursive{
+		Path:      w.dirPath,
+		WatchData: topo.WatchData{Err: err},
+	})
+}
+
+// relativePath returns the path of a zk node relative to the root of the cell.
+func (w *recursiveWatcher) relativePath(zkPath string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(zkPath, w.zs.root), "/")
+}
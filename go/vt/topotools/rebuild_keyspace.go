@@ -98,6 +98,7 @@ func RebuildKeyspaceLocked(ctx context.Context, log logutil.Logger, ts *topo.Ser
 			ShardingColumnName: ki.ShardingColumnName,
 			ShardingColumnType: ki.ShardingColumnType,
 			ServedFrom:         ki.ComputeCellServedFrom(cell),
+			ThrottlerConfig:    ki.ThrottlerConfig,
 		}
 	}
 
@@ -167,6 +167,16 @@ func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
+// MessageAck is part of the VTGateService interface
+func (f *fakeVTGateService) MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+// MessageNack is part of the VTGateService interface
+func (f *fakeVTGateService) MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
 func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
 	return nil
 }
@@ -522,14 +522,20 @@ func (itc *internalTabletConn) BeginExecuteBatch(ctx context.Context, target *qu
 }
 
 // MessageStream is part of queryservice.QueryService
-func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
-	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, callback)
+func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name, consumerGroup string, callback func(*sqltypes.Result) error) error {
+	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, consumerGroup, callback)
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
 // MessageAck is part of queryservice.QueryService
-func (itc *internalTabletConn) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
-	count, err := itc.tablet.qsc.QueryService().MessageAck(ctx, target, name, ids)
+func (itc *internalTabletConn) MessageAck(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	count, err := itc.tablet.qsc.QueryService().MessageAck(ctx, target, name, consumerGroup, ids)
+	return count, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
+// MessageNack is part of queryservice.QueryService
+func (itc *internalTabletConn) MessageNack(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
+	count, err := itc.tablet.qsc.QueryService().MessageNack(ctx, target, name, ids)
 	return count, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
@@ -787,7 +793,7 @@ func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *top
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"context"
 
@@ -237,7 +238,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, "" /* restoreToPos */, time.Time{} /* restoreToTimestamp */)
 	if err != nil {
 		return err
 	}
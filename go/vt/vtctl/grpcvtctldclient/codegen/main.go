@@ -132,13 +132,19 @@ func main() { // nolint:funlen
 		// The second result is always error.
 		result := sig.Results().At(0)
 
-		localType, localImport, pkgPath, err = extractLocalPointerType(result) // (TODO|@amason): does not work for streaming rpcs
+		// Unary rpcs return a pointer to the response message, while streaming
+		// rpcs return a (non-pointer) client stream interface.
+		localType, localImport, pkgPath, isPointer, err := extractLocalNamedType(result)
 		if err != nil {
 			panic(err)
 		}
 
 		f.Result.Name = result.Name()
-		f.Result.Type = "*" + localImport + "." + localType
+		f.Result.Type = localImport + "." + localType
+
+		if isPointer {
+			f.Result.Type = "*" + f.Result.Type
+		}
 
 		if _, ok := imports[localImport]; !ok {
 			importNames = append(importNames, localImport)
@@ -288,3 +294,21 @@ func extractLocalPointerType(v *types.Var) (name string, localImport string, pkg
 
 	return name, localImport, pkgPath, nil
 }
+
+func extractLocalNamedType(v *types.Var) (name string, localImport string, pkgPath string, isPointer bool, err error) {
+	if _, ok := v.Type().(*types.Pointer); ok {
+		name, localImport, pkgPath, err = extractLocalPointerType(v)
+		return name, localImport, pkgPath, true, err
+	}
+
+	typ, ok := v.Type().(*types.Named)
+	if !ok {
+		return "", "", "", false, fmt.Errorf("expected a pointer or named type for %s, got %v", v.Name(), v.Type())
+	}
+
+	name = typ.Obj().Name()
+	localImport = rewriteProtoImports(typ.Obj().Pkg())
+	pkgPath = typ.Obj().Pkg().Path()
+
+	return name, localImport, pkgPath, false, nil
+}
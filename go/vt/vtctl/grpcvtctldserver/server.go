@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -32,20 +34,26 @@ import (
 	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/mysqlctlproto"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemamanager"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/topotools/events"
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
+	"vitess.io/vitess/go/vt/vtctl/schematools"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
@@ -61,6 +69,13 @@ import (
 
 const (
 	initShardMasterOperation = "InitShardMaster" // (TODO:@amason) Can I rename this to Primary?
+
+	// defaultReloadSchemaConcurrency is the number of tablets reloaded at once
+	// by ReloadSchemaShard and ReloadSchemaKeyspace, when unset in a request.
+	defaultReloadSchemaConcurrency = 10
+	// defaultWaitReplicasTimeout is how long ApplySchema waits for replicas to
+	// receive a schema change, when unset in a request.
+	defaultWaitReplicasTimeout = 10 * time.Second
 )
 
 // VtctldServer implements the Vtctld RPC service protocol.
@@ -123,6 +138,48 @@ func (s *VtctldServer) AddCellsAlias(ctx context.Context, req *vtctldatapb.AddCe
 	return &vtctldatapb.AddCellsAliasResponse{}, nil
 }
 
+// ApplyRangeMaps is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyRangeMaps(ctx context.Context, req *vtctldatapb.ApplyRangeMapsRequest) (*vtctldatapb.ApplyRangeMapsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyRangeMaps")
+	defer span.Finish()
+
+	span.Annotate("skip_rebuild", req.SkipRebuild)
+	span.Annotate("rebuild_cells", strings.Join(req.RebuildCells, ","))
+
+	for name, rangeMap := range req.RangeMaps.GetRangeMaps() {
+		if err := vindexes.ValidateRangeMap(rangeMap); err != nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid range map %s: %v", name, err)
+		}
+	}
+	for _, pending := range req.RangeMaps.GetPending() {
+		if pending.Keyspace == "" || pending.Workflow == "" {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "pending range maps require a keyspace and a workflow")
+		}
+		for name, rangeMap := range pending.RangeMaps {
+			if err := vindexes.ValidateRangeMap(rangeMap); err != nil {
+				return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid range map %s pending for workflow %s.%s: %v", name, pending.Keyspace, pending.Workflow, err)
+			}
+		}
+	}
+
+	if err := s.ts.SaveRangeMaps(ctx, req.RangeMaps); err != nil {
+		return nil, err
+	}
+
+	resp := &vtctldatapb.ApplyRangeMapsResponse{}
+
+	if req.SkipRebuild {
+		log.Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		return resp, nil
+	}
+
+	if err := s.ts.RebuildSrvVSchema(ctx, req.RebuildCells); err != nil {
+		return nil, vterrors.Wrapf(err, "RebuildSrvVSchema(%v) failed: %v", req.RebuildCells, err)
+	}
+
+	return resp, nil
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.ApplyRoutingRulesRequest) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyRoutingRules")
@@ -149,6 +206,92 @@ func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.A
 	return resp, nil
 }
 
+// ApplySchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySchemaRequest) (*vtctldatapb.ApplySchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplySchema")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("allow_long_unavailability", req.AllowLongUnavailability)
+	span.Annotate("ddl_strategy", req.DdlStrategy)
+	span.Annotate("migration_context", req.MigrationContext)
+	span.Annotate("skip_preflight", req.SkipPreflight)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ApplySchema requires a keyspace")
+	}
+
+	if len(req.Sql) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ApplySchema requires at least one SQL statement")
+	}
+
+	// The PlainController panics on unsplittable input, so we check it here
+	// and return a proper error instead.
+	for _, sql := range req.Sql {
+		if _, err := sqlparser.SplitStatementToPieces(sql); err != nil {
+			return nil, vterrors.Wrapf(err, "cannot split SQL statement %q", sql)
+		}
+	}
+
+	waitReplicasTimeout, ok, err := protoutil.DurationFromProto(req.WaitReplicasTimeout)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		waitReplicasTimeout = defaultWaitReplicasTimeout
+	}
+
+	migrationContext := req.MigrationContext
+	if migrationContext == "" {
+		executionUUID, err := schema.CreateUUID()
+		if err != nil {
+			return nil, err
+		}
+
+		migrationContext = fmt.Sprintf("vtctld:%s", executionUUID)
+	}
+
+	executor := schemamanager.NewTabletExecutor(migrationContext, s.ts, s.tmc, logutil.NewConsoleLogger(), waitReplicasTimeout)
+	if req.AllowLongUnavailability {
+		executor.AllowBigSchemaChange()
+	}
+
+	if req.SkipPreflight {
+		executor.SkipPreflight()
+	}
+
+	if err := executor.SetDDLStrategy(req.DdlStrategy); err != nil {
+		return nil, vterrors.Wrapf(err, "invalid DdlStrategy: %s", req.DdlStrategy)
+	}
+
+	controller := &applySchemaController{
+		PlainController: schemamanager.NewPlainController(strings.Join(req.Sql, ";"), req.Keyspace),
+	}
+
+	if err := schemamanager.Run(ctx, controller, executor); err != nil {
+		return nil, err
+	}
+
+	resp := &vtctldatapb.ApplySchemaResponse{}
+	if controller.result != nil {
+		resp.UuidList = controller.result.UUIDs
+	}
+
+	return resp, nil
+}
+
+// applySchemaController is a schemamanager.PlainController that also holds on
+// to the executor's result, so ApplySchema can report the migration UUIDs.
+type applySchemaController struct {
+	*schemamanager.PlainController
+	result *schemamanager.ExecuteResult
+}
+
+// OnExecutorComplete is part of the schemamanager.Controller interface.
+func (controller *applySchemaController) OnExecutorComplete(ctx context.Context, result *schemamanager.ExecuteResult) error {
+	controller.result = result
+	return controller.PlainController.OnExecutorComplete(ctx, result)
+}
+
 // ApplyVSchema is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyVSchemaRequest) (*vtctldatapb.ApplyVSchemaResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyVSchema")
@@ -221,6 +364,130 @@ func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyV
 	return &vtctldatapb.ApplyVSchemaResponse{VSchema: updatedVS}, nil
 }
 
+// Backup is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) Backup(req *vtctldatapb.BackupRequest, stream vtctlservicepb.Vtctld_BackupServer) error {
+	span, ctx := trace.NewSpan(stream.Context(), "VtctldServer.Backup")
+	defer span.Finish()
+
+	if req.TabletAlias == nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "Backup requires a tablet alias")
+	}
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("allow_primary", req.AllowPrimary)
+	span.Annotate("concurrency", req.Concurrency)
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return err
+	}
+
+	span.Annotate("keyspace", ti.Keyspace)
+	span.Annotate("shard", ti.Shard)
+
+	return s.backupTablet(ctx, ti.Tablet, req.AllowPrimary, req.Concurrency, stream)
+}
+
+// BackupShard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) BackupShard(req *vtctldatapb.BackupShardRequest, stream vtctlservicepb.Vtctld_BackupShardServer) error {
+	span, ctx := trace.NewSpan(stream.Context(), "VtctldServer.BackupShard")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("allow_primary", req.AllowPrimary)
+	span.Annotate("concurrency", req.Concurrency)
+
+	positions, err := s.ShardReplicationPositions(ctx, &vtctldatapb.ShardReplicationPositionsRequest{
+		Keyspace: req.Keyspace,
+		Shard:    req.Shard,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		backupTablet  *topodatapb.Tablet
+		secondsBehind uint32
+	)
+
+	for alias, tablet := range positions.TabletMap {
+		// Find a replica, rdonly or spare tablet type to run the backup on.
+		switch tablet.Type {
+		case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_SPARE:
+		default:
+			continue
+		}
+
+		status, ok := positions.ReplicationStatuses[alias]
+		if !ok || status == nil {
+			// We could not get a replication position for this tablet, so we
+			// cannot tell how far behind it is.
+			continue
+		}
+
+		// Choose this tablet if it is the first candidate, or if it is more
+		// up-to-date than the current candidate.
+		if backupTablet == nil || status.SecondsBehindMaster < secondsBehind {
+			backupTablet = tablet
+			secondsBehind = status.SecondsBehindMaster
+		}
+	}
+
+	// If no other tablet is available and AllowPrimary is set, fall back to
+	// the primary.
+	if backupTablet == nil && req.AllowPrimary {
+		for _, tablet := range positions.TabletMap {
+			if tablet.Type == topodatapb.TabletType_MASTER {
+				backupTablet = tablet
+				break
+			}
+		}
+	}
+
+	if backupTablet == nil {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no tablet available for backup in shard %s/%s", req.Keyspace, req.Shard)
+	}
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(backupTablet.Alias))
+
+	return s.backupTablet(ctx, backupTablet, req.AllowPrimary, req.Concurrency, stream)
+}
+
+// backupTablet is shared by Backup and BackupShard. It runs the backup on the
+// given tablet, forwarding each log event from the tablet to the stream.
+func (s *VtctldServer) backupTablet(ctx context.Context, tablet *topodatapb.Tablet, allowPrimary bool, concurrency uint64, stream interface {
+	Send(resp *vtctldatapb.BackupResponse) error
+}) error {
+	logStream, err := s.tmc.Backup(ctx, tablet, int(concurrency), allowPrimary)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := logStream.Recv()
+		switch err {
+		case nil:
+			resp := &vtctldatapb.BackupResponse{
+				TabletAlias: tablet.Alias,
+				Keyspace:    tablet.Keyspace,
+				Shard:       tablet.Shard,
+				Event:       event,
+			}
+
+			// The backup keeps running on the tablet even if the client goes
+			// away, so we only log send failures here.
+			if err := stream.Send(resp); err != nil {
+				log.Errorf("failed to send stream response %+v: %v", resp, err)
+			}
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.ChangeTabletTypeRequest) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ChangeTabletType")
@@ -621,6 +888,49 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 	return resp, err
 }
 
+// ExecuteHook is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ExecuteHook(ctx context.Context, req *vtctldatapb.ExecuteHookRequest) (*vtctldatapb.ExecuteHookResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ExecuteHook")
+	defer span.Finish()
+
+	if req.TabletAlias == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ExecuteHook requires a tablet alias")
+	}
+
+	if req.TabletHookRequest == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ExecuteHook requires a TabletHookRequest")
+	}
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("hook_name", req.TabletHookRequest.Name)
+
+	if strings.Contains(req.TabletHookRequest.Name, "/") {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "hook name cannot contain a '/'; was %v", req.TabletHookRequest.Name)
+	}
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	hr, err := s.tmc.ExecuteHook(ctx, ti.Tablet, &hook.Hook{
+		Name:       req.TabletHookRequest.Name,
+		Parameters: req.TabletHookRequest.Parameters,
+		ExtraEnv:   req.TabletHookRequest.ExtraEnv,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ExecuteHookResponse{
+		HookResult: &tabletmanagerdatapb.ExecuteHookResponse{
+			ExitStatus: int64(hr.ExitStatus),
+			Stdout:     hr.Stdout,
+			Stderr:     hr.Stderr,
+		},
+	}, nil
+}
+
 // FindAllShardsInKeyspace is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) FindAllShardsInKeyspace(ctx context.Context, req *vtctldatapb.FindAllShardsInKeyspaceRequest) (*vtctldatapb.FindAllShardsInKeyspaceResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.FindAllShardsInKeyspace")
@@ -803,6 +1113,21 @@ func (s *VtctldServer) GetKeyspaces(ctx context.Context, req *vtctldatapb.GetKey
 	return &vtctldatapb.GetKeyspacesResponse{Keyspaces: keyspaces}, nil
 }
 
+// GetRangeMaps is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetRangeMaps(ctx context.Context, req *vtctldatapb.GetRangeMapsRequest) (*vtctldatapb.GetRangeMapsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetRangeMaps")
+	defer span.Finish()
+
+	rm, err := s.ts.GetRangeMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetRangeMapsResponse{
+		RangeMaps: rm,
+	}, nil
+}
+
 // GetRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetRoutingRules(ctx context.Context, req *vtctldatapb.GetRoutingRulesRequest) (*vtctldatapb.GetRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetRoutingRules")
@@ -1471,6 +1796,38 @@ func (s *VtctldServer) InitShardPrimaryLocked(
 	return nil
 }
 
+// MoveTables is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.MoveTables")
+	defer span.Finish()
+
+	if req.TargetKeyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "target_keyspace field is required")
+	}
+
+	if req.Workflow == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "workflow field is required")
+	}
+
+	span.Annotate("action", req.Action.String())
+	span.Annotate("target_keyspace", req.TargetKeyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("dry_run", req.DryRun)
+
+	runner, logger, err := s.newVReplicationWorkflowRunner()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := runner.MoveTables(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Events = logger.Events
+	return resp, nil
+}
+
 // PlannedReparentShard is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) PlannedReparentShard(ctx context.Context, req *vtctldatapb.PlannedReparentShardRequest) (*vtctldatapb.PlannedReparentShardResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.PlannedReparentShard")
@@ -1609,6 +1966,114 @@ func (s *VtctldServer) RefreshStateByShard(ctx context.Context, req *vtctldatapb
 	}, nil
 }
 
+// ReloadSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ReloadSchema(ctx context.Context, req *vtctldatapb.ReloadSchemaRequest) (*vtctldatapb.ReloadSchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReloadSchema")
+	defer span.Finish()
+
+	if req.TabletAlias == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ReloadSchema requires a tablet alias")
+	}
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "GetTablet(%v) failed: %v", req.TabletAlias, err)
+	}
+
+	if err := s.tmc.ReloadSchema(ctx, ti.Tablet, ""); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ReloadSchemaResponse{}, nil
+}
+
+// ReloadSchemaKeyspace is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ReloadSchemaKeyspace(ctx context.Context, req *vtctldatapb.ReloadSchemaKeyspaceRequest) (*vtctldatapb.ReloadSchemaKeyspaceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReloadSchemaKeyspace")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("include_primary", req.IncludePrimary)
+	span.Annotate("concurrency", req.Concurrency)
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetShardNames(%v) failed", req.Keyspace)
+	}
+
+	// The concurrency is shared across all shards, so only that many tablets
+	// will be reloaded at once.
+	sema := sync2.NewSemaphore(reloadSchemaConcurrency(req.Concurrency), 0)
+	logger := logutil.NewMemoryLogger()
+
+	for _, shard := range shards {
+		schematools.ReloadShard(ctx, s.ts, s.tmc, logger, req.Keyspace, shard, req.WaitPosition, sema, req.IncludePrimary)
+	}
+
+	return &vtctldatapb.ReloadSchemaKeyspaceResponse{
+		Events: logger.Events,
+	}, nil
+}
+
+// ReloadSchemaShard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ReloadSchemaShard(ctx context.Context, req *vtctldatapb.ReloadSchemaShardRequest) (*vtctldatapb.ReloadSchemaShardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReloadSchemaShard")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("include_primary", req.IncludePrimary)
+	span.Annotate("concurrency", req.Concurrency)
+
+	sema := sync2.NewSemaphore(reloadSchemaConcurrency(req.Concurrency), 0)
+	logger := logutil.NewMemoryLogger()
+
+	schematools.ReloadShard(ctx, s.ts, s.tmc, logger, req.Keyspace, req.Shard, req.WaitPosition, sema, req.IncludePrimary)
+
+	return &vtctldatapb.ReloadSchemaShardResponse{
+		Events: logger.Events,
+	}, nil
+}
+
+func reloadSchemaConcurrency(concurrency uint32) int {
+	if concurrency == 0 {
+		return defaultReloadSchemaConcurrency
+	}
+
+	return int(concurrency)
+}
+
+// RemoveBackup is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RemoveBackup(ctx context.Context, req *vtctldatapb.RemoveBackupRequest) (*vtctldatapb.RemoveBackupResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.RemoveBackup")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("name", req.Name)
+
+	if req.Keyspace == "" || req.Shard == "" || req.Name == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "RemoveBackup requires a keyspace, shard and backup name; got %s/%s %q", req.Keyspace, req.Shard, req.Name)
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	bucket := filepath.Join(req.Keyspace, req.Shard)
+	span.Annotate("backup_path", bucket)
+
+	if err := bs.RemoveBackup(ctx, bucket, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.RemoveBackupResponse{}, nil
+}
+
 // RemoveKeyspaceCell is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) RemoveKeyspaceCell(ctx context.Context, req *vtctldatapb.RemoveKeyspaceCellRequest) (*vtctldatapb.RemoveKeyspaceCellResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.RemoveKeyspaceCell")
@@ -1712,6 +2177,130 @@ func (s *VtctldServer) ReparentTablet(ctx context.Context, req *vtctldatapb.Repa
 	}, nil
 }
 
+// Reshard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.Reshard")
+	defer span.Finish()
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	if req.Workflow == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "workflow field is required")
+	}
+
+	span.Annotate("action", req.Action.String())
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("dry_run", req.DryRun)
+
+	runner, logger, err := s.newVReplicationWorkflowRunner()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := runner.Reshard(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Events = logger.Events
+	return resp, nil
+}
+
+// RestoreFromBackup is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RestoreFromBackup(req *vtctldatapb.RestoreFromBackupRequest, stream vtctlservicepb.Vtctld_RestoreFromBackupServer) error {
+	span, ctx := trace.NewSpan(stream.Context(), "VtctldServer.RestoreFromBackup")
+	defer span.Finish()
+
+	if req.TabletAlias == nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "RestoreFromBackup requires a tablet alias")
+	}
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return err
+	}
+
+	span.Annotate("keyspace", ti.Keyspace)
+	span.Annotate("shard", ti.Shard)
+	span.Annotate("restore_to_pos", req.RestoreToPos)
+	span.Annotate("restore_to_timestamp", logutil.ProtoToTime(req.RestoreToTimestamp).String())
+
+	logStream, err := s.tmc.RestoreFromBackup(ctx, ti.Tablet, req.RestoreToPos, logutil.ProtoToTime(req.RestoreToTimestamp))
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := logStream.Recv()
+		switch err {
+		case nil:
+			resp := &vtctldatapb.RestoreFromBackupResponse{
+				TabletAlias: req.TabletAlias,
+				Keyspace:    ti.Keyspace,
+				Shard:       ti.Shard,
+				Event:       event,
+			}
+
+			if err := stream.Send(resp); err != nil {
+				log.Errorf("failed to send stream response %+v: %v", resp, err)
+			}
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+// SetShardTabletControl is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SetShardTabletControl(ctx context.Context, req *vtctldatapb.SetShardTabletControlRequest) (*vtctldatapb.SetShardTabletControlResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetShardTabletControl")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("tablet_type", topoproto.TabletTypeLString(req.TabletType))
+	span.Annotate("cells", strings.Join(req.Cells, ","))
+	span.Annotate("blacklisted_tables", strings.Join(req.BlacklistedTables, ","))
+	span.Annotate("disable_query_service", req.DisableQueryService)
+	span.Annotate("remove", req.Remove)
+
+	if !topo.IsInServingGraph(req.TabletType) {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "tablet type %v is not a serving type", topoproto.TabletTypeLString(req.TabletType))
+	}
+
+	// Take the keyspace lock so we do not interfere with resharding
+	// operations.
+	ctx, unlock, err := s.ts.LockKeyspace(ctx, req.Keyspace, "SetShardTabletControl")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock(&err)
+
+	si, err := s.ts.UpdateShardFields(ctx, req.Keyspace, req.Shard, func(si *topo.ShardInfo) error {
+		// We are setting or removing the blacklisted tables only.
+		return si.UpdateSourceBlacklistedTables(ctx, req.TabletType, req.Cells, req.Remove, req.BlacklistedTables)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Remove && len(req.BlacklistedTables) == 0 {
+		if err = s.ts.UpdateDisableQueryService(ctx, req.Keyspace, []*topo.ShardInfo{si}, req.TabletType, req.Cells, req.DisableQueryService); err != nil {
+			return nil, err
+		}
+	}
+
+	return &vtctldatapb.SetShardTabletControlResponse{
+		Shard: si.Shard,
+	}, nil
+}
+
 // ShardReplicationPositions is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) ShardReplicationPositions(ctx context.Context, req *vtctldatapb.ShardReplicationPositionsRequest) (*vtctldatapb.ShardReplicationPositionsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ShardReplicationPositions")
@@ -1839,6 +2428,38 @@ func (s *VtctldServer) ShardReplicationPositions(ctx context.Context, req *vtctl
 	}, nil
 }
 
+// SleepTablet is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SleepTablet(ctx context.Context, req *vtctldatapb.SleepTabletRequest) (*vtctldatapb.SleepTabletResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SleepTablet")
+	defer span.Finish()
+
+	if req.TabletAlias == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "SleepTablet requires a tablet alias")
+	}
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+
+	duration, ok, err := protoutil.DurationFromProto(req.Duration)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "SleepTablet requires a duration")
+	}
+
+	span.Annotate("sleep_duration", duration.String())
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tmc.Sleep(ctx, ti.Tablet, duration); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.SleepTabletResponse{}, nil
+}
+
 // TabletExternallyReparented is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) TabletExternallyReparented(ctx context.Context, req *vtctldatapb.TabletExternallyReparentedRequest) (*vtctldatapb.TabletExternallyReparentedResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.TabletExternallyReparented")
@@ -1982,6 +2603,405 @@ func (s *VtctldServer) UpdateCellsAlias(ctx context.Context, req *vtctldatapb.Up
 	}, nil
 }
 
+// UpdateThrottlerConfig is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) UpdateThrottlerConfig(ctx context.Context, req *vtctldatapb.UpdateThrottlerConfigRequest) (resp *vtctldatapb.UpdateThrottlerConfigResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.UpdateThrottlerConfig")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("threshold", req.Threshold)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace is required")
+	}
+	if rule := req.ThrottledApp; rule != nil {
+		span.Annotate("throttled_app", rule.Name)
+		span.Annotate("ratio", rule.Ratio)
+
+		if rule.Name == "" {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "the name of the throttled app is required")
+		}
+		if rule.Ratio < 0 || rule.Ratio > 1 {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid ratio %v for throttled app %s, it must be between 0 and 1", rule.Ratio, rule.Name)
+		}
+	}
+
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, "UpdateThrottlerConfig")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+	ki.ThrottlerConfig = updateThrottlerConfig(ki.ThrottlerConfig, req, time.Now())
+	if err = s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
+	if err = s.ts.UpdateSrvKeyspaceThrottlerConfig(ctx, req.Keyspace, nil, ki.ThrottlerConfig); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.UpdateThrottlerConfigResponse{
+		ThrottlerConfig: ki.ThrottlerConfig,
+	}, nil
+}
+
+// updateThrottlerConfig returns a copy of a throttler configuration with the
+// changes of a request applied, and without the expired throttled apps.
+func updateThrottlerConfig(config *topodatapb.ThrottlerConfig, req *vtctldatapb.UpdateThrottlerConfigRequest, now time.Time) *topodatapb.ThrottlerConfig {
+	if config == nil {
+		config = &topodatapb.ThrottlerConfig{}
+	} else {
+		config = proto.Clone(config).(*topodatapb.ThrottlerConfig)
+	}
+
+	switch {
+	case req.Threshold > 0:
+		config.Threshold = req.Threshold
+	case req.Threshold < 0:
+		config.Threshold = 0
+	}
+
+	if rule := req.ThrottledApp; rule != nil {
+		if config.ThrottledApps == nil {
+			config.ThrottledApps = make(map[string]*topodatapb.ThrottledAppRule)
+		}
+		config.ThrottledApps[rule.Name] = rule
+	}
+	for name, rule := range config.ThrottledApps {
+		if rule.Ratio <= 0 || (rule.ExpiresAt != nil && !protoutil.TimeFromProto(rule.ExpiresAt).After(now)) {
+			delete(config.ThrottledApps, name)
+		}
+	}
+
+	return config
+}
+
+// VDiffCreate is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffCreate(ctx context.Context, req *vtctldatapb.VDiffCreateRequest) (*vtctldatapb.VDiffCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffCreate")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	return s.ws.VDiffCreate(ctx, req)
+}
+
+// VDiffDelete is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffDelete(ctx context.Context, req *vtctldatapb.VDiffDeleteRequest) (*vtctldatapb.VDiffDeleteResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffDelete")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffDelete(ctx, req)
+}
+
+// VDiffResume is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffResume(ctx context.Context, req *vtctldatapb.VDiffResumeRequest) (*vtctldatapb.VDiffResumeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffResume")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffResume(ctx, req)
+}
+
+// VDiffShow is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffShow(ctx context.Context, req *vtctldatapb.VDiffShowRequest) (*vtctldatapb.VDiffShowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffShow")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffShow(ctx, req)
+}
+
+// VDiffStop is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffStop(ctx context.Context, req *vtctldatapb.VDiffStopRequest) (*vtctldatapb.VDiffStopResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffStop")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffStop(ctx, req)
+}
+
+// Validate is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) Validate(ctx context.Context, req *vtctldatapb.ValidateRequest) (*vtctldatapb.ValidateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.Validate")
+	defer span.Finish()
+
+	span.Annotate("ping_tablets", req.PingTablets)
+
+	resp := &vtctldatapb.ValidateResponse{
+		ResultsByKeyspace: map[string]*vtctldatapb.ValidateKeyspaceResponse{},
+	}
+
+	// Validate all tablets in all cells, even if they are not discoverable
+	// by the replication graph.
+	rec := &concurrency.AllErrorRecorder{}
+	s.validateAllTablets(ctx, rec)
+	resp.Results = append(resp.Results, rec.ErrorStrings()...)
+
+	// Validate the replication graph by traversing each keyspace and then
+	// each shard.
+	keyspaces, err := s.ts.GetKeyspaces(ctx)
+	if err != nil {
+		resp.Results = append(resp.Results, fmt.Sprintf("GetKeyspaces failed: %v", err))
+		return resp, nil
+	}
+
+	var (
+		m  sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for _, keyspace := range keyspaces {
+		wg.Add(1)
+		go func(keyspace string) {
+			defer wg.Done()
+
+			ksResp, err := s.ValidateKeyspace(ctx, &vtctldatapb.ValidateKeyspaceRequest{
+				Keyspace:    keyspace,
+				PingTablets: req.PingTablets,
+			})
+
+			m.Lock()
+			defer m.Unlock()
+
+			if err != nil {
+				resp.Results = append(resp.Results, fmt.Sprintf("ValidateKeyspace(%v) failed: %v", keyspace, err))
+				return
+			}
+
+			resp.Results = append(resp.Results, ksResp.Results...)
+			resp.ResultsByKeyspace[keyspace] = ksResp
+		}(keyspace)
+	}
+
+	wg.Wait()
+
+	return resp, nil
+}
+
+// ValidateKeyspace is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ValidateKeyspace(ctx context.Context, req *vtctldatapb.ValidateKeyspaceRequest) (*vtctldatapb.ValidateKeyspaceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ValidateKeyspace")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("ping_tablets", req.PingTablets)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ValidateKeyspace requires a keyspace")
+	}
+
+	resp := &vtctldatapb.ValidateKeyspaceResponse{
+		ResultsByShard: map[string]*vtctldatapb.ValidateShardResponse{},
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		resp.Results = append(resp.Results, fmt.Sprintf("TopologyServer.GetShardNames(%v) failed: %v", req.Keyspace, err))
+		return resp, nil
+	}
+
+	var (
+		m  sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard string) {
+			defer wg.Done()
+
+			shardResp, err := s.ValidateShard(ctx, &vtctldatapb.ValidateShardRequest{
+				Keyspace:    req.Keyspace,
+				Shard:       shard,
+				PingTablets: req.PingTablets,
+			})
+
+			m.Lock()
+			defer m.Unlock()
+
+			if err != nil {
+				resp.Results = append(resp.Results, fmt.Sprintf("ValidateShard(%v/%v) failed: %v", req.Keyspace, shard, err))
+				return
+			}
+
+			resp.Results = append(resp.Results, shardResp.Results...)
+			resp.ResultsByShard[shard] = shardResp
+		}(shard)
+	}
+
+	wg.Wait()
+
+	return resp, nil
+}
+
+// ValidateSchemaKeyspace is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ValidateSchemaKeyspace(ctx context.Context, req *vtctldatapb.ValidateSchemaKeyspaceRequest) (*vtctldatapb.ValidateSchemaKeyspaceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ValidateSchemaKeyspace")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("exclude_tables", strings.Join(req.ExcludeTables, ","))
+	span.Annotate("include_views", req.IncludeViews)
+	span.Annotate("skip_no_primary", req.SkipNoPrimary)
+	span.Annotate("include_vschema", req.IncludeVschema)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ValidateSchemaKeyspace requires a keyspace")
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetShardNames(%v) failed", req.Keyspace)
+	}
+
+	if len(shards) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no shards in keyspace %v", req.Keyspace)
+	}
+
+	sort.Strings(shards)
+
+	var vschema *vschemapb.Keyspace
+	if req.IncludeVschema {
+		vschema, err = s.ts.GetVSchema(ctx, req.Keyspace)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetVSchema(%v) failed", req.Keyspace)
+		}
+	}
+
+	resp := &vtctldatapb.ValidateSchemaKeyspaceResponse{
+		ResultsByShard: make(map[string]*vtctldatapb.ValidateShardResponse, len(shards)),
+	}
+
+	ref := &schemaReference{}
+	for _, shard := range shards {
+		rec := &concurrency.AllErrorRecorder{}
+		s.validateSchemaShard(ctx, req, shard, vschema, ref, rec)
+
+		shardResp := &vtctldatapb.ValidateShardResponse{
+			Results: rec.ErrorStrings(),
+		}
+
+		resp.Results = append(resp.Results, shardResp.Results...)
+		resp.ResultsByShard[shard] = shardResp
+	}
+
+	return resp, nil
+}
+
+// ValidateShard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ValidateShard(ctx context.Context, req *vtctldatapb.ValidateShardRequest) (*vtctldatapb.ValidateShardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ValidateShard")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("ping_tablets", req.PingTablets)
+
+	if req.Keyspace == "" || req.Shard == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ValidateShard requires a keyspace and shard; got %s/%s", req.Keyspace, req.Shard)
+	}
+
+	rec := &concurrency.AllErrorRecorder{}
+	s.validateShard(ctx, req.Keyspace, req.Shard, req.PingTablets, rec)
+
+	return &vtctldatapb.ValidateShardResponse{
+		Results: rec.ErrorStrings(),
+	}, nil
+}
+
+// ValidateVersionKeyspace is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ValidateVersionKeyspace(ctx context.Context, req *vtctldatapb.ValidateVersionKeyspaceRequest) (*vtctldatapb.ValidateVersionKeyspaceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ValidateVersionKeyspace")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ValidateVersionKeyspace requires a keyspace")
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetShardNames(%v) failed", req.Keyspace)
+	}
+
+	if len(shards) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no shards in keyspace %v", req.Keyspace)
+	}
+
+	sort.Strings(shards)
+
+	// Find the reference version using the first shard's primary.
+	si, err := s.ts.GetShard(ctx, req.Keyspace, shards[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if !si.HasMaster() {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no primary in shard %v/%v", req.Keyspace, shards[0])
+	}
+
+	referenceAlias := si.MasterAlias
+	referenceVersion, err := s.getTabletVersion(ctx, referenceAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vtctldatapb.ValidateVersionKeyspaceResponse{
+		ResultsByShard: make(map[string]*vtctldatapb.ValidateShardResponse, len(shards)),
+	}
+
+	// Then diff with all tablets but the reference primary.
+	for _, shard := range shards {
+		rec := &concurrency.AllErrorRecorder{}
+
+		aliases, err := s.ts.FindAllTabletAliasesInShard(ctx, req.Keyspace, shard)
+		if err != nil {
+			rec.RecordError(fmt.Errorf("FindAllTabletAliasesInShard(%v, %v) failed: %v", req.Keyspace, shard, err))
+		}
+
+		wg := sync.WaitGroup{}
+		for _, alias := range aliases {
+			if topoproto.TabletAliasEqual(alias, referenceAlias) {
+				continue
+			}
+
+			wg.Add(1)
+			go s.diffVersion(ctx, referenceVersion, referenceAlias, alias, &wg, rec)
+		}
+
+		wg.Wait()
+
+		shardResp := &vtctldatapb.ValidateShardResponse{
+			Results: rec.ErrorStrings(),
+		}
+
+		resp.Results = append(resp.Results, shardResp.Results...)
+		resp.ResultsByShard[shard] = shardResp
+	}
+
+	return resp, nil
+}
+
 // StartServer registers a VtctldServer for RPCs on the given gRPC server.
 func StartServer(s *grpc.Server, ts *topo.Server) {
 	vtctlservicepb.RegisterVtctldServer(s, NewVtctldServer(ts))
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/topo"
@@ -207,6 +208,132 @@ func TestAddCellsAlias(t *testing.T) {
 	}
 }
 
+func TestApplyRangeMaps(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rangeMaps := &vschemapb.RangeMaps{
+		RangeMaps: map[string]*vschemapb.RangeMap{
+			"tenants": {
+				Ranges: []*vschemapb.IdRange{{Start: 1, End: 100, KeyspaceId: "80"}},
+			},
+		},
+		Pending: []*vschemapb.PendingRangeMaps{{
+			Keyspace: "ks",
+			Workflow: "wf",
+			RangeMaps: map[string]*vschemapb.RangeMap{
+				"tenants": {
+					Ranges: []*vschemapb.IdRange{{Start: 1, End: 100, KeyspaceId: "c0"}},
+				},
+			},
+		}},
+	}
+	tests := []struct {
+		name               string
+		cells              []string
+		req                *vtctldatapb.ApplyRangeMapsRequest
+		expectedRangeMaps  *vschemapb.RangeMaps
+		expectedSrvVSchema *vschemapb.RangeMaps
+		topoDown           bool
+		shouldErr          bool
+	}{
+		{
+			name:  "success",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyRangeMapsRequest{
+				RangeMaps: rangeMaps,
+			},
+			expectedRangeMaps: rangeMaps,
+			expectedSrvVSchema: &vschemapb.RangeMaps{
+				RangeMaps: rangeMaps.RangeMaps,
+			},
+		},
+		{
+			name:  "invalid range map",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyRangeMapsRequest{
+				RangeMaps: &vschemapb.RangeMaps{
+					RangeMaps: map[string]*vschemapb.RangeMap{
+						"tenants": {
+							Ranges: []*vschemapb.IdRange{{Start: 100, End: 1, KeyspaceId: "80"}},
+						},
+					},
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name:  "pending range maps without workflow",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyRangeMapsRequest{
+				RangeMaps: &vschemapb.RangeMaps{
+					Pending: []*vschemapb.PendingRangeMaps{{Keyspace: "ks"}},
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name:  "rebuild failed (bad cell)",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyRangeMapsRequest{
+				RangeMaps:    rangeMaps,
+				RebuildCells: []string{"zone1", "zone2"},
+			},
+			shouldErr: true,
+		},
+		{
+			name:  "rebuild skipped",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyRangeMapsRequest{
+				RangeMaps:    rangeMaps,
+				SkipRebuild:  true,
+				RebuildCells: []string{"zone1", "zone2"},
+			},
+			expectedRangeMaps: rangeMaps,
+		},
+		{
+			name:      "topo down",
+			cells:     []string{"zone1"},
+			req:       &vtctldatapb.ApplyRangeMapsRequest{},
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory(tt.cells...)
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			_, err := vtctld.ApplyRangeMaps(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err, "ApplyRangeMaps(%+v) failed", tt.req)
+
+			rm, err := ts.GetRangeMaps(ctx)
+			require.NoError(t, err, "failed to get range maps from topo to compare")
+			utils.MustMatch(t, tt.expectedRangeMaps, rm)
+
+			if tt.expectedSrvVSchema != nil {
+				srvVSchema, err := ts.GetSrvVSchema(ctx, tt.cells[0])
+				require.NoError(t, err)
+				utils.MustMatch(t, tt.expectedSrvVSchema, srvVSchema.RangeMaps)
+			}
+		})
+	}
+}
+
 func TestApplyRoutingRules(t *testing.T) {
 	t.Parallel()
 
@@ -2677,6 +2804,178 @@ func TestEmergencyReparentShard(t *testing.T) {
 	}
 }
 
+func TestExecuteHook(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		ts        *topo.Server
+		tmc       tmclient.TabletManagerClient
+		tablets   []*topodatapb.Tablet
+		req       *vtctldatapb.ExecuteHookRequest
+		expected  *vtctldatapb.ExecuteHookResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			ts:   memorytopo.NewServer("zone1"),
+			tmc: &testutil.TabletManagerClient{
+				ExecuteHookResults: map[string]struct {
+					Response *hook.HookResult
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Response: &hook.HookResult{
+							ExitStatus: hook.HOOK_SUCCESS,
+							Stdout:     "hello\n",
+						},
+					},
+				},
+			},
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+				},
+			},
+			req: &vtctldatapb.ExecuteHookRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				TabletHookRequest: &tabletmanagerdatapb.ExecuteHookRequest{
+					Name:       "test.sh",
+					Parameters: []string{"--param1=value1"},
+				},
+			},
+			expected: &vtctldatapb.ExecuteHookResponse{
+				HookResult: &tabletmanagerdatapb.ExecuteHookResponse{
+					ExitStatus: hook.HOOK_SUCCESS,
+					Stdout:     "hello\n",
+				},
+			},
+		},
+		{
+			name: "nil tablet alias",
+			ts:   memorytopo.NewServer("zone1"),
+			tmc:  &testutil.TabletManagerClient{},
+			req: &vtctldatapb.ExecuteHookRequest{
+				TabletHookRequest: &tabletmanagerdatapb.ExecuteHookRequest{
+					Name: "test.sh",
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "nil hook request",
+			ts:   memorytopo.NewServer("zone1"),
+			tmc:  &testutil.TabletManagerClient{},
+			req: &vtctldatapb.ExecuteHookRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "hook name with slash",
+			ts:   memorytopo.NewServer("zone1"),
+			tmc:  &testutil.TabletManagerClient{},
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+				},
+			},
+			req: &vtctldatapb.ExecuteHookRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				TabletHookRequest: &tabletmanagerdatapb.ExecuteHookRequest{
+					Name: "../../bin/rm",
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "tablet not found",
+			ts:   memorytopo.NewServer("zone1"),
+			tmc:  &testutil.TabletManagerClient{},
+			req: &vtctldatapb.ExecuteHookRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  404,
+				},
+				TabletHookRequest: &tabletmanagerdatapb.ExecuteHookRequest{
+					Name: "test.sh",
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "tmc error",
+			ts:   memorytopo.NewServer("zone1"),
+			tmc: &testutil.TabletManagerClient{
+				ExecuteHookResults: map[string]struct {
+					Response *hook.HookResult
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+				},
+			},
+			req: &vtctldatapb.ExecuteHookRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				TabletHookRequest: &tabletmanagerdatapb.ExecuteHookRequest{
+					Name: "test.sh",
+				},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.AddTablets(ctx, t, tt.ts, nil, tt.tablets...)
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, tt.ts, tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			resp, err := vtctld.ExecuteHook(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestFindAllShardsInKeyspace(t *testing.T) {
 	t.Parallel()
 
@@ -3001,40 +3300,33 @@ func TestGetKeyspaces(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestGetRoutingRules(t *testing.T) {
+func TestGetRangeMaps(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
+	rangeMaps := &vschemapb.RangeMaps{
+		RangeMaps: map[string]*vschemapb.RangeMap{
+			"tenants": {
+				Ranges: []*vschemapb.IdRange{{Start: 1, End: 100, KeyspaceId: "80"}},
+			},
+		},
+	}
 	tests := []struct {
 		name      string
 		topoDown  bool
-		rrIn      *vschemapb.RoutingRules
-		expected  *vschemapb.RoutingRules
+		rmIn      *vschemapb.RangeMaps
+		expected  *vschemapb.RangeMaps
 		shouldErr bool
 	}{
 		{
-			name: "success",
-			rrIn: &vschemapb.RoutingRules{
-				Rules: []*vschemapb.RoutingRule{
-					{
-						FromTable: "t1",
-						ToTables:  []string{"t2", "t3"},
-					},
-				},
-			},
-			expected: &vschemapb.RoutingRules{
-				Rules: []*vschemapb.RoutingRule{
-					{
-						FromTable: "t1",
-						ToTables:  []string{"t2", "t3"},
-					},
-				},
-			},
+			name:     "success",
+			rmIn:     rangeMaps,
+			expected: rangeMaps,
 		},
 		{
-			name:     "empty routing rules",
-			rrIn:     nil,
-			expected: &vschemapb.RoutingRules{},
+			name:     "empty range maps",
+			rmIn:     nil,
+			expected: &vschemapb.RangeMaps{},
 		},
 		{
 			name:      "topo error",
@@ -3049,9 +3341,9 @@ func TestGetRoutingRules(t *testing.T) {
 			t.Parallel()
 
 			ts, factory := memorytopo.NewServerAndFactory()
-			if tt.rrIn != nil {
-				err := ts.SaveRoutingRules(ctx, tt.rrIn)
-				require.NoError(t, err, "could not save routing rules: %+v", tt.rrIn)
+			if tt.rmIn != nil {
+				err := ts.SaveRangeMaps(ctx, tt.rmIn)
+				require.NoError(t, err, "could not save range maps: %+v", tt.rmIn)
 			}
 
 			if tt.topoDown {
@@ -3061,30 +3353,102 @@ func TestGetRoutingRules(t *testing.T) {
 			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
 				return NewVtctldServer(ts)
 			})
-			resp, err := vtctld.GetRoutingRules(ctx, &vtctldatapb.GetRoutingRulesRequest{})
+			resp, err := vtctld.GetRangeMaps(ctx, &vtctldatapb.GetRangeMapsRequest{})
 			if tt.shouldErr {
 				assert.Error(t, err)
 				return
 			}
 
 			require.NoError(t, err)
-			utils.MustMatch(t, resp.RoutingRules, tt.expected)
+			utils.MustMatch(t, tt.expected, resp.RangeMaps)
 		})
 	}
 }
 
-func TestGetSchema(t *testing.T) {
-	ctx := context.Background()
-	ts := memorytopo.NewServer("zone1")
-	tmc := testutil.TabletManagerClient{
-		GetSchemaResults: map[string]struct {
-			Schema *tabletmanagerdatapb.SchemaDefinition
-			Error  error
-		}{},
-	}
-	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
-		return NewVtctldServer(ts)
-	})
+func TestGetRoutingRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		topoDown  bool
+		rrIn      *vschemapb.RoutingRules
+		expected  *vschemapb.RoutingRules
+		shouldErr bool
+	}{
+		{
+			name: "success",
+			rrIn: &vschemapb.RoutingRules{
+				Rules: []*vschemapb.RoutingRule{
+					{
+						FromTable: "t1",
+						ToTables:  []string{"t2", "t3"},
+					},
+				},
+			},
+			expected: &vschemapb.RoutingRules{
+				Rules: []*vschemapb.RoutingRule{
+					{
+						FromTable: "t1",
+						ToTables:  []string{"t2", "t3"},
+					},
+				},
+			},
+		},
+		{
+			name:     "empty routing rules",
+			rrIn:     nil,
+			expected: &vschemapb.RoutingRules{},
+		},
+		{
+			name:      "topo error",
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory()
+			if tt.rrIn != nil {
+				err := ts.SaveRoutingRules(ctx, tt.rrIn)
+				require.NoError(t, err, "could not save routing rules: %+v", tt.rrIn)
+			}
+
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetRoutingRules(ctx, &vtctldatapb.GetRoutingRulesRequest{})
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, resp.RoutingRules, tt.expected)
+		})
+	}
+}
+
+func TestGetSchema(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	tmc := testutil.TabletManagerClient{
+		GetSchemaResults: map[string]struct {
+			Schema *tabletmanagerdatapb.SchemaDefinition
+			Error  error
+		}{},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
 
 	validAlias := &topodatapb.TabletAlias{
 		Cell: "zone1",
@@ -4356,6 +4720,122 @@ func TestGetVSchema(t *testing.T) {
 	})
 }
 
+// fakeVReplicationWorkflowRunner logs the action of each request, and returns
+// the given error, if any.
+type fakeVReplicationWorkflowRunner struct {
+	logger logutil.Logger
+	err    error
+}
+
+func (runner *fakeVReplicationWorkflowRunner) MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error) {
+	if runner.err != nil {
+		return nil, runner.err
+	}
+
+	runner.logger.Infof("MoveTables %s %s.%s", req.Action, req.TargetKeyspace, req.Workflow)
+	return &vtctldatapb.MoveTablesResponse{State: "Reads Not Switched. Writes Not Switched"}, nil
+}
+
+func (runner *fakeVReplicationWorkflowRunner) Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error) {
+	if runner.err != nil {
+		return nil, runner.err
+	}
+
+	runner.logger.Infof("Reshard %s %s.%s", req.Action, req.Keyspace, req.Workflow)
+	return &vtctldatapb.ReshardResponse{State: "Reads Not Switched. Writes Not Switched"}, nil
+}
+
+// registerFakeVReplicationWorkflowRunner registers a fake runner, or none if
+// register is false, and returns a func that restores the previous one.
+func registerFakeVReplicationWorkflowRunner(register bool, err error) func() {
+	previous := vreplicationWorkflowRunnerFactory
+	vreplicationWorkflowRunnerFactory = nil
+	if register {
+		RegisterVReplicationWorkflowRunner(func(ts *topo.Server, tmc tmclient.TabletManagerClient, logger logutil.Logger) VReplicationWorkflowRunner {
+			return &fakeVReplicationWorkflowRunner{logger: logger, err: err}
+		})
+	}
+
+	return func() { vreplicationWorkflowRunnerFactory = previous }
+}
+
+func TestMoveTables(t *testing.T) {
+	// Not parallel, since the runner factory is global.
+	ctx := context.Background()
+	tests := []struct {
+		name           string
+		req            *vtctldatapb.MoveTablesRequest
+		noRunner       bool
+		runnerErr      error
+		expectedState  string
+		expectedEvents int
+		shouldErr      bool
+	}{
+		{
+			name: "ok",
+			req: &vtctldatapb.MoveTablesRequest{
+				Action:         vtctldatapb.VReplicationWorkflowAction_SWITCH_TRAFFIC,
+				TargetKeyspace: "target",
+				Workflow:       "wf",
+			},
+			expectedState:  "Reads Not Switched. Writes Not Switched",
+			expectedEvents: 1,
+		},
+		{
+			name: "missing target keyspace",
+			req: &vtctldatapb.MoveTablesRequest{
+				Workflow: "wf",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "missing workflow",
+			req: &vtctldatapb.MoveTablesRequest{
+				TargetKeyspace: "target",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no runner registered",
+			req: &vtctldatapb.MoveTablesRequest{
+				TargetKeyspace: "target",
+				Workflow:       "wf",
+			},
+			noRunner:  true,
+			shouldErr: true,
+		},
+		{
+			name: "runner error",
+			req: &vtctldatapb.MoveTablesRequest{
+				TargetKeyspace: "target",
+				Workflow:       "wf",
+			},
+			runnerErr: assert.AnError,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			defer registerFakeVReplicationWorkflowRunner(!tt.noRunner, tt.runnerErr)()
+
+			ts := memorytopo.NewServer("zone1")
+			vtctld := NewVtctldServer(ts)
+			resp, err := vtctld.MoveTables(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedState, resp.State)
+			assert.Len(t, resp.Events, tt.expectedEvents)
+		})
+	}
+}
+
 func TestPlannedReparentShard(t *testing.T) {
 	t.Parallel()
 
@@ -4874,120 +5354,330 @@ func TestRefreshStateByShard(t *testing.T) {
 	}
 }
 
-func TestRemoveKeyspaceCell(t *testing.T) {
+func TestReloadSchema(t *testing.T) {
 	t.Parallel()
 
+	ctx := context.Background()
 	tests := []struct {
-		name                    string
-		keyspace                *vtctldatapb.Keyspace
-		shards                  []*vtctldatapb.Shard
-		topoError               error
-		topoIsLocked            bool
-		srvKeyspaceDoesNotExist bool
-		req                     *vtctldatapb.RemoveKeyspaceCellRequest
-		expected                *vtctldatapb.RemoveKeyspaceCellResponse
-		shouldErr               bool
+		name      string
+		tablet    *topodatapb.Tablet
+		tmcErr    error
+		req       *vtctldatapb.ReloadSchemaRequest
+		shouldErr bool
 	}{
 		{
-			name:     "success",
-			keyspace: nil,
-			shards: []*vtctldatapb.Shard{
-				{
-					Keyspace: "testkeyspace",
-					Name:     "-",
+			name: "ok",
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
 				},
 			},
-			topoError:               nil,
-			topoIsLocked:            false,
-			srvKeyspaceDoesNotExist: false,
-			req: &vtctldatapb.RemoveKeyspaceCellRequest{
-				Keyspace: "testkeyspace",
-				Cell:     "zone1",
-			},
-			expected:  &vtctldatapb.RemoveKeyspaceCellResponse{},
-			shouldErr: false,
-		},
-		{
-			name: "success/empty keyspace",
-			keyspace: &vtctldatapb.Keyspace{
-				Name:     "testkeyspace",
-				Keyspace: &topodatapb.Keyspace{},
-			},
-			shards:                  nil,
-			topoError:               nil,
-			topoIsLocked:            false,
-			srvKeyspaceDoesNotExist: false,
-			req: &vtctldatapb.RemoveKeyspaceCellRequest{
-				Keyspace: "testkeyspace",
-				Cell:     "zone1",
+			req: &vtctldatapb.ReloadSchemaRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
 			},
-			expected:  &vtctldatapb.RemoveKeyspaceCellResponse{},
-			shouldErr: false,
 		},
 		{
-			name: "keyspace not found",
-			keyspace: &vtctldatapb.Keyspace{
-				Name:     "otherkeyspace",
-				Keyspace: &topodatapb.Keyspace{},
-			},
-			shards:                  nil,
-			topoError:               nil,
-			topoIsLocked:            false,
-			srvKeyspaceDoesNotExist: false,
-			req: &vtctldatapb.RemoveKeyspaceCellRequest{
-				Keyspace: "testkeyspace",
-				Cell:     "zone1",
-			},
-			expected:  nil,
+			name:      "nil tablet alias",
+			req:       &vtctldatapb.ReloadSchemaRequest{},
 			shouldErr: true,
 		},
 		{
-			name:     "topo is down",
-			keyspace: nil,
-			shards: []*vtctldatapb.Shard{
-				{
-					Keyspace: "testkeyspace",
-					Name:     "-",
+			name: "tablet not found",
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
 				},
 			},
-			topoError:               assert.AnError,
-			topoIsLocked:            false,
-			srvKeyspaceDoesNotExist: false,
-			req: &vtctldatapb.RemoveKeyspaceCellRequest{
-				Keyspace: "testkeyspace",
-				Cell:     "zone1",
+			req: &vtctldatapb.ReloadSchemaRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  404,
+				},
 			},
-			expected:  nil,
 			shouldErr: true,
 		},
 		{
-			name:     "topo is locked",
-			keyspace: nil,
-			shards: []*vtctldatapb.Shard{
-				{
-					Keyspace: "testkeyspace",
-					Name:     "-",
+			name: "tmc error",
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
 				},
 			},
-			topoError:               nil,
-			topoIsLocked:            true,
-			srvKeyspaceDoesNotExist: false,
-			req: &vtctldatapb.RemoveKeyspaceCellRequest{
-				Keyspace: "testkeyspace",
-				Cell:     "zone1",
+			tmcErr: assert.AnError,
+			req: &vtctldatapb.ReloadSchemaRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
 			},
-			expected:  nil,
 			shouldErr: true,
 		},
-		{
-			name:     "srvkeyspace already deleted",
-			keyspace: nil,
-			shards: []*vtctldatapb.Shard{
-				{
-					Keyspace: "testkeyspace",
-					Name:     "-",
-				},
-			},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			tmc := &testutil.TabletManagerClient{}
+			if tt.tablet != nil {
+				testutil.AddTablet(ctx, t, ts, tt.tablet, nil)
+				tmc.ReloadSchemaResults = map[string]error{
+					topoproto.TabletAliasString(tt.tablet.Alias): tt.tmcErr,
+				}
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			_, err := vtctld.ReloadSchema(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestReloadSchemaShard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_MASTER,
+		},
+		{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+		{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  300,
+			},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_RDONLY,
+		},
+	}
+
+	tests := []struct {
+		name               string
+		reloadSchemaErrors map[string]error
+		req                *vtctldatapb.ReloadSchemaShardRequest
+		expectedEventCount int
+	}{
+		{
+			name: "ok",
+			reloadSchemaErrors: map[string]error{
+				"zone1-0000000200": nil,
+				"zone1-0000000300": nil,
+			},
+			req: &vtctldatapb.ReloadSchemaShardRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expectedEventCount: 0,
+		},
+		{
+			name: "include primary",
+			reloadSchemaErrors: map[string]error{
+				"zone1-0000000100": nil,
+				"zone1-0000000200": nil,
+				"zone1-0000000300": nil,
+			},
+			req: &vtctldatapb.ReloadSchemaShardRequest{
+				Keyspace:       "testkeyspace",
+				Shard:          "-",
+				IncludePrimary: true,
+				Concurrency:    1,
+			},
+			expectedEventCount: 0,
+		},
+		{
+			name: "partial failure",
+			reloadSchemaErrors: map[string]error{
+				"zone1-0000000200": nil,
+				"zone1-0000000300": assert.AnError,
+			},
+			req: &vtctldatapb.ReloadSchemaShardRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expectedEventCount: 1,
+		},
+		{
+			name: "shard not found",
+			req: &vtctldatapb.ReloadSchemaShardRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "80-",
+			},
+			expectedEventCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardMaster: true,
+			}, tablets...)
+
+			tmc := &testutil.TabletManagerClient{
+				ReloadSchemaResults: tt.reloadSchemaErrors,
+			}
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			resp, err := vtctld.ReloadSchemaShard(ctx, tt.req)
+			require.NoError(t, err)
+			assert.Len(t, resp.Events, tt.expectedEventCount, "unexpected events: %+v", resp.Events)
+		})
+	}
+}
+
+func TestRemoveKeyspaceCell(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		keyspace                *vtctldatapb.Keyspace
+		shards                  []*vtctldatapb.Shard
+		topoError               error
+		topoIsLocked            bool
+		srvKeyspaceDoesNotExist bool
+		req                     *vtctldatapb.RemoveKeyspaceCellRequest
+		expected                *vtctldatapb.RemoveKeyspaceCellResponse
+		shouldErr               bool
+	}{
+		{
+			name:     "success",
+			keyspace: nil,
+			shards: []*vtctldatapb.Shard{
+				{
+					Keyspace: "testkeyspace",
+					Name:     "-",
+				},
+			},
+			topoError:               nil,
+			topoIsLocked:            false,
+			srvKeyspaceDoesNotExist: false,
+			req: &vtctldatapb.RemoveKeyspaceCellRequest{
+				Keyspace: "testkeyspace",
+				Cell:     "zone1",
+			},
+			expected:  &vtctldatapb.RemoveKeyspaceCellResponse{},
+			shouldErr: false,
+		},
+		{
+			name: "success/empty keyspace",
+			keyspace: &vtctldatapb.Keyspace{
+				Name:     "testkeyspace",
+				Keyspace: &topodatapb.Keyspace{},
+			},
+			shards:                  nil,
+			topoError:               nil,
+			topoIsLocked:            false,
+			srvKeyspaceDoesNotExist: false,
+			req: &vtctldatapb.RemoveKeyspaceCellRequest{
+				Keyspace: "testkeyspace",
+				Cell:     "zone1",
+			},
+			expected:  &vtctldatapb.RemoveKeyspaceCellResponse{},
+			shouldErr: false,
+		},
+		{
+			name: "keyspace not found",
+			keyspace: &vtctldatapb.Keyspace{
+				Name:     "otherkeyspace",
+				Keyspace: &topodatapb.Keyspace{},
+			},
+			shards:                  nil,
+			topoError:               nil,
+			topoIsLocked:            false,
+			srvKeyspaceDoesNotExist: false,
+			req: &vtctldatapb.RemoveKeyspaceCellRequest{
+				Keyspace: "testkeyspace",
+				Cell:     "zone1",
+			},
+			expected:  nil,
+			shouldErr: true,
+		},
+		{
+			name:     "topo is down",
+			keyspace: nil,
+			shards: []*vtctldatapb.Shard{
+				{
+					Keyspace: "testkeyspace",
+					Name:     "-",
+				},
+			},
+			topoError:               assert.AnError,
+			topoIsLocked:            false,
+			srvKeyspaceDoesNotExist: false,
+			req: &vtctldatapb.RemoveKeyspaceCellRequest{
+				Keyspace: "testkeyspace",
+				Cell:     "zone1",
+			},
+			expected:  nil,
+			shouldErr: true,
+		},
+		{
+			name:     "topo is locked",
+			keyspace: nil,
+			shards: []*vtctldatapb.Shard{
+				{
+					Keyspace: "testkeyspace",
+					Name:     "-",
+				},
+			},
+			topoError:               nil,
+			topoIsLocked:            true,
+			srvKeyspaceDoesNotExist: false,
+			req: &vtctldatapb.RemoveKeyspaceCellRequest{
+				Keyspace: "testkeyspace",
+				Cell:     "zone1",
+			},
+			expected:  nil,
+			shouldErr: true,
+		},
+		{
+			name:     "srvkeyspace already deleted",
+			keyspace: nil,
+			shards: []*vtctldatapb.Shard{
+				{
+					Keyspace: "testkeyspace",
+					Name:     "-",
+				},
+			},
 			topoError:               nil,
 			topoIsLocked:            false,
 			srvKeyspaceDoesNotExist: true,
@@ -5916,17 +6606,179 @@ func TestReparentTablet(t *testing.T) {
 			}, tt.tablets...)
 			testutil.AddShards(ctx, t, ts, tt.shards...)
 
-			if tt.topoErr != nil {
-				topofactory.SetError(tt.topoErr)
-			}
+			if tt.topoErr != nil {
+				topofactory.SetError(tt.topoErr)
+			}
+
+			resp, err := vtctld.ReparentTablet(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestReshard(t *testing.T) {
+	// Not parallel, since the runner factory is global.
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.ReshardRequest
+		noRunner  bool
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			req: &vtctldatapb.ReshardRequest{
+				Action:       vtctldatapb.VReplicationWorkflowAction_CREATE,
+				Keyspace:     "ks",
+				Workflow:     "wf",
+				SourceShards: []string{"-"},
+				TargetShards: []string{"-80", "80-"},
+			},
+		},
+		{
+			name: "missing keyspace",
+			req: &vtctldatapb.ReshardRequest{
+				Workflow: "wf",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "missing workflow",
+			req: &vtctldatapb.ReshardRequest{
+				Keyspace: "ks",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no runner registered",
+			req: &vtctldatapb.ReshardRequest{
+				Keyspace: "ks",
+				Workflow: "wf",
+			},
+			noRunner:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			defer registerFakeVReplicationWorkflowRunner(!tt.noRunner, nil)()
+
+			ts := memorytopo.NewServer("zone1")
+			vtctld := NewVtctldServer(ts)
+			resp, err := vtctld.Reshard(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Events, 1)
+			assert.Equal(t, "Reshard CREATE ks.wf", resp.Events[0].Value)
+		})
+	}
+}
+
+func TestSetShardTabletControl(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.SetShardTabletControlRequest
+		expected  *vtctldatapb.SetShardTabletControlResponse
+		shouldErr bool
+	}{
+		{
+			name: "blacklist tables",
+			req: &vtctldatapb.SetShardTabletControlRequest{
+				Keyspace:          "testkeyspace",
+				Shard:             "-",
+				TabletType:        topodatapb.TabletType_REPLICA,
+				Cells:             []string{"zone1"},
+				BlacklistedTables: []string{"t1", "t2"},
+			},
+			expected: &vtctldatapb.SetShardTabletControlResponse{
+				Shard: &topodatapb.Shard{
+					KeyRange:        &topodatapb.KeyRange{},
+					IsMasterServing: true,
+					TabletControls: []*topodatapb.Shard_TabletControl{
+						{
+							TabletType:        topodatapb.TabletType_REPLICA,
+							Cells:             []string{"zone1"},
+							BlacklistedTables: []string{"t1", "t2"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "remove tablet control",
+			req: &vtctldatapb.SetShardTabletControlRequest{
+				Keyspace:   "testkeyspace",
+				Shard:      "-",
+				TabletType: topodatapb.TabletType_RDONLY,
+				Cells:      []string{"zone1"},
+				Remove:     true,
+			},
+			expected: &vtctldatapb.SetShardTabletControlResponse{
+				Shard: &topodatapb.Shard{
+					KeyRange:        &topodatapb.KeyRange{},
+					IsMasterServing: true,
+				},
+			},
+		},
+		{
+			name: "non-serving tablet type",
+			req: &vtctldatapb.SetShardTabletControlRequest{
+				Keyspace:   "testkeyspace",
+				Shard:      "-",
+				TabletType: topodatapb.TabletType_BACKUP,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "keyspace not found",
+			req: &vtctldatapb.SetShardTabletControlRequest{
+				Keyspace:   "otherkeyspace",
+				Shard:      "-",
+				TabletType: topodatapb.TabletType_REPLICA,
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{
+				Keyspace: "testkeyspace",
+				Name:     "-",
+			})
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
 
-			resp, err := vtctld.ReparentTablet(ctx, tt.req)
+			resp, err := vtctld.SetShardTabletControl(ctx, tt.req)
 			if tt.shouldErr {
 				assert.Error(t, err)
 				return
 			}
 
-			assert.NoError(t, err)
+			require.NoError(t, err)
 			utils.MustMatch(t, tt.expected, resp)
 		})
 	}
@@ -6205,6 +7057,117 @@ func TestShardReplicationPositions(t *testing.T) {
 	}
 }
 
+func TestSleepTablet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		tablet    *topodatapb.Tablet
+		tmcErr    error
+		req       *vtctldatapb.SleepTabletRequest
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			req: &vtctldatapb.SleepTabletRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				Duration: protoutil.DurationToProto(time.Millisecond),
+			},
+		},
+		{
+			name: "nil tablet alias",
+			req: &vtctldatapb.SleepTabletRequest{
+				Duration: protoutil.DurationToProto(time.Millisecond),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "missing duration",
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			req: &vtctldatapb.SleepTabletRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "tablet not found",
+			req: &vtctldatapb.SleepTabletRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  404,
+				},
+				Duration: protoutil.DurationToProto(time.Millisecond),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "tmc error",
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			tmcErr: assert.AnError,
+			req: &vtctldatapb.SleepTabletRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				Duration: protoutil.DurationToProto(time.Millisecond),
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			tmc := &testutil.TabletManagerClient{}
+			if tt.tablet != nil {
+				testutil.AddTablet(ctx, t, ts, tt.tablet, nil)
+				tmc.SleepResults = map[string]error{
+					topoproto.TabletAliasString(tt.tablet.Alias): tt.tmcErr,
+				}
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			_, err := vtctld.SleepTablet(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestTabletExternallyReparented(t *testing.T) {
 	t.Parallel()
 
@@ -6921,3 +7884,246 @@ func TestUpdateCellsAlias(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateThrottlerConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1", "zone2")
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{},
+	})
+	// zone2 has no SrvKeyspace, it is skipped.
+	testutil.AddSrvKeyspaces(t, ts, &testutil.SrvKeyspace{
+		Keyspace:    "testkeyspace",
+		Cell:        "zone1",
+		SrvKeyspace: &topodatapb.SrvKeyspace{ShardingColumnName: "col"},
+	})
+
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	future := protoutil.TimeToProto(time.Now().Add(time.Hour))
+	past := protoutil.TimeToProto(time.Now().Add(-time.Hour))
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.UpdateThrottlerConfigRequest
+		expected  *topodatapb.ThrottlerConfig
+		shouldErr bool
+	}{
+		{
+			name: "throttle app",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:     "testkeyspace",
+				ThrottledApp: &topodatapb.ThrottledAppRule{Name: "online-ddl", Ratio: 0.5, ExpiresAt: future},
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+					"online-ddl": {Name: "online-ddl", Ratio: 0.5, ExpiresAt: future},
+				},
+			},
+		},
+		{
+			name: "set threshold, expired app",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:     "testkeyspace",
+				Threshold:    2,
+				ThrottledApp: &topodatapb.ThrottledAppRule{Name: "vreplication", Ratio: 1, ExpiresAt: past},
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				Threshold: 2,
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+					"online-ddl": {Name: "online-ddl", Ratio: 0.5, ExpiresAt: future},
+				},
+			},
+		},
+		{
+			name: "unthrottle app",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:     "testkeyspace",
+				ThrottledApp: &topodatapb.ThrottledAppRule{Name: "online-ddl"},
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				Threshold:     2,
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{},
+			},
+		},
+		{
+			name: "reset threshold",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:  "testkeyspace",
+				Threshold: -1,
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{},
+			},
+		},
+		{
+			name: "invalid ratio",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:     "testkeyspace",
+				ThrottledApp: &topodatapb.ThrottledAppRule{Name: "online-ddl", Ratio: 2},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "keyspace not found",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:  "otherkeyspace",
+				Threshold: 2,
+			},
+			shouldErr: true,
+		},
+	}
+
+	// The requests build on each other, they are not run in parallel.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := vtctld.UpdateThrottlerConfig(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp.ThrottlerConfig)
+
+			ki, err := ts.GetKeyspace(ctx, "testkeyspace")
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, ki.ThrottlerConfig)
+
+			srvKeyspace, err := ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, srvKeyspace.ThrottlerConfig)
+			assert.Equal(t, "col", srvKeyspace.ShardingColumnName)
+
+			_, err = ts.GetSrvKeyspace(ctx, "zone2", "testkeyspace")
+			assert.True(t, topo.IsErrType(err, topo.NoNode), "zone2 SrvKeyspace: %v", err)
+		})
+	}
+}
+
+func TestValidateShard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name            string
+		tablets         []*topodatapb.Tablet
+		setShardPrimary bool
+		req             *vtctldatapb.ValidateShardRequest
+		expectedResults int
+		shouldErr       bool
+	}{
+		{
+			name: "consistent shard",
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_MASTER,
+				},
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  200,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
+				},
+			},
+			setShardPrimary: true,
+			req: &vtctldatapb.ValidateShardRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expectedResults: 0,
+		},
+		{
+			name: "primary not in shard record",
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_MASTER,
+				},
+			},
+			setShardPrimary: false,
+			req: &vtctldatapb.ValidateShardRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expectedResults: 1,
+		},
+		{
+			name: "no primary",
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  200,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
+				},
+			},
+			req: &vtctldatapb.ValidateShardRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expectedResults: 1,
+		},
+		{
+			name: "shard not found",
+			req: &vtctldatapb.ValidateShardRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expectedResults: 1,
+		},
+		{
+			name:      "missing shard name",
+			req:       &vtctldatapb.ValidateShardRequest{Keyspace: "testkeyspace"},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardMaster: tt.setShardPrimary,
+			}, tt.tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			resp, err := vtctld.ValidateShard(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, resp.Results, tt.expectedResults, "unexpected results: %v", resp.Results)
+		})
+	}
+}
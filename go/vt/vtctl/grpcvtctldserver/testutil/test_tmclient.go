@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -135,6 +136,11 @@ type TabletManagerClient struct {
 		Error  error
 	}
 	// keyed by tablet alias.
+	ExecuteHookResults map[string]struct {
+		Response *hook.HookResult
+		Error    error
+	}
+	// keyed by tablet alias.
 	GetSchemaDelays map[string]time.Duration
 	// keyed by tablet alias.
 	GetSchemaResults map[string]struct {
@@ -163,7 +169,12 @@ type TabletManagerClient struct {
 		Error  error
 	}
 	// keyed by tablet alias.
-	RefreshStateResults      map[string]error
+	RefreshStateResults map[string]error
+	// keyed by tablet alias.
+	ReloadSchemaDelays map[string]time.Duration
+	// keyed by tablet alias.
+	ReloadSchemaResults map[string]error
+	// keyed by tablet alias.
 	ReplicationStatusDelays  map[string]time.Duration
 	ReplicationStatusResults map[string]struct {
 		Position *replicationdatapb.Status
@@ -178,6 +189,8 @@ type TabletManagerClient struct {
 	// keyed by tablet alias.
 	SetReadWriteResults map[string]error
 	// keyed by tablet alias.
+	SleepResults map[string]error
+	// keyed by tablet alias.
 	StopReplicationAndGetStatusDelays map[string]time.Duration
 	// keyed by tablet alias.
 	StopReplicationAndGetStatusResults map[string]struct {
@@ -246,6 +259,20 @@ func (fake *TabletManagerClient) DemoteMaster(ctx context.Context, tablet *topod
 	return nil, assert.AnError
 }
 
+// ExecuteHook is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (*hook.HookResult, error) {
+	if fake.ExecuteHookResults == nil {
+		return nil, fmt.Errorf("%w: no ExecuteHook results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.ExecuteHookResults[key]; ok {
+		return result.Response, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no ExecuteHook result set for tablet %s", assert.AnError, key)
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tablets []string, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	if fake.GetSchemaResults == nil {
@@ -382,6 +409,32 @@ func (fake *TabletManagerClient) RefreshState(ctx context.Context, tablet *topod
 	return fmt.Errorf("%w: no RefreshState result set for tablet %s", assert.AnError, key)
 }
 
+// ReloadSchema is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
+	if fake.ReloadSchemaResults == nil {
+		return fmt.Errorf("%w: no ReloadSchema results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+
+	if fake.ReloadSchemaDelays != nil {
+		if delay, ok := fake.ReloadSchemaDelays[key]; ok {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+				// proceed to results
+			}
+		}
+	}
+
+	if err, ok := fake.ReloadSchemaResults[key]; ok {
+		return err
+	}
+
+	return fmt.Errorf("%w: no ReloadSchema result set for tablet %s", assert.AnError, key)
+}
+
 // ReplicationStatus is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) ReplicationStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
 	if fake.ReplicationStatusResults == nil {
@@ -464,6 +517,20 @@ func (fake *TabletManagerClient) SetReadWrite(ctx context.Context, tablet *topod
 	return assert.AnError
 }
 
+// Sleep is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error {
+	if fake.SleepResults == nil {
+		return fmt.Errorf("%w: no Sleep results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if err, ok := fake.SleepResults[key]; ok {
+		return err
+	}
+
+	return fmt.Errorf("%w: no Sleep result set for tablet %s", assert.AnError, key)
+}
+
 // StopReplicationAndGetStatus is part of the tmclient.TabletManagerClient
 // interface.
 func (fake *TabletManagerClient) StopReplicationAndGetStatus(ctx context.Context, tablet *topodatapb.Tablet, mode replicationdatapb.StopReplicationMode) (*replicationdatapb.Status, *replicationdatapb.StopReplicationStatus, error) {
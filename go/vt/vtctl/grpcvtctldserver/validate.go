@@ -0,0 +1,396 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// The helpers in this file back the Validate* rpcs. Unlike most rpcs, those
+// report the inconsistencies they find as results rather than as an error, so
+// each helper records into a concurrency.ErrorRecorder and keeps going.
+
+// validateAllTablets validates all tablets in all discoverable cells, even if
+// they are not in the replication graph.
+func (s *VtctldServer) validateAllTablets(ctx context.Context, rec concurrency.ErrorRecorder) {
+	cellSet := make(map[string]bool, 16)
+
+	keyspaces, err := s.ts.GetKeyspaces(ctx)
+	if err != nil {
+		rec.RecordError(fmt.Errorf("TopologyServer.GetKeyspaces failed: %v", err))
+		return
+	}
+
+	for _, keyspace := range keyspaces {
+		shards, err := s.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			rec.RecordError(fmt.Errorf("TopologyServer.GetShardNames(%v) failed: %v", keyspace, err))
+			return
+		}
+
+		for _, shard := range shards {
+			aliases, err := s.ts.FindAllTabletAliasesInShard(ctx, keyspace, shard)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("TopologyServer.FindAllTabletAliasesInShard(%v, %v) failed: %v", keyspace, shard, err))
+				return
+			}
+
+			for _, alias := range aliases {
+				cellSet[alias.Cell] = true
+			}
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	for cell := range cellSet {
+		aliases, err := s.ts.GetTabletsByCell(ctx, cell)
+		if err != nil {
+			rec.RecordError(fmt.Errorf("TopologyServer.GetTabletsByCell(%v) failed: %v", cell, err))
+			continue
+		}
+
+		for _, alias := range aliases {
+			wg.Add(1)
+			go func(alias *topodatapb.TabletAlias) {
+				defer wg.Done()
+
+				if err := topo.Validate(ctx, s.ts, alias); err != nil {
+					rec.RecordError(fmt.Errorf("topo.Validate(%v) failed: %v", topoproto.TabletAliasString(alias), err))
+				}
+			}(alias)
+		}
+	}
+
+	wg.Wait()
+}
+
+// validateShard validates the shard record and every tablet in the shard. If
+// pingTablets is set, it also checks replication and pings each tablet.
+func (s *VtctldServer) validateShard(ctx context.Context, keyspace, shard string, pingTablets bool, rec concurrency.ErrorRecorder) {
+	si, err := s.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		rec.RecordError(fmt.Errorf("TopologyServer.GetShard(%v, %v) failed: %v", keyspace, shard, err))
+		return
+	}
+
+	aliases, err := s.ts.FindAllTabletAliasesInShard(ctx, keyspace, shard)
+	if err != nil {
+		rec.RecordError(fmt.Errorf("TopologyServer.FindAllTabletAliasesInShard(%v, %v) failed: %v", keyspace, shard, err))
+		return
+	}
+
+	tabletMap, _ := s.ts.GetTabletMap(ctx, aliases)
+
+	var primaryAlias *topodatapb.TabletAlias
+	for _, alias := range aliases {
+		ti, ok := tabletMap[topoproto.TabletAliasString(alias)]
+		if !ok {
+			rec.RecordError(fmt.Errorf("tablet %v not found in map", topoproto.TabletAliasString(alias)))
+			continue
+		}
+
+		if ti.Type == topodatapb.TabletType_MASTER {
+			if primaryAlias != nil {
+				rec.RecordError(fmt.Errorf("shard %v/%v already has primary %v but found other primary %v", keyspace, shard, topoproto.TabletAliasString(primaryAlias), topoproto.TabletAliasString(alias)))
+			} else {
+				primaryAlias = alias
+			}
+		}
+	}
+
+	if primaryAlias == nil {
+		rec.RecordError(fmt.Errorf("no primary for shard %v/%v", keyspace, shard))
+	} else if !topoproto.TabletAliasEqual(si.MasterAlias, primaryAlias) {
+		rec.RecordError(fmt.Errorf("primary mismatch for shard %v/%v: found %v, expected %v", keyspace, shard, topoproto.TabletAliasString(primaryAlias), topoproto.TabletAliasString(si.MasterAlias)))
+	}
+
+	wg := sync.WaitGroup{}
+	for _, alias := range aliases {
+		wg.Add(1)
+		go func(alias *topodatapb.TabletAlias) {
+			defer wg.Done()
+
+			if err := topo.Validate(ctx, s.ts, alias); err != nil {
+				rec.RecordError(fmt.Errorf("topo.Validate(%v) failed: %v", topoproto.TabletAliasString(alias), err))
+			}
+		}(alias)
+	}
+
+	if pingTablets {
+		s.validateReplication(ctx, si, tabletMap, rec)
+		s.pingTablets(ctx, tabletMap, &wg, rec)
+	}
+
+	wg.Wait()
+}
+
+func normalizeIP(ip string) string {
+	// Normalize loopback to avoid spurious validation errors.
+	if parsedIP := net.ParseIP(ip); parsedIP != nil && parsedIP.IsLoopback() {
+		// Note that this also maps IPv6 localhost to IPv4 localhost
+		// as GetReplicas() will return only IPv4 addresses.
+		return "127.0.0.1"
+	}
+
+	return ip
+}
+
+func (s *VtctldServer) validateReplication(ctx context.Context, si *topo.ShardInfo, tabletMap map[string]*topo.TabletInfo, rec concurrency.ErrorRecorder) {
+	if si.MasterAlias == nil {
+		rec.RecordError(fmt.Errorf("no primary in shard record %v/%v", si.Keyspace(), si.ShardName()))
+		return
+	}
+
+	primaryAliasStr := topoproto.TabletAliasString(si.MasterAlias)
+	primaryInfo, ok := tabletMap[primaryAliasStr]
+	if !ok {
+		rec.RecordError(fmt.Errorf("primary %v not in tablet map", primaryAliasStr))
+		return
+	}
+
+	replicaList, err := s.tmc.GetReplicas(ctx, primaryInfo.Tablet)
+	if err != nil {
+		rec.RecordError(fmt.Errorf("GetReplicas(%v) failed: %v", primaryAliasStr, err))
+		return
+	}
+
+	if len(replicaList) == 0 {
+		rec.RecordError(fmt.Errorf("no replicas of tablet %v found", primaryAliasStr))
+		return
+	}
+
+	tabletIPMap := make(map[string]*topodatapb.Tablet)
+	replicaIPMap := make(map[string]bool)
+	for _, ti := range tabletMap {
+		ip, err := topoproto.MySQLIP(ti.Tablet)
+		if err != nil {
+			rec.RecordError(fmt.Errorf("could not resolve IP for tablet %s: %v", ti.Tablet.MysqlHostname, err))
+			continue
+		}
+
+		tabletIPMap[normalizeIP(ip)] = ti.Tablet
+	}
+
+	// See if every replica is in the replication graph.
+	for _, replicaAddr := range replicaList {
+		if tabletIPMap[normalizeIP(replicaAddr)] == nil {
+			rec.RecordError(fmt.Errorf("replica %v not in replication graph for shard %v/%v (mysql instance without vttablet?)", replicaAddr, si.Keyspace(), si.ShardName()))
+		}
+
+		replicaIPMap[normalizeIP(replicaAddr)] = true
+	}
+
+	// See if every entry in the replication graph is connected to the primary.
+	for _, ti := range tabletMap {
+		if !ti.IsReplicaType() {
+			continue
+		}
+
+		ip, err := topoproto.MySQLIP(ti.Tablet)
+		if err != nil {
+			rec.RecordError(fmt.Errorf("could not resolve IP for tablet %s: %v", ti.Tablet.MysqlHostname, err))
+			continue
+		}
+
+		if !replicaIPMap[normalizeIP(ip)] {
+			rec.RecordError(fmt.Errorf("replica %v not replicating: %v replica list: %q", topoproto.TabletAliasString(ti.Alias), ip, replicaList))
+		}
+	}
+}
+
+func (s *VtctldServer) pingTablets(ctx context.Context, tabletMap map[string]*topo.TabletInfo, wg *sync.WaitGroup, rec concurrency.ErrorRecorder) {
+	for alias, ti := range tabletMap {
+		wg.Add(1)
+		go func(alias string, ti *topo.TabletInfo) {
+			defer wg.Done()
+
+			if err := s.tmc.Ping(ctx, ti.Tablet); err != nil {
+				rec.RecordError(fmt.Errorf("Ping(%v) failed: %v tablet hostname: %v", alias, err, ti.Hostname)) // nolint:golint
+			}
+		}(alias, ti)
+	}
+}
+
+// schemaReference is the schema that ValidateSchemaKeyspace diffs every other
+// tablet against. It is taken from the primary of the first shard that has
+// one.
+type schemaReference struct {
+	alias  *topodatapb.TabletAlias
+	schema *tabletmanagerdatapb.SchemaDefinition
+}
+
+// validateSchemaShard diffs the schema of every tablet in the shard against
+// the reference schema, filling in the reference from this shard's primary if
+// it has not been set yet. If vschema is non-nil, it also checks that every
+// table on the shard primary is in the vschema.
+func (s *VtctldServer) validateSchemaShard(ctx context.Context, req *vtctldatapb.ValidateSchemaKeyspaceRequest, shard string, vschema *vschemapb.Keyspace, ref *schemaReference, rec concurrency.ErrorRecorder) {
+	si, err := s.ts.GetShard(ctx, req.Keyspace, shard)
+	if err != nil {
+		rec.RecordError(fmt.Errorf("GetShard(%v, %v) failed: %v", req.Keyspace, shard, err))
+		return
+	}
+
+	if !si.HasMaster() {
+		if !req.SkipNoPrimary {
+			rec.RecordError(fmt.Errorf("no primary in shard %v/%v", req.Keyspace, shard))
+		}
+
+		return
+	}
+
+	if vschema != nil || ref.schema == nil {
+		primarySchema, err := s.getTabletSchema(ctx, si.MasterAlias, req.ExcludeTables, req.IncludeViews)
+		if err != nil {
+			rec.RecordError(fmt.Errorf("GetSchema(%v, nil, %v, %v) failed: %v", topoproto.TabletAliasString(si.MasterAlias), req.ExcludeTables, req.IncludeViews, err))
+			return
+		}
+
+		if vschema != nil {
+			var notFoundTables []string
+			for _, td := range primarySchema.TableDefinitions {
+				if _, ok := vschema.Tables[td.Name]; !ok {
+					notFoundTables = append(notFoundTables, td.Name)
+				}
+			}
+
+			if len(notFoundTables) > 0 {
+				rec.RecordError(fmt.Errorf("%v/%v has tables that are not in the vschema: %v", req.Keyspace, shard, notFoundTables))
+			}
+		}
+
+		if ref.schema == nil {
+			log.Infof("Using schema of primary %v as the reference", topoproto.TabletAliasString(si.MasterAlias))
+			ref.alias = si.MasterAlias
+			ref.schema = primarySchema
+		}
+	}
+
+	aliases, err := s.ts.FindAllTabletAliasesInShard(ctx, req.Keyspace, shard)
+	if err != nil {
+		rec.RecordError(fmt.Errorf("FindAllTabletAliasesInShard(%v, %v) failed: %v", req.Keyspace, shard, err))
+		return
+	}
+
+	wg := sync.WaitGroup{}
+	for _, alias := range aliases {
+		// Don't diff the reference schema against itself.
+		if topoproto.TabletAliasEqual(alias, ref.alias) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(alias *topodatapb.TabletAlias) {
+			defer wg.Done()
+
+			replicaSchema, err := s.getTabletSchema(ctx, alias, req.ExcludeTables, req.IncludeViews)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("GetSchema(%v, nil, %v, %v) failed: %v", topoproto.TabletAliasString(alias), req.ExcludeTables, req.IncludeViews, err))
+				return
+			}
+
+			tmutils.DiffSchema(topoproto.TabletAliasString(ref.alias), ref.schema, topoproto.TabletAliasString(alias), replicaSchema, rec)
+		}(alias)
+	}
+
+	wg.Wait()
+}
+
+func (s *VtctldServer) getTabletSchema(ctx context.Context, alias *topodatapb.TabletAlias, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	ti, err := s.ts.GetTablet(ctx, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tmc.GetSchema(ctx, ti.Tablet, nil, excludeTables, includeViews)
+}
+
+// getVersionFromTablet returns the version of the tablet at the given address.
+// Tests can swap it out to avoid making http requests.
+var getVersionFromTablet = getVersionFromTabletDebugVars
+
+func getVersionFromTabletDebugVars(tabletAddr string) (string, error) {
+	resp, err := http.Get("http://" + tabletAddr + "/debug/vars")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var vars struct {
+		BuildHost      string
+		BuildUser      string
+		BuildTimestamp int64
+		BuildGitRev    string
+	}
+
+	if err := json.Unmarshal(body, &vars); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%v", vars), nil
+}
+
+func (s *VtctldServer) getTabletVersion(ctx context.Context, alias *topodatapb.TabletAlias) (string, error) {
+	ti, err := s.ts.GetTablet(ctx, alias)
+	if err != nil {
+		return "", err
+	}
+
+	version, err := getVersionFromTablet(ti.Addr())
+	if err != nil {
+		return "", err
+	}
+
+	log.Infof("Tablet %v is running version '%v'", topoproto.TabletAliasString(alias), version)
+
+	return version, nil
+}
+
+func (s *VtctldServer) diffVersion(ctx context.Context, primaryVersion string, primaryAlias *topodatapb.TabletAlias, alias *topodatapb.TabletAlias, wg *sync.WaitGroup, rec concurrency.ErrorRecorder) {
+	defer wg.Done()
+
+	replicaVersion, err := s.getTabletVersion(ctx, alias)
+	if err != nil {
+		rec.RecordError(err)
+		return
+	}
+
+	if primaryVersion != replicaVersion {
+		rec.RecordError(fmt.Errorf("primary %v version %v is different than replica %v version %v", topoproto.TabletAliasString(primaryAlias), primaryVersion, topoproto.TabletAliasString(alias), replicaVersion))
+	}
+}
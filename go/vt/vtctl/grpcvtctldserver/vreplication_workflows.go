@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// VReplicationWorkflowRunner runs the actions of the MoveTables and Reshard
+// workflows. They are implemented by the wrangler, which can't be imported
+// here since it imports this package, so the wrangler registers its runner
+// with RegisterVReplicationWorkflowRunner.
+type VReplicationWorkflowRunner interface {
+	MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error)
+	Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error)
+}
+
+// VReplicationWorkflowRunnerFactory returns a VReplicationWorkflowRunner that
+// logs its progress to the logger.
+type VReplicationWorkflowRunnerFactory func(ts *topo.Server, tmc tmclient.TabletManagerClient, logger logutil.Logger) VReplicationWorkflowRunner
+
+var vreplicationWorkflowRunnerFactory VReplicationWorkflowRunnerFactory
+
+// RegisterVReplicationWorkflowRunner registers the factory of the runner used
+// by the MoveTables and Reshard rpcs.
+func RegisterVReplicationWorkflowRunner(factory VReplicationWorkflowRunnerFactory) {
+	vreplicationWorkflowRunnerFactory = factory
+}
+
+// newVReplicationWorkflowRunner returns a runner for one rpc, and the logger
+// that collects its events.
+func (s *VtctldServer) newVReplicationWorkflowRunner() (VReplicationWorkflowRunner, *logutil.MemoryLogger, error) {
+	if vreplicationWorkflowRunnerFactory == nil {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "no vreplication workflow runner is registered")
+	}
+
+	logger := logutil.NewMemoryLogger()
+	return vreplicationWorkflowRunnerFactory(s.ts, s.tmc, logger), logger, nil
+}
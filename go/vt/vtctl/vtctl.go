@@ -318,8 +318,8 @@ var commands = []commandGroup{
 				"[-cell=<source_cells> DEPRECATED] [-cells=<source_cells>] [-tablet_types=<source_tablet_types>] <keyspace> <json_spec>",
 				`Create and backfill a lookup vindex. the json_spec must contain the vindex and colvindex specs for the new lookup.`},
 			{"ExternalizeVindex", commandExternalizeVindex,
-				"<keyspace>.<vindex>",
-				`Externalize a backfilled vindex.`},
+				"[-skip_vdiff] <keyspace>.<vindex>",
+				`Externalize a backfilled vindex. The last VDiff of its workflow must have completed without mismatch, unless -skip_vdiff is specified. The state of the vindex is shown by Workflow show.`},
 			{"Materialize", commandMaterialize,
 				`[-cells=<cells>] [-tablet_types=<source_tablet_types>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
 				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
@@ -449,6 +449,12 @@ var commands = []commandGroup{
 			{"ApplyRoutingRules", commandApplyRoutingRules,
 				"{-rules=<rules> || -rules_file=<rules_file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run]",
 				"Applies the VSchema routing rules."},
+			{"GetRangeMaps", commandGetRangeMaps,
+				"",
+				"Displays the range maps of the range_map vindexes."},
+			{"ApplyRangeMaps", commandApplyRangeMaps,
+				"{-range_maps=<range_maps> || -range_maps_file=<range_maps_file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run]",
+				"Applies the range maps of the range_map vindexes. Range maps listed as pending for a workflow take effect when the workflow switches writes."},
 			{"RebuildVSchemaGraph", commandRebuildVSchemaGraph,
 				"[-cells=c1,c2,...]",
 				"Rebuilds the cell-specific SrvVSchema from the global VSchema objects in the provided cells (or all cells if none provided)."},
@@ -2298,13 +2304,14 @@ func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFl
 }
 
 func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	skipVDiff := subFlags.Bool("skip_vdiff", false, "Externalize the vindex without checking that it was verified by VDiff")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("one argument is required: keyspace.vindex")
 	}
-	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
+	return wr.ExternalizeVindex(ctx, subFlags.Arg(0), *skipVDiff)
 }
 
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -2867,7 +2874,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if *requestContext == "" {
 		*requestContext = fmt.Sprintf("vtctl:%s", executionUUID)
 	}
-	executor := schemamanager.NewTabletExecutor(*requestContext, wr, *waitReplicasTimeout)
+	executor := schemamanager.NewTabletExecutor(*requestContext, wr.TopoServer(), wr.TabletManagerClient(), wr.Logger(), *waitReplicasTimeout)
 	if *allowLongUnavailability {
 		executor.AllowBigSchemaChange()
 	}
@@ -3149,6 +3156,21 @@ func commandGetRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	return nil
 }
 
+func commandGetRangeMaps(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	resp, err := wr.VtctldServer().GetRangeMaps(ctx, &vtctldatapb.GetRangeMapsRequest{})
+	if err != nil {
+		return err
+	}
+
+	b, err := json2.MarshalIndentPB(resp.RangeMaps, "  ")
+	if err != nil {
+		wr.Logger().Printf("%v\n", err)
+		return err
+	}
+	wr.Logger().Printf("%s\n", b)
+	return nil
+}
+
 func commandRebuildVSchemaGraph(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "Specifies a comma-separated list of cells to look for tablets")
@@ -3356,6 +3378,82 @@ func commandApplyRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return nil
 }
 
+func commandApplyRangeMaps(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	rangeMaps := subFlags.String("range_maps", "", "Specify range maps as a string")
+	rangeMapsFile := subFlags.String("range_maps_file", "", "Specify range maps in a file")
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	dryRun := subFlags.Bool("dry-run", false, "Do not upload the range maps, but print what actions would be taken")
+	var cells flagutil.StringListValue
+	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("ApplyRangeMaps doesn't take any arguments")
+	}
+
+	var rangeMapsBytes []byte
+	if *rangeMapsFile != "" {
+		var err error
+		rangeMapsBytes, err = ioutil.ReadFile(*rangeMapsFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		rangeMapsBytes = []byte(*rangeMaps)
+	}
+
+	rm := &vschemapb.RangeMaps{}
+	if err := json2.Unmarshal(rangeMapsBytes, rm); err != nil {
+		return err
+	}
+
+	b, err := json2.MarshalIndentPB(rm, "  ")
+	if err != nil {
+		msg := &strings.Builder{}
+		if *dryRun {
+			msg.WriteString("DRY RUN: ")
+		}
+		msg.WriteString("Failed to marshal RangeMaps for display")
+
+		wr.Logger().Errorf2(err, msg.String())
+	} else {
+		msg := &strings.Builder{}
+		if *dryRun {
+			msg.WriteString("=== DRY RUN ===\n")
+		}
+		msg.WriteString(fmt.Sprintf("New RangeMaps object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", b))
+		if *dryRun {
+			msg.WriteString("=== (END) DRY RUN ===\n")
+		}
+
+		wr.Logger().Printf(msg.String())
+	}
+
+	if !*dryRun {
+		_, err = wr.VtctldServer().ApplyRangeMaps(ctx, &vtctldatapb.ApplyRangeMapsRequest{
+			RangeMaps:    rm,
+			SkipRebuild:  *skipRebuild,
+			RebuildCells: cells,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if *skipRebuild {
+		msg := &strings.Builder{}
+		if *dryRun {
+			msg.WriteString("DRY RUN: ")
+		}
+		msg.WriteString("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		wr.Logger().Warningf(msg.String())
+	}
+
+	return nil
+}
+
 func commandGetSrvKeyspaceNames(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/workflow/vexec"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vttime"
+)
+
+const (
+	// VDiffAll selects all the vdiffs of a workflow in VDiffShow and
+	// VDiffDelete.
+	VDiffAll = "all"
+	// VDiffLast selects the most recent vdiff of a workflow in VDiffShow.
+	VDiffLast = "last"
+)
+
+// VDiffCreate starts a new vdiff of a workflow on the primaries of its target
+// keyspace. The vdiff runs on the tablets, and its progress can be followed
+// with VDiffShow.
+func (s *Server) VDiffCreate(ctx context.Context, req *vtctldatapb.VDiffCreateRequest) (*vtctldatapb.VDiffCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffCreate")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if req.Workflow == "" {
+		return nil, fmt.Errorf("%w: workflow name is required", ErrInvalidWorkflow)
+	}
+
+	uuid := req.Uuid
+	if uuid == "" {
+		var err error
+		if uuid, err = schema.CreateUUID(); err != nil {
+			return nil, err
+		}
+	}
+	span.Annotate("uuid", uuid)
+
+	options := req.Options
+	if options == nil {
+		options = &tabletmanagerdatapb.VDiffOptions{}
+	}
+	optionsJSON, err := protojson.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, options) values (%s, %s, %s, %s)",
+		encodeString(uuid), encodeString(req.Workflow), encodeString(req.Keyspace), encodeString(string(optionsJSON)))
+	if _, err := vexec.NewVExec(req.Keyspace, req.Workflow, s.ts, s.tmc).QueryContext(ctx, query); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.VDiffCreateResponse{Uuid: uuid}, nil
+}
+
+// VDiffStop stops a running vdiff. Its progress is kept, so that it can be
+// resumed with VDiffResume.
+func (s *Server) VDiffStop(ctx context.Context, req *vtctldatapb.VDiffStopRequest) (*vtctldatapb.VDiffStopResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffStop")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	if err := s.updateVDiffState(ctx, req.Keyspace, req.Workflow, req.Uuid, "stopped"); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.VDiffStopResponse{}, nil
+}
+
+// VDiffResume resumes a stopped or failed vdiff from where it left off.
+func (s *Server) VDiffResume(ctx context.Context, req *vtctldatapb.VDiffResumeRequest) (*vtctldatapb.VDiffResumeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffResume")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	if err := s.updateVDiffState(ctx, req.Keyspace, req.Workflow, req.Uuid, "pending"); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.VDiffResumeResponse{}, nil
+}
+
+func (s *Server) updateVDiffState(ctx context.Context, keyspace, workflow, uuid, state string) error {
+	if workflow == "" || uuid == "" {
+		return fmt.Errorf("%w: workflow name and vdiff uuid are required", ErrInvalidWorkflow)
+	}
+
+	query := fmt.Sprintf("update _vt.vdiff set state = %s where vdiff_uuid = %s", encodeString(state), encodeString(uuid))
+	_, err := vexec.NewVExec(keyspace, workflow, s.ts, s.tmc).QueryContext(ctx, query)
+	return err
+}
+
+// VDiffDelete deletes a vdiff, or all the vdiffs of a workflow, along with
+// their results. Running vdiffs are stopped first.
+func (s *Server) VDiffDelete(ctx context.Context, req *vtctldatapb.VDiffDeleteRequest) (*vtctldatapb.VDiffDeleteResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffDelete")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	if req.Workflow == "" || req.Uuid == "" {
+		return nil, fmt.Errorf("%w: workflow name and vdiff uuid are required", ErrInvalidWorkflow)
+	}
+
+	query := "delete from _vt.vdiff"
+	if req.Uuid != VDiffAll {
+		query = fmt.Sprintf("delete from _vt.vdiff where vdiff_uuid = %s", encodeString(req.Uuid))
+	}
+	if _, err := vexec.NewVExec(req.Keyspace, req.Workflow, s.ts, s.tmc).QueryContext(ctx, query); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.VDiffDeleteResponse{}, nil
+}
+
+// VDiffShow returns the state and the results of a vdiff, of the most recent
+// vdiff, or of all the vdiffs of a workflow, on each target shard.
+func (s *Server) VDiffShow(ctx context.Context, req *vtctldatapb.VDiffShowRequest) (*vtctldatapb.VDiffShowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffShow")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	if req.Workflow == "" {
+		return nil, fmt.Errorf("%w: workflow name is required", ErrInvalidWorkflow)
+	}
+
+	query := "select id, vdiff_uuid, state, last_error, created_at, started_at, completed_at from _vt.vdiff"
+	if req.Uuid != "" && req.Uuid != VDiffAll && req.Uuid != VDiffLast {
+		query = fmt.Sprintf("%s where vdiff_uuid = %s", query, encodeString(req.Uuid))
+	}
+
+	vx := vexec.NewVExec(req.Keyspace, req.Workflow, s.ts, s.tmc)
+	results, err := vx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	type shardVDiff struct {
+		uuid   string
+		status *vtctldatapb.VDiff_ShardStatus
+	}
+	var (
+		vdiffs    = make(map[string]*vtctldatapb.VDiff)
+		createdAt = make(map[string]time.Time)
+		// byID maps the id of each vdiff on each tablet to its shard status.
+		byID = make(map[*topo.TabletInfo]map[int64]*shardVDiff, len(results))
+	)
+
+	for tablet, p3qr := range results {
+		byID[tablet] = make(map[int64]*shardVDiff)
+		for _, row := range sqltypes.Proto3ToResult(p3qr).Named().Rows {
+			id, err := row.ToInt64("id")
+			if err != nil {
+				return nil, err
+			}
+
+			uuid := row.AsString("vdiff_uuid", "")
+			status := &vtctldatapb.VDiff_ShardStatus{
+				Tablet:    tablet.Alias,
+				State:     row.AsString("state", ""),
+				LastError: row.AsString("last_error", ""),
+			}
+
+			created, err := parseVDiffTime(row.AsString("created_at", ""))
+			if err != nil {
+				return nil, err
+			}
+			status.CreatedAt = timeToProto(created)
+			if created.After(createdAt[uuid]) {
+				createdAt[uuid] = created
+			}
+
+			started, err := parseVDiffTime(row.AsString("started_at", ""))
+			if err != nil {
+				return nil, err
+			}
+			status.StartedAt = timeToProto(started)
+
+			completed, err := parseVDiffTime(row.AsString("completed_at", ""))
+			if err != nil {
+				return nil, err
+			}
+			status.CompletedAt = timeToProto(completed)
+
+			vdiff, ok := vdiffs[uuid]
+			if !ok {
+				vdiff = &vtctldatapb.VDiff{
+					Uuid:          uuid,
+					Keyspace:      req.Keyspace,
+					Workflow:      req.Workflow,
+					ShardStatuses: make(map[string]*vtctldatapb.VDiff_ShardStatus),
+				}
+				vdiffs[uuid] = vdiff
+			}
+			vdiff.ShardStatuses[tablet.Shard] = status
+			byID[tablet][id] = &shardVDiff{uuid: uuid, status: status}
+		}
+	}
+
+	if req.Uuid == VDiffLast && len(vdiffs) > 1 {
+		var last string
+		for uuid, created := range createdAt {
+			if last == "" || created.After(createdAt[last]) {
+				last = uuid
+			}
+		}
+		vdiffs = map[string]*vtctldatapb.VDiff{last: vdiffs[last]}
+	}
+
+	if len(vdiffs) == 0 {
+		return &vtctldatapb.VDiffShowResponse{}, nil
+	}
+
+	uuids := make([]string, 0, len(vdiffs))
+	for uuid := range vdiffs {
+		uuids = append(uuids, encodeString(uuid))
+	}
+	sort.Strings(uuids)
+
+	query = fmt.Sprintf(
+		"select vdiff_id, table_name, state, rows_compared, mismatch, report from _vt.vdiff_table where vdiff_id in (select id from _vt.vdiff where vdiff_uuid in (%s)) order by table_name",
+		strings.Join(uuids, ", "),
+	)
+	results, err = vx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	for tablet, p3qr := range results {
+		for _, row := range sqltypes.Proto3ToResult(p3qr).Named().Rows {
+			id, err := row.ToInt64("vdiff_id")
+			if err != nil {
+				return nil, err
+			}
+
+			svd, ok := byID[tablet][id]
+			if !ok {
+				// The vdiff was created after our first query.
+				continue
+			}
+
+			rowsCompared, err := row.ToInt64("rows_compared")
+			if err != nil {
+				return nil, err
+			}
+
+			table := &vtctldatapb.VDiff_TableStatus{
+				TableName:    row.AsString("table_name", ""),
+				State:        row.AsString("state", ""),
+				RowsCompared: rowsCompared,
+				HasMismatch:  row.AsInt64("mismatch", 0) != 0,
+			}
+			if report := row.AsString("report", ""); report != "" {
+				table.Report = &tabletmanagerdatapb.VDiffReport{}
+				if err := protojson.Unmarshal([]byte(report), table.Report); err != nil {
+					return nil, err
+				}
+			}
+
+			svd.status.Tables = append(svd.status.Tables, table)
+		}
+	}
+
+	resp := &vtctldatapb.VDiffShowResponse{
+		Vdiffs: make([]*vtctldatapb.VDiff, 0, len(vdiffs)),
+	}
+	for _, vdiff := range vdiffs {
+		resp.Vdiffs = append(resp.Vdiffs, vdiff)
+	}
+	sort.Slice(resp.Vdiffs, func(i, j int) bool {
+		return createdAt[resp.Vdiffs[i].Uuid].Before(createdAt[resp.Vdiffs[j].Uuid])
+	})
+
+	return resp, nil
+}
+
+// parseVDiffTime parses a timestamp column of the vdiff table. A NULL
+// timestamp is returned as the zero time.
+func parseVDiffTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02 15:04:05", s)
+}
+
+func timeToProto(t time.Time) *vttime.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return protoutil.TimeToProto(t)
+}
@@ -201,3 +201,78 @@ func (qp *PerTargetQueryPlan) ExecuteScatter(ctx context.Context, targets ...*to
 
 	return results, rec.AggrError(vterrors.Aggregate)
 }
+
+// TabletVExecQueryPlan implements the QueryPlan interface. Like FixedQueryPlan,
+// it executes the same query on each target, but it does so through the
+// VExec tabletmanager RPC rather than VReplicationExec, so that the query can
+// be intercepted by the tablet. It is used for tables which are managed by a
+// tablet-side engine, like the vdiff tables.
+type TabletVExecQueryPlan struct {
+	ParsedQuery *sqlparser.ParsedQuery
+
+	keyspace string
+	workflow string
+	tmc      tmclient.TabletManagerClient
+}
+
+// Execute is part of the QueryPlan interface.
+func (qp *TabletVExecQueryPlan) Execute(ctx context.Context, target *topo.TabletInfo) (qr *querypb.QueryResult, err error) {
+	if qp.ParsedQuery == nil {
+		return nil, fmt.Errorf("%w: call PlanQuery on a query planner first", ErrUnpreparedQuery)
+	}
+
+	targetAliasStr := target.AliasString()
+
+	defer func() {
+		if err != nil {
+			log.Warningf("Result on %v: %v", targetAliasStr, err)
+			return
+		}
+	}()
+
+	qr, err = qp.tmc.VExec(ctx, target.Tablet, qp.ParsedQuery.Query, qp.workflow, qp.keyspace)
+	if err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// ExecuteScatter is part of the QueryPlan interface. The same query is executed
+// on each target, and errors from individual targets are aggregated into a
+// singular error.
+func (qp *TabletVExecQueryPlan) ExecuteScatter(ctx context.Context, targets ...*topo.TabletInfo) (map[*topo.TabletInfo]*querypb.QueryResult, error) {
+	if qp.ParsedQuery == nil {
+		return nil, fmt.Errorf("%w: call PlanQuery on a query planner first", ErrUnpreparedQuery)
+	}
+
+	var (
+		m       sync.Mutex
+		wg      sync.WaitGroup
+		rec     concurrency.AllErrorRecorder
+		results = make(map[*topo.TabletInfo]*querypb.QueryResult, len(targets))
+	)
+
+	for _, target := range targets {
+		wg.Add(1)
+
+		go func(ctx context.Context, target *topo.TabletInfo) {
+			defer wg.Done()
+
+			qr, err := qp.Execute(ctx, target)
+			if err != nil {
+				rec.RecordError(err)
+
+				return
+			}
+
+			m.Lock()
+			defer m.Unlock()
+
+			results[target] = qr
+		}(ctx, target)
+	}
+
+	wg.Wait()
+
+	return results, rec.AggrError(vterrors.Aggregate)
+}
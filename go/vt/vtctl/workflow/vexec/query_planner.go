@@ -456,3 +456,131 @@ func extractWhereComparisonColumns(where *sqlparser.Where) []string {
 
 	return cols
 }
+
+// VDiffQueryPlanner implements the QueryPlanner interface for queries on the
+// _vt.vdiff table. Queries are sent to the tablets with the VExec RPC, where
+// they are handled by the vdiff engine.
+type VDiffQueryPlanner struct {
+	tmc tmclient.TabletManagerClient
+
+	keyspace string
+	dbname   string
+	workflow string
+}
+
+// NewVDiffQueryPlanner returns a new VDiffQueryPlanner. It is valid to pass an
+// empty string for the workflow parameter.
+func NewVDiffQueryPlanner(tmc tmclient.TabletManagerClient, keyspace string, workflow string, dbname string) *VDiffQueryPlanner {
+	return &VDiffQueryPlanner{
+		tmc:      tmc,
+		keyspace: keyspace,
+		dbname:   dbname,
+		workflow: workflow,
+	}
+}
+
+// PlanQuery is part of the QueryPlanner interface.
+//
+// For vdiff query planners, SELECT, INSERT, UPDATE, and DELETE queries are
+// supported. INSERT queries must insert a single row; the tablets fill in
+// their shard and database name. UPDATE queries may only update the state
+// column. ORDER BY and LIMIT clauses are not supported on UPDATE and DELETE
+// queries.
+func (planner *VDiffQueryPlanner) PlanQuery(stmt sqlparser.Statement) (plan QueryPlan, err error) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		stmt.Where = addDefaultWheres(planner, stmt.Where)
+	case *sqlparser.Insert:
+		if rows, ok := stmt.Rows.(sqlparser.Values); !ok || len(rows) != 1 {
+			err = fmt.Errorf("%w: INSERT must insert a single row", ErrUnsupportedQueryConstruct)
+		}
+	case *sqlparser.Update:
+		if stmt.OrderBy != nil || stmt.Limit != nil {
+			err = fmt.Errorf("%w: UPDATE must not have explicit ordering or limit clauses", ErrUnsupportedQueryConstruct)
+			break
+		}
+		for _, expr := range stmt.Exprs {
+			if !expr.Name.Name.EqualString("state") {
+				err = fmt.Errorf("%w %+v", ErrCannotUpdateImmutableColumn, expr.Name.Name)
+				break
+			}
+		}
+		stmt.Where = addDefaultWheres(planner, stmt.Where)
+	case *sqlparser.Delete:
+		if stmt.Targets != nil || stmt.Partitions != nil || stmt.OrderBy != nil || stmt.Limit != nil {
+			err = fmt.Errorf("%w: DELETE must not have USING, partitions, ordering or limit clauses", ErrUnsupportedQueryConstruct)
+			break
+		}
+		stmt.Where = addDefaultWheres(planner, stmt.Where)
+	default:
+		err = ErrUnsupportedQuery
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, sqlparser.String(stmt))
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("%v", stmt)
+
+	return &TabletVExecQueryPlan{
+		ParsedQuery: buf.ParsedQuery(),
+		keyspace:    planner.keyspace,
+		workflow:    planner.workflow,
+		tmc:         planner.tmc,
+	}, nil
+}
+
+// QueryParams is part of the QueryPlanner interface. A VDiffQueryPlanner
+// attaches the same default WHERE clauses as a VReplicationQueryPlanner.
+func (planner *VDiffQueryPlanner) QueryParams() QueryParams {
+	return QueryParams{
+		DBName:         planner.dbname,
+		DBNameColumn:   "db_name",
+		Workflow:       planner.workflow,
+		WorkflowColumn: "workflow",
+	}
+}
+
+// VDiffTableQueryPlanner implements the QueryPlanner interface for queries on
+// the _vt.vdiff_table table, which holds the per-table results of the vdiffs.
+// Only SELECT queries are supported, and they are sent to the tablets as-is.
+type VDiffTableQueryPlanner struct {
+	tmc tmclient.TabletManagerClient
+
+	keyspace string
+	workflow string
+}
+
+// NewVDiffTableQueryPlanner returns a new VDiffTableQueryPlanner.
+func NewVDiffTableQueryPlanner(tmc tmclient.TabletManagerClient, keyspace string, workflow string) *VDiffTableQueryPlanner {
+	return &VDiffTableQueryPlanner{
+		tmc:      tmc,
+		keyspace: keyspace,
+		workflow: workflow,
+	}
+}
+
+// PlanQuery is part of the QueryPlanner interface.
+func (planner *VDiffTableQueryPlanner) PlanQuery(stmt sqlparser.Statement) (QueryPlan, error) {
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedQuery, sqlparser.String(stmt))
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("%v", sel)
+
+	return &TabletVExecQueryPlan{
+		ParsedQuery: buf.ParsedQuery(),
+		keyspace:    planner.keyspace,
+		workflow:    planner.workflow,
+		tmc:         planner.tmc,
+	}, nil
+}
+
+// QueryParams is part of the QueryPlanner interface. The vdiff_table table
+// has neither a db_name nor a workflow column.
+func (planner *VDiffTableQueryPlanner) QueryParams() QueryParams {
+	return QueryParams{}
+}
@@ -381,3 +381,73 @@ func assertQueryMapsMatch(t *testing.T, expected map[string]string, actual map[s
 
 	assert.Equal(t, expected, actualQueryMap, msgAndArgs...)
 }
+
+func TestVDiffQueryPlanner(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		query                string
+		expectedPlannedQuery string
+		err                  error
+	}{
+		{
+			name:                 "select",
+			query:                "select * from _vt.vdiff where vdiff_uuid = 'u1'",
+			expectedPlannedQuery: "select * from _vt.vdiff where vdiff_uuid = 'u1' and db_name = 'vt_testkeyspace' and workflow = 'testworkflow'",
+		},
+		{
+			name:                 "insert",
+			query:                "insert into _vt.vdiff(vdiff_uuid, workflow) values ('u1', 'testworkflow')",
+			expectedPlannedQuery: "insert into _vt.vdiff(vdiff_uuid, workflow) values ('u1', 'testworkflow')",
+		},
+		{
+			name:  "multi-row insert",
+			query: "insert into _vt.vdiff(vdiff_uuid, workflow) values ('u1', 'testworkflow'), ('u2', 'testworkflow')",
+			err:   ErrUnsupportedQueryConstruct,
+		},
+		{
+			name:                 "update state",
+			query:                "update _vt.vdiff set state = 'stopped' where vdiff_uuid = 'u1'",
+			expectedPlannedQuery: "update _vt.vdiff set state = 'stopped' where vdiff_uuid = 'u1' and db_name = 'vt_testkeyspace' and workflow = 'testworkflow'",
+		},
+		{
+			name:  "update other column",
+			query: "update _vt.vdiff set workflow = 'otherworkflow'",
+			err:   ErrCannotUpdateImmutableColumn,
+		},
+		{
+			name:                 "delete",
+			query:                "delete from _vt.vdiff",
+			expectedPlannedQuery: "delete from _vt.vdiff where db_name = 'vt_testkeyspace' and workflow = 'testworkflow'",
+		},
+	}
+
+	planner := NewVDiffQueryPlanner(nil, "testkeyspace", "testworkflow", "vt_testkeyspace")
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stmt := testutil.StatementFromString(t, tt.query)
+
+			plan, err := planner.PlanQuery(stmt)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err), "expected err of type %v, got %v", tt.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			qp, ok := plan.(*TabletVExecQueryPlan)
+			require.True(t, ok, "expected plan to be TabletVExecQueryPlan, got %T: %v", plan, plan)
+			assert.Equal(t, tt.expectedPlannedQuery, qp.ParsedQuery.Query)
+		})
+	}
+
+	_, err := NewVDiffTableQueryPlanner(nil, "testkeyspace", "testworkflow").PlanQuery(testutil.StatementFromString(t, "delete from _vt.vdiff_table"))
+	assert.True(t, errors.Is(err, ErrUnsupportedQuery), "expected err of type %v, got %v", ErrUnsupportedQuery, err)
+}
@@ -36,6 +36,12 @@ const (
 	// are prefixed by.
 	VExecTableQualifier = "_vt"
 
+	// VDiffTableName is the unqualified name of the vdiff table supported by
+	// vexec.
+	VDiffTableName = "vdiff"
+	// VDiffTableTableName is the unqualified name of the vdiff_table table
+	// supported by vexec.
+	VDiffTableTableName = "vdiff_table"
 	// SchemaMigrationsTableName is the unqualified name of the schema
 	// migrations table supported by vexec.
 	SchemaMigrationsTableName = "schema_migrations"
@@ -237,6 +243,10 @@ func (vx *VExec) GetPlanner(ctx context.Context, table string) (QueryPlanner, er
 		}
 
 		return NewVReplicationLogQueryPlanner(vx.tmc, tabletStreamIDMap), nil
+	case qualifiedTableName(VDiffTableName):
+		return NewVDiffQueryPlanner(vx.tmc, vx.keyspace, vx.workflow, vx.primaries[0].DbName()), nil
+	case qualifiedTableName(VDiffTableTableName):
+		return NewVDiffTableQueryPlanner(vx.tmc, vx.keyspace, vx.workflow), nil
 	case qualifiedTableName(SchemaMigrationsTableName):
 		return nil, errors.New("Schema Migrations not yet supported in new workflow package")
 	default:
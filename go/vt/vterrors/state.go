@@ -26,6 +26,7 @@ const (
 	// invalid argument
 	BadFieldError
 	BadTableError
+	CantAggregate2Collations
 	CantUseOptionHere
 	DataOutOfRange
 	EmptyQuery
@@ -36,6 +37,7 @@ const (
 	NonUpdateableTable
 	SyntaxError
 	WrongGroupField
+	WrongParamcountToNativeFct
 	WrongTypeForVar
 	WrongValueForVar
 	LockOrActiveTransaction
@@ -0,0 +1,419 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package debezium converts the events of a VTGate VStream into change events
+which follow the Debezium JSON envelope: each row change has a before and an
+after image, an op type and the source metadata of the change, which includes
+the VGtid to restart the stream from. DDLs are converted into schema change
+events.
+
+Row changes are only emitted once their transaction is committed. The rows
+read by the copy phase of the stream are emitted as snapshot reads.
+*/
+package debezium
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Connector is the name of the connector reported in the source of the events.
+const Connector = "vitess"
+
+// Op types of the row change events.
+const (
+	OpCreate = "c"
+	OpUpdate = "u"
+	OpDelete = "d"
+	OpRead   = "r"
+)
+
+// Source is the metadata of the origin of a change event.
+type Source struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	Keyspace  string `json:"keyspace"`
+	Shard     string `json:"shard,omitempty"`
+	Table     string `json:"table,omitempty"`
+	// Vgtid is the JSON encoded VGtid of the transaction of the change.
+	// A stream started from it resumes after the transaction.
+	Vgtid string `json:"vgtid"`
+}
+
+// Envelope is the value of a row change event.
+type Envelope struct {
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+	Source *Source                `json:"source"`
+	Op     string                 `json:"op"`
+	TsMs   int64                  `json:"ts_ms"`
+}
+
+// SchemaChange is the value of a schema change event.
+type SchemaChange struct {
+	Source       *Source `json:"source"`
+	DatabaseName string  `json:"databaseName"`
+	DDL          string  `json:"ddl"`
+	TsMs         int64   `json:"ts_ms"`
+}
+
+// Event is a change event. Like in Debezium, the events of a table go to the
+// topic <server name>.<keyspace>.<table>, and the schema change events go to
+// the topic <server name>.
+type Event struct {
+	Topic string `json:"topic"`
+	// Key has the primary key columns of the changed row. It's nil for
+	// schema change events.
+	Key map[string]interface{} `json:"key"`
+	// Value is an *Envelope or a *SchemaChange.
+	Value interface{} `json:"value"`
+}
+
+// Converter converts VStream events into Debezium change events. It keeps
+// the state of the stream across calls to Convert, and must be fed all the
+// events of a VStream in order.
+type Converter struct {
+	serverName string
+
+	// fields contains the fields of each table, keyed by the table name
+	// qualified with its keyspace, as sent by VTGate.
+	fields map[string][]*querypb.Field
+
+	// vgtid is the latest VGtid of the stream, and committed the VGtid
+	// at the end of the last transaction, DDL or other statement.
+	vgtid     *binlogdatapb.VGtid
+	committed *binlogdatapb.VGtid
+
+	// pending contains the row events of the current transaction.
+	pending []*binlogdatapb.VEvent
+}
+
+// NewConverter returns a Converter. serverName identifies the stream in
+// the source of the events, and prefixes their topic.
+func NewConverter(serverName string) *Converter {
+	return &Converter{
+		serverName: serverName,
+		fields:     make(map[string][]*querypb.Field),
+	}
+}
+
+// Convert converts the next events of the VStream. It returns the change
+// events of the transactions committed by them.
+func (c *Converter) Convert(events []*binlogdatapb.VEvent) ([]*Event, error) {
+	var out []*Event
+	for _, ev := range events {
+		switch ev.Type {
+		case binlogdatapb.VEventType_FIELD:
+			c.fields[ev.FieldEvent.TableName] = ev.FieldEvent.Fields
+		case binlogdatapb.VEventType_VGTID:
+			c.vgtid = ev.Vgtid
+		case binlogdatapb.VEventType_ROW:
+			c.pending = append(c.pending, ev)
+		case binlogdatapb.VEventType_COMMIT:
+			evs, err := c.commit(ev)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, evs...)
+			c.committed = c.vgtid
+		case binlogdatapb.VEventType_DDL:
+			ev, err := c.schemaChange(ev)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, ev)
+			c.committed = c.vgtid
+		case binlogdatapb.VEventType_OTHER:
+			c.committed = c.vgtid
+		}
+	}
+	return out, nil
+}
+
+// commit returns the change events of the pending row events.
+func (c *Converter) commit(commit *binlogdatapb.VEvent) ([]*Event, error) {
+	defer func() {
+		c.pending = nil
+	}()
+
+	if len(c.pending) == 0 {
+		return nil, nil
+	}
+	vgtid, err := c.encodeVgtid()
+	if err != nil {
+		return nil, err
+	}
+	snapshot := c.isCopy()
+	shard := c.moved().Shard
+
+	var out []*Event
+	for _, ev := range c.pending {
+		keyspace, table := splitTableName(ev.RowEvent.TableName)
+		fields, ok := c.fields[ev.RowEvent.TableName]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "no field event for table %s", ev.RowEvent.TableName)
+		}
+		source := &Source{
+			Connector: Connector,
+			Name:      c.serverName,
+			TsMs:      ev.Timestamp * 1000,
+			Snapshot:  "false",
+			Keyspace:  keyspace,
+			Shard:     shard,
+			Table:     table,
+			Vgtid:     vgtid,
+		}
+		if snapshot {
+			source.Snapshot = "true"
+		}
+		for _, change := range ev.RowEvent.RowChanges {
+			before, err := rowToMap(fields, change.Before)
+			if err != nil {
+				return nil, err
+			}
+			after, err := rowToMap(fields, change.After)
+			if err != nil {
+				return nil, err
+			}
+			envelope := &Envelope{
+				Before: before,
+				After:  after,
+				Source: source,
+				Op:     op(change, snapshot),
+				TsMs:   commit.CurrentTime / 1e6,
+			}
+			keyRow := after
+			if keyRow == nil {
+				keyRow = before
+			}
+			out = append(out, &Event{
+				Topic: c.topic(keyspace, table),
+				Key:   primaryKey(fields, keyRow),
+				Value: envelope,
+			})
+		}
+	}
+	return out, nil
+}
+
+// isCopy returns true if the current transaction copies the rows of a table.
+// The copy phase of a stream sends the rows it reads in a transaction which
+// moves the lastpk of their table in the VGtid, while the transactions which
+// replicate changes only move the Gtid.
+func (c *Converter) isCopy() bool {
+	if c.vgtid == nil {
+		return false
+	}
+	for _, ev := range c.pending {
+		keyspace, table := splitTableName(ev.RowEvent.TableName)
+		for _, sgtid := range c.vgtid.ShardGtids {
+			if sgtid.Keyspace != keyspace {
+				continue
+			}
+			for _, tablePK := range sgtid.TablePKs {
+				if tablePK.TableName != table {
+					continue
+				}
+				if !proto.Equal(tablePK, c.committedTablePK(sgtid, table)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// moved returns the ShardGtid of the shard of the current transaction,
+// which is the one whose position moved in the VGtid since the last
+// transaction. VTGate sends the transactions of the shards one at a time.
+func (c *Converter) moved() *binlogdatapb.ShardGtid {
+	if c.vgtid == nil {
+		return &binlogdatapb.ShardGtid{}
+	}
+	for _, sgtid := range c.vgtid.ShardGtids {
+		if !proto.Equal(sgtid, c.committedShardGtid(sgtid)) {
+			return sgtid
+		}
+	}
+	return &binlogdatapb.ShardGtid{}
+}
+
+// committedShardGtid returns the ShardGtid of a shard in the VGtid of the
+// last transaction.
+func (c *Converter) committedShardGtid(sgtid *binlogdatapb.ShardGtid) *binlogdatapb.ShardGtid {
+	if c.committed == nil {
+		return nil
+	}
+	for _, committed := range c.committed.ShardGtids {
+		if committed.Keyspace == sgtid.Keyspace && committed.Shard == sgtid.Shard {
+			return committed
+		}
+	}
+	return nil
+}
+
+// committedTablePK returns the lastpk of a table in the VGtid of the last
+// transaction, or nil if the table had none.
+func (c *Converter) committedTablePK(sgtid *binlogdatapb.ShardGtid, table string) *binlogdatapb.TableLastPK {
+	committed := c.committedShardGtid(sgtid)
+	if committed == nil {
+		return nil
+	}
+	for _, tablePK := range committed.TablePKs {
+		if tablePK.TableName == table {
+			return tablePK
+		}
+	}
+	return nil
+}
+
+// schemaChange returns the schema change event of a DDL.
+func (c *Converter) schemaChange(ev *binlogdatapb.VEvent) (*Event, error) {
+	vgtid, err := c.encodeVgtid()
+	if err != nil {
+		return nil, err
+	}
+	moved := c.moved()
+	return &Event{
+		Topic: c.serverName,
+		Value: &SchemaChange{
+			Source: &Source{
+				Connector: Connector,
+				Name:      c.serverName,
+				TsMs:      ev.Timestamp * 1000,
+				Snapshot:  "false",
+				Keyspace:  moved.Keyspace,
+				Shard:     moved.Shard,
+				Vgtid:     vgtid,
+			},
+			DatabaseName: moved.Keyspace,
+			DDL:          ev.Statement,
+			TsMs:         ev.CurrentTime / 1e6,
+		},
+	}, nil
+}
+
+func (c *Converter) encodeVgtid() (string, error) {
+	if c.vgtid == nil {
+		return "", nil
+	}
+	data, err := protojson.Marshal(c.vgtid)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (c *Converter) topic(keyspace, table string) string {
+	return fmt.Sprintf("%s.%s.%s", c.serverName, keyspace, table)
+}
+
+// DecodeVgtid decodes the VGtid of the source of a change event.
+func DecodeVgtid(vgtid string) (*binlogdatapb.VGtid, error) {
+	out := &binlogdatapb.VGtid{}
+	if err := protojson.Unmarshal([]byte(vgtid), out); err != nil {
+		return nil, vterrors.Wrapf(err, "invalid vgtid %s", vgtid)
+	}
+	return out, nil
+}
+
+func op(change *binlogdatapb.RowChange, snapshot bool) string {
+	switch {
+	case snapshot:
+		return OpRead
+	case change.Before == nil:
+		return OpCreate
+	case change.After == nil:
+		return OpDelete
+	default:
+		return OpUpdate
+	}
+}
+
+// splitTableName splits a table name qualified by VTGate with its keyspace.
+func splitTableName(name string) (keyspace, table string) {
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// rowToMap returns the values of a row keyed by column name.
+func rowToMap(fields []*querypb.Field, row *querypb.Row) (map[string]interface{}, error) {
+	if row == nil {
+		return nil, nil
+	}
+	if len(row.Lengths) != len(fields) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "row has %d values, but there are %d fields", len(row.Lengths), len(fields))
+	}
+	// The row events of a VStream are built from the fields sent with them.
+	values := sqltypes.MakeRowTrusted(fields, row)
+	out := make(map[string]interface{}, len(fields))
+	for i, field := range fields {
+		value, err := jsonValue(values[i])
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "column %s", field.Name)
+		}
+		out[field.Name] = value
+	}
+	return out, nil
+}
+
+// jsonValue returns the JSON representation of a value. Like Debezium,
+// binary values are encoded in base64 by returning them as bytes.
+func jsonValue(v sqltypes.Value) (interface{}, error) {
+	switch {
+	case v.IsNull():
+		return nil, nil
+	case v.IsSigned():
+		return v.ToInt64()
+	case v.IsUnsigned():
+		return v.ToUint64()
+	case v.IsFloat():
+		return v.ToFloat64()
+	case v.IsBinary() || v.Type() == sqltypes.Bit:
+		return v.ToBytes(), nil
+	default:
+		return v.ToString(), nil
+	}
+}
+
+// primaryKey returns the primary key columns of a row.
+func primaryKey(fields []*querypb.Field, row map[string]interface{}) map[string]interface{} {
+	key := make(map[string]interface{})
+	for _, field := range fields {
+		if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0 {
+			key[field.Name] = row[field.Name]
+		}
+	}
+	if len(key) == 0 {
+		return nil
+	}
+	return key
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debezium
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var testFields = func() []*querypb.Field {
+	fields := sqltypes.MakeTestFields("id|name|data", "int64|varchar|varbinary")
+	fields[0].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	return fields
+}()
+
+func testRow(values string) *querypb.Row {
+	return sqltypes.RowToProto3(sqltypes.MakeTestResult(testFields, values).Rows[0])
+}
+
+func testVgtid(gtid1 string, lastpk1 *querypb.QueryResult, gtid2 string) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type: binlogdatapb.VEventType_VGTID,
+		Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: "ks",
+				Shard:    "-80",
+				Gtid:     gtid1,
+				TablePKs: []*binlogdatapb.TableLastPK{{TableName: "t1", Lastpk: lastpk1}},
+			}, {
+				Keyspace: "ks",
+				Shard:    "80-",
+				Gtid:     gtid2,
+			}},
+		},
+	}
+}
+
+func rowEvent(before, after string) *binlogdatapb.VEvent {
+	change := &binlogdatapb.RowChange{}
+	if before != "" {
+		change.Before = testRow(before)
+	}
+	if after != "" {
+		change.After = testRow(after)
+	}
+	return &binlogdatapb.VEvent{
+		Type:      binlogdatapb.VEventType_ROW,
+		Timestamp: 1,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName:  "ks.t1",
+			RowChanges: []*binlogdatapb.RowChange{change},
+		},
+	}
+}
+
+func TestConverter(t *testing.T) {
+	c := NewConverter("vt")
+	begin := &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_BEGIN}
+	commit := &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT, CurrentTime: 2e9}
+	lastpk := sqltypes.ResultToProto3(sqltypes.MakeTestResult(testFields[:1], "1"))
+
+	// The copy phase announces the tables to copy, and copies them.
+	evs, err := c.Convert([]*binlogdatapb.VEvent{begin, testVgtid("", nil, ""), commit})
+	require.NoError(t, err)
+	assert.Empty(t, evs)
+	evs, err = c.Convert([]*binlogdatapb.VEvent{
+		begin,
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t1", Fields: testFields}},
+		testVgtid("g1", nil, ""),
+		rowEvent("", "1|a|x"),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, evs)
+	evs, err = c.Convert([]*binlogdatapb.VEvent{testVgtid("g1", lastpk, ""), commit})
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+
+	// The VGtid of a change resumes the stream.
+	source := evs[0].Value.(*Envelope).Source
+	vgtid, err := DecodeVgtid(source.Vgtid)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(testVgtid("g1", lastpk, "").Vgtid, vgtid))
+	// The output of protojson is not stable.
+	source.Vgtid = ""
+
+	data, err := json.Marshal(evs[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"topic": "vt.ks.t1",
+		"key": {"id": 1},
+		"value": {
+			"before": null,
+			"after": {"id": 1, "name": "a", "data": "eA=="},
+			"source": {
+				"connector": "vitess",
+				"name": "vt",
+				"ts_ms": 1000,
+				"snapshot": "true",
+				"keyspace": "ks",
+				"shard": "-80",
+				"table": "t1",
+				"vgtid": ""
+			},
+			"op": "r",
+			"ts_ms": 2000
+		}
+	}`, string(data))
+
+	// Changes replicated while the table is copied are not snapshot reads.
+	evs, err = c.Convert([]*binlogdatapb.VEvent{begin, rowEvent("1|a|x", "1|b|x"), testVgtid("g2", lastpk, ""), commit})
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	envelope := evs[0].Value.(*Envelope)
+	assert.Equal(t, OpUpdate, envelope.Op)
+	assert.Equal(t, "false", envelope.Source.Snapshot)
+	assert.Equal(t, "-80", envelope.Source.Shard)
+	assert.Equal(t, map[string]interface{}{"id": int64(1), "name": "a", "data": []byte("x")}, envelope.Before)
+	assert.Equal(t, map[string]interface{}{"id": int64(1), "name": "b", "data": []byte("x")}, envelope.After)
+
+	evs, err = c.Convert([]*binlogdatapb.VEvent{begin, rowEvent("2|c|y", ""), testVgtid("g2", lastpk, "g3"), commit})
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	envelope = evs[0].Value.(*Envelope)
+	assert.Equal(t, OpDelete, envelope.Op)
+	assert.Equal(t, "80-", envelope.Source.Shard)
+	assert.Nil(t, envelope.After)
+	assert.Equal(t, map[string]interface{}{"id": int64(2)}, evs[0].Key)
+
+	evs, err = c.Convert([]*binlogdatapb.VEvent{
+		testVgtid("g4", lastpk, "g3"),
+		{Type: binlogdatapb.VEventType_DDL, Statement: "alter table t1 add column c int"},
+	})
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	assert.Equal(t, "vt", evs[0].Topic)
+	assert.Nil(t, evs[0].Key)
+	schemaChange := evs[0].Value.(*SchemaChange)
+	assert.Equal(t, "alter table t1 add column c int", schemaChange.DDL)
+	assert.Equal(t, "ks", schemaChange.DatabaseName)
+	assert.Equal(t, "-80", schemaChange.Source.Shard)
+
+	_, err = c.Convert([]*binlogdatapb.VEvent{begin, {Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "ks.t2"}}, commit})
+	assert.EqualError(t, err, "no field event for table ks.t2")
+}
@@ -112,7 +112,7 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(152)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -122,7 +122,7 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 	}
 	// field Query string
 	size += int64(len(cached.Query))
-	// field Vindex vitess.io/vitess/go/vt/vtgate/vindexes.SingleColumn
+	// field Vindex vitess.io/vitess/go/vt/vtgate/vindexes.Vindex
 	if cc, ok := cached.Vindex.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
@@ -133,7 +133,7 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
-	// field KsidVindex vitess.io/vitess/go/vt/vtgate/vindexes.SingleColumn
+	// field KsidVindex vitess.io/vitess/go/vt/vtgate/vindexes.Vindex
 	if cc, ok := cached.KsidVindex.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
@@ -149,7 +149,7 @@ func (cached *Delete) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(152)
 	}
 	// field DML vitess.io/vitess/go/vt/vtgate/engine.DML
 	size += cached.DML.CachedSize(false)
@@ -185,6 +185,36 @@ func (cached *Generate) CachedSize(alloc bool) int64 {
 	size += cached.Values.CachedSize(false)
 	return size
 }
+func (cached *HashJoin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Cols []int
+	{
+		size += int64(cap(cached.Cols)) * int64(8)
+	}
+	// field LHSKeys []int
+	{
+		size += int64(cap(cached.LHSKeys)) * int64(8)
+	}
+	// field RHSKeys []int
+	{
+		size += int64(cap(cached.RHSKeys)) * int64(8)
+	}
+	return size
+}
 func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -301,7 +331,7 @@ func (cached *MStream) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(40)
+		size += int64(56)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -311,6 +341,8 @@ func (cached *MStream) CachedSize(alloc bool) int64 {
 	}
 	// field TableName string
 	size += int64(len(cached.TableName))
+	// field ConsumerGroup string
+	size += int64(len(cached.ConsumerGroup))
 	return size
 }
 func (cached *MemorySort) CachedSize(alloc bool) int64 {
@@ -411,7 +443,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(120)
+		size += int64(144)
 	}
 	// field Original string
 	size += int64(len(cached.Original))
@@ -428,6 +460,13 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field ResultCacheTables []string
+	{
+		size += int64(cap(cached.ResultCacheTables)) * int64(16)
+		for _, elem := range cached.ResultCacheTables {
+			size += int64(len(elem))
+		}
+	}
 	return size
 }
 func (cached *Projection) CachedSize(alloc bool) int64 {
@@ -792,7 +831,7 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(152)
+		size += int64(160)
 	}
 	// field DML vitess.io/vitess/go/vt/vtgate/engine.DML
 	size += cached.DML.CachedSize(false)
@@ -917,6 +956,53 @@ func (cached *VindexValues) CachedSize(alloc bool) int64 {
 }
 
 //go:nocheckptr
+func (cached *Window) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field PartitionBy []vitess.io/vitess/go/vt/vtgate/engine.OrderbyParams
+	{
+		size += int64(cap(cached.PartitionBy)) * int64(32)
+	}
+	// field OrderBy []vitess.io/vitess/go/vt/vtgate/engine.OrderbyParams
+	{
+		size += int64(cap(cached.OrderBy)) * int64(32)
+	}
+	// field Functions []*vitess.io/vitess/go/vt/vtgate/engine.WindowFunc
+	{
+		size += int64(cap(cached.Functions)) * int64(8)
+		for _, elem := range cached.Functions {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Cols []int
+	{
+		size += int64(cap(cached.Cols)) * int64(8)
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *WindowFunc) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(72)
+	}
+	// field Default vitess.io/vitess/go/sqltypes.Value
+	size += cached.Default.CachedSize(false)
+	// field Alias string
+	size += int64(len(cached.Alias))
+	return size
+}
 func (cached *shardRoute) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -118,11 +118,11 @@ func (del *Delete) execDeleteUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	key, err := del.Values[0].ResolveValue(bindVars)
+	keys, err := del.resolveEqualValues(bindVars)
 	if err != nil {
 		return nil, err
 	}
-	rs, ksid, err := resolveSingleShard(vcursor, del.Vindex, del.Keyspace, key)
+	rs, ksid, err := resolveSingleShard(vcursor, del.Vindex, del.Keyspace, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -207,8 +207,8 @@ func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*que
 	}
 
 	for _, row := range subQueryResults.Rows {
-		colnum := 1
-		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[0])
+		colnum := del.KsidLength
+		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[:del.KsidLength])
 		if err != nil {
 			return err
 		}
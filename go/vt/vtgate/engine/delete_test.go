@@ -152,6 +152,7 @@ func TestDeleteOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
@@ -223,6 +224,47 @@ func TestDeleteOwnedVindex(t *testing.T) {
 	})
 }
 
+func TestDeleteOwnedVindexMultiColumn(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	vindex, err := vindexes.CreateVindex("multicol", "", map[string]string{"column_count": "2"})
+	require.NoError(t, err)
+	del := &Delete{
+		DML: DML{
+			Opcode:           Equal,
+			Keyspace:         ks.Keyspace,
+			Query:            "dummy_delete",
+			Vindex:           vindex,
+			Values:           []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}},
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       vindex,
+			KsidLength:       2,
+		},
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"cola|colb|c1|c2|c3",
+			"int64|int64|int64|int64|int64",
+		),
+		"1|2|4|5|6",
+	)}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = results
+
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(1606e7ea22ce9270)`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} false false`,
+		// The keyspace id is computed from the first two columns.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\x16\x06\xe7\xea\"Βp" true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\x16\x06\xe7\xea\"Βp" true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} true true`,
+	})
+}
+
 func TestDeleteSharded(t *testing.T) {
 	ks := buildTestVSchema().Keyspaces["sharded"]
 	del := &Delete{
@@ -264,6 +306,7 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
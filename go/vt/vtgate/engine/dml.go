@@ -40,14 +40,19 @@ type DML struct {
 	Query string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 
 	// Values specifies the vindex values to use for routing.
-	// For now, only one value is specified.
+	// For now, only one value is specified, except for an Equal
+	// on a multi-column vindex, which has one value per column.
 	Values []sqltypes.PlanValue
 
 	// Keyspace Id Vindex
-	KsidVindex vindexes.SingleColumn
+	KsidVindex vindexes.Vindex
+
+	// KsidLength is the number of columns of the KsidVindex
+	// at the start of the rows returned by the OwnedVindexQuery.
+	KsidLength int
 
 	// Table specifies the table for the update.
 	Table *vindexes.Table
@@ -100,7 +105,20 @@ func (op DMLOpcode) String() string {
 	return opcodeName[op]
 }
 
-func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.SingleColumn) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+// resolveEqualValues resolves the values of an Equal: one per column of the vindex.
+func (dml *DML) resolveEqualValues(bindVars map[string]*querypb.BindVariable) ([]sqltypes.Value, error) {
+	keys := make([]sqltypes.Value, 0, len(dml.Values))
+	for _, pv := range dml.Values {
+		key, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.Vindex) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
 	keys, err := pv.ResolveList(bindVars)
 	if err != nil {
 		return nil, nil, err
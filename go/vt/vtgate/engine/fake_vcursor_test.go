@@ -45,6 +45,7 @@ import (
 
 var testMaxMemoryRows = 100
 var testIgnoreMaxMemoryRows = false
+var testMaxHashJoinMemory = int64(1024 * 1024)
 
 var _ VCursor = (*noopVCursor)(nil)
 var _ SessionActions = (*noopVCursor)(nil)
@@ -62,7 +63,7 @@ func (t *noopVCursor) VStream(rss []*srvtopo.ResolvedShard, filter *binlogdatapb
 	panic("implement me")
 }
 
-func (t *noopVCursor) MessageStream(rss []*srvtopo.ResolvedShard, tableName string, callback func(*sqltypes.Result) error) error {
+func (t *noopVCursor) MessageStream(rss []*srvtopo.ResolvedShard, tableName, consumerGroup string, callback func(*sqltypes.Result) error) error {
 	panic("implement me")
 }
 
@@ -199,6 +200,10 @@ func (t *noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
 
+func (t *noopVCursor) MaxHashJoinMemory() int64 {
+	return testMaxHashJoinMemory
+}
+
 func (t *noopVCursor) GetKeyspace() string {
 	return ""
 }
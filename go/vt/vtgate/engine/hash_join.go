@@ -0,0 +1,290 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin specifies the parameters for a hash join primitive.
+// Unlike Join, which executes the RHS once for every row of the LHS,
+// HashJoin executes both sides once. The RHS rows are kept in a hash
+// table on the join keys, which is then probed with the LHS rows.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the Join. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result, using the same convention as Join.
+	Cols []int `json:",omitempty"`
+
+	// LHSKeys and RHSKeys are the offsets of the columns that are
+	// compared for equality. They must have the same length, and
+	// LHSKeys[i] is compared with RHSKeys[i].
+	LHSKeys, RHSKeys []int `json:",omitempty"`
+}
+
+// Execute performs a non-streaming exec.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rresult, err := hj.Right.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	ht := newHashJoinTable(hj.RHSKeys)
+	for _, rrow := range rresult.Rows {
+		if err := ht.add(vcursor, rrow); err != nil {
+			return nil, err
+		}
+	}
+
+	lresult, err := hj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+	for _, lrow := range lresult.Rows {
+		rows, err := hj.probe(ht, lrow)
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var rfields []*querypb.Field
+	ht := newHashJoinTable(hj.RHSKeys)
+	err := hj.Right.StreamExecute(vcursor, bindVars, wantfields, func(rresult *sqltypes.Result) error {
+		if rresult.Fields != nil {
+			rfields = rresult.Fields
+		}
+		for _, rrow := range rresult.Rows {
+			if err := ht.add(vcursor, rrow); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if wantfields && lresult.Fields != nil {
+			wantfields = false
+			if rfields == nil {
+				rresult, err := hj.Right.GetFields(vcursor, bindVars)
+				if err != nil {
+					return err
+				}
+				rfields = rresult.Fields
+			}
+			result.Fields = joinFields(lresult.Fields, rfields, hj.Cols)
+		}
+		for _, lrow := range lresult.Rows {
+			rows, err := hj.probe(ht, lrow)
+			if err != nil {
+				return err
+			}
+			result.Rows = append(result.Rows, rows...)
+		}
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+}
+
+// probe returns the joined rows produced by a single LHS row.
+func (hj *HashJoin) probe(ht *hashJoinTable, lrow []sqltypes.Value) ([][]sqltypes.Value, error) {
+	matches, err := ht.matches(lrow, hj.LHSKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		if hj.Opcode == LeftJoin {
+			return [][]sqltypes.Value{joinRows(lrow, nil, hj.Cols)}, nil
+		}
+		return nil, nil
+	}
+	rows := make([][]sqltypes.Value, 0, len(matches))
+	for _, rrow := range matches {
+		rows = append(rows, joinRows(lrow, rrow, hj.Cols))
+	}
+	return rows, nil
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (hj *HashJoin) NeedsTransaction() bool {
+	return hj.Right.NeedsTransaction() || hj.Left.NeedsTransaction()
+}
+
+func (hj *HashJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":         hj.GetTableName(),
+		"JoinColumnIndexes": intsToString(hj.Cols),
+		"LHSKeys":           intsToString(hj.LHSKeys),
+		"RHSKeys":           intsToString(hj.RHSKeys),
+	}
+	return PrimitiveDescription{
+		OperatorType: "Join",
+		Variant:      "Hash" + hj.Opcode.String(),
+		Other:        other,
+	}
+}
+
+func intsToString(ints []int) string {
+	return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ints)), ","), "[]")
+}
+
+// hashJoinTable holds the rows of the RHS of a hash join,
+// grouped by the hashcode of their join keys.
+type hashJoinTable struct {
+	keys []int
+	m    map[int64][]row
+	// size is the estimated number of bytes used by the rows in the table.
+	size int64
+}
+
+func newHashJoinTable(keys []int) *hashJoinTable {
+	return &hashJoinTable{keys: keys, m: map[int64][]row{}}
+}
+
+// add inserts a row in the table. Rows that have a NULL join key
+// can never match, and are not kept.
+func (ht *hashJoinTable) add(vcursor VCursor, r row) error {
+	code, null, err := hashJoinKeys(r, ht.keys)
+	if err != nil || null {
+		return err
+	}
+	ht.m[code] = append(ht.m[code], r)
+	for _, value := range r {
+		ht.size += value.CachedSize(true)
+	}
+	if limit := vcursor.MaxHashJoinMemory(); ht.size > limit {
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "hash join table size exceeded allowed limit of %d bytes", limit)
+	}
+	return nil
+}
+
+// matches returns the rows of the table whose join keys are equal
+// to the values found at the given offsets of the input row.
+func (ht *hashJoinTable) matches(r row, keys []int) ([]row, error) {
+	code, null, err := hashJoinKeys(r, keys)
+	if err != nil || null {
+		return nil, err
+	}
+	var result []row
+	for _, candidate := range ht.m[code] {
+		// the hashcode can collide, so the values still need to be compared
+		equal, err := joinKeysEqual(r, keys, candidate, ht.keys)
+		if err != nil {
+			return nil, err
+		}
+		if equal {
+			result = append(result, candidate)
+		}
+	}
+	return result, nil
+}
+
+// hashJoinKeys calculates the hashcode of the join keys of a row.
+// It also returns true if any of the keys is NULL.
+func hashJoinKeys(r row, keys []int) (int64, bool, error) {
+	code := int64(17)
+	for _, key := range keys {
+		if r[key].IsNull() {
+			return 0, true, nil
+		}
+		hashcode, err := evalengine.NullsafeHashcode(r[key])
+		if err != nil {
+			return 0, false, err
+		}
+		code = code*31 + hashcode
+	}
+	return code, false, nil
+}
+
+func joinKeysEqual(a row, aKeys []int, b row, bKeys []int) (bool, error) {
+	for i, key := range aKeys {
+		cmp, err := evalengine.NullsafeCompare(a[key], b[bKeys[i]])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
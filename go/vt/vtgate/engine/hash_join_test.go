@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+func hashJoinInputs() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+				"null|d",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"3|x",
+				"1|y",
+				"3|z",
+				"null|w",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := hashJoinInputs()
+	hj := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{`Execute  true`})
+	rightPrim.ExpectLog(t, []string{`Execute  true`})
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|varchar",
+		),
+		"1|a|y",
+		"3|c|x",
+		"3|c|z",
+	))
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Opcode = LeftJoin
+	r, err = hj.Execute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|varchar",
+		),
+		"1|a|y",
+		"2|b|null",
+		"3|c|x",
+		"3|c|z",
+		"null|d|null",
+	))
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := hashJoinInputs()
+	hj := &HashJoin{
+		Opcode:  LeftJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{2, -1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := wrapStreamExecute(hj, &noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{`StreamExecute  true`})
+	rightPrim.ExpectLog(t, []string{`StreamExecute  true`})
+	expectResult(t, "hj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col4|col1",
+			"varchar|int64",
+		),
+		"y|1",
+		"null|2",
+		"x|3",
+		"z|3",
+		"null|null",
+	))
+}
+
+func TestHashJoinMultipleKeys(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("a|b", "int64|float64"),
+				"1|1",
+				"1|2",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("c|d|e", "varchar|int64|uint64"),
+				"x|1|1",
+				"y|2|1",
+				"z|1|2",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 1},
+		LHSKeys: []int{0, 1},
+		RHSKeys: []int{2, 1},
+	}
+	r, err := hj.Execute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("a|b|c", "int64|float64|varchar"),
+		"1|1|x",
+		"1|2|y",
+	))
+}
+
+func TestHashJoinMemoryLimit(t *testing.T) {
+	defer func(limit int64) { testMaxHashJoinMemory = limit }(testMaxHashJoinMemory)
+	testMaxHashJoinMemory = 100
+
+	leftPrim, rightPrim := hashJoinInputs()
+	hj := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	_, err := hj.Execute(&noopVCursor{}, nil, true)
+	require.EqualError(t, err, "hash join table size exceeded allowed limit of 100 bytes")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	// the LHS is not executed if the RHS does not fit in memory
+	leftPrim.ExpectLog(t, nil)
+}
+
+func TestHashJoinUnsupportedKeyType(t *testing.T) {
+	leftPrim, rightPrim := hashJoinInputs()
+	hj := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{1},
+		RHSKeys: []int{1},
+	}
+	_, err := hj.Execute(&noopVCursor{}, nil, true)
+	require.EqualError(t, err, "types does not support hashcode yet: VARCHAR")
+}
+
+func TestHashJoinDescription(t *testing.T) {
+	hj := &HashJoin{
+		Opcode:  LeftJoin,
+		Left:    &fakePrimitive{},
+		Right:   &fakePrimitive{},
+		Cols:    []int{-1, 2},
+		LHSKeys: []int{1, 0},
+		RHSKeys: []int{0, 3},
+	}
+	want := PrimitiveDescription{
+		OperatorType: "Join",
+		Variant:      "HashLeftJoin",
+		Other: map[string]interface{}{
+			"TableName":         "fakeTable_fakeTable",
+			"JoinColumnIndexes": "-1,2",
+			"LHSKeys":           "1,0",
+			"RHSKeys":           "0,3",
+		},
+	}
+	assert.Equal(t, want, hj.description())
+}
@@ -38,6 +38,10 @@ type MStream struct {
 	// TableName specifies the table on which stream will be executed.
 	TableName string
 
+	// ConsumerGroup specifies the consumer group to stream the messages of.
+	// If empty, the messages are streamed to the regular subscribers.
+	ConsumerGroup string
+
 	noTxNeeded
 
 	noInputs
@@ -69,7 +73,7 @@ func (m *MStream) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.Bi
 	if err != nil {
 		return err
 	}
-	return vcursor.MessageStream(rss, m.TableName, callback)
+	return vcursor.MessageStream(rss, m.TableName, m.ConsumerGroup, callback)
 }
 
 // GetFields implements the Primitive interface
@@ -78,11 +82,15 @@ func (m *MStream) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVa
 }
 
 func (m *MStream) description() PrimitiveDescription {
+	other := map[string]interface{}{"Table": m.TableName}
+	if m.ConsumerGroup != "" {
+		other["ConsumerGroup"] = m.ConsumerGroup
+	}
 	return PrimitiveDescription{
 		OperatorType:      "MStream",
 		Keyspace:          m.Keyspace,
 		TargetDestination: m.TargetDestination,
 
-		Other: map[string]interface{}{"Table": m.TableName},
+		Other: other,
 	}
 }
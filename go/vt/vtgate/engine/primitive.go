@@ -61,6 +61,10 @@ type (
 		// if the max memory rows override directive is set to true
 		ExceedsMaxMemoryRows(numRows int) bool
 
+		// MaxHashJoinMemory returns the maximum number of bytes
+		// a hash join can use for the rows it keeps in memory.
+		MaxHashJoinMemory() int64
+
 		// SetContextTimeout updates the context and sets a timeout.
 		SetContextTimeout(timeout time.Duration) context.CancelFunc
 
@@ -103,7 +107,7 @@ type (
 		// KeyspaceAvailable returns true when a keyspace is visible from vtgate
 		KeyspaceAvailable(ks string) bool
 
-		MessageStream(rss []*srvtopo.ResolvedShard, tableName string, callback func(*sqltypes.Result) error) error
+		MessageStream(rss []*srvtopo.ResolvedShard, tableName, consumerGroup string, callback func(*sqltypes.Result) error) error
 
 		VStream(rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error
 	}
@@ -167,6 +171,8 @@ type (
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
 		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
 
+		ResultCacheTables []string // ResultCacheTables are the keyspace qualified tables read by a select whose results can be cached
+
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
 		ShardQueries uint64 // Total number of shard queries
@@ -73,8 +73,10 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a multi-column vindex, there is one value per column,
+	// in the order of the vindex columns.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -186,6 +188,11 @@ const (
 	SelectReference
 	// SelectNone is used for queries that always return empty values
 	SelectNone
+	// SelectSubShard is for routing a query to the shards of the
+	// key range given by the leading columns of a multi-column
+	// vindex. Requires: A MultiColumn Vindex, and one Value per
+	// leading column.
+	SelectSubShard
 	// NumRouteOpcodes is the number of opcodes
 	NumRouteOpcodes
 )
@@ -201,6 +208,7 @@ var routeName = map[RouteOpcode]string{
 	SelectDBA:         "SelectDBA",
 	SelectReference:   "SelectReference",
 	SelectNone:        "SelectNone",
+	SelectSubShard:    "SelectSubShard",
 }
 
 var (
@@ -257,7 +265,7 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
 	case SelectScatter:
 		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
-	case SelectEqual, SelectEqualUnique:
+	case SelectEqual, SelectEqualUnique, SelectSubShard:
 		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
 	case SelectIN:
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
@@ -331,7 +339,7 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
 	case SelectScatter:
 		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
-	case SelectEqual, SelectEqualUnique:
+	case SelectEqual, SelectEqualUnique, SelectSubShard:
 		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
 	case SelectIN:
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
@@ -568,11 +576,21 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	key, err := route.Values[0].ResolveValue(bindVars)
-	if err != nil {
-		return nil, nil, err
+	keys := make([]sqltypes.Value, 0, len(route.Values))
+	for _, pv := range route.Values {
+		key, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+	}
+	var rss []*srvtopo.ResolvedShard
+	var err error
+	if _, ok := route.Vindex.(vindexes.MultiColumn); ok {
+		rss, err = resolveMultiColumnShards(vcursor, route.Vindex, route.Keyspace, [][]sqltypes.Value{keys})
+	} else {
+		rss, _, err = resolveShards(vcursor, route.Vindex, route.Keyspace, keys[:1])
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -611,15 +629,17 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
-func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+func resolveShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
+	rowsColValues := make([][]sqltypes.Value, len(vindexKeys))
 	for i, vik := range vindexKeys {
 		ids[i] = sqltypes.ValueToProto(vik)
+		rowsColValues[i] = []sqltypes.Value{vik}
 	}
 
 	// Map using the Vindex
-	destinations, err := vindex.Map(vcursor, vindexKeys)
+	destinations, err := vindexes.Map(vindex, vcursor, rowsColValues)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -628,6 +648,18 @@ func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vind
 	return vcursor.ResolveDestinations(keyspace.Name, ids, destinations)
 }
 
+// resolveMultiColumnShards resolves the shards of rows of values of the
+// columns of a multi-column vindex. A row that only has values for the
+// leading columns resolves to the shards of a key range.
+func resolveMultiColumnShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, rowsColValues [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, rowsColValues)
+	if err != nil {
+		return nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, destinations)
+	return rss, err
+}
+
 func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	var err error
 	// Since Result is immutable, we make a copy.
@@ -668,8 +700,8 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	return out, err
 }
 
-func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveSingleShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -692,8 +724,12 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace
 	return rss[0], ksid, nil
 }
 
-func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
-	destinations, err := vindex.Map(vcursor, vindexKey)
+func resolveMultiShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
+	rowsColValues := make([][]sqltypes.Value, len(vindexKey))
+	for i, vik := range vindexKey {
+		rowsColValues[i] = []sqltypes.Value{vik}
+	}
+	destinations, err := vindexes.Map(vindex, vcursor, rowsColValues)
 	if err != nil {
 		return nil, err
 	}
@@ -704,8 +740,8 @@ func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *
 	return rss, nil
 }
 
-func resolveKeyspaceID(vcursor VCursor, vindex vindexes.SingleColumn, vindexKey sqltypes.Value) ([]byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveKeyspaceID(vcursor VCursor, vindex vindexes.Vindex, vindexKey []sqltypes.Value) ([]byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, err
 	}
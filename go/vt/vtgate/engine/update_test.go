@@ -204,6 +204,7 @@ func TestUpdateEqualChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
@@ -343,6 +344,7 @@ func TestUpdateScatterChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
@@ -484,6 +486,7 @@ func TestUpdateInChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
@@ -0,0 +1,540 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that evaluates window functions at the vtgate level.
+// It expects the underlying primitive to feed results sorted by the
+// PartitionBy columns, followed by the OrderBy columns. All the window
+// functions share the same window: the rows of a partition, up to and
+// including the peers of the current row if OrderBy is set.
+type Window struct {
+	// PartitionBy specifies the input columns that split the rows into partitions.
+	PartitionBy []OrderbyParams `json:",omitempty"`
+	// OrderBy specifies the input columns that order the rows of a partition.
+	// Rows that are equal on all these columns are peers.
+	OrderBy []OrderbyParams `json:",omitempty"`
+	// Functions specifies the window functions to evaluate.
+	Functions []*WindowFunc
+	// Cols defines which columns from the input, or from the
+	// window functions are used to build the result.
+	// A negative value refers to an input column: -1 is the first column.
+	// A positive value refers to a window function: 1 is the first function.
+	Cols []int
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowFunc specifies the parameters of a window function.
+type WindowFunc struct {
+	Opcode WindowOpcode
+	// Col is the input column of the first argument of the function.
+	// It is -1 for functions without arguments, and for count(*).
+	Col int
+	// N is the integer argument of the function: the offset for LAG and LEAD,
+	// the number of buckets for NTILE and the row number for NTH_VALUE.
+	// It is a PlanValue because the literal may have been normalized
+	// into a bind variable.
+	N sqltypes.PlanValue
+	// Default is the value returned by LAG and LEAD when the offset
+	// is outside of the partition.
+	Default sqltypes.PlanValue
+	// Alias is the name of the result column.
+	Alias string `json:",omitempty"`
+}
+
+func (wf *WindowFunc) String() string {
+	var args []string
+	switch {
+	case wf.Col != -1:
+		args = append(args, fmt.Sprintf("%d", wf.Col))
+	case wf.Opcode == WindowCount:
+		args = append(args, "*")
+	}
+	for _, pv := range []sqltypes.PlanValue{wf.N, wf.Default} {
+		switch {
+		case pv.Key != "":
+			args = append(args, ":"+pv.Key)
+		case !pv.Value.IsNull():
+			var buf strings.Builder
+			pv.Value.EncodeSQL(&buf)
+			args = append(args, buf.String())
+		}
+	}
+	return fmt.Sprintf("%s(%s)", wf.Opcode.String(), strings.Join(args, ", "))
+}
+
+// windowArgs are the arguments of a window function, resolved for one execution.
+type windowArgs struct {
+	n   int64
+	def sqltypes.Value
+}
+
+func (w *Window) resolveArgs(bindVars map[string]*querypb.BindVariable) ([]windowArgs, error) {
+	args := make([]windowArgs, len(w.Functions))
+	for i, wf := range w.Functions {
+		if !wf.N.IsNull() {
+			resolved, err := wf.N.ResolveValue(bindVars)
+			if err != nil {
+				return nil, err
+			}
+			args[i].n, err = evalengine.ToInt64(resolved)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !wf.Default.IsNull() {
+			var err error
+			args[i].def, err = wf.Default.ResolveValue(bindVars)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return args, nil
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowPercentRank
+	WindowCumeDist
+	WindowNtile
+	WindowLag
+	WindowLead
+	WindowFirstValue
+	WindowLastValue
+	WindowNthValue
+	WindowCount
+	WindowSum
+	WindowMin
+	WindowMax
+)
+
+// SupportedWindowFunctions maps the list of window
+// functions that vtgate can evaluate to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number":   WindowRowNumber,
+	"rank":         WindowRank,
+	"dense_rank":   WindowDenseRank,
+	"percent_rank": WindowPercentRank,
+	"cume_dist":    WindowCumeDist,
+	"ntile":        WindowNtile,
+	"lag":          WindowLag,
+	"lead":         WindowLead,
+	"first_value":  WindowFirstValue,
+	"last_value":   WindowLastValue,
+	"nth_value":    WindowNthValue,
+	"count":        WindowCount,
+	"sum":          WindowSum,
+	"min":          WindowMin,
+	"max":          WindowMax,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// Execute is a Primitive function.
+func (w *Window) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	args, err := w.resolveArgs(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	result, err := w.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Rows: make([][]sqltypes.Value, 0, len(result.Rows)),
+	}
+	if result.Fields != nil {
+		out.Fields = w.convertFields(result.Fields)
+	}
+
+	start := 0
+	for i := 1; i <= len(result.Rows); i++ {
+		if i < len(result.Rows) {
+			same, err := rowsEqual(w.PartitionBy, result.Rows[start], result.Rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if same {
+				continue
+			}
+		}
+		rows, err := w.evalPartition(result.Rows[start:i], args)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, rows...)
+		start = i
+	}
+	return out, nil
+}
+
+// StreamExecute is a Primitive function.
+func (w *Window) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	args, err := w.resolveArgs(bindVars)
+	if err != nil {
+		return err
+	}
+	var fields []*querypb.Field
+	var partition [][]sqltypes.Value
+
+	flush := func() error {
+		if len(partition) == 0 {
+			return nil
+		}
+		rows, err := w.evalPartition(partition, args)
+		if err != nil {
+			return err
+		}
+		partition = nil
+		return callback(&sqltypes.Result{Rows: rows})
+	}
+
+	err = w.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+			if err := callback(&sqltypes.Result{Fields: w.convertFields(fields)}); err != nil {
+				return err
+			}
+		}
+		for _, row := range qr.Rows {
+			if len(partition) > 0 {
+				same, err := rowsEqual(w.PartitionBy, partition[0], row)
+				if err != nil {
+					return err
+				}
+				if !same {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+			partition = append(partition, row)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// GetFields is a Primitive function.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: w.convertFields(qr.Fields)}, nil
+}
+
+// Inputs returns the Primitive input for this window.
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	funcFields := make([]*querypb.Field, len(w.Functions))
+	for i, wf := range w.Functions {
+		var typ querypb.Type
+		switch wf.Opcode {
+		case WindowRowNumber, WindowRank, WindowDenseRank, WindowNtile, WindowCount:
+			typ = sqltypes.Int64
+		case WindowPercentRank, WindowCumeDist:
+			typ = sqltypes.Float64
+		case WindowSum:
+			typ = sumType(fields[wf.Col].Type)
+		default:
+			typ = fields[wf.Col].Type
+		}
+		funcFields[i] = &querypb.Field{Name: wf.Alias, Type: typ}
+	}
+	return joinFields(fields, funcFields, w.Cols)
+}
+
+func sumType(typ querypb.Type) querypb.Type {
+	if sqltypes.IsFloat(typ) {
+		return sqltypes.Float64
+	}
+	return sqltypes.Decimal
+}
+
+// evalPartition evaluates the window functions over the rows of one partition.
+func (w *Window) evalPartition(rows [][]sqltypes.Value, args []windowArgs) ([][]sqltypes.Value, error) {
+	// peerStart and peerEnd are the first and last rows of the peer group of each row.
+	peerStart := make([]int, len(rows))
+	peerEnd := make([]int, len(rows))
+	start := 0
+	for i := 1; i <= len(rows); i++ {
+		if i < len(rows) {
+			same, err := rowsEqual(w.OrderBy, rows[start], rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if same {
+				continue
+			}
+		}
+		for j := start; j < i; j++ {
+			peerStart[j] = start
+			peerEnd[j] = i - 1
+		}
+		start = i
+	}
+
+	results := make([][]sqltypes.Value, len(rows))
+	for i := range results {
+		results[i] = make([]sqltypes.Value, len(w.Functions))
+	}
+	for f, wf := range w.Functions {
+		if err := wf.eval(rows, args[f], peerStart, peerEnd, func(i int, v sqltypes.Value) { results[i][f] = v }); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([][]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		out[i] = joinRows(row, results[i], w.Cols)
+	}
+	return out, nil
+}
+
+func (wf *WindowFunc) eval(rows [][]sqltypes.Value, args windowArgs, peerStart, peerEnd []int, set func(int, sqltypes.Value)) error {
+	count := len(rows)
+	switch wf.Opcode {
+	case WindowRowNumber:
+		for i := range rows {
+			set(i, sqltypes.NewInt64(int64(i+1)))
+		}
+	case WindowRank:
+		for i := range rows {
+			set(i, sqltypes.NewInt64(int64(peerStart[i]+1)))
+		}
+	case WindowDenseRank:
+		rank := int64(0)
+		for i := range rows {
+			if peerStart[i] == i {
+				rank++
+			}
+			set(i, sqltypes.NewInt64(rank))
+		}
+	case WindowPercentRank:
+		for i := range rows {
+			rank := float64(0)
+			if count > 1 {
+				rank = float64(peerStart[i]) / float64(count-1)
+			}
+			set(i, sqltypes.NewFloat64(rank))
+		}
+	case WindowCumeDist:
+		for i := range rows {
+			set(i, sqltypes.NewFloat64(float64(peerEnd[i]+1)/float64(count)))
+		}
+	case WindowNtile:
+		if args.n <= 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to ntile")
+		}
+		// The first count%N buckets get one more row than the others.
+		size, rem := int64(count)/args.n, int64(count)%args.n
+		for i := range rows {
+			pos := int64(i)
+			var bucket int64
+			if pos < rem*(size+1) {
+				bucket = pos / (size + 1)
+			} else {
+				bucket = rem + (pos-rem*(size+1))/size
+			}
+			set(i, sqltypes.NewInt64(bucket+1))
+		}
+	case WindowLag, WindowLead:
+		if args.n < 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to %s", wf.Opcode.String())
+		}
+		offset := int(args.n)
+		if wf.Opcode == WindowLag {
+			offset = -offset
+		}
+		for i := range rows {
+			j := i + offset
+			if j < 0 || j >= count {
+				set(i, args.def)
+				continue
+			}
+			set(i, rows[j][wf.Col])
+		}
+	case WindowFirstValue:
+		for i := range rows {
+			set(i, rows[0][wf.Col])
+		}
+	case WindowLastValue:
+		for i := range rows {
+			set(i, rows[peerEnd[i]][wf.Col])
+		}
+	case WindowNthValue:
+		if args.n <= 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to nth_value")
+		}
+		n := int(args.n) - 1
+		for i := range rows {
+			if n > peerEnd[i] {
+				set(i, sqltypes.NULL)
+				continue
+			}
+			set(i, rows[n][wf.Col])
+		}
+	case WindowCount, WindowSum, WindowMin, WindowMax:
+		// The frame of a row ends with its last peer, so the value
+		// is accumulated one peer group at a time.
+		acc := sqltypes.NULL
+		if wf.Opcode == WindowCount {
+			acc = countZero
+		}
+		for i := range rows {
+			if peerStart[i] == i {
+				for j := i; j <= peerEnd[i]; j++ {
+					var err error
+					acc, err = wf.accumulate(acc, rows[j])
+					if err != nil {
+						return err
+					}
+				}
+			}
+			set(i, acc)
+		}
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected window function opcode: %v", wf.Opcode)
+	}
+	return nil
+}
+
+func (wf *WindowFunc) accumulate(acc sqltypes.Value, row []sqltypes.Value) (sqltypes.Value, error) {
+	if wf.Opcode == WindowCount {
+		if wf.Col != -1 && row[wf.Col].IsNull() {
+			return acc, nil
+		}
+		return evalengine.NullsafeAdd(acc, countOne, sqltypes.Int64), nil
+	}
+	value := row[wf.Col]
+	if value.IsNull() {
+		return acc, nil
+	}
+	switch wf.Opcode {
+	case WindowSum:
+		// The type of the sum comes from the value, not from the fields:
+		// they are not returned by the input if they're not wanted.
+		return evalengine.NullsafeAdd(acc, value, sumType(value.Type())), nil
+	case WindowMin:
+		return evalengine.Min(acc, value)
+	default:
+		return evalengine.Max(acc, value)
+	}
+}
+
+// rowsEqual returns true if the two rows are equal on all the given columns.
+// The weight_string of a column is compared instead of the column itself if
+// it is available, so that rows that only differ by case or trailing spaces,
+// and which were therefore sorted together, are also grouped together.
+func rowsEqual(params []OrderbyParams, row1, row2 []sqltypes.Value) (bool, error) {
+	for _, param := range params {
+		col := param.Col
+		if param.WeightStringCol != -1 {
+			col = param.WeightStringCol
+		}
+		cmp, err := evalengine.NullsafeCompare(row1[col], row2[col])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func windowFuncToString(in interface{}) string {
+	return in.(*WindowFunc).String()
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Functions":     GenericJoin(w.Functions, windowFuncToString),
+		"ColumnIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(w.Cols)), ","), "[]"),
+	}
+	if len(w.PartitionBy) > 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, orderByParamsToString)
+	}
+	if len(w.OrderBy) > 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, orderByParamsToString)
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
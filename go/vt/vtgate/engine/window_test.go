@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestWindowExecutePartitions(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"p|o|v",
+				"int64|int64|int64",
+			),
+			"1|1|10",
+			"1|2|20",
+			"1|2|30",
+			"1|3|null",
+			"2|1|5",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []OrderbyParams{{Col: 0, WeightStringCol: -1}},
+		OrderBy:     []OrderbyParams{{Col: 1, WeightStringCol: -1}},
+		Functions: []*WindowFunc{
+			{Opcode: WindowRowNumber, Col: -1, Alias: "rn"},
+			{Opcode: WindowRank, Col: -1, Alias: "r"},
+			{Opcode: WindowDenseRank, Col: -1, Alias: "dr"},
+			{Opcode: WindowCount, Col: -1, Alias: "c"},
+			{Opcode: WindowSum, Col: 2, Alias: "s"},
+			{Opcode: WindowLag, Col: 2, N: sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}, Default: sqltypes.PlanValue{Key: "vtg1"}, Alias: "l"},
+		},
+		Cols:  []int{-1, -2, 1, 2, 3, 4, 5, 6},
+		Input: fp,
+	}
+
+	result, err := w.Execute(nil, map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(0)}, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"p|o|rn|r|dr|c|s|l",
+			"int64|int64|int64|int64|int64|int64|decimal|int64",
+		),
+		"1|1|1|1|1|1|10|0",
+		"1|2|2|2|2|3|60|10",
+		"1|2|3|2|2|3|60|20",
+		"1|3|4|4|3|4|60|30",
+		"2|1|1|1|1|1|5|0",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowExecuteNoFields(t *testing.T) {
+	input := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"o|i|f",
+			"int64|int64|float64",
+		),
+		"1|10|1.5",
+		"2|20|2.5",
+	)
+	// The input doesn't return the fields if they're not wanted.
+	input.Fields = nil
+	w := &Window{
+		OrderBy: []OrderbyParams{{Col: 0, WeightStringCol: -1}},
+		Functions: []*WindowFunc{
+			{Opcode: WindowSum, Col: 1, Alias: "si"},
+			{Opcode: WindowSum, Col: 2, Alias: "sf"},
+		},
+		Cols: []int{-1, 1, 2},
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"o|si|sf",
+			"int64|decimal|float64",
+		),
+		"1|10|1.5",
+		"2|30|4",
+	)
+	wantResult.Fields = nil
+
+	w.Input = &fakePrimitive{results: []*sqltypes.Result{input}}
+	result, err := w.Execute(nil, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+
+	w.Input = &fakePrimitive{results: []*sqltypes.Result{input}}
+	result, err = wrapStreamExecute(w, nil, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowExecuteFrame(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"o|v",
+				"int64|varchar",
+			),
+			"1|a",
+			"2|b",
+			"2|c",
+			"3|d",
+			"4|e",
+		)},
+	}
+
+	w := &Window{
+		OrderBy: []OrderbyParams{{Col: 0, WeightStringCol: -1}},
+		Functions: []*WindowFunc{
+			{Opcode: WindowPercentRank, Col: -1, Alias: "pr"},
+			{Opcode: WindowCumeDist, Col: -1, Alias: "cd"},
+			{Opcode: WindowNtile, Col: -1, N: sqltypes.PlanValue{Value: sqltypes.NewInt64(3)}, Alias: "nt"},
+			{Opcode: WindowFirstValue, Col: 1, Alias: "fv"},
+			{Opcode: WindowLastValue, Col: 1, Alias: "lv"},
+			{Opcode: WindowNthValue, Col: 1, N: sqltypes.PlanValue{Value: sqltypes.NewInt64(2)}, Alias: "nv"},
+			{Opcode: WindowLead, Col: 1, N: sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}, Alias: "ld"},
+			{Opcode: WindowMax, Col: 0, Alias: "mx"},
+		},
+		Cols:  []int{-2, 1, 2, 3, 4, 5, 6, 7, 8},
+		Input: fp,
+	}
+
+	result, err := w.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"v|pr|cd|nt|fv|lv|nv|ld|mx",
+			"varchar|float64|float64|int64|varchar|varchar|varchar|varchar|int64",
+		),
+		"a|0|0.2|1|a|a|null|b|1",
+		"b|0.25|0.6|1|a|c|b|c|2",
+		"c|0.25|0.6|2|a|c|b|d|2",
+		"d|0.75|0.8|2|a|d|b|e|3",
+		"e|1|1|3|a|e|b|null|4",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowStreamExecuteWeightString(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|weight_string(col)",
+				"varchar|varbinary",
+			),
+			"a|A",
+			"A|A",
+			"b|B",
+			"C|C",
+			"c|C",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []OrderbyParams{{Col: 0, WeightStringCol: 1}},
+		Functions: []*WindowFunc{
+			{Opcode: WindowCount, Col: 0, Alias: "count(col) over (partition by col)"},
+		},
+		Cols:  []int{-1, 1},
+		Input: fp,
+	}
+
+	result, err := wrapStreamExecute(w, nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(col) over (partition by col)",
+			"varchar|int64",
+		),
+		"a|2",
+		"A|2",
+		"b|1",
+		"C|2",
+		"c|2",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowDescription(t *testing.T) {
+	w := &Window{
+		PartitionBy: []OrderbyParams{{Col: 0, WeightStringCol: 2}},
+		OrderBy:     []OrderbyParams{{Col: 1, WeightStringCol: -1, Desc: true}},
+		Functions: []*WindowFunc{
+			{Opcode: WindowRank, Col: -1},
+			{Opcode: WindowLag, Col: 1, N: sqltypes.PlanValue{Value: sqltypes.NewInt64(2)}, Default: sqltypes.PlanValue{Key: "vtg1"}},
+		},
+		Cols:  []int{-1, 1, 2},
+		Input: &fakePrimitive{},
+	}
+
+	want := PrimitiveDescription{
+		OperatorType: "Window",
+		Other: map[string]interface{}{
+			"Functions":     "rank(), lag(1, 2, :vtg1)",
+			"ColumnIndexes": "-1,1,2",
+			"PartitionBy":   "0 ASC",
+			"OrderBy":       "1 DESC",
+		},
+	}
+	assert.Equal(t, want, w.description())
+}
+
+func TestWindowGetFields(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"a|b",
+				"float64|varchar",
+			),
+		)},
+	}
+
+	w := &Window{
+		Functions: []*WindowFunc{
+			{Opcode: WindowSum, Col: 0, Alias: "s"},
+			{Opcode: WindowMin, Col: 1, Alias: "m"},
+		},
+		Cols:  []int{2, -2, 1},
+		Input: fp,
+	}
+
+	result, err := w.GetFields(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(sqltypes.MakeTestFields("m|b|s", "varchar|varchar|float64")), result)
+}
+
+func TestWindowNtileError(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("a", "int64"),
+			"1",
+		)},
+	}
+
+	w := &Window{
+		Functions: []*WindowFunc{{Opcode: WindowNtile, Col: -1, N: sqltypes.PlanValue{Value: sqltypes.NewInt64(0)}, Alias: "n"}},
+		Cols:      []int{1},
+		Input:     fp,
+	}
+
+	_, err := w.Execute(nil, nil, false)
+	require.EqualError(t, err, "Incorrect arguments to ntile")
+}
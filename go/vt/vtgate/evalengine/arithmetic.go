@@ -406,12 +406,19 @@ func makeNumeric(v EvalResult) EvalResult {
 	if sqltypes.IsNumber(v.typ) {
 		return v
 	}
+	if isTemporalType(v.typ) {
+		return temporalToNumeric(v)
+	}
 	if ival, err := strconv.ParseInt(string(v.bytes), 10, 64); err == nil {
 		return EvalResult{ival: ival, typ: sqltypes.Int64}
 	}
 	if fval, err := strconv.ParseFloat(string(v.bytes), 64); err == nil {
 		return EvalResult{fval: fval, typ: sqltypes.Float64}
 	}
+	// strings that are not numbers are converted using their numeric prefix
+	if fval := parseFloatPrefix(v.bytes); fval != 0 {
+		return EvalResult{fval: fval, typ: sqltypes.Float64}
+	}
 	return EvalResult{ival: 0, typ: sqltypes.Int64}
 }
 
@@ -564,10 +571,7 @@ func floatDivideAnyWithError(v1 float64, v2 EvalResult) (EvalResult, error) {
 		v2.fval = float64(v2.uval)
 	}
 	result := v1 / v2.fval
-	divisorLessThanOne := v2.fval < 1
-	resultMismatch := v2.fval*result != v1
-
-	if divisorLessThanOne && resultMismatch {
+	if math.IsInf(result, 0) {
 		return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "%s value is out of range in %v / %v", "BIGINT", v1, v2.fval)
 	}
 
@@ -55,6 +55,68 @@ func (cached *BindVariable) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.Key))
 	return size
 }
+func (cached *CallExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field Arguments []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += int64(cap(cached.Arguments)) * int64(16)
+		for _, elem := range cached.Arguments {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field builtin *vitess.io/vitess/go/vt/vtgate/evalengine.builtin
+	size += cached.builtin.CachedSize(true)
+	return size
+}
+func (cached *CaseExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Base vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Base.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Whens []vitess.io/vitess/go/vt/vtgate/evalengine.WhenThen
+	{
+		size += int64(cap(cached.Whens)) * int64(32)
+		for _, elem := range cached.Whens {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Else vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Else.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CollateExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Inner vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Inner.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Column) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -65,6 +127,42 @@ func (cached *Column) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ConvertExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Inner vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Inner.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Target string
+	size += int64(len(cached.Target))
+	return size
+}
+func (cached *DateAdd) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Date vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Date.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Interval vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Interval.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Unit string
+	size += int64(len(cached.Unit))
+	return size
+}
 func (cached *EvalResult) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -77,6 +175,53 @@ func (cached *EvalResult) CachedSize(alloc bool) int64 {
 	size += int64(cap(cached.bytes))
 	return size
 }
+func (cached *InExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += int64(cap(cached.Right)) * int64(16)
+		for _, elem := range cached.Right {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *IsExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Inner vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Inner.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *Like) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	return size
+}
 func (cached *Literal) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -89,3 +234,69 @@ func (cached *Literal) CachedSize(alloc bool) int64 {
 	size += cached.Val.CachedSize(false)
 	return size
 }
+func (cached *TimestampDiff) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Unit string
+	size += int64(len(cached.Unit))
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *UnaryOp) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Expr vitess.io/vitess/go/vt/vtgate/evalengine.UnaryExpr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Inner vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Inner.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *WhenThen) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field When vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.When.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Then vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Then.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *builtin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	return size
+}
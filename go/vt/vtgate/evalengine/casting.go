@@ -17,9 +17,14 @@ limitations under the License.
 package evalengine
 
 import (
+	"bytes"
+	"math"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -56,3 +61,316 @@ func (e *EvalResult) ToBooleanStrict() (bool, error) {
 	}
 	return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "is not a boolean")
 }
+
+// resultNull is the result of any expression that evaluates to NULL
+var resultNull = EvalResult{typ: sqltypes.Null}
+
+func newEvalInt64(i int64) EvalResult {
+	return EvalResult{typ: sqltypes.Int64, ival: i}
+}
+
+func newEvalUint64(u uint64) EvalResult {
+	return EvalResult{typ: sqltypes.Uint64, uval: u}
+}
+
+func newEvalFloat(f float64) EvalResult {
+	return EvalResult{typ: sqltypes.Float64, fval: f}
+}
+
+func newEvalBool(b bool) EvalResult {
+	if b {
+		return newEvalInt64(1)
+	}
+	return newEvalInt64(0)
+}
+
+// newEvalString returns a string value with the given collation. Strings always
+// have the VARBINARY type, and are told apart from binary strings by their collation.
+func newEvalString(b []byte, coll collationID, coercion coercibility) EvalResult {
+	return EvalResult{typ: sqltypes.VarBinary, collation: coll, coercion: coercion, bytes: b}
+}
+
+// isTemporalType returns true for the MySQL types that hold dates and times
+func isTemporalType(typ querypb.Type) bool {
+	switch typ {
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time:
+		return true
+	}
+	return false
+}
+
+// isStringType returns true for the types that are compared as strings
+func isStringType(typ querypb.Type) bool {
+	return (sqltypes.IsQuoted(typ) && !isTemporalType(typ)) || typ == sqltypes.Bit
+}
+
+func (e EvalResult) isNull() bool {
+	return e.typ == sqltypes.Null
+}
+
+func (e EvalResult) isIntegral() bool {
+	return e.typ == sqltypes.Int64 || e.typ == sqltypes.Uint64
+}
+
+// toStringResult returns the value converted to a string, the way MySQL
+// converts the arguments of string functions.
+func (e EvalResult) toStringResult() EvalResult {
+	if isStringType(e.typ) {
+		if e.typ != sqltypes.VarBinary {
+			e.typ = sqltypes.VarBinary
+		}
+		return e
+	}
+	if e.isNull() {
+		return e
+	}
+	return newEvalString(e.toBytes(), collationDefault, coerceCoercible)
+}
+
+// toBytes returns the string representation of a value
+func (e EvalResult) toBytes() []byte {
+	switch e.typ {
+	case sqltypes.Int64:
+		return strconv.AppendInt(nil, e.ival, 10)
+	case sqltypes.Uint64:
+		return strconv.AppendUint(nil, e.uval, 10)
+	case sqltypes.Float64:
+		return strconv.AppendFloat(nil, e.fval, 'g', -1, 64)
+	}
+	return e.bytes
+}
+
+// toFloat returns the value converted to a float, the way MySQL converts
+// values in a numeric context. Strings are converted using their longest
+// numeric prefix, so '12abc' is 12 and 'abc' is 0.
+func (e EvalResult) toFloat() float64 {
+	switch e.typ {
+	case sqltypes.Int64:
+		return float64(e.ival)
+	case sqltypes.Uint64:
+		return float64(e.uval)
+	case sqltypes.Float64:
+		return e.fval
+	}
+	if isTemporalType(e.typ) {
+		return temporalToNumeric(e).toFloat()
+	}
+	return parseFloatPrefix(e.bytes)
+}
+
+// toInt64 returns the value converted to an integer. Floats are rounded,
+// and strings are truncated to their integral prefix.
+func (e EvalResult) toInt64() int64 {
+	switch e.typ {
+	case sqltypes.Int64:
+		return e.ival
+	case sqltypes.Uint64:
+		return int64(e.uval)
+	case sqltypes.Float64:
+		return floatToInt64(e.fval)
+	}
+	if isTemporalType(e.typ) {
+		return temporalToNumeric(e).toInt64()
+	}
+	s := bytes.TrimLeft(e.bytes, " \t\n\r")
+	end := 0
+	if end < len(s) && (s[end] == '-' || s[end] == '+') {
+		end++
+	}
+	for end < len(s) && '0' <= s[end] && s[end] <= '9' {
+		end++
+	}
+	if ival, err := strconv.ParseInt(string(s[:end]), 10, 64); err == nil {
+		return ival
+	}
+	return floatToInt64(parseFloatPrefix(s[:end]))
+}
+
+// toUint64 returns the value converted to an unsigned integer. Negative
+// values wrap around, like they do in MySQL.
+func (e EvalResult) toUint64() uint64 {
+	switch e.typ {
+	case sqltypes.Uint64:
+		return e.uval
+	case sqltypes.Float64:
+		if e.fval >= math.MaxInt64 {
+			if e.fval >= math.MaxUint64 {
+				return math.MaxUint64
+			}
+			return uint64(math.Round(e.fval))
+		}
+	case sqltypes.VarBinary:
+		s := bytes.TrimSpace(e.bytes)
+		if uval, err := strconv.ParseUint(string(s), 10, 64); err == nil {
+			return uval
+		}
+	}
+	return uint64(e.toInt64())
+}
+
+// isTrue returns whether a non-NULL value is true in a boolean context
+func (e EvalResult) isTrue() bool {
+	switch e.typ {
+	case sqltypes.Int64:
+		return e.ival != 0
+	case sqltypes.Uint64:
+		return e.uval != 0
+	}
+	return e.toFloat() != 0
+}
+
+func floatToInt64(f float64) int64 {
+	f = math.Round(f)
+	switch {
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	}
+	return int64(f)
+}
+
+// parseFloatPrefix parses the longest prefix of a string that is a number.
+func parseFloatPrefix(b []byte) float64 {
+	s := bytes.TrimLeft(b, " \t\n\r")
+	end := 0
+	if end < len(s) && (s[end] == '-' || s[end] == '+') {
+		end++
+	}
+	digits := 0
+	for end < len(s) && '0' <= s[end] && s[end] <= '9' {
+		end++
+		digits++
+	}
+	if end < len(s) && s[end] == '.' {
+		end++
+		for end < len(s) && '0' <= s[end] && s[end] <= '9' {
+			end++
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0
+	}
+	if end < len(s) && (s[end] == 'e' || s[end] == 'E') {
+		exp := end + 1
+		if exp < len(s) && (s[exp] == '-' || s[exp] == '+') {
+			exp++
+		}
+		if exp < len(s) && '0' <= s[exp] && s[exp] <= '9' {
+			for exp < len(s) && '0' <= s[exp] && s[exp] <= '9' {
+				exp++
+			}
+			end = exp
+		}
+	}
+	// values that are out of range are parsed as ±Inf
+	fval, _ := strconv.ParseFloat(string(s[:end]), 64)
+	return fval
+}
+
+// ConvertExpr represents a call to CAST(expr AS type) or CONVERT(expr, type)
+type ConvertExpr struct {
+	Inner Expr
+	// Target is the name of the target type, in upper case
+	Target string
+	// Length is the length of CHAR(N) and BINARY(N), or -1 if it was not given
+	Length    int
+	Collation collationID
+}
+
+var _ Expr = (*ConvertExpr)(nil)
+
+// NewConvertExpr returns an expression that converts its input to the given type.
+// It returns false if the conversion is not supported.
+func NewConvertExpr(inner Expr, typ string, length int, charset string) (Expr, bool) {
+	typ = strings.ToUpper(typ)
+	convert := &ConvertExpr{Inner: inner, Target: typ, Length: length}
+	switch typ {
+	case "SIGNED", "SIGNED INTEGER", "UNSIGNED", "UNSIGNED INTEGER", "DOUBLE", "DATE", "DATETIME", "TIME":
+		if charset != "" {
+			return nil, false
+		}
+	case "BINARY":
+		convert.Collation = collationBinary
+	case "CHAR", "NCHAR":
+		convert.Collation = collationDefault
+		if charset != "" {
+			coll, ok := defaultCollationForCharset(charset)
+			if !ok {
+				return nil, false
+			}
+			convert.Collation = coll
+		}
+	default:
+		return nil, false
+	}
+	return convert, true
+}
+
+// Evaluate implements the Expr interface
+func (c *ConvertExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := c.Inner.Evaluate(env)
+	if err != nil || val.isNull() {
+		return val, err
+	}
+	switch c.Target {
+	case "SIGNED", "SIGNED INTEGER":
+		return newEvalInt64(val.toInt64()), nil
+	case "UNSIGNED", "UNSIGNED INTEGER":
+		return newEvalUint64(val.toUint64()), nil
+	case "DOUBLE":
+		return newEvalFloat(val.toFloat()), nil
+	case "DATE":
+		return convertToDate(val), nil
+	case "DATETIME":
+		return convertToDatetime(val), nil
+	case "TIME":
+		return convertToTime(val), nil
+	}
+	str := val.toBytes()
+	if c.Length >= 0 {
+		if c.Collation == collationBinary {
+			if len(str) > c.Length {
+				str = str[:c.Length]
+			} else if len(str) < c.Length {
+				// BINARY(N) pads the value with 0x00 bytes
+				str = append(append([]byte(nil), str...), make([]byte, c.Length-len(str))...)
+			}
+		} else if c.Length < utf8.RuneCount(str) {
+			str = []byte(string([]rune(string(str))[:c.Length]))
+		}
+	}
+	return newEvalString(str, c.Collation, coerceImplicit), nil
+}
+
+// Type implements the Expr interface
+func (c *ConvertExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	switch c.Target {
+	case "SIGNED", "SIGNED INTEGER":
+		return sqltypes.Int64, nil
+	case "UNSIGNED", "UNSIGNED INTEGER":
+		return sqltypes.Uint64, nil
+	case "DOUBLE":
+		return sqltypes.Float64, nil
+	case "DATE":
+		return sqltypes.Date, nil
+	case "DATETIME":
+		return sqltypes.Datetime, nil
+	case "TIME":
+		return sqltypes.Time, nil
+	}
+	return sqltypes.VarBinary, nil
+}
+
+// String implements the Expr interface
+func (c *ConvertExpr) String() string {
+	typ := c.Target
+	if c.Length >= 0 {
+		typ += "(" + strconv.Itoa(c.Length) + ")"
+	}
+	if c.Collation != 0 && c.Collation != collationBinary && c.Collation != collationDefault {
+		typ += " COLLATE " + lookupCollation(c.Collation).name
+	}
+	return "CAST(" + c.Inner.String() + " AS " + typ + ")"
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// collationID is the id MySQL uses to identify a collation,
+// as found in information_schema.collations.
+type collationID uint16
+
+// coercibility is the derivation of the collation of a string value.
+// When two strings with different collations are compared, the collation
+// with the highest coercibility is used. The zero value is the coercibility
+// of literals and bind variables.
+type coercibility uint8
+
+const (
+	coerceCoercible coercibility = iota
+	coerceImplicit
+	coerceExplicit
+)
+
+func (c coercibility) String() string {
+	switch c {
+	case coerceExplicit:
+		return "EXPLICIT"
+	case coerceImplicit:
+		return "IMPLICIT"
+	}
+	return "COERCIBLE"
+}
+
+const (
+	collationLatin1SwedishCI  collationID = 8
+	collationUtf8GeneralCI    collationID = 33
+	collationUtf8mb4GeneralCI collationID = 45
+	collationUtf8mb4Bin       collationID = 46
+	collationLatin1Bin        collationID = 47
+	collationBinary           collationID = 63
+	collationUtf8Bin          collationID = 83
+	collationUtf8mb4AICI      collationID = 255
+	collationUtf8mb4ASCS      collationID = 278
+
+	// collationDefault is the collation of string literals and of the
+	// text columns returned by MySQL, for which the collation is not known.
+	collationDefault = collationUtf8mb4GeneralCI
+)
+
+// collation describes how the strings of a MySQL collation are compared.
+// All the strings handled by vtgate are utf8 encoded, so the latin1
+// collations are implemented with the rules of their utf8 counterparts.
+type collation struct {
+	id   collationID
+	name string
+	// pad is true for the PAD SPACE collations, that ignore trailing spaces.
+	pad bool
+	// compare compares two strings of this collation.
+	compare func(a, b []byte) int
+	// fold returns the rune that r is equal to when matching
+	// LIKE patterns. It is nil for the binary collation, which
+	// matches the patterns byte by byte.
+	fold func(r rune) rune
+}
+
+var collations = map[collationID]*collation{}
+var collationsByName = map[string]*collation{}
+
+func init() {
+	for _, coll := range []*collation{
+		{id: collationBinary, name: "binary", compare: bytes.Compare},
+		{id: collationUtf8mb4Bin, name: "utf8mb4_bin", pad: true, compare: bytes.Compare, fold: identityRune},
+		{id: collationUtf8Bin, name: "utf8_bin", pad: true, compare: bytes.Compare, fold: identityRune},
+		{id: collationLatin1Bin, name: "latin1_bin", pad: true, compare: bytes.Compare, fold: identityRune},
+		{id: collationUtf8mb4GeneralCI, name: "utf8mb4_general_ci", pad: true, compare: compareGeneralCI, fold: foldGeneralCI},
+		{id: collationUtf8GeneralCI, name: "utf8_general_ci", pad: true, compare: compareGeneralCI, fold: foldGeneralCI},
+		{id: collationLatin1SwedishCI, name: "latin1_swedish_ci", pad: true, compare: compareGeneralCI, fold: foldGeneralCI},
+		{id: collationUtf8mb4AICI, name: "utf8mb4_0900_ai_ci", compare: newUCACompare(collate.Loose), fold: foldGeneralCI},
+		{id: collationUtf8mb4ASCS, name: "utf8mb4_0900_as_cs", compare: newUCACompare(), fold: identityRune},
+	} {
+		collations[coll.id] = coll
+		collationsByName[coll.name] = coll
+	}
+}
+
+// lookupCollation returns the collation of a string. Strings that have
+// never been assigned a collation use the default one.
+func lookupCollation(id collationID) *collation {
+	if coll, ok := collations[id]; ok {
+		return coll
+	}
+	return collations[collationDefault]
+}
+
+// defaultCollationForCharset returns the collation used by a charset
+// introducer, such as _utf8mb4 'foo'.
+func defaultCollationForCharset(charset string) (collationID, bool) {
+	switch strings.ToLower(charset) {
+	case "binary":
+		return collationBinary, true
+	case "utf8mb4":
+		return collationUtf8mb4GeneralCI, true
+	case "utf8", "utf8mb3":
+		return collationUtf8GeneralCI, true
+	case "latin1":
+		return collationLatin1SwedishCI, true
+	}
+	return 0, false
+}
+
+// compareStrings compares two strings of this collation, ignoring the trailing
+// spaces if the collation is PAD SPACE.
+func (c *collation) compareStrings(a, b []byte) int {
+	if c.pad {
+		a = bytes.TrimRight(a, " ")
+		b = bytes.TrimRight(b, " ")
+	}
+	return c.compare(a, b)
+}
+
+func identityRune(r rune) rune {
+	return r
+}
+
+// foldGeneralCI returns the weight of a rune in utf8mb4_general_ci:
+// the base letter without its accents, in upper case.
+func foldGeneralCI(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		return r
+	}
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	decomposed := norm.NFD.Bytes(buf[:n])
+	base, _ := utf8.DecodeRune(decomposed)
+	return unicode.ToUpper(base)
+}
+
+func compareGeneralCI(a, b []byte) int {
+	for len(a) > 0 && len(b) > 0 {
+		ra, na := utf8.DecodeRune(a)
+		rb, nb := utf8.DecodeRune(b)
+		fa, fb := foldGeneralCI(ra), foldGeneralCI(rb)
+		if fa != fb {
+			if fa < fb {
+				return -1
+			}
+			return 1
+		}
+		a, b = a[na:], b[nb:]
+	}
+	switch {
+	case len(a) == len(b):
+		return 0
+	case len(a) < len(b):
+		return -1
+	}
+	return 1
+}
+
+// newUCACompare returns a function that compares strings using the
+// Unicode Collation Algorithm, used by the utf8mb4_0900 collations.
+// Collators cannot be used concurrently, so they are pooled.
+func newUCACompare(options ...collate.Option) func(a, b []byte) int {
+	pool := &sync.Pool{New: func() interface{} {
+		return collate.New(language.Und, options...)
+	}}
+	return func(a, b []byte) int {
+		col := pool.Get().(*collate.Collator)
+		defer pool.Put(col)
+		return col.Compare(a, b)
+	}
+}
+
+// mergeCollations returns the collation used to compare two strings,
+// following the MySQL rules for collation coercibility.
+func mergeCollations(left, right EvalResult, operation string) (*collation, coercibility, error) {
+	lcoll, rcoll := lookupCollation(left.collation), lookupCollation(right.collation)
+	if lcoll.id == rcoll.id {
+		return lcoll, maxCoercibility(left.coercion, right.coercion), nil
+	}
+	switch {
+	case left.coercion > right.coercion:
+		return lcoll, left.coercion, nil
+	case left.coercion < right.coercion:
+		return rcoll, right.coercion, nil
+	case lcoll.id == collationBinary:
+		return lcoll, left.coercion, nil
+	case rcoll.id == collationBinary:
+		return rcoll, right.coercion, nil
+	case left.coercion == coerceCoercible:
+		return lcoll, left.coercion, nil
+	}
+	return nil, 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.CantAggregate2Collations,
+		"Illegal mix of collations (%s,%s) and (%s,%s) for operation '%s'",
+		lcoll.name, left.coercion, rcoll.name, right.coercion, operation)
+}
+
+func maxCoercibility(a, b coercibility) coercibility {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// CollateExpr is an expression with an explicit COLLATE clause
+type CollateExpr struct {
+	Inner     Expr
+	Collation collationID
+}
+
+var _ Expr = (*CollateExpr)(nil)
+
+// NewCollateExpr returns an expression that sets the collation of its input,
+// or false if the collation is not supported.
+func NewCollateExpr(inner Expr, collation string) (Expr, bool) {
+	coll, ok := collationsByName[strings.ToLower(collation)]
+	if !ok {
+		return nil, false
+	}
+	return &CollateExpr{Inner: inner, Collation: coll.id}, true
+}
+
+// NewLiteralStringWithCharset returns a string literal with a charset
+// introducer, or false if the charset is not supported.
+func NewLiteralStringWithCharset(val []byte, charset string) (Expr, bool) {
+	coll, ok := defaultCollationForCharset(charset)
+	if !ok {
+		return nil, false
+	}
+	return &Literal{EvalResult{typ: querypb.Type_VARBINARY, collation: coll, bytes: val}}, true
+}
+
+// Evaluate implements the Expr interface
+func (c *CollateExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := c.Inner.Evaluate(env)
+	if err != nil || val.typ == querypb.Type_NULL_TYPE {
+		return val, err
+	}
+	val = val.toStringResult()
+	val.collation = c.Collation
+	val.coercion = coerceExplicit
+	return val, nil
+}
+
+// Type implements the Expr interface
+func (c *CollateExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return querypb.Type_VARBINARY, nil
+}
+
+// String implements the Expr interface
+func (c *CollateExpr) String() string {
+	return c.Inner.String() + " COLLATE " + lookupCollation(c.Collation).name
+}
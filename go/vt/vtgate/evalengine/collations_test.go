@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareStrings(t *testing.T) {
+	tests := []struct {
+		collation collationID
+		a, b      string
+		expected  int
+	}{
+		{collationUtf8mb4GeneralCI, "abc", "ABC", 0},
+		{collationUtf8mb4GeneralCI, "abc", "ABC  ", 0},
+		{collationUtf8mb4GeneralCI, "résumé", "RESUME", 0},
+		{collationUtf8mb4GeneralCI, "a", "b", -1},
+		{collationUtf8mb4Bin, "abc", "ABC", 1},
+		{collationUtf8mb4Bin, "abc", "abc ", 0},
+		{collationBinary, "abc", "abc ", -1},
+		{collationUtf8mb4AICI, "Résumé", "resume", 0},
+		{collationUtf8mb4AICI, "abc", "abc ", -1},
+		{collationUtf8mb4ASCS, "Résumé", "resume", 1},
+	}
+	for _, test := range tests {
+		coll := lookupCollation(test.collation)
+		t.Run(coll.name+"/"+test.a+"/"+test.b, func(t *testing.T) {
+			assert.Equal(t, test.expected, coll.compareStrings([]byte(test.a), []byte(test.b)))
+		})
+	}
+}
+
+func TestMergeCollations(t *testing.T) {
+	column := newEvalString(nil, collationUtf8mb4Bin, coerceImplicit)
+	literal := newEvalString(nil, collationUtf8mb4GeneralCI, coerceCoercible)
+	explicit := newEvalString(nil, collationUtf8mb4AICI, coerceExplicit)
+	binary := newEvalString(nil, collationBinary, coerceImplicit)
+
+	coll, coercion, err := mergeCollations(literal, column, "=")
+	require.NoError(t, err)
+	assert.Equal(t, collationUtf8mb4Bin, coll.id)
+	assert.Equal(t, coerceImplicit, coercion)
+
+	coll, _, err = mergeCollations(column, explicit, "=")
+	require.NoError(t, err)
+	assert.Equal(t, collationUtf8mb4AICI, coll.id)
+
+	coll, _, err = mergeCollations(column, binary, "=")
+	require.NoError(t, err)
+	assert.Equal(t, collationBinary, coll.id)
+
+	_, _, err = mergeCollations(column, newEvalString(nil, collationUtf8GeneralCI, coerceImplicit), "like")
+	assert.EqualError(t, err, "Illegal mix of collations (utf8mb4_bin,IMPLICIT) and (utf8_general_ci,IMPLICIT) for operation 'like'")
+}
+
+func TestMatchLike(t *testing.T) {
+	tests := []struct {
+		s, pattern string
+		expected   bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "a%", true},
+		{"abc", "%c", true},
+		{"abc", "%b%", true},
+		{"abc", "a_c", true},
+		{"abc", "a_", false},
+		{"", "%", true},
+		{"aXbXc", "a%b%c", true},
+		{"a%c", "a\\%c", true},
+		{"abc", "a\\%c", false},
+		{"a_c", "a\\_c", true},
+		{"mississippi", "%iss%pi", true},
+		{"mississippi", "%iss%ppx", false},
+	}
+	for _, test := range tests {
+		t.Run(test.s+"/"+test.pattern, func(t *testing.T) {
+			assert.Equal(t, test.expected, matchLike([]rune(test.s), []rune(test.pattern), '\\', identityRune))
+		})
+	}
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// Comparison operators, evaluated with the MySQL rules for comparing
+	// values of different types.
+	Equals         struct{}
+	NotEquals      struct{}
+	NullSafeEquals struct{}
+	LessThan       struct{}
+	LessEqual      struct{}
+	GreaterThan    struct{}
+	GreaterEqual   struct{}
+
+	// Like is the LIKE and NOT LIKE operator
+	Like struct {
+		Negate bool
+		// Escape is the escape character of the pattern,
+		// or 0 to use the default backslash.
+		Escape rune
+	}
+
+	// InExpr is the IN and NOT IN operator for a list of expressions
+	InExpr struct {
+		Left   Expr
+		Right  []Expr
+		Negate bool
+	}
+)
+
+var _ BinaryExpr = (*Equals)(nil)
+var _ BinaryExpr = (*NotEquals)(nil)
+var _ BinaryExpr = (*NullSafeEquals)(nil)
+var _ BinaryExpr = (*LessThan)(nil)
+var _ BinaryExpr = (*LessEqual)(nil)
+var _ BinaryExpr = (*GreaterThan)(nil)
+var _ BinaryExpr = (*GreaterEqual)(nil)
+var _ BinaryExpr = (*Like)(nil)
+var _ Expr = (*InExpr)(nil)
+
+// compareValues compares two values that are not NULL. Two strings are
+// compared using their collation, and two integers as integers. Temporal
+// values are compared as dates when the other value is a date or a string.
+// All the other values are compared as floats.
+func compareValues(left, right EvalResult, operation string) (int, error) {
+	switch {
+	case isStringType(left.typ) && isStringType(right.typ):
+		coll, _, err := mergeCollations(left, right, operation)
+		if err != nil {
+			return 0, err
+		}
+		return coll.compareStrings(left.bytes, right.bytes), nil
+	case left.isIntegral() && right.isIntegral():
+		return compareNumeric(left, right)
+	case isTemporalType(left.typ) || isTemporalType(right.typ):
+		if cmp, ok := compareTemporal(left, right); ok {
+			return cmp, nil
+		}
+	}
+	return compareFloats(left.toFloat(), right.toFloat()), nil
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func evaluateComparison(left, right EvalResult, operation string, test func(cmp int) bool) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	cmp, err := compareValues(left, right, operation)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return newEvalBool(test(cmp)), nil
+}
+
+// Evaluate implements the BinaryExpr interface
+func (e *Equals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, e.String(), func(cmp int) bool { return cmp == 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (n *NotEquals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, n.String(), func(cmp int) bool { return cmp != 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (n *NullSafeEquals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return newEvalBool(left.isNull() && right.isNull()), nil
+	}
+	return evaluateComparison(left, right, n.String(), func(cmp int) bool { return cmp == 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (l *LessThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, l.String(), func(cmp int) bool { return cmp < 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (l *LessEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, l.String(), func(cmp int) bool { return cmp <= 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (g *GreaterThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, g.String(), func(cmp int) bool { return cmp > 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (g *GreaterEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, g.String(), func(cmp int) bool { return cmp >= 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (l *Like) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	left, right = left.toStringResult(), right.toStringResult()
+	coll, _, err := mergeCollations(left, right, "like")
+	if err != nil {
+		return EvalResult{}, err
+	}
+	escape := l.Escape
+	if escape == 0 {
+		escape = '\\'
+	}
+	var match bool
+	if coll.fold == nil {
+		match = matchLike(bytesToRunes(left.bytes), bytesToRunes(right.bytes), escape, identityRune)
+	} else {
+		match = matchLike([]rune(string(left.bytes)), []rune(string(right.bytes)), escape, coll.fold)
+	}
+	return newEvalBool(match != l.Negate), nil
+}
+
+// bytesToRunes is used to match the LIKE patterns of binary strings byte by byte
+func bytesToRunes(b []byte) []rune {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return runes
+}
+
+// matchLike returns true if the string matches the LIKE pattern, in which
+// % matches any number of characters and _ matches exactly one character.
+// Both are matched literally when preceded by the escape character.
+func matchLike(s, pattern []rune, escape rune, fold func(rune) rune) bool {
+	si, pi := 0, 0
+	// position of the last % in the pattern, and of the
+	// character of the string it was matched against
+	percentP, percentS := -1, 0
+	for si < len(s) {
+		if pi < len(pattern) {
+			pc := pattern[pi]
+			switch {
+			case pc == '%':
+				percentP, percentS = pi, si
+				pi++
+				continue
+			case pc == '_':
+				si++
+				pi++
+				continue
+			case pc == escape && pi+1 < len(pattern):
+				if fold(pattern[pi+1]) == fold(s[si]) {
+					si++
+					pi += 2
+					continue
+				}
+			case fold(pc) == fold(s[si]):
+				si++
+				pi++
+				continue
+			}
+		}
+		if percentP < 0 {
+			return false
+		}
+		// backtrack, and let the last % match one more character
+		percentS++
+		si, pi = percentS, percentP+1
+	}
+	for pi < len(pattern) && pattern[pi] == '%' {
+		pi++
+	}
+	return pi == len(pattern)
+}
+
+// Type implements the BinaryExpr interface
+func (e *Equals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (n *NotEquals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (n *NullSafeEquals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (l *LessThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (l *LessEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (g *GreaterThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (g *GreaterEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (l *Like) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String implements the BinaryExpr interface
+func (e *Equals) String() string {
+	return "="
+}
+
+// String implements the BinaryExpr interface
+func (n *NotEquals) String() string {
+	return "!="
+}
+
+// String implements the BinaryExpr interface
+func (n *NullSafeEquals) String() string {
+	return "<=>"
+}
+
+// String implements the BinaryExpr interface
+func (l *LessThan) String() string {
+	return "<"
+}
+
+// String implements the BinaryExpr interface
+func (l *LessEqual) String() string {
+	return "<="
+}
+
+// String implements the BinaryExpr interface
+func (g *GreaterThan) String() string {
+	return ">"
+}
+
+// String implements the BinaryExpr interface
+func (g *GreaterEqual) String() string {
+	return ">="
+}
+
+// String implements the BinaryExpr interface
+func (l *Like) String() string {
+	op := "like"
+	if l.Negate {
+		op = "not like"
+	}
+	if l.Escape != 0 && l.Escape != '\\' {
+		var buf [utf8.UTFMax]byte
+		n := utf8.EncodeRune(buf[:], l.Escape)
+		op += " escape '" + string(buf[:n]) + "'"
+	}
+	return op
+}
+
+// Evaluate implements the Expr interface
+func (i *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := i.Left.Evaluate(env)
+	if err != nil || left.isNull() {
+		return left, err
+	}
+	foundNull := false
+	for _, expr := range i.Right {
+		right, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if right.isNull() {
+			foundNull = true
+			continue
+		}
+		cmp, err := compareValues(left, right, "in")
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if cmp == 0 {
+			return newEvalBool(!i.Negate), nil
+		}
+	}
+	// when there is no match, the result is NULL if the list contains a NULL
+	if foundNull {
+		return resultNull, nil
+	}
+	return newEvalBool(i.Negate), nil
+}
+
+// Type implements the Expr interface
+func (i *InExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (i *InExpr) String() string {
+	var right []string
+	for _, expr := range i.Right {
+		right = append(right, expr.String())
+	}
+	op := " in "
+	if i.Negate {
+		op = " not in "
+	}
+	return i.Left.String() + op + "(" + strings.Join(right, ", ") + ")"
+}
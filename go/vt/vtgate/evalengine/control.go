@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// CaseExpr is CASE [base] WHEN ... THEN ... [ELSE ...] END.
+	// Without a base, the first true WHEN condition is taken,
+	// otherwise the first WHEN that is equal to the base.
+	CaseExpr struct {
+		Base  Expr
+		Whens []WhenThen
+		Else  Expr
+	}
+
+	// WhenThen is a WHEN branch of a CaseExpr
+	WhenThen struct {
+		When Expr
+		Then Expr
+	}
+)
+
+var _ Expr = (*CaseExpr)(nil)
+
+// Evaluate implements the Expr interface
+func (c *CaseExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	var base EvalResult
+	if c.Base != nil {
+		var err error
+		if base, err = c.Base.Evaluate(env); err != nil {
+			return EvalResult{}, err
+		}
+	}
+	for _, wt := range c.Whens {
+		when, err := wt.When.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		var match bool
+		if c.Base == nil {
+			match = !when.isNull() && when.isTrue()
+		} else if !base.isNull() && !when.isNull() {
+			cmp, err := compareValues(base, when, "case")
+			if err != nil {
+				return EvalResult{}, err
+			}
+			match = cmp == 0
+		}
+		if match {
+			return wt.Then.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return resultNull, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+// Type implements the Expr interface
+func (c *CaseExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	var types []querypb.Type
+	for _, wt := range c.Whens {
+		typ, err := wt.Then.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	if c.Else != nil {
+		typ, err := c.Else.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	return mergeTypesFrom(0)(types), nil
+}
+
+// String implements the Expr interface
+func (c *CaseExpr) String() string {
+	var buf strings.Builder
+	buf.WriteString("case")
+	if c.Base != nil {
+		buf.WriteString(" " + c.Base.String())
+	}
+	for _, wt := range c.Whens {
+		buf.WriteString(" when " + wt.When.String() + " then " + wt.Then.String())
+	}
+	if c.Else != nil {
+		buf.WriteString(" else " + c.Else.String())
+	}
+	buf.WriteString(" end")
+	return buf.String()
+}
+
+// builtinIf is IF(cond, then, else), which only evaluates the selected branch
+func builtinIf(env ExpressionEnv, args []Expr) (EvalResult, error) {
+	cond, err := args[0].Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if !cond.isNull() && cond.isTrue() {
+		return args[1].Evaluate(env)
+	}
+	return args[2].Evaluate(env)
+}
+
+// builtinCoalesce returns the first argument that is not NULL, and is also IFNULL()
+func builtinCoalesce(env ExpressionEnv, args []Expr) (EvalResult, error) {
+	for _, arg := range args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if !val.isNull() {
+			return val, nil
+		}
+	}
+	return resultNull, nil
+}
+
+// builtinNullIf returns NULL if both arguments are equal, otherwise the first one
+func builtinNullIf(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() || args[1].isNull() {
+		return args[0], nil
+	}
+	cmp, err := compareValues(args[0], args[1], "nullif")
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if cmp == 0 {
+		return resultNull, nil
+	}
+	return args[0], nil
+}
@@ -143,8 +143,10 @@ func ToNative(v sqltypes.Value) (interface{}, error) {
 func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 	raw := v.Raw()
 	switch {
-	case v.IsBinary() || v.IsText():
-		return EvalResult{bytes: raw, typ: sqltypes.VarBinary}, nil
+	case v.IsText():
+		return newEvalString(raw, collationDefault, coerceImplicit), nil
+	case v.IsBinary():
+		return newEvalString(raw, collationBinary, coerceImplicit), nil
 	case v.IsSigned():
 		ival, err := strconv.ParseInt(string(raw), 10, 64)
 		if err != nil {
@@ -164,7 +166,7 @@ func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 		}
 		return EvalResult{fval: fval, typ: sqltypes.Float64}, nil
 	default:
-		return EvalResult{typ: v.Type(), bytes: raw}, nil
+		return EvalResult{typ: v.Type(), collation: collationDefault, coercion: coerceImplicit, bytes: raw}, nil
 	}
 }
 
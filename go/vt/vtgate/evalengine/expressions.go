@@ -29,11 +29,14 @@ import (
 
 type (
 	EvalResult struct {
-		typ   querypb.Type
-		ival  int64
-		uval  uint64
-		fval  float64
-		bytes []byte
+		typ querypb.Type
+		// collation and coercion are only used by string values
+		collation collationID
+		coercion  coercibility
+		ival      int64
+		uval      uint64
+		fval      float64
+		bytes     []byte
 	}
 	//ExpressionEnv contains the environment that the expression
 	//evaluates in, such as the current row and bindvars
@@ -56,6 +59,13 @@ type (
 		String() string
 	}
 
+	//UnaryExpr allows unary expressions to not have to evaluate their child expression - this is done by the UnaryOp
+	UnaryExpr interface {
+		Evaluate(val EvalResult) (EvalResult, error)
+		Type(inner querypb.Type) querypb.Type
+		String() string
+	}
+
 	// Expressions
 	Literal      struct{ Val EvalResult }
 	BindVariable struct{ Key string }
@@ -64,6 +74,10 @@ type (
 		Expr        BinaryExpr
 		Left, Right Expr
 	}
+	UnaryOp struct {
+		Expr  UnaryExpr
+		Inner Expr
+	}
 
 	// Binary ops
 	Addition       struct{}
@@ -105,6 +119,11 @@ func NewLiteralString(val []byte) Expr {
 	return &Literal{EvalResult{typ: sqltypes.VarBinary, bytes: val}}
 }
 
+// NewLiteralNull returns a NULL literal
+func NewLiteralNull() Expr {
+	return &Literal{resultNull}
+}
+
 //NewBindVar returns a bind variable
 func NewBindVar(key string) Expr {
 	return &BindVariable{Key: key}
@@ -121,6 +140,7 @@ var _ Expr = (*Literal)(nil)
 var _ Expr = (*BindVariable)(nil)
 var _ Expr = (*BinaryOp)(nil)
 var _ Expr = (*Column)(nil)
+var _ Expr = (*UnaryOp)(nil)
 
 var _ BinaryExpr = (*Addition)(nil)
 var _ BinaryExpr = (*Subtraction)(nil)
@@ -140,6 +160,15 @@ func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	return b.Expr.Evaluate(lVal, rVal)
 }
 
+//Evaluate implements the Expr interface
+func (u *UnaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := u.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return u.Expr.Evaluate(val)
+}
+
 //Evaluate implements the Expr interface
 func (l *Literal) Evaluate(ExpressionEnv) (EvalResult, error) {
 	return l.Val, nil
@@ -163,21 +192,34 @@ func (c *Column) Evaluate(env ExpressionEnv) (EvalResult, error) {
 
 //Evaluate implements the BinaryOp interface
 func (a *Addition) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
 	return addNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
 func (s *Subtraction) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
 	return subtractNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
 func (m *Multiplication) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
 	return multiplyNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
 func (d *Division) Evaluate(left, right EvalResult) (EvalResult, error) {
+	// division by zero returns NULL
+	if left.isNull() || right.isNull() || right.toFloat() == 0 {
+		return resultNull, nil
+	}
 	return divideNumericWithError(left, right)
 }
 
@@ -215,6 +257,15 @@ func (b *BinaryOp) Type(env ExpressionEnv) (querypb.Type, error) {
 	return b.Expr.Type(typ), nil
 }
 
+//Type implements the Expr interface
+func (u *UnaryOp) Type(env ExpressionEnv) (querypb.Type, error) {
+	typ, err := u.Inner.Type(env)
+	if err != nil {
+		return 0, err
+	}
+	return u.Expr.Type(typ), nil
+}
+
 //Type implements the Expr interface
 func (b *BindVariable) Type(env ExpressionEnv) (querypb.Type, error) {
 	e := env.BindVars
@@ -260,6 +311,11 @@ func (b *BinaryOp) String() string {
 	return b.Left.String() + " " + b.Expr.String() + " " + b.Right.String()
 }
 
+//String implements the Expr interface
+func (u *UnaryOp) String() string {
+	return u.Expr.String() + u.Inner.String()
+}
+
 //String implements the Expr interface
 func (b *BindVariable) String() string {
 	return ":" + b.Key
@@ -317,6 +373,8 @@ func evaluateByType(val *querypb.BindVariable) (EvalResult, error) {
 		return EvalResult{typ: sqltypes.Float64, fval: fval}, nil
 	case sqltypes.VarChar, sqltypes.Text, sqltypes.VarBinary:
 		return EvalResult{typ: sqltypes.VarBinary, bytes: val.Value}, nil
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time:
+		return EvalResult{typ: val.Type, bytes: val.Value}, nil
 	case sqltypes.Null:
 		return EvalResult{typ: sqltypes.Null}, nil
 	}
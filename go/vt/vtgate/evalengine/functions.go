@@ -0,0 +1,1205 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// ErrUnsupportedFunction is returned by NewCallExpr for the functions
+// that cannot be evaluated by the engine.
+var ErrUnsupportedFunction = errors.New("unsupported function")
+
+// maxStringLength is the length of the longest string a function can return,
+// which is the default max_allowed_packet of MySQL. Longer results are NULL.
+const maxStringLength = 64 * 1024 * 1024
+
+// variadic is the maxArgs of the functions without a maximum number of arguments
+const variadic = -1
+
+type (
+	// CallExpr is a call to one of the builtin functions of the engine
+	CallExpr struct {
+		Name      string
+		Arguments []Expr
+		builtin   *builtin
+	}
+
+	// builtin is the implementation of a function
+	builtin struct {
+		minArgs, maxArgs int
+		// eval evaluates the function from its evaluated arguments
+		eval func(args []EvalResult) (EvalResult, error)
+		// lazy is used instead of eval by the functions that
+		// must only evaluate some of their arguments, such as IF()
+		lazy func(env ExpressionEnv, args []Expr) (EvalResult, error)
+		// typeof returns the type of the result from the types of the arguments
+		typeof func(args []querypb.Type) querypb.Type
+	}
+)
+
+var _ Expr = (*CallExpr)(nil)
+
+var builtinFunctions = map[string]*builtin{
+	// control flow functions
+	"if":       {minArgs: 3, maxArgs: 3, lazy: builtinIf, typeof: mergeTypesFrom(1)},
+	"ifnull":   {minArgs: 2, maxArgs: 2, lazy: builtinCoalesce, typeof: mergeTypesFrom(0)},
+	"coalesce": {minArgs: 1, maxArgs: variadic, lazy: builtinCoalesce, typeof: mergeTypesFrom(0)},
+	"nullif":   {minArgs: 2, maxArgs: 2, eval: builtinNullIf, typeof: firstArgType},
+
+	// string functions
+	"ascii":            {minArgs: 1, maxArgs: 1, eval: builtinASCII, typeof: returns(sqltypes.Int64)},
+	"bin":              {minArgs: 1, maxArgs: 1, eval: builtinBase(2), typeof: returns(sqltypes.VarBinary)},
+	"bit_length":       {minArgs: 1, maxArgs: 1, eval: builtinBitLength, typeof: returns(sqltypes.Int64)},
+	"char":             {minArgs: 1, maxArgs: variadic, eval: builtinChar, typeof: returns(sqltypes.VarBinary)},
+	"char_length":      {minArgs: 1, maxArgs: 1, eval: builtinCharLength, typeof: returns(sqltypes.Int64)},
+	"character_length": {minArgs: 1, maxArgs: 1, eval: builtinCharLength, typeof: returns(sqltypes.Int64)},
+	"concat":           {minArgs: 1, maxArgs: variadic, eval: builtinConcat, typeof: returns(sqltypes.VarBinary)},
+	"concat_ws":        {minArgs: 2, maxArgs: variadic, eval: builtinConcatWs, typeof: returns(sqltypes.VarBinary)},
+	"crc32":            {minArgs: 1, maxArgs: 1, eval: builtinCrc32, typeof: returns(sqltypes.Uint64)},
+	"elt":              {minArgs: 2, maxArgs: variadic, eval: builtinElt, typeof: returns(sqltypes.VarBinary)},
+	"field":            {minArgs: 2, maxArgs: variadic, eval: builtinField, typeof: returns(sqltypes.Int64)},
+	"find_in_set":      {minArgs: 2, maxArgs: 2, eval: builtinFindInSet, typeof: returns(sqltypes.Int64)},
+	"from_base64":      {minArgs: 1, maxArgs: 1, eval: builtinFromBase64, typeof: returns(sqltypes.VarBinary)},
+	"hex":              {minArgs: 1, maxArgs: 1, eval: builtinHex, typeof: returns(sqltypes.VarBinary)},
+	"instr":            {minArgs: 2, maxArgs: 2, eval: builtinInstr, typeof: returns(sqltypes.Int64)},
+	"lcase":            {minArgs: 1, maxArgs: 1, eval: builtinLower, typeof: returns(sqltypes.VarBinary)},
+	"left":             {minArgs: 2, maxArgs: 2, eval: builtinLeft, typeof: returns(sqltypes.VarBinary)},
+	"length":           {minArgs: 1, maxArgs: 1, eval: builtinLength, typeof: returns(sqltypes.Int64)},
+	"locate":           {minArgs: 2, maxArgs: 3, eval: builtinLocate, typeof: returns(sqltypes.Int64)},
+	"lower":            {minArgs: 1, maxArgs: 1, eval: builtinLower, typeof: returns(sqltypes.VarBinary)},
+	"lpad":             {minArgs: 3, maxArgs: 3, eval: builtinPad(true), typeof: returns(sqltypes.VarBinary)},
+	"ltrim":            {minArgs: 1, maxArgs: 1, eval: builtinTrim(true, false), typeof: returns(sqltypes.VarBinary)},
+	"md5":              {minArgs: 1, maxArgs: 1, eval: builtinMd5, typeof: returns(sqltypes.VarBinary)},
+	"mid":              {minArgs: 3, maxArgs: 3, eval: builtinSubstring, typeof: returns(sqltypes.VarBinary)},
+	"oct":              {minArgs: 1, maxArgs: 1, eval: builtinBase(8), typeof: returns(sqltypes.VarBinary)},
+	"octet_length":     {minArgs: 1, maxArgs: 1, eval: builtinLength, typeof: returns(sqltypes.Int64)},
+	"repeat":           {minArgs: 2, maxArgs: 2, eval: builtinRepeat, typeof: returns(sqltypes.VarBinary)},
+	"replace":          {minArgs: 3, maxArgs: 3, eval: builtinReplace, typeof: returns(sqltypes.VarBinary)},
+	"reverse":          {minArgs: 1, maxArgs: 1, eval: builtinReverse, typeof: returns(sqltypes.VarBinary)},
+	"right":            {minArgs: 2, maxArgs: 2, eval: builtinRight, typeof: returns(sqltypes.VarBinary)},
+	"rpad":             {minArgs: 3, maxArgs: 3, eval: builtinPad(false), typeof: returns(sqltypes.VarBinary)},
+	"rtrim":            {minArgs: 1, maxArgs: 1, eval: builtinTrim(false, true), typeof: returns(sqltypes.VarBinary)},
+	"sha":              {minArgs: 1, maxArgs: 1, eval: builtinSha1, typeof: returns(sqltypes.VarBinary)},
+	"sha1":             {minArgs: 1, maxArgs: 1, eval: builtinSha1, typeof: returns(sqltypes.VarBinary)},
+	"sha2":             {minArgs: 2, maxArgs: 2, eval: builtinSha2, typeof: returns(sqltypes.VarBinary)},
+	"space":            {minArgs: 1, maxArgs: 1, eval: builtinSpace, typeof: returns(sqltypes.VarBinary)},
+	"strcmp":           {minArgs: 2, maxArgs: 2, eval: builtinStrcmp, typeof: returns(sqltypes.Int64)},
+	"substr":           {minArgs: 2, maxArgs: 3, eval: builtinSubstring, typeof: returns(sqltypes.VarBinary)},
+	"substring":        {minArgs: 2, maxArgs: 3, eval: builtinSubstring, typeof: returns(sqltypes.VarBinary)},
+	"substring_index":  {minArgs: 3, maxArgs: 3, eval: builtinSubstringIndex, typeof: returns(sqltypes.VarBinary)},
+	"to_base64":        {minArgs: 1, maxArgs: 1, eval: builtinToBase64, typeof: returns(sqltypes.VarBinary)},
+	"trim":             {minArgs: 1, maxArgs: 1, eval: builtinTrim(true, true), typeof: returns(sqltypes.VarBinary)},
+	"ucase":            {minArgs: 1, maxArgs: 1, eval: builtinUpper, typeof: returns(sqltypes.VarBinary)},
+	"unhex":            {minArgs: 1, maxArgs: 1, eval: builtinUnhex, typeof: returns(sqltypes.VarBinary)},
+	"upper":            {minArgs: 1, maxArgs: 1, eval: builtinUpper, typeof: returns(sqltypes.VarBinary)},
+
+	// numeric functions
+	"abs":      {minArgs: 1, maxArgs: 1, eval: builtinAbs, typeof: numericArgType},
+	"acos":     {minArgs: 1, maxArgs: 1, eval: floatFunction("acos", math.Acos), typeof: returns(sqltypes.Float64)},
+	"asin":     {minArgs: 1, maxArgs: 1, eval: floatFunction("asin", math.Asin), typeof: returns(sqltypes.Float64)},
+	"atan":     {minArgs: 1, maxArgs: 2, eval: builtinAtan, typeof: returns(sqltypes.Float64)},
+	"atan2":    {minArgs: 2, maxArgs: 2, eval: builtinAtan, typeof: returns(sqltypes.Float64)},
+	"ceil":     {minArgs: 1, maxArgs: 1, eval: builtinRounding(math.Ceil), typeof: numericArgType},
+	"ceiling":  {minArgs: 1, maxArgs: 1, eval: builtinRounding(math.Ceil), typeof: numericArgType},
+	"cos":      {minArgs: 1, maxArgs: 1, eval: floatFunction("cos", math.Cos), typeof: returns(sqltypes.Float64)},
+	"cot":      {minArgs: 1, maxArgs: 1, eval: floatFunction("cot", func(f float64) float64 { return 1 / math.Tan(f) }), typeof: returns(sqltypes.Float64)},
+	"degrees":  {minArgs: 1, maxArgs: 1, eval: floatFunction("degrees", func(f float64) float64 { return f * 180 / math.Pi }), typeof: returns(sqltypes.Float64)},
+	"exp":      {minArgs: 1, maxArgs: 1, eval: floatFunction("exp", math.Exp), typeof: returns(sqltypes.Float64)},
+	"floor":    {minArgs: 1, maxArgs: 1, eval: builtinRounding(math.Floor), typeof: numericArgType},
+	"greatest": {minArgs: 2, maxArgs: variadic, eval: builtinMinMax(1), typeof: mergeTypesFrom(0)},
+	"least":    {minArgs: 2, maxArgs: variadic, eval: builtinMinMax(-1), typeof: mergeTypesFrom(0)},
+	"ln":       {minArgs: 1, maxArgs: 1, eval: logarithm("ln", math.Log), typeof: returns(sqltypes.Float64)},
+	"log":      {minArgs: 1, maxArgs: 2, eval: builtinLog, typeof: returns(sqltypes.Float64)},
+	"log10":    {minArgs: 1, maxArgs: 1, eval: logarithm("log10", math.Log10), typeof: returns(sqltypes.Float64)},
+	"log2":     {minArgs: 1, maxArgs: 1, eval: logarithm("log2", math.Log2), typeof: returns(sqltypes.Float64)},
+	"mod":      {minArgs: 2, maxArgs: 2, eval: builtinMod, typeof: mergeTypesFrom(0)},
+	"pi":       {minArgs: 0, maxArgs: 0, eval: builtinPi, typeof: returns(sqltypes.Float64)},
+	"pow":      {minArgs: 2, maxArgs: 2, eval: builtinPow, typeof: returns(sqltypes.Float64)},
+	"power":    {minArgs: 2, maxArgs: 2, eval: builtinPow, typeof: returns(sqltypes.Float64)},
+	"radians":  {minArgs: 1, maxArgs: 1, eval: floatFunction("radians", func(f float64) float64 { return f * math.Pi / 180 }), typeof: returns(sqltypes.Float64)},
+	"round":    {minArgs: 1, maxArgs: 2, eval: builtinRound(true), typeof: numericArgType},
+	"sign":     {minArgs: 1, maxArgs: 1, eval: builtinSign, typeof: returns(sqltypes.Int64)},
+	"sin":      {minArgs: 1, maxArgs: 1, eval: floatFunction("sin", math.Sin), typeof: returns(sqltypes.Float64)},
+	"sqrt":     {minArgs: 1, maxArgs: 1, eval: floatFunction("sqrt", math.Sqrt), typeof: returns(sqltypes.Float64)},
+	"tan":      {minArgs: 1, maxArgs: 1, eval: floatFunction("tan", math.Tan), typeof: returns(sqltypes.Float64)},
+	"truncate": {minArgs: 2, maxArgs: 2, eval: builtinRound(false), typeof: numericArgType},
+
+	// temporal functions
+	"date":        {minArgs: 1, maxArgs: 1, eval: builtinDate, typeof: returns(sqltypes.Date)},
+	"date_format": {minArgs: 2, maxArgs: 2, eval: builtinDateFormat, typeof: returns(sqltypes.VarBinary)},
+	"datediff":    {minArgs: 2, maxArgs: 2, eval: builtinDateDiff, typeof: returns(sqltypes.Int64)},
+	"day":         {minArgs: 1, maxArgs: 1, eval: datePart(time.Time.Day), typeof: returns(sqltypes.Int64)},
+	"dayname":     {minArgs: 1, maxArgs: 1, eval: dateName(func(t time.Time) string { return t.Weekday().String() }), typeof: returns(sqltypes.VarBinary)},
+	"dayofmonth":  {minArgs: 1, maxArgs: 1, eval: datePart(time.Time.Day), typeof: returns(sqltypes.Int64)},
+	"dayofweek":   {minArgs: 1, maxArgs: 1, eval: datePart(func(t time.Time) int { return int(t.Weekday()) + 1 }), typeof: returns(sqltypes.Int64)},
+	"dayofyear":   {minArgs: 1, maxArgs: 1, eval: datePart(time.Time.YearDay), typeof: returns(sqltypes.Int64)},
+	"from_days":   {minArgs: 1, maxArgs: 1, eval: builtinFromDays, typeof: returns(sqltypes.Date)},
+	"hour":        {minArgs: 1, maxArgs: 1, eval: timePart(func(d time.Duration) int64 { return int64(d / time.Hour) }), typeof: returns(sqltypes.Int64)},
+	"last_day":    {minArgs: 1, maxArgs: 1, eval: builtinLastDay, typeof: returns(sqltypes.Date)},
+	"makedate":    {minArgs: 2, maxArgs: 2, eval: builtinMakeDate, typeof: returns(sqltypes.Date)},
+	"microsecond": {minArgs: 1, maxArgs: 1, eval: timePart(func(d time.Duration) int64 { return int64(d/time.Microsecond) % 1e6 }), typeof: returns(sqltypes.Int64)},
+	"minute":      {minArgs: 1, maxArgs: 1, eval: timePart(func(d time.Duration) int64 { return int64(d/time.Minute) % 60 }), typeof: returns(sqltypes.Int64)},
+	"month":       {minArgs: 1, maxArgs: 1, eval: datePart(func(t time.Time) int { return int(t.Month()) }), typeof: returns(sqltypes.Int64)},
+	"monthname":   {minArgs: 1, maxArgs: 1, eval: dateName(func(t time.Time) string { return t.Month().String() }), typeof: returns(sqltypes.VarBinary)},
+	"quarter":     {minArgs: 1, maxArgs: 1, eval: datePart(func(t time.Time) int { return (int(t.Month()) + 2) / 3 }), typeof: returns(sqltypes.Int64)},
+	"sec_to_time": {minArgs: 1, maxArgs: 1, eval: builtinSecToTime, typeof: returns(sqltypes.Time)},
+	"second":      {minArgs: 1, maxArgs: 1, eval: timePart(func(d time.Duration) int64 { return int64(d/time.Second) % 60 }), typeof: returns(sqltypes.Int64)},
+	"time":        {minArgs: 1, maxArgs: 1, eval: builtinTime, typeof: returns(sqltypes.Time)},
+	"time_to_sec": {minArgs: 1, maxArgs: 1, eval: builtinTimeToSec, typeof: returns(sqltypes.Int64)},
+	"to_days":     {minArgs: 1, maxArgs: 1, eval: builtinToDays, typeof: returns(sqltypes.Int64)},
+	"week":        {minArgs: 1, maxArgs: 2, eval: builtinWeek, typeof: returns(sqltypes.Int64)},
+	"weekday":     {minArgs: 1, maxArgs: 1, eval: datePart(func(t time.Time) int { return (int(t.Weekday()) + 6) % 7 }), typeof: returns(sqltypes.Int64)},
+	"weekofyear":  {minArgs: 1, maxArgs: 1, eval: builtinWeekOfYear, typeof: returns(sqltypes.Int64)},
+	"year":        {minArgs: 1, maxArgs: 1, eval: datePart(time.Time.Year), typeof: returns(sqltypes.Int64)},
+}
+
+// NewCallExpr returns a call to a builtin function. It returns
+// ErrUnsupportedFunction if the function is not implemented by the engine.
+func NewCallExpr(name string, args []Expr) (Expr, error) {
+	name = strings.ToLower(name)
+	b, ok := builtinFunctions[name]
+	if !ok {
+		return nil, ErrUnsupportedFunction
+	}
+	if len(args) < b.minArgs || (b.maxArgs != variadic && len(args) > b.maxArgs) {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongParamcountToNativeFct, "Incorrect parameter count in the call to native function '%s'", name)
+	}
+	return &CallExpr{Name: name, Arguments: args, builtin: b}, nil
+}
+
+// Evaluate implements the Expr interface
+func (c *CallExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	if c.builtin.lazy != nil {
+		return c.builtin.lazy(env, c.Arguments)
+	}
+	args := make([]EvalResult, len(c.Arguments))
+	for i, arg := range c.Arguments {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		args[i] = val
+	}
+	return c.builtin.eval(args)
+}
+
+// Type implements the Expr interface
+func (c *CallExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	types := make([]querypb.Type, len(c.Arguments))
+	for i, arg := range c.Arguments {
+		typ, err := arg.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types[i] = typ
+	}
+	return c.builtin.typeof(types), nil
+}
+
+// String implements the Expr interface
+func (c *CallExpr) String() string {
+	args := make([]string, len(c.Arguments))
+	for i, arg := range c.Arguments {
+		args[i] = arg.String()
+	}
+	return c.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func returns(typ querypb.Type) func([]querypb.Type) querypb.Type {
+	return func([]querypb.Type) querypb.Type {
+		return typ
+	}
+}
+
+func firstArgType(args []querypb.Type) querypb.Type {
+	return args[0]
+}
+
+func numericArgType(args []querypb.Type) querypb.Type {
+	if sqltypes.IsNumber(args[0]) {
+		return args[0]
+	}
+	return sqltypes.Float64
+}
+
+// mergeTypesFrom returns the type of functions that return one of their
+// arguments, starting at the given one.
+func mergeTypesFrom(first int) func([]querypb.Type) querypb.Type {
+	return func(args []querypb.Type) querypb.Type {
+		var result querypb.Type
+		for _, typ := range args[first:] {
+			switch {
+			case typ == sqltypes.Null:
+				continue
+			case result == sqltypes.Null || result == typ:
+				result = typ
+			case sqltypes.IsNumber(result) && sqltypes.IsNumber(typ):
+				result = mergeNumericalTypes(result, typ)
+			default:
+				return sqltypes.VarBinary
+			}
+		}
+		return result
+	}
+}
+
+func anyNull(args []EvalResult) bool {
+	for _, arg := range args {
+		if arg.isNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// The string functions work on characters, which are runes for strings,
+// and bytes for binary strings.
+
+func isBinaryString(e EvalResult) bool {
+	return e.collation == collationBinary
+}
+
+func toChars(e EvalResult) []rune {
+	if isBinaryString(e) {
+		return bytesToRunes(e.bytes)
+	}
+	return []rune(string(e.bytes))
+}
+
+func fromChars(chars []rune, like EvalResult) EvalResult {
+	if isBinaryString(like) {
+		b := make([]byte, len(chars))
+		for i, c := range chars {
+			b[i] = byte(c)
+		}
+		return newEvalString(b, like.collation, like.coercion)
+	}
+	return newEvalString([]byte(string(chars)), like.collation, like.coercion)
+}
+
+func charCount(e EvalResult) int {
+	if isBinaryString(e) {
+		return len(e.bytes)
+	}
+	return utf8.RuneCount(e.bytes)
+}
+
+// withBytes returns a string with the same collation as the given value
+func withBytes(b []byte, like EvalResult) EvalResult {
+	return newEvalString(b, like.collation, like.coercion)
+}
+
+// mergeStringCollations returns the collation of the result of a function
+// that combines strings, such as CONCAT(). If any of the strings is binary,
+// the result is binary.
+func mergeStringCollations(args []EvalResult, operation string) (EvalResult, error) {
+	result := args[0]
+	for _, arg := range args[1:] {
+		if isBinaryString(result) {
+			break
+		}
+		if isBinaryString(arg) {
+			result = arg
+			continue
+		}
+		coll, coercion, err := mergeCollations(result, arg, operation)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		result = EvalResult{collation: coll.id, coercion: coercion}
+	}
+	return EvalResult{collation: result.collation, coercion: result.coercion}, nil
+}
+
+func toStrings(args []EvalResult) []EvalResult {
+	strs := make([]EvalResult, len(args))
+	for i, arg := range args {
+		strs[i] = arg.toStringResult()
+	}
+	return strs
+}
+
+func builtinASCII(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	str := args[0].toBytes()
+	if len(str) == 0 {
+		return newEvalInt64(0), nil
+	}
+	return newEvalInt64(int64(str[0])), nil
+}
+
+func builtinBitLength(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	return newEvalInt64(int64(len(args[0].toBytes())) * 8), nil
+}
+
+func builtinLength(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	return newEvalInt64(int64(len(args[0].toBytes()))), nil
+}
+
+func builtinCharLength(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	return newEvalInt64(int64(charCount(args[0].toStringResult()))), nil
+}
+
+func builtinConcat(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	args = toStrings(args)
+	result, err := mergeStringCollations(args, "concat")
+	if err != nil {
+		return EvalResult{}, err
+	}
+	var buf []byte
+	for _, arg := range args {
+		buf = append(buf, arg.bytes...)
+	}
+	if len(buf) > maxStringLength {
+		return resultNull, nil
+	}
+	return withBytes(buf, result), nil
+}
+
+func builtinConcatWs(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	// NULL values are skipped
+	var strs []EvalResult
+	for _, arg := range args {
+		if !arg.isNull() {
+			strs = append(strs, arg.toStringResult())
+		}
+	}
+	result, err := mergeStringCollations(strs, "concat_ws")
+	if err != nil {
+		return EvalResult{}, err
+	}
+	var buf []byte
+	for i, str := range strs[1:] {
+		if i > 0 {
+			buf = append(buf, strs[0].bytes...)
+		}
+		buf = append(buf, str.bytes...)
+	}
+	if len(buf) > maxStringLength {
+		return resultNull, nil
+	}
+	return withBytes(buf, result), nil
+}
+
+func builtinElt(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	n := args[0].toInt64()
+	if n < 1 || n >= int64(len(args)) {
+		return resultNull, nil
+	}
+	return args[n].toStringResult(), nil
+}
+
+func builtinField(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return newEvalInt64(0), nil
+	}
+	for i, arg := range args[1:] {
+		if arg.isNull() {
+			continue
+		}
+		cmp, err := compareValues(args[0], arg, "field")
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if cmp == 0 {
+			return newEvalInt64(int64(i + 1)), nil
+		}
+	}
+	return newEvalInt64(0), nil
+}
+
+func builtinFindInSet(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	str, list := args[0].toStringResult(), args[1].toStringResult()
+	coll, _, err := mergeCollations(str, list, "find_in_set")
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if bytes.IndexByte(str.bytes, ',') >= 0 {
+		return newEvalInt64(0), nil
+	}
+	for i, elem := range bytes.Split(list.bytes, []byte(",")) {
+		if coll.compareStrings(elem, str.bytes) == 0 {
+			return newEvalInt64(int64(i + 1)), nil
+		}
+	}
+	return newEvalInt64(0), nil
+}
+
+func builtinHex(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	var str string
+	if isStringType(args[0].typ) || isTemporalType(args[0].typ) {
+		str = hex.EncodeToString(args[0].bytes)
+	} else {
+		str = strconv.FormatUint(args[0].toUint64(), 16)
+	}
+	return newEvalString([]byte(strings.ToUpper(str)), collationDefault, coerceCoercible), nil
+}
+
+func builtinUnhex(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	str := args[0].toBytes()
+	if len(str)%2 == 1 {
+		str = append([]byte{'0'}, str...)
+	}
+	decoded := make([]byte, hex.DecodedLen(len(str)))
+	if _, err := hex.Decode(decoded, str); err != nil {
+		return resultNull, nil
+	}
+	return newEvalString(decoded, collationBinary, coerceCoercible), nil
+}
+
+// builtinBase returns BIN() or OCT()
+func builtinBase(base int) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if args[0].isNull() {
+			return resultNull, nil
+		}
+		str := strconv.FormatUint(args[0].toUint64(), base)
+		return newEvalString([]byte(str), collationDefault, coerceCoercible), nil
+	}
+}
+
+func builtinChar(args []EvalResult) (EvalResult, error) {
+	var buf []byte
+	for _, arg := range args {
+		if arg.isNull() {
+			continue
+		}
+		// each argument is written as the shortest big endian sequence of bytes
+		n := arg.toUint64() & math.MaxUint32
+		var char []byte
+		for ; n > 0; n >>= 8 {
+			char = append([]byte{byte(n)}, char...)
+		}
+		buf = append(buf, char...)
+	}
+	return newEvalString(buf, collationBinary, coerceCoercible), nil
+}
+
+func builtinLower(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	str := args[0].toStringResult()
+	if isBinaryString(str) {
+		return str, nil
+	}
+	return withBytes(bytes.ToLower(str.bytes), str), nil
+}
+
+func builtinUpper(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	str := args[0].toStringResult()
+	if isBinaryString(str) {
+		return str, nil
+	}
+	return withBytes(bytes.ToUpper(str.bytes), str), nil
+}
+
+func builtinLeft(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	str := args[0].toStringResult()
+	chars := toChars(str)
+	n := args[1].toInt64()
+	if n < 0 {
+		n = 0
+	}
+	if n < int64(len(chars)) {
+		chars = chars[:n]
+	}
+	return fromChars(chars, str), nil
+}
+
+func builtinRight(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	str := args[0].toStringResult()
+	chars := toChars(str)
+	n := args[1].toInt64()
+	if n < 0 {
+		n = 0
+	}
+	if n < int64(len(chars)) {
+		chars = chars[int64(len(chars))-n:]
+	}
+	return fromChars(chars, str), nil
+}
+
+// builtinPad returns LPAD() or RPAD()
+func builtinPad(left bool) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if anyNull(args) {
+			return resultNull, nil
+		}
+		str, pad := args[0].toStringResult(), args[2].toStringResult()
+		length := args[1].toInt64()
+		if length < 0 || length > maxStringLength {
+			return resultNull, nil
+		}
+		chars := toChars(str)
+		if length <= int64(len(chars)) {
+			return fromChars(chars[:length], str), nil
+		}
+		padChars := toChars(pad)
+		if len(padChars) == 0 {
+			return resultNull, nil
+		}
+		padding := make([]rune, 0, length-int64(len(chars)))
+		for int64(len(padding)) < length-int64(len(chars)) {
+			padding = append(padding, padChars[len(padding)%len(padChars)])
+		}
+		if left {
+			return fromChars(append(padding, chars...), str), nil
+		}
+		return fromChars(append(chars, padding...), str), nil
+	}
+}
+
+// builtinTrim returns TRIM(), LTRIM() or RTRIM(), that remove spaces
+func builtinTrim(left, right bool) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if args[0].isNull() {
+			return resultNull, nil
+		}
+		str := args[0].toStringResult()
+		b := str.bytes
+		if left {
+			b = bytes.TrimLeft(b, " ")
+		}
+		if right {
+			b = bytes.TrimRight(b, " ")
+		}
+		return withBytes(b, str), nil
+	}
+}
+
+func builtinRepeat(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	str := args[0].toStringResult()
+	n := args[1].toInt64()
+	if n <= 0 {
+		return withBytes(nil, str), nil
+	}
+	if int64(len(str.bytes))*n > maxStringLength {
+		return resultNull, nil
+	}
+	return withBytes(bytes.Repeat(str.bytes, int(n)), str), nil
+}
+
+// builtinReplace replaces all the occurrences of a string. Like in MySQL,
+// the search is case sensitive regardless of the collation.
+func builtinReplace(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	args = toStrings(args)
+	if len(args[1].bytes) == 0 {
+		return args[0], nil
+	}
+	result := bytes.Replace(args[0].bytes, args[1].bytes, args[2].bytes, -1)
+	if len(result) > maxStringLength {
+		return resultNull, nil
+	}
+	return withBytes(result, args[0]), nil
+}
+
+func builtinReverse(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	str := args[0].toStringResult()
+	chars := toChars(str)
+	for i, j := 0, len(chars)-1; i < j; i, j = i+1, j-1 {
+		chars[i], chars[j] = chars[j], chars[i]
+	}
+	return fromChars(chars, str), nil
+}
+
+func builtinSpace(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	n := args[0].toInt64()
+	if n < 0 {
+		n = 0
+	}
+	if n > maxStringLength {
+		return resultNull, nil
+	}
+	return newEvalString(bytes.Repeat([]byte{' '}, int(n)), collationDefault, coerceCoercible), nil
+}
+
+func builtinStrcmp(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	args = toStrings(args)
+	coll, _, err := mergeCollations(args[0], args[1], "strcmp")
+	if err != nil {
+		return EvalResult{}, err
+	}
+	cmp := coll.compareStrings(args[0].bytes, args[1].bytes)
+	switch {
+	case cmp < 0:
+		return newEvalInt64(-1), nil
+	case cmp > 0:
+		return newEvalInt64(1), nil
+	}
+	return newEvalInt64(0), nil
+}
+
+// builtinSubstring is SUBSTRING(str, pos[, len]). A negative position
+// is counted from the end of the string.
+func builtinSubstring(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	str := args[0].toStringResult()
+	chars := toChars(str)
+	pos := args[1].toInt64()
+	length := int64(len(chars))
+	if len(args) > 2 {
+		length = args[2].toInt64()
+	}
+	switch {
+	case pos > 0:
+		pos--
+	case pos < 0:
+		pos += int64(len(chars))
+	default:
+		return fromChars(nil, str), nil
+	}
+	if pos < 0 || pos >= int64(len(chars)) || length <= 0 {
+		return fromChars(nil, str), nil
+	}
+	end := int64(len(chars))
+	if length < end-pos {
+		end = pos + length
+	}
+	return fromChars(chars[pos:end], str), nil
+}
+
+// builtinSubstringIndex returns the substring before count occurrences of
+// the delimiter, or after them if count is negative. The search is case
+// sensitive.
+func builtinSubstringIndex(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	str, delim := args[0].toStringResult(), args[1].toStringResult()
+	count := args[2].toInt64()
+	if count == 0 || len(delim.bytes) == 0 {
+		return withBytes(nil, str), nil
+	}
+	parts := bytes.Split(str.bytes, delim.bytes)
+	if count > 0 {
+		if count >= int64(len(parts)) {
+			return str, nil
+		}
+		return withBytes(bytes.Join(parts[:count], delim.bytes), str), nil
+	}
+	if -count >= int64(len(parts)) {
+		return str, nil
+	}
+	return withBytes(bytes.Join(parts[int64(len(parts))+count:], delim.bytes), str), nil
+}
+
+// indexOf returns the position of the first occurrence of sub in str,
+// starting at the given character, or -1. The search uses the collation.
+func indexOf(str, sub EvalResult, start int) (int, error) {
+	coll, _, err := mergeCollations(str, sub, "locate")
+	if err != nil {
+		return 0, err
+	}
+	fold := coll.fold
+	if fold == nil {
+		fold = identityRune
+	}
+	strChars, subChars := toChars(str), toChars(sub)
+	if isBinaryString(str) != isBinaryString(sub) {
+		// a binary search uses the bytes of both strings
+		strChars, subChars = bytesToRunes(str.bytes), bytesToRunes(sub.bytes)
+	}
+outer:
+	for i := start; i+len(subChars) <= len(strChars); i++ {
+		for j, c := range subChars {
+			if fold(strChars[i+j]) != fold(c) {
+				continue outer
+			}
+		}
+		return i, nil
+	}
+	return -1, nil
+}
+
+func builtinInstr(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	pos, err := indexOf(args[0].toStringResult(), args[1].toStringResult(), 0)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return newEvalInt64(int64(pos + 1)), nil
+}
+
+func builtinLocate(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	start := int64(1)
+	if len(args) > 2 {
+		start = args[2].toInt64()
+	}
+	str := args[1].toStringResult()
+	if start < 1 || start > int64(charCount(str))+1 {
+		return newEvalInt64(0), nil
+	}
+	pos, err := indexOf(str, args[0].toStringResult(), int(start-1))
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return newEvalInt64(int64(pos + 1)), nil
+}
+
+func builtinToBase64(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	encoded := base64.StdEncoding.EncodeToString(args[0].toBytes())
+	// MySQL wraps the encoded string every 76 characters
+	var buf []byte
+	for len(encoded) > 76 {
+		buf = append(buf, encoded[:76]...)
+		buf = append(buf, '\n')
+		encoded = encoded[76:]
+	}
+	buf = append(buf, encoded...)
+	return newEvalString(buf, collationDefault, coerceCoercible), nil
+}
+
+func builtinFromBase64(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	str := bytes.Map(func(r rune) rune {
+		if r == '\n' || r == '\r' || r == '\t' || r == ' ' {
+			return -1
+		}
+		return r
+	}, args[0].toBytes())
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(str)))
+	n, err := base64.StdEncoding.Decode(decoded, str)
+	if err != nil {
+		return resultNull, nil
+	}
+	return newEvalString(decoded[:n], collationBinary, coerceCoercible), nil
+}
+
+func hexString(sum []byte) EvalResult {
+	return newEvalString([]byte(hex.EncodeToString(sum)), collationDefault, coerceCoercible)
+}
+
+func builtinMd5(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	sum := md5.Sum(args[0].toBytes())
+	return hexString(sum[:]), nil
+}
+
+func builtinSha1(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	sum := sha1.Sum(args[0].toBytes())
+	return hexString(sum[:]), nil
+}
+
+func builtinSha2(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	str := args[0].toBytes()
+	switch args[1].toInt64() {
+	case 224:
+		sum := sha256.Sum224(str)
+		return hexString(sum[:]), nil
+	case 0, 256:
+		sum := sha256.Sum256(str)
+		return hexString(sum[:]), nil
+	case 384:
+		sum := sha512.Sum384(str)
+		return hexString(sum[:]), nil
+	case 512:
+		sum := sha512.Sum512(str)
+		return hexString(sum[:]), nil
+	}
+	return resultNull, nil
+}
+
+func builtinCrc32(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	return newEvalUint64(uint64(crc32.ChecksumIEEE(args[0].toBytes()))), nil
+}
+
+// The numeric functions
+
+// floatResult returns the result of a function on floats: NaN is
+// returned as NULL, and infinity as an out of range error.
+func floatResult(f float64, name string, args []EvalResult) (EvalResult, error) {
+	switch {
+	case math.IsNaN(f):
+		return resultNull, nil
+	case math.IsInf(f, 0):
+		var strs []string
+		for _, arg := range args {
+			strs = append(strs, string(arg.toBytes()))
+		}
+		return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "DOUBLE value is out of range in '%s(%s)'", name, strings.Join(strs, ","))
+	}
+	return newEvalFloat(f), nil
+}
+
+// floatFunction returns a builtin that applies a math function to its argument
+func floatFunction(name string, f func(float64) float64) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if args[0].isNull() {
+			return resultNull, nil
+		}
+		return floatResult(f(args[0].toFloat()), name, args)
+	}
+}
+
+// logarithm returns a logarithm function, which is NULL for non positive values
+func logarithm(name string, f func(float64) float64) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if args[0].isNull() || args[0].toFloat() <= 0 {
+			return resultNull, nil
+		}
+		return floatResult(f(args[0].toFloat()), name, args)
+	}
+}
+
+// builtinLog is LOG(x) or LOG(base, x)
+func builtinLog(args []EvalResult) (EvalResult, error) {
+	if len(args) == 1 {
+		return logarithm("log", math.Log)(args)
+	}
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	base, x := args[0].toFloat(), args[1].toFloat()
+	if base <= 0 || base == 1 || x <= 0 {
+		return resultNull, nil
+	}
+	return floatResult(math.Log(x)/math.Log(base), "log", args)
+}
+
+func builtinAtan(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	if len(args) == 1 {
+		return floatResult(math.Atan(args[0].toFloat()), "atan", args)
+	}
+	return floatResult(math.Atan2(args[0].toFloat(), args[1].toFloat()), "atan2", args)
+}
+
+func builtinPow(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	return floatResult(math.Pow(args[0].toFloat(), args[1].toFloat()), "pow", args)
+}
+
+func builtinPi([]EvalResult) (EvalResult, error) {
+	return newEvalFloat(math.Pi), nil
+}
+
+func builtinMod(args []EvalResult) (EvalResult, error) {
+	return (&Modulo{}).Evaluate(args[0], args[1])
+}
+
+func builtinAbs(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	val := makeNumeric(args[0])
+	switch val.typ {
+	case sqltypes.Int64:
+		if val.ival == math.MinInt64 {
+			return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in 'abs(%d)'", val.ival)
+		}
+		if val.ival < 0 {
+			return newEvalInt64(-val.ival), nil
+		}
+		return val, nil
+	case sqltypes.Uint64:
+		return val, nil
+	}
+	return newEvalFloat(math.Abs(val.toFloat())), nil
+}
+
+func builtinSign(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	val := makeNumeric(args[0])
+	switch val.typ {
+	case sqltypes.Int64:
+		return newEvalInt64(int64(compareFloats(float64(val.ival), 0))), nil
+	case sqltypes.Uint64:
+		return newEvalInt64(int64(compareFloats(float64(val.uval), 0))), nil
+	}
+	return newEvalInt64(int64(compareFloats(val.toFloat(), 0))), nil
+}
+
+// builtinRounding returns CEIL() or FLOOR(), which do not change integers
+func builtinRounding(f func(float64) float64) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if args[0].isNull() {
+			return resultNull, nil
+		}
+		val := makeNumeric(args[0])
+		if val.isIntegral() {
+			return val, nil
+		}
+		return newEvalFloat(f(val.toFloat())), nil
+	}
+}
+
+// builtinRound returns ROUND(x[, d]), which rounds half away from zero,
+// or TRUNCATE(x, d).
+func builtinRound(round bool) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if anyNull(args) {
+			return resultNull, nil
+		}
+		val := makeNumeric(args[0])
+		var digits int64
+		if len(args) > 1 {
+			digits = args[1].toInt64()
+		}
+		switch {
+		case digits > 30:
+			digits = 30
+		case digits < -30:
+			digits = -30
+		}
+		switch val.typ {
+		case sqltypes.Int64:
+			if digits >= 0 {
+				return val, nil
+			}
+			abs := uint64(val.ival)
+			if val.ival < 0 {
+				abs = uint64(-val.ival)
+			}
+			rounded, ok := roundUint(abs, int(-digits), round)
+			if !ok || rounded > math.MaxInt64 {
+				return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in 'round(%d,%d)'", val.ival, digits)
+			}
+			if val.ival < 0 {
+				return newEvalInt64(-int64(rounded)), nil
+			}
+			return newEvalInt64(int64(rounded)), nil
+		case sqltypes.Uint64:
+			if digits >= 0 {
+				return val, nil
+			}
+			rounded, ok := roundUint(val.uval, int(-digits), round)
+			if !ok {
+				return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT UNSIGNED value is out of range in 'round(%d,%d)'", val.uval, digits)
+			}
+			return newEvalUint64(rounded), nil
+		}
+		return newEvalFloat(roundFloat(val.toFloat(), int(digits), round)), nil
+	}
+}
+
+// roundUint rounds or truncates an integer to the given number of tens
+func roundUint(v uint64, tens int, round bool) (uint64, bool) {
+	if tens > 19 {
+		return 0, true
+	}
+	p := uint64(1)
+	for i := 0; i < tens; i++ {
+		p *= 10
+	}
+	rem := v % p
+	v -= rem
+	if round && rem >= p/2+p%2 && p > 1 {
+		if v > math.MaxUint64-p {
+			return 0, false
+		}
+		v += p
+	}
+	return v, true
+}
+
+// roundFloat rounds or truncates a float to the given number of decimals.
+// The float is rounded using its shortest decimal representation, so that
+// ROUND(2.675, 2) is 2.68, like the DECIMAL literals of MySQL.
+func roundFloat(f float64, digits int, round bool) float64 {
+	if f == 0 || math.IsInf(f, 0) || math.IsNaN(f) {
+		return f
+	}
+	neg := f < 0
+	// the mantissa and exponent of the shortest representation: d.ddddde±x
+	repr := strconv.FormatFloat(math.Abs(f), 'e', -1, 64)
+	mantissa, exp := repr, 0
+	if i := strings.IndexByte(repr, 'e'); i >= 0 {
+		mantissa = repr[:i]
+		exp, _ = strconv.Atoi(repr[i+1:])
+	}
+	decimals := []byte(strings.Replace(mantissa, ".", "", 1))
+	// the position of the decimal point in decimals
+	point := exp + 1
+	keep := point + digits
+	if keep >= len(decimals) {
+		return f
+	}
+	if keep < 0 {
+		return 0
+	}
+	roundUp := round && decimals[keep] >= '5'
+	decimals = decimals[:keep]
+	if roundUp {
+		i := len(decimals) - 1
+		for ; i >= 0; i-- {
+			if decimals[i] < '9' {
+				decimals[i]++
+				break
+			}
+			decimals[i] = '0'
+		}
+		if i < 0 {
+			decimals = append([]byte{'1'}, decimals...)
+			point++
+		}
+	}
+	if len(decimals) == 0 {
+		return 0
+	}
+	result, _ := strconv.ParseFloat(string(decimals)+"e"+strconv.Itoa(point-len(decimals)), 64)
+	if neg {
+		return -result
+	}
+	return result
+}
+
+// builtinMinMax returns GREATEST() if sign is 1, or LEAST() if sign is -1.
+// Integers are compared as integers, numbers as floats, and strings
+// using their collation. Numbers and strings are compared as numbers.
+func builtinMinMax(sign int) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if anyNull(args) {
+			return resultNull, nil
+		}
+		allIntegers, allStrings := true, true
+		for _, arg := range args {
+			allIntegers = allIntegers && arg.isIntegral()
+			allStrings = allStrings && (isStringType(arg.typ) || isTemporalType(arg.typ))
+		}
+		result := args[0]
+		for _, arg := range args[1:] {
+			var cmp int
+			switch {
+			case allIntegers:
+				var err error
+				if cmp, err = compareNumeric(arg, result); err != nil {
+					return EvalResult{}, err
+				}
+			case allStrings:
+				str, res := arg.toStringResult(), result.toStringResult()
+				coll, _, err := mergeCollations(str, res, "greatest")
+				if err != nil {
+					return EvalResult{}, err
+				}
+				cmp = coll.compareStrings(str.bytes, res.bytes)
+			default:
+				cmp = compareFloats(arg.toFloat(), result.toFloat())
+			}
+			if cmp*sign > 0 {
+				result = arg
+			}
+		}
+		if !allIntegers && !allStrings {
+			if result.typ == sqltypes.Float64 || isStringType(result.typ) {
+				return newEvalFloat(result.toFloat()), nil
+			}
+		}
+		return result, nil
+	}
+}
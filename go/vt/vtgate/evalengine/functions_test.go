@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// more tests in go/sqlparser/expressions_test.go
+
+func TestRoundFloat(t *testing.T) {
+	tests := []struct {
+		f        float64
+		digits   int
+		round    bool
+		expected float64
+	}{
+		{2.675, 2, true, 2.68},
+		{2.675, 2, false, 2.67},
+		{-2.5, 0, true, -3},
+		{0.5, 0, true, 1},
+		{0.49999, 0, true, 0},
+		{9.99, 1, true, 10},
+		{1234.5, -2, true, 1200},
+		{1250, -2, true, 1300},
+		{1250, -5, true, 0},
+		{1.5e300, 2, true, 1.5e300},
+		{123.456, 10, true, 123.456},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v,%d,%v", test.f, test.digits, test.round), func(t *testing.T) {
+			assert.Equal(t, test.expected, roundFloat(test.f, test.digits, test.round))
+		})
+	}
+}
+
+func TestCallExpr(t *testing.T) {
+	str := func(s string) Expr { return NewLiteralString([]byte(s)) }
+	binary := func(s string) Expr {
+		expr, _ := NewLiteralStringWithCharset([]byte(s), "binary")
+		return expr
+	}
+	tests := []struct {
+		name     string
+		args     []Expr
+		expected sqltypes.Value
+	}{
+		{"reverse", []Expr{str("ñandú")}, sqltypes.NewVarBinary("údnañ")},
+		{"left", []Expr{str("ñandú"), NewLiteralInt(2)}, sqltypes.NewVarBinary("ña")},
+		{"left", []Expr{binary("ñandú"), NewLiteralInt(2)}, sqltypes.NewVarBinary("ñ")},
+		{"upper", []Expr{binary("abc")}, sqltypes.NewVarBinary("abc")},
+		{"instr", []Expr{str("Foobar"), str("BAR")}, sqltypes.NewInt64(4)},
+		{"instr", []Expr{binary("Foobar"), str("BAR")}, sqltypes.NewInt64(0)},
+		{"find_in_set", []Expr{str("B"), str("a,b,c")}, sqltypes.NewInt64(2)},
+		{"rpad", []Expr{str("abc"), NewLiteralInt(2), str("x")}, sqltypes.NewVarBinary("ab")},
+		{"rpad", []Expr{str("abc"), NewLiteralInt(5), str("")}, sqltypes.NULL},
+		{"elt", []Expr{NewLiteralInt(3), str("a"), str("b")}, sqltypes.NULL},
+		{"char", []Expr{NewLiteralInt(0x4142), NewLiteralNull(), NewLiteralInt(0x43)}, sqltypes.NewVarBinary("ABC")},
+		{"unhex", []Expr{str("4G")}, sqltypes.NULL},
+		{"to_base64", []Expr{str("abc")}, sqltypes.NewVarBinary("YWJj")},
+		{"from_base64", []Expr{str("YWJj")}, sqltypes.NewVarBinary("abc")},
+		{"sha2", []Expr{str("abc"), NewLiteralInt(1)}, sqltypes.NULL},
+		{"crc32", []Expr{str("vitess")}, sqltypes.NewUint64(2680450800)},
+		{"repeat", []Expr{str("ab"), NewLiteralInt(3)}, sqltypes.NewVarBinary("ababab")},
+		{"space", []Expr{NewLiteralInt(-1)}, sqltypes.NewVarBinary("")},
+		{"sign", []Expr{str("-0.5")}, sqltypes.NewInt64(-1)},
+		{"log", []Expr{NewLiteralInt(2), NewLiteralInt(1024)}, sqltypes.NewFloat64(10)},
+		{"ln", []Expr{NewLiteralInt(0)}, sqltypes.NULL},
+		{"greatest", []Expr{NewLiteralInt(1), str("2.5")}, sqltypes.NewFloat64(2.5)},
+		{"least", []Expr{NewLiteralInt(1), NewLiteralNull()}, sqltypes.NULL},
+		{"week", []Expr{str("2021-01-01")}, sqltypes.NewInt64(0)},
+		{"week", []Expr{str("2021-01-01"), NewLiteralInt(3)}, sqltypes.NewInt64(53)},
+		{"weekofyear", []Expr{str("2020-12-31")}, sqltypes.NewInt64(53)},
+		{"to_days", []Expr{str("2021-01-01")}, sqltypes.NewInt64(738156)},
+		{"from_days", []Expr{NewLiteralInt(738156)}, sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-01-01"))},
+		{"last_day", []Expr{str("2020-02-10 10:00:00")}, sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-02-29"))},
+		{"makedate", []Expr{NewLiteralInt(2021), NewLiteralInt(60)}, sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-03-01"))},
+		{"sec_to_time", []Expr{NewLiteralInt(-3661)}, sqltypes.MakeTrusted(sqltypes.Time, []byte("-01:01:01"))},
+		{"time_to_sec", []Expr{str("22:23:00")}, sqltypes.NewInt64(80580)},
+		{"hour", []Expr{str("100:10:00")}, sqltypes.NewInt64(100)},
+		{"month", []Expr{str("2021-13-01")}, sqltypes.NULL},
+		{"dayname", []Expr{str("2021-06-15")}, sqltypes.NewVarBinary("Tuesday")},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s(%v)", test.name, test.args), func(t *testing.T) {
+			call, err := NewCallExpr(test.name, test.args)
+			require.NoError(t, err)
+			result, err := call.Evaluate(ExpressionEnv{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result.Value())
+		})
+	}
+}
+
+func TestNewCallExprErrors(t *testing.T) {
+	_, err := NewCallExpr("now", nil)
+	assert.Equal(t, ErrUnsupportedFunction, err)
+
+	_, err = NewCallExpr("CONCAT", nil)
+	assert.EqualError(t, err, "Incorrect parameter count in the call to native function 'concat'")
+
+	_, err = NewCallExpr("pi", []Expr{NewLiteralInt(1)})
+	assert.EqualError(t, err, "Incorrect parameter count in the call to native function 'pi'")
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// Logical operators, using the three-valued logic of SQL:
+	// NULL is returned when the result cannot be known.
+	And struct{}
+	Or  struct{}
+	Xor struct{}
+	Not struct{}
+
+	// IsExpr is the IS [NOT] NULL, IS [NOT] TRUE and IS [NOT] FALSE operator
+	IsExpr struct {
+		Inner Expr
+		Op    IsOp
+	}
+
+	// IsOp is the test done by an IsExpr
+	IsOp int8
+)
+
+// Tests of an IsExpr
+const (
+	IsNullOp IsOp = iota
+	IsNotNullOp
+	IsTrueOp
+	IsNotTrueOp
+	IsFalseOp
+	IsNotFalseOp
+)
+
+var _ BinaryExpr = (*And)(nil)
+var _ BinaryExpr = (*Or)(nil)
+var _ BinaryExpr = (*Xor)(nil)
+var _ UnaryExpr = (*Not)(nil)
+var _ Expr = (*IsExpr)(nil)
+
+// Evaluate implements the BinaryExpr interface
+func (a *And) Evaluate(left, right EvalResult) (EvalResult, error) {
+	switch {
+	case !left.isNull() && !left.isTrue(), !right.isNull() && !right.isTrue():
+		return newEvalBool(false), nil
+	case left.isNull() || right.isNull():
+		return resultNull, nil
+	}
+	return newEvalBool(true), nil
+}
+
+// Evaluate implements the BinaryExpr interface
+func (o *Or) Evaluate(left, right EvalResult) (EvalResult, error) {
+	switch {
+	case !left.isNull() && left.isTrue(), !right.isNull() && right.isTrue():
+		return newEvalBool(true), nil
+	case left.isNull() || right.isNull():
+		return resultNull, nil
+	}
+	return newEvalBool(false), nil
+}
+
+// Evaluate implements the BinaryExpr interface
+func (x *Xor) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	return newEvalBool(left.isTrue() != right.isTrue()), nil
+}
+
+// Evaluate implements the UnaryExpr interface
+func (n *Not) Evaluate(val EvalResult) (EvalResult, error) {
+	if val.isNull() {
+		return resultNull, nil
+	}
+	return newEvalBool(!val.isTrue()), nil
+}
+
+// Type implements the BinaryExpr interface
+func (a *And) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (o *Or) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (x *Xor) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the UnaryExpr interface
+func (n *Not) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String implements the BinaryExpr interface
+func (a *And) String() string {
+	return "and"
+}
+
+// String implements the BinaryExpr interface
+func (o *Or) String() string {
+	return "or"
+}
+
+// String implements the BinaryExpr interface
+func (x *Xor) String() string {
+	return "xor"
+}
+
+// String implements the UnaryExpr interface
+func (n *Not) String() string {
+	return "not "
+}
+
+// Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	var result bool
+	switch i.Op {
+	case IsNullOp:
+		result = val.isNull()
+	case IsNotNullOp:
+		result = !val.isNull()
+	case IsTrueOp:
+		result = !val.isNull() && val.isTrue()
+	case IsNotTrueOp:
+		result = val.isNull() || !val.isTrue()
+	case IsFalseOp:
+		result = !val.isNull() && !val.isTrue()
+	case IsNotFalseOp:
+		result = val.isNull() || val.isTrue()
+	}
+	return newEvalBool(result), nil
+}
+
+// Type implements the Expr interface
+func (i *IsExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (i *IsExpr) String() string {
+	return i.Inner.String() + " " + i.Op.String()
+}
+
+func (op IsOp) String() string {
+	switch op {
+	case IsNullOp:
+		return "is null"
+	case IsNotNullOp:
+		return "is not null"
+	case IsTrueOp:
+		return "is true"
+	case IsNotTrueOp:
+		return "is not true"
+	case IsFalseOp:
+		return "is false"
+	case IsNotFalseOp:
+		return "is not false"
+	}
+	return "is unknown"
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// Binary ops
+	Modulo          struct{}
+	IntegerDivision struct{}
+	BitwiseAnd      struct{}
+	BitwiseOr       struct{}
+	BitwiseXor      struct{}
+	ShiftLeft       struct{}
+	ShiftRight      struct{}
+
+	// Unary ops
+	Negate     struct{}
+	BitwiseNot struct{}
+)
+
+var _ BinaryExpr = (*Modulo)(nil)
+var _ BinaryExpr = (*IntegerDivision)(nil)
+var _ BinaryExpr = (*BitwiseAnd)(nil)
+var _ BinaryExpr = (*BitwiseOr)(nil)
+var _ BinaryExpr = (*BitwiseXor)(nil)
+var _ BinaryExpr = (*ShiftLeft)(nil)
+var _ BinaryExpr = (*ShiftRight)(nil)
+var _ UnaryExpr = (*Negate)(nil)
+var _ UnaryExpr = (*BitwiseNot)(nil)
+
+// Evaluate implements the BinaryExpr interface
+func (m *Modulo) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	l, r := makeNumeric(left), makeNumeric(right)
+	if r.toFloat() == 0 {
+		return resultNull, nil
+	}
+	// the sign of the result is the sign of the dividend
+	switch {
+	case l.typ == sqltypes.Int64 && r.typ == sqltypes.Int64:
+		return newEvalInt64(l.ival % r.ival), nil
+	case l.typ == sqltypes.Uint64 && r.isIntegral():
+		return newEvalUint64(l.uval % absUint64(r)), nil
+	case l.typ == sqltypes.Int64 && r.typ == sqltypes.Uint64:
+		if l.ival < 0 {
+			return newEvalInt64(-int64(uint64(-l.ival) % r.uval)), nil
+		}
+		return newEvalInt64(int64(uint64(l.ival) % r.uval)), nil
+	}
+	return newEvalFloat(math.Mod(l.toFloat(), r.toFloat())), nil
+}
+
+func absUint64(v EvalResult) uint64 {
+	if v.typ == sqltypes.Int64 {
+		if v.ival < 0 {
+			return uint64(-v.ival)
+		}
+		return uint64(v.ival)
+	}
+	return v.uval
+}
+
+// Evaluate implements the BinaryExpr interface
+func (i *IntegerDivision) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	l, r := makeNumeric(left), makeNumeric(right)
+	if r.toFloat() == 0 {
+		return resultNull, nil
+	}
+	switch {
+	case l.typ == sqltypes.Int64 && r.typ == sqltypes.Int64:
+		if l.ival == math.MinInt64 && r.ival == -1 {
+			return EvalResult{}, outOfRange("BIGINT", l, "DIV", r)
+		}
+		return newEvalInt64(l.ival / r.ival), nil
+	case l.typ == sqltypes.Uint64 && r.typ == sqltypes.Uint64:
+		return newEvalUint64(l.uval / r.uval), nil
+	case l.typ == sqltypes.Uint64 && r.typ == sqltypes.Int64:
+		if r.ival < 0 {
+			if l.uval < uint64(-r.ival) {
+				return newEvalUint64(0), nil
+			}
+			return EvalResult{}, outOfRange("BIGINT UNSIGNED", l, "DIV", r)
+		}
+		return newEvalUint64(l.uval / uint64(r.ival)), nil
+	case l.typ == sqltypes.Int64 && r.typ == sqltypes.Uint64:
+		if l.ival < 0 {
+			if uint64(-l.ival) < r.uval {
+				return newEvalUint64(0), nil
+			}
+			return EvalResult{}, outOfRange("BIGINT UNSIGNED", l, "DIV", r)
+		}
+		return newEvalUint64(uint64(l.ival) / r.uval), nil
+	}
+	result := math.Trunc(l.toFloat() / r.toFloat())
+	if result < math.MinInt64 || result >= math.MaxInt64 {
+		return EvalResult{}, outOfRange("BIGINT", l, "DIV", r)
+	}
+	return newEvalInt64(int64(result)), nil
+}
+
+func outOfRange(typ string, left EvalResult, op string, right EvalResult) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "%s value is out of range in '(%s %s %s)'", typ, left.toBytes(), op, right.toBytes())
+}
+
+func evaluateBitwise(left, right EvalResult, op func(l, r uint64) uint64) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	return newEvalUint64(op(left.toUint64(), right.toUint64())), nil
+}
+
+// Evaluate implements the BinaryExpr interface
+func (b *BitwiseAnd) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateBitwise(left, right, func(l, r uint64) uint64 { return l & r })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (b *BitwiseOr) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateBitwise(left, right, func(l, r uint64) uint64 { return l | r })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (b *BitwiseXor) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateBitwise(left, right, func(l, r uint64) uint64 { return l ^ r })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (s *ShiftLeft) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateBitwise(left, right, func(l, r uint64) uint64 {
+		if r >= 64 {
+			return 0
+		}
+		return l << r
+	})
+}
+
+// Evaluate implements the BinaryExpr interface
+func (s *ShiftRight) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateBitwise(left, right, func(l, r uint64) uint64 {
+		if r >= 64 {
+			return 0
+		}
+		return l >> r
+	})
+}
+
+// Evaluate implements the UnaryExpr interface
+func (n *Negate) Evaluate(val EvalResult) (EvalResult, error) {
+	if val.isNull() {
+		return resultNull, nil
+	}
+	val = makeNumeric(val)
+	switch val.typ {
+	case sqltypes.Int64:
+		if val.ival == math.MinInt64 {
+			return newEvalUint64(1 << 63), nil
+		}
+		return newEvalInt64(-val.ival), nil
+	case sqltypes.Uint64:
+		if val.uval <= 1<<63 {
+			return newEvalInt64(int64(-val.uval)), nil
+		}
+	}
+	return newEvalFloat(-val.toFloat()), nil
+}
+
+// Evaluate implements the UnaryExpr interface
+func (b *BitwiseNot) Evaluate(val EvalResult) (EvalResult, error) {
+	if val.isNull() {
+		return resultNull, nil
+	}
+	return newEvalUint64(^val.toUint64()), nil
+}
+
+// Type implements the BinaryExpr interface
+func (m *Modulo) Type(left querypb.Type) querypb.Type {
+	return left
+}
+
+// Type implements the BinaryExpr interface
+func (i *IntegerDivision) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (b *BitwiseAnd) Type(querypb.Type) querypb.Type {
+	return sqltypes.Uint64
+}
+
+// Type implements the BinaryExpr interface
+func (b *BitwiseOr) Type(querypb.Type) querypb.Type {
+	return sqltypes.Uint64
+}
+
+// Type implements the BinaryExpr interface
+func (b *BitwiseXor) Type(querypb.Type) querypb.Type {
+	return sqltypes.Uint64
+}
+
+// Type implements the BinaryExpr interface
+func (s *ShiftLeft) Type(querypb.Type) querypb.Type {
+	return sqltypes.Uint64
+}
+
+// Type implements the BinaryExpr interface
+func (s *ShiftRight) Type(querypb.Type) querypb.Type {
+	return sqltypes.Uint64
+}
+
+// Type implements the UnaryExpr interface
+func (n *Negate) Type(inner querypb.Type) querypb.Type {
+	if sqltypes.IsNumber(inner) {
+		return inner
+	}
+	return sqltypes.Float64
+}
+
+// Type implements the UnaryExpr interface
+func (b *BitwiseNot) Type(querypb.Type) querypb.Type {
+	return sqltypes.Uint64
+}
+
+// String implements the BinaryExpr interface
+func (m *Modulo) String() string {
+	return "%"
+}
+
+// String implements the BinaryExpr interface
+func (i *IntegerDivision) String() string {
+	return "div"
+}
+
+// String implements the BinaryExpr interface
+func (b *BitwiseAnd) String() string {
+	return "&"
+}
+
+// String implements the BinaryExpr interface
+func (b *BitwiseOr) String() string {
+	return "|"
+}
+
+// String implements the BinaryExpr interface
+func (b *BitwiseXor) String() string {
+	return "^"
+}
+
+// String implements the BinaryExpr interface
+func (s *ShiftLeft) String() string {
+	return "<<"
+}
+
+// String implements the BinaryExpr interface
+func (s *ShiftRight) String() string {
+	return ">>"
+}
+
+// String implements the UnaryExpr interface
+func (n *Negate) String() string {
+	return "-"
+}
+
+// String implements the UnaryExpr interface
+func (b *BitwiseNot) String() string {
+	return "~"
+}
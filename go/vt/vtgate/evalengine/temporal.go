@@ -0,0 +1,1037 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Dates and datetimes are represented with a time.Time in UTC, and the
+// TIME values, which can be negative or larger than a day, with a
+// time.Duration. The functions that depend on the time zone of the
+// MySQL session, such as NOW() or UNIX_TIMESTAMP(), are not supported.
+
+// maxTime is the largest value of the TIME type: 838:59:59
+const maxTime = 838*time.Hour + 59*time.Minute + 59*time.Second
+
+type (
+	// DateAdd is DATE_ADD(), DATE_SUB() and their synonyms
+	DateAdd struct {
+		Date, Interval Expr
+		// Unit is the unit of the interval, in upper case, such as DAY or HOUR_MINUTE
+		Unit     string
+		Subtract bool
+	}
+
+	// TimestampDiff is TIMESTAMPDIFF(unit, left, right)
+	TimestampDiff struct {
+		Unit        string
+		Left, Right Expr
+	}
+)
+
+var _ Expr = (*DateAdd)(nil)
+var _ Expr = (*TimestampDiff)(nil)
+
+func newEvalDate(t time.Time) EvalResult {
+	return EvalResult{typ: sqltypes.Date, bytes: []byte(t.Format("2006-01-02"))}
+}
+
+func newEvalDatetime(t time.Time) EvalResult {
+	layout := "2006-01-02 15:04:05"
+	if t.Nanosecond() != 0 {
+		layout = "2006-01-02 15:04:05.000000"
+	}
+	return EvalResult{typ: sqltypes.Datetime, bytes: []byte(t.Format(layout))}
+}
+
+func newEvalTime(d time.Duration) EvalResult {
+	return EvalResult{typ: sqltypes.Time, bytes: formatTime(d)}
+}
+
+func formatTime(d time.Duration) []byte {
+	var buf []byte
+	if d < 0 {
+		buf = append(buf, '-')
+		d = -d
+	}
+	hours := int64(d / time.Hour)
+	if hours < 10 {
+		buf = append(buf, '0')
+	}
+	buf = strconv.AppendInt(buf, hours, 10)
+	buf = append(buf, fmt.Sprintf(":%02d:%02d", int64(d/time.Minute)%60, int64(d/time.Second)%60)...)
+	if micros := int64(d/time.Microsecond) % 1e6; micros != 0 {
+		buf = append(buf, fmt.Sprintf(".%06d", micros)...)
+	}
+	return buf
+}
+
+// daysIn returns the number of days of a month
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func twoDigitYear(year int) int {
+	if year < 70 {
+		return 2000 + year
+	}
+	return 1900 + year
+}
+
+// parseDatetime parses the string representation of a DATE or a DATETIME,
+// either delimited ('2021-03-04 10:20:30') or as a number (20210304102030).
+// It also returns whether the value had a time part.
+func parseDatetime(s string) (time.Time, bool, bool) {
+	s = strings.TrimSpace(s)
+	if digits, frac := splitFraction(s); isDigits(digits) && (frac == "" || isDigits(frac)) {
+		var parts []string
+		switch len(digits) {
+		case 6:
+			parts = []string{digits[0:2], digits[2:4], digits[4:6]}
+		case 8:
+			parts = []string{digits[0:4], digits[4:6], digits[6:8]}
+		case 12:
+			parts = []string{digits[0:2], digits[2:4], digits[4:6], digits[6:8], digits[8:10], digits[10:12]}
+		case 14:
+			parts = []string{digits[0:4], digits[4:6], digits[6:8], digits[8:10], digits[10:12], digits[12:14]}
+		default:
+			return time.Time{}, false, false
+		}
+		return makeDatetime(parts, len(parts[0]) == 2, frac)
+	}
+
+	var frac string
+	datePart, timePart := s, ""
+	if i := strings.IndexAny(s, " T"); i >= 0 {
+		datePart, timePart = s[:i], strings.TrimSpace(s[i+1:])
+	}
+	dateFields := strings.FieldsFunc(datePart, func(r rune) bool { return r < '0' || r > '9' })
+	if len(dateFields) != 3 {
+		return time.Time{}, false, false
+	}
+	parts := dateFields
+	if timePart != "" {
+		if i := strings.IndexByte(timePart, '.'); i >= 0 {
+			timePart, frac = timePart[:i], timePart[i+1:]
+		}
+		timeFields := strings.Split(timePart, ":")
+		if len(timeFields) < 2 || len(timeFields) > 3 {
+			return time.Time{}, false, false
+		}
+		for len(timeFields) < 3 {
+			timeFields = append(timeFields, "0")
+		}
+		parts = append(parts, timeFields...)
+	}
+	return makeDatetime(parts, len(dateFields[0]) <= 2, frac)
+}
+
+func splitFraction(s string) (string, string) {
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+	return s, ""
+}
+
+func makeDatetime(parts []string, shortYear bool, frac string) (time.Time, bool, bool) {
+	var values [6]int
+	for i, part := range parts {
+		if !isDigits(part) {
+			return time.Time{}, false, false
+		}
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, false, false
+		}
+		values[i] = v
+	}
+	if shortYear {
+		values[0] = twoDigitYear(values[0])
+	}
+	nanos, ok := parseFraction(frac)
+	if !ok {
+		return time.Time{}, false, false
+	}
+	year, month, day, hour, minute, second := values[0], time.Month(values[1]), values[2], values[3], values[4], values[5]
+	if year > 9999 || month < 1 || month > 12 || day < 1 || day > daysIn(month, year) || hour > 23 || minute > 59 || second > 59 {
+		return time.Time{}, false, false
+	}
+	return time.Date(year, month, day, hour, minute, second, nanos, time.UTC), len(parts) > 3, true
+}
+
+// parseFraction parses the fractional seconds of a time, rounded to microseconds
+func parseFraction(frac string) (int, bool) {
+	if frac == "" {
+		return 0, true
+	}
+	if !isDigits(frac) {
+		return 0, false
+	}
+	for len(frac) < 6 {
+		frac += "0"
+	}
+	micros, err := strconv.Atoi(frac[:6])
+	if err != nil {
+		return 0, false
+	}
+	return micros * 1000, true
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTime parses the string representation of a TIME,
+// either delimited ('[-][D ]HH:MM:SS') or as a number (HHMMSS).
+func parseTime(s string) (time.Duration, bool) {
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	var frac string
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, frac = s[:i], s[i+1:]
+	}
+	var days, hours, minutes, seconds int
+	var err error
+	if strings.Contains(s, ":") {
+		if i := strings.IndexByte(s, ' '); i >= 0 {
+			if days, err = strconv.Atoi(s[:i]); err != nil {
+				return 0, false
+			}
+			s = s[i+1:]
+		}
+		fields := strings.Split(s, ":")
+		if len(fields) > 3 {
+			return 0, false
+		}
+		values := make([]int, 3)
+		for i, field := range fields {
+			if !isDigits(field) {
+				return 0, false
+			}
+			if values[i], err = strconv.Atoi(field); err != nil {
+				return 0, false
+			}
+		}
+		hours, minutes, seconds = values[0], values[1], values[2]
+	} else {
+		if !isDigits(s) {
+			return 0, false
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, false
+		}
+		hours, minutes, seconds = n/10000, n/100%100, n%100
+	}
+	nanos, ok := parseFraction(frac)
+	if !ok || minutes > 59 || seconds > 59 {
+		return 0, false
+	}
+	d := time.Duration(days*24+hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second + time.Duration(nanos)
+	if d > maxTime {
+		d = maxTime
+	}
+	if neg {
+		d = -d
+	}
+	return d, true
+}
+
+// toDatetime returns the date and time of a value. It also returns
+// whether the value had a time part.
+func toDatetime(e EvalResult) (time.Time, bool, bool) {
+	switch e.typ {
+	case sqltypes.Null, sqltypes.Time:
+		return time.Time{}, false, false
+	case sqltypes.Int64, sqltypes.Uint64, sqltypes.Float64:
+		return parseDatetime(string(e.toBytes()))
+	}
+	return parseDatetime(string(e.bytes))
+}
+
+// toTime returns the TIME value of an expression, which is the
+// time part of dates and datetimes.
+func toTime(e EvalResult) (time.Duration, bool) {
+	switch e.typ {
+	case sqltypes.Null:
+		return 0, false
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		t, _, ok := parseDatetime(string(e.bytes))
+		return clock(t), ok
+	}
+	str := string(e.toBytes())
+	if d, ok := parseTime(str); ok {
+		return d, true
+	}
+	if t, hasTime, ok := parseDatetime(str); ok && hasTime {
+		return clock(t), true
+	}
+	return 0, false
+}
+
+func clock(t time.Time) time.Duration {
+	return t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+// temporalToNumeric returns the numeric value of a temporal value, such
+// as 20210304 for a DATE, or 20210304102030 for a DATETIME.
+func temporalToNumeric(e EvalResult) EvalResult {
+	var str []byte
+	if e.typ == sqltypes.Time {
+		d, ok := parseTime(string(e.bytes))
+		if !ok {
+			return newEvalInt64(0)
+		}
+		str = bytes.Replace(formatTime(d), []byte(":"), nil, -1)
+	} else {
+		t, hasTime, ok := parseDatetime(string(e.bytes))
+		if !ok {
+			return newEvalInt64(0)
+		}
+		layout := "20060102"
+		if hasTime || e.typ != sqltypes.Date {
+			layout = "20060102150405"
+			if t.Nanosecond() != 0 {
+				layout += ".000000"
+			}
+		}
+		str = []byte(t.Format(layout))
+	}
+	if ival, err := strconv.ParseInt(string(str), 10, 64); err == nil {
+		return newEvalInt64(ival)
+	}
+	fval, _ := strconv.ParseFloat(string(str), 64)
+	return newEvalFloat(fval)
+}
+
+// compareTemporal compares two values as dates or times, if both of them
+// are temporal values or strings. It returns false if the values cannot
+// be compared this way.
+func compareTemporal(left, right EvalResult) (int, bool) {
+	if !(isTemporalType(left.typ) || isStringType(left.typ)) || !(isTemporalType(right.typ) || isStringType(right.typ)) {
+		return 0, false
+	}
+	if left.typ == sqltypes.Time || right.typ == sqltypes.Time {
+		ltime, lok := toTime(left)
+		rtime, rok := toTime(right)
+		if !lok || !rok {
+			return 0, false
+		}
+		return compareFloats(float64(ltime), float64(rtime)), true
+	}
+	ldate, _, lok := toDatetime(left)
+	rdate, _, rok := toDatetime(right)
+	if !lok || !rok {
+		return 0, false
+	}
+	switch {
+	case ldate.Before(rdate):
+		return -1, true
+	case ldate.After(rdate):
+		return 1, true
+	}
+	return 0, true
+}
+
+func convertToDate(e EvalResult) EvalResult {
+	t, _, ok := toDatetime(e)
+	if !ok {
+		return resultNull
+	}
+	return newEvalDate(t)
+}
+
+func convertToDatetime(e EvalResult) EvalResult {
+	t, _, ok := toDatetime(e)
+	if !ok {
+		return resultNull
+	}
+	return newEvalDatetime(t)
+}
+
+func convertToTime(e EvalResult) EvalResult {
+	d, ok := toTime(e)
+	if !ok {
+		return resultNull
+	}
+	return newEvalTime(d)
+}
+
+// calcDaynr returns the number of days since year 0, like TO_DAYS()
+func calcDaynr(year, month, day int) int {
+	if year == 0 && month == 0 {
+		return 0
+	}
+	delsum := 365*year + 31*(month-1) + day
+	if month <= 2 {
+		year--
+	} else {
+		delsum -= (month*4 + 23) / 10
+	}
+	temp := ((year/100 + 1) * 3) / 4
+	return delsum + year/4 - temp
+}
+
+func calcDaysInYear(year int) int {
+	if year&3 == 0 && (year%100 != 0 || (year%400 == 0 && year != 0)) {
+		return 366
+	}
+	return 365
+}
+
+// Flags of the week modes of WEEK() and DATE_FORMAT()
+const (
+	weekMondayFirst  = 1
+	weekYear         = 2
+	weekFirstWeekday = 4
+)
+
+// weekMode returns the week behaviour of a mode of WEEK()
+func weekMode(mode int) int {
+	mode &= 7
+	if mode&weekMondayFirst == 0 {
+		mode ^= weekFirstWeekday
+	}
+	return mode
+}
+
+// calcWeek returns the week number of a date, and the year it belongs to,
+// with the same algorithm as MySQL.
+func calcWeek(t time.Time, behaviour int) (int, int) {
+	year := t.Year()
+	daynr := calcDaynr(year, int(t.Month()), t.Day())
+	firstDaynr := calcDaynr(year, 1, 1)
+	mondayFirst := behaviour&weekMondayFirst != 0
+	useWeekYear := behaviour&weekYear != 0
+	firstWeekday := behaviour&weekFirstWeekday != 0
+
+	weekday := calcWeekday(firstDaynr, !mondayFirst)
+	if t.Month() == 1 && t.Day() <= 7-weekday {
+		if !useWeekYear && ((firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4)) {
+			return 0, year
+		}
+		useWeekYear = true
+		year--
+		days := calcDaysInYear(year)
+		firstDaynr -= days
+		weekday = (weekday + 53*7 - days) % 7
+	}
+
+	var days int
+	if (firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4) {
+		days = daynr - (firstDaynr + (7 - weekday))
+	} else {
+		days = daynr - (firstDaynr - weekday)
+	}
+	if useWeekYear && days >= 52*7 {
+		weekday = (weekday + calcDaysInYear(year)) % 7
+		if (!firstWeekday && weekday < 4) || (firstWeekday && weekday == 0) {
+			return 1, year + 1
+		}
+	}
+	return days/7 + 1, year
+}
+
+// calcWeekday returns the day of the week of a day number,
+// where 0 is the first day of the week.
+func calcWeekday(daynr int, sundayFirst bool) int {
+	if sundayFirst {
+		return (daynr + 6) % 7
+	}
+	return (daynr + 5) % 7
+}
+
+var shortDayNames = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// formatDate implements DATE_FORMAT()
+func formatDate(t time.Time, format []byte) []byte {
+	var buf []byte
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' || i == len(format)-1 {
+			buf = append(buf, c)
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			buf = append(buf, shortDayNames[t.Weekday()]...)
+		case 'b':
+			buf = append(buf, t.Month().String()[:3]...)
+		case 'c':
+			buf = strconv.AppendInt(buf, int64(t.Month()), 10)
+		case 'D':
+			buf = strconv.AppendInt(buf, int64(t.Day()), 10)
+			buf = append(buf, ordinalSuffix(t.Day())...)
+		case 'd':
+			buf = append(buf, fmt.Sprintf("%02d", t.Day())...)
+		case 'e':
+			buf = strconv.AppendInt(buf, int64(t.Day()), 10)
+		case 'f':
+			buf = append(buf, fmt.Sprintf("%06d", t.Nanosecond()/1000)...)
+		case 'H':
+			buf = append(buf, fmt.Sprintf("%02d", t.Hour())...)
+		case 'h', 'I':
+			buf = append(buf, fmt.Sprintf("%02d", hour12(t))...)
+		case 'i':
+			buf = append(buf, fmt.Sprintf("%02d", t.Minute())...)
+		case 'j':
+			buf = append(buf, fmt.Sprintf("%03d", t.YearDay())...)
+		case 'k':
+			buf = strconv.AppendInt(buf, int64(t.Hour()), 10)
+		case 'l':
+			buf = strconv.AppendInt(buf, int64(hour12(t)), 10)
+		case 'M':
+			buf = append(buf, t.Month().String()...)
+		case 'm':
+			buf = append(buf, fmt.Sprintf("%02d", int(t.Month()))...)
+		case 'p':
+			buf = append(buf, t.Format("PM")...)
+		case 'r':
+			buf = append(buf, fmt.Sprintf("%02d:%02d:%02d %s", hour12(t), t.Minute(), t.Second(), t.Format("PM"))...)
+		case 'S', 's':
+			buf = append(buf, fmt.Sprintf("%02d", t.Second())...)
+		case 'T':
+			buf = append(buf, t.Format("15:04:05")...)
+		case 'U':
+			week, _ := calcWeek(t, weekFirstWeekday)
+			buf = append(buf, fmt.Sprintf("%02d", week)...)
+		case 'u':
+			week, _ := calcWeek(t, weekMondayFirst)
+			buf = append(buf, fmt.Sprintf("%02d", week)...)
+		case 'V':
+			week, _ := calcWeek(t, weekYear|weekFirstWeekday)
+			buf = append(buf, fmt.Sprintf("%02d", week)...)
+		case 'v':
+			week, _ := calcWeek(t, weekYear|weekMondayFirst)
+			buf = append(buf, fmt.Sprintf("%02d", week)...)
+		case 'W':
+			buf = append(buf, t.Weekday().String()...)
+		case 'w':
+			buf = strconv.AppendInt(buf, int64(t.Weekday()), 10)
+		case 'X':
+			_, year := calcWeek(t, weekYear|weekFirstWeekday)
+			buf = append(buf, fmt.Sprintf("%04d", year)...)
+		case 'x':
+			_, year := calcWeek(t, weekYear|weekMondayFirst)
+			buf = append(buf, fmt.Sprintf("%04d", year)...)
+		case 'Y':
+			buf = append(buf, fmt.Sprintf("%04d", t.Year())...)
+		case 'y':
+			buf = append(buf, fmt.Sprintf("%02d", t.Year()%100)...)
+		default:
+			// any other character is copied, including a '%'
+			buf = append(buf, format[i])
+		}
+	}
+	return buf
+}
+
+func hour12(t time.Time) int {
+	h := t.Hour() % 12
+	if h == 0 {
+		return 12
+	}
+	return h
+}
+
+func ordinalSuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// interval is the value of an INTERVAL expression
+type interval struct {
+	months int64
+	// duration holds the days, hours, minutes, seconds and microseconds
+	duration time.Duration
+}
+
+// intervalFields are the fields of each INTERVAL unit, in the order in which
+// they are written. 'y' is a year, 'M' a month, 'd' a day, 'h' an hour,
+// 'm' a minute, 's' a second and 'u' a microsecond.
+var intervalFields = map[string]string{
+	"MICROSECOND":        "u",
+	"SECOND":             "s",
+	"MINUTE":             "m",
+	"HOUR":               "h",
+	"DAY":                "d",
+	"WEEK":               "w",
+	"MONTH":              "M",
+	"QUARTER":            "q",
+	"YEAR":               "y",
+	"SECOND_MICROSECOND": "su",
+	"MINUTE_MICROSECOND": "msu",
+	"MINUTE_SECOND":      "ms",
+	"HOUR_MICROSECOND":   "hmsu",
+	"HOUR_SECOND":        "hms",
+	"HOUR_MINUTE":        "hm",
+	"DAY_MICROSECOND":    "dhmsu",
+	"DAY_SECOND":         "dhms",
+	"DAY_MINUTE":         "dhm",
+	"DAY_HOUR":           "dh",
+	"YEAR_MONTH":         "yM",
+}
+
+// IsIntervalUnit returns true if unit is a valid unit of an INTERVAL expression
+func IsIntervalUnit(unit string) bool {
+	_, ok := intervalFields[strings.ToUpper(unit)]
+	return ok
+}
+
+// isDateUnit returns true for the units that do not change the time of a date
+func isDateUnit(unit string) bool {
+	switch unit {
+	case "DAY", "WEEK", "MONTH", "QUARTER", "YEAR", "YEAR_MONTH":
+		return true
+	}
+	return false
+}
+
+// parseInterval returns the interval represented by a value and a unit.
+// Simple units use the numeric value, while the compound units such as
+// HOUR_MINUTE parse all the groups of digits of the value: if there are
+// less groups than fields, they are assigned to the rightmost fields.
+func parseInterval(val EvalResult, unit string) (interval, bool) {
+	fields := intervalFields[unit]
+	if len(fields) == 1 {
+		var iv interval
+		switch fields[0] {
+		case 's':
+			secs := val.toFloat()
+			if math.Abs(secs) > float64(math.MaxInt64/time.Second) {
+				return interval{}, false
+			}
+			return interval{duration: time.Duration(math.Round(secs*1e6)) * time.Microsecond}, true
+		case 'M', 'q', 'y':
+			iv.months = val.toInt64()
+			switch fields[0] {
+			case 'q':
+				iv.months *= 3
+			case 'y':
+				iv.months *= 12
+			}
+			return iv, math.Abs(float64(iv.months)) < 12*10000
+		}
+		return addIntervalField(iv, fields[0], val.toInt64())
+	}
+
+	str := strings.TrimSpace(string(val.toBytes()))
+	neg := strings.HasPrefix(str, "-")
+	groups := strings.FieldsFunc(str, func(r rune) bool { return r < '0' || r > '9' })
+	if len(groups) == 0 || len(groups) > len(fields) {
+		return interval{}, false
+	}
+	fields = fields[len(fields)-len(groups):]
+	var iv interval
+	for i, group := range groups {
+		if fields[i] == 'u' && len(group) < 6 {
+			// microseconds are the digits of a fraction of seconds
+			group += strings.Repeat("0", 6-len(group))
+		}
+		n, err := strconv.ParseInt(group, 10, 64)
+		if err != nil {
+			return interval{}, false
+		}
+		if fields[i] == 'y' {
+			iv.months += n * 12
+			continue
+		}
+		if fields[i] == 'M' {
+			iv.months += n
+			continue
+		}
+		var ok bool
+		if iv, ok = addIntervalField(iv, fields[i], n); !ok {
+			return interval{}, false
+		}
+	}
+	if neg {
+		iv.months, iv.duration = -iv.months, -iv.duration
+	}
+	return iv, true
+}
+
+func addIntervalField(iv interval, field byte, n int64) (interval, bool) {
+	var unit time.Duration
+	switch field {
+	case 'u':
+		unit = time.Microsecond
+	case 's':
+		unit = time.Second
+	case 'm':
+		unit = time.Minute
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	}
+	if n > int64(math.MaxInt64/unit) || n < int64(math.MinInt64/unit) {
+		return interval{}, false
+	}
+	iv.duration += time.Duration(n) * unit
+	return iv, true
+}
+
+// addInterval adds an interval to a date. Adding months keeps the day of
+// the month, unless it is after the last day of the resulting month.
+func addInterval(t time.Time, iv interval, subtract bool) (time.Time, bool) {
+	if subtract {
+		iv.months, iv.duration = -iv.months, -iv.duration
+	}
+	if iv.months != 0 {
+		months := int64(t.Year())*12 + int64(t.Month()) - 1 + iv.months
+		if months < 0 || months >= 10000*12 {
+			return time.Time{}, false
+		}
+		year, month := int(months/12), time.Month(months%12+1)
+		day := t.Day()
+		if last := daysIn(month, year); day > last {
+			day = last
+		}
+		t = time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	}
+	// time.Duration can hold about 292 years, which is less than the range of dates
+	if iv.duration != 0 {
+		t = t.Add(iv.duration)
+	}
+	if t.Year() < 0 || t.Year() > 9999 {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Evaluate implements the Expr interface
+func (d *DateAdd) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	date, err := d.Date.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	amount, err := d.Interval.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if date.isNull() || amount.isNull() {
+		return resultNull, nil
+	}
+	t, hasTime, ok := toDatetime(date)
+	if !ok {
+		return resultNull, nil
+	}
+	iv, ok := parseInterval(amount, d.Unit)
+	if !ok {
+		return resultNull, nil
+	}
+	if t, ok = addInterval(t, iv, d.Subtract); !ok {
+		return resultNull, nil
+	}
+	if !hasTime && date.typ != sqltypes.Datetime && date.typ != sqltypes.Timestamp && isDateUnit(d.Unit) {
+		return newEvalDate(t), nil
+	}
+	return newEvalDatetime(t), nil
+}
+
+// Type implements the Expr interface
+func (d *DateAdd) Type(env ExpressionEnv) (querypb.Type, error) {
+	typ, err := d.Date.Type(env)
+	if err != nil {
+		return 0, err
+	}
+	if typ == sqltypes.Date && isDateUnit(d.Unit) {
+		return sqltypes.Date, nil
+	}
+	return sqltypes.Datetime, nil
+}
+
+// String implements the Expr interface
+func (d *DateAdd) String() string {
+	name := "DATE_ADD"
+	if d.Subtract {
+		name = "DATE_SUB"
+	}
+	return name + "(" + d.Date.String() + ", INTERVAL " + d.Interval.String() + " " + d.Unit + ")"
+}
+
+// Evaluate implements the Expr interface
+func (t *TimestampDiff) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := t.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := t.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	from, _, ok := toDatetime(left)
+	if !ok {
+		return resultNull, nil
+	}
+	to, _, ok := toDatetime(right)
+	if !ok {
+		return resultNull, nil
+	}
+
+	switch t.Unit {
+	case "MONTH", "QUARTER", "YEAR":
+		months := int64(to.Year()-from.Year())*12 + int64(to.Month()-from.Month())
+		// a month is only complete when the day and time have been reached
+		fromRest := time.Duration(from.Day())*24*time.Hour + clock(from)
+		toRest := time.Duration(to.Day())*24*time.Hour + clock(to)
+		if months > 0 && toRest < fromRest {
+			months--
+		} else if months < 0 && toRest > fromRest {
+			months++
+		}
+		switch t.Unit {
+		case "QUARTER":
+			months /= 3
+		case "YEAR":
+			months /= 12
+		}
+		return newEvalInt64(months), nil
+	}
+
+	diff := to.Sub(from)
+	var unit time.Duration
+	switch t.Unit {
+	case "MICROSECOND":
+		unit = time.Microsecond
+	case "SECOND":
+		unit = time.Second
+	case "MINUTE":
+		unit = time.Minute
+	case "HOUR":
+		unit = time.Hour
+	case "DAY":
+		unit = 24 * time.Hour
+	case "WEEK":
+		unit = 7 * 24 * time.Hour
+	default:
+		return resultNull, nil
+	}
+	return newEvalInt64(int64(diff / unit)), nil
+}
+
+// Type implements the Expr interface
+func (t *TimestampDiff) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (t *TimestampDiff) String() string {
+	return "TIMESTAMPDIFF(" + t.Unit + ", " + t.Left.String() + ", " + t.Right.String() + ")"
+}
+
+// The builtin temporal functions
+
+func builtinDate(args []EvalResult) (EvalResult, error) {
+	return convertToDate(args[0]), nil
+}
+
+func builtinTime(args []EvalResult) (EvalResult, error) {
+	return convertToTime(args[0]), nil
+}
+
+// datePart returns a builtin function that extracts an integer from a date
+func datePart(part func(t time.Time) int) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		t, _, ok := toDatetime(args[0])
+		if !ok {
+			return resultNull, nil
+		}
+		return newEvalInt64(int64(part(t))), nil
+	}
+}
+
+// timePart returns a builtin function that extracts an integer from a time
+func timePart(part func(d time.Duration) int64) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		d, ok := toTime(args[0])
+		if !ok {
+			return resultNull, nil
+		}
+		if d < 0 {
+			d = -d
+		}
+		return newEvalInt64(part(d)), nil
+	}
+}
+
+// dateName returns a builtin function that returns the name of a part of a date
+func dateName(name func(t time.Time) string) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		t, _, ok := toDatetime(args[0])
+		if !ok {
+			return resultNull, nil
+		}
+		return newEvalString([]byte(name(t)), collationDefault, coerceCoercible), nil
+	}
+}
+
+func builtinWeek(args []EvalResult) (EvalResult, error) {
+	t, _, ok := toDatetime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	mode := 0
+	if len(args) > 1 {
+		if args[1].isNull() {
+			return resultNull, nil
+		}
+		mode = int(args[1].toInt64())
+	}
+	week, _ := calcWeek(t, weekMode(mode))
+	return newEvalInt64(int64(week)), nil
+}
+
+func builtinWeekOfYear(args []EvalResult) (EvalResult, error) {
+	t, _, ok := toDatetime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	week, _ := calcWeek(t, weekMode(3))
+	return newEvalInt64(int64(week)), nil
+}
+
+func builtinToDays(args []EvalResult) (EvalResult, error) {
+	t, _, ok := toDatetime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	return newEvalInt64(int64(calcDaynr(t.Year(), int(t.Month()), t.Day()))), nil
+}
+
+func builtinFromDays(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	days := args[0].toInt64()
+	// days before 0001-01-01 are returned as the zero date
+	if days < 366 {
+		return EvalResult{typ: sqltypes.Date, bytes: []byte("0000-00-00")}, nil
+	}
+	if days > int64(calcDaynr(9999, 12, 31)) {
+		return resultNull, nil
+	}
+	return newEvalDate(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(days-366))), nil
+}
+
+func builtinLastDay(args []EvalResult) (EvalResult, error) {
+	t, _, ok := toDatetime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	return newEvalDate(time.Date(t.Year(), t.Month(), daysIn(t.Month(), t.Year()), 0, 0, 0, 0, time.UTC)), nil
+}
+
+func builtinDateDiff(args []EvalResult) (EvalResult, error) {
+	left, _, ok := toDatetime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	right, _, ok := toDatetime(args[1])
+	if !ok {
+		return resultNull, nil
+	}
+	return newEvalInt64(int64(calcDaynr(left.Year(), int(left.Month()), left.Day()) - calcDaynr(right.Year(), int(right.Month()), right.Day()))), nil
+}
+
+func builtinDateFormat(args []EvalResult) (EvalResult, error) {
+	if args[1].isNull() {
+		return resultNull, nil
+	}
+	t, _, ok := toDatetime(args[0])
+	if !ok {
+		// DATE_FORMAT() also accepts TIME values
+		d, ok := toTime(args[0])
+		if !ok {
+			return resultNull, nil
+		}
+		t = time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(d)
+	}
+	return newEvalString(formatDate(t, args[1].toBytes()), collationDefault, coerceCoercible), nil
+}
+
+func builtinTimeToSec(args []EvalResult) (EvalResult, error) {
+	d, ok := toTime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	return newEvalInt64(int64(d / time.Second)), nil
+}
+
+func builtinSecToTime(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	secs := args[0].toFloat()
+	if math.Abs(secs) > maxTime.Seconds() {
+		secs = math.Copysign(maxTime.Seconds(), secs)
+	}
+	return newEvalTime(time.Duration(math.Round(secs*1e6)) * time.Microsecond), nil
+}
+
+func builtinMakeDate(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() || args[1].isNull() {
+		return resultNull, nil
+	}
+	year, dayOfYear := args[0].toInt64(), args[1].toInt64()
+	if dayOfYear <= 0 || year < 0 || year > 9999 {
+		return resultNull, nil
+	}
+	if year < 100 {
+		year = int64(twoDigitYear(int(year)))
+	}
+	t := time.Date(int(year), 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(dayOfYear-1))
+	if t.Year() > 9999 {
+		return resultNull, nil
+	}
+	return newEvalDate(t), nil
+}
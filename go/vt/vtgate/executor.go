@@ -100,6 +100,9 @@ type Executor struct {
 
 	vm            *VSchemaManager
 	schemaTracker SchemaInfo
+
+	// resultCache is nil if the result cache is disabled.
+	resultCache *resultCache
 }
 
 var executorOnce sync.Once
@@ -152,6 +155,7 @@ func NewExecutor(
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
+		http.Handle(pathResultCache, e)
 	})
 	return e
 }
@@ -1146,6 +1150,79 @@ func (e *Executor) MessageStream(ctx context.Context, keyspace string, shard str
 	return formatError(err)
 }
 
+// MessageAck acks messages, for consumerGroup if it's not empty. The ids
+// are routed to their shards through the primary vindex of the message
+// table.
+func (e *Executor) MessageAck(ctx context.Context, keyspace, name, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	table, rss, rssValues, err := e.resolveMessageIDs(ctx, "MessageAck", keyspace, name, ids)
+	if err != nil {
+		return 0, err
+	}
+	count, err := e.scatterConn.MessageAck(ctx, rss, rssValues, table.Name.String(), consumerGroup)
+	return count, formatError(err)
+}
+
+// MessageNack sends messages back for immediate redelivery. The ids are
+// routed to their shards through the primary vindex of the message table.
+func (e *Executor) MessageNack(ctx context.Context, keyspace, name string, ids []*querypb.Value) (int64, error) {
+	table, rss, rssValues, err := e.resolveMessageIDs(ctx, "MessageNack", keyspace, name, ids)
+	if err != nil {
+		return 0, err
+	}
+	count, err := e.scatterConn.MessageNack(ctx, rss, rssValues, table.Name.String())
+	return count, formatError(err)
+}
+
+// resolveMessageIDs finds the message table, and resolves the shards
+// of the ids, along with the ids of each shard.
+func (e *Executor) resolveMessageIDs(ctx context.Context, method, keyspace, name string, ids []*querypb.Value) (*vindexes.Table, []*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	vschema := e.VSchema()
+	table, err := vschema.FindTable(keyspace, name)
+	if err != nil {
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
+
+	var rss []*srvtopo.ResolvedShard
+	var rssValues [][]*querypb.Value
+	switch {
+	case !table.Keyspace.Sharded:
+		// All the ids go to the single shard of the keyspace.
+		rss, _, err = e.resolver.resolver.ResolveDestinations(ctx, table.Keyspace.Name, topodatapb.TabletType_MASTER, nil, []key.Destination{key.DestinationAnyShard{}})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		rssValues = [][]*querypb.Value{ids}
+	case table.Pinned != nil:
+		rss, _, err = e.resolver.resolver.ResolveDestinations(ctx, table.Keyspace.Name, topodatapb.TabletType_MASTER, nil, []key.Destination{key.DestinationKeyspaceID(table.Pinned)})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		rssValues = [][]*querypb.Value{ids}
+	default:
+		if len(table.ColumnVindexes) == 0 {
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "table %s has no primary vindex", name)
+		}
+		// The id of a message table must be its primary vindex column.
+		rowsColValues := make([][]sqltypes.Value, 0, len(ids))
+		for _, id := range ids {
+			rowsColValues = append(rowsColValues, []sqltypes.Value{sqltypes.ProtoToValue(id)})
+		}
+		vcursor, err := newVCursorImpl(ctx, NewSafeSession(nil), sqlparser.MarginComments{}, e, NewLogStats(ctx, method, "", nil), e.vm, vschema, e.resolver.resolver, e.serv, e.warnShardedOnly)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		destinations, err := vindexes.Map(table.ColumnVindexes[0].Vindex, vcursor, rowsColValues)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		rss, rssValues, err = e.resolver.resolver.ResolveDestinations(ctx, table.Keyspace.Name, topodatapb.TabletType_MASTER, ids, destinations)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return table, rss, rssValues, nil
+}
+
 // VSchema returns the VSchema.
 func (e *Executor) VSchema() *vindexes.VSchema {
 	e.mu.Lock()
@@ -1184,6 +1261,9 @@ func (e *Executor) ParseDestinationTarget(targetString string) (string, topodata
 // getPlan computes the plan for the given query. If one is in
 // the cache, it reuses it.
 func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.MarginComments, bindVars map[string]*querypb.BindVariable, skipQueryPlanCache bool, logStats *LogStats) (*engine.Plan, error) {
+	span, _ := trace.NewSpan(vcursor.ctx, "executor.getPlan")
+	defer span.Finish()
+
 	if logStats != nil {
 		logStats.SQL = comments.Leading + sql + comments.Trailing
 		logStats.BindVariables = bindVars
@@ -1229,6 +1309,11 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return plan.(*engine.Plan), nil
 	}
 
+	var resultCacheTablesUsed []string
+	if e.resultCache != nil {
+		resultCacheTablesUsed = resultCacheTables(vcursor, statement, bindVarNeeds)
+	}
+
 	plan, err := planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds, *enableOnlineDDL, *enableDirectDDL)
 	if err != nil {
 		return nil, err
@@ -1236,6 +1321,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 
 	plan.Warnings = vcursor.warnings
 	vcursor.warnings = nil
+	plan.ResultCacheTables = resultCacheTablesUsed
 
 	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) && sqlparser.CachePlan(statement) {
 		e.plans.Set(planKey, plan)
@@ -1282,6 +1368,12 @@ func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request
 		returnAsJSON(response, e.VSchema())
 	case pathScatterStats:
 		e.WriteScatterStats(response)
+	case pathResultCache:
+		items := []resultCacheItem{}
+		if e.resultCache != nil {
+			items = e.resultCache.debugEntries()
+		}
+		returnAsJSON(response, items)
 	default:
 		response.WriteHeader(http.StatusNotFound)
 	}
@@ -1483,8 +1575,8 @@ func (e *Executor) ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, q
 }
 
 // ExecuteMessageStream implements the IExecutor interface
-func (e *Executor) ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, tableName string, callback func(reply *sqltypes.Result) error) error {
-	return e.scatterConn.MessageStream(ctx, rss, tableName, callback)
+func (e *Executor) ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, tableName, consumerGroup string, callback func(reply *sqltypes.Result) error) error {
+	return e.scatterConn.MessageStream(ctx, rss, tableName, consumerGroup, callback)
 }
 
 // ExecuteVStream implements the IExecutor interface
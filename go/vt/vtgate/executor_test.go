@@ -2054,6 +2054,70 @@ func TestExecutorOtherAdmin(t *testing.T) {
 	}
 }
 
+func TestExecutorMessageAck(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+
+	ids := []*querypb.Value{{
+		Type:  sqltypes.VarChar,
+		Value: []byte("1"),
+	}}
+	count, err := executor.MessageAck(context.Background(), "", "user_msgs", "", ids)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	utils.MustMatch(t, ids, sbclookup.MessageIDs, "sbclookup.MessageIDs")
+	assert.Empty(t, sbclookup.MessageConsumerGroup)
+
+	// The consumer group is passed to the shards of the ids.
+	ids = []*querypb.Value{{
+		Type:  sqltypes.VarChar,
+		Value: []byte("1"),
+	}, {
+		Type:  sqltypes.VarChar,
+		Value: []byte("3"),
+	}}
+	count, err = executor.MessageAck(context.Background(), "", "sharded_user_msgs", "g1", ids)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	utils.MustMatch(t, ids[:1], sbc1.MessageIDs, "sbc1.MessageIDs")
+	utils.MustMatch(t, ids[1:], sbc2.MessageIDs, "sbc2.MessageIDs")
+	assert.Equal(t, "g1", sbc1.MessageConsumerGroup)
+	assert.Equal(t, "g1", sbc2.MessageConsumerGroup)
+
+	_, err = executor.MessageAck(context.Background(), "", "nonexistent", "g1", ids)
+	require.EqualError(t, err, "table nonexistent not found")
+}
+
+func TestExecutorMessageNack(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+
+	ids := []*querypb.Value{{
+		Type:  sqltypes.VarChar,
+		Value: []byte("1"),
+	}}
+	count, err := executor.MessageNack(context.Background(), "", "user_msgs", ids)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	utils.MustMatch(t, ids, sbclookup.MessageIDs, "sbclookup.MessageIDs")
+
+	// The ids are routed to their shards through the primary vindex:
+	// 1 maps to -20 and 3 maps to 40-60.
+	ids = []*querypb.Value{{
+		Type:  sqltypes.VarChar,
+		Value: []byte("1"),
+	}, {
+		Type:  sqltypes.VarChar,
+		Value: []byte("3"),
+	}}
+	count, err = executor.MessageNack(context.Background(), "", "sharded_user_msgs", ids)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	utils.MustMatch(t, ids[:1], sbc1.MessageIDs, "sbc1.MessageIDs")
+	utils.MustMatch(t, ids[1:], sbc2.MessageIDs, "sbc2.MessageIDs")
+
+	_, err = executor.MessageNack(context.Background(), "", "nonexistent", ids)
+	require.EqualError(t, err, "table nonexistent not found")
+}
+
 func TestExecutorSavepointInTx(t *testing.T) {
 	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
 	logChan := QueryLogger.Subscribe("TestExecutorSavepoint")
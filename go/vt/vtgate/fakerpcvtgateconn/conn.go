@@ -187,6 +187,16 @@ func (conn *FakeVTGateConn) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
+// MessageAck please see vtgateconn.Impl.MessageAck
+func (conn *FakeVTGateConn) MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	panic("not implemented")
+}
+
+// MessageNack please see vtgateconn.Impl.MessageNack
+func (conn *FakeVTGateConn) MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error) {
+	panic("not implemented")
+}
+
 // VStream streams binlog events.
 func (conn *FakeVTGateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
@@ -206,6 +206,35 @@ func (conn *vtgateConn) ResolveTransaction(ctx context.Context, dtid string) err
 	return vterrors.FromGRPC(err)
 }
 
+func (conn *vtgateConn) MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	request := &vtgatepb.MessageAckRequest{
+		CallerId:      callerid.EffectiveCallerIDFromContext(ctx),
+		Keyspace:      keyspace,
+		Name:          name,
+		Ids:           ids,
+		ConsumerGroup: consumerGroup,
+	}
+	response, err := conn.c.MessageAck(ctx, request)
+	if err != nil {
+		return 0, vterrors.FromGRPC(err)
+	}
+	return int64(response.RowsAffected), nil
+}
+
+func (conn *vtgateConn) MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error) {
+	request := &vtgatepb.MessageNackRequest{
+		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		Keyspace: keyspace,
+		Name:     name,
+		Ids:      ids,
+	}
+	response, err := conn.c.MessageNack(ctx, request)
+	if err != nil {
+		return 0, vterrors.FromGRPC(err)
+	}
+	return int64(response.RowsAffected), nil
+}
+
 type vstreamAdapter struct {
 	stream vtgateservicepb.Vitess_VStreamClient
 }
@@ -249,6 +249,39 @@ func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
+// MessageAck is part of the VTGateService interface
+func (f *fakeVTGateService) MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	if f.hasError {
+		return 0, errTestVtGateError
+	}
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	f.checkCallerID(ctx, "MessageAck")
+	if keyspace != messageKeyspace || name != messageName {
+		return 0, fmt.Errorf("MessageAck: unexpected table %s.%s", keyspace, name)
+	}
+	if consumerGroup != messageConsumerGroup {
+		return 0, fmt.Errorf("MessageAck: unexpected consumer group %s", consumerGroup)
+	}
+	return int64(len(ids)), nil
+}
+
+// MessageNack is part of the VTGateService interface
+func (f *fakeVTGateService) MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error) {
+	if f.hasError {
+		return 0, errTestVtGateError
+	}
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	f.checkCallerID(ctx, "MessageNack")
+	if keyspace != messageKeyspace || name != messageName {
+		return 0, fmt.Errorf("MessageNack: unexpected table %s.%s", keyspace, name)
+	}
+	return int64(len(ids)), nil
+}
+
 func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
 	panic("unimplemented")
 }
@@ -295,6 +328,8 @@ func RunTests(t *testing.T, impl vtgateconn.Impl, fakeServer vtgateservice.VTGat
 	testStreamExecute(t, session)
 	testExecuteBatch(t, session)
 	testPrepare(t, session)
+	testMessageAck(t, conn)
+	testMessageNack(t, conn)
 
 	// force a panic at every call, then test that works
 	fs.panics = true
@@ -302,6 +337,8 @@ func RunTests(t *testing.T, impl vtgateconn.Impl, fakeServer vtgateservice.VTGat
 	testExecuteBatchPanic(t, session)
 	testStreamExecutePanic(t, session)
 	testPreparePanic(t, session)
+	testMessageAckPanic(t, conn)
+	testMessageNackPanic(t, conn)
 	fs.panics = false
 }
 
@@ -321,6 +358,8 @@ func RunErrorTests(t *testing.T, fakeServer vtgateservice.VTGateService) {
 	testExecuteBatchError(t, session, fs)
 	testStreamExecuteError(t, session, fs)
 	testPrepareError(t, session, fs)
+	testMessageAckError(t, conn)
+	testMessageNackError(t, conn)
 	fs.hasError = false
 }
 
@@ -527,6 +566,52 @@ func testPreparePanic(t *testing.T, session *vtgateconn.VTGateSession) {
 	expectPanic(t, err)
 }
 
+func testMessageAck(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	count, err := conn.MessageAck(ctx, messageKeyspace, messageName, messageConsumerGroup, messageIDs)
+	require.NoError(t, err)
+	require.EqualValues(t, len(messageIDs), count)
+
+	_, err = conn.MessageAck(ctx, messageKeyspace, messageName, "", messageIDs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "MessageAck: unexpected consumer group")
+}
+
+func testMessageAckError(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	_, err := conn.MessageAck(ctx, messageKeyspace, messageName, messageConsumerGroup, messageIDs)
+	verifyError(t, err, "MessageAck")
+}
+
+func testMessageAckPanic(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	_, err := conn.MessageAck(ctx, messageKeyspace, messageName, messageConsumerGroup, messageIDs)
+	expectPanic(t, err)
+}
+
+func testMessageNack(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	count, err := conn.MessageNack(ctx, messageKeyspace, messageName, messageIDs)
+	require.NoError(t, err)
+	require.EqualValues(t, len(messageIDs), count)
+
+	_, err = conn.MessageNack(ctx, messageKeyspace, "none", messageIDs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "MessageNack: unexpected table ks.none")
+}
+
+func testMessageNackError(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	_, err := conn.MessageNack(ctx, messageKeyspace, messageName, messageIDs)
+	verifyError(t, err, "MessageNack")
+}
+
+func testMessageNackPanic(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	_, err := conn.MessageNack(ctx, messageKeyspace, messageName, messageIDs)
+	expectPanic(t, err)
+}
+
 var testCallerID = &vtrpcpb.CallerID{
 	Principal:    "test_principal",
 	Component:    "test_component",
@@ -603,3 +688,17 @@ var streamResultFields = sqltypes.Result{
 }
 
 var dtid2 = "aa"
+
+const (
+	messageKeyspace      = "ks"
+	messageName          = "msg"
+	messageConsumerGroup = "g1"
+)
+
+var messageIDs = []*querypb.Value{{
+	Type:  sqltypes.VarChar,
+	Value: []byte("1"),
+}, {
+	Type:  sqltypes.VarChar,
+	Value: []byte("2"),
+}}
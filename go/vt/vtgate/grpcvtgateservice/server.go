@@ -224,6 +224,32 @@ func (vtg *VTGate) ResolveTransaction(ctx context.Context, request *vtgatepb.Res
 	return nil, vterrors.ToGRPC(vtgErr)
 }
 
+// MessageAck is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) MessageAck(ctx context.Context, request *vtgatepb.MessageAckRequest) (response *vtgatepb.MessageAckResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+	count, vtgErr := vtg.server.MessageAck(ctx, request.Keyspace, request.Name, request.ConsumerGroup, request.Ids)
+	if vtgErr != nil {
+		return nil, vterrors.ToGRPC(vtgErr)
+	}
+	return &vtgatepb.MessageAckResponse{
+		RowsAffected: uint64(count),
+	}, nil
+}
+
+// MessageNack is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) MessageNack(ctx context.Context, request *vtgatepb.MessageNackRequest) (response *vtgatepb.MessageNackResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+	count, vtgErr := vtg.server.MessageNack(ctx, request.Keyspace, request.Name, request.Ids)
+	if vtgErr != nil {
+		return nil, vterrors.ToGRPC(vtgErr)
+	}
+	return &vtgatepb.MessageNackResponse{
+		RowsAffected: uint64(count),
+	}, nil
+}
+
 // VStream is the RPC version of vtgateservice.VTGateService method
 func (vtg *VTGate) VStream(request *vtgatepb.VStreamRequest, stream vtgateservicepb.Vitess_VStreamServer) (err error) {
 	defer vtg.server.HandlePanic(&err)
@@ -22,6 +22,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -175,7 +176,7 @@ type currFunc func(*LogStats, *SafeSession) (sqlparser.StatementType, *sqltypes.
 func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time) currFunc {
 	return func(logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
 		// 4: Execute!
-		qr, err := plan.Instructions.Execute(vcursor, bindVars, true)
+		qr, err := e.executeInstructions(plan, vcursor, bindVars, safeSession)
 
 		// 5: Log and add statistics
 		logStats.Keyspace = plan.Instructions.GetKeyspaceName()
@@ -193,6 +194,43 @@ func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *
 	}
 }
 
+// executeInstructions executes the plan, going through the result
+// cache if its results can be cached.
+func (e *Executor) executeInstructions(plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, safeSession *SafeSession) (*sqltypes.Result, error) {
+	if !e.resultCacheable(plan, vcursor, safeSession) {
+		return plan.Instructions.Execute(vcursor, bindVars, true)
+	}
+	key, err := resultCacheKey(vcursor.ctx, vcursor.planPrefixKey()+":"+plan.Original, bindVars)
+	if err != nil {
+		return plan.Instructions.Execute(vcursor, bindVars, true)
+	}
+	if qr, ok := e.resultCache.Get(key); ok {
+		return qr, nil
+	}
+	epoch, generations, ok := e.resultCache.Snapshot(plan.ResultCacheTables, vcursor.TabletType())
+	qr, err := plan.Instructions.Execute(vcursor, bindVars, true)
+	if err == nil && ok {
+		e.resultCache.Set(key, plan.Original, bindVars, plan.ResultCacheTables, epoch, generations, qr)
+	}
+	return qr, err
+}
+
+// resultCacheable returns true if the results of the plan can be
+// served from the result cache. Only the selects sent to replica and
+// rdonly tablets outside of transactions and reserved connections
+// are cached, since they don't depend on the state of the session.
+func (e *Executor) resultCacheable(plan *engine.Plan, vcursor *vcursorImpl, safeSession *SafeSession) bool {
+	if e.resultCache == nil || plan.ResultCacheTables == nil {
+		return false
+	}
+	switch vcursor.TabletType() {
+	case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY:
+	default:
+		return false
+	}
+	return !safeSession.InTransaction() && !safeSession.InReservedConn() && len(safeSession.SystemVariables) == 0
+}
+
 func (e *Executor) logExecutionEnd(logStats *LogStats, execStart time.Time, plan *engine.Plan, err error, qr *sqltypes.Result) uint64 {
 	logStats.ExecuteTime = time.Since(execStart)
 
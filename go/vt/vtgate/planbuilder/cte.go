@@ -0,0 +1,385 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// errRecursiveCTE is returned by inlineCTEs when a common table expression
+// refers to itself, and can therefore not be turned into a derived table.
+var errRecursiveCTE = errors.New("recursive common table expression")
+
+// hasCTE returns true if the statement, or any of its subqueries, has a WITH clause.
+func hasCTE(stmt sqlparser.SelectStatement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if with, ok := node.(*sqlparser.With); ok && with != nil {
+			found = true
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
+// buildCTEPlan plans a SELECT or UNION that uses common table expressions.
+//
+// Non-recursive CTEs are inlined as derived tables and the resulting statement
+// is planned as usual. If that produces a single route to one shard or to an
+// unsharded keyspace, the original statement is sent down with its WITH clause
+// intact. Otherwise, the derived tables that cannot be merged into a route are
+// evaluated by vtgate, just like any other derived table. A CTE referenced
+// more than once is not materialized: each reference is evaluated on its own.
+// Such a CTE must therefore be deterministic, unless the statement is sent
+// down with its WITH clause: all the references must see the same rows.
+//
+// Only the v3 planner supports CTEs, Gen4 returns Gen4NotSupported for them.
+//
+// Recursive CTEs can only be pushed down whole, so every table they use
+// must live in the same unsharded keyspace, or be a reference table.
+func buildCTEPlan(query string, stmt sqlparser.SelectStatement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
+	inlined, err := inlineCTEs(stmt)
+	if err == errRecursiveCTE {
+		return buildRecursiveCTEPlan(stmt, vschema)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var plan engine.Primitive
+	switch inlined := inlined.(type) {
+	case *sqlparser.Select:
+		plan, err = buildSelectPlan(query)(inlined, reservedVars, vschema)
+	case *sqlparser.Union:
+		plan, err = buildUnionPlan(inlined, reservedVars, vschema)
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected SELECT type: %T", inlined)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rb, ok := plan.(*engine.Route); ok {
+		switch rb.Opcode {
+		case engine.SelectUnsharded, engine.SelectEqualUnique, engine.SelectReference:
+			rb.Query, rb.FieldQuery = cteRouteQueries(stmt)
+			return plan, nil
+		}
+	}
+	if err := checkInlinedCTEs(stmt); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// nondeterministicFuncs are the functions whose result can change from
+// one evaluation to the next. The CURRENT DATE/TIME functions which are
+// parsed as a CurTimeFuncExpr are not listed.
+var nondeterministicFuncs = map[string]bool{
+	"connection_id":  true,
+	"curdate":        true,
+	"current_date":   true,
+	"curtime":        true,
+	"found_rows":     true,
+	"last_insert_id": true,
+	"now":            true,
+	"rand":           true,
+	"random_bytes":   true,
+	"row_count":      true,
+	"sysdate":        true,
+	"unix_timestamp": true,
+	"utc_date":       true,
+	"uuid":           true,
+	"uuid_short":     true,
+}
+
+// checkInlinedCTEs returns an error if a common table expression referenced
+// more than once is not deterministic: its inlined references could each
+// see different rows.
+func checkInlinedCTEs(stmt sqlparser.SelectStatement) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		with, ok := node.(*sqlparser.With)
+		if !ok || with == nil {
+			return true, nil
+		}
+		for _, cte := range with.CTEs {
+			if countReferences(stmt, cte.TableID) > 1 && isNondeterministic(cte.Subquery.Select) {
+				return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: common table expression %s is not deterministic and is referenced more than once in a query which does not go to a single shard", cte.TableID.String())
+			}
+		}
+		return true, nil
+	}, stmt)
+}
+
+// isNondeterministic returns true if the statement can return different
+// rows from one evaluation to the next, because it calls a function like
+// rand() or now(), or has a LIMIT without an ORDER BY.
+func isNondeterministic(stmt sqlparser.SelectStatement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			found = nondeterministicFuncs[node.Name.Lowered()]
+		case *sqlparser.CurTimeFuncExpr:
+			found = true
+		case *sqlparser.Select:
+			found = node.Limit != nil && len(node.OrderBy) == 0
+		case *sqlparser.Union:
+			found = node.Limit != nil && len(node.OrderBy) == 0
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
+// buildRecursiveCTEPlan sends the whole statement to a single keyspace.
+func buildRecursiveCTEPlan(stmt sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	cteNames := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if cte, ok := node.(*sqlparser.CommonTableExpr); ok {
+			cteNames[cte.TableID.String()] = true
+		}
+		return true, nil
+	}, stmt)
+
+	var keyspace *vindexes.Keyspace
+	allReference := true
+	tableNames := map[string]bool{}
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		aliased, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := aliased.Expr.(sqlparser.TableName)
+		if !ok {
+			return true, nil
+		}
+		if tableName.Qualifier.IsEmpty() && cteNames[tableName.Name.String()] {
+			return true, nil
+		}
+		if sqlparser.SystemSchema(tableName.Qualifier.String()) {
+			return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression on system tables")
+		}
+		tbl, _, _, dest, err := vschema.FindTable(tableName)
+		if err != nil {
+			return false, err
+		}
+		if dest != nil {
+			return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression with a shard-targeted table")
+		}
+		if keyspace == nil {
+			keyspace = tbl.Keyspace
+		} else if keyspace.Name != tbl.Keyspace.Name {
+			return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression across keyspaces")
+		}
+		if tbl.Type != vindexes.TypeReference {
+			allReference = false
+		}
+		tableNames[sqlparser.String(tbl.Name)] = true
+		return true, nil
+	}, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	var opcode engine.RouteOpcode
+	switch {
+	case keyspace == nil:
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression without tables")
+	case !keyspace.Sharded:
+		opcode = engine.SelectUnsharded
+	case allReference:
+		opcode = engine.SelectReference
+	default:
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression on a sharded table")
+	}
+
+	var names []string
+	for name := range tableNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	eroute := engine.NewRoute(opcode, keyspace, "", "")
+	eroute.Query, eroute.FieldQuery = cteRouteQueries(stmt)
+	eroute.TableName = strings.Join(names, ", ")
+	return eroute, nil
+}
+
+// cteRouteQueries produces the query and the field query used to send a
+// statement with a WITH clause down to the tablets.
+func cteRouteQueries(stmt sqlparser.SelectStatement) (string, string) {
+	formatter := func(impossible bool) sqlparser.NodeFormatter {
+		return func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+			if tableName, ok := node.(sqlparser.TableName); ok && !sqlparser.SystemSchema(tableName.Qualifier.String()) {
+				tableName.Name.Format(buf)
+				return
+			}
+			if impossible {
+				sqlparser.FormatImpossibleQuery(buf, node)
+				return
+			}
+			node.Format(buf)
+		}
+	}
+	query := sqlparser.NewTrackedBuffer(formatter(false)).WriteNode(stmt).ParsedQuery().Query
+	fieldQuery := sqlparser.NewTrackedBuffer(formatter(true)).WriteNode(stmt).ParsedQuery().Query
+	return query, fieldQuery
+}
+
+// inlineCTEs returns a copy of the statement where every reference to a
+// common table expression has been replaced by a derived table, and all
+// WITH clauses have been removed.
+func inlineCTEs(stmt sqlparser.SelectStatement) (sqlparser.SelectStatement, error) {
+	stmt = sqlparser.CloneSelectStatement(stmt)
+	var err error
+	// Post-order, so nested WITH clauses are inlined before the ones around them.
+	sqlparser.Rewrite(stmt, nil, func(cursor *sqlparser.Cursor) bool {
+		var with *sqlparser.With
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select:
+			with, node.With = node.With, nil
+		case *sqlparser.Union:
+			with, node.With = node.With, nil
+		}
+		if with == nil {
+			return true
+		}
+		err = inlineWith(with, cursor.Node())
+		return err == nil
+	})
+	return stmt, err
+}
+
+// inlineWith replaces the references to the given common table expressions in
+// the body. A CTE is also visible to the CTEs that follow it in the same clause.
+func inlineWith(with *sqlparser.With, body sqlparser.SQLNode) error {
+	for i, cte := range with.CTEs {
+		if with.Recursive && referencesTable(cte.Subquery, cte.TableID) {
+			return errRecursiveCTE
+		}
+		sel, err := cteSelect(cte)
+		if err != nil {
+			return err
+		}
+		for _, later := range with.CTEs[i+1:] {
+			replaceTableWithDerived(later.Subquery, cte.TableID, sel)
+		}
+		replaceTableWithDerived(body, cte.TableID, sel)
+	}
+	return nil
+}
+
+// cteSelect returns the statement of the CTE, with the
+// optional column list applied as aliases.
+func cteSelect(cte *sqlparser.CommonTableExpr) (sqlparser.SelectStatement, error) {
+	stmt := cte.Subquery.Select
+	if len(cte.Columns) == 0 {
+		return stmt, nil
+	}
+	stmt = sqlparser.CloneSelectStatement(stmt)
+	sel := firstSelect(stmt)
+	if len(sel.SelectExprs) != len(cte.Columns) {
+		return nil, &mysql.SQLError{
+			Num:     mysql.ERViewWrongList,
+			State:   mysql.SSUnknownSQLState,
+			Message: "In definition of view, derived table or common table expression, SELECT list and column names list have different column counts",
+		}
+	}
+	for i, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '*' expression in common table expression with a column list")
+		}
+		aliased.As = cte.Columns[i]
+	}
+	return stmt, nil
+}
+
+func firstSelect(stmt sqlparser.SelectStatement) *sqlparser.Select {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Union:
+		return firstSelect(stmt.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return firstSelect(stmt.Select)
+	}
+	return stmt.(*sqlparser.Select)
+}
+
+func isCTEReference(expr sqlparser.SimpleTableExpr, name sqlparser.TableIdent) bool {
+	tableName, ok := expr.(sqlparser.TableName)
+	return ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name.String()
+}
+
+func countReferences(node sqlparser.SQLNode, name sqlparser.TableIdent) int {
+	count := 0
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if aliased, ok := node.(*sqlparser.AliasedTableExpr); ok && isCTEReference(aliased.Expr, name) {
+			count++
+		}
+		return true, nil
+	}, node)
+	return count
+}
+
+func referencesTable(node sqlparser.SQLNode, name sqlparser.TableIdent) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if aliased, ok := node.(*sqlparser.AliasedTableExpr); ok && isCTEReference(aliased.Expr, name) {
+			found = true
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+func replaceTableWithDerived(node sqlparser.SQLNode, name sqlparser.TableIdent, sel sqlparser.SelectStatement) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		aliased, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok || !isCTEReference(aliased.Expr, name) {
+			return true, nil
+		}
+		aliased.Expr = &sqlparser.DerivedTable{Select: cloneWithColNames(sel)}
+		if aliased.As.IsEmpty() {
+			aliased.As = name
+		}
+		return false, nil
+	}, node)
+}
+
+// cloneWithColNames clones the statement, including the column names that
+// CloneSelectStatement shares with the original. The v3 planner keeps state
+// in them, so every inlined reference to a CTE needs its own.
+func cloneWithColNames(sel sqlparser.SelectStatement) sqlparser.SelectStatement {
+	clone := sqlparser.CloneSelectStatement(sel)
+	return sqlparser.Rewrite(clone, func(cursor *sqlparser.Cursor) bool {
+		if col, ok := cursor.Node().(*sqlparser.ColName); ok {
+			newCol := *col
+			cursor.Replace(&newCol)
+		}
+		return true
+	}, nil).(sqlparser.SelectStatement)
+}
@@ -28,37 +28,60 @@ import (
 
 // getDMLRouting returns the vindex and values for the DML,
 // If it cannot find a unique vindex match, it returns an error.
-func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.SingleColumn, string, vindexes.SingleColumn, []sqltypes.PlanValue, error) {
-	var ksidVindex vindexes.SingleColumn
-	var ksidCol string
+// A multi-column vindex only matches if all its columns are
+// equated to a value.
+func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.Vindex, []sqlparser.ColIdent, vindexes.Vindex, []sqltypes.PlanValue, error) {
+	var ksidVindex vindexes.Vindex
+	var ksidCols []sqlparser.ColIdent
 	for _, index := range table.Ordered {
 		if !index.Vindex.IsUnique() {
 			continue
 		}
-		single, ok := index.Vindex.(vindexes.SingleColumn)
-		if !ok {
+		switch index.Vindex.(type) {
+		case vindexes.SingleColumn, vindexes.MultiColumn:
+		default:
 			continue
 		}
-		if ksidCol == "" {
-			ksidCol = sqlparser.String(index.Columns[0])
-			ksidVindex = single
+		if ksidCols == nil {
+			ksidCols = index.Columns
+			ksidVindex = index.Vindex
 		}
 		if where == nil {
-			return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+			return engine.Scatter, ksidVindex, ksidCols, nil, nil, nil
 		}
 
+		if _, ok := index.Vindex.(vindexes.MultiColumn); ok {
+			if values, ok := getMultiColumnMatch(where.Expr, index.Columns); ok {
+				return engine.Equal, ksidVindex, ksidCols, index.Vindex, values, nil
+			}
+			continue
+		}
 		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
 			opcode := engine.Equal
 			if pv.IsList() {
 				opcode = engine.In
 			}
-			return opcode, ksidVindex, ksidCol, single, []sqltypes.PlanValue{pv}, nil
+			return opcode, ksidVindex, ksidCols, index.Vindex, []sqltypes.PlanValue{pv}, nil
 		}
 	}
 	if ksidVindex == nil {
-		return engine.Scatter, nil, "", nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, table.Name)
+		return engine.Scatter, nil, nil, nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, table.Name)
+	}
+	return engine.Scatter, ksidVindex, ksidCols, nil, nil, nil
+}
+
+// getMultiColumnMatch returns the values of all the columns
+// if each of them has an equality constraint.
+func getMultiColumnMatch(node sqlparser.Expr, cols []sqlparser.ColIdent) ([]sqltypes.PlanValue, bool) {
+	values := make([]sqltypes.PlanValue, 0, len(cols))
+	for _, col := range cols {
+		pv, ok := getMatch(node, col)
+		if !ok || pv.IsList() {
+			return nil, false
+		}
+		values = append(values, pv)
 	}
-	return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+	return values, true
 }
 
 // getMatch returns the matched value if there is an equality
@@ -100,12 +123,12 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
-func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
+func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.Vindex, []sqlparser.ColIdent, error) {
 	edml := &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
 	rb, err := pb.processDMLTable(tableExprs, reservedVars, nil)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, err
 	}
 	edml.Keyspace = rb.eroute.Keyspace
 	if !edml.Keyspace.Sharded {
@@ -116,17 +139,17 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 		if pb.finalizeUnshardedDMLSubqueries(reservedVars, subqueryArgs...) {
 			vschema.WarnUnshardedOnly("subqueries can't be sharded in DML")
 		} else {
-			return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+			return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		edml.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		edml.Query = generateQuery(stmt)
-		return edml, nil, "", nil
+		return edml, nil, nil, nil
 	}
 
 	if hasSubquery(stmt) {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -141,43 +164,49 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	edml.QueryTimeout = queryTimeout(directives)
 
 	if len(pb.st.tables) != 1 {
-		return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table %s statement is not supported in sharded database", dmlType)
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table %s statement is not supported in sharded database", dmlType)
 	}
 	for _, tval := range pb.st.tables {
 		// There is only one table.
 		edml.Table = tval.vschemaTable
 	}
 
-	routingType, ksidVindex, ksidCol, vindex, values, err := getDMLRouting(where, edml.Table)
+	routingType, ksidVindex, ksidCols, vindex, values, err := getDMLRouting(where, edml.Table)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, err
 	}
 
 	if rb.eroute.TargetDestination != nil {
 		if rb.eroute.TargetTabletType != topodatapb.TabletType_MASTER {
-			return nil, nil, "", vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: %s statement with a replica target", dmlType)
 		}
 		edml.Opcode = engine.ByDestination
 		edml.TargetDestination = rb.eroute.TargetDestination
-		return edml, ksidVindex, ksidCol, nil
+		return edml, ksidVindex, ksidCols, nil
 	}
 
 	edml.Opcode = routingType
 	if routingType == engine.Scatter {
 		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi shard %s with limit is not supported", dmlType)
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi shard %s with limit is not supported", dmlType)
 		}
 	} else {
 		edml.Vindex = vindex
 		edml.Values = values
 	}
 
-	return edml, ksidVindex, ksidCol, nil
+	return edml, ksidVindex, ksidCols, nil
 }
 
-func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
+func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCols []sqlparser.ColIdent) string {
 	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.Myprintf("select %s", ksidCol)
+	for idx, col := range ksidCols {
+		if idx == 0 {
+			buf.Myprintf("select %v", col)
+		} else {
+			buf.Myprintf(", %v", col)
+		}
+	}
 	for _, cv := range table.Owned {
 		for _, column := range cv.Columns {
 			buf.Myprintf(", %v", column)
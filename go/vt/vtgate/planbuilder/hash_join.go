@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*hashJoin)(nil)
+
+// hashJoin is used to build a HashJoin primitive.
+// It's only used by the Gen4 planner
+type hashJoin struct {
+	// Left and Right are the nodes for the join.
+	Left, Right      logicalPlan
+	Opcode           engine.JoinOpcode
+	Cols             []int
+	LHSKeys, RHSKeys []int
+}
+
+// Order implements the logicalPlan interface
+func (hj *hashJoin) Order() int {
+	panic("implement me")
+}
+
+// ResultColumns implements the logicalPlan interface
+func (hj *hashJoin) ResultColumns() []*resultColumn {
+	panic("implement me")
+}
+
+// Reorder implements the logicalPlan interface
+func (hj *hashJoin) Reorder(i int) {
+	panic("implement me")
+}
+
+// Wireup implements the logicalPlan interface
+func (hj *hashJoin) Wireup(lp logicalPlan, jt *jointab) error {
+	panic("implement me")
+}
+
+// WireupGen4 implements the logicalPlan interface
+func (hj *hashJoin) WireupGen4(semTable *semantics.SemTable) error {
+	err := hj.Left.WireupGen4(semTable)
+	if err != nil {
+		return err
+	}
+	return hj.Right.WireupGen4(semTable)
+}
+
+// SupplyVar implements the logicalPlan interface
+func (hj *hashJoin) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	panic("implement me")
+}
+
+// SupplyCol implements the logicalPlan interface
+func (hj *hashJoin) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	panic("implement me")
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (hj *hashJoin) SupplyWeightString(int, bool) (weightcolNumber int, err error) {
+	panic("implement me")
+}
+
+// Primitive implements the logicalPlan interface
+func (hj *hashJoin) Primitive() engine.Primitive {
+	return &engine.HashJoin{
+		Left:    hj.Left.Primitive(),
+		Right:   hj.Right.Primitive(),
+		Cols:    hj.Cols,
+		LHSKeys: hj.LHSKeys,
+		RHSKeys: hj.RHSKeys,
+		Opcode:  hj.Opcode,
+	}
+}
+
+// Inputs implements the logicalPlan interface
+func (hj *hashJoin) Inputs() []logicalPlan {
+	return []logicalPlan{hj.Left, hj.Right}
+}
+
+// Rewrite implements the logicalPlan interface
+func (hj *hashJoin) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 2 {
+		return vterrors.New(vtrpcpb.Code_INTERNAL, "wrong number of children")
+	}
+	hj.Left = inputs[0]
+	hj.Right = inputs[1]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (hj *hashJoin) ContainsTables() semantics.TableSet {
+	return hj.Left.ContainsTables().Merge(hj.Right.ContainsTables())
+}
@@ -102,12 +102,29 @@ type (
 		outer bool
 	}
 
+	// hashJoinPlan joins the results of its inputs in memory. Both sides are
+	// only executed once, instead of once for every row of the LHS.
+	hashJoinPlan struct {
+		// columns needed to feed other plans
+		columns []int
+
+		// the children of this plan
+		lhs, rhs joinTree
+
+		// lhsKeys and rhsKeys are the offsets of the columns
+		// that are compared for equality by the join predicates
+		lhsKeys, rhsKeys []int
+
+		outer bool
+	}
+
 	parenTables []relation
 )
 
 // type assertions
 var _ joinTree = (*routePlan)(nil)
 var _ joinTree = (*joinPlan)(nil)
+var _ joinTree = (*hashJoinPlan)(nil)
 var _ relation = (*routeTable)(nil)
 var _ relation = (*leJoin)(nil)
 var _ relation = (parenTables)(nil)
@@ -178,6 +195,7 @@ func (rp *routePlan) clone() joinTree {
 	for i, pred := range rp.vindexPreds {
 		// we do this to create a copy of the struct
 		p := *pred
+		p.colValues = append([]*sqltypes.PlanValue(nil), pred.colValues...)
 		result.vindexPreds[i] = &p
 	}
 	return &result
@@ -211,6 +229,8 @@ func (rp *routePlan) cost() int {
 		return 10
 	case engine.SelectMultiEqual:
 		return 10
+	case engine.SelectSubShard:
+		return 15
 	case engine.SelectScatter:
 		return 20
 	}
@@ -222,6 +242,10 @@ type vindexPlusPredicates struct {
 	colVindex *vindexes.ColumnVindex
 	values    []sqltypes.PlanValue
 
+	// colValues stores the value found for each column of a MultiColumn vindex.
+	// values then holds the values of its leading columns, in order.
+	colValues []*sqltypes.PlanValue
+
 	// when we have the predicates found, we also know how to interact with this vindex
 	foundVindex vindexes.Vindex
 	opcode      engine.RouteOpcode
@@ -363,7 +387,8 @@ func (rp *routePlan) planEqualOp(node *sqlparser.ComparisonExpr) (bool, error) {
 		return false, err
 	}
 
-	return rp.haveMatchingVindex(node, column, *val, equalOrEqualUnique, justTheVindex), err
+	found := rp.haveMatchingVindex(node, column, *val, equalOrEqualUnique, justTheVindex)
+	return rp.haveMatchingMultiColumnVindex(node, column, *val) || found, nil
 }
 
 func (rp *routePlan) planSimpleInOp(node *sqlparser.ComparisonExpr, left *sqlparser.ColName) (bool, error) {
@@ -527,6 +552,10 @@ func (rp *routePlan) haveMatchingVindex(
 		if v.foundVindex != nil {
 			continue
 		}
+		if _, ok := v.colVindex.Vindex.(vindexes.MultiColumn); ok {
+			// See haveMatchingMultiColumnVindex.
+			continue
+		}
 		for _, col := range v.colVindex.Columns {
 			// If the column for the predicate matches any column in the vindex add it to the list
 			if column.Name.Equal(col) {
@@ -545,19 +574,72 @@ func (rp *routePlan) haveMatchingVindex(
 	return newVindexFound
 }
 
+// haveMatchingMultiColumnVindex records the value of an equality on a column of the MultiColumn vindexes
+// of the route. A vindex is covered once all its columns have a value. A partial vindex is also
+// covered by its leading columns, with a SelectSubShard opcode, until it gets the values of the others.
+func (rp *routePlan) haveMatchingMultiColumnVindex(node sqlparser.Expr, column *sqlparser.ColName, value sqltypes.PlanValue) bool {
+	newVindexFound := false
+	for _, v := range rp.vindexPreds {
+		vindex, ok := v.colVindex.Vindex.(vindexes.MultiColumn)
+		if !ok || (v.foundVindex != nil && v.opcode != engine.SelectSubShard) {
+			continue
+		}
+		for i, col := range v.colVindex.Columns {
+			if !column.Name.Equal(col) {
+				continue
+			}
+			if v.colValues == nil {
+				v.colValues = make([]*sqltypes.PlanValue, len(v.colVindex.Columns))
+			}
+			if v.colValues[i] != nil {
+				continue
+			}
+			v.colValues[i] = &value
+			v.predicates = append(v.predicates, node)
+
+			var values []sqltypes.PlanValue
+			for _, colValue := range v.colValues {
+				if colValue == nil {
+					break
+				}
+				values = append(values, *colValue)
+			}
+			switch {
+			case len(values) == len(v.colValues):
+				v.opcode = equalOrEqualUnique(v.colVindex)
+			case len(values) > 0 && vindex.PartialVindex():
+				v.opcode = engine.SelectSubShard
+			default:
+				continue
+			}
+			v.values = values
+			v.foundVindex = vindex
+			newVindexFound = true
+		}
+	}
+	return newVindexFound
+}
+
 // pickBestAvailableVindex goes over the available vindexes for this route and picks the best one available.
 func (rp *routePlan) pickBestAvailableVindex() {
 	for _, v := range rp.vindexPreds {
 		if v.foundVindex == nil {
 			continue
 		}
-		// Choose the minimum cost vindex from the ones which are covered
-		if rp.vindex == nil || v.colVindex.Vindex.Cost() < rp.vindex.Cost() {
-			rp.routeOpCode = v.opcode
-			rp.vindex = v.foundVindex
-			rp.vindexValues = v.values
-			rp.vindexPredicates = v.predicates
+		// Choose the minimum cost vindex from the ones which are covered.
+		// A vindex covered by its leading columns only is chosen if no other vindex is covered.
+		switch {
+		case rp.vindex == nil:
+		case rp.routeOpCode == engine.SelectSubShard && (v.opcode != engine.SelectSubShard || v.foundVindex == rp.vindex):
+		case v.opcode == engine.SelectSubShard:
+			continue
+		case v.colVindex.Vindex.Cost() >= rp.vindex.Cost():
+			continue
 		}
+		rp.routeOpCode = v.opcode
+		rp.vindex = v.foundVindex
+		rp.vindexValues = v.values
+		rp.vindexPredicates = v.predicates
 	}
 }
 
@@ -605,29 +687,58 @@ func (jp *joinPlan) clone() joinTree {
 }
 
 func (jp *joinPlan) pushOutputColumns(columns []*sqlparser.ColName, semTable *semantics.SemTable) []int {
+	return pushJoinOutputColumns(columns, jp.lhs, jp.rhs, &jp.columns, semTable)
+}
+
+func (hj *hashJoinPlan) tableID() semantics.TableSet {
+	return hj.lhs.tableID() | hj.rhs.tableID()
+}
+
+func (hj *hashJoinPlan) cost() int {
+	return hj.lhs.cost() + hj.rhs.cost()
+}
+
+func (hj *hashJoinPlan) clone() joinTree {
+	return &hashJoinPlan{
+		columns: append([]int(nil), hj.columns...),
+		lhs:     hj.lhs.clone(),
+		rhs:     hj.rhs.clone(),
+		lhsKeys: hj.lhsKeys,
+		rhsKeys: hj.rhsKeys,
+		outer:   hj.outer,
+	}
+}
+
+func (hj *hashJoinPlan) pushOutputColumns(columns []*sqlparser.ColName, semTable *semantics.SemTable) []int {
+	return pushJoinOutputColumns(columns, hj.lhs, hj.rhs, &hj.columns, semTable)
+}
+
+// pushJoinOutputColumns pushes the columns to the side of the join that can
+// produce them, and adds them to the output columns of the join
+func pushJoinOutputColumns(columns []*sqlparser.ColName, lhs, rhs joinTree, joinColumns *[]int, semTable *semantics.SemTable) []int {
 	var toTheLeft []bool
-	var lhs, rhs []*sqlparser.ColName
+	var lhsCols, rhsCols []*sqlparser.ColName
 	for _, col := range columns {
 		col.Qualifier.Qualifier = sqlparser.NewTableIdent("")
-		if semTable.Dependencies(col).IsSolvedBy(jp.lhs.tableID()) {
-			lhs = append(lhs, col)
+		if semTable.Dependencies(col).IsSolvedBy(lhs.tableID()) {
+			lhsCols = append(lhsCols, col)
 			toTheLeft = append(toTheLeft, true)
 		} else {
-			rhs = append(rhs, col)
+			rhsCols = append(rhsCols, col)
 			toTheLeft = append(toTheLeft, false)
 		}
 	}
-	lhsOffset := jp.lhs.pushOutputColumns(lhs, semTable)
-	rhsOffset := jp.rhs.pushOutputColumns(rhs, semTable)
+	lhsOffset := lhs.pushOutputColumns(lhsCols, semTable)
+	rhsOffset := rhs.pushOutputColumns(rhsCols, semTable)
 	outputColumns := make([]int, len(toTheLeft))
 	var l, r int
 	for i, isLeft := range toTheLeft {
-		outputColumns[i] = i
+		outputColumns[i] = len(*joinColumns)
 		if isLeft {
-			jp.columns = append(jp.columns, -lhsOffset[l]-1)
+			*joinColumns = append(*joinColumns, -lhsOffset[l]-1)
 			l++
 		} else {
-			jp.columns = append(jp.columns, rhsOffset[r]+1)
+			*joinColumns = append(*joinColumns, rhsOffset[r]+1)
 			r++
 		}
 	}
@@ -24,7 +24,6 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
-	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -36,6 +35,9 @@ func transformToLogicalPlan(tree joinTree, semTable *semantics.SemTable) (logica
 
 	case *joinPlan:
 		return transformJoinPlan(n, semTable)
+
+	case *hashJoinPlan:
+		return transformHashJoinPlan(n, semTable)
 	}
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unknown type encountered: %T", tree)
@@ -63,6 +65,29 @@ func transformJoinPlan(n *joinPlan, semTable *semantics.SemTable) (logicalPlan,
 	}, nil
 }
 
+func transformHashJoinPlan(n *hashJoinPlan, semTable *semantics.SemTable) (logicalPlan, error) {
+	lhs, err := transformToLogicalPlan(n.lhs, semTable)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := transformToLogicalPlan(n.rhs, semTable)
+	if err != nil {
+		return nil, err
+	}
+	opCode := engine.InnerJoin
+	if n.outer {
+		opCode = engine.LeftJoin
+	}
+	return &hashJoin{
+		Left:    lhs,
+		Right:   rhs,
+		Cols:    n.columns,
+		LHSKeys: n.lhsKeys,
+		RHSKeys: n.rhsKeys,
+		Opcode:  opCode,
+	}, nil
+}
+
 func transformRoutePlan(n *routePlan) (*route, error) {
 	var tablesForSelect sqlparser.TableExprs
 	tableNameMap := map[string]interface{}{}
@@ -122,11 +147,6 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 		where = &sqlparser.Where{Expr: predicates, Type: sqlparser.WhereClause}
 	}
 
-	var singleColumn vindexes.SingleColumn
-	if n.vindex != nil {
-		singleColumn = n.vindex.(vindexes.SingleColumn)
-	}
-
 	var expressions sqlparser.SelectExprs
 	for _, col := range n.columns {
 		expressions = append(expressions, &sqlparser.AliasedExpr{
@@ -146,7 +166,7 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 			Opcode:    n.routeOpCode,
 			TableName: strings.Join(tableNames, ", "),
 			Keyspace:  n.keyspace,
-			Vindex:    singleColumn,
+			Vindex:    n.vindex,
 			Values:    n.vindexValues,
 		},
 		Select: &sqlparser.Select{
@@ -146,6 +146,9 @@ func nodeHasAggregates(node sqlparser.SQLNode) bool {
 		case *sqlparser.GroupConcatExpr:
 			hasAggregates = true
 			return false, errors.New("unused error")
+		case *sqlparser.WindowFuncExpr:
+			// Aggregate functions used as window functions do not group rows.
+			return false, nil
 		case *sqlparser.Subquery:
 			// Subqueries are analyzed by themselves.
 			return false, nil
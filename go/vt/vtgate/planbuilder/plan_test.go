@@ -188,6 +188,8 @@ func TestPlan(t *testing.T) {
 	testFile(t, "use_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "set_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "union_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "cte_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "transaction_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "lock_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "large_cases.txt", testOutputTempDir, vschemaWrapper, true)
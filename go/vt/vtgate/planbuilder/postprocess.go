@@ -133,6 +133,9 @@ func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 		node.Select.SetLimit(&sqlparser.Limit{Rowcount: arg})
 	case *concatenate:
 		return false, node, nil
+	case *window:
+		// The window functions need all the rows of a partition.
+		return false, node, nil
 	}
 	return true, plan, nil
 }
@@ -30,6 +30,9 @@ type queryProjection struct {
 
 	orderExprs sqlparser.OrderBy
 
+	// hasWindowFuncs is true if the select expressions use window functions
+	hasWindowFuncs bool
+
 	// orderExprColMap keeps a map between the Order object and the offset into the select expressions list
 	orderExprColMap map[*sqlparser.Order]int
 }
@@ -62,6 +65,7 @@ func createQPFromSelect(sel *sqlparser.Select) (*queryProjection, error) {
 		}
 		qp.selectExprs = append(qp.selectExprs, exp)
 	}
+	qp.hasWindowFuncs = len(findWindowFuncs(sel.SelectExprs)) > 0
 
 	qp.orderExprs = sel.OrderBy
 
@@ -59,9 +59,14 @@ type route struct {
 	substitutions []*tableSubstitution
 
 	// condition stores the AST condition that will be used
-	// to resolve the ERoute Values field.
+	// to resolve the ERoute Values field. For a multi-column
+	// vindex, it is a ValTuple of the values of its leading columns.
 	condition sqlparser.Expr
 
+	// vindexValues stores the values found in the filters for the
+	// columns of the multi-column vindexes of the route.
+	vindexValues map[*vindexes.ColumnVindex][]sqlparser.Expr
+
 	// eroute is the primitive being built.
 	eroute *engine.Route
 
@@ -155,6 +160,23 @@ func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 	if rb.eroute.Values == nil {
 		// Resolve values stored in the logical plan.
 		switch vals := rb.condition.(type) {
+		case sqlparser.ValTuple:
+			if _, ok := rb.eroute.Vindex.(vindexes.MultiColumn); ok {
+				// One value per leading column of the vindex.
+				for _, val := range vals {
+					pv, err := rb.procureValues(plan, jt, val)
+					if err != nil {
+						return err
+					}
+					rb.eroute.Values = append(rb.eroute.Values, pv)
+				}
+				break
+			}
+			pv, err := rb.procureValues(plan, jt, vals)
+			if err != nil {
+				return err
+			}
+			rb.eroute.Values = []sqltypes.PlanValue{pv}
 		case *sqlparser.ComparisonExpr:
 			pv, err := rb.procureValues(plan, jt, vals.Right)
 			if err != nil {
@@ -553,6 +575,15 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		return
 	}
 	opcode, vindex, values := rb.computePlan(pb, filter)
+	rb.updatePlan(opcode, vindex, values)
+	for _, plan := range rb.computeMultiColumnPlans(pb, filter) {
+		rb.updatePlan(plan.opcode, plan.vindex, plan.condition)
+	}
+}
+
+// updatePlan updates the route with the specified plan if
+// it's an improvement.
+func (rb *route) updatePlan(opcode engine.RouteOpcode, vindex vindexes.Vindex, values sqlparser.Expr) {
 	if opcode == engine.SelectScatter {
 		return
 	}
@@ -593,15 +624,25 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 				rb.updateRoute(opcode, vindex, values)
 			}
 		}
+	case engine.SelectSubShard:
+		switch opcode {
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual:
+			rb.updateRoute(opcode, vindex, values)
+		case engine.SelectSubShard:
+			// The same vindex can only get more columns.
+			if vindex == rb.eroute.Vindex || vindex.Cost() < rb.eroute.Vindex.Cost() {
+				rb.updateRoute(opcode, vindex, values)
+			}
+		}
 	case engine.SelectScatter:
 		switch opcode {
-		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectNone:
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectSubShard, engine.SelectNone:
 			rb.updateRoute(opcode, vindex, values)
 		}
 	}
 }
 
-func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	rb.eroute.Opcode = opcode
 	rb.eroute.Vindex = vindex
 	rb.condition = condition
@@ -627,6 +668,69 @@ func (rb *route) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcod
 	return engine.SelectScatter, nil, nil
 }
 
+// multiColumnPlan is a plan computed for a multi-column vindex.
+type multiColumnPlan struct {
+	opcode    engine.RouteOpcode
+	vindex    vindexes.Vindex
+	condition sqlparser.Expr
+}
+
+// computeMultiColumnPlans records the value of an equality constraint
+// on a column of multi-column vindexes, and computes the plans of
+// these vindexes. A vindex whose columns all have a value gives a plan
+// like a single column vindex would. A partial vindex whose leading
+// columns have a value gives a SelectSubShard plan.
+func (rb *route) computeMultiColumnPlans(pb *primitiveBuilder, filter sqlparser.Expr) []multiColumnPlan {
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualOp {
+		return nil
+	}
+	left := comparison.Left
+	right := comparison.Right
+	vcols := pb.st.MultiColumnVindexes(left, rb)
+	if vcols == nil {
+		left, right = right, left
+		vcols = pb.st.MultiColumnVindexes(left, rb)
+		if vcols == nil {
+			return nil
+		}
+	}
+	if sqlparser.IsNull(right) || !rb.exprIsValue(right) {
+		return nil
+	}
+
+	var plans []multiColumnPlan
+	for _, vcol := range vcols {
+		if rb.vindexValues == nil {
+			rb.vindexValues = make(map[*vindexes.ColumnVindex][]sqlparser.Expr)
+		}
+		values := rb.vindexValues[vcol.colVindex]
+		if values == nil {
+			values = make([]sqlparser.Expr, len(vcol.colVindex.Columns))
+			rb.vindexValues[vcol.colVindex] = values
+		}
+		values[vcol.index] = right
+
+		var condition sqlparser.ValTuple
+		for _, value := range values {
+			if value == nil {
+				break
+			}
+			condition = append(condition, value)
+		}
+		vindex := vcol.colVindex.Vindex
+		switch {
+		case len(condition) == len(values) && vindex.IsUnique():
+			plans = append(plans, multiColumnPlan{opcode: engine.SelectEqualUnique, vindex: vindex, condition: condition})
+		case len(condition) == len(values):
+			plans = append(plans, multiColumnPlan{opcode: engine.SelectEqual, vindex: vindex, condition: condition})
+		case len(condition) > 0 && vindex.(vindexes.MultiColumn).PartialVindex():
+			plans = append(plans, multiColumnPlan{opcode: engine.SelectSubShard, vindex: vindex, condition: condition})
+		}
+	}
+	return plans
+}
+
 // computeLikePlan computes the plan for 'LIKE' constraint
 func (rb *route) computeLikePlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
 
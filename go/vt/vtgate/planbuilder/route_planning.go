@@ -21,6 +21,8 @@ import (
 	"io"
 	"sort"
 
+	"vitess.io/vitess/go/sqltypes"
+
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -51,6 +53,9 @@ func newBuildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.P
 	if len(directives) > 0 {
 		return nil, semantics.Gen4NotSupportedF("comment directives")
 	}
+	if hasCTE(sel) {
+		return nil, semantics.Gen4NotSupportedF("common table expressions")
+	}
 	keyspace, err := vschema.DefaultKeyspace()
 	if err != nil {
 		return nil, err
@@ -278,6 +283,15 @@ func planHorizon(sel *sqlparser.Select, plan logicalPlan, semTable *semantics.Se
 	if err != nil {
 		return nil, err
 	}
+	if qp.hasWindowFuncs {
+		windowPlan, planned, err := planWindowFuncs(sel, qp, plan, semTable)
+		if err != nil {
+			return nil, err
+		}
+		if planned {
+			return windowPlan, nil
+		}
+	}
 	for _, e := range qp.selectExprs {
 		if _, err := pushProjection(e, plan, semTable, true); err != nil {
 			return nil, err
@@ -373,6 +387,37 @@ func pushJoinPredicate(exprs []sqlparser.Expr, tree joinTree, semTable *semantic
 			rhs:   rhsPlan,
 			outer: node.outer,
 		}, nil
+
+	case *hashJoinPlan:
+		// both sides of a hash join are executed independently,
+		// so every predicate has to be solved by one of them
+		node = node.clone().(*hashJoinPlan)
+		var lhsPreds, rhsPreds []sqlparser.Expr
+		for _, expr := range exprs {
+			deps := semTable.Dependencies(expr)
+			switch {
+			case deps.IsSolvedBy(node.lhs.tableID()):
+				lhsPreds = append(lhsPreds, expr)
+			case deps.IsSolvedBy(node.rhs.tableID()):
+				rhsPreds = append(rhsPreds, expr)
+			default:
+				return nil, semantics.Gen4NotSupportedF("join predicate that spans both sides of a hash join")
+			}
+		}
+		var err error
+		if len(lhsPreds) > 0 {
+			node.lhs, err = pushJoinPredicate(lhsPreds, node.lhs, semTable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(rhsPreds) > 0 {
+			node.rhs, err = pushJoinPredicate(rhsPreds, node.rhs, semTable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return node, nil
 	default:
 		panic(fmt.Sprintf("BUG: unknown type %T", node))
 	}
@@ -414,7 +459,103 @@ func mergeOrJoin(lhs, rhs joinTree, joinPredicates []sqlparser.Expr, semTable *s
 	}
 
 	tree := &joinPlan{lhs: lhs.clone(), rhs: rhs.clone(), outer: !inner}
-	return pushJoinPredicate(joinPredicates, tree, semTable)
+	plan, err := pushJoinPredicate(joinPredicates, tree, semTable)
+	if err != nil {
+		return nil, err
+	}
+	if hashJoin := tryHashJoin(lhs, rhs, plan.(*joinPlan), joinPredicates, semTable); hashJoin != nil {
+		return hashJoin, nil
+	}
+	return plan, nil
+}
+
+// tryHashJoin returns a hash join between lhs and rhs that can replace the
+// given nested-loop join, or nil if the nested-loop join should be kept.
+//
+// A nested-loop join executes its RHS once for every row of the LHS. That is
+// only a problem when the LHS is expected to return many rows, and the RHS is
+// a scatter query even with the join predicates applied, since the join then
+// sends a query to every shard for every row of the LHS. In that case, if all
+// the join predicates are equality comparisons between numeric columns of both
+// sides, it is cheaper to fetch both sides once and join them in vtgate.
+func tryHashJoin(lhs, rhs joinTree, join *joinPlan, joinPredicates []sqlparser.Expr, semTable *semantics.SemTable) *hashJoinPlan {
+	rhsRoute, ok := join.rhs.(*routePlan)
+	if !ok || rhsRoute.routeOpCode != engine.SelectScatter || !expectsManyRows(lhs) {
+		return nil
+	}
+	lhsSolves := lhs.tableID()
+	rhsSolves := rhs.tableID()
+
+	var lhsCols, rhsCols []*sqlparser.ColName
+	for _, predicate := range joinPredicates {
+		for _, expr := range sqlparser.SplitAndExpression(nil, predicate) {
+			cmp, ok := expr.(*sqlparser.ComparisonExpr)
+			if !ok || cmp.Operator != sqlparser.EqualOp {
+				return nil
+			}
+			left, ok := cmp.Left.(*sqlparser.ColName)
+			if !ok || !isNumericColumn(left, semTable) {
+				return nil
+			}
+			right, ok := cmp.Right.(*sqlparser.ColName)
+			if !ok || !isNumericColumn(right, semTable) {
+				return nil
+			}
+			leftDeps := semTable.Dependencies(left)
+			rightDeps := semTable.Dependencies(right)
+			switch {
+			case leftDeps.IsSolvedBy(lhsSolves) && rightDeps.IsSolvedBy(rhsSolves):
+			case leftDeps.IsSolvedBy(rhsSolves) && rightDeps.IsSolvedBy(lhsSolves):
+				left, right = right, left
+			default:
+				return nil
+			}
+			lhsCols = append(lhsCols, sqlparser.CloneRefOfColName(left))
+			rhsCols = append(rhsCols, sqlparser.CloneRefOfColName(right))
+		}
+	}
+	if len(lhsCols) == 0 {
+		return nil
+	}
+
+	hashJoin := &hashJoinPlan{
+		lhs:   lhs.clone(),
+		rhs:   rhs.clone(),
+		outer: join.outer,
+	}
+	hashJoin.lhsKeys = hashJoin.lhs.pushOutputColumns(lhsCols, semTable)
+	hashJoin.rhsKeys = hashJoin.rhs.pushOutputColumns(rhsCols, semTable)
+	return hashJoin
+}
+
+// expectsManyRows returns true if the plan contains a scatter query that
+// is not restricted by any vindex, in which case we have no way to know
+// how many rows it is going to return.
+func expectsManyRows(tree joinTree) bool {
+	switch tree := tree.(type) {
+	case *routePlan:
+		return tree.routeOpCode == engine.SelectScatter
+	case *joinPlan:
+		return expectsManyRows(tree.lhs)
+	case *hashJoinPlan:
+		return expectsManyRows(tree.lhs) || expectsManyRows(tree.rhs)
+	}
+	return false
+}
+
+// isNumericColumn returns true if the vschema declares a numeric type for the column.
+// Only numeric values can be hashed and compared by vtgate the same way MySQL would.
+func isNumericColumn(col *sqlparser.ColName, semTable *semantics.SemTable) bool {
+	tableInfo, err := semTable.TableInfoFor(semTable.Dependencies(col))
+	if err != nil || tableInfo.Table == nil {
+		return false
+	}
+	for _, c := range tableInfo.Table.Columns {
+		if col.Name.Equal(c.Name) {
+			return sqltypes.IsNumber(c.Type)
+		}
+	}
+	return false
 }
 
 type (
@@ -625,63 +766,98 @@ func createRoutePlan(table *abstract.QueryTable, solves semantics.TableSet, vsch
 	return plan, nil
 }
 
-func findColumnVindex(a *routePlan, exp sqlparser.Expr, sem *semantics.SemTable) vindexes.SingleColumn {
+// findColumnVindex returns the column vindex of a's tables which exp is a
+// column of, together with the position of that column in the vindex.
+func findColumnVindex(a *routePlan, exp sqlparser.Expr, sem *semantics.SemTable) (*vindexes.ColumnVindex, int) {
 	left, isCol := exp.(*sqlparser.ColName)
 	if !isCol {
-		return nil
+		return nil, -1
 	}
 	leftDep := sem.Dependencies(left)
 
-	var singCol vindexes.SingleColumn
+	var (
+		vindex *vindexes.ColumnVindex
+		colIdx = -1
+	)
 
 	_ = visitTables(a.tables, func(table *routeTable) error {
 		if leftDep.IsSolvedBy(table.qtable.TableID) {
-			for _, vindex := range table.vtable.ColumnVindexes {
-				sC, isSingle := vindex.Vindex.(vindexes.SingleColumn)
-				if isSingle && vindex.Columns[0].Equal(left.Name) {
-					singCol = sC
-					return io.EOF
+			for _, colVindex := range table.vtable.ColumnVindexes {
+				for i, col := range colVindex.Columns {
+					if col.Equal(left.Name) {
+						vindex, colIdx = colVindex, i
+						return io.EOF
+					}
 				}
 			}
 		}
 		return nil
 	})
 
-	return singCol
+	return vindex, colIdx
 }
 
-func canMergeOnFilter(a, b *routePlan, predicate sqlparser.Expr, sem *semantics.SemTable) bool {
+// vindexOnFilter returns the vindex and the position of the column in it if
+// predicate is an equality between the same column of the same vindex in a
+// and in b.
+func vindexOnFilter(a, b *routePlan, predicate sqlparser.Expr, sem *semantics.SemTable) (*vindexes.ColumnVindex, int) {
 	comparison, ok := predicate.(*sqlparser.ComparisonExpr)
 	if !ok {
-		return false
+		return nil, -1
 	}
 	if comparison.Operator != sqlparser.EqualOp {
-		return false
+		return nil, -1
 	}
 	left := comparison.Left
 	right := comparison.Right
 
-	lVindex := findColumnVindex(a, left, sem)
+	lVindex, lIdx := findColumnVindex(a, left, sem)
 	if lVindex == nil {
 		left, right = right, left
-		lVindex = findColumnVindex(a, left, sem)
+		lVindex, lIdx = findColumnVindex(a, left, sem)
 	}
-	if lVindex == nil || !lVindex.IsUnique() {
-		return false
+	if lVindex == nil || !lVindex.Vindex.IsUnique() {
+		return nil, -1
+	}
+	rVindex, rIdx := findColumnVindex(b, right, sem)
+	if rVindex == nil || rVindex.Vindex != lVindex.Vindex || rIdx != lIdx {
+		return nil, -1
 	}
-	rVindex := findColumnVindex(b, right, sem)
-	if rVindex == nil {
+	return lVindex, lIdx
+}
+
+func canMergeOnFilter(a, b *routePlan, predicate sqlparser.Expr, sem *semantics.SemTable) bool {
+	vindex, _ := vindexOnFilter(a, b, predicate, sem)
+	if vindex == nil {
 		return false
 	}
-	return rVindex == lVindex
+	_, isSingle := vindex.Vindex.(vindexes.SingleColumn)
+	return isSingle
 }
 
+// canMergeOnFilters returns true if the join predicates equate a unique single
+// column vindex, or all the columns of a unique multi column vindex, of a and b.
 func canMergeOnFilters(a, b *routePlan, joinPredicates []sqlparser.Expr, semTable *semantics.SemTable) bool {
+	multiCols := map[vindexes.Vindex]map[int]bool{}
 	for _, predicate := range joinPredicates {
 		for _, expr := range sqlparser.SplitAndExpression(nil, predicate) {
 			if canMergeOnFilter(a, b, expr, semTable) {
 				return true
 			}
+			vindex, colIdx := vindexOnFilter(a, b, expr, semTable)
+			if vindex == nil {
+				continue
+			}
+			if _, isMulti := vindex.Vindex.(vindexes.MultiColumn); !isMulti {
+				continue
+			}
+			if multiCols[vindex.Vindex] == nil {
+				multiCols[vindex.Vindex] = map[int]bool{}
+			}
+			multiCols[vindex.Vindex][colIdx] = true
+			if len(multiCols[vindex.Vindex]) == len(vindex.Columns) {
+				return true
+			}
 		}
 	}
 	return false
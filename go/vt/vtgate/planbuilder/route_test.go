@@ -39,21 +39,23 @@ For easy reference, opcodes are:
 	SelectDBA         7
 	SelectReference   8
 	SelectNone        9
-	NumRouteOpcodes   10
+	SelectSubShard    10
+	NumRouteOpcodes   11
 */
 
 func TestJoinCanMerge(t *testing.T) {
 	testcases := [engine.NumRouteOpcodes][engine.NumRouteOpcodes]bool{
-		{true, false, false, false, false, false, false, false, true, false},
-		{false, true, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, true, true, false},
-		{true, true, true, true, true, true, true, true, true, true},
-		{false, false, false, false, false, false, false, false, true, false},
+		{true, false, false, false, false, false, false, false, true, false, false},
+		{false, true, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, true, true, false, false},
+		{true, true, true, true, true, true, true, true, true, true, true},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
 	}
 
 	ks := &vindexes.Keyspace{}
@@ -81,16 +83,17 @@ func TestJoinCanMerge(t *testing.T) {
 
 func TestSubqueryCanMerge(t *testing.T) {
 	testcases := [engine.NumRouteOpcodes][engine.NumRouteOpcodes]bool{
-		{true, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, true, true, false},
-		{true, true, true, true, true, true, true, true, true, true},
-		{false, false, false, false, false, false, false, false, true, false},
+		{true, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, true, true, false, false},
+		{true, true, true, true, true, true, true, true, true, true, true},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
 	}
 
 	ks := &vindexes.Keyspace{}
@@ -110,16 +113,17 @@ func TestSubqueryCanMerge(t *testing.T) {
 
 func TestUnionCanMerge(t *testing.T) {
 	testcases := [engine.NumRouteOpcodes][engine.NumRouteOpcodes]bool{
-		{true, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, true, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, false, false},
+		{true, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, true, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false},
 	}
 	ks := &vindexes.Keyspace{}
 	lRoute := &route{}
@@ -37,6 +37,10 @@ func buildSelectPlan(query string) func(sqlparser.Statement, *sqlparser.Reserved
 	return func(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 		sel := stmt.(*sqlparser.Select)
 
+		if hasCTE(sel) {
+			return buildCTEPlan(query, sel, reservedVars, vschema)
+		}
+
 		p, err := handleDualSelects(sel, vschema)
 		if err != nil {
 			return nil, err
@@ -192,6 +196,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 			return err
 		}
 	}
+	if err := pb.checkWindowFuncs(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
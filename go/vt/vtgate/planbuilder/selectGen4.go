@@ -51,31 +51,46 @@ func pushProjection(expr *sqlparser.AliasedExpr, plan logicalPlan, semTable *sem
 		sel.SelectExprs = append(sel.SelectExprs, expr)
 		return offset, nil
 	case *joinGen4:
-		lhsSolves := node.Left.ContainsTables()
-		rhsSolves := node.Right.ContainsTables()
-		deps := semTable.Dependencies(expr.Expr)
-		switch {
-		case deps.IsSolvedBy(lhsSolves):
-			offset, err := pushProjection(expr, node.Left, semTable, inner)
-			if err != nil {
-				return 0, err
-			}
-			node.Cols = append(node.Cols, -(offset + 1))
-		case deps.IsSolvedBy(rhsSolves):
-			offset, err := pushProjection(expr, node.Right, semTable, inner && node.Opcode != engine.LeftJoin)
-			if err != nil {
-				return 0, err
-			}
-			node.Cols = append(node.Cols, offset+1)
-		default:
-			return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unknown dependencies for %s", sqlparser.String(expr))
+		col, err := pushProjectionToJoin(expr, node.Left, node.Right, node.Opcode, semTable, inner)
+		if err != nil {
+			return 0, err
+		}
+		node.Cols = append(node.Cols, col)
+		return len(node.Cols) - 1, nil
+	case *hashJoin:
+		col, err := pushProjectionToJoin(expr, node.Left, node.Right, node.Opcode, semTable, inner)
+		if err != nil {
+			return 0, err
 		}
+		node.Cols = append(node.Cols, col)
 		return len(node.Cols) - 1, nil
 	default:
 		return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%T not yet supported", node)
 	}
 }
 
+// pushProjectionToJoin pushes the expression to the side of the join that
+// can evaluate it, and returns the join column that refers to it
+func pushProjectionToJoin(expr *sqlparser.AliasedExpr, lhs, rhs logicalPlan, opcode engine.JoinOpcode, semTable *semantics.SemTable, inner bool) (int, error) {
+	deps := semTable.Dependencies(expr.Expr)
+	switch {
+	case deps.IsSolvedBy(lhs.ContainsTables()):
+		offset, err := pushProjection(expr, lhs, semTable, inner)
+		if err != nil {
+			return 0, err
+		}
+		return -(offset + 1), nil
+	case deps.IsSolvedBy(rhs.ContainsTables()):
+		offset, err := pushProjection(expr, rhs, semTable, inner && opcode != engine.LeftJoin)
+		if err != nil {
+			return 0, err
+		}
+		return offset + 1, nil
+	default:
+		return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unknown dependencies for %s", sqlparser.String(expr))
+	}
+}
+
 func removeQualifierFromColName(expr *sqlparser.AliasedExpr) *sqlparser.AliasedExpr {
 	if _, ok := expr.Expr.(*sqlparser.ColName); ok {
 		expr = sqlparser.CloneRefOfAliasedExpr(expr)
@@ -139,37 +154,11 @@ func planOrderBy(qp *queryProjection, plan logicalPlan, semTable *semantics.SemT
 				additionalColAdded = true
 			}
 
-			table := semTable.Dependencies(colName)
-			tableInfo, err := semTable.TableInfoFor(table)
+			weightStringOffset, err := pushWeightString(colName, plan, semTable)
 			if err != nil {
 				return nil, err
 			}
-			weightStringNeeded := true
-			for _, c := range tableInfo.Table.Columns {
-				if colName.Name.Equal(c.Name) {
-					if sqltypes.IsNumber(c.Type) {
-						weightStringNeeded = false
-					}
-					break
-				}
-			}
-
-			weightStringOffset := -1
-			if weightStringNeeded {
-				expr := &sqlparser.AliasedExpr{
-					Expr: &sqlparser.FuncExpr{
-						Name: sqlparser.NewColIdent("weight_string"),
-						Exprs: []sqlparser.SelectExpr{
-							&sqlparser.AliasedExpr{
-								Expr: order.Expr,
-							},
-						},
-					},
-				}
-				weightStringOffset, err = pushProjection(expr, plan, semTable, true)
-				if err != nil {
-					return nil, err
-				}
+			if weightStringOffset != -1 {
 				additionalColAdded = true
 			}
 
@@ -189,3 +178,34 @@ func planOrderBy(qp *queryProjection, plan logicalPlan, semTable *semantics.SemT
 		return nil, semantics.Gen4NotSupportedF("ordering on complex query")
 	}
 }
+
+// pushWeightString adds the weight_string of the column to the select list of the
+// route, so that the rows can be compared on it by vtgate. Numeric columns can be
+// compared as they are, in which case nothing is added and -1 is returned.
+func pushWeightString(colName *sqlparser.ColName, plan *route, semTable *semantics.SemTable) (int, error) {
+	table := semTable.Dependencies(colName)
+	tableInfo, err := semTable.TableInfoFor(table)
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range tableInfo.Table.Columns {
+		if colName.Name.Equal(c.Name) {
+			if sqltypes.IsNumber(c.Type) {
+				return -1, nil
+			}
+			break
+		}
+	}
+
+	expr := &sqlparser.AliasedExpr{
+		Expr: &sqlparser.FuncExpr{
+			Name: sqlparser.NewColIdent("weight_string"),
+			Exprs: []sqlparser.SelectExpr{
+				&sqlparser.AliasedExpr{
+					Expr: colName,
+				},
+			},
+		},
+	}
+	return pushProjection(expr, plan, semTable, true)
+}
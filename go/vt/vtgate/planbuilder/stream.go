@@ -42,13 +42,33 @@ func buildStreamPlan(stmt *sqlparser.Stream, vschema ContextVSchema) (engine.Pri
 	if dest == nil {
 		dest = key.DestinationExactKeyRange{}
 	}
+	group, err := consumerGroup(sqlparser.ExtractCommentDirectives(stmt.Comments))
+	if err != nil {
+		return nil, err
+	}
 	return &engine.MStream{
 		Keyspace:          table.Keyspace,
 		TargetDestination: dest,
 		TableName:         table.Name.CompliantName(),
+		ConsumerGroup:     group,
 	}, nil
 }
 
+// consumerGroup returns DirectiveConsumerGroup value if set, otherwise returns "".
+// The value must be a non-empty string: a bare directive, or a value that
+// parses as a number or a boolean, is rejected.
+func consumerGroup(d sqlparser.CommentDirectives) (string, error) {
+	val, ok := d[sqlparser.DirectiveConsumerGroup]
+	if !ok {
+		return "", nil
+	}
+	group, ok := val.(string)
+	if !ok || group == "" {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s: %v, must be a non-empty string", sqlparser.DirectiveConsumerGroup, val)
+	}
+	return group, nil
+}
+
 func buildVStreamPlan(stmt *sqlparser.VStream, vschema ContextVSchema) (engine.Primitive, error) {
 	table, _, destTabletType, dest, err := vschema.FindTable(stmt.Table)
 	if err != nil {
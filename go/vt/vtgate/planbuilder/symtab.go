@@ -110,6 +110,19 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 	}
 
 	for _, cv := range vschemaTable.ColumnVindexes {
+		if _, ok := cv.Vindex.(vindexes.MultiColumn); ok {
+			for i, cvcol := range cv.Columns {
+				col, err := t.mergeColumn(cvcol, &column{
+					origin: rb,
+					st:     st,
+				})
+				if err != nil {
+					return err
+				}
+				col.vindexColumns = append(col.vindexColumns, vindexColumn{colVindex: cv, index: i})
+			}
+			continue
+		}
 		single, ok := cv.Vindex.(vindexes.SingleColumn)
 		if !ok {
 			continue
@@ -413,6 +426,27 @@ func (st *symtab) Vindex(expr sqlparser.Expr, scope *route) vindexes.SingleColum
 	return c.vindex
 }
 
+// MultiColumnVindexes returns the columns of multi-column vindexes that
+// the expression refers to. It returns nil if the expression is not a
+// column or if the column does not originate from the specified scope.
+func (st *symtab) MultiColumnVindexes(expr sqlparser.Expr, scope *route) []vindexColumn {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	if col.Metadata == nil {
+		// Find will set the Metadata.
+		if _, _, err := st.Find(col); err != nil {
+			return nil
+		}
+	}
+	c := col.Metadata.(*column)
+	if c.Origin() != scope {
+		return nil
+	}
+	return c.vindexColumns
+}
+
 // BuildColName builds a *sqlparser.ColName for the resultColumn specified
 // by the index. The built ColName will correctly reference the resultColumn
 // it was built from.
@@ -524,6 +558,17 @@ type column struct {
 	vindex    vindexes.SingleColumn
 	typ       querypb.Type
 	colNumber int
+
+	// vindexColumns lists the multi-column vindexes that the
+	// column is part of.
+	vindexColumns []vindexColumn
+}
+
+// vindexColumn is the column at position index of the
+// multi-column vindex colVindex.
+type vindexColumn struct {
+	colVindex *vindexes.ColumnVindex
+	index     int
 }
 
 // Origin returns the route that originates the column.
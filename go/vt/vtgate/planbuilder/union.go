@@ -31,6 +31,9 @@ import (
 
 func buildUnionPlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 	union := stmt.(*sqlparser.Union)
+	if hasCTE(union) {
+		return buildCTEPlan("", union, reservedVars, vschema)
+	}
 	// For unions, create a pb with anonymous scope.
 	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
 	if err := pb.processUnion(union, reservedVars, nil); err != nil {
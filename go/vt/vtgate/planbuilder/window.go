@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*window)(nil)
+
+// window is the logicalPlan for engine.Window.
+// It gets built by the Gen4 planner when the partitions of
+// the window functions span shards, in which case the functions
+// are evaluated at the vtgate level over the merge-sorted rows
+// returned by the route.
+type window struct {
+	logicalPlanCommon
+	ewindow *engine.Window
+}
+
+// ResultColumns implements the logicalPlan interface
+func (w *window) ResultColumns() []*resultColumn {
+	panic("implement me")
+}
+
+// SupplyCol implements the logicalPlan interface
+func (w *window) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	panic("implement me")
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (w *window) SupplyWeightString(int, bool) (weightcolNumber int, err error) {
+	panic("implement me")
+}
+
+// Primitive implements the logicalPlan interface
+func (w *window) Primitive() engine.Primitive {
+	w.ewindow.Input = w.input.Primitive()
+	return w.ewindow
+}
+
+// findWindowFuncs returns the window functions used in the select list.
+func findWindowFuncs(exprs sqlparser.SelectExprs) []*sqlparser.WindowFuncExpr {
+	var funcs []*sqlparser.WindowFuncExpr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.WindowFuncExpr:
+			funcs = append(funcs, node)
+			return false, nil
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, exprs)
+	return funcs
+}
+
+// checkWindowFuncs makes sure that the window functions of the select
+// list can be pushed down to the route, which is only possible if
+// every partition lives in a single shard. v3 does not evaluate
+// window functions at the vtgate level.
+func (pb *primitiveBuilder) checkWindowFuncs(sel *sqlparser.Select) error {
+	funcs := findWindowFuncs(sel.SelectExprs)
+	if len(funcs) == 0 {
+		return nil
+	}
+	rb, isRoute := pb.plan.(*route)
+	if isRoute && rb.isSingleShard() {
+		return nil
+	}
+	if !isRoute {
+		return errors.New("unsupported: cross-shard query with window functions")
+	}
+	for _, wf := range funcs {
+		if !pb.partitionHasUniqueVindex(wf.Over, rb) {
+			return errors.New("unsupported: window function in scatter query without a unique vindex in its PARTITION BY")
+		}
+	}
+	return nil
+}
+
+func (pb *primitiveBuilder) partitionHasUniqueVindex(spec *sqlparser.WindowSpec, rb *route) bool {
+	for _, expr := range spec.PartitionBy {
+		vindex := pb.st.Vindex(expr, rb)
+		if vindex != nil && vindex.IsUnique() {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionHasUniqueVindexGen4 returns true if one of the PARTITION BY
+// expressions is a column with a unique vindex. All the rows of such a
+// partition live in the same shard, so the window can be evaluated by MySQL.
+func partitionHasUniqueVindexGen4(spec *sqlparser.WindowSpec, semTable *semantics.SemTable) bool {
+	for _, expr := range spec.PartitionBy {
+		col, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			continue
+		}
+		tableInfo, err := semTable.TableInfoFor(semTable.Dependencies(col))
+		if err != nil || tableInfo.Table == nil {
+			continue
+		}
+		for _, cv := range tableInfo.Table.ColumnVindexes {
+			if len(cv.Columns) == 1 && cv.Columns[0].Equal(col.Name) && cv.Vindex.IsUnique() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// planWindowFuncs plans a select list with window functions on top of a
+// plan that is not a single shard route. If every partition lives in a
+// single shard, the window functions are pushed down with the rest of the
+// select list. Otherwise, they are evaluated by vtgate: the route is asked
+// for the arguments of the functions, sorted by the PARTITION BY and ORDER BY
+// expressions of the window, and an engine.Window is planned on top of it.
+func planWindowFuncs(sel *sqlparser.Select, qp *queryProjection, plan logicalPlan, semTable *semantics.SemTable) (logicalPlan, bool, error) {
+	funcs := findWindowFuncs(sel.SelectExprs)
+	rb, isRoute := plan.(*route)
+	if !isRoute {
+		return nil, false, semantics.Gen4NotSupportedF("window functions on a cross-shard join")
+	}
+	pushDown := true
+	for _, wf := range funcs {
+		if !partitionHasUniqueVindexGen4(wf.Over, semTable) {
+			pushDown = false
+			break
+		}
+	}
+	if pushDown {
+		return plan, false, nil
+	}
+
+	if len(qp.aggrExprs) > 0 {
+		return nil, false, semantics.Gen4NotSupportedF("aggregation with cross-shard window functions")
+	}
+	if len(sel.OrderBy) > 0 {
+		return nil, false, semantics.Gen4NotSupportedF("ORDER BY with cross-shard window functions")
+	}
+	spec := funcs[0].Over
+	for _, wf := range funcs[1:] {
+		if !sqlparser.EqualsRefOfWindowSpec(spec, wf.Over) {
+			return nil, false, semantics.Gen4NotSupportedF("cross-shard window functions with different windows")
+		}
+	}
+	if spec.Frame != nil {
+		return nil, false, semantics.Gen4NotSupportedF("cross-shard window functions with a frame clause")
+	}
+
+	ewindow := &engine.Window{}
+	for _, expr := range qp.selectExprs {
+		wf, ok := expr.Expr.(*sqlparser.WindowFuncExpr)
+		if !ok {
+			if len(findWindowFuncs(sqlparser.SelectExprs{expr})) > 0 {
+				return nil, false, semantics.Gen4NotSupportedF("cross-shard window function in an expression")
+			}
+			offset, err := pushProjection(expr, rb, semTable, true)
+			if err != nil {
+				return nil, false, err
+			}
+			ewindow.Cols = append(ewindow.Cols, -(offset + 1))
+			continue
+		}
+		windowFunc, err := planWindowFunc(wf, rb, semTable)
+		if err != nil {
+			return nil, false, err
+		}
+		windowFunc.Alias = expr.As.String()
+		if windowFunc.Alias == "" {
+			windowFunc.Alias = sqlparser.String(wf)
+		}
+		ewindow.Functions = append(ewindow.Functions, windowFunc)
+		ewindow.Cols = append(ewindow.Cols, len(ewindow.Functions))
+	}
+
+	for _, expr := range spec.PartitionBy {
+		order := &sqlparser.Order{Expr: expr, Direction: sqlparser.AscOrder}
+		params, err := pushWindowOrder(order, rb, semTable)
+		if err != nil {
+			return nil, false, err
+		}
+		ewindow.PartitionBy = append(ewindow.PartitionBy, params)
+	}
+	for _, order := range spec.OrderBy {
+		params, err := pushWindowOrder(order, rb, semTable)
+		if err != nil {
+			return nil, false, err
+		}
+		ewindow.OrderBy = append(ewindow.OrderBy, params)
+	}
+
+	return &window{
+		logicalPlanCommon: newBuilderCommon(rb),
+		ewindow:           ewindow,
+	}, true, nil
+}
+
+// pushWindowOrder adds the PARTITION BY or ORDER BY expression of the window
+// to the ORDER BY of the route, so that the rows are merge-sorted by vtgate.
+func pushWindowOrder(order *sqlparser.Order, rb *route, semTable *semantics.SemTable) (engine.OrderbyParams, error) {
+	colName, ok := order.Expr.(*sqlparser.ColName)
+	if !ok {
+		return engine.OrderbyParams{}, semantics.Gen4NotSupportedF("cross-shard window function on a non-column expression")
+	}
+	offset, err := pushProjection(&sqlparser.AliasedExpr{Expr: colName}, rb, semTable, true)
+	if err != nil {
+		return engine.OrderbyParams{}, err
+	}
+	weightStringOffset, err := pushWeightString(colName, rb, semTable)
+	if err != nil {
+		return engine.OrderbyParams{}, err
+	}
+	params := engine.OrderbyParams{
+		Col:             offset,
+		WeightStringCol: weightStringOffset,
+		Desc:            order.Direction == sqlparser.DescOrder,
+	}
+	rb.eroute.OrderBy = append(rb.eroute.OrderBy, params)
+	rb.Select.AddOrder(order)
+	return params, nil
+}
+
+// planWindowFunc pushes the argument of the window function to the route,
+// and builds the engine.WindowFunc that evaluates the function.
+func planWindowFunc(wf *sqlparser.WindowFuncExpr, rb *route, semTable *semantics.SemTable) (*engine.WindowFunc, error) {
+	name := wf.Func.Name.Lowered()
+	opcode, ok := engine.SupportedWindowFunctions[name]
+	if !ok || wf.Func.Distinct {
+		return nil, semantics.Gen4NotSupportedF("cross-shard window function %s", sqlparser.String(wf.Func))
+	}
+
+	// The arguments that follow the expression must be constants.
+	var minArgs, maxArgs int
+	switch opcode {
+	case engine.WindowRowNumber, engine.WindowRank, engine.WindowDenseRank, engine.WindowPercentRank, engine.WindowCumeDist:
+		minArgs, maxArgs = 0, 0
+	case engine.WindowNtile:
+		minArgs, maxArgs = 1, 1
+	case engine.WindowLag, engine.WindowLead:
+		minArgs, maxArgs = 1, 3
+	case engine.WindowNthValue:
+		minArgs, maxArgs = 2, 2
+	default:
+		minArgs, maxArgs = 1, 1
+	}
+	args := wf.Func.Exprs
+	if len(args) < minArgs || len(args) > maxArgs {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "incorrect number of arguments for window function '%s'", sqlparser.String(wf.Func))
+	}
+
+	windowFunc := &engine.WindowFunc{Opcode: opcode, Col: -1}
+	switch opcode {
+	case engine.WindowNtile:
+		n, err := windowConstArg(args[0], wf)
+		if err != nil {
+			return nil, err
+		}
+		windowFunc.N = n
+		return windowFunc, nil
+	case engine.WindowLag, engine.WindowLead:
+		windowFunc.N = sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}
+	}
+	if len(args) == 0 {
+		return windowFunc, nil
+	}
+
+	switch arg := args[0].(type) {
+	case *sqlparser.StarExpr:
+		if opcode != engine.WindowCount {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "'*' is only allowed in count: '%s'", sqlparser.String(wf.Func))
+		}
+	case *sqlparser.AliasedExpr:
+		offset, err := pushProjection(&sqlparser.AliasedExpr{Expr: arg.Expr}, rb, semTable, true)
+		if err != nil {
+			return nil, err
+		}
+		windowFunc.Col = offset
+	default:
+		return nil, semantics.Gen4NotSupportedF("cross-shard window function %s", sqlparser.String(wf.Func))
+	}
+
+	if len(args) > 1 {
+		n, err := windowConstArg(args[1], wf)
+		if err != nil {
+			return nil, err
+		}
+		windowFunc.N = n
+	}
+	if len(args) > 2 {
+		def, err := windowConstArg(args[2], wf)
+		if err != nil {
+			return nil, err
+		}
+		windowFunc.Default = def
+	}
+	return windowFunc, nil
+}
+
+// windowConstArg returns the value of a constant argument of a window function.
+// The constant is either a literal, or the bind variable it was normalized into.
+func windowConstArg(arg sqlparser.SelectExpr, wf *sqlparser.WindowFuncExpr) (sqltypes.PlanValue, error) {
+	if aliased, ok := arg.(*sqlparser.AliasedExpr); ok {
+		switch aliased.Expr.(type) {
+		case *sqlparser.Literal, sqlparser.Argument:
+			return sqlparser.NewPlanValue(aliased.Expr)
+		}
+	}
+	return sqltypes.PlanValue{}, semantics.Gen4NotSupportedF("cross-shard window function %s with a non-constant argument", sqlparser.String(wf.Func))
+}
@@ -66,6 +66,8 @@ var (
 
 	mysqlSslServerCA = flag.String("mysql_server_ssl_server_ca", "", "path to server CA in PEM format, which will be combine with server cert, return full certificate chain to clients")
 
+	mysqlCachingSha2PrivateKey = flag.String("mysql_server_caching_sha2_private_key", "", "Path to a RSA private key in PEM format, used by caching_sha2_password to receive the passwords of the clients that do not use SSL")
+
 	mysqlSlowConnectWarnThreshold = flag.Duration("mysql_slow_connect_warn_threshold", 0, "Warn if it takes more than the given threshold for a mysql connection to establish")
 
 	mysqlConnReadTimeout  = flag.Duration("mysql_server_read_timeout", 0, "connection read timeout")
@@ -144,12 +146,19 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 // Regexp to extract parent span id over the sql query
 var r = regexp.MustCompile(`/\*VT_SPAN_CONTEXT=(.*)\*/`)
 
+// Regexp to extract a W3C trace context over the sql query, in the
+// sqlcommenter format: /*traceparent='00-<trace id>-<parent id>-<flags>'*/
+var traceParentRegexp = regexp.MustCompile(`traceparent='([^']*)'`)
+
 // this function is here to make this logic easy to test by decoupling the logic from the `trace.NewSpan` and `trace.NewFromString` functions
 func startSpanTestable(ctx context.Context, query, label string,
 	newSpan func(context.Context, string) (trace.Span, context.Context),
 	newSpanFromString func(context.Context, string, string) (trace.Span, context.Context, error)) (trace.Span, context.Context, error) {
 	_, comments := sqlparser.SplitMarginComments(query)
 	match := r.FindStringSubmatch(comments.Leading)
+	if len(match) == 0 {
+		match = traceParentRegexp.FindStringSubmatch(comments.Leading + comments.Trailing)
+	}
 	span, ctx := getSpan(ctx, match, newSpan, label, newSpanFromString)
 
 	trace.AnnotateSQL(span, query)
@@ -432,6 +441,12 @@ func initMySQLProtocol() {
 		if *mysqlSslCert != "" && *mysqlSslKey != "" {
 			initTLSConfig(mysqlListener, *mysqlSslCert, *mysqlSslKey, *mysqlSslCa, *mysqlSslServerCA, *mysqlServerRequireSecureTransport)
 		}
+		if *mysqlCachingSha2PrivateKey != "" {
+			mysqlListener.CachingSha2PrivateKey, err = mysql.ReadRSAPrivateKey(*mysqlCachingSha2PrivateKey)
+			if err != nil {
+				log.Exitf("mysql.ReadRSAPrivateKey failed: %v", err)
+			}
+		}
 		mysqlListener.AllowClearTextWithoutTLS.Set(*mysqlAllowClearTextWithoutTLS)
 		// Check for the connection threshold
 		if *mysqlSlowConnectWarnThreshold != 0 {
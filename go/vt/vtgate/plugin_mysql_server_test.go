@@ -214,6 +214,18 @@ func TestSpanContextPassedInEvenAroundOtherComments(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSpanContextTraceParent(t *testing.T) {
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	_, _, err := startSpanTestable(context.Background(), "SELECT col1 FROM TABLE /*traceparent='"+traceParent+"'*/", "someLabel",
+		newSpanFail(t),
+		newFromStringExpect(t, traceParent))
+	assert.NoError(t, err)
+	_, _, err = startSpanTestable(context.Background(), "/*traceparent='"+traceParent+"',route='/x'*/SELECT col1 FROM TABLE", "someLabel",
+		newSpanFail(t),
+		newFromStringExpect(t, traceParent))
+	assert.NoError(t, err)
+}
+
 func TestSpanContextNotParsable(t *testing.T) {
 	hasRun := false
 	_, _, err := startSpanTestable(context.Background(), "/*VT_SPAN_CONTEXT=123*/SQL QUERY", "someLabel",
@@ -165,7 +165,7 @@ func (res *Resolver) MessageStream(ctx context.Context, keyspace string, shard s
 	if err != nil {
 		return err
 	}
-	return res.scatterConn.MessageStream(ctx, rss, name, callback)
+	return res.scatterConn.MessageStream(ctx, rss, name, "", callback)
 }
 
 // GetGatewayCacheStatus returns a displayable version of the Gateway cache.
@@ -0,0 +1,425 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+const pathResultCache = "/debug/result_cache"
+
+var (
+	resultCacheHits          = stats.NewCounter("QueryResultCacheHits", "Query result cache hits")
+	resultCacheMisses        = stats.NewCounter("QueryResultCacheMisses", "Query result cache misses")
+	resultCacheInvalidations = stats.NewCounter("QueryResultCacheInvalidations", "Query result cache invalidations")
+
+	resultCacheOnce sync.Once
+
+	// resultCacheVolatileFuncs are the functions whose results depend
+	// on the session or change from one execution to the next.
+	resultCacheVolatileFuncs = map[string]bool{
+		"last_insert_id":    true,
+		"database":          true,
+		"schema":            true,
+		"found_rows":        true,
+		"row_count":         true,
+		"user":              true,
+		"current_user":      true,
+		"session_user":      true,
+		"system_user":       true,
+		"connection_id":     true,
+		"now":               true,
+		"sysdate":           true,
+		"current_timestamp": true,
+		"localtime":         true,
+		"localtimestamp":    true,
+		"curdate":           true,
+		"current_date":      true,
+		"curtime":           true,
+		"current_time":      true,
+		"utc_date":          true,
+		"utc_time":          true,
+		"utc_timestamp":     true,
+		"unix_timestamp":    true,
+		"rand":              true,
+		"uuid":              true,
+		"uuid_short":        true,
+		"sleep":             true,
+	}
+)
+
+// resultCacheStreamer is the subset of the vstreamManager
+// used by the result cache.
+type resultCacheStreamer interface {
+	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error
+}
+
+// resultCache caches the results of the selects sent to replica and
+// rdonly tablets, keyed by their plan, their callers and their bind
+// variables: the tablets authorize every caller, so a result is only
+// served to the callers it was fetched for. The first cacheable query
+// on a keyspace subscribes to its vstream, and the results are
+// invalidated as soon as the stream shows a change on one of the tables
+// they were read from.
+//
+// The vstream of a keyspace and tablet type can read from other
+// tablets than the queries: a query sent to a replica which lags
+// behind the one streamed can still read a row changed before the
+// invalidation, and that stale result is then served until it expires.
+// Staleness is thus only bounded by the TTL of the cache.
+//
+// Invalidation is done by bumping a per-table generation: an entry is
+// only served if the generations of its tables did not change since
+// the query that produced it was sent to the tablets. This also covers
+// the changes that happen while that query is in flight. Results are
+// not cached while a stream is not established, since its changes
+// would be missed.
+type resultCache struct {
+	vs      resultCacheStreamer
+	ttl     time.Duration
+	entries *cache.LRUCache
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// mu protects the following fields.
+	mu sync.Mutex
+	// epoch is bumped when the whole cache is invalidated.
+	epoch       int64
+	generations map[string]int64
+	// streams tells, for each keyspace@tablet_type that is being
+	// watched, whether its vstream is established.
+	streams map[string]bool
+}
+
+type resultCacheEntry struct {
+	query       string
+	bindVars    map[string]*querypb.BindVariable
+	result      *sqltypes.Result
+	expires     time.Time
+	tables      []string
+	epoch       int64
+	generations []int64
+	size        int64
+	hits        int64
+}
+
+// newResultCache creates a resultCache that holds up to
+// memory bytes of results, for at most ttl.
+func newResultCache(vs resultCacheStreamer, memory int64, ttl time.Duration) *resultCache {
+	ctx, cancel := context.WithCancel(context.Background())
+	rc := &resultCache{
+		vs: vs,
+		entries: cache.NewLRUCache(memory, func(v interface{}) int64 {
+			return v.(*resultCacheEntry).size
+		}),
+		ttl:         ttl,
+		ctx:         ctx,
+		cancel:      cancel,
+		generations: make(map[string]int64),
+		streams:     make(map[string]bool),
+	}
+	resultCacheOnce.Do(func() {
+		stats.NewGaugeFunc("QueryResultCacheLength", "Query result cache length", func() int64 {
+			return int64(rc.entries.Len())
+		})
+		stats.NewGaugeFunc("QueryResultCacheSize", "Query result cache size", rc.entries.UsedCapacity)
+		stats.NewGaugeFunc("QueryResultCacheCapacity", "Query result cache capacity", rc.entries.MaxCapacity)
+		stats.NewCounterFunc("QueryResultCacheEvictions", "Query result cache evictions", rc.entries.Evictions)
+	})
+	return rc
+}
+
+// Close stops watching the vstreams and empties the cache.
+func (rc *resultCache) Close() {
+	rc.cancel()
+	rc.wg.Wait()
+	rc.InvalidateAll()
+}
+
+// resultCacheTables returns the keyspace qualified tables read by the
+// select, or nil if its results must not be cached. This is the case
+// for the selects that lock rows, depend on the session or the time,
+// or read from tables that are not in the vschema. It must be called
+// before planning, which can rewrite the statement.
+func resultCacheTables(vcursor *vcursorImpl, stmt sqlparser.Statement, bindVarNeeds *sqlparser.BindVarNeeds) []string {
+	if _, ok := stmt.(sqlparser.SelectStatement); !ok {
+		return nil
+	}
+	if len(bindVarNeeds.NeedFunctionResult) > 0 || len(bindVarNeeds.NeedSystemVariable) > 0 || len(bindVarNeeds.NeedUserDefinedVariables) > 0 {
+		return nil
+	}
+	var tables []string
+	cacheable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if node.Lock != sqlparser.NoLock || node.Into != nil || node.SQLCalcFoundRows || (node.Cache != nil && !*node.Cache) {
+				cacheable = false
+			}
+		case *sqlparser.ColName:
+			// User defined and system variables.
+			if node.Name.AtCount() != sqlparser.NoAt {
+				cacheable = false
+			}
+		case *sqlparser.FuncExpr:
+			if resultCacheVolatileFuncs[node.Name.Lowered()] || sqlparser.IsLockingFunc(node) {
+				cacheable = false
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			cacheable = false
+		case *sqlparser.AliasedTableExpr:
+			name, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				return true, nil
+			}
+			table, err := vcursor.FindRoutedTable(name)
+			if err != nil || table.Keyspace == nil {
+				cacheable = false
+				return false, nil
+			}
+			tables = append(tables, table.Keyspace.Name+"."+table.Name.String())
+		}
+		return cacheable, nil
+	}, stmt)
+	if !cacheable || len(tables) == 0 {
+		return nil
+	}
+	sort.Strings(tables)
+	unique := tables[:1]
+	for _, table := range tables[1:] {
+		if table != unique[len(unique)-1] {
+			unique = append(unique, table)
+		}
+	}
+	return unique
+}
+
+// resultCacheKey returns the cache key of the query executed
+// with the bind variables on behalf of the callers of ctx.
+func resultCacheKey(ctx context.Context, planKey string, bindVars map[string]*querypb.BindVariable) (string, error) {
+	// Deterministic marshaling orders the bind variables by name.
+	buf, err := proto.MarshalOptions{Deterministic: true}.Marshal(&querypb.ExecuteRequest{
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		Query:             &querypb.BoundQuery{Sql: planKey, BindVariables: bindVars},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// Get returns a copy of the cached result of the query, if it's
+// still valid. The caller owns the copy.
+func (rc *resultCache) Get(key string) (*sqltypes.Result, bool) {
+	v, ok := rc.entries.Get(key)
+	if !ok {
+		resultCacheMisses.Add(1)
+		return nil, false
+	}
+	entry := v.(*resultCacheEntry)
+	if time.Now().After(entry.expires) || !rc.isCurrent(entry.tables, entry.epoch, entry.generations) {
+		rc.entries.Delete(key)
+		resultCacheMisses.Add(1)
+		return nil, false
+	}
+	atomic.AddInt64(&entry.hits, 1)
+	resultCacheHits.Add(1)
+	return entry.result.Copy(), true
+}
+
+// Snapshot returns the generations of the tables. It must be called
+// before fetching the result that will be passed to Set. It returns
+// false if the result can't be cached because the vstreams of the
+// keyspaces of the tables are not established yet, in which case it
+// starts watching them.
+func (rc *resultCache) Snapshot(tables []string, tabletType topodatapb.TabletType) (epoch int64, generations []int64, ok bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	ok = true
+	generations = make([]int64, len(tables))
+	for i, table := range tables {
+		generations[i] = rc.generations[table]
+		keyspace := table[:strings.IndexByte(table, '.')]
+		name := keyspace + "@" + topoproto.TabletTypeLString(tabletType)
+		established, watched := rc.streams[name]
+		if !watched {
+			rc.streams[name] = false
+			rc.wg.Add(1)
+			go rc.watch(name, keyspace, tabletType)
+		}
+		ok = ok && established
+	}
+	return rc.epoch, generations, ok
+}
+
+// Set caches a copy of the result of the query. The result is
+// dropped if the tables changed since the snapshot was taken.
+func (rc *resultCache) Set(key, query string, bindVars map[string]*querypb.BindVariable, tables []string, epoch int64, generations []int64, result *sqltypes.Result) {
+	if !rc.isCurrent(tables, epoch, generations) {
+		return
+	}
+	rc.entries.Set(key, &resultCacheEntry{
+		query:       query,
+		bindVars:    bindVars,
+		result:      result.Copy(),
+		expires:     time.Now().Add(rc.ttl),
+		tables:      tables,
+		epoch:       epoch,
+		generations: generations,
+		size:        int64(len(key)) + result.CachedSize(true),
+	})
+}
+
+func (rc *resultCache) isCurrent(tables []string, epoch int64, generations []int64) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.epoch != epoch {
+		return false
+	}
+	for i, table := range tables {
+		if rc.generations[table] != generations[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Invalidate invalidates the cached results that were read from
+// the keyspace qualified table.
+func (rc *resultCache) Invalidate(table string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generations[table]++
+	resultCacheInvalidations.Add(1)
+}
+
+// InvalidateAll invalidates all the cached results.
+func (rc *resultCache) InvalidateAll() {
+	rc.mu.Lock()
+	rc.epoch++
+	rc.generations = make(map[string]int64)
+	rc.mu.Unlock()
+	resultCacheInvalidations.Add(1)
+	rc.entries.Clear()
+}
+
+func (rc *resultCache) setEstablished(name string, established bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.streams[name] = established
+}
+
+// watch streams the changes of all the shards of the keyspace
+// until the cache is closed.
+func (rc *resultCache) watch(name, keyspace string, tabletType topodatapb.TabletType) {
+	defer rc.wg.Done()
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: keyspace,
+			Gtid:     "current",
+		}},
+	}
+	// The heartbeats tell that the stream is established even
+	// if the keyspace does not change.
+	flags := &vtgatepb.VStreamFlags{HeartbeatInterval: 1}
+
+	for {
+		err := rc.vs.VStream(rc.ctx, tabletType, vgtid, nil, flags, func(events []*binlogdatapb.VEvent) error {
+			rc.handleEvents(name, events)
+			return nil
+		})
+		// Changes are missed until the stream is restarted: stop
+		// caching and drop what could become stale.
+		rc.setEstablished(name, false)
+		rc.InvalidateAll()
+		log.Infof("Result cache vstream for %s ended: %v, retrying in 5 seconds", name, err)
+		select {
+		case <-rc.ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (rc *resultCache) handleEvents(name string, events []*binlogdatapb.VEvent) {
+	for _, ev := range events {
+		switch ev.Type {
+		case binlogdatapb.VEventType_ROW:
+			// The vstreamManager qualifies the table names with their keyspace.
+			rc.Invalidate(ev.RowEvent.TableName)
+		case binlogdatapb.VEventType_DDL:
+			rc.InvalidateAll()
+		}
+	}
+	rc.setEstablished(name, true)
+}
+
+type resultCacheItem struct {
+	Query    string
+	BindVars map[string]*querypb.BindVariable
+	Tables   []string
+	Rows     int
+	Size     int64
+	Hits     int64
+	Expires  time.Time
+}
+
+// debugEntries returns the cached results, the most hit first.
+func (rc *resultCache) debugEntries() []resultCacheItem {
+	items := []resultCacheItem{}
+	rc.entries.ForEach(func(value interface{}) bool {
+		entry := value.(*resultCacheEntry)
+		items = append(items, resultCacheItem{
+			Query:    entry.query,
+			BindVars: entry.bindVars,
+			Tables:   entry.tables,
+			Rows:     len(entry.result.Rows),
+			Size:     entry.size,
+			Hits:     atomic.LoadInt64(&entry.hits),
+			Expires:  entry.expires,
+		})
+		return true
+	})
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Hits > items[j].Hits
+	})
+	return items
+}
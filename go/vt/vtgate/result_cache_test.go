@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/discovery"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// fakeResultCacheStreamer hands the send functions of the
+// vstreams to the test, and blocks until they're canceled.
+type fakeResultCacheStreamer struct {
+	streams chan fakeResultCacheStream
+}
+
+type fakeResultCacheStream struct {
+	keyspace   string
+	tabletType topodatapb.TabletType
+	send       func(events []*binlogdatapb.VEvent) error
+}
+
+func newFakeResultCacheStreamer() *fakeResultCacheStreamer {
+	return &fakeResultCacheStreamer{streams: make(chan fakeResultCacheStream, 10)}
+}
+
+func (f *fakeResultCacheStreamer) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
+	f.streams <- fakeResultCacheStream{
+		keyspace:   vgtid.ShardGtids[0].Keyspace,
+		tabletType: tabletType,
+		send:       send,
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestResultCacheTables(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.resultCache = newResultCache(newFakeResultCacheStreamer(), 1024*1024, time.Hour)
+	defer executor.resultCache.Close()
+	vc, err := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@replica"}), makeComments(""), executor, nil, executor.vm, executor.VSchema(), executor.resolver.resolver, nil, false)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		query  string
+		tables []string
+	}{{
+		query:  "select * from music_user_map",
+		tables: []string{"TestUnsharded.music_user_map"},
+	}, {
+		query:  "select * from music_user_map join name_user_map union select * from music_user_map",
+		tables: []string{"TestUnsharded.music_user_map", "TestUnsharded.name_user_map"},
+	}, {
+		query:  "select * from (select id from user) as t",
+		tables: []string{"TestExecutor.user"},
+	}, {
+		query:  "select id from user where id in (select user_id from user_extra)",
+		tables: []string{"TestExecutor.user", "TestExecutor.user_extra"},
+	}, {
+		query: "select * from user for update",
+	}, {
+		query: "select sql_no_cache * from user",
+	}, {
+		query: "select sql_calc_found_rows * from user limit 10",
+	}, {
+		query: "select last_insert_id() from user",
+	}, {
+		query: "select @foo from user",
+	}, {
+		query: "select id from user where created < now()",
+	}, {
+		query: "select rand() from user",
+	}, {
+		query: "insert into user(id) values (1)",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			plan, _ := getPlanCached(t, executor, vc, tcase.query, makeComments(""), map[string]*querypb.BindVariable{}, true)
+			assert.Equal(t, tcase.tables, plan.ResultCacheTables)
+		})
+	}
+}
+
+func TestExecutorResultCache(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	hc := vtgateHealthCheck.(*discovery.FakeHealthCheck)
+	sbc := hc.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	vs := newFakeResultCacheStreamer()
+	executor.resultCache = newResultCache(vs, 1024*1024, time.Hour)
+	defer executor.resultCache.Close()
+
+	sbc.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")})
+	execCtx := func(ctx context.Context, target, sql string, id int64) {
+		t.Helper()
+		session := NewSafeSession(&vtgatepb.Session{TargetString: target, Autocommit: true})
+		_, err := executor.Execute(ctx, "TestExecute", session, sql, map[string]*querypb.BindVariable{
+			"id": sqltypes.Int64BindVariable(id),
+		})
+		require.NoError(t, err)
+	}
+	exec := func(target, sql string, id int64) {
+		t.Helper()
+		execCtx(context.Background(), target, sql, id)
+	}
+	const query = "select id from music_user_map where id = :id"
+	const target = KsTestUnsharded + "@replica"
+
+	// Nothing is cached until the vstream of the keyspace is established.
+	exec(target, query, 1)
+	exec(target, query, 1)
+	assert.EqualValues(t, 2, sbc.ExecCount.Get())
+	stream := <-vs.streams
+	assert.Equal(t, KsTestUnsharded, stream.keyspace)
+	assert.Equal(t, topodatapb.TabletType_REPLICA, stream.tabletType)
+	require.NoError(t, stream.send([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_HEARTBEAT}}))
+
+	exec(target, query, 1)
+	exec(target, query, 1)
+	exec(target, query, 1)
+	assert.EqualValues(t, 3, sbc.ExecCount.Get())
+
+	// The bind variables are part of the key.
+	exec(target, query, 2)
+	exec(target, query, 2)
+	assert.EqualValues(t, 4, sbc.ExecCount.Get())
+
+	items := executor.resultCache.debugEntries()
+	require.Len(t, items, 2)
+	assert.Equal(t, query, items[0].Query)
+	assert.EqualValues(t, 2, items[0].Hits)
+	assert.EqualValues(t, 1, items[1].Hits)
+	assert.Equal(t, []string{"TestUnsharded.music_user_map"}, items[0].Tables)
+
+	// A change on another table keeps the results, a change on the table drops them.
+	require.NoError(t, stream.send([]*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "TestUnsharded.name_user_map"},
+	}}))
+	exec(target, query, 1)
+	assert.EqualValues(t, 4, sbc.ExecCount.Get())
+	require.NoError(t, stream.send([]*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "TestUnsharded.music_user_map"},
+	}}))
+	exec(target, query, 1)
+	exec(target, query, 1)
+	assert.EqualValues(t, 5, sbc.ExecCount.Get())
+
+	// A DDL drops everything.
+	require.NoError(t, stream.send([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_DDL}}))
+	exec(target, query, 2)
+	assert.EqualValues(t, 6, sbc.ExecCount.Get())
+	assert.Len(t, executor.resultCache.debugEntries(), 1)
+
+	// The queries sent to the master are never cached.
+	masterCount := executor.resultCache.entries.Len()
+	exec(KsTestUnsharded, query, 1)
+	exec(KsTestUnsharded, query, 1)
+	assert.Equal(t, masterCount, executor.resultCache.entries.Len())
+	assert.EqualValues(t, 6, sbc.ExecCount.Get())
+
+	// The callers are part of the key: the tablets authorize each of them.
+	for _, ctx := range []context.Context{
+		callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("other")),
+		callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("app", "", ""), nil),
+	} {
+		execCtx(ctx, target, query, 2)
+		execCtx(ctx, target, query, 2)
+	}
+	assert.EqualValues(t, 8, sbc.ExecCount.Get())
+	exec(target, query, 2)
+	assert.EqualValues(t, 8, sbc.ExecCount.Get())
+}
+
+func TestResultCacheReplicaLag(t *testing.T) {
+	// The vstream which invalidates the results can read from another
+	// replica than the queries. A query sent to a lagging replica after
+	// the invalidation reads a stale row, and its result is cached: it
+	// must not be served for longer than the TTL.
+	const ttl = 50 * time.Millisecond
+	rc := newResultCache(newFakeResultCacheStreamer(), 1024*1024, ttl)
+	defer rc.Close()
+	tables := []string{"ks.t1"}
+	rc.setEstablished("ks@replica", true)
+
+	rc.Invalidate("ks.t1")
+	epoch, generations, ok := rc.Snapshot(tables, topodatapb.TabletType_REPLICA)
+	require.True(t, ok)
+	stale := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|val", "int64|varchar"), "1|old")
+	rc.Set("q1", "select * from t1", nil, tables, epoch, generations, stale)
+
+	got, ok := rc.Get("q1")
+	require.True(t, ok)
+	assert.True(t, stale.Equal(got), "got %v, want %v", got, stale)
+
+	// The result handed out is a copy.
+	got.Rows[0][1] = sqltypes.NewVarChar("new")
+	got, ok = rc.Get("q1")
+	require.True(t, ok)
+	assert.True(t, stale.Equal(got), "got %v, want %v", got, stale)
+
+	time.Sleep(2 * ttl)
+	_, ok = rc.Get("q1")
+	assert.False(t, ok)
+}
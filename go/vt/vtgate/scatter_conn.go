@@ -416,7 +416,7 @@ func (tt *timeTracker) Record(target *querypb.Target) time.Time {
 // MessageStream streams messages from the specified shards.
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines, through processOneStreamingResult.
-func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error {
+func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name, consumerGroup string, callback func(*sqltypes.Result) error) error {
 	// The cancelable context is used for handling errors
 	// from individual streams.
 	ctx, cancel := context.WithCancel(ctx)
@@ -431,7 +431,7 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 		// an individual stream to end. If we don't succeed on the retries for
 		// messageStreamGracePeriod, we abort and return an error.
 		for {
-			err := rs.Gateway.MessageStream(ctx, rs.Target, name, func(qr *sqltypes.Result) error {
+			err := rs.Gateway.MessageStream(ctx, rs.Target, name, consumerGroup, func(qr *sqltypes.Result) error {
 				lastErrors.Reset(rs.Target)
 				return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 			})
@@ -468,6 +468,41 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 	return allErrors.AggrError(vterrors.Aggregate)
 }
 
+// MessageAck acks messages across multiple shards, for consumerGroup
+// if it's not empty.
+func (stc *ScatterConn) MessageAck(ctx context.Context, rss []*srvtopo.ResolvedShard, values [][]*querypb.Value, name, consumerGroup string) (int64, error) {
+	var mu sync.Mutex
+	var totalCount int64
+	allErrors := stc.multiGo("MessageAck", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+		count, err := rs.Gateway.MessageAck(ctx, rs.Target, name, consumerGroup, values[i])
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		totalCount += count
+		mu.Unlock()
+		return nil
+	})
+	return totalCount, allErrors.AggrError(vterrors.Aggregate)
+}
+
+// MessageNack nacks messages across multiple shards.
+func (stc *ScatterConn) MessageNack(ctx context.Context, rss []*srvtopo.ResolvedShard, values [][]*querypb.Value, name string) (int64, error) {
+	var mu sync.Mutex
+	var totalCount int64
+	allErrors := stc.multiGo("MessageNack", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+		count, err := rs.Gateway.MessageNack(ctx, rs.Target, name, values[i])
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		totalCount += count
+		mu.Unlock()
+		return nil
+	})
+	return totalCount, allErrors.AggrError(vterrors.Aggregate)
+}
+
 // Close closes the underlying Gateway.
 func (stc *ScatterConn) Close() error {
 	return stc.gateway.Close(context.Background())
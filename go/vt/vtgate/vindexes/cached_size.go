@@ -254,6 +254,31 @@ func (cached *LookupUnique) CachedSize(alloc bool) int64 {
 	size += cached.lkp.CachedSize(false)
 	return size
 }
+func (cached *MultiCol) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field name string
+	size += int64(len(cached.name))
+	// field columnVindex []vitess.io/vitess/go/vt/vtgate/vindexes.SingleColumn
+	{
+		size += int64(cap(cached.columnVindex)) * int64(16)
+		for _, elem := range cached.columnVindex {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field columnBytes []int
+	{
+		size += int64(cap(cached.columnBytes)) * int64(8)
+	}
+	return size
+}
 func (cached *Null) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -303,6 +328,27 @@ func (cached *NumericStaticMap) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *RangeMap) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field name string
+	size += int64(len(cached.name))
+	// field rangeMap string
+	size += int64(len(cached.rangeMap))
+	// field ranges []vitess.io/vitess/go/vt/vtgate/vindexes.idRange
+	{
+		size += int64(cap(cached.ranges)) * int64(40)
+		for _, elem := range cached.ranges {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
 func (cached *RegionExperimental) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -472,6 +518,18 @@ func (cached *clCommon) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.updateLookupQuery))
 	return size
 }
+func (cached *idRange) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field ksid []byte
+	size += int64(cap(cached.ksid))
+	return size
+}
 func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
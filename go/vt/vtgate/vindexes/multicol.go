@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ MultiColumn = (*MultiCol)(nil)
+)
+
+const (
+	paramColumnCount  = "column_count"
+	paramColumnBytes  = "column_bytes"
+	paramColumnVindex = "column_vindex"
+	defaultVindex     = "hash"
+	maxKeyspaceIDLen  = 8
+)
+
+func init() {
+	Register("multicol", NewMultiCol)
+}
+
+// MultiCol is a multi-column unique functional vindex. Every column is
+// mapped by its own functional vindex, and the keyspace id is the
+// concatenation of the leading bytes of each column's keyspace id.
+// Since the first column decides the leading bytes of the keyspace id,
+// a row that only supplies the leading columns maps to a key range:
+// MultiCol can be used to subshard the rows of the first column on the
+// next ones.
+type MultiCol struct {
+	name          string
+	columnCount   int
+	columnVindex  []SingleColumn
+	columnBytes   []int
+	noOfBytesUsed int
+}
+
+// NewMultiCol creates a MultiCol vindex.
+// The supplied map requires a column_count argument, between 1 and 8.
+// column_vindex optionally lists the comma separated vindex types of the
+// columns, which default to hash. They must be unique functional vindexes.
+// column_bytes optionally lists the comma separated number of bytes taken
+// from each column. The bytes must add up to at most 8. By default, every
+// column but the last one takes a single byte, and the last one takes the
+// remaining bytes.
+func NewMultiCol(name string, m map[string]string) (Vindex, error) {
+	colCount, err := strconv.Atoi(m[paramColumnCount])
+	if err != nil {
+		return nil, fmt.Errorf("multicol: invalid %s param: %v", paramColumnCount, m[paramColumnCount])
+	}
+	if colCount < 1 || colCount > maxKeyspaceIDLen {
+		return nil, fmt.Errorf("multicol: %s must be between 1 and %d: %d", paramColumnCount, maxKeyspaceIDLen, colCount)
+	}
+	colVindexes, err := getColumnVindex(m, colCount)
+	if err != nil {
+		return nil, err
+	}
+	colBytes, noOfBytes, err := getColumnBytes(m, colCount)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiCol{
+		name:          name,
+		columnCount:   colCount,
+		columnVindex:  colVindexes,
+		columnBytes:   colBytes,
+		noOfBytesUsed: noOfBytes,
+	}, nil
+}
+
+// String returns the name of the vindex.
+func (m *MultiCol) String() string {
+	return m.name
+}
+
+// Cost returns the cost of this index as 1.
+func (m *MultiCol) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (m *MultiCol) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (m *MultiCol) NeedsVCursor() bool {
+	return false
+}
+
+// PartialVindex returns true since a row with only the leading columns
+// maps to a key range.
+func (m *MultiCol) PartialVindex() bool {
+	return true
+}
+
+// Map satisfies MultiColumn. A row with all the columns maps to a
+// keyspace id, and a row with only the leading columns maps to the key
+// range of the keyspace ids which start with their bytes.
+func (m *MultiCol) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	for _, colValues := range rowsColValues {
+		ksid, err := m.mapKsid(colValues)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case ksid == nil:
+			out = append(out, key.DestinationNone{})
+		case len(colValues) < m.columnCount:
+			out = append(out, key.DestinationKeyRange{KeyRange: prefixKeyRange(ksid)})
+		default:
+			out = append(out, key.DestinationKeyspaceID(ksid))
+		}
+	}
+	return out, nil
+}
+
+// Verify satisfies MultiColumn.
+func (m *MultiCol) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(rowsColValues))
+	for i, colValues := range rowsColValues {
+		if len(colValues) != m.columnCount {
+			continue
+		}
+		ksid, err := m.mapKsid(colValues)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = ksid != nil && bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// mapKsid returns the keyspace id of the column values, or its leading
+// bytes if only the leading columns are supplied. It returns nil if a
+// column value does not map to a keyspace id.
+func (m *MultiCol) mapKsid(colValues []sqltypes.Value) ([]byte, error) {
+	if len(colValues) == 0 || len(colValues) > m.columnCount {
+		return nil, fmt.Errorf("multicol: wrong number of column values were passed: maximum allowed is %d, got %d", m.columnCount, len(colValues))
+	}
+	ksid := make([]byte, 0, m.noOfBytesUsed)
+	for i, colVal := range colValues {
+		dests, err := m.columnVindex[i].Map(nil, []sqltypes.Value{colVal})
+		if err != nil {
+			return nil, err
+		}
+		colKsid, ok := dests[0].(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, nil
+		}
+		if len(colKsid) < m.columnBytes[i] {
+			return nil, fmt.Errorf("multicol: vindex %s returned %d bytes, %d are needed", m.columnVindex[i].String(), len(colKsid), m.columnBytes[i])
+		}
+		ksid = append(ksid, colKsid[:m.columnBytes[i]]...)
+	}
+	return ksid, nil
+}
+
+// prefixKeyRange returns the key range of the keyspace ids which start
+// with prefix.
+func prefixKeyRange(prefix []byte) *topodatapb.KeyRange {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return &topodatapb.KeyRange{Start: prefix, End: end[:i+1]}
+		}
+	}
+	// prefix is all 0xff: the key range is open ended.
+	return &topodatapb.KeyRange{Start: prefix}
+}
+
+func getColumnVindex(m map[string]string, colCount int) ([]SingleColumn, error) {
+	var colVindexTypes []string
+	if colVindexStr := strings.TrimSpace(m[paramColumnVindex]); colVindexStr != "" {
+		colVindexTypes = strings.Split(colVindexStr, ",")
+		if len(colVindexTypes) > colCount {
+			return nil, fmt.Errorf("multicol: number of vindexes in %s exceeds %s: %d > %d", paramColumnVindex, paramColumnCount, len(colVindexTypes), colCount)
+		}
+	}
+	colVindexes := make([]SingleColumn, 0, colCount)
+	for i := 0; i < colCount; i++ {
+		vindexType := defaultVindex
+		if i < len(colVindexTypes) && strings.TrimSpace(colVindexTypes[i]) != "" {
+			vindexType = strings.TrimSpace(colVindexTypes[i])
+		}
+		vindex, err := CreateVindex(vindexType, vindexType, nil)
+		if err != nil {
+			return nil, fmt.Errorf("multicol: %v", err)
+		}
+		single, ok := vindex.(SingleColumn)
+		if !ok || !vindex.IsUnique() || vindex.NeedsVCursor() {
+			return nil, fmt.Errorf("multicol: vindex %s of column %d is not a unique functional vindex", vindexType, i+1)
+		}
+		colVindexes = append(colVindexes, single)
+	}
+	return colVindexes, nil
+}
+
+func getColumnBytes(m map[string]string, colCount int) ([]int, int, error) {
+	colBytesStr := strings.TrimSpace(m[paramColumnBytes])
+	if colBytesStr == "" {
+		colBytes := make([]int, colCount)
+		for i := range colBytes {
+			colBytes[i] = 1
+		}
+		colBytes[colCount-1] = maxKeyspaceIDLen - (colCount - 1)
+		return colBytes, maxKeyspaceIDLen, nil
+	}
+	parts := strings.Split(colBytesStr, ",")
+	if len(parts) != colCount {
+		return nil, 0, fmt.Errorf("multicol: number of values in %s must match %s: %d != %d", paramColumnBytes, paramColumnCount, len(parts), colCount)
+	}
+	colBytes := make([]int, 0, colCount)
+	total := 0
+	for _, part := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n < 1 {
+			return nil, 0, fmt.Errorf("multicol: invalid %s param: %v", paramColumnBytes, colBytesStr)
+		}
+		colBytes = append(colBytes, n)
+		total += n
+	}
+	if total > maxKeyspaceIDLen {
+		return nil, 0, fmt.Errorf("multicol: %s cannot exceed %d bytes in total: %d", paramColumnBytes, maxKeyspaceIDLen, total)
+	}
+	return colBytes, total, nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func hexBytes(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestMultiColMisc(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, vindex.Cost())
+	assert.Equal(t, "multicol", vindex.String())
+	assert.True(t, vindex.IsUnique())
+	assert.False(t, vindex.NeedsVCursor())
+	assert.True(t, vindex.(MultiColumn).PartialVindex())
+}
+
+func TestMultiColCreate(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		bytes  []int
+		err    string
+	}{{
+		params: map[string]string{"column_count": "1"},
+		bytes:  []int{8},
+	}, {
+		params: map[string]string{"column_count": "3"},
+		bytes:  []int{1, 1, 6},
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "2,4", "column_vindex": "xxhash"},
+		bytes:  []int{2, 4},
+	}, {
+		params: map[string]string{},
+		err:    "multicol: invalid column_count param: ",
+	}, {
+		params: map[string]string{"column_count": "9"},
+		err:    "multicol: column_count must be between 1 and 8: 9",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "1"},
+		err:    "multicol: number of values in column_bytes must match column_count: 1 != 2",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4,5"},
+		err:    "multicol: column_bytes cannot exceed 8 bytes in total: 9",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "0,5"},
+		err:    "multicol: invalid column_bytes param: 0,5",
+	}, {
+		params: map[string]string{"column_count": "1", "column_vindex": "hash,hash"},
+		err:    "multicol: number of vindexes in column_vindex exceeds column_count: 2 > 1",
+	}, {
+		params: map[string]string{"column_count": "1", "column_vindex": "lookup"},
+		err:    "multicol: vindex lookup of column 1 is not a unique functional vindex",
+	}, {
+		params: map[string]string{"column_count": "1", "column_vindex": "foo"},
+		err:    `multicol: vindexType "foo" not found`,
+	}}
+	for _, tcase := range testcases {
+		vindex, err := CreateVindex("multicol", "multicol", tcase.params)
+		if tcase.err != "" {
+			assert.EqualError(t, err, tcase.err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tcase.bytes, vindex.(*MultiCol).columnBytes)
+	}
+}
+
+func TestMultiColMap(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+	})
+	require.NoError(t, err)
+	mc := vindex.(MultiColumn)
+
+	// hash(1) = 166b40b44aba4bd6, hash(2) = 06e7ea22ce92708f
+	got, err := mc.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		sqltypes.NewInt64(1),
+	}, {
+		// Not a number.
+		sqltypes.NewVarBinary("abcd"), sqltypes.NewInt64(2),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyspaceID(hexBytes(t, "1606e7ea22ce9270")),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: hexBytes(t, "16"), End: hexBytes(t, "17")}},
+		key.DestinationNone{},
+	}, got)
+
+	_, err = mc.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3),
+	}})
+	assert.EqualError(t, err, "multicol: wrong number of column values were passed: maximum allowed is 2, got 3")
+}
+
+func TestMultiColVerify(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+		"column_bytes": "2,2",
+	})
+	require.NoError(t, err)
+	mc := vindex.(MultiColumn)
+
+	got, err := mc.Verify(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		sqltypes.NewInt64(2), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1),
+	}}, [][]byte{
+		hexBytes(t, "166b06e7"),
+		hexBytes(t, "166b06e7"),
+		hexBytes(t, "166b"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
+
+func TestPrefixKeyRange(t *testing.T) {
+	testcases := []struct {
+		prefix, start, end string
+	}{{
+		prefix: "16",
+		start:  "16",
+		end:    "17",
+	}, {
+		prefix: "16ff",
+		start:  "16ff",
+		end:    "17",
+	}, {
+		prefix: "ffff",
+		start:  "ffff",
+	}}
+	for _, tcase := range testcases {
+		kr := prefixKeyRange(hexBytes(t, tcase.prefix))
+		assert.Equal(t, tcase.start, hex.EncodeToString(kr.Start))
+		assert.Equal(t, tcase.end, hex.EncodeToString(kr.End))
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+var (
+	_ SingleColumn = (*RangeMap)(nil)
+	_ WantRangeMap = (*RangeMap)(nil)
+)
+
+func init() {
+	Register("range_map", NewRangeMap)
+}
+
+// RangeMap is a unique functional vindex that maps ranges of numeric
+// ids to keyspace ids. The ranges are stored in the topo as a named
+// vschemapb.RangeMap, and are delivered to vtgate with the SrvVSchema:
+// changing them moves the ids to other shards without rehashing
+// anything. Ids which are not in a range map to no keyspace id.
+type RangeMap struct {
+	name     string
+	rangeMap string
+	ranges   []idRange
+}
+
+// idRange is a parsed vschemapb.IdRange.
+type idRange struct {
+	start, end uint64
+	ksid       []byte
+}
+
+// NewRangeMap creates a RangeMap vindex.
+// The supplied map has an optional range_map param, which is the name of
+// the range map in the topo. It defaults to the name of the vindex.
+func NewRangeMap(name string, m map[string]string) (Vindex, error) {
+	rangeMap := m["range_map"]
+	if rangeMap == "" {
+		rangeMap = name
+	}
+	return &RangeMap{
+		name:     name,
+		rangeMap: rangeMap,
+	}, nil
+}
+
+// String returns the name of the vindex.
+func (vind *RangeMap) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*RangeMap) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (vind *RangeMap) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (vind *RangeMap) NeedsVCursor() bool {
+	return false
+}
+
+// RangeMapName satisfies WantRangeMap.
+func (vind *RangeMap) RangeMapName() string {
+	return vind.rangeMap
+}
+
+// SetRangeMap satisfies WantRangeMap.
+func (vind *RangeMap) SetRangeMap(rangeMap *vschemapb.RangeMap) error {
+	ranges, err := parseRangeMap(rangeMap)
+	if err != nil {
+		return err
+	}
+	vind.ranges = ranges
+	return nil
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *RangeMap) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		num, err := evalengine.ToUint64(id)
+		if err != nil {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		ksid := vind.lookup(num)
+		if ksid == nil {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		out = append(out, key.DestinationKeyspaceID(ksid))
+	}
+	return out, nil
+}
+
+// Verify returns true if ids and ksids match.
+func (vind *RangeMap) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		num, err := evalengine.ToUint64(ids[i])
+		if err != nil {
+			return nil, err
+		}
+		ksid := vind.lookup(num)
+		out[i] = ksid != nil && bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// lookup returns the keyspace id of the range of num, or nil if
+// num is not in a range.
+func (vind *RangeMap) lookup(num uint64) []byte {
+	// Find the last range which starts at or before num.
+	i := sort.Search(len(vind.ranges), func(i int) bool {
+		return vind.ranges[i].start > num
+	}) - 1
+	if i < 0 {
+		return nil
+	}
+	if r := vind.ranges[i]; r.end == 0 || num < r.end {
+		return r.ksid
+	}
+	return nil
+}
+
+// ValidateRangeMap returns an error if the range map is not valid:
+// a range is empty, ranges overlap or a keyspace id is not in hex form.
+func ValidateRangeMap(rangeMap *vschemapb.RangeMap) error {
+	_, err := parseRangeMap(rangeMap)
+	return err
+}
+
+// parseRangeMap parses the ranges of the range map, sorted by start.
+func parseRangeMap(rangeMap *vschemapb.RangeMap) ([]idRange, error) {
+	ranges := make([]idRange, 0, len(rangeMap.GetRanges()))
+	for _, r := range rangeMap.GetRanges() {
+		if r.End != 0 && r.End <= r.Start {
+			return nil, fmt.Errorf("range_map: empty range [%d, %d)", r.Start, r.End)
+		}
+		ksid, err := hex.DecodeString(r.KeyspaceId)
+		if err != nil || len(ksid) == 0 {
+			return nil, fmt.Errorf("range_map: invalid keyspace id %q for range starting at %d", r.KeyspaceId, r.Start)
+		}
+		ranges = append(ranges, idRange{start: r.Start, end: r.End, ksid: ksid})
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start < ranges[j].start
+	})
+	for i := 1; i < len(ranges); i++ {
+		prev := ranges[i-1]
+		if prev.end == 0 || prev.end > ranges[i].start {
+			return nil, fmt.Errorf("range_map: range starting at %d overlaps range starting at %d", ranges[i].start, prev.start)
+		}
+	}
+	return ranges, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+var testRangeMap = &vschemapb.RangeMap{
+	Ranges: []*vschemapb.IdRange{{
+		Start:      100,
+		End:        200,
+		KeyspaceId: "80",
+	}, {
+		Start:      1,
+		End:        100,
+		KeyspaceId: "40",
+	}, {
+		Start:      1000,
+		KeyspaceId: "c000",
+	}},
+}
+
+func createRangeMap(t *testing.T) SingleColumn {
+	t.Helper()
+	vindex, err := CreateVindex("range_map", "tenants", nil)
+	require.NoError(t, err)
+	require.NoError(t, vindex.(WantRangeMap).SetRangeMap(testRangeMap))
+	return vindex.(SingleColumn)
+}
+
+func TestRangeMapInfo(t *testing.T) {
+	rangeMap := createRangeMap(t)
+	assert.Equal(t, 1, rangeMap.Cost())
+	assert.Equal(t, "tenants", rangeMap.String())
+	assert.True(t, rangeMap.IsUnique())
+	assert.False(t, rangeMap.NeedsVCursor())
+	assert.Equal(t, "tenants", rangeMap.(WantRangeMap).RangeMapName())
+
+	vindex, err := CreateVindex("range_map", "tenants", map[string]string{"range_map": "other"})
+	require.NoError(t, err)
+	assert.Equal(t, "other", vindex.(WantRangeMap).RangeMapName())
+}
+
+func TestRangeMapMap(t *testing.T) {
+	rangeMap := createRangeMap(t)
+	got, err := rangeMap.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(0),
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(99),
+		sqltypes.NewInt64(100),
+		sqltypes.NewInt64(200),
+		sqltypes.NewInt64(1000),
+		sqltypes.NewUint64(1 << 63),
+		sqltypes.NewVarChar("abcd"),
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationNone{},
+		key.DestinationKeyspaceID([]byte{0x40}),
+		key.DestinationKeyspaceID([]byte{0x40}),
+		key.DestinationKeyspaceID([]byte{0x80}),
+		key.DestinationNone{},
+		key.DestinationKeyspaceID([]byte{0xc0, 0x00}),
+		key.DestinationKeyspaceID([]byte{0xc0, 0x00}),
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestRangeMapMapWithoutRanges(t *testing.T) {
+	vindex, err := CreateVindex("range_map", "tenants", nil)
+	require.NoError(t, err)
+	got, err := vindex.(SingleColumn).Map(nil, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationNone{}}, got)
+}
+
+func TestRangeMapVerify(t *testing.T) {
+	rangeMap := createRangeMap(t)
+	got, err := rangeMap.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(150), sqltypes.NewInt64(500)},
+		[][]byte{{0x40}, {0x40}, {0x40}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+
+	_, err = rangeMap.Verify(nil, []sqltypes.Value{sqltypes.NewVarChar("abcd")}, [][]byte{{0x40}})
+	require.EqualError(t, err, `could not parse value: 'abcd'`)
+}
+
+func TestValidateRangeMap(t *testing.T) {
+	testcases := []struct {
+		ranges []*vschemapb.IdRange
+		err    string
+	}{{
+		ranges: testRangeMap.Ranges,
+	}, {
+		ranges: []*vschemapb.IdRange{{Start: 10, End: 10, KeyspaceId: "80"}},
+		err:    "range_map: empty range [10, 10)",
+	}, {
+		ranges: []*vschemapb.IdRange{{Start: 10, End: 20, KeyspaceId: "8"}},
+		err:    `range_map: invalid keyspace id "8" for range starting at 10`,
+	}, {
+		ranges: []*vschemapb.IdRange{{Start: 10, End: 20}},
+		err:    `range_map: invalid keyspace id "" for range starting at 10`,
+	}, {
+		ranges: []*vschemapb.IdRange{
+			{Start: 10, End: 20, KeyspaceId: "80"},
+			{Start: 15, End: 30, KeyspaceId: "c0"},
+		},
+		err: "range_map: range starting at 15 overlaps range starting at 10",
+	}, {
+		ranges: []*vschemapb.IdRange{
+			{Start: 10, KeyspaceId: "80"},
+			{Start: 1000, End: 2000, KeyspaceId: "c0"},
+		},
+		err: "range_map: range starting at 1000 overlaps range starting at 10",
+	}}
+	for _, tcase := range testcases {
+		err := ValidateRangeMap(&vschemapb.RangeMap{Ranges: tcase.ranges})
+		if tcase.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, tcase.err)
+	}
+}
@@ -135,3 +135,8 @@ func (ge *RegionExperimental) Verify(vcursor VCursor, rowsColValues [][]sqltypes
 	}
 	return result, nil
 }
+
+// PartialVindex returns false because both columns are needed to compute the keyspace id.
+func (ge *RegionExperimental) PartialVindex() bool {
+	return false
+}
@@ -153,3 +153,8 @@ func (rv *RegionJSON) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value,
 func (rv *RegionJSON) NeedsVCursor() bool {
 	return false
 }
+
+// PartialVindex returns false because both columns are needed to compute the keyspace id.
+func (rv *RegionJSON) PartialVindex() bool {
+	return false
+}
@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -89,6 +90,9 @@ type MultiColumn interface {
 	Vindex
 	Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error)
 	Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
+	// PartialVindex returns true if the vindex can map rows that
+	// contain only the leading columns. Such rows map to a KeyRange.
+	PartialVindex() bool
 }
 
 // A Reversible vindex is one that can perform a
@@ -135,6 +139,14 @@ type WantOwnerInfo interface {
 	SetOwnerInfo(keyspace, table string, cols []sqlparser.ColIdent) error
 }
 
+// WantRangeMap defines the interface that a vindex must
+// satisfy to receive the range map it is backed by. The range
+// maps are stored in the topo and delivered with the SrvVSchema.
+type WantRangeMap interface {
+	RangeMapName() string
+	SetRangeMap(rangeMap *vschemapb.RangeMap) error
+}
+
 // A NewVindexFunc is a function that creates a Vindex based on the
 // properties specified in the input map. Every vindex must
 // register a NewVindexFunc under a unique vindexType.
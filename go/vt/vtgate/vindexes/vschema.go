@@ -171,6 +171,7 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema) {
 	}
 	buildKeyspaces(source, vschema)
 	resolveAutoIncrement(source, vschema)
+	resolveRangeMaps(source, vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
 	return vschema
@@ -374,6 +375,29 @@ func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	}
 }
 
+// resolveRangeMaps sets the range maps of the vindexes that want one.
+// If the range map of a vindex cannot be found or set, the keyspace
+// gets an error, which fails all its queries.
+func resolveRangeMaps(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	rangeMaps := source.GetRangeMaps().GetRangeMaps()
+	for _, ksvschema := range vschema.Keyspaces {
+		for vname, vindex := range ksvschema.Vindexes {
+			setter, ok := vindex.(WantRangeMap)
+			if !ok {
+				continue
+			}
+			rangeMap, ok := rangeMaps[setter.RangeMapName()]
+			if !ok {
+				ksvschema.Error = fmt.Errorf("cannot resolve range map %s of vindex %s", setter.RangeMapName(), vname)
+				continue
+			}
+			if err := setter.SetRangeMap(rangeMap); err != nil {
+				ksvschema.Error = fmt.Errorf("cannot set range map %s of vindex %s: %v", setter.RangeMapName(), vname, err)
+			}
+		}
+	}
+}
+
 // addDual adds dual as a valid table to all keyspaces.
 // For sharded keyspaces, it gets pinned against keyspace id '0x00'.
 func addDual(vschema *VSchema) {
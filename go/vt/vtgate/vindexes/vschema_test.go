@@ -1353,6 +1353,44 @@ func TestBuildVSchemaVindexNotFoundFail(t *testing.T) {
 	}
 }
 
+func TestBuildVSchemaRangeMaps(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"tenants": {
+						Type: "range_map",
+					},
+				},
+			},
+			"other": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"tenants": {
+						Type:   "range_map",
+						Params: map[string]string{"range_map": "noexist"},
+					},
+				},
+			},
+		},
+		RangeMaps: &vschemapb.RangeMaps{
+			RangeMaps: map[string]*vschemapb.RangeMap{
+				"tenants": {
+					Ranges: []*vschemapb.IdRange{{Start: 1, End: 100, KeyspaceId: "80"}},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&input)
+	require.NoError(t, got.Keyspaces["sharded"].Error)
+	dests, err := got.Keyspaces["sharded"].Vindexes["tenants"].(SingleColumn).Map(nil, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID([]byte{0x80})}, dests)
+
+	require.EqualError(t, got.Keyspaces["other"].Error, "cannot resolve range map noexist of vindex tenants")
+}
+
 func TestBuildVSchemaNoColumnVindexFail(t *testing.T) {
 	bad := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
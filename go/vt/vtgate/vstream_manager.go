@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,6 +29,8 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -92,6 +96,35 @@ type vstream struct {
 
 	eventCh           chan []*binlogdatapb.VEvent
 	heartbeatInterval uint32
+
+	// this flag is set by the client, default false
+	// if true the streams which have completed their copy phase wait for all the other streams to complete
+	// theirs before they stream any further events. Once the last stream completes its copy, a VGTID with the
+	// position of every shard at the end of its copy is sent, followed by a COPY_COMPLETED event.
+	// Each shard is copied from its own snapshot: this is a barrier, not a snapshot consistent across shards.
+	copyBarrier bool
+	// copyBarrierTimeout is the maximum amount of time a stream waits at the barrier
+	copyBarrierTimeout time.Duration
+
+	// this flag is set by the client, default false
+	// if true, it implies copyBarrier. Once all the streams have completed their copy phase, the current position of
+	// every shard is read: it is the stop position of its stream. The streams which reach their stop position wait
+	// for all the other streams to reach theirs, then a VGTID with all the stop positions is sent, followed by a
+	// COPY_COMPLETED event.
+	consistentSnapshot bool
+
+	// copyMu protects copyPending, stopPositions and snapshotPending
+	copyMu sync.Mutex
+	// the streams which have not completed their copy phase yet, keyed by streamId. streamId is of the form <keyspace>/<shard>
+	copyPending map[string]bool
+	// copyDone is closed once all the streams have completed their copy phase
+	copyDone chan struct{}
+	// the stop positions of the consistent snapshot, keyed by streamId. It is set before copyDone is closed
+	stopPositions map[string]mysql.Position
+	// the streams which have not reached their stop position yet, keyed by streamId
+	snapshotPending map[string]bool
+	// snapshotDone is closed once all the streams have reached their stop position
+	snapshotDone chan struct{}
 }
 
 type journalEvent struct {
@@ -127,6 +160,12 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		vsm:                vsm,
 		eventCh:            make(chan []*binlogdatapb.VEvent),
 		heartbeatInterval:  flags.GetHeartbeatInterval(),
+		copyBarrier:        flags.GetCopyBarrier() || flags.GetConsistentSnapshot(),
+		copyBarrierTimeout: *vstreamCopyBarrierTimeout,
+		consistentSnapshot: flags.GetConsistentSnapshot(),
+		copyPending:        make(map[string]bool),
+		copyDone:           make(chan struct{}),
+		snapshotDone:       make(chan struct{}),
 	}
 	return vs.stream(ctx)
 }
@@ -150,9 +189,7 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vgtid must have at least one value with a starting position")
 	}
 	// To fetch from all keyspaces, the input must contain a single ShardGtid
-	// that has an empty keyspace, and the Gtid must be "current". In the
-	// future, we'll allow the Gtid to be empty which will also support
-	// copying of existing data.
+	// that has an empty keyspace, and the Gtid must be "current".
 	if len(vgtid.ShardGtids) == 1 && vgtid.ShardGtids[0].Keyspace == "" {
 		if vgtid.ShardGtids[0].Gtid != "current" {
 			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "for an empty keyspace, the Gtid value must be 'current': %v", vgtid)
@@ -170,11 +207,16 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 		}
 		vgtid = newvgtid
 	}
+	copyBarrier := flags.CopyBarrier || flags.ConsistentSnapshot
 	newvgtid := &binlogdatapb.VGtid{}
 	for _, sgtid := range vgtid.ShardGtids {
+		if copyBarrier && sgtid.Gtid == "current" {
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a copy barrier requires the tables to be copied, the Gtid value cannot be 'current': %v", vgtid)
+		}
 		if sgtid.Shard == "" {
-			if sgtid.Gtid != "current" {
-				return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "if shards are unspecified, the Gtid value must be 'current': %v", vgtid)
+			// An empty Gtid copies the tables of all the shards before streaming their changes.
+			if sgtid.Gtid != "current" && sgtid.Gtid != "" {
+				return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "if shards are unspecified, the Gtid value must be 'current' or empty: %v", vgtid)
 			}
 			// TODO(sougou): this should work with the new Migrate workflow
 			_, _, allShards, err := vsm.resolver.GetKeyspaceShards(ctx, sgtid.Keyspace, tabletType)
@@ -195,6 +237,11 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 
 	//TODO add tablepk validations
 
+	if copyBarrier {
+		// The barrier needs the vstreamers to report the progress of their copy.
+		filter = proto.Clone(filter).(*binlogdatapb.Filter)
+		filter.CopyProgress = true
+	}
 	return newvgtid, filter, flags, nil
 }
 
@@ -213,6 +260,16 @@ func (vs *vstream) stream(ctx context.Context) error {
 
 	// Make a copy first, because the ShardGtids list can change once streaming starts.
 	copylist := append(([]*binlogdatapb.ShardGtid)(nil), vs.vgtid.ShardGtids...)
+	if vs.copyBarrier {
+		for _, sgtid := range copylist {
+			if startsWithCopy(sgtid) {
+				vs.copyPending[streamID(sgtid)] = true
+			}
+		}
+	}
+	if len(vs.copyPending) == 0 {
+		close(vs.copyDone)
+	}
 	for _, sgtid := range copylist {
 		vs.startOneStream(ctx, sgtid)
 	}
@@ -383,6 +440,278 @@ func (vs *vstream) alignStreams(ctx context.Context, event *binlogdatapb.VEvent,
 	}
 }
 
+// streamID returns the streamId of a shard, of the form <keyspace>/<shard>.
+func streamID(sgtid *binlogdatapb.ShardGtid) string {
+	return fmt.Sprintf("%s/%s", sgtid.Keyspace, sgtid.Shard)
+}
+
+// startsWithCopy returns true if a stream starts with a copy phase: if it has no position,
+// or if tables are left to copy.
+func startsWithCopy(sgtid *binlogdatapb.ShardGtid) bool {
+	if sgtid.Gtid == "" {
+		return true
+	}
+	for _, tablePK := range sgtid.TablePKs {
+		if !tablePK.Completed {
+			return true
+		}
+	}
+	return false
+}
+
+// copyCompleted is called by each stream once its copy phase is complete. The last stream to complete its copy
+// sends the VGTID with the position of every shard at the end of its copy, followed by a COPY_COMPLETED event,
+// and releases the streams waiting at the barrier. If a consistent snapshot was requested, it reads the stop
+// positions instead, and the VGTID is sent once all the streams have reached them.
+func (vs *vstream) copyCompleted(ctx context.Context, sgtid *binlogdatapb.ShardGtid) error {
+	vs.copyMu.Lock()
+	if !vs.copyPending[streamID(sgtid)] {
+		vs.copyMu.Unlock()
+		return nil
+	}
+	delete(vs.copyPending, streamID(sgtid))
+	last := len(vs.copyPending) == 0
+	vs.copyMu.Unlock()
+
+	if !last {
+		return nil
+	}
+	if vs.consistentSnapshot {
+		if err := vs.readStopPositions(ctx); err != nil {
+			// The stream must not be retried: the copy barrier would never be released.
+			return vterrors.Errorf(vtrpcpb.Code_ABORTED, "vstream failed to read the stop positions of the consistent snapshot: %v", err)
+		}
+	} else if err := vs.sendCopyCompleted(ctx); err != nil {
+		return err
+	}
+	close(vs.copyDone)
+	return nil
+}
+
+// readStopPositions reads the current position of every shard, and makes it the stop position of its stream.
+// The positions are read concurrently, to be as close as possible to a single point in time. A shard can be
+// read from a tablet which is behind the one it's streamed from: the positions are then read again, until
+// every stop position is at least the position of its stream.
+func (vs *vstream) readStopPositions(ctx context.Context) error {
+	vs.mu.Lock()
+	sgtids := make([]*binlogdatapb.ShardGtid, 0, len(vs.vgtid.ShardGtids))
+	for _, sgtid := range vs.vgtid.ShardGtids {
+		sgtids = append(sgtids, proto.Clone(sgtid).(*binlogdatapb.ShardGtid))
+	}
+	vs.mu.Unlock()
+
+	deadline := time.Now().Add(vs.copyBarrierTimeout)
+	for {
+		positions, err := vs.readPositions(ctx, sgtids)
+		if err != nil {
+			return err
+		}
+		var behind *binlogdatapb.ShardGtid
+		for _, sgtid := range sgtids {
+			pos, err := mysql.DecodePosition(sgtid.Gtid)
+			if err != nil {
+				return err
+			}
+			if !positions[streamID(sgtid)].AtLeast(pos) {
+				behind = sgtid
+				break
+			}
+		}
+		if behind == nil {
+			vs.copyMu.Lock()
+			defer vs.copyMu.Unlock()
+			vs.stopPositions = positions
+			vs.snapshotPending = make(map[string]bool)
+			for id := range positions {
+				vs.snapshotPending[id] = true
+			}
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "vstream timed out after %v waiting for the position of %s/%s to reach %s", vs.copyBarrierTimeout, behind.Keyspace, behind.Shard, behind.Gtid)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// readPositions reads the current position of the shards concurrently, keyed by streamId.
+func (vs *vstream) readPositions(ctx context.Context, sgtids []*binlogdatapb.ShardGtid) (map[string]mysql.Position, error) {
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		allErrors concurrency.AllErrorRecorder
+	)
+	positions := make(map[string]mysql.Position)
+	for _, sgtid := range sgtids {
+		wg.Add(1)
+		go func(sgtid *binlogdatapb.ShardGtid) {
+			defer wg.Done()
+			pos, err := vs.readPosition(ctx, sgtid)
+			if err != nil {
+				allErrors.RecordError(err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			positions[streamID(sgtid)] = pos
+		}(sgtid)
+	}
+	wg.Wait()
+	return positions, allErrors.AggrError(vterrors.Aggregate)
+}
+
+// readPosition reads the current position of a shard, in the flavor of the position of its stream.
+func (vs *vstream) readPosition(ctx context.Context, sgtid *binlogdatapb.ShardGtid) (mysql.Position, error) {
+	var query string
+	flavor := strings.SplitN(sgtid.Gtid, "/", 2)[0]
+	switch flavor {
+	case mysql.Mysql56FlavorID:
+		query = "select @@global.gtid_executed"
+	case mysql.MariadbFlavorID:
+		query = "select @@global.gtid_binlog_pos"
+	default:
+		return mysql.Position{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a consistent snapshot requires GTID positions, the position of %s/%s is %s", sgtid.Keyspace, sgtid.Shard, sgtid.Gtid)
+	}
+	rss, err := vs.resolveShard(ctx, sgtid)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	qr, err := rss[0].Gateway.Execute(ctx, rss[0].Target, query, nil, 0, 0, nil)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return mysql.Position{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s on %s/%s: %v", query, sgtid.Keyspace, sgtid.Shard, qr.Rows)
+	}
+	return mysql.ParsePosition(flavor, qr.Rows[0][0].ToString())
+}
+
+// sendCopyCompleted sends the current VGTID followed by a COPY_COMPLETED event for the whole stream.
+func (vs *vstream) sendCopyCompleted(ctx context.Context) error {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+
+	events := []*binlogdatapb.VEvent{{
+		Type:  binlogdatapb.VEventType_VGTID,
+		Vgtid: proto.Clone(vs.vgtid).(*binlogdatapb.VGtid),
+	}, {
+		Type: binlogdatapb.VEventType_COPY_COMPLETED,
+	}}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case vs.eventCh <- events:
+	}
+	return nil
+}
+
+// waitForCopyBarrier blocks a stream which has completed its copy phase until all the other streams have completed
+// theirs, if a copy barrier was requested. It fails if they don't within copyBarrierTimeout.
+func (vs *vstream) waitForCopyBarrier(ctx context.Context, sgtid *binlogdatapb.ShardGtid) error {
+	if !vs.copyBarrier {
+		return nil
+	}
+	vs.copyMu.Lock()
+	pending := vs.copyPending[streamID(sgtid)]
+	vs.copyMu.Unlock()
+	if pending {
+		return nil
+	}
+	timer := time.NewTimer(vs.copyBarrierTimeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-vs.copyDone:
+	case <-timer.C:
+		vs.copyMu.Lock()
+		defer vs.copyMu.Unlock()
+		var pending []string
+		for id := range vs.copyPending {
+			pending = append(pending, id)
+		}
+		sort.Strings(pending)
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "vstream for %s/%s timed out after %v waiting for the copy of %v", sgtid.Keyspace, sgtid.Shard, vs.copyBarrierTimeout, pending)
+	}
+	return nil
+}
+
+// waitForSnapshot holds a stream which has reached its stop position until all the other streams have reached
+// theirs, if a consistent snapshot was requested. The last stream to reach its stop position sends the VGTID with
+// all the stop positions, followed by a COPY_COMPLETED event, and releases the other streams. It fails if they
+// don't within copyBarrierTimeout.
+func (vs *vstream) waitForSnapshot(ctx context.Context, sgtid *binlogdatapb.ShardGtid) error {
+	if !vs.consistentSnapshot {
+		return nil
+	}
+	select {
+	case <-vs.snapshotDone:
+		return nil
+	default:
+	}
+	id := streamID(sgtid)
+	vs.copyMu.Lock()
+	stop, ok := vs.stopPositions[id]
+	pending := vs.snapshotPending[id]
+	vs.copyMu.Unlock()
+	if !ok {
+		// The copy phase is not complete, or the stream is not part of the snapshot.
+		return nil
+	}
+	if pending {
+		vs.mu.Lock()
+		gtid := sgtid.Gtid
+		vs.mu.Unlock()
+		pos, err := mysql.DecodePosition(gtid)
+		if err != nil {
+			return err
+		}
+		if !pos.AtLeast(stop) {
+			return nil
+		}
+		vs.copyMu.Lock()
+		delete(vs.snapshotPending, id)
+		last := len(vs.snapshotPending) == 0
+		vs.copyMu.Unlock()
+		if last {
+			if err := vs.sendCopyCompleted(ctx); err != nil {
+				return err
+			}
+			close(vs.snapshotDone)
+			return nil
+		}
+	}
+	timer := time.NewTimer(vs.copyBarrierTimeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-vs.snapshotDone:
+	case <-timer.C:
+		vs.copyMu.Lock()
+		defer vs.copyMu.Unlock()
+		var pending []string
+		for id := range vs.snapshotPending {
+			pending = append(pending, id)
+		}
+		sort.Strings(pending)
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "vstream for %s/%s timed out after %v waiting for %v to reach their stop position", sgtid.Keyspace, sgtid.Shard, vs.copyBarrierTimeout, pending)
+	}
+	return nil
+}
+
+// resolveShard resolves the shard of a stream.
+func (vs *vstream) resolveShard(ctx context.Context, sgtid *binlogdatapb.ShardGtid) ([]*srvtopo.ResolvedShard, error) {
+	if vs.resolver == nil {
+		return vs.rss, nil
+	}
+	return vs.resolver.ResolveDestination(ctx, sgtid.Keyspace, vs.tabletType, key.DestinationShard(sgtid.Shard))
+}
+
 // streamFromTablet streams from one shard. If transactions come in separate chunks, they are grouped and sent.
 func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.ShardGtid) error {
 	// journalDone is assigned a channel when a journal event is encountered.
@@ -402,14 +731,17 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 		default:
 		}
 
+		if err := vs.waitForCopyBarrier(ctx, sgtid); err != nil {
+			return err
+		}
+		if err := vs.waitForSnapshot(ctx, sgtid); err != nil {
+			return err
+		}
+
 		var eventss [][]*binlogdatapb.VEvent
-		var err error
-		rss := vs.rss
-		if vs.resolver != nil {
-			rss, err = vs.resolver.ResolveDestination(ctx, sgtid.Keyspace, vs.tabletType, key.DestinationShard(sgtid.Shard))
-			if err != nil {
-				return err
-			}
+		rss, err := vs.resolveShard(ctx, sgtid)
+		if err != nil {
+			return err
 		}
 		if len(rss) != 1 {
 			// Unreachable.
@@ -459,6 +791,32 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 					}
 					eventss = nil
 					sendevents = nil
+
+					if err := vs.waitForSnapshot(ctx, sgtid); err != nil {
+						return err
+					}
+				case binlogdatapb.VEventType_COPY_COMPLETED:
+					// Tag the event with the shard whose copy is complete, and send it.
+					ev := proto.Clone(event).(*binlogdatapb.VEvent)
+					ev.Keyspace = sgtid.Keyspace
+					ev.Shard = sgtid.Shard
+					sendevents = append(sendevents, ev)
+					eventss = append(eventss, sendevents)
+					if err := vs.sendAll(sgtid, eventss); err != nil {
+						return err
+					}
+					eventss = nil
+					sendevents = nil
+
+					if err := vs.copyCompleted(ctx, sgtid); err != nil {
+						return err
+					}
+					if err := vs.waitForCopyBarrier(ctx, sgtid); err != nil {
+						return err
+					}
+					if err := vs.waitForSnapshot(ctx, sgtid); err != nil {
+						return err
+					}
 				case binlogdatapb.VEventType_HEARTBEAT:
 					// Remove all heartbeat events for now.
 					// Otherwise they can accumulate indefinitely if there are no real events.
@@ -535,6 +893,9 @@ func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdat
 					Type:  binlogdatapb.VEventType_VGTID,
 					Vgtid: proto.Clone(vs.vgtid).(*binlogdatapb.VGtid),
 				}
+			} else if event.Type == binlogdatapb.VEventType_COPY_COMPLETED {
+				// The copy of the shard is done: it has no more tables to copy.
+				sgtid.TablePKs = nil
 			} else if event.Type == binlogdatapb.VEventType_LASTPK {
 				var foundIndex = -1
 				eventTablePK := event.LastPKEvent.TableLastPK
@@ -544,12 +905,17 @@ func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdat
 						break
 					}
 				}
+				if vs.copyBarrier && event.LastPKEvent.Completed {
+					// Keep the completed tables: a stream restarted mid-copy must know the copy state of every table.
+					eventTablePK = proto.Clone(eventTablePK).(*binlogdatapb.TableLastPK)
+					eventTablePK.Completed = true
+				}
 				if foundIndex == -1 {
-					if !event.LastPKEvent.Completed {
+					if !event.LastPKEvent.Completed || vs.copyBarrier {
 						sgtid.TablePKs = append(sgtid.TablePKs, eventTablePK)
 					}
 				} else {
-					if event.LastPKEvent.Completed {
+					if event.LastPKEvent.Completed && !vs.copyBarrier {
 						// remove tablepk from sgtid
 						sgtid.TablePKs[foundIndex] = sgtid.TablePKs[len(sgtid.TablePKs)-1]
 						sgtid.TablePKs[len(sgtid.TablePKs)-1] = nil
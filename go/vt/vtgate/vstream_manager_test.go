@@ -27,6 +27,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
@@ -870,9 +871,10 @@ func TestResolveVStreamParams(t *testing.T) {
 		input: &binlogdatapb.VGtid{
 			ShardGtids: []*binlogdatapb.ShardGtid{{
 				Keyspace: "TestVStream",
+				Gtid:     "other",
 			}},
 		},
-		err: "if shards are unspecified, the Gtid value must be 'current'",
+		err: "if shards are unspecified, the Gtid value must be 'current' or empty",
 	}, {
 		input: &binlogdatapb.VGtid{
 			ShardGtids: []*binlogdatapb.ShardGtid{{
@@ -991,6 +993,307 @@ func TestResolveVStreamParams(t *testing.T) {
 		})
 	}
 
+	// An empty Gtid copies all the shards.
+	vgtid, _, _, err = vsm.resolveParams(context.Background(), topodatapb.TabletType_REPLICA, &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "TestVStream",
+		}},
+	}, nil, &vtgatepb.VStreamFlags{CopyBarrier: true})
+	require.NoError(t, err)
+	require.Len(t, vgtid.ShardGtids, 8)
+	for _, sgtid := range vgtid.ShardGtids {
+		assert.Equal(t, "", sgtid.Gtid)
+	}
+
+	_, _, _, err = vsm.resolveParams(context.Background(), topodatapb.TabletType_REPLICA, &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "TestVStream",
+			Gtid:     "current",
+		}},
+	}, nil, &vtgatepb.VStreamFlags{CopyBarrier: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a copy barrier requires the tables to be copied, the Gtid value cannot be 'current'")
+
+	_, _, _, err = vsm.resolveParams(context.Background(), topodatapb.TabletType_REPLICA, &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "TestVStream",
+			Gtid:     "current",
+		}},
+	}, nil, &vtgatepb.VStreamFlags{ConsistentSnapshot: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a copy barrier requires the tables to be copied, the Gtid value cannot be 'current'")
+
+	// The barrier makes the vstreamers report their copy progress, without changing the filter of the caller.
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1"}}}
+	_, filter2, _, err := vsm.resolveParams(context.Background(), topodatapb.TabletType_REPLICA, &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "TestVStream",
+		}},
+	}, filter, &vtgatepb.VStreamFlags{CopyBarrier: true})
+	require.NoError(t, err)
+	assert.True(t, filter2.CopyProgress)
+	assert.False(t, filter.CopyProgress)
+}
+
+func TestVStreamCopyBarrier(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1.1.1.1", 1002, name, "20-40", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc0, sbc1} {
+		shard := sbc.Tablet().Shard
+		sbc.AddVStreamEvents([]*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: "copy" + shard},
+			{Type: binlogdatapb.VEventType_LASTPK, LastPKEvent: &binlogdatapb.LastPKEvent{
+				TableLastPK: &binlogdatapb.TableLastPK{TableName: "t1"},
+				Completed:   true,
+			}},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}, nil)
+		sbc.AddVStreamEvents([]*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_COPY_COMPLETED},
+		}, nil)
+		sbc.AddVStreamEvents([]*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: "replicate" + shard},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}, nil)
+	}
+
+	// The second shard resumes a copy from a checkpoint.
+	tablePKs := []*binlogdatapb.TableLastPK{{TableName: "t1"}}
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+		}, {
+			Keyspace: name,
+			Shard:    "20-40",
+			Gtid:     "pos",
+			TablePKs: tablePKs,
+		}},
+	}
+	ch := startVStream(ctx, t, vsm, vgtid, &vtgatepb.VStreamFlags{CopyBarrier: true})
+
+	shardsCopied := 0
+	snapshotSeen := false
+	completedSeen := false
+	for i := 0; i < 7; i++ {
+		response := <-ch
+		for _, ev := range response.Events {
+			switch ev.Type {
+			case binlogdatapb.VEventType_COPY_COMPLETED:
+				if ev.Shard != "" {
+					require.False(t, snapshotSeen, "COPY_COMPLETED of shard %s sent after the snapshot", ev.Shard)
+					assert.Equal(t, name, ev.Keyspace)
+					shardsCopied++
+					continue
+				}
+				require.Equal(t, 2, shardsCopied, "snapshot sent before all the shards were copied")
+				snapshotSeen = true
+			case binlogdatapb.VEventType_VGTID:
+				for _, sgtid := range ev.Vgtid.ShardGtids {
+					if strings.HasPrefix(sgtid.Gtid, "replicate") {
+						require.True(t, snapshotSeen, "replicated event sent before the snapshot: %v", ev.Vgtid)
+					}
+					// The tables whose copy is done are kept until the copy of the shard is done.
+					if sgtid.Shard == "20-40" && len(sgtid.TablePKs) == 1 && sgtid.TablePKs[0].Completed {
+						completedSeen = true
+					}
+				}
+			}
+		}
+		if len(response.Events) == 2 && response.Events[1].Type == binlogdatapb.VEventType_COPY_COMPLETED && response.Events[1].Shard == "" {
+			want := &binlogdatapb.VGtid{
+				ShardGtids: []*binlogdatapb.ShardGtid{{
+					Keyspace: name,
+					Shard:    "-20",
+					Gtid:     "copy-20",
+				}, {
+					Keyspace: name,
+					Shard:    "20-40",
+					Gtid:     "copy20-40",
+				}},
+			}
+			assert.True(t, proto.Equal(want, response.Events[0].Vgtid), "snapshot VGtid: %v, want %v", response.Events[0].Vgtid, want)
+		}
+	}
+	assert.True(t, snapshotSeen)
+	assert.True(t, completedSeen)
+}
+
+func TestVStreamCopyBarrierTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer func(timeout time.Duration) {
+		*vstreamCopyBarrierTimeout = timeout
+	}(*vstreamCopyBarrierTimeout)
+	*vstreamCopyBarrierTimeout = 100 * time.Millisecond
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	_ = hc.AddTestTablet("aa", "1.1.1.1", 1002, name, "20-40", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	// The second shard never completes its copy.
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "copy"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_COPY_COMPLETED},
+	}, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+		}, {
+			Keyspace: name,
+			Shard:    "20-40",
+		}},
+	}
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{CopyBarrier: true}, func(events []*binlogdatapb.VEvent) error {
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "waiting for the copy of [TestVStream/20-40]")
+}
+
+func TestVStreamConsistentSnapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1.1.1.1", 1002, name, "20-40", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	const (
+		uuid0 = "00000000-0000-0000-0000-000000000001"
+		uuid1 = "00000000-0000-0000-0000-000000000002"
+	)
+	gtid := func(uuid string, last int) string {
+		return fmt.Sprintf("MySQL56/%s:1-%d", uuid, last)
+	}
+	position := func(uuid string, last int) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@global.gtid_executed", "varchar"), fmt.Sprintf("%s:1-%d", uuid, last))
+	}
+	addCopy := func(sbc *sandboxconn.SandboxConn, pos string) {
+		sbc.AddVStreamEvents([]*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: pos},
+			{Type: binlogdatapb.VEventType_LASTPK, LastPKEvent: &binlogdatapb.LastPKEvent{
+				TableLastPK: &binlogdatapb.TableLastPK{TableName: "t1"},
+				Completed:   true,
+			}},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}, nil)
+		sbc.AddVStreamEvents([]*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_COPY_COMPLETED},
+		}, nil)
+	}
+	addCommit := func(sbc *sandboxconn.SandboxConn, pos string) {
+		sbc.AddVStreamEvents([]*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: pos},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}, nil)
+	}
+
+	// The first shard is copied at 10 and must catch up with its stop position, 12.
+	addCopy(sbc0, gtid(uuid0, 10))
+	addCommit(sbc0, gtid(uuid0, 11))
+	addCommit(sbc0, gtid(uuid0, 12))
+	addCommit(sbc0, gtid(uuid0, 13))
+	sbc0.SetResults([]*sqltypes.Result{position(uuid0, 12)})
+	// The second shard is at its stop position as soon as its copy is complete.
+	addCopy(sbc1, gtid(uuid1, 5))
+	addCommit(sbc1, gtid(uuid1, 6))
+	sbc1.SetResults([]*sqltypes.Result{position(uuid1, 5)})
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+		}, {
+			Keyspace: name,
+			Shard:    "20-40",
+		}},
+	}
+	ch := startVStream(ctx, t, vsm, vgtid, &vtgatepb.VStreamFlags{ConsistentSnapshot: true})
+
+	past := map[string]bool{gtid(uuid0, 13): true, gtid(uuid1, 6): true}
+	var snapshot *binlogdatapb.VGtid
+	pastSeen := 0
+	for pastSeen < 2 {
+		var response *binlogdatapb.VStreamResponse
+		select {
+		case response = <-ch:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the events past the snapshot, snapshot: %v", snapshot)
+		}
+		for i, ev := range response.Events {
+			switch ev.Type {
+			case binlogdatapb.VEventType_COPY_COMPLETED:
+				if ev.Shard == "" {
+					require.Nil(t, snapshot, "snapshot sent twice")
+					snapshot = response.Events[i-1].Vgtid
+				}
+			case binlogdatapb.VEventType_VGTID:
+				for _, sgtid := range ev.Vgtid.ShardGtids {
+					if past[sgtid.Gtid] {
+						require.NotNil(t, snapshot, "event past the stop position sent before the snapshot: %v", ev.Vgtid)
+						delete(past, sgtid.Gtid)
+						pastSeen++
+					}
+				}
+			}
+		}
+	}
+	require.Len(t, snapshot.ShardGtids, 2)
+	assert.Equal(t, gtid(uuid0, 12), snapshot.ShardGtids[0].Gtid)
+	assert.Equal(t, gtid(uuid1, 5), snapshot.ShardGtids[1].Gtid)
+}
+
+func TestVStreamConsistentSnapshotFlavor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "FilePos/binlog.000001:4"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_COPY_COMPLETED},
+	}, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+		}},
+	}
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{ConsistentSnapshot: true}, func(events []*binlogdatapb.VEvent) error {
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_ABORTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "a consistent snapshot requires GTID positions, the position of TestVStream/-20 is FilePos/binlog.000001:4")
 }
 
 func TestVStreamIdleHeartbeat(t *testing.T) {
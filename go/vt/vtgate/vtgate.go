@@ -62,9 +62,12 @@ var (
 	queryPlanCacheSize   = flag.Int64("gate_query_cache_size", cache.DefaultConfig.MaxEntries, "gate server query cache size, maximum number of queries to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a cache. This config controls the expected amount of unique entries in the cache.")
 	queryPlanCacheMemory = flag.Int64("gate_query_cache_memory", cache.DefaultConfig.MaxMemoryUsage, "gate server query cache size in bytes, maximum amount of memory to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	queryPlanCacheLFU    = flag.Bool("gate_query_cache_lfu", cache.DefaultConfig.LFU, "gate server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
+	resultCacheMemory    = flag.Int64("gate_result_cache_memory", 0, "gate server result cache size in bytes. When it and gate_result_cache_ttl are non-zero, the results of the selects sent to replica and rdonly tablets outside of transactions are cached for each caller, and evicted as soon as the vstream of their keyspace shows a change on one of the tables they were read from.")
+	resultCacheTTL       = flag.Duration("gate_result_cache_ttl", 0, "the maximum amount of time a result stays in the gate server result cache. The vstream which evicts the results can read from other tablets than the queries, so this also bounds how stale a cached result can be.")
 	_                    = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows        = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
 	warnMemoryRows       = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+	hashJoinMaxMemory    = flag.Int64("hash_join_max_memory_bytes", 64*1024*1024, "Maximum number of bytes a hash join can use for the rows it keeps in memory.")
 	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
 
@@ -92,6 +95,8 @@ var (
 	enableDirectDDL = flag.Bool("enable_direct_ddl", true, "Allow users to submit direct DDL statements")
 
 	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker")
+
+	vstreamCopyBarrierTimeout = flag.Duration("vstream_copy_barrier_timeout", time.Hour, "the maximum amount of time a VStream with the copy_barrier or consistent_snapshot flag holds back a shard which has completed its copy phase or reached its stop position, waiting for the other shards to do the same. The VStream fails once it expires.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -214,6 +219,9 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	}
 
 	executor := NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *warnShardedOnly, *streamBufferSize, cacheCfg, si)
+	if *resultCacheMemory > 0 && *resultCacheTTL > 0 {
+		executor.resultCache = newResultCache(vsm, *resultCacheMemory, *resultCacheTTL)
+	}
 
 	// connect the schema tracker with the vschema manager
 	if *enableSchemaChangeSignal {
@@ -270,6 +278,9 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 		if st != nil && *enableSchemaChangeSignal {
 			st.Stop()
 		}
+		if executor.resultCache != nil {
+			executor.resultCache.Close()
+		}
 	})
 	rpcVTGate.registerDebugHealthHandler()
 	rpcVTGate.registerDebugEnvHandler()
@@ -463,6 +474,41 @@ func (vtg *VTGate) ResolveTransaction(ctx context.Context, dtid string) error {
 	return formatError(vtg.txConn.Resolve(ctx, dtid))
 }
 
+// MessageAck acks the specified messages of a message table, for the
+// consumer group if it's not empty.
+func (vtg *VTGate) MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	statsKey := []string{"MessageAck", keyspace, topoproto.TabletTypeLString(topodatapb.TabletType_MASTER)}
+	defer vtg.timings.Record(statsKey, time.Now())
+
+	count, err := vtg.executor.MessageAck(ctx, keyspace, name, consumerGroup, ids)
+	if err != nil {
+		query := map[string]interface{}{
+			"Keyspace":      keyspace,
+			"Name":          name,
+			"ConsumerGroup": consumerGroup,
+		}
+		return 0, recordAndAnnotateError(err, statsKey, query, vtg.logExecute)
+	}
+	return count, nil
+}
+
+// MessageNack sends the specified messages of a message table back for
+// immediate redelivery.
+func (vtg *VTGate) MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error) {
+	statsKey := []string{"MessageNack", keyspace, topoproto.TabletTypeLString(topodatapb.TabletType_MASTER)}
+	defer vtg.timings.Record(statsKey, time.Now())
+
+	count, err := vtg.executor.MessageNack(ctx, keyspace, name, ids)
+	if err != nil {
+		query := map[string]interface{}{
+			"Keyspace": keyspace,
+			"Name":     name,
+		}
+		return 0, recordAndAnnotateError(err, statsKey, query, vtg.logExecute)
+	}
+	return count, nil
+}
+
 // Prepare supports non-streaming prepare statement query with multi shards
 func (vtg *VTGate) Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (newSession *vtgatepb.Session, fld []*querypb.Field, err error) {
 	// In this context, we don't care if we can't fully parse destination
@@ -60,6 +60,19 @@ func (conn *VTGateConn) ResolveTransaction(ctx context.Context, dtid string) err
 	return conn.impl.ResolveTransaction(ctx, dtid)
 }
 
+// MessageAck acks the specified messages of a message table, for the
+// consumer group if it's not empty. It returns the number of messages
+// acked.
+func (conn *VTGateConn) MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error) {
+	return conn.impl.MessageAck(ctx, keyspace, name, consumerGroup, ids)
+}
+
+// MessageNack sends the specified messages of a message table back
+// for immediate redelivery. It returns the number of messages nacked.
+func (conn *VTGateConn) MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error) {
+	return conn.impl.MessageNack(ctx, keyspace, name, ids)
+}
+
 // Close must be called for releasing resources.
 func (conn *VTGateConn) Close() {
 	conn.impl.Close()
@@ -147,6 +160,12 @@ type Impl interface {
 	// ResolveTransaction resolves the specified 2pc transaction.
 	ResolveTransaction(ctx context.Context, dtid string) error
 
+	// MessageAck acks messages, for a consumer group if it's not empty.
+	MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error)
+
+	// MessageNack sends messages back for immediate redelivery.
+	MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error)
+
 	// VStream streams binlogevents
 	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (VStreamReader, error)
 
@@ -47,6 +47,10 @@ type VTGateService interface {
 	// 2PC support
 	ResolveTransaction(ctx context.Context, dtid string) error
 
+	// Messaging support
+	MessageAck(ctx context.Context, keyspace string, name string, consumerGroup string, ids []*querypb.Value) (int64, error)
+	MessageNack(ctx context.Context, keyspace string, name string, ids []*querypb.Value) (int64, error)
+
 	// Update Stream methods
 	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error
 
@@ -307,7 +307,7 @@ func (q *query) MessageStream(request *querypb.MessageStreamRequest, stream quer
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	err = q.server.MessageStream(ctx, request.Target, request.Name, func(qr *sqltypes.Result) error {
+	err = q.server.MessageStream(ctx, request.Target, request.Name, request.ConsumerGroup, func(qr *sqltypes.Result) error {
 		return stream.Send(&querypb.MessageStreamResponse{
 			Result: sqltypes.ResultToProto3(qr),
 		})
@@ -322,7 +322,7 @@ func (q *query) MessageAck(ctx context.Context, request *querypb.MessageAckReque
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	count, err := q.server.MessageAck(ctx, request.Target, request.Name, request.Ids)
+	count, err := q.server.MessageAck(ctx, request.Target, request.Name, request.ConsumerGroup, request.Ids)
 	if err != nil {
 		return nil, vterrors.ToGRPC(err)
 	}
@@ -333,6 +333,24 @@ func (q *query) MessageAck(ctx context.Context, request *querypb.MessageAckReque
 	}, nil
 }
 
+// MessageNack is part of the queryservice.QueryServer interface
+func (q *query) MessageNack(ctx context.Context, request *querypb.MessageNackRequest) (response *querypb.MessageNackResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	count, err := q.server.MessageNack(ctx, request.Target, request.Name, request.Ids)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &querypb.MessageNackResponse{
+		Result: &querypb.QueryResult{
+			RowsAffected: uint64(count),
+		},
+	}, nil
+}
+
 // StreamHealth is part of the queryservice.QueryServer interface
 func (q *query) StreamHealth(request *querypb.StreamHealthRequest, stream queryservicepb.Query_StreamHealthServer) (err error) {
 	defer q.server.HandlePanic(&err)
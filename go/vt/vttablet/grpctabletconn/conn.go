@@ -502,7 +502,7 @@ func (conn *gRPCQueryClient) BeginExecuteBatch(ctx context.Context, target *quer
 }
 
 // MessageStream streams messages.
-func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name, consumerGroup string, callback func(*sqltypes.Result) error) error {
 	// Please see comments in StreamExecute to see how this works.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -519,6 +519,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 			EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
 			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 			Name:              name,
+			ConsumerGroup:     consumerGroup,
 		}
 		stream, err := conn.c.MessageStream(ctx, req)
 		if err != nil {
@@ -548,7 +549,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 }
 
 // MessageAck acks messages.
-func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
+func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []*querypb.Value) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -560,6 +561,7 @@ func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Tar
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		Name:              name,
 		Ids:               ids,
+		ConsumerGroup:     consumerGroup,
 	}
 	reply, err := conn.c.MessageAck(ctx, req)
 	if err != nil {
@@ -568,6 +570,27 @@ func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Tar
 	return int64(reply.Result.RowsAffected), nil
 }
 
+// MessageNack nacks messages.
+func (conn *gRPCQueryClient) MessageNack(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return 0, tabletconn.ConnClosed
+	}
+	req := &querypb.MessageNackRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		Name:              name,
+		Ids:               ids,
+	}
+	reply, err := conn.c.MessageNack(ctx, req)
+	if err != nil {
+		return 0, tabletconn.ErrorFromGRPC(err)
+	}
+	return int64(reply.Result.RowsAffected), nil
+}
+
 // StreamHealth starts a streaming RPC for VTTablet health status updates.
 func (conn *gRPCQueryClient) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	// Please see comments in StreamExecute to see how this works.
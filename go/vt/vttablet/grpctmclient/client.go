@@ -854,13 +854,19 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	req := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: restoreToPos,
+	}
+	if !restoreToTimestamp.IsZero() {
+		req.RestoreToTimestamp = logutil.TimeToProto(restoreToTimestamp)
+	}
+	stream, err := c.RestoreFromBackup(ctx, req)
 	if err != nil {
 		cc.Close()
 		return nil, err
@@ -488,7 +488,7 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.tm.RestoreFromBackup(ctx, logger)
+	return s.tm.RestoreFromBackup(ctx, logger, request.RestoreToPos, logutil.ProtoToTime(request.RestoreToTimestamp))
 }
 
 // registration glue
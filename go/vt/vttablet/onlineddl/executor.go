@@ -52,6 +52,7 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -61,10 +62,6 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
-
-	mysqldriver "github.com/go-sql-driver/mysql"
-	"github.com/jmoiron/sqlx"
-	"github.com/planetscale/tengo"
 )
 
 var (
@@ -1643,117 +1640,46 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 // evaluateDeclarativeDiff is called for -declarative CREATE statements, where the table already exists. The function generates a SQL diff, which can be:
 // - empty, in which case the migration is noop and implicitly successful, or
 // - non-empty, in which case the migration turns to be an ALTER
+// The diff is computed by schemadiff, comparing the existing table's SHOW CREATE TABLE with the declared CREATE TABLE statement.
 func (e *Executor) evaluateDeclarativeDiff(ctx context.Context, onlineDDL *schema.OnlineDDL) (alterClause string, err error) {
-
-	// Modify the CREATE TABLE statement to indicate a different, made up table name, known as the "comparison table"
 	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
 	if err != nil {
 		return "", err
 	}
-	comparisonTableName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+	desiredCreateTable, ok := ddlStmt.(*sqlparser.CreateTable)
+	if !ok {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "expected CREATE TABLE statement in declarative migration %v", onlineDDL.UUID)
+	}
+
+	// Read the existing table
+	parsed := sqlparser.BuildParsedQuery(sqlShowCreateTable, onlineDDL.Table)
+	rs, err := e.execQuery(ctx, parsed.Query)
 	if err != nil {
 		return "", err
 	}
-
-	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if len(rs.Rows) == 0 || len(rs.Rows[0]) < 2 {
+		return "", vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "table %s not found", onlineDDL.Table)
+	}
+	existingStmt, err := sqlparser.ParseStrictDDL(rs.Rows[0][1].ToString())
 	if err != nil {
 		return "", err
 	}
-	defer conn.Close()
-
-	{
-		// Create the comparison table
-		ddlStmt.SetTable("", comparisonTableName)
-		modifiedCreateSQL := sqlparser.String(ddlStmt)
-
-		if _, err := conn.ExecuteFetch(modifiedCreateSQL, 0, false); err != nil {
-			return "", err
-		}
-
-		defer func() {
-			// Drop the comparison table
-			parsed := sqlparser.BuildParsedQuery(sqlDropTable, comparisonTableName)
-			_, _ = conn.ExecuteFetch(parsed.Query, 0, false)
-			// Nothing bad happens for not checking the error code. The table is GC/HOLD. If we
-			// can't drop it now, it still gets collected later by tablegc mechanism
-		}()
-
+	existingCreateTable, ok := existingStmt.(*sqlparser.CreateTable)
+	if !ok {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected SHOW CREATE TABLE output for %s", onlineDDL.Table)
 	}
 
-	// Compare the existing (to be potentially migrated) table with the declared (newly created) table:
-	// all things are tengo related
-	if err := func() error {
-		variables, err := e.readMySQLVariables(ctx)
-		if err != nil {
-			return err
-		}
-		flavor := tengo.ParseFlavor(variables.version, variables.versionComment)
-
-		// Create a temporary account for tengo to use
-		onlineDDLPassword, err := e.createOnlineDDLUser(ctx)
-		if err != nil {
-			return err
-		}
-		defer e.dropOnlineDDLUser(ctx)
-
-		// tengo requires sqlx.DB
-		cfg := mysqldriver.NewConfig()
-		cfg.User = onlineDDLUser
-		cfg.Passwd = onlineDDLPassword
-		cfg.Net = "tcp"
-		cfg.Addr = fmt.Sprintf("%s:%d", variables.host, variables.port)
-		cfg.DBName = e.dbName
-		cfg.ParseTime = true
-		cfg.InterpolateParams = true
-		cfg.Timeout = 1 * time.Second
-		mysqlDSN := cfg.FormatDSN()
-
-		db, err := sqlx.Open("mysql", mysqlDSN)
-		if err != nil {
-			return err
-		}
-		defer db.Close()
-
-		// Read existing table
-		existingTable, err := tengo.QuerySchemaTable(ctx, db, e.dbName, onlineDDL.Table, flavor)
-		if err != nil {
-			return err
-		}
-		// Read comparison table
-		comparisonTable, err := tengo.QuerySchemaTable(ctx, db, e.dbName, comparisonTableName, flavor)
-		if err != nil {
-			return err
-		}
-		// We created the comparison tablein same schema as original table, but under different name (because obviously we can't have
-		// two tables with identical name in same schema). It is our preference to create the table in the same schema.
-		// unfortunately, tengo does not allow comparing tables with different names. After asking tengo to read table info, we cheat
-		// and override the name of the table:
-		comparisonTable.Name = existingTable.Name
-		// We also override `.CreateStatement` (output of SHOW CREATE TABLE), because tengo has a validation, where if it doesn't
-		// find any ALTER changes, then the CreateStatement-s must be identical (or else it errors with UnsupportedDiffError)
-		comparisonTable.CreateStatement, err = schema.ReplaceTableNameInCreateTableStatement(comparisonTable.CreateStatement, existingTable.Name)
-		if err != nil {
-			return err
-		}
-		// Diff the two tables
-		diff := tengo.NewAlterTable(existingTable, comparisonTable)
-		if diff == nil {
-			// No change. alterClause remains empty
-			return nil
-		}
-		mods := tengo.StatementModifiers{
-			AllowUnsafe: true,
-			NextAutoInc: tengo.NextAutoIncIfIncreased,
-		}
-		alterClause, err = diff.Clauses(mods)
-		if err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
+	// Compare the existing (to be potentially migrated) table with the declared table
+	hints := &schemadiff.DiffHints{AutoIncrementStrategy: schemadiff.AutoIncrementApplyHigher}
+	alterTable, err := schemadiff.DiffTables(existingCreateTable, desiredCreateTable, hints)
+	if err != nil {
 		return "", err
 	}
-
+	if alterTable == nil {
+		// No change. alterClause remains empty
+		return "", nil
+	}
+	_, _, alterClause = schema.ParseAlterTableOptions(sqlparser.String(alterTable))
 	return alterClause, nil
 }
 
@@ -366,6 +366,7 @@ const (
 		`
 	sqlDropTrigger       = "DROP TRIGGER IF EXISTS `%a`.`%a`"
 	sqlShowTablesLike    = "SHOW TABLES LIKE '%a'"
+	sqlShowCreateTable   = "SHOW CREATE TABLE `%a`"
 	sqlCreateTableLike   = "CREATE TABLE `%a` LIKE `%a`"
 	sqlDropTable         = "DROP TABLE `%a`"
 	sqlAlterTableOptions = "ALTER TABLE `%a` %s"
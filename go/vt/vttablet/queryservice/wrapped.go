@@ -234,17 +234,26 @@ func (ws *wrappedService) BeginExecuteBatch(ctx context.Context, target *querypb
 	return qrs, transactionID, alias, err
 }
 
-func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name, consumerGroup string, callback func(*sqltypes.Result) error) error {
 	return ws.wrapper(ctx, target, ws.impl, "MessageStream", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
-		innerErr := conn.MessageStream(ctx, target, name, callback)
+		innerErr := conn.MessageStream(ctx, target, name, consumerGroup, callback)
 		return canRetry(ctx, innerErr), innerErr
 	})
 }
 
-func (ws *wrappedService) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (ws *wrappedService) MessageAck(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []*querypb.Value) (count int64, err error) {
 	err = ws.wrapper(ctx, target, ws.impl, "MessageAck", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		var innerErr error
-		count, innerErr = conn.MessageAck(ctx, target, name, ids)
+		count, innerErr = conn.MessageAck(ctx, target, name, consumerGroup, ids)
+		return canRetry(ctx, innerErr), innerErr
+	})
+	return count, err
+}
+
+func (ws *wrappedService) MessageNack(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+	err = ws.wrapper(ctx, target, ws.impl, "MessageNack", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		count, innerErr = conn.MessageNack(ctx, target, name, ids)
 		return canRetry(ctx, innerErr), innerErr
 	})
 	return count, err
@@ -93,6 +93,8 @@ type SandboxConn struct {
 	ReadTransactionResults []*querypb.TransactionMetadata
 
 	MessageIDs []*querypb.Value
+	// MessageConsumerGroup is the consumer group of the last MessageAck.
+	MessageConsumerGroup string
 
 	// vstream expectations.
 	StartPos      string
@@ -392,7 +394,7 @@ func (sbc *SandboxConn) BeginExecuteBatch(ctx context.Context, target *querypb.T
 }
 
 // MessageStream is part of the QueryService interface.
-func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name, consumerGroup string, callback func(*sqltypes.Result) error) (err error) {
 	if err := sbc.getError(); err != nil {
 		return err
 	}
@@ -405,7 +407,14 @@ func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Targe
 }
 
 // MessageAck is part of the QueryService interface.
-func (sbc *SandboxConn) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (sbc *SandboxConn) MessageAck(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []*querypb.Value) (count int64, err error) {
+	sbc.MessageIDs = ids
+	sbc.MessageConsumerGroup = consumerGroup
+	return int64(len(ids)), nil
+}
+
+// MessageNack is part of the QueryService interface.
+func (sbc *SandboxConn) MessageNack(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
 	sbc.MessageIDs = ids
 	return int64(len(ids)), nil
 }
@@ -119,7 +119,7 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 
 	startTime = time.Now()
 
-	err = tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore)
+	err = tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, mysql.Position{}, time.Time{})
 	if err != nil {
 		return err
 	}
@@ -137,7 +137,7 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	return nil
 }
 
-func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool) error {
+func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, restoreToPos mysql.Position, restoreToTimestamp time.Time) error {
 
 	tablet := tm.Tablet()
 	originalType := tablet.Type
@@ -174,6 +174,8 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
 		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
+		RestoreToPos:        restoreToPos,
+		RestoreToTimestamp:  restoreToTimestamp,
 	}
 
 	// Check whether we're going to restore before changing to RESTORE type,
@@ -234,7 +236,15 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
-		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
+		if params.IsPointInTime() {
+			// Replicating from the master would move us past the point we
+			// restored to, so only record the position, and stay out of
+			// serving until an operator decides what to do with the tablet.
+			if err := tm.MysqlDaemon.SetReplicationPosition(context.Background(), pos); err != nil {
+				return vterrors.Wrap(err, "failed to set replication position")
+			}
+			originalType = topodatapb.TabletType_DRAINED
+		} else if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
 			// Reconnect to master only for "NORMAL" keyspaces
 			if err := tm.startReplication(context.Background(), pos, originalType); err != nil {
 				return err
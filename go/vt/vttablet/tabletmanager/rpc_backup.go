@@ -22,6 +22,7 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -151,7 +152,10 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 }
 
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// If restoreToPos or restoreToTimestamp is set, binlog backups are applied on
+// top of the backup to reach that point, and the tablet is left DRAINED with
+// replication stopped.
+func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTimestamp time.Time) error {
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -164,12 +168,18 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 	if tablet.Type == topodatapb.TabletType_MASTER {
 		return fmt.Errorf("type MASTER cannot restore from backup, if you really need to do this, restart vttablet in replica mode")
 	}
+	var pos mysql.Position
+	if restoreToPos != "" {
+		if pos, err = mysql.DecodePosition(restoreToPos); err != nil {
+			return vterrors.Wrapf(err, "invalid restore position %v", restoreToPos)
+		}
+	}
 
 	// create the loggers: tee to console and source
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */)
+	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, pos, restoreToTimestamp)
 
 	// re-run health check to be sure to capture any replication delay
 	tm.QueryServiceControl.BroadcastHealth()
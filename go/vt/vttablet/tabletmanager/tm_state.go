@@ -267,6 +267,14 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	if ts.tm.VDiffEngine != nil {
+		if ts.tablet.Type == topodatapb.TabletType_MASTER {
+			ts.tm.VDiffEngine.Open(ts.tm.BatchCtx, proto.Clone(ts.tablet).(*topodatapb.Tablet))
+		} else {
+			ts.tm.VDiffEngine.Close()
+		}
+	}
+
 	if ts.isShardServing[ts.tablet.Type] {
 		ts.isInSrvKeyspace = true
 		statsIsInSrvKeyspace.Set(1)
@@ -0,0 +1,622 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	defaultTabletTypes                 = "master,replica,rdonly"
+	defaultFilteredReplicationWaitTime = 30 * time.Second
+)
+
+// controller runs one vdiff. It compares the tables of the workflow one
+// after the other, and saves the progress of each in _vt.vdiff_table.
+type controller struct {
+	vde *Engine
+
+	id       int64
+	uuid     string
+	workflow string
+	options  *tabletmanagerdatapb.VDiffOptions
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// workflowStream is a vreplication stream of the workflow being diffed.
+type workflowStream struct {
+	id     int
+	source *binlogdatapb.BinlogSource
+	pos    string
+	state  string
+}
+
+// tableProgress is the saved state of a table of the vdiff.
+type tableProgress struct {
+	state  string
+	lastpk *querypb.QueryResult
+	report *tabletmanagerdatapb.VDiffReport
+}
+
+// newController creates and starts a controller for the given _vt.vdiff row.
+func newController(ctx context.Context, row sqltypes.RowNamedValues, vde *Engine) (*controller, error) {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	ct := &controller{
+		vde:      vde,
+		id:       id,
+		uuid:     row.AsString("vdiff_uuid", ""),
+		workflow: row.AsString("workflow", ""),
+		options:  &tabletmanagerdatapb.VDiffOptions{},
+		done:     make(chan struct{}),
+	}
+	if options := row.AsString("options", ""); options != "" {
+		if err := protojson.Unmarshal([]byte(options), ct.options); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid options for vdiff %s", ct.uuid)
+		}
+	}
+	ctx, ct.cancel = context.WithCancel(ctx)
+	go ct.run(ctx)
+	return ct, nil
+}
+
+// Stop stops the controller and waits for it to exit.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+
+	err := ct.runVDiff(ctx)
+	if err == nil {
+		log.Infof("VDiff %s on workflow %s completed", ct.uuid, ct.workflow)
+		return
+	}
+	// A cancelled context means that the vdiff was stopped, or that the
+	// engine was closed: the vdiff is not failed.
+	select {
+	case <-ctx.Done():
+		log.Infof("VDiff %s on workflow %s stopped: %v", ct.uuid, ct.workflow, err)
+		return
+	default:
+	}
+	log.Errorf("VDiff %s on workflow %s failed: %v", ct.uuid, ct.workflow, err)
+	if _, err := ct.vde.execQuery(fmt.Sprintf(sqlFailVDiff, encodeString(err.Error()), ct.id)); err != nil {
+		log.Errorf("VDiff %s could not record its error: %v", ct.uuid, err)
+	}
+}
+
+func (ct *controller) runVDiff(ctx context.Context) error {
+	if _, err := ct.vde.execQuery(fmt.Sprintf(sqlStartVDiff, ct.id)); err != nil {
+		return err
+	}
+	streams, err := ct.workflowStreams()
+	if err != nil {
+		return err
+	}
+	differs, err := ct.buildPlan(ctx, streams[0].source)
+	if err != nil {
+		return err
+	}
+	progress, err := ct.tableProgress()
+	if err != nil {
+		return err
+	}
+	tables := make([]string, 0, len(differs))
+	for table := range differs {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		tp := progress[table]
+		if tp == nil {
+			tp = &tableProgress{report: &tabletmanagerdatapb.VDiffReport{TableName: table}}
+			if _, err := ct.vde.execQuery(fmt.Sprintf(sqlInitVDiffTable, ct.id, encodeString(table))); err != nil {
+				return err
+			}
+		}
+		if tp.state == CompletedState {
+			continue
+		}
+		if err := ct.diffTable(ctx, differs[table], tp); err != nil {
+			return vterrors.Wrapf(err, "table %s", table)
+		}
+	}
+	_, err = ct.vde.execQuery(fmt.Sprintf(sqlCompleteVDiff, ct.id))
+	return err
+}
+
+// workflowStreams returns the vreplication streams of the workflow on
+// this tablet.
+func (ct *controller) workflowStreams() ([]*workflowStream, error) {
+	qr, err := ct.vde.execQuery(fmt.Sprintf(sqlGetWorkflowStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("workflow %s not found", ct.workflow)
+	}
+	var streams []*workflowStream
+	for _, row := range qr.Named().Rows {
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		stream := &workflowStream{
+			id:     int(id),
+			source: &binlogdatapb.BinlogSource{},
+			pos:    row.AsString("pos", ""),
+			state:  row.AsString("state", ""),
+		}
+		if err := prototext.Unmarshal([]byte(row.AsString("source", "")), stream.source); err != nil {
+			return nil, err
+		}
+		if stream.source.ExternalMysql != "" {
+			return nil, fmt.Errorf("vdiff is not supported on workflows from an external mysql: %s", ct.workflow)
+		}
+		streams = append(streams, stream)
+	}
+	return streams, nil
+}
+
+// buildPlan builds the differs of the tables of the workflow, keyed by
+// table name. source is the source of any of the streams: they only differ
+// by shard.
+func (ct *controller) buildPlan(ctx context.Context, source *binlogdatapb.BinlogSource) (map[string]*tableDiffer, error) {
+	schm, err := ct.vde.mysqld.GetSchema(ctx, ct.vde.dbName, ct.options.Tables, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	filter := source.Filter
+	// The vschema is only needed to compute the keyspace ids of the owner
+	// table of a lookup vindex.
+	var vschema *vindexes.VSchema
+	differs := make(map[string]*tableDiffer)
+	for _, table := range schm.TableDefinitions {
+		if schema.IsInternalOperationTableName(table.Name) {
+			continue
+		}
+		rule, err := vreplication.MatchTable(table.Name, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == "exclude" {
+			continue
+		}
+		query := rule.Filter
+		if rule.Filter == "" || key.IsKeyRange(rule.Filter) {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+			if rule.Filter != "" {
+				buf.Myprintf(" where in_keyrange(%v)", sqlparser.NewStrLiteral(rule.Filter))
+			}
+			query = buf.String()
+		}
+		if isLookupFilter(query) {
+			if vschema == nil {
+				srvVSchema, err := ct.vde.ts.GetSrvVSchema(ctx, ct.vde.tablet.Alias.Cell)
+				if err != nil {
+					return nil, err
+				}
+				vschema = vindexes.BuildVSchema(srvVSchema)
+			}
+			if differs[table.Name], err = buildLookupPlan(table, query, vschema, source.Keyspace); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if differs[table.Name], err = buildTablePlan(table, query); err != nil {
+			return nil, err
+		}
+	}
+	for _, table := range ct.options.Tables {
+		if differs[table] == nil {
+			return nil, fmt.Errorf("table %s is not part of workflow %s", table, ct.workflow)
+		}
+	}
+	return differs, nil
+}
+
+// tableProgress returns the saved progress of the tables of the vdiff.
+func (ct *controller) tableProgress() (map[string]*tableProgress, error) {
+	qr, err := ct.vde.execQuery(fmt.Sprintf(sqlGetVDiffTables, ct.id))
+	if err != nil {
+		return nil, err
+	}
+	progress := make(map[string]*tableProgress)
+	for _, row := range qr.Named().Rows {
+		table := row.AsString("table_name", "")
+		tp := &tableProgress{
+			state:  row.AsString("state", ""),
+			report: &tabletmanagerdatapb.VDiffReport{TableName: table},
+		}
+		if lastpk := row.AsString("lastpk", ""); lastpk != "" {
+			tp.lastpk = &querypb.QueryResult{}
+			if err := prototext.Unmarshal([]byte(lastpk), tp.lastpk); err != nil {
+				return nil, err
+			}
+		}
+		if report := row.AsString("report", ""); report != "" {
+			if err := protojson.Unmarshal([]byte(report), tp.report); err != nil {
+				return nil, err
+			}
+		}
+		progress[table] = tp
+	}
+	return progress, nil
+}
+
+// diffTable compares one table. The workflow is stopped while consistent
+// snapshots of the sources and of this shard are taken, then restarted while
+// the rows are compared. The streams which are not running, like the ones
+// which stopped after the copy of a lookup vindex, are left alone: their
+// table is compared as is.
+func (ct *controller) diffTable(ctx context.Context, td *tableDiffer, tp *tableProgress) error {
+	log.Infof("VDiff %s: starting the diff of table %s", ct.uuid, td.table)
+	if _, err := ct.vde.execQuery(fmt.Sprintf(sqlUpdateTableState, encodeString(StartedState), ct.id, encodeString(td.table))); err != nil {
+		return err
+	}
+	sources, target, err := ct.startStreams(ctx, td, tp.lastpk)
+	if err != nil {
+		return err
+	}
+
+	checkpoint := func(state string, lastpk []sqltypes.Value) error {
+		return ct.saveProgress(td, tp.report, state, target.pkFields(td), lastpk)
+	}
+	lastpk, err := td.diff(ctx, td.newMergeSorter(sources), td.newMergeSorter([]*shardStreamer{target}), tp.report, ct.options, func(lastpk []sqltypes.Value) error {
+		return checkpoint(StartedState, lastpk)
+	})
+	if err != nil {
+		if lastpk != nil {
+			if err := checkpoint(StartedState, lastpk); err != nil {
+				log.Errorf("VDiff %s: could not save the progress of table %s: %v", ct.uuid, td.table, err)
+			}
+		}
+		return err
+	}
+	log.Infof("VDiff %s: table %s compared, %d rows processed", ct.uuid, td.table, tp.report.ProcessedRows)
+	return checkpoint(CompletedState, lastpk)
+}
+
+// startStreams takes the snapshots of the table on the sources and on this
+// tablet, and starts streaming their rows from lastpk.
+func (ct *controller) startStreams(ctx context.Context, td *tableDiffer, lastpk *querypb.QueryResult) (sources []*shardStreamer, target *shardStreamer, err error) {
+	waitTime, ok, err := protoutil.DurationFromProto(ct.options.FilteredReplicationWaitTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		waitTime = defaultFilteredReplicationWaitTime
+	}
+
+	// Stop the running streams so that their positions stay put.
+	streams, err := ct.workflowStreams()
+	if err != nil {
+		return nil, nil, err
+	}
+	running := make(map[int]bool)
+	var ids []string
+	for _, stream := range streams {
+		if stream.state == binlogplayer.BlpRunning {
+			running[stream.id] = true
+			ids = append(ids, fmt.Sprintf("%d", stream.id))
+		}
+	}
+	if len(ids) != 0 {
+		idList := strings.Join(ids, ", ")
+		if _, err := ct.vde.vre.Exec(fmt.Sprintf(sqlStopWorkflowStreams, idList)); err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			if _, rerr := ct.vde.vre.Exec(fmt.Sprintf(sqlRestartWorkflowStreams, idList)); rerr != nil {
+				log.Errorf("VDiff %s: could not restart workflow %s: %v", ct.uuid, ct.workflow, rerr)
+				if err == nil {
+					err = rerr
+				}
+			}
+		}()
+		if streams, err = ct.workflowStreams(); err != nil {
+			return nil, nil, err
+		}
+	}
+	var lastpkValues []sqltypes.Value
+	if lastpk != nil {
+		lastpkValues = sqltypes.Proto3ToResult(lastpk).Rows[0]
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, waitTime)
+	defer cancel()
+
+	// Make sure the sources are past the positions of the streams, and
+	// start streaming from them. This records their snapshot positions.
+	for _, stream := range streams {
+		tablet, err := ct.pickSourceTablet(waitCtx, stream.source)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := ct.vde.tmc.WaitForPosition(waitCtx, tablet, stream.pos); err != nil {
+			return nil, nil, vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(tablet.Alias))
+		}
+		source := &shardStreamer{
+			tablet: tablet,
+			target: &querypb.Target{
+				Keyspace:   stream.source.Keyspace,
+				Shard:      stream.source.Shard,
+				TabletType: tablet.Type,
+			},
+		}
+		query := td.sourceQuery
+		if td.lookup != nil {
+			source.mapper = td.lookup.newRowMapper()
+			query = td.lookup.sourceQuery(lastpkValues)
+		}
+		if err := source.start(ctx, query, lastpk); err != nil {
+			return nil, nil, err
+		}
+		sources = append(sources, source)
+	}
+
+	// Fast forward the running streams to the snapshot positions of the
+	// sources.
+	for i, stream := range streams {
+		if !running[stream.id] {
+			continue
+		}
+		pos := sources[i].snapshotPosition
+		if _, err := ct.vde.vre.Exec(fmt.Sprintf(sqlSyncWorkflowStream, encodeString(pos), stream.id)); err != nil {
+			return nil, nil, err
+		}
+		if err := ct.vde.vre.WaitForPos(waitCtx, stream.id, pos); err != nil {
+			return nil, nil, vterrors.Wrapf(err, "WaitForPos for stream %d", stream.id)
+		}
+	}
+
+	// This tablet is now in sync with the sources.
+	target = &shardStreamer{
+		tablet: ct.vde.tablet,
+		target: &querypb.Target{
+			Keyspace:   ct.vde.tablet.Keyspace,
+			Shard:      ct.vde.tablet.Shard,
+			TabletType: ct.vde.tablet.Type,
+		},
+	}
+	query := td.targetQuery
+	if td.lookup != nil {
+		target.results = true
+		query = td.lookup.targetQuery(td.table, lastpkValues)
+	}
+	if err := target.start(ctx, query, lastpk); err != nil {
+		return nil, nil, err
+	}
+	return sources, target, nil
+}
+
+func (ct *controller) pickSourceTablet(ctx context.Context, source *binlogdatapb.BinlogSource) (*topodatapb.Tablet, error) {
+	cells := ct.options.SourceCells
+	if len(cells) == 0 {
+		cells = []string{ct.vde.tablet.Alias.Cell}
+	}
+	tabletTypes := ct.options.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = defaultTabletTypes
+	}
+	tp, err := discovery.NewTabletPicker(ct.vde.ts, cells, source.Keyspace, source.Shard, tabletTypes)
+	if err != nil {
+		return nil, err
+	}
+	return tp.PickForStreaming(ctx)
+}
+
+// saveProgress saves the state, the report and the last compared primary key
+// of a table.
+func (ct *controller) saveProgress(td *tableDiffer, report *tabletmanagerdatapb.VDiffReport, state string, pkFields []*querypb.Field, lastpk []sqltypes.Value) error {
+	lastpkBytes := []byte{}
+	if lastpk != nil {
+		var err error
+		lastpkBytes, err = prototext.Marshal(&querypb.QueryResult{
+			Fields: pkFields,
+			Rows:   []*querypb.Row{sqltypes.RowToProto3(lastpk)},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	reportBytes, err := protojson.Marshal(report)
+	if err != nil {
+		return err
+	}
+	mismatch := report.MismatchedRows > 0 || report.ExtraRowsSource > 0 || report.ExtraRowsTarget > 0
+	bindVars := map[string]*querypb.BindVariable{
+		"state":         sqltypes.StringBindVariable(state),
+		"lastpk":        sqltypes.BytesBindVariable(lastpkBytes),
+		"rows_compared": sqltypes.Int64BindVariable(report.ProcessedRows),
+		"mismatch":      sqltypes.BoolBindVariable(mismatch),
+		"report":        sqltypes.BytesBindVariable(reportBytes),
+		"vdiff_id":      sqltypes.Int64BindVariable(ct.id),
+		"table_name":    sqltypes.StringBindVariable(td.table),
+	}
+	query, err := sqlparser.ParseAndBind(sqlUpdateTableProgress,
+		bindVars["state"], bindVars["lastpk"], bindVars["rows_compared"], bindVars["mismatch"],
+		bindVars["report"], bindVars["vdiff_id"], bindVars["table_name"])
+	if err != nil {
+		return err
+	}
+	_, err = ct.vde.execQuery(query)
+	return err
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+// shardStreamer streams the rows of a table from one tablet, with
+// VStreamRows. It satisfies engine.StreamExecutor, and can be added
+// to the Primitives of an engine.MergeSort.
+type shardStreamer struct {
+	tablet *topodatapb.Tablet
+	target *querypb.Target
+	// results makes the stream use VStreamResults, whose query has its own
+	// order and lastpk. It is implied by mapper, which maps the rows of the
+	// owner table of a lookup vindex to the rows of its table.
+	results bool
+	mapper  *lookupRowMapper
+
+	// snapshotPosition is the position of the snapshot of the stream.
+	snapshotPosition string
+	fields           []*querypb.Field
+	result           chan *sqltypes.Result
+	err              error
+}
+
+// start starts the stream in a separate goroutine, and waits for its snapshot
+// position. The rows are then served by StreamExecute.
+func (sm *shardStreamer) start(ctx context.Context, query string, lastpk *querypb.QueryResult) error {
+	sm.result = make(chan *sqltypes.Result, 1)
+	gtidch := make(chan string, 1)
+	go sm.stream(ctx, query, lastpk, gtidch)
+
+	// Wait for the gtid to be sent. If it's not received, there was an
+	// error which would be stored in sm.err.
+	gtid, ok := <-gtidch
+	if !ok {
+		return vterrors.Wrapf(sm.err, "%s on tablet %v", sm.method(), topoproto.TabletAliasString(sm.tablet.Alias))
+	}
+	sm.snapshotPosition = gtid
+	return nil
+}
+
+// stream first sends the snapshot gtid to gtidch, then streams the
+// rows to sm.result. Before returning, it sets sm.err, and closes all
+// channels.
+func (sm *shardStreamer) stream(ctx context.Context, query string, lastpk *querypb.QueryResult, gtidch chan string) {
+	defer close(sm.result)
+	defer close(gtidch)
+
+	sm.err = func() error {
+		conn, err := tabletconn.GetDialer()(sm.tablet, grpcclient.FailFast(false))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		if sm.mapper == nil && !sm.results {
+			return conn.VStreamRows(ctx, sm.target, query, lastpk, func(vrs *binlogdatapb.VStreamRowsResponse) error {
+				return sm.send(ctx, vrs.Fields, vrs.Gtid, vrs.Rows, gtidch)
+			})
+		}
+		err = conn.VStreamResults(ctx, sm.target, query, func(vrs *binlogdatapb.VStreamResultsResponse) error {
+			return sm.send(ctx, vrs.Fields, vrs.Gtid, vrs.Rows, gtidch)
+		})
+		if err != nil || sm.mapper == nil {
+			return err
+		}
+		return sm.sendResult(ctx, &sqltypes.Result{Rows: sm.mapper.flush()})
+	}()
+}
+
+// send sends the rows received from the tablet to sm.result, and the gtid
+// to gtidch along with the fields.
+func (sm *shardStreamer) send(ctx context.Context, fields []*querypb.Field, gtid string, rows []*querypb.Row, gtidch chan string) error {
+	if fields != nil {
+		sm.fields = fields
+		if sm.mapper != nil {
+			sm.fields = sm.mapper.mapFields(fields)
+		}
+		gtidch <- gtid
+	}
+	if sm.mapper == nil {
+		// Fields are received only once, and sent only once.
+		return sm.sendResult(ctx, sqltypes.CustomProto3ToResult(sm.fields, &querypb.QueryResult{
+			Fields: fields,
+			Rows:   rows,
+		}))
+	}
+	result := sqltypes.CustomProto3ToResult(sm.mapper.sourceFields, &querypb.QueryResult{Rows: rows})
+	if fields != nil {
+		result.Fields = sm.fields
+	}
+	var err error
+	if result.Rows, err = sm.mapper.mapRows(result.Rows); err != nil {
+		return err
+	}
+	return sm.sendResult(ctx, result)
+}
+
+func (sm *shardStreamer) sendResult(ctx context.Context, result *sqltypes.Result) error {
+	select {
+	case sm.result <- result:
+	case <-ctx.Done():
+		return vterrors.Wrap(ctx.Err(), sm.method())
+	}
+	return nil
+}
+
+func (sm *shardStreamer) method() string {
+	if sm.mapper == nil && !sm.results {
+		return "VStreamRows"
+	}
+	return "VStreamResults"
+}
+
+// StreamExecute satisfies engine.StreamExecutor.
+func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for result := range sm.result {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return sm.err
+}
+
+// pkFields returns the fields of the primary key of the table streamed.
+func (sm *shardStreamer) pkFields(td *tableDiffer) []*querypb.Field {
+	return td.pkFields(sm.fields)
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package vdiff runs VDiffs on the primary of each target shard of a workflow.
+
+A VDiff is requested by inserting a row into _vt.vdiff through VExec. The
+engine then starts a controller, which compares the rows of each table of the
+workflow between the source shards and this shard. The progress of each table
+is saved in _vt.vdiff_table, so that a VDiff can be stopped and resumed, and
+survives restarts of the tablet.
+*/
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+	"vitess.io/vitess/go/vt/withddl"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var withDDL = withddl.New([]string{sqlCreateVDiffTable, sqlCreateVDiffTableTable})
+
+var emptyResult = &sqltypes.Result{}
+
+// VReplicationEngine is the part of the vreplication engine used to stop,
+// synchronize and restart the streams of the workflow being diffed.
+type VReplicationEngine interface {
+	Exec(query string) (*sqltypes.Result, error)
+	WaitForPos(ctx context.Context, id int, pos string) error
+}
+
+// Engine runs the vdiffs of a target primary.
+type Engine struct {
+	// mu synchronizes isOpen, controllers and tablet.
+	mu          sync.Mutex
+	isOpen      bool
+	controllers map[int64]*controller
+
+	// ctx is the root context for all controllers.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	ts              *topo.Server
+	tmc             tmclient.TabletManagerClient
+	mysqld          mysqlctl.MysqlDaemon
+	vre             VReplicationEngine
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+	tablet          *topodatapb.Tablet
+}
+
+// NewEngine creates a new Engine.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, tmc tmclient.TabletManagerClient, mysqld mysqlctl.MysqlDaemon, vre VReplicationEngine) *Engine {
+	return &Engine{
+		controllers: make(map[int64]*controller),
+		ts:          ts,
+		tmc:         tmc,
+		mysqld:      mysqld,
+		vre:         vre,
+	}
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, tmc tmclient.TabletManagerClient, mysqld mysqlctl.MysqlDaemon, vre VReplicationEngine, dbClientFactory func() binlogplayer.DBClient, dbName string) *Engine {
+	return &Engine{
+		controllers:     make(map[int64]*controller),
+		ts:              ts,
+		tmc:             tmc,
+		mysqld:          mysqld,
+		vre:             vre,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbName,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initialized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.DbaWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// Open starts the Engine, and resumes the vdiffs which were running when it
+// was last closed. The given tablet is the primary the engine runs on.
+func (vde *Engine) Open(ctx context.Context, tablet *topodatapb.Tablet) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+
+	vde.tablet = tablet
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.isOpen = true
+
+	qr, err := vde.execQuery(fmt.Sprintf(sqlGetVDiffsToRun, encodeString(vde.dbName)))
+	if err != nil {
+		// The vdiffs will be resumed on the next Open, or by the user.
+		log.Errorf("VDiff Engine: could not read vdiffs to resume: %v", err)
+		return
+	}
+	for _, row := range qr.Named().Rows {
+		vde.startControllerLocked(row)
+	}
+}
+
+// IsOpen returns true if Engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close stops all the running vdiffs. Their state is kept, so that they are
+// resumed on the next Open.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if !vde.isOpen {
+		return
+	}
+
+	vde.cancel()
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[int64]*controller)
+	vde.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// VExec runs a VExec query on the vdiff tables, and starts, stops or removes
+// the matching vdiffs accordingly. An INSERT creates a new vdiff, which starts
+// right away. An UPDATE of the state to 'stopped' pauses the matching vdiffs,
+// and to 'pending' resumes the stopped and failed ones. A DELETE removes the
+// matching vdiffs along with their results.
+func (vde *Engine) VExec(ctx context.Context, vx *vexec.TabletVExec) (*querypb.QueryResult, error) {
+	qr, err := vde.vexec(ctx, vx)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.ResultToProto3(qr), nil
+}
+
+func (vde *Engine) vexec(ctx context.Context, vx *vexec.TabletVExec) (*sqltypes.Result, error) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if !vde.isOpen {
+		return nil, fmt.Errorf("vdiff engine is closed, tablet is probably not a primary")
+	}
+
+	switch stmt := vx.Stmt.(type) {
+	case *sqlparser.Select:
+		return vde.execQuery(vx.Query)
+	case *sqlparser.Insert:
+		if vx.TableName != qualifiedTableName(VDiffTableName) {
+			return nil, fmt.Errorf("INSERT is not supported on %s", vx.TableName)
+		}
+		return vde.createVDiff(vx)
+	case *sqlparser.Update:
+		if vx.TableName != qualifiedTableName(VDiffTableName) {
+			return nil, fmt.Errorf("UPDATE is not supported on %s", vx.TableName)
+		}
+		if len(stmt.Exprs) != 1 || vx.UpdateCols["state"] == nil {
+			return nil, fmt.Errorf("only the state of a vdiff can be updated: %s", vx.Query)
+		}
+		state := vx.UpdateCols["state"].Val
+		switch state {
+		case StoppedState:
+			return vde.stopVDiffs(stmt)
+		case PendingState:
+			return vde.resumeVDiffs(stmt)
+		default:
+			return nil, fmt.Errorf("unsupported vdiff state %s, only %s and %s are supported", state, StoppedState, PendingState)
+		}
+	case *sqlparser.Delete:
+		if vx.TableName != qualifiedTableName(VDiffTableName) {
+			return nil, fmt.Errorf("DELETE is not supported on %s", vx.TableName)
+		}
+		return vde.deleteVDiffs(stmt)
+	default:
+		return nil, fmt.Errorf("query not supported by vdiff: %s", vx.Query)
+	}
+}
+
+func (vde *Engine) createVDiff(vx *vexec.TabletVExec) (*sqltypes.Result, error) {
+	if vx.InsertCols["vdiff_uuid"] == nil || vx.InsertCols["workflow"] == nil {
+		return nil, fmt.Errorf("vdiff_uuid and workflow are required to create a vdiff: %s", vx.Query)
+	}
+	// VExec runs outside of the shard and database context, so it does not
+	// supply these values. We fill them in.
+	if err := vx.AddOrReplaceInsertColumnVal("keyspace", vx.ToStringVal(vde.tablet.Keyspace)); err != nil {
+		return nil, err
+	}
+	if err := vx.AddOrReplaceInsertColumnVal("shard", vx.ToStringVal(vde.tablet.Shard)); err != nil {
+		return nil, err
+	}
+	if err := vx.AddOrReplaceInsertColumnVal("db_name", vx.ToStringVal(vde.dbName)); err != nil {
+		return nil, err
+	}
+	if err := vx.AddOrReplaceInsertColumnVal("state", vx.ToStringVal(PendingState)); err != nil {
+		return nil, err
+	}
+	qr, err := vde.execQuery(vx.Query)
+	if err != nil {
+		return nil, err
+	}
+	if err := vde.startControllerByIDLocked(int64(qr.InsertID)); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+func (vde *Engine) stopVDiffs(upd *sqlparser.Update) (*sqltypes.Result, error) {
+	ids, err := vde.matchingIDs(upd.Where, PendingState, StartedState)
+	if err != nil || len(ids) == 0 {
+		return emptyResult, err
+	}
+	for _, id := range ids {
+		if ct, ok := vde.controllers[id]; ok {
+			ct.Stop()
+			delete(vde.controllers, id)
+		}
+	}
+	return vde.execQuery(fmt.Sprintf("update _vt.vdiff set state = %s where id in (%s)", encodeString(StoppedState), joinIDs(ids)))
+}
+
+func (vde *Engine) resumeVDiffs(upd *sqlparser.Update) (*sqltypes.Result, error) {
+	ids, err := vde.matchingIDs(upd.Where, StoppedState, ErrorState)
+	if err != nil || len(ids) == 0 {
+		return emptyResult, err
+	}
+	qr, err := vde.execQuery(fmt.Sprintf("update _vt.vdiff set state = %s, last_error = '' where id in (%s)", encodeString(PendingState), joinIDs(ids)))
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if err := vde.startControllerByIDLocked(id); err != nil {
+			return nil, err
+		}
+	}
+	return qr, nil
+}
+
+func (vde *Engine) deleteVDiffs(del *sqlparser.Delete) (*sqltypes.Result, error) {
+	ids, err := vde.matchingIDs(del.Where)
+	if err != nil || len(ids) == 0 {
+		return emptyResult, err
+	}
+	for _, id := range ids {
+		if ct, ok := vde.controllers[id]; ok {
+			ct.Stop()
+			delete(vde.controllers, id)
+		}
+	}
+	if _, err := vde.execQuery(fmt.Sprintf(sqlDeleteVDiffTables, joinIDs(ids))); err != nil {
+		return nil, err
+	}
+	return vde.execQuery(fmt.Sprintf("delete from _vt.vdiff where id in (%s)", joinIDs(ids)))
+}
+
+// matchingIDs returns the ids of the vdiffs of this database which match the
+// given WHERE clause, restricted to the given states if any.
+func (vde *Engine) matchingIDs(where *sqlparser.Where, states ...string) ([]int64, error) {
+	conditions := []string{fmt.Sprintf("db_name = %s", encodeString(vde.dbName))}
+	if where != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s)", sqlparser.String(where.Expr)))
+	}
+	if len(states) > 0 {
+		var encoded []string
+		for _, state := range states {
+			encoded = append(encoded, encodeString(state))
+		}
+		conditions = append(conditions, fmt.Sprintf("state in (%s)", strings.Join(encoded, ", ")))
+	}
+	qr, err := vde.execQuery(fmt.Sprintf(sqlGetVDiffIDsWhere, strings.Join(conditions, " and ")))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (vde *Engine) startControllerByIDLocked(id int64) error {
+	qr, err := vde.execQuery(fmt.Sprintf(sqlGetVDiffByID, id))
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		return fmt.Errorf("vdiff %d not found", id)
+	}
+	vde.startControllerLocked(qr.Named().Row())
+	return nil
+}
+
+func (vde *Engine) startControllerLocked(row sqltypes.RowNamedValues) {
+	ct, err := newController(vde.ctx, row, vde)
+	if err != nil {
+		log.Errorf("VDiff controller could not be initialized for %v: %v", row, err)
+		return
+	}
+	if old, ok := vde.controllers[ct.id]; ok {
+		old.Stop()
+	}
+	vde.controllers[ct.id] = ct
+}
+
+// execQuery runs a query on the _vt tables, creating them if needed.
+func (vde *Engine) execQuery(query string) (*sqltypes.Result, error) {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+	return withDDL.Exec(vde.ctx, query, dbClient.ExecuteFetch)
+}
+
+func qualifiedTableName(name string) string {
+	return fmt.Sprintf("%s.%s", vexec.TableQualifier, name)
+}
+
+func joinIDs(ids []int64) string {
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, fmt.Sprintf("%d", id))
+	}
+	return strings.Join(strs, ", ")
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var testTablet = &topodatapb.Tablet{
+	Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+	Keyspace: "ks",
+	Shard:    "-80",
+	Type:     topodatapb.TabletType_MASTER,
+}
+
+func newTestEngine(t *testing.T) (*Engine, *binlogplayer.MockDBClient) {
+	t.Helper()
+
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClientFactory := func() binlogplayer.DBClient { return dbClient }
+	vde := NewTestEngine(memorytopo.NewServer("zone1"), nil, nil, nil, dbClientFactory, "vt_ks")
+
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options from _vt.vdiff where db_name = 'vt_ks' and state in ('pending', 'started')", &sqltypes.Result{}, nil)
+	vde.Open(context.Background(), testTablet)
+	dbClient.Wait()
+	require.True(t, vde.IsOpen())
+
+	return vde, dbClient
+}
+
+func vexecQuery(t *testing.T, vde *Engine, query string) (*sqltypes.Result, error) {
+	t.Helper()
+
+	vx := vexec.NewTabletVExec("wf", "ks")
+	require.NoError(t, vx.AnalyzeQuery(context.Background(), query))
+	qr, err := vde.VExec(context.Background(), vx)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(qr), nil
+}
+
+func TestEngineVExecCreate(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+	defer vde.Close()
+
+	dbClient.ExpectRequest("insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, options, shard, db_name, state) values ('u1', 'wf', 'ks', '{}', '-80', 'vt_ks', 'pending')", &sqltypes.Result{InsertID: 1}, nil)
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options from _vt.vdiff where id = 1", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|vdiff_uuid|workflow|keyspace|shard|db_name|state|options",
+			"int64|varchar|varbinary|varbinary|varchar|varbinary|varbinary|json",
+		),
+		"1|u1|wf|ks|-80|vt_ks|pending|{}",
+	), nil)
+	// The controller fails right away, and records its error.
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'started', started_at = ifnull(started_at, utc_timestamp()), last_error = '' where id = 1", nil, errors.New("mock error"))
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'error', last_error = 'mock error' where id = 1", &sqltypes.Result{}, nil)
+
+	qr, err := vexecQuery(t, vde, "insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, options) values ('u1', 'wf', 'ks', '{}')")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), qr.InsertID)
+	dbClient.Wait()
+
+	_, err = vexecQuery(t, vde, "insert into _vt.vdiff(workflow) values ('wf')")
+	assert.EqualError(t, err, "vdiff_uuid and workflow are required to create a vdiff: insert into _vt.vdiff(workflow) values ('wf')")
+}
+
+func TestEngineVExecStopResumeDelete(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+	defer vde.Close()
+
+	idResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+
+	dbClient.ExpectRequest("select id from _vt.vdiff where db_name = 'vt_ks' and (vdiff_uuid = 'u1' and db_name = 'vt_ks' and workflow = 'wf') and state in ('pending', 'started')", idResult, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'stopped' where id in (1, 2)", &sqltypes.Result{RowsAffected: 2}, nil)
+	qr, err := vexecQuery(t, vde, "update _vt.vdiff set state = 'stopped' where vdiff_uuid = 'u1' and db_name = 'vt_ks' and workflow = 'wf'")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), qr.RowsAffected)
+	dbClient.Wait()
+
+	// Nothing to resume.
+	dbClient.ExpectRequest("select id from _vt.vdiff where db_name = 'vt_ks' and (vdiff_uuid = 'u1') and state in ('stopped', 'error')", &sqltypes.Result{}, nil)
+	_, err = vexecQuery(t, vde, "update _vt.vdiff set state = 'pending' where vdiff_uuid = 'u1'")
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	dbClient.ExpectRequest("select id from _vt.vdiff where db_name = 'vt_ks' and (workflow = 'wf')", idResult, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff_table where vdiff_id in (1, 2)", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff where id in (1, 2)", &sqltypes.Result{RowsAffected: 2}, nil)
+	_, err = vexecQuery(t, vde, "delete from _vt.vdiff where workflow = 'wf'")
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	_, err = vexecQuery(t, vde, "update _vt.vdiff set state = 'completed' where vdiff_uuid = 'u1'")
+	assert.EqualError(t, err, "unsupported vdiff state completed, only stopped and pending are supported")
+
+	_, err = vexecQuery(t, vde, "update _vt.vdiff set workflow = 'wf2' where vdiff_uuid = 'u1'")
+	assert.EqualError(t, err, "only the state of a vdiff can be updated: update _vt.vdiff set workflow = 'wf2' where vdiff_uuid = 'u1'")
+
+	_, err = vexecQuery(t, vde, "delete from _vt.vdiff_table")
+	assert.EqualError(t, err, "DELETE is not supported on _vt.vdiff_table")
+}
+
+func TestEngineVExecClosed(t *testing.T) {
+	vde, _ := newTestEngine(t)
+	vde.Close()
+	assert.False(t, vde.IsOpen())
+
+	_, err := vexecQuery(t, vde, "select * from _vt.vdiff")
+	assert.EqualError(t, err, "vdiff engine is closed, tablet is probably not a primary")
+}
@@ -0,0 +1,525 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// lookupPlan is the part of a tableDiffer which compares the table of a
+// lookup vindex with its owner table. Such a table is backfilled by a
+// workflow with a filter like:
+//
+//	select name as name, keyspace_id() as keyspace_id from t1 where in_keyrange(name, 'ks.hash', '-80') group by name, keyspace_id
+//
+// Neither keyspace_id() nor in_keyrange can be evaluated by MySQL, so the
+// rows of the owner table are streamed with VStreamResults, along with the
+// columns needed to compute them. The keyspace ids are then computed and
+// filtered here, and the rows are deduplicated like the group by of the
+// filter does. Both sides are ordered by the binary value of the "from"
+// columns, so that they merge and compare in the same order.
+type lookupPlan struct {
+	ownerTable sqlparser.TableIdent
+	// fromExprs are the source expressions of the "from" columns, in select
+	// order, and targetExprs the target ones of all the columns.
+	fromExprs   []sqlparser.Expr
+	targetExprs []sqlparser.SelectExpr
+	where       sqlparser.Expr
+	// extraCols are selected on the source after the "from" columns.
+	extraCols []sqlparser.ColIdent
+
+	// toCol is the index of the keyspace_id() column in the select list.
+	toCol int
+	// ownerVindex maps the ownerCols of a source row to its keyspace id.
+	ownerVindex vindexes.Vindex
+	ownerCols   []int
+	// keyRange, if set, skips the source rows which do not belong to this
+	// shard. They are mapped with keyRangeVindex on keyRangeCols, or with
+	// their keyspace id if keyRangeVindex is nil.
+	keyRange       *topodatapb.KeyRange
+	keyRangeVindex vindexes.Vindex
+	keyRangeCols   []int
+}
+
+// isLookupFilter returns true if the query of a vreplication rule
+// computes a keyspace_id(), which is how lookup vindexes are backfilled.
+func isLookupFilter(query string) bool {
+	return strings.Contains(strings.ToLower(query), "keyspace_id()")
+}
+
+// buildLookupPlan builds the tableDiffer of the table of a lookup vindex.
+// sourceKeyspace is the keyspace of the owner table, whose primary vindex
+// computes the keyspace ids.
+func buildLookupPlan(table *tabletmanagerdatapb.TableDefinition, query string, vschema *vindexes.VSchema, sourceKeyspace string) (*tableDiffer, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok || len(sel.From) != 1 {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	from, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	lp := &lookupPlan{
+		ownerTable: sqlparser.GetTableName(from.Expr),
+		toCol:      -1,
+	}
+	if lp.ownerTable.IsEmpty() {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	ownerTable, err := vschema.FindTable(sourceKeyspace, lp.ownerTable.String())
+	if err != nil {
+		return nil, err
+	}
+	cv, err := vindexes.FindBestColVindex(ownerTable)
+	if err != nil {
+		return nil, err
+	}
+	if cv.Vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("unsupported: primary vindex %s of table %s needs a vcursor", cv.Name, lp.ownerTable.String())
+	}
+	lp.ownerVindex = cv.Vindex
+
+	fields := make(map[string]*querypb.Field)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = field
+	}
+	td := &tableDiffer{
+		table:  table.Name,
+		lookup: lp,
+	}
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: %v", sqlparser.String(selExpr))
+		}
+		var col sqlparser.ColIdent
+		switch expr := aliased.Expr.(type) {
+		case *sqlparser.ColName:
+			col = expr.Name
+			if !aliased.As.IsEmpty() {
+				col = aliased.As
+			}
+			lp.fromExprs = append(lp.fromExprs, binaryExpr(expr, fields[col.Lowered()]))
+		case *sqlparser.FuncExpr:
+			if !expr.Name.EqualString("keyspace_id") || lp.toCol != -1 {
+				return nil, fmt.Errorf("unsupported: expression %v cannot be compared", sqlparser.String(selExpr))
+			}
+			col = sqlparser.NewColIdent("keyspace_id")
+			if !aliased.As.IsEmpty() {
+				col = aliased.As
+			}
+			lp.toCol = len(td.columns)
+		default:
+			return nil, fmt.Errorf("unsupported: expression %v cannot be compared", sqlparser.String(selExpr))
+		}
+		field := fields[col.Lowered()]
+		if field == nil {
+			return nil, fmt.Errorf("column %v not found in table %v", col.String(), table.Name)
+		}
+		targetExpr := &sqlparser.AliasedExpr{Expr: binaryExpr(&sqlparser.ColName{Name: col}, field)}
+		if _, ok := targetExpr.Expr.(*sqlparser.ConvertUsingExpr); ok {
+			targetExpr.As = col
+		}
+		lp.targetExprs = append(lp.targetExprs, targetExpr)
+		td.compareCols = append(td.compareCols, compareColInfo{colIndex: len(td.columns)})
+		td.columns = append(td.columns, col.String())
+	}
+	if lp.toCol == -1 || len(lp.fromExprs) == 0 {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+
+	// The rows are ordered by the "from" columns on both sides, so they
+	// must come first in the primary key of the lookup table.
+	if len(table.PrimaryKeyColumns) == 0 {
+		return nil, fmt.Errorf("table %v has no primary key", table.Name)
+	}
+	fromIndex := 0
+	for i, pk := range table.PrimaryKeyColumns {
+		colIndex := -1
+		for j, col := range td.columns {
+			if strings.EqualFold(pk, col) {
+				colIndex = j
+				break
+			}
+		}
+		switch {
+		case colIndex == -1:
+			return nil, fmt.Errorf("primary key column %v of table %v is not part of the vreplication filter", pk, table.Name)
+		case colIndex == lp.toCol:
+			if i != len(table.PrimaryKeyColumns)-1 {
+				return nil, fmt.Errorf("unsupported: column %v is not the last one of the primary key of table %v", pk, table.Name)
+			}
+		default:
+			if colIndex != lp.fromIndices()[fromIndex] {
+				return nil, fmt.Errorf("unsupported: the primary key of table %v does not start with the lookup columns of the vreplication filter", table.Name)
+			}
+			fromIndex++
+		}
+		td.compareCols[colIndex].isPK = true
+		td.comparePKs = append(td.comparePKs, td.compareCols[colIndex])
+		td.pkCols = append(td.pkCols, colIndex)
+	}
+	if fromIndex != len(lp.fromExprs) {
+		return nil, fmt.Errorf("unsupported: the primary key of table %v does not start with the lookup columns of the vreplication filter", table.Name)
+	}
+
+	for _, col := range cv.Columns {
+		lp.ownerCols = append(lp.ownerCols, lp.addExtraCol(col))
+	}
+	if sel.Where != nil {
+		var conds []sqlparser.Expr
+		for _, expr := range sqlparser.SplitAndExpression(nil, sel.Where.Expr) {
+			funcExpr, ok := expr.(*sqlparser.FuncExpr)
+			if !ok || !funcExpr.Name.EqualString("in_keyrange") {
+				conds = append(conds, expr)
+				continue
+			}
+			if err := lp.analyzeInKeyRange(vschema, funcExpr); err != nil {
+				return nil, err
+			}
+		}
+		lp.where = sqlparser.AndExpressions(conds...)
+	}
+	return td, nil
+}
+
+// binaryExpr converts the text columns to binary, which makes them sort
+// and compare like the rows of the tableDiffer.
+func binaryExpr(col *sqlparser.ColName, field *querypb.Field) sqlparser.Expr {
+	if field == nil || !sqltypes.IsText(field.Type) {
+		return col
+	}
+	return &sqlparser.ConvertUsingExpr{Expr: col, Type: "binary"}
+}
+
+// fromIndices returns the indices of the "from" columns in the select list.
+func (lp *lookupPlan) fromIndices() []int {
+	indices := make([]int, 0, len(lp.fromExprs))
+	for i := 0; i <= len(lp.fromExprs); i++ {
+		if i != lp.toCol {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// addExtraCol adds a column of the owner table to the source select list
+// if needed, and returns its index.
+func (lp *lookupPlan) addExtraCol(col sqlparser.ColIdent) int {
+	for i, extra := range lp.extraCols {
+		if extra.Equal(col) {
+			return len(lp.fromExprs) + i
+		}
+	}
+	lp.extraCols = append(lp.extraCols, col)
+	return len(lp.fromExprs) + len(lp.extraCols) - 1
+}
+
+// analyzeInKeyRange supports the same forms of in_keyrange as the vstreamer:
+// "in_keyrange('-80')", and "in_keyrange(col, 'ks.vindex', '-80')".
+func (lp *lookupPlan) analyzeInKeyRange(vschema *vindexes.VSchema, funcExpr *sqlparser.FuncExpr) error {
+	exprs := funcExpr.Exprs
+	if len(exprs) != 1 && len(exprs) < 3 {
+		return fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(funcExpr))
+	}
+	if len(exprs) >= 3 {
+		for _, expr := range exprs[:len(exprs)-2] {
+			aliased, ok := expr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(funcExpr))
+			}
+			col, ok := aliased.Expr.(*sqlparser.ColName)
+			if !ok || !col.Qualifier.IsEmpty() {
+				return fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(funcExpr))
+			}
+			lp.keyRangeCols = append(lp.keyRangeCols, lp.addExtraCol(col.Name))
+		}
+		name, err := literalString(exprs[len(exprs)-2])
+		if err != nil {
+			return err
+		}
+		keyspace := ""
+		if i := strings.Index(name, "."); i != -1 {
+			keyspace, name = name[:i], name[i+1:]
+		}
+		if lp.keyRangeVindex, err = vschema.FindVindex(keyspace, name); err != nil {
+			return err
+		}
+		if lp.keyRangeVindex == nil {
+			if keyspace != "" {
+				return fmt.Errorf("vindex %v.%v not found", keyspace, name)
+			}
+			if lp.keyRangeVindex, err = vindexes.CreateVindex(name, name, map[string]string{}); err != nil {
+				return err
+			}
+		}
+		if lp.keyRangeVindex.NeedsVCursor() {
+			return fmt.Errorf("unsupported: vindex %v of %v needs a vcursor", name, sqlparser.String(funcExpr))
+		}
+	}
+	kr, err := literalString(exprs[len(exprs)-1])
+	if err != nil {
+		return err
+	}
+	keyranges, err := key.ParseShardingSpec(kr)
+	if err != nil {
+		return err
+	}
+	if len(keyranges) != 1 {
+		return fmt.Errorf("unexpected in_keyrange parameter: %v", kr)
+	}
+	lp.keyRange = keyranges[0]
+	return nil
+}
+
+func literalString(expr sqlparser.SelectExpr) (string, error) {
+	aliased, ok := expr.(*sqlparser.AliasedExpr)
+	if !ok {
+		return "", fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+	}
+	val, ok := aliased.Expr.(*sqlparser.Literal)
+	if !ok {
+		return "", fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+	}
+	return string(val.Val), nil
+}
+
+// sourceQuery returns the VStreamResults query of the owner table. It
+// starts after the "from" values of lastpk, if set.
+func (lp *lookupPlan) sourceQuery(lastpk []sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, expr := range lp.fromExprs {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", expr)
+	}
+	for _, col := range lp.extraCols {
+		buf.Myprintf(", %v", col)
+	}
+	buf.Myprintf(" from %v", lp.ownerTable)
+	lp.formatWhere(buf, lp.where, lp.fromExprs, lastpk)
+	lp.formatOrderBy(buf, lp.fromExprs)
+	return buf.String()
+}
+
+// targetQuery returns the VStreamResults query of the lookup table.
+func (lp *lookupPlan) targetQuery(table string, lastpk []sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %v from %v", sqlparser.SelectExprs(lp.targetExprs), sqlparser.NewTableIdent(table))
+	var fromExprs []sqlparser.Expr
+	for _, i := range lp.fromIndices() {
+		expr := lp.targetExprs[i].(*sqlparser.AliasedExpr).Expr
+		fromExprs = append(fromExprs, expr)
+	}
+	lp.formatWhere(buf, nil, fromExprs, lastpk)
+	lp.formatOrderBy(buf, fromExprs)
+	return buf.String()
+}
+
+func (lp *lookupPlan) formatWhere(buf *sqlparser.TrackedBuffer, where sqlparser.Expr, fromExprs []sqlparser.Expr, lastpk []sqltypes.Value) {
+	if where == nil && lastpk == nil {
+		return
+	}
+	buf.Myprintf(" where ")
+	if where != nil {
+		buf.Myprintf("%v", where)
+		if lastpk == nil {
+			return
+		}
+		buf.Myprintf(" and ")
+	}
+	// Only the "from" values of lastpk are used: the rows of the source
+	// are grouped by them.
+	if len(fromExprs) > 1 {
+		buf.Myprintf("(")
+	}
+	for i, expr := range fromExprs {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", expr)
+	}
+	if len(fromExprs) > 1 {
+		buf.Myprintf(") > (")
+	} else {
+		buf.Myprintf(" > ")
+	}
+	for i := range fromExprs {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		lastpk[i].EncodeSQL(buf)
+	}
+	if len(fromExprs) > 1 {
+		buf.Myprintf(")")
+	}
+}
+
+func (lp *lookupPlan) formatOrderBy(buf *sqlparser.TrackedBuffer, fromExprs []sqlparser.Expr) {
+	buf.Myprintf(" order by ")
+	for i, expr := range fromExprs {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", expr)
+	}
+}
+
+//-----------------------------------------------------------------
+// lookupRowMapper
+
+// lookupRowMapper maps the rows streamed from the owner table to rows of
+// the lookup table. The rows with the same "from" values are held until
+// they are all received, so that they can be sorted by keyspace id and
+// deduplicated.
+type lookupRowMapper struct {
+	lp *lookupPlan
+	// sourceFields are the fields of the rows streamed from the owner table.
+	sourceFields []*querypb.Field
+	group        [][]sqltypes.Value
+}
+
+func (lp *lookupPlan) newRowMapper() *lookupRowMapper {
+	return &lookupRowMapper{lp: lp}
+}
+
+// mapFields returns the fields of the lookup table, from the fields of the
+// owner table.
+func (lm *lookupRowMapper) mapFields(fields []*querypb.Field) []*querypb.Field {
+	lm.sourceFields = fields
+	mapped := make([]*querypb.Field, 0, len(lm.lp.fromExprs)+1)
+	for i, j := 0, 0; i <= len(lm.lp.fromExprs); i++ {
+		if i == lm.lp.toCol {
+			mapped = append(mapped, &querypb.Field{Name: "keyspace_id", Type: sqltypes.VarBinary})
+			continue
+		}
+		mapped = append(mapped, fields[j])
+		j++
+	}
+	return mapped
+}
+
+// mapRows returns the rows of the lookup table which are complete.
+func (lm *lookupRowMapper) mapRows(rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var mapped [][]sqltypes.Value
+	for _, row := range rows {
+		ksid, err := keyspaceID(lm.lp.ownerVindex, row, lm.lp.ownerCols)
+		if err != nil {
+			return nil, err
+		}
+		if lm.lp.keyRange != nil {
+			krid := ksid
+			if lm.lp.keyRangeVindex != nil {
+				if krid, err = keyspaceID(lm.lp.keyRangeVindex, row, lm.lp.keyRangeCols); err != nil {
+					return nil, err
+				}
+			}
+			if !key.KeyRangeContains(lm.lp.keyRange, krid) {
+				continue
+			}
+		}
+		lookupRow := make([]sqltypes.Value, 0, len(lm.lp.fromExprs)+1)
+		for i, j := 0, 0; i <= len(lm.lp.fromExprs); i++ {
+			if i == lm.lp.toCol {
+				lookupRow = append(lookupRow, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid))
+				continue
+			}
+			lookupRow = append(lookupRow, row[j])
+			j++
+		}
+		if len(lm.group) != 0 {
+			c, err := lm.compareFrom(lm.group[0], lookupRow)
+			if err != nil {
+				return nil, err
+			}
+			if c != 0 {
+				mapped = append(mapped, lm.flush()...)
+			}
+		}
+		lm.group = append(lm.group, lookupRow)
+	}
+	return mapped, nil
+}
+
+// flush returns the rows of the current group, sorted by keyspace id and
+// without duplicates.
+func (lm *lookupRowMapper) flush() [][]sqltypes.Value {
+	group := lm.group
+	lm.group = nil
+	toCol := lm.lp.toCol
+	sort.SliceStable(group, func(i, j int) bool {
+		return bytes.Compare(group[i][toCol].Raw(), group[j][toCol].Raw()) < 0
+	})
+	var rows [][]sqltypes.Value
+	for _, row := range group {
+		if len(rows) != 0 && bytes.Equal(rows[len(rows)-1][toCol].Raw(), row[toCol].Raw()) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (lm *lookupRowMapper) compareFrom(row1, row2 []sqltypes.Value) (int, error) {
+	for _, i := range lm.lp.fromIndices() {
+		c, err := evalengine.NullsafeCompare(row1[i], row2[i])
+		if err != nil || c != 0 {
+			return c, err
+		}
+	}
+	return 0, nil
+}
+
+// keyspaceID maps the columns of a row to a keyspace id, like the vstreamer
+// does for keyspace_id() and in_keyrange.
+func keyspaceID(vindex vindexes.Vindex, row []sqltypes.Value, cols []int) ([]byte, error) {
+	values := make([]sqltypes.Value, 0, len(cols))
+	for _, col := range cols {
+		values = append(values, row[col])
+	}
+	destinations, err := vindexes.Map(vindex, nil, [][]sqltypes.Value{values})
+	if err != nil {
+		return nil, err
+	}
+	if len(destinations) != 1 {
+		return nil, fmt.Errorf("mapping row to keyspace id returned an invalid array of destinations: %v", key.DestinationsString(destinations))
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok || len(ksid) == 0 {
+		return nil, fmt.Errorf("could not map %v to a keyspace id, got destination %v", values, destinations[0])
+	}
+	return ksid, nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+var testLookupTableDef = &tabletmanagerdatapb.TableDefinition{
+	Name:              "lkp",
+	Columns:           []string{"name", "keyspace_id"},
+	PrimaryKeyColumns: []string{"name"},
+	Fields:            sqltypes.MakeTestFields("name|keyspace_id", "varchar|varbinary"),
+}
+
+var testLookupVSchema = vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+	Keyspaces: map[string]*vschemapb.Keyspace{
+		"ks1": {
+			Sharded:  true,
+			Vindexes: map[string]*vschemapb.Vindex{"hash": {Type: "hash"}},
+			Tables: map[string]*vschemapb.Table{
+				"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}}},
+			},
+		},
+		"ks2": {
+			Sharded:  true,
+			Vindexes: map[string]*vschemapb.Vindex{"unicode_loose_md5": {Type: "unicode_loose_md5"}},
+			Tables: map[string]*vschemapb.Table{
+				"lkp": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "name", Name: "unicode_loose_md5"}}},
+			},
+		},
+	},
+})
+
+func TestBuildLookupPlan(t *testing.T) {
+	testcases := []struct {
+		query             string
+		sourceQuery       string
+		sourceQueryLastPK string
+		targetQuery       string
+		columns           []string
+		keyRangeCols      []int
+		err               string
+	}{{
+		query:             "select name as name, keyspace_id() as keyspace_id from t1 group by name, keyspace_id",
+		sourceQuery:       "select convert(`name` using binary), id from t1 order by convert(`name` using binary)",
+		sourceQueryLastPK: "select convert(`name` using binary), id from t1 where convert(`name` using binary) > 'b' order by convert(`name` using binary)",
+		targetQuery:       "select convert(`name` using binary) as `name`, keyspace_id from lkp order by convert(`name` using binary)",
+		columns:           []string{"name", "keyspace_id"},
+	}, {
+		query:             "select c1 as name, keyspace_id() as keyspace_id from t1 where c2 = 1 and in_keyrange(c1, 'ks2.unicode_loose_md5', '-80')",
+		sourceQuery:       "select convert(c1 using binary), id, c1 from t1 where c2 = 1 order by convert(c1 using binary)",
+		sourceQueryLastPK: "select convert(c1 using binary), id, c1 from t1 where c2 = 1 and convert(c1 using binary) > 'b' order by convert(c1 using binary)",
+		targetQuery:       "select convert(`name` using binary) as `name`, keyspace_id from lkp order by convert(`name` using binary)",
+		columns:           []string{"name", "keyspace_id"},
+		keyRangeCols:      []int{2},
+	}, {
+		query: "select name as name, keyspace_id() as keyspace_id from t2",
+		err:   "table t2 not found",
+	}, {
+		query: "select name as name, keyspace_id() as ksid from t1",
+		err:   "column ksid not found in table lkp",
+	}, {
+		query: "select name as name, keyspace_id() as keyspace_id from t1 where in_keyrange(name, 'ks2.xxhash', '-80')",
+		err:   "vindex ks2.xxhash not found",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			td, err := buildLookupPlan(testLookupTableDef, tcase.query, testLookupVSchema, "ks1")
+			if tcase.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.sourceQuery, td.lookup.sourceQuery(nil))
+			assert.Equal(t, tcase.sourceQueryLastPK, td.lookup.sourceQuery([]sqltypes.Value{sqltypes.NewVarBinary("b")}))
+			assert.Equal(t, tcase.targetQuery, td.lookup.targetQuery(td.table, nil))
+			assert.Equal(t, tcase.columns, td.columns)
+			assert.Equal(t, []int{0}, td.pkCols)
+			assert.Equal(t, tcase.keyRangeCols, td.lookup.keyRangeCols)
+		})
+	}
+}
+
+// ksid returns the keyspace id of id in the hash vindex.
+func ksid(t *testing.T, id string) sqltypes.Value {
+	b, err := hex.DecodeString(map[string]string{
+		"1": "166b40b44aba4bd6",
+		"2": "06e7ea22ce92708f",
+	}[id])
+	require.NoError(t, err)
+	return sqltypes.MakeTrusted(sqltypes.VarBinary, b)
+}
+
+func TestLookupRowMapper(t *testing.T) {
+	td, err := buildLookupPlan(testLookupTableDef, "select name as name, keyspace_id() as keyspace_id from t1 where in_keyrange('-80')", testLookupVSchema, "ks1")
+	require.NoError(t, err)
+
+	lm := td.lookup.newRowMapper()
+	sourceFields := sqltypes.MakeTestFields("name|id", "varbinary|int64")
+	fields := lm.mapFields(sourceFields)
+	assert.Equal(t, []*querypb.Field{sourceFields[0], {Name: "keyspace_id", Type: sqltypes.VarBinary}}, fields)
+	rows := sqltypes.MakeTestResult(lm.sourceFields, "a|1", "a|1", "b|2", "b|1", "c|4", "d|2").Rows
+
+	// The rows of a group are held until the next group starts, even across
+	// results. Duplicates and rows out of the key range are dropped.
+	mapped, err := lm.mapRows(rows[:3])
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{rows[0][0], ksid(t, "1")}}, mapped)
+	mapped, err = lm.mapRows(rows[3:])
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{rows[2][0], ksid(t, "2")}, {rows[2][0], ksid(t, "1")}}, mapped)
+	assert.Equal(t, [][]sqltypes.Value{{rows[5][0], ksid(t, "2")}}, lm.flush())
+	assert.Nil(t, lm.flush())
+}
+
+func TestLookupDiff(t *testing.T) {
+	td, err := buildLookupPlan(testLookupTableDef, "select name as name, keyspace_id() as keyspace_id from t1", testLookupVSchema, "ks1")
+	require.NoError(t, err)
+
+	fields := sqltypes.MakeTestFields("name|keyspace_id", "varbinary|varbinary")
+	newStreamer := func(rows ...[]sqltypes.Value) *shardStreamer {
+		sm := &shardStreamer{fields: fields, result: make(chan *sqltypes.Result, 2)}
+		sm.result <- &sqltypes.Result{Fields: fields}
+		sm.result <- &sqltypes.Result{Rows: rows}
+		close(sm.result)
+		return sm
+	}
+	name := sqltypes.NewVarBinary
+	sources := []*shardStreamer{
+		newStreamer([]sqltypes.Value{name("a"), ksid(t, "1")}, []sqltypes.Value{name("c"), ksid(t, "1")}),
+		newStreamer([]sqltypes.Value{name("b"), ksid(t, "2")}),
+	}
+	target := newStreamer([]sqltypes.Value{name("a"), ksid(t, "1")}, []sqltypes.Value{name("b"), ksid(t, "1")})
+
+	dr := &tabletmanagerdatapb.VDiffReport{TableName: "lkp"}
+	lastpk, err := td.diff(context.Background(), td.newMergeSorter(sources), td.newMergeSorter([]*shardStreamer{target}), dr, &tabletmanagerdatapb.VDiffOptions{OnlyPks: true}, func([]sqltypes.Value) error {
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{name("c")}, lastpk)
+	assert.EqualValues(t, 3, dr.ProcessedRows)
+	assert.EqualValues(t, 1, dr.MatchingRows)
+	assert.EqualValues(t, 1, dr.MismatchedRows)
+	assert.EqualValues(t, 1, dr.ExtraRowsSource)
+	assert.Equal(t, map[string]string{"name": "'b'"}, dr.MismatchedRowsSample[0].Source.Row)
+}
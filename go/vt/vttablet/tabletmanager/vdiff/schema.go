@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+const (
+	// VDiffTableName is the name of the table listing the vdiffs of this tablet.
+	VDiffTableName = "vdiff"
+	// VDiffTableTableName is the name of the table holding the progress and
+	// the results of each table of each vdiff.
+	VDiffTableTableName = "vdiff_table"
+
+	sqlCreateVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint(20) unsigned not null auto_increment,
+  vdiff_uuid varchar(64) not null,
+  workflow varbinary(1024) not null,
+  keyspace varbinary(256) not null,
+  shard varchar(255) not null,
+  db_name varbinary(1024) not null,
+  state varbinary(64) not null,
+  options json,
+  created_at timestamp not null default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  last_error varbinary(512) not null default '',
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key state_idx (state),
+  key workflow_idx (db_name(64), workflow(64))
+) engine=InnoDB`
+
+	sqlCreateVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint(20) unsigned not null,
+  table_name varbinary(128) not null,
+  state varbinary(64) not null,
+  lastpk varbinary(2000),
+  rows_compared bigint not null default 0,
+  mismatch bool not null default false,
+  report json,
+  created_at timestamp not null default current_timestamp,
+  updated_at timestamp not null default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name)
+) engine=InnoDB`
+
+	sqlGetVDiffsToRun    = "select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options from _vt.vdiff where db_name = %s and state in ('pending', 'started')"
+	sqlGetVDiffByID      = "select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options from _vt.vdiff where id = %d"
+	sqlGetVDiffIDsWhere  = "select id from _vt.vdiff where %s"
+	sqlStartVDiff        = "update _vt.vdiff set state = 'started', started_at = ifnull(started_at, utc_timestamp()), last_error = '' where id = %d"
+	sqlCompleteVDiff     = "update _vt.vdiff set state = 'completed', completed_at = utc_timestamp() where id = %d"
+	sqlFailVDiff         = "update _vt.vdiff set state = 'error', last_error = %s where id = %d"
+	sqlDeleteVDiffTables = "delete from _vt.vdiff_table where vdiff_id in (%s)"
+
+	sqlGetVDiffTables      = "select table_name, state, lastpk, rows_compared, report from _vt.vdiff_table where vdiff_id = %d"
+	sqlInitVDiffTable      = "insert ignore into _vt.vdiff_table(vdiff_id, table_name, state) values (%d, %s, 'pending')"
+	sqlUpdateTableState    = "update _vt.vdiff_table set state = %s where vdiff_id = %d and table_name = %s"
+	sqlUpdateTableProgress = "update _vt.vdiff_table set state = %a, lastpk = %a, rows_compared = %a, mismatch = %a, report = %a " +
+		"where vdiff_id = %a and table_name = %a"
+
+	sqlGetWorkflowStreams     = "select id, source, pos, state from _vt.vreplication where db_name = %s and workflow = %s"
+	sqlStopWorkflowStreams    = "update _vt.vreplication set state = 'Stopped', message = 'for vdiff' where id in (%s)"
+	sqlSyncWorkflowStream     = "update _vt.vreplication set state = 'Running', stop_pos = %s, message = 'synchronizing for vdiff' where id = %d"
+	sqlRestartWorkflowStreams = "update _vt.vreplication set state = 'Running', message = '', stop_pos = '' where id in (%s)"
+)
+
+// The states of a vdiff, and of each of its tables.
+const (
+	PendingState   = "pending"
+	StartedState   = "started"
+	StoppedState   = "stopped"
+	CompletedState = "completed"
+	ErrorState     = "error"
+)
@@ -0,0 +1,419 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// maxSamples is the maximum number of rows kept in each sample of a report.
+const maxSamples = 10
+
+// checkpointInterval is the number of rows compared between two saves of the
+// progress of a table.
+var checkpointInterval int64 = 10000
+
+// compareColInfo contains the metadata for a column of the table being diffed.
+type compareColInfo struct {
+	colIndex int  // index of the column in the select list
+	isPK     bool // is this column part of the primary key
+}
+
+// tableDiffer compares the rows of one table of the workflow.
+//
+// Rows are streamed with VStreamRows, which orders them by primary key and
+// does not support expressions. Text columns are therefore compared by their
+// binary value, and may report differences for values which are equal in the
+// collation of the column.
+type tableDiffer struct {
+	table string
+	// sourceQuery and targetQuery are the VStreamRows queries.
+	sourceQuery string
+	targetQuery string
+
+	// columns are the names of the target columns, in select order.
+	columns []string
+	// compareCols is the list of all columns to compare.
+	compareCols []compareColInfo
+	// comparePKs is the list of pk columns to compare, in pk order.
+	comparePKs []compareColInfo
+	// pkCols has the indices of the pk columns in the select list, in pk order.
+	pkCols []int
+
+	// lookup is set if the table is the table of a lookup vindex, which is
+	// compared with its owner table. sourceQuery and targetQuery are then
+	// unused.
+	lookup *lookupPlan
+}
+
+// buildTablePlan builds the tableDiffer of a table, based on the filter
+// query of the vreplication rule which matched it.
+func buildTablePlan(table *tabletmanagerdatapb.TableDefinition, query string) (*tableDiffer, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	if len(sel.GroupBy) != 0 {
+		return nil, fmt.Errorf("unsupported: group by in %v", sqlparser.String(sel))
+	}
+	td := &tableDiffer{
+		table: table.Name,
+	}
+	sourceSelect := &sqlparser.Select{}
+	targetSelect := &sqlparser.Select{}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			// If it's a '*' expression, expand column list from the schema.
+			for _, fld := range table.Fields {
+				aliased := &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, aliased)
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, aliased)
+			}
+		case *sqlparser.AliasedExpr:
+			sourceCol, ok := selExpr.Expr.(*sqlparser.ColName)
+			if !ok {
+				return nil, fmt.Errorf("unsupported: expression %v cannot be compared", sqlparser.String(selExpr))
+			}
+			targetCol := sourceCol
+			if !selExpr.As.IsEmpty() {
+				targetCol = &sqlparser.ColName{Name: selExpr.As}
+			}
+			// If the input was "select a as b", then source will use "a" and target will use "b".
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+		default:
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+		}
+	}
+
+	fields := make(map[string]bool)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = true
+	}
+	td.compareCols = make([]compareColInfo, len(targetSelect.SelectExprs))
+	for i := range td.compareCols {
+		col := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name
+		if !fields[col.Lowered()] {
+			return nil, fmt.Errorf("column %v not found in table %v", col.String(), table.Name)
+		}
+		td.compareCols[i].colIndex = i
+		td.columns = append(td.columns, col.String())
+	}
+	if len(table.PrimaryKeyColumns) == 0 {
+		return nil, fmt.Errorf("table %v has no primary key", table.Name)
+	}
+	for _, pk := range table.PrimaryKeyColumns {
+		found := false
+		for i, col := range td.columns {
+			if strings.EqualFold(pk, col) {
+				td.compareCols[i].isPK = true
+				td.comparePKs = append(td.comparePKs, td.compareCols[i])
+				td.pkCols = append(td.pkCols, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("primary key column %v of table %v is not part of the vreplication filter", pk, table.Name)
+		}
+	}
+
+	sourceSelect.From = sel.From
+	sourceSelect.Where = sel.Where
+	// The target table name should the one that matched the rule.
+	// It can be different from the source table.
+	targetSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{
+			Expr: &sqlparser.TableName{
+				Name: sqlparser.NewTableIdent(table.Name),
+			},
+		},
+	}
+	td.sourceQuery = sqlparser.String(sourceSelect)
+	td.targetQuery = sqlparser.String(targetSelect)
+	return td, nil
+}
+
+// newMergeSorter creates an engine.MergeSort which merges the rows of the
+// given streams in pk order.
+func (td *tableDiffer) newMergeSorter(streamers []*shardStreamer) *engine.MergeSort {
+	prims := make([]engine.StreamExecutor, 0, len(streamers))
+	for _, streamer := range streamers {
+		prims = append(prims, streamer)
+	}
+	ob := make([]engine.OrderbyParams, 0, len(td.comparePKs))
+	for _, cpk := range td.comparePKs {
+		ob = append(ob, engine.OrderbyParams{Col: cpk.colIndex, WeightStringCol: -1})
+	}
+	return &engine.MergeSort{
+		Primitives: prims,
+		OrderBy:    ob,
+	}
+}
+
+// diff compares the rows of source and target, and adds the results to dr.
+// It stops after opts.MaxRows rows if set. The primary key of the last
+// compared row is returned, along with any error. checkpoint is called with
+// that primary key every checkpointInterval rows.
+func (td *tableDiffer) diff(ctx context.Context, source, target engine.Primitive, dr *tabletmanagerdatapb.VDiffReport, opts *tabletmanagerdatapb.VDiffOptions, checkpoint func(lastpk []sqltypes.Value) error) ([]sqltypes.Value, error) {
+	sourceExecutor := newPrimitiveExecutor(ctx, source)
+	targetExecutor := newPrimitiveExecutor(ctx, target)
+	var sourceRow, targetRow, lastpk []sqltypes.Value
+	var err error
+	advanceSource := true
+	advanceTarget := true
+	for {
+		if opts.MaxRows > 0 && dr.ProcessedRows >= opts.MaxRows {
+			return lastpk, nil
+		}
+		if advanceSource {
+			if sourceRow, err = sourceExecutor.next(); err != nil {
+				return lastpk, err
+			}
+		}
+		if advanceTarget {
+			if targetRow, err = targetExecutor.next(); err != nil {
+				return lastpk, err
+			}
+		}
+		if sourceRow == nil && targetRow == nil {
+			return lastpk, nil
+		}
+		advanceSource = true
+		advanceTarget = true
+
+		// A missing row sorts after all others.
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			if c, err = td.compare(sourceRow, targetRow, td.comparePKs, false); err != nil {
+				return lastpk, err
+			}
+		}
+
+		switch {
+		case c < 0:
+			if len(dr.ExtraRowsSourceSample) < maxSamples {
+				dr.ExtraRowsSourceSample = append(dr.ExtraRowsSourceSample, td.genRowDiff(sourceRow, opts))
+			}
+			dr.ExtraRowsSource++
+			advanceTarget = false
+			lastpk = td.pk(sourceRow)
+		case c > 0:
+			if len(dr.ExtraRowsTargetSample) < maxSamples {
+				dr.ExtraRowsTargetSample = append(dr.ExtraRowsTargetSample, td.genRowDiff(targetRow, opts))
+			}
+			dr.ExtraRowsTarget++
+			advanceSource = false
+			lastpk = td.pk(targetRow)
+		default:
+			// Compare non-pk values.
+			c, err = td.compare(sourceRow, targetRow, td.compareCols, true)
+			if err != nil {
+				return lastpk, err
+			}
+			if c != 0 {
+				if len(dr.MismatchedRowsSample) < maxSamples {
+					dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, &tabletmanagerdatapb.VDiffMismatch{
+						Source: td.genRowDiff(sourceRow, opts),
+						Target: td.genRowDiff(targetRow, opts),
+					})
+				}
+				dr.MismatchedRows++
+			} else {
+				dr.MatchingRows++
+			}
+			lastpk = td.pk(targetRow)
+		}
+		dr.ProcessedRows++
+
+		if dr.ProcessedRows%checkpointInterval == 0 {
+			if err := checkpoint(lastpk); err != nil {
+				return lastpk, err
+			}
+		}
+	}
+}
+
+func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []compareColInfo, compareOnlyNonPKs bool) (int, error) {
+	for _, col := range cols {
+		if col.isPK && compareOnlyNonPKs {
+			continue
+		}
+		var c int
+		var err error
+		source, target := sourceRow[col.colIndex], targetRow[col.colIndex]
+		if source.IsText() && target.IsText() {
+			c = bytes.Compare(source.ToBytes(), target.ToBytes())
+		} else {
+			c, err = evalengine.NullsafeCompare(source, target)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// pk returns the primary key values of a row, in pk order.
+func (td *tableDiffer) pk(row []sqltypes.Value) []sqltypes.Value {
+	pk := make([]sqltypes.Value, 0, len(td.pkCols))
+	for _, i := range td.pkCols {
+		pk = append(pk, row[i])
+	}
+	return pk
+}
+
+// pkFields returns the fields of the primary key columns, in pk order.
+func (td *tableDiffer) pkFields(fields []*querypb.Field) []*querypb.Field {
+	pkFields := make([]*querypb.Field, 0, len(td.pkCols))
+	for _, i := range td.pkCols {
+		pkFields = append(pkFields, fields[i])
+	}
+	return pkFields
+}
+
+func (td *tableDiffer) genRowDiff(row []sqltypes.Value, opts *tabletmanagerdatapb.VDiffOptions) *tabletmanagerdatapb.VDiffRowDiff {
+	rd := &tabletmanagerdatapb.VDiffRowDiff{
+		Row: make(map[string]string),
+	}
+	if opts.DebugQuery {
+		rd.Query = td.genDebugQuery(row, opts.OnlyPks)
+	}
+	if opts.OnlyPks {
+		for _, i := range td.pkCols {
+			rd.Row[td.columns[i]] = encodeValue(row[i])
+		}
+		return rd
+	}
+	for i, col := range td.columns {
+		rd.Row[col] = encodeValue(row[i])
+	}
+	return rd
+}
+
+func (td *tableDiffer) genDebugQuery(row []sqltypes.Value, onlyPks bool) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	prefix := ""
+	for i, col := range td.columns {
+		if onlyPks && !td.compareCols[i].isPK {
+			continue
+		}
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(col))
+		prefix = ", "
+	}
+	buf.Myprintf(" from %v where ", sqlparser.NewTableIdent(td.table))
+	for i, pkI := range td.pkCols {
+		if i != 0 {
+			buf.Myprintf(" and ")
+		}
+		buf.Myprintf("%v = ", sqlparser.NewColIdent(td.columns[pkI]))
+		row[pkI].EncodeSQL(buf)
+	}
+	return buf.String()
+}
+
+func encodeValue(val sqltypes.Value) string {
+	var buf strings.Builder
+	val.EncodeSQL(&buf)
+	return buf.String()
+}
+
+//-----------------------------------------------------------------
+// primitiveExecutor
+
+// primitiveExecutor starts execution on the top level primitive
+// and provides convenience functions for row-by-row iteration.
+type primitiveExecutor struct {
+	prim     engine.Primitive
+	rows     [][]sqltypes.Value
+	resultch chan *sqltypes.Result
+	err      error
+}
+
+func newPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *primitiveExecutor {
+	pe := &primitiveExecutor{
+		prim:     prim,
+		resultch: make(chan *sqltypes.Result, 1),
+	}
+	vcursor := &contextVCursor{ctx: ctx}
+	go func() {
+		defer close(pe.resultch)
+		pe.err = pe.prim.StreamExecute(vcursor, make(map[string]*querypb.BindVariable), true, func(qr *sqltypes.Result) error {
+			select {
+			case pe.resultch <- qr:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "Outer Stream")
+			}
+			return nil
+		})
+	}()
+	return pe
+}
+
+func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
+	for len(pe.rows) == 0 {
+		qr, ok := <-pe.resultch
+		if !ok {
+			return nil, pe.err
+		}
+		pe.rows = qr.Rows
+	}
+
+	row := pe.rows[0]
+	pe.rows = pe.rows[1:]
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// contextVCursor
+
+// contextVCursor satisfies VCursor, but only implements Context().
+// MergeSort only requires Context to be implemented.
+type contextVCursor struct {
+	engine.VCursor
+	ctx context.Context
+}
+
+func (vc *contextVCursor) Context() context.Context {
+	return vc.ctx
+}
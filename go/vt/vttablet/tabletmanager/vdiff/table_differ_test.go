@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var testTableDef = &tabletmanagerdatapb.TableDefinition{
+	Name:              "t1",
+	Columns:           []string{"c1", "c2"},
+	PrimaryKeyColumns: []string{"c1"},
+	Fields:            sqltypes.MakeTestFields("c1|c2", "int64|varchar"),
+}
+
+func TestBuildTablePlan(t *testing.T) {
+	testcases := []struct {
+		query       string
+		sourceQuery string
+		targetQuery string
+		columns     []string
+		err         string
+	}{{
+		query:       "select * from t1",
+		sourceQuery: "select c1, c2 from t1",
+		targetQuery: "select c1, c2 from t1",
+		columns:     []string{"c1", "c2"},
+	}, {
+		query:       "select * from t1 where in_keyrange('-80')",
+		sourceQuery: "select c1, c2 from t1 where in_keyrange('-80')",
+		targetQuery: "select c1, c2 from t1",
+		columns:     []string{"c1", "c2"},
+	}, {
+		query:       "select c2, c1 from t1",
+		sourceQuery: "select c2, c1 from t1",
+		targetQuery: "select c2, c1 from t1",
+		columns:     []string{"c2", "c1"},
+	}, {
+		query:       "select a as c1, c2 from t2",
+		sourceQuery: "select a as c1, c2 from t2",
+		targetQuery: "select c1, c2 from t1",
+		columns:     []string{"c1", "c2"},
+	}, {
+		query: "select c2 from t1",
+		err:   "primary key column c1 of table t1 is not part of the vreplication filter",
+	}, {
+		query: "select c1, c3 from t1",
+		err:   "column c3 not found in table t1",
+	}, {
+		query: "select c1, count(*) as c2 from t1 group by c1",
+		err:   "unsupported: group by in select c1, count(*) as c2 from t1 group by c1",
+	}, {
+		query: "select c1, c2 + 1 as c2 from t1",
+		err:   "unsupported: expression c2 + 1 as c2 cannot be compared",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			td, err := buildTablePlan(testTableDef, tcase.query)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.sourceQuery, td.sourceQuery)
+			assert.Equal(t, tcase.targetQuery, td.targetQuery)
+			assert.Equal(t, tcase.columns, td.columns)
+		})
+	}
+}
+
+// newTestStreamer returns a shardStreamer which serves the given rows.
+func newTestStreamer(fields []*querypb.Field, rows ...string) *shardStreamer {
+	sm := &shardStreamer{
+		fields: fields,
+		result: make(chan *sqltypes.Result, 2),
+	}
+	// Like VStreamRows, the fields are only sent with the first result.
+	sm.result <- &sqltypes.Result{Fields: fields}
+	sm.result <- &sqltypes.Result{Rows: sqltypes.MakeTestResult(fields, rows...).Rows}
+	close(sm.result)
+	return sm
+}
+
+func TestTableDifferDiff(t *testing.T) {
+	td, err := buildTablePlan(testTableDef, "select * from t1")
+	require.NoError(t, err)
+
+	fields := testTableDef.Fields
+	sources := []*shardStreamer{
+		newTestStreamer(fields, "1|a", "3|c", "5|e"),
+		newTestStreamer(fields, "2|b", "4|d", "6|f"),
+	}
+	target := newTestStreamer(fields, "1|a", "2|b", "3|x", "5|e", "6|f", "7|g")
+
+	dr := &tabletmanagerdatapb.VDiffReport{TableName: "t1"}
+	opts := &tabletmanagerdatapb.VDiffOptions{DebugQuery: true}
+	lastpk, err := td.diff(context.Background(), td.newMergeSorter(sources), td.newMergeSorter([]*shardStreamer{target}), dr, opts, func([]sqltypes.Value) error {
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(7)}, lastpk)
+
+	want := &tabletmanagerdatapb.VDiffReport{
+		TableName:       "t1",
+		ProcessedRows:   7,
+		MatchingRows:    4,
+		MismatchedRows:  1,
+		ExtraRowsSource: 1,
+		ExtraRowsTarget: 1,
+		ExtraRowsSourceSample: []*tabletmanagerdatapb.VDiffRowDiff{{
+			Row:   map[string]string{"c1": "4", "c2": "'d'"},
+			Query: "select c1, c2 from t1 where c1 = 4",
+		}},
+		ExtraRowsTargetSample: []*tabletmanagerdatapb.VDiffRowDiff{{
+			Row:   map[string]string{"c1": "7", "c2": "'g'"},
+			Query: "select c1, c2 from t1 where c1 = 7",
+		}},
+		MismatchedRowsSample: []*tabletmanagerdatapb.VDiffMismatch{{
+			Source: &tabletmanagerdatapb.VDiffRowDiff{
+				Row:   map[string]string{"c1": "3", "c2": "'c'"},
+				Query: "select c1, c2 from t1 where c1 = 3",
+			},
+			Target: &tabletmanagerdatapb.VDiffRowDiff{
+				Row:   map[string]string{"c1": "3", "c2": "'x'"},
+				Query: "select c1, c2 from t1 where c1 = 3",
+			},
+		}},
+	}
+	assert.Equal(t, want.String(), dr.String())
+}
+
+func TestTableDifferDiffMaxRowsAndCheckpoint(t *testing.T) {
+	defer func(interval int64) { checkpointInterval = interval }(checkpointInterval)
+	checkpointInterval = 2
+
+	td, err := buildTablePlan(testTableDef, "select * from t1")
+	require.NoError(t, err)
+
+	fields := testTableDef.Fields
+	source := newTestStreamer(fields, "1|a", "2|b", "3|c", "4|d", "5|e")
+	target := newTestStreamer(fields, "1|a", "2|b", "3|c", "4|d", "5|e")
+
+	// The report of a resumed vdiff already has the rows compared before.
+	dr := &tabletmanagerdatapb.VDiffReport{TableName: "t1", ProcessedRows: 2, MatchingRows: 2}
+	opts := &tabletmanagerdatapb.VDiffOptions{MaxRows: 5, OnlyPks: true}
+	var checkpoints [][]sqltypes.Value
+	lastpk, err := td.diff(context.Background(), td.newMergeSorter([]*shardStreamer{source}), td.newMergeSorter([]*shardStreamer{target}), dr, opts, func(lastpk []sqltypes.Value) error {
+		checkpoints = append(checkpoints, lastpk)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(3)}, lastpk)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(2)}}, checkpoints)
+	assert.EqualValues(t, 5, dr.ProcessedRows)
+	assert.EqualValues(t, 5, dr.MatchingRows)
+
+	pkFields := td.pkFields(fields)
+	assert.Equal(t, []*querypb.Field{fields[0]}, pkFields)
+	assert.Equal(t, map[string]string{"c1": "4"}, td.genRowDiff(sqltypes.MakeTestResult(fields, "4|d").Rows[0], opts).Row)
+}
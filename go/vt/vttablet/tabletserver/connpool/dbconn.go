@@ -106,6 +106,7 @@ func (dbc *DBConn) Err() error {
 // and retry. A failed reconnect will trigger a CheckMySQL.
 func (dbc *DBConn) Exec(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
 	span, ctx := trace.NewSpan(ctx, "DBConn.Exec")
+	trace.AnnotateSQL(span, query)
 	defer span.Finish()
 
 	for attempt := 1; attempt <= 2; attempt++ {
@@ -141,6 +142,10 @@ func (dbc *DBConn) Exec(ctx context.Context, query string, maxrows int, wantfiel
 }
 
 func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	// This is one round trip to MySQL.
+	span, ctx := trace.NewSpan(ctx, "DBConn.execOnce")
+	defer span.Finish()
+
 	dbc.current.Set(query)
 	defer dbc.current.Set("")
 
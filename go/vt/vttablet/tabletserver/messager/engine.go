@@ -39,6 +39,8 @@ type TabletService interface {
 	tabletenv.Env
 	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []string) (count int64, err error)
+	PostponeConsumerGroupMessages(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []string) (count int64, err error)
 }
 
 // VStreamer defines  the functions of VStreamer
@@ -53,6 +55,9 @@ type Engine struct {
 	mu       sync.Mutex
 	isOpen   bool
 	managers map[string]*messageManager
+	// groups contains the managers of the consumer groups
+	// declared for each table.
+	groups map[string]map[string]*messageManager
 
 	tsv          TabletService
 	se           *schema.Engine
@@ -68,6 +73,7 @@ func NewEngine(tsv TabletService, se *schema.Engine, vs VStreamer) *Engine {
 		vs:           vs,
 		postponeSema: sync2.NewSemaphore(tsv.Config().MessagePostponeParallelism, 0),
 		managers:     make(map[string]*messageManager),
+		groups:       make(map[string]map[string]*messageManager),
 	}
 }
 
@@ -95,8 +101,9 @@ func (me *Engine) Close() {
 	}
 	me.isOpen = false
 	me.se.UnregisterNotifier("messages")
-	for _, mm := range me.managers {
+	for name, mm := range me.managers {
 		mm.Close()
+		me.closeGroups(name)
 	}
 	me.managers = make(map[string]*messageManager)
 	log.Info("Messager: closed")
@@ -109,7 +116,9 @@ func (me *Engine) Close() {
 // usually triggered by Close. It's the responsibility of the send
 // function to promptly return if the done channel is closed. Otherwise,
 // the engine's Close function will hang indefinitely.
-func (me *Engine) Subscribe(ctx context.Context, name string, send func(*sqltypes.Result) error) (done <-chan struct{}, err error) {
+// If consumerGroup is not empty, the subscriber receives the messages
+// of that consumer group, which must be declared for the table.
+func (me *Engine) Subscribe(ctx context.Context, name, consumerGroup string, send func(*sqltypes.Result) error) (done <-chan struct{}, err error) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
 	if !me.isOpen {
@@ -119,7 +128,22 @@ func (me *Engine) Subscribe(ctx context.Context, name string, send func(*sqltype
 	if mm == nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found", name)
 	}
-	return mm.Subscribe(ctx, send), nil
+	if consumerGroup == "" {
+		return mm.Subscribe(ctx, send), nil
+	}
+	gm := me.groups[name][consumerGroup]
+	if gm == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s of message table %s not found", consumerGroup, name)
+	}
+	return gm.Subscribe(ctx, send), nil
+}
+
+// closeGroups closes the managers of the consumer groups of the table.
+func (me *Engine) closeGroups(name string) {
+	for _, gm := range me.groups[name] {
+		gm.Close()
+	}
+	delete(me.groups, name)
 }
 
 // GenerateAckQuery returns the query and bind vars for acking a message.
@@ -134,6 +158,38 @@ func (me *Engine) GenerateAckQuery(name string, ids []string) (string, map[strin
 	return query, bv, nil
 }
 
+// GenerateNackQuery returns the query and bind vars for nacking a message.
+func (me *Engine) GenerateNackQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	query, bv := mm.GenerateNackQuery(ids)
+	return query, bv, nil
+}
+
+// GenerateDeadLetterQueries returns the queries for dead-lettering messages.
+// If consumerGroup is not empty, the messages are only given up on for
+// that consumer group.
+func (me *Engine) GenerateDeadLetterQueries(name, consumerGroup string, ids []string) ([]*querypb.BoundQuery, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	if consumerGroup != "" {
+		gm := me.groups[name][consumerGroup]
+		if gm == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s of message table %s not found", consumerGroup, name)
+		}
+		return gm.GenerateDeadLetterQueries(ids), nil
+	}
+	mm := me.managers[name]
+	if mm == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	return mm.GenerateDeadLetterQueries(ids), nil
+}
+
 // GeneratePostponeQuery returns the query and bind vars for postponing a message.
 func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
@@ -146,15 +202,40 @@ func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[
 	return query, bv, nil
 }
 
-// GeneratePurgeQuery returns the query and bind vars for purging messages.
-func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map[string]*querypb.BindVariable, error) {
+// GeneratePurgeQueries returns the queries for purging messages.
+func (me *Engine) GeneratePurgeQueries(name string, timeCutoff int64) ([]*querypb.BoundQuery, error) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
 	mm := me.managers[name]
 	if mm == nil {
-		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	return mm.GeneratePurgeQueries(timeCutoff), nil
+}
+
+// GenerateConsumerGroupAckQuery returns the query and bind vars for acking
+// messages for a consumer group.
+func (me *Engine) GenerateConsumerGroupAckQuery(name, consumerGroup string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	gm := me.groups[name][consumerGroup]
+	if gm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s of message table %s not found", consumerGroup, name)
 	}
-	query, bv := mm.GeneratePurgeQuery(timeCutoff)
+	query, bv := gm.GenerateConsumerGroupAckQuery(ids)
+	return query, bv, nil
+}
+
+// GenerateConsumerGroupPostponeQuery returns the query and bind vars for
+// recording messages as pending for a consumer group.
+func (me *Engine) GenerateConsumerGroupPostponeQuery(name, consumerGroup string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	gm := me.groups[name][consumerGroup]
+	if gm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s of message table %s not found", consumerGroup, name)
+	}
+	query, bv := gm.GenerateConsumerGroupPostponeQuery(ids)
 	return query, bv, nil
 }
 
@@ -168,6 +249,7 @@ func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altere
 		}
 		log.Infof("Stopping messager for dropped/updated table: %v", name)
 		mm.Close()
+		me.closeGroups(name)
 		delete(me.managers, name)
 	}
 
@@ -185,5 +267,14 @@ func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altere
 		me.managers[name] = mm
 		log.Infof("Starting messager for table: %v", name)
 		mm.Open()
+		for _, group := range t.MessageInfo.ConsumerGroups {
+			gm := newConsumerGroupManager(me.tsv, me.vs, t, group, me.postponeSema)
+			if me.groups[name] == nil {
+				me.groups[name] = make(map[string]*messageManager)
+			}
+			me.groups[name][group] = gm
+			log.Infof("Starting messager for table: %v, consumer group: %v", name, group)
+			gm.Open()
+		}
 	}
 }
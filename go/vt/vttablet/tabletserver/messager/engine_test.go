@@ -22,9 +22,13 @@ import (
 
 	"context"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -114,9 +118,9 @@ func TestSubscribe(t *testing.T) {
 	f1, ch1 := newEngineReceiver()
 	f2, ch2 := newEngineReceiver()
 	// Each receiver is subscribed to different managers.
-	engine.Subscribe(context.Background(), "t1", f1)
+	engine.Subscribe(context.Background(), "t1", "", f1)
 	<-ch1
-	engine.Subscribe(context.Background(), "t2", f2)
+	engine.Subscribe(context.Background(), "t2", "", f2)
 	<-ch2
 	engine.managers["t1"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
 	engine.managers["t2"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
@@ -125,14 +129,14 @@ func TestSubscribe(t *testing.T) {
 
 	// Error case.
 	want := "message table t3 not found"
-	_, err := engine.Subscribe(context.Background(), "t3", f1)
+	_, err := engine.Subscribe(context.Background(), "t3", "", f1)
 	if err == nil || err.Error() != want {
 		t.Errorf("Subscribe: %v, want %s", err, want)
 	}
 
 	// After close, Subscribe should return a closed channel.
 	engine.Close()
-	_, err = engine.Subscribe(context.Background(), "t1", nil)
+	_, err = engine.Subscribe(context.Background(), "t1", "", nil)
 	if got, want := vterrors.Code(err), vtrpcpb.Code_UNAVAILABLE; got != want {
 		t.Errorf("Subscribed on closed engine error code: %v, want %v", got, want)
 	}
@@ -161,12 +165,73 @@ func TestEngineGenerate(t *testing.T) {
 		t.Errorf("engine.GeneratePostponeQuery(invalid): %v, want %s", err, want)
 	}
 
-	if _, _, err := engine.GeneratePurgeQuery("t1", 0); err != nil {
+	if _, err := engine.GeneratePurgeQueries("t1", 0); err != nil {
 		t.Error(err)
 	}
-	if _, _, err := engine.GeneratePurgeQuery("t2", 0); err == nil || err.Error() != want {
-		t.Errorf("engine.GeneratePurgeQuery(invalid): %v, want %s", err, want)
+	if _, err := engine.GeneratePurgeQueries("t2", 0); err == nil || err.Error() != want {
+		t.Errorf("engine.GeneratePurgeQueries(invalid): %v, want %s", err, want)
+	}
+}
+
+func TestSubscribeConsumerGroup(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	engine := newTestEngine(db)
+	defer engine.Close()
+	cgTable := &schema.Table{
+		Name:        sqlparser.NewTableIdent("t2"),
+		Type:        schema.Message,
+		MessageInfo: newMMTable().MessageInfo,
 	}
+	cgTable.MessageInfo.ConsumerGroupTable = "t2_groups"
+	cgTable.MessageInfo.ConsumerGroups = []string{"g1", "g2"}
+	engine.schemaChanged(map[string]*schema.Table{
+		"t1": meTable,
+		"t2": cgTable,
+	}, []string{"t1", "t2"}, nil, nil)
+	// The declared groups are started with the table.
+	require.Len(t, engine.groups["t2"], 2)
+	gm := engine.groups["t2"]["g1"]
+
+	f1, ch1 := newEngineReceiver()
+	_, err := engine.Subscribe(context.Background(), "t1", "g1", f1)
+	require.EqualError(t, err, "consumer group g1 of message table t1 not found")
+	_, err = engine.Subscribe(context.Background(), "t2", "g3", f1)
+	require.EqualError(t, err, "consumer group g3 of message table t2 not found")
+
+	_, err = engine.Subscribe(context.Background(), "t2", "g1", f1)
+	require.NoError(t, err)
+	<-ch1
+	f2, ch2 := newEngineReceiver()
+	_, err = engine.Subscribe(context.Background(), "t2", "g1", f2)
+	require.NoError(t, err)
+	<-ch2
+	// Subscribers don't create managers.
+	require.Len(t, engine.groups["t2"], 2)
+	assert.Equal(t, gm, engine.groups["t2"]["g1"])
+
+	_, _, err = engine.GenerateConsumerGroupAckQuery("t2", "g1", []string{"1"})
+	require.NoError(t, err)
+	_, _, err = engine.GenerateConsumerGroupAckQuery("t2", "g3", []string{"1"})
+	require.EqualError(t, err, "consumer group g3 of message table t2 not found")
+	_, _, err = engine.GenerateConsumerGroupPostponeQuery("t2", "g2", []string{"1"})
+	require.NoError(t, err)
+	_, _, err = engine.GenerateConsumerGroupPostponeQuery("t1", "g1", []string{"1"})
+	require.EqualError(t, err, "consumer group g1 of message table t1 not found")
+	// A group only gives up on a message for itself.
+	queries, err := engine.GenerateDeadLetterQueries("t2", "g1", []string{"1"})
+	require.NoError(t, err)
+	require.Len(t, queries, 1)
+	assert.Equal(t, "update t2_groups set time_acked = :time_acked, time_next = null where group_name = 'g1' and id in ::ids and time_acked is null", queries[0].Sql)
+	_, err = engine.GenerateDeadLetterQueries("t2", "g3", []string{"1"})
+	require.EqualError(t, err, "consumer group g3 of message table t2 not found")
+
+	// Dropping the table closes its groups.
+	engine.schemaChanged(map[string]*schema.Table{"t1": meTable}, nil, nil, []string{"t2"})
+	assert.Empty(t, engine.groups)
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+	assert.False(t, gm.isOpen)
 }
 
 func newTestEngine(db *fakesqldb.DB) *Engine {
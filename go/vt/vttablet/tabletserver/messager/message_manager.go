@@ -161,16 +161,44 @@ type receiverWithStatus struct {
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
 // to delete old rows that were successfully acked.
+//
+// Dead letters
+// If the table has a max attempts setting, the send loop doesn't send
+// messages that have already been sent that many times. Instead, they're
+// moved to the dead-letter table, or acked if there's none.
+//
+// Consumer groups
+// A messageManager can also serve one of the consumer groups declared
+// for the table. Each group receives every message, independently of
+// the other groups and of the regular subscribers. The delivery state
+// of a group is kept in the consumer group table, which has a
+// (group_name, id) primary key and time_next, epoch and time_acked
+// columns. Before a message is sent to a subscriber of the group, it's
+// recorded as pending until ack wait time elapses, and its epoch for
+// the group is incremented. The poller picks up the messages that have
+// no row for the group, or whose pending row is due, which redelivers
+// the messages that weren't acked in time. MessageAck with the group
+// acks the messages for that group only. Once a message reaches max
+// attempts for a group, the group gives up on it by acking it: the
+// dead-letter table only receives the messages of the regular
+// subscribers. The message table itself is never updated by a group:
+// the vstream only adds newly inserted messages. A message is purged
+// only once the regular subscribers and all the groups have acked it.
 type messageManager struct {
 	tsv TabletService
 	vs  VStreamer
 
-	name         sqlparser.TableIdent
+	name sqlparser.TableIdent
+	// group is the consumer group, or empty for the regular subscribers.
+	group        string
+	statsName    string
 	fieldResult  *sqltypes.Result
 	ackWaitTime  time.Duration
 	purgeAfter   time.Duration
 	minBackoff   time.Duration
 	maxBackoff   time.Duration
+	maxAttempts  int64
+	hasDeliverAt bool
 	batchSize    int
 	pollerTicks  *timer.Timer
 	purgeTicks   *timer.Timer
@@ -214,16 +242,30 @@ type messageManager struct {
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	nackQuery                 *sqlparser.ParsedQuery
+	// deadLetterQueries are executed in a single transaction.
+	deadLetterQueries          []*sqlparser.ParsedQuery
+	consumerGroupAckQuery      *sqlparser.ParsedQuery
+	consumerGroupPostponeQuery *sqlparser.ParsedQuery
+	consumerGroupPurgeQuery    *sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
 // Calls into tsv have to be made asynchronously. Otherwise,
 // it can lead to deadlocks.
 func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, postponeSema *sync2.Semaphore) *messageManager {
+	return newConsumerGroupManager(tsv, vs, table, "", postponeSema)
+}
+
+// newConsumerGroupManager creates a message manager for a consumer group.
+// If group is empty, the manager is for the regular subscribers.
+func newConsumerGroupManager(tsv TabletService, vs VStreamer, table *schema.Table, group string, postponeSema *sync2.Semaphore) *messageManager {
 	mm := &messageManager{
-		tsv:  tsv,
-		vs:   vs,
-		name: table.Name,
+		tsv:       tsv,
+		vs:        vs,
+		name:      table.Name,
+		group:     group,
+		statsName: table.Name.String(),
 		fieldResult: &sqltypes.Result{
 			Fields: table.MessageInfo.Fields,
 		},
@@ -231,6 +273,8 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		purgeAfter:      table.MessageInfo.PurgeAfterDuration,
 		minBackoff:      table.MessageInfo.MinBackoff,
 		maxBackoff:      table.MessageInfo.MaxBackoff,
+		maxAttempts:     int64(table.MessageInfo.MaxAttempts),
+		hasDeliverAt:    table.MessageInfo.HasDeliverAt,
 		batchSize:       table.MessageInfo.BatchSize,
 		cache:           newCache(table.MessageInfo.CacheSize),
 		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
@@ -241,7 +285,12 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 	mm.cond.L = &mm.mu
 
 	columnList := buildSelectColumnList(table)
-	vsQuery := fmt.Sprintf("select priority, time_next, epoch, time_acked, %s from %v", columnList, mm.name)
+	hiddenList := buildHiddenColumnList("epoch", mm.hasDeliverAt)
+	deliverAtCond := ""
+	if mm.hasDeliverAt {
+		deliverAtCond = " and (deliver_at is null or deliver_at < :time_next)"
+	}
+	vsQuery := fmt.Sprintf("select %s, %s from %v", hiddenList, columnList, mm.name)
 	mm.vsFilter = &binlogdatapb.Filter{
 		Rules: []*binlogdatapb.Rule{{
 			Match:  table.Name.String(),
@@ -249,13 +298,66 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		}},
 	}
 	mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
-		"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
+		"select %s, %s from %v where time_next < %a%s order by priority, time_next desc limit %a",
+		hiddenList, columnList, mm.name, ":time_next", deliverAtCond, ":max")
 	mm.ackQuery = sqlparser.BuildParsedQuery(
 		"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
 		mm.name, ":time_acked", "::ids")
 	mm.purgeQuery = sqlparser.BuildParsedQuery(
 		"delete from %v where time_acked < %a limit 500", mm.name, ":time_acked")
+	if cgt := table.MessageInfo.ConsumerGroupTable; cgt != "" {
+		cgTable := sqlparser.NewTableIdent(cgt)
+		groupName := sqlparser.NewStrLiteral(group)
+		if group != "" {
+			mm.statsName = fmt.Sprintf("%s:%s", mm.name.String(), group)
+			// The attempts are counted per group.
+			epoch := sqlparser.NewTrackedBuffer(nil)
+			epoch.Myprintf("(select epoch from %v where group_name = %v and %v.id = %v.id)", cgTable, groupName, cgTable, mm.name)
+			mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
+				"select %s, %s from %v where id not in (select id from %v where group_name = %v and (time_acked is not null or time_next >= %a))%s order by priority, time_next desc limit %a",
+				buildHiddenColumnList(epoch.String(), mm.hasDeliverAt), columnList, mm.name, cgTable, groupName, ":time_next", deliverAtCond, ":max")
+			mm.consumerGroupAckQuery = sqlparser.BuildParsedQuery(
+				"update %v set time_acked = %a, time_next = null where group_name = %v and id in %a and time_acked is null",
+				cgTable, ":time_acked", groupName, "::ids")
+			mm.consumerGroupPostponeQuery = sqlparser.BuildParsedQuery(
+				"insert into %v(group_name, id, time_next, epoch) select %v, id, %a, 1 from %v where id in %a on duplicate key update time_next = %a, epoch = ifnull(%v.epoch, 0)+1",
+				cgTable, groupName, ":time_next", mm.name, "::ids", ":time_next", cgTable)
+		} else {
+			// A message is purged only once every group acked it.
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("delete from %v where time_acked < %a", mm.name, ":time_acked")
+			for _, g := range table.MessageInfo.ConsumerGroups {
+				buf.Myprintf(" and id in (select id from %v where group_name = %v and time_acked < %a)", cgTable, sqlparser.NewStrLiteral(g), ":time_acked")
+			}
+			buf.Myprintf(" limit 500")
+			mm.purgeQuery = buf.ParsedQuery()
+		}
+		// The delivery state of a message is kept as long as the
+		// message, so that it doesn't get sent again.
+		mm.consumerGroupPurgeQuery = sqlparser.BuildParsedQuery(
+			"delete from %v where id not in (select id from %v) limit 500",
+			cgTable, mm.name)
+	}
+	mm.nackQuery = sqlparser.BuildParsedQuery(
+		"update %v set time_next = %a where id in %a and time_acked is null",
+		mm.name, ":time_now", "::ids")
+
+	if dlt := table.MessageInfo.DeadLetterTable; dlt != "" {
+		mm.deadLetterQueries = []*sqlparser.ParsedQuery{
+			sqlparser.BuildParsedQuery(
+				"insert into %v(%s) select %s from %v where id in %a and time_acked is null",
+				sqlparser.NewTableIdent(dlt), columnList, columnList, mm.name, "::ids"),
+			sqlparser.BuildParsedQuery(
+				"delete from %v where id in %a and time_acked is null",
+				mm.name, "::ids"),
+		}
+	} else {
+		mm.deadLetterQueries = []*sqlparser.ParsedQuery{mm.ackQuery}
+	}
+	if group != "" {
+		// A group gives up on a message for itself only.
+		mm.deadLetterQueries = []*sqlparser.ParsedQuery{mm.consumerGroupAckQuery}
+	}
 
 	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff)
 
@@ -308,6 +410,16 @@ func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.D
 	return sqlparser.BuildParsedQuery(buf.String(), args...)
 }
 
+// buildHiddenColumnList builds the 'select' list for the hidden
+// columns, with epoch as the expression of the epoch column.
+func buildHiddenColumnList(epoch string, hasDeliverAt bool) string {
+	hiddenList := "priority, time_next, " + epoch + ", time_acked"
+	if hasDeliverAt {
+		hiddenList += ", deliver_at"
+	}
+	return hiddenList
+}
+
 // buildSelectColumnList is a convenience function that
 // builds a 'select' list for the user-defined columns.
 func buildSelectColumnList(t *schema.Table) string {
@@ -337,7 +449,10 @@ func (mm *messageManager) Open() {
 	go mm.runSend()
 	// TODO(sougou): improve ticks to add randomness.
 	mm.pollerTicks.Start(mm.runPoller)
-	mm.purgeTicks.Start(mm.runPurge)
+	if mm.group == "" {
+		// Consumer groups rely on the purge of the regular manager.
+		mm.purgeTicks.Start(mm.runPurge)
+	}
 }
 
 // Close stops the messageManager service.
@@ -355,7 +470,7 @@ func (mm *messageManager) Close() {
 		rcvr.receiver.cancel()
 	}
 	mm.receivers = nil
-	MessageStats.Set([]string{mm.name.String(), "ClientCount"}, 0)
+	MessageStats.Set([]string{mm.statsName, "ClientCount"}, 0)
 	mm.cache.Clear()
 	// This broadcast will cause runSend to exit.
 	mm.cond.Broadcast()
@@ -393,7 +508,7 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 		mm.startVStream()
 	}
 	mm.receivers = append(mm.receivers, withStatus)
-	MessageStats.Set([]string{mm.name.String(), "ClientCount"}, int64(len(mm.receivers)))
+	MessageStats.Set([]string{mm.statsName, "ClientCount"}, int64(len(mm.receivers)))
 	if mm.curReceiver == -1 {
 		mm.rescanReceivers(-1)
 	}
@@ -417,7 +532,7 @@ func (mm *messageManager) unsubscribe(receiver *messageReceiver) {
 		n := len(mm.receivers)
 		copy(mm.receivers[i:n-1], mm.receivers[i+1:n])
 		mm.receivers = mm.receivers[0 : n-1]
-		MessageStats.Set([]string{mm.name.String(), "ClientCount"}, int64(len(mm.receivers)))
+		MessageStats.Set([]string{mm.statsName, "ClientCount"}, int64(len(mm.receivers)))
 		break
 	}
 	// curReceiver is obsolete. Recompute.
@@ -516,24 +631,35 @@ func (mm *messageManager) runSend() {
 
 			// Fetch rows from cache.
 			lateCount := int64(0)
+			var deadIDs []string
 			for i := 0; i < mm.batchSize; i++ {
 				mr := mm.cache.Pop()
 				if mr == nil {
 					break
 				}
+				if mm.maxAttempts > 0 && mr.Epoch >= mm.maxAttempts {
+					// The message was already sent as many times as
+					// allowed without being acked.
+					deadIDs = append(deadIDs, mr.Row[0].ToString())
+					continue
+				}
 				if mr.Epoch >= 1 {
 					lateCount++
 				}
 				rows = append(rows, mr.Row)
 			}
-			MessageStats.Add([]string{mm.name.String(), "Delayed"}, lateCount)
+			MessageStats.Add([]string{mm.statsName, "Delayed"}, lateCount)
+			if deadIDs != nil {
+				mm.wg.Add(1)
+				go mm.deadLetter(deadIDs)
+			}
 
 			// If we have rows to send, break out of this loop.
 			if rows != nil {
 				break
 			}
 		}
-		MessageStats.Add([]string{mm.name.String(), "Sent"}, int64(len(rows)))
+		MessageStats.Add([]string{mm.statsName, "Sent"}, int64(len(rows)))
 		// If we're here, there is a current receiver, and messages
 		// to send. Reserve the receiver and find the next one.
 		receiver := mm.receivers[mm.curReceiver]
@@ -579,12 +705,24 @@ func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result
 		}
 	}()
 
+	if mm.group != "" {
+		// The messages are recorded as pending before they're sent.
+		// Otherwise, an ack could reach the consumer group table
+		// first, and be overwritten by the pending state.
+		if !mm.postponeConsumerGroup(ids) {
+			// The poller will pick up the messages again.
+			return
+		}
+	}
 	if err := receiver.receiver.Send(qr); err != nil {
 		// Log the error, but we still want to postpone the message.
 		// Otherwise, if this is a chronic failure like "message too
 		// big", we'll end up spamming non-stop.
 		log.Errorf("Error sending messages: %v: %v", qr, err)
 	}
+	if mm.group != "" {
+		return
+	}
 	mm.postpone(mm.tsv, mm.name.String(), mm.ackWaitTime, ids)
 }
 
@@ -599,8 +737,58 @@ func (mm *messageManager) postpone(tsv TabletService, name string, ackWaitTime t
 	defer cancel()
 	if _, err := tsv.PostponeMessages(ctx, nil, name, ids); err != nil {
 		// This can happen during spikes. Record the incident for monitoring.
-		MessageStats.Add([]string{mm.name.String(), "PostponeFailed"}, 1)
+		MessageStats.Add([]string{mm.statsName, "PostponeFailed"}, 1)
+	}
+}
+
+// postponeConsumerGroup records the messages as pending for the group
+// until ack wait time elapses. It returns false if that failed.
+func (mm *messageManager) postponeConsumerGroup(ids []string) bool {
+	if !mm.postponeSema.Acquire() {
+		// Unreachable.
+		return false
 	}
+	defer mm.postponeSema.Release()
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	if _, err := mm.tsv.PostponeConsumerGroupMessages(ctx, nil, mm.name.String(), mm.group, ids); err != nil {
+		MessageStats.Add([]string{mm.statsName, "PostponeFailed"}, 1)
+		log.Errorf("Unable to postpone messages %v for consumer group %s: %v", ids, mm.group, err)
+		return false
+	}
+	return true
+}
+
+// deadLetter moves the messages out of the way of the send loop.
+func (mm *messageManager) deadLetter(ids []string) {
+	defer func() {
+		mm.tsv.LogError()
+		mm.wg.Done()
+	}()
+
+	defer func() {
+		// Same as for send. If the dead-lettering failed, the
+		// poller will pick up the messages again.
+		mm.streamMu.Lock()
+		defer mm.streamMu.Unlock()
+		mm.cache.Discard(ids)
+	}()
+
+	// Dead-lettering shares the postpone semaphore.
+	if !mm.postponeSema.Acquire() {
+		// Unreachable.
+		return
+	}
+	defer mm.postponeSema.Release()
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	count, err := mm.tsv.DeadLetterMessages(ctx, nil, mm.name.String(), mm.group, ids)
+	if err != nil {
+		MessageStats.Add([]string{mm.statsName, "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to dead-letter messages %v: %v", ids, err)
+		return
+	}
+	MessageStats.Add([]string{mm.statsName, "DeadLettered"}, count)
 }
 
 func (mm *messageManager) startVStream() {
@@ -632,7 +820,7 @@ func (mm *messageManager) runVStream(ctx context.Context) {
 			return
 		default:
 		}
-		MessageStats.Add([]string{mm.name.String(), "VStreamFailed"}, 1)
+		MessageStats.Add([]string{mm.statsName, "VStreamFailed"}, 1)
 		log.Infof("VStream ended: %v, retrying in 5 seconds", err)
 		time.Sleep(5 * time.Second)
 	}
@@ -720,11 +908,19 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 		if rc.After == nil {
 			continue
 		}
+		if mm.group != "" && rc.Before != nil {
+			// Only new messages are known to not be acked by the group.
+			continue
+		}
 		row := sqltypes.MakeRowTrusted(fields, rc.After)
-		mr, err := BuildMessageRow(row)
+		mr, err := mm.buildMessageRow(row)
 		if err != nil {
 			return err
 		}
+		if mm.group != "" {
+			// A new message wasn't sent to the group yet.
+			mr.Epoch = 0
+		}
 		if mr.TimeAcked != 0 || mr.TimeNext > now {
 			continue
 		}
@@ -776,7 +972,7 @@ func (mm *messageManager) runPoller() {
 		defer mm.cond.Broadcast()
 	}
 	for _, row := range qr.Rows {
-		mr, err := BuildMessageRow(row)
+		mr, err := mm.buildMessageRow(row)
 		if err != nil {
 			mm.tsv.Stats().InternalErrors.Add("Messages", 1)
 			log.Errorf("Error reading message row: %v", err)
@@ -818,41 +1014,46 @@ func purge(tsv TabletService, name string, purgeAfter, purgeInterval time.Durati
 
 // GenerateAckQuery returns the query and bind vars for acking a message.
 func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
-	idbvs := &querypb.BindVariable{
-		Type:   querypb.Type_TUPLE,
-		Values: make([]*querypb.Value, 0, len(ids)),
-	}
-	for _, id := range ids {
-		idbvs.Values = append(idbvs.Values, &querypb.Value{
-			Type:  querypb.Type_VARBINARY,
-			Value: []byte(id),
-		})
-	}
 	return mm.ackQuery.Query, map[string]*querypb.BindVariable{
 		"time_acked": sqltypes.Int64BindVariable(time.Now().UnixNano()),
-		"ids":        idbvs,
+		"ids":        idsBindVariable(ids),
 	}
 }
 
-// GeneratePostponeQuery returns the query and bind vars for postponing a message.
-func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[string]*querypb.BindVariable) {
-	idbvs := &querypb.BindVariable{
-		Type:   querypb.Type_TUPLE,
-		Values: make([]*querypb.Value, 0, len(ids)),
+// GenerateNackQuery returns the query and bind vars for making a message
+// due for immediate redelivery.
+func (mm *messageManager) GenerateNackQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+	return mm.nackQuery.Query, map[string]*querypb.BindVariable{
+		"time_now": sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"ids":      idsBindVariable(ids),
 	}
-	for _, id := range ids {
-		idbvs.Values = append(idbvs.Values, &querypb.Value{
-			Type:  querypb.Type_VARBINARY,
-			Value: []byte(id),
+}
+
+// GenerateDeadLetterQueries returns the queries for moving messages to the
+// dead-letter table, or for acking them if there's none.
+func (mm *messageManager) GenerateDeadLetterQueries(ids []string) []*querypb.BoundQuery {
+	bvs := map[string]*querypb.BindVariable{
+		"time_acked": sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"ids":        idsBindVariable(ids),
+	}
+	queries := make([]*querypb.BoundQuery, 0, len(mm.deadLetterQueries))
+	for _, pq := range mm.deadLetterQueries {
+		queries = append(queries, &querypb.BoundQuery{
+			Sql:           pq.Query,
+			BindVariables: bvs,
 		})
 	}
+	return queries
+}
 
+// GeneratePostponeQuery returns the query and bind vars for postponing a message.
+func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[string]*querypb.BindVariable) {
 	bvs := map[string]*querypb.BindVariable{
 		"time_now":    sqltypes.Int64BindVariable(time.Now().UnixNano()),
 		"wait_time":   sqltypes.Int64BindVariable(int64(mm.ackWaitTime)),
 		"min_backoff": sqltypes.Int64BindVariable(int64(mm.minBackoff)),
 		"jitter":      sqltypes.Float64BindVariable(.666666 + rand.Float64()*.666666),
-		"ids":         idbvs,
+		"ids":         idsBindVariable(ids),
 	}
 
 	if mm.maxBackoff > 0 {
@@ -869,6 +1070,88 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// GeneratePurgeQueries returns the queries for purging messages, and the
+// consumer group delivery state of the messages that were purged. The
+// query for purging messages is last.
+func (mm *messageManager) GeneratePurgeQueries(timeCutoff int64) []*querypb.BoundQuery {
+	query, bvs := mm.GeneratePurgeQuery(timeCutoff)
+	purge := &querypb.BoundQuery{
+		Sql:           query,
+		BindVariables: bvs,
+	}
+	if mm.consumerGroupPurgeQuery == nil {
+		return []*querypb.BoundQuery{purge}
+	}
+	return []*querypb.BoundQuery{{
+		Sql:           mm.consumerGroupPurgeQuery.Query,
+		BindVariables: bvs,
+	}, purge}
+}
+
+// GenerateConsumerGroupAckQuery returns the query and bind vars for acking
+// messages for the consumer group of the manager.
+func (mm *messageManager) GenerateConsumerGroupAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+	return mm.consumerGroupAckQuery.Query, map[string]*querypb.BindVariable{
+		"time_acked": sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"ids":        idsBindVariable(ids),
+	}
+}
+
+// GenerateConsumerGroupPostponeQuery returns the query and bind vars for
+// recording messages as pending for the consumer group of the manager.
+func (mm *messageManager) GenerateConsumerGroupPostponeQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+	return mm.consumerGroupPostponeQuery.Query, map[string]*querypb.BindVariable{
+		"time_next": sqltypes.Int64BindVariable(time.Now().Add(mm.ackWaitTime).UnixNano()),
+		"ids":       idsBindVariable(ids),
+	}
+}
+
+func idsBindVariable(ids []string) *querypb.BindVariable {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARBINARY,
+			Value: []byte(id),
+		})
+	}
+	return idbvs
+}
+
+// buildMessageRow builds a MessageRow for a row read with the
+// hidden columns of the table.
+func (mm *messageManager) buildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
+	deliverAt := int64(0)
+	if mm.hasDeliverAt {
+		if !row[4].IsNull() {
+			v, err := evalengine.ToInt64(row[4])
+			if err != nil {
+				return nil, err
+			}
+			deliverAt = v
+		}
+		// deliver_at follows the other hidden columns.
+		row = append(row[:4:4], row[5:]...)
+	}
+	mr, err := BuildMessageRow(row)
+	if err != nil {
+		return nil, err
+	}
+	if mm.group != "" {
+		// The state of the message in the table belongs to the
+		// regular subscribers. The epoch was read for the group.
+		mr.TimeNext, mr.TimeAcked = deliverAt, 0
+		return mr, nil
+	}
+	// A message is not due before its delivery time.
+	if deliverAt > mr.TimeNext {
+		mr.TimeNext = deliverAt
+	}
+	return mr, nil
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	mr := &MessageRow{Row: row[4:]}
@@ -33,6 +33,7 @@ import (
 	"context"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
@@ -299,6 +300,98 @@ func TestMessageManagerSend(t *testing.T) {
 	<-r1.ch
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	tsv := newFakeTabletServer()
+	ti := newMMTable()
+	ti.MessageInfo.MaxAttempts = 2
+	mm := newMessageManager(tsv, newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+	// The message was already sent twice: it must be dead-lettered.
+	mm.Add(&MessageRow{Epoch: 2, Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
+	if got, want := <-ch, "deadletter"; got != want {
+		t.Errorf("DeadLetter: %s, want %v", got, want)
+	}
+
+	// The next message is still sent.
+	mm.Add(&MessageRow{Epoch: 1, Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("2"),
+		}},
+	}
+	if got := <-r1.ch; !reflect.DeepEqual(got, want) {
+		t.Errorf("Received: %v, want %v", got, want)
+	}
+	if got, want := <-ch, "postpone"; got != want {
+		t.Errorf("Postpone: %s, want %v", got, want)
+	}
+}
+
+func TestMessageManagerConsumerGroup(t *testing.T) {
+	tsv := newFakeTabletServer()
+	ti := newMMTable()
+	ti.MessageInfo.ConsumerGroupTable = "foo_groups"
+	ti.MessageInfo.ConsumerGroups = []string{"g1"}
+	ti.MessageInfo.MaxAttempts = 2
+	mm := newConsumerGroupManager(tsv, newFakeVStreamer(), ti, "g1", sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+	assert.Equal(t, "foo:g1", mm.statsName)
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	ch := make(chan string)
+	tsv.SetChannel(ch)
+	// The time_next and time_acked of the message in the
+	// table don't apply to the group: it's postponed for the
+	// group. The epoch was read for the group.
+	mr, err := mm.buildMessageRow([]sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(10),
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(10),
+		sqltypes.NewVarBinary("1"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &MessageRow{Priority: 1, Epoch: 1, Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}}, mr)
+	mm.Add(mr)
+	// The message is pending for the group before it's sent.
+	assert.Equal(t, "postpone:g1", <-ch)
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("1"),
+		}},
+	}
+	if got := <-r1.ch; !reflect.DeepEqual(got, want) {
+		t.Errorf("Received: %v, want %v", got, want)
+	}
+
+	// A message sent to the group max attempts times is
+	// given up on for the group only.
+	mm.Add(&MessageRow{Epoch: 2, Row: []sqltypes.Value{sqltypes.NewVarBinary("3")}})
+	assert.Equal(t, "deadletter:g1", <-ch)
+
+	// If the message can't be recorded as pending, it's not sent.
+	tsv.SetChannel(nil)
+	tsv.postponeGroupErr = errors.New("postpone failed")
+	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
+	select {
+	case got := <-r1.ch:
+		t.Errorf("Received: %v, want nothing", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
 func TestMessageManagerPostponeThrottle(t *testing.T) {
 	tsv := newFakeTabletServer()
 	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
@@ -790,6 +883,113 @@ func TestMMGenerate(t *testing.T) {
 	}
 }
 
+func TestMMGenerateNackAndDeadLetter(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.MaxAttempts = 3
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	wantids := sqltypes.TestBindVariable([]interface{}{"1", "2"})
+
+	query, bv := mm.GenerateNackQuery([]string{"1", "2"})
+	assert.Equal(t, "update foo set time_next = :time_now where id in ::ids and time_acked is null", query)
+	assert.Contains(t, bv, "time_now")
+	utils.MustMatch(t, wantids, bv["ids"], "did not match")
+
+	// Without a dead-letter table, messages are acked.
+	queries := mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	require.Len(t, queries, 1)
+	assert.Equal(t, "update foo set time_acked = :time_acked, time_next = null where id in ::ids and time_acked is null", queries[0].Sql)
+	assert.Contains(t, queries[0].BindVariables, "time_acked")
+	utils.MustMatch(t, wantids, queries[0].BindVariables["ids"], "did not match")
+
+	ti.MessageInfo.DeadLetterTable = "foo_dead"
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	queries = mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	require.Len(t, queries, 2)
+	assert.Equal(t, "insert into foo_dead(id, message) select id, message from foo where id in ::ids and time_acked is null", queries[0].Sql)
+	assert.Equal(t, "delete from foo where id in ::ids and time_acked is null", queries[1].Sql)
+	utils.MustMatch(t, wantids, queries[1].BindVariables["ids"], "did not match")
+}
+
+func TestMMGenerateConsumerGroup(t *testing.T) {
+	ti := newMMTable()
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	// Without a consumer group table, only the messages are purged.
+	queries := mm.GeneratePurgeQueries(3)
+	require.Len(t, queries, 1)
+	assert.Equal(t, "delete from foo where time_acked < :time_acked limit 500", queries[0].Sql)
+
+	ti.MessageInfo.ConsumerGroupTable = "foo_groups"
+	ti.MessageInfo.ConsumerGroups = []string{"g1", "g2"}
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	queries = mm.GeneratePurgeQueries(3)
+	require.Len(t, queries, 2)
+	assert.Equal(t, "delete from foo_groups where id not in (select id from foo) limit 500", queries[0].Sql)
+	// A message acked by the regular subscribers is kept until every
+	// group acked it too.
+	assert.Equal(t, "delete from foo where time_acked < :time_acked and id in (select id from foo_groups where group_name = 'g1' and time_acked < :time_acked) and id in (select id from foo_groups where group_name = 'g2' and time_acked < :time_acked) limit 500", queries[1].Sql)
+	utils.MustMatch(t, sqltypes.Int64BindVariable(3), queries[1].BindVariables["time_acked"], "did not match")
+
+	gm := newConsumerGroupManager(newFakeTabletServer(), newFakeVStreamer(), ti, "g1", sync2.NewSemaphore(1, 0))
+	// The epoch is the number of deliveries to the group.
+	assert.Equal(t, "select priority, time_next, (select epoch from foo_groups where group_name = 'g1' and foo_groups.id = foo.id), time_acked, id, message from foo where id not in (select id from foo_groups where group_name = 'g1' and (time_acked is not null or time_next >= :time_next)) order by priority, time_next desc limit :max", gm.readByPriorityAndTimeNext.Query)
+	query, bv := gm.GenerateConsumerGroupAckQuery([]string{"1", "2"})
+	assert.Equal(t, "update foo_groups set time_acked = :time_acked, time_next = null where group_name = 'g1' and id in ::ids and time_acked is null", query)
+	assert.Contains(t, bv, "time_acked")
+	utils.MustMatch(t, sqltypes.TestBindVariable([]interface{}{"1", "2"}), bv["ids"], "did not match")
+
+	// Messages are pending for the group until ack wait time elapses.
+	before := time.Now().Add(ti.MessageInfo.AckWaitDuration).UnixNano()
+	query, bv = gm.GenerateConsumerGroupPostponeQuery([]string{"1", "2"})
+	assert.Equal(t, "insert into foo_groups(group_name, id, time_next, epoch) select 'g1', id, :time_next, 1 from foo where id in ::ids on duplicate key update time_next = :time_next, epoch = ifnull(foo_groups.epoch, 0)+1", query)
+	v, err := sqltypes.BindVariableToValue(bv["time_next"])
+	require.NoError(t, err)
+	timeNext, err := v.ToInt64()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, timeNext, before)
+	utils.MustMatch(t, sqltypes.TestBindVariable([]interface{}{"1", "2"}), bv["ids"], "did not match")
+}
+
+func TestMMDeliverAt(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.HasDeliverAt = true
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+
+	assert.Equal(t, "select priority, time_next, epoch, time_acked, deliver_at, id, message from foo", mm.vsFilter.Rules[0].Filter)
+	assert.Equal(t, "select priority, time_next, epoch, time_acked, deliver_at, id, message from foo where time_next < :time_next and (deliver_at is null or deliver_at < :time_next) order by priority, time_next desc limit :max", mm.readByPriorityAndTimeNext.Query)
+
+	// deliver_at delays time_next, and is not part of the message.
+	mr, err := mm.buildMessageRow([]sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(10),
+		sqltypes.NewInt64(0),
+		sqltypes.NULL,
+		sqltypes.NewInt64(20),
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarBinary("1"),
+	})
+	require.NoError(t, err)
+	want := &MessageRow{
+		Priority: 1,
+		TimeNext: 20,
+		Row:      []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("1")},
+	}
+	assert.Equal(t, want, mr)
+
+	// A past deliver_at doesn't change time_next.
+	mr, err = mm.buildMessageRow([]sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(10),
+		sqltypes.NewInt64(0),
+		sqltypes.NULL,
+		sqltypes.NewInt64(5),
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarBinary("1"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want.Row, mr.Row)
+	assert.Equal(t, int64(10), mr.TimeNext)
+}
+
 func TestMMGenerateWithBackoff(t *testing.T) {
 	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithBackoff(), sync2.NewSemaphore(1, 0))
 	mm.Open()
@@ -832,6 +1032,8 @@ type fakeTabletServer struct {
 
 	mu sync.Mutex
 	ch chan string
+	// postponeGroupErr is returned by PostponeConsumerGroupMessages.
+	postponeGroupErr error
 }
 
 func newFakeTabletServer() *fakeTabletServer {
@@ -871,6 +1073,34 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []string) (count int64, err error) {
+	fts.mu.Lock()
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		if consumerGroup != "" {
+			ch <- "deadletter:" + consumerGroup
+		} else {
+			ch <- "deadletter"
+		}
+	}
+	return int64(len(ids)), nil
+}
+
+func (fts *fakeTabletServer) PostponeConsumerGroupMessages(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []string) (count int64, err error) {
+	fts.mu.Lock()
+	ch := fts.ch
+	err = fts.postponeGroupErr
+	fts.mu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	if ch != nil {
+		ch <- "postpone:" + consumerGroup
+	}
+	return int64(len(ids)), nil
+}
+
 type fakeVStreamer struct {
 	streamInvocations sync2.AtomicInt64
 	mu                sync.Mutex
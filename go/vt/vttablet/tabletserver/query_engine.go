@@ -61,6 +61,17 @@ type TabletPlan struct {
 	Rules      *rules.Rules
 	Authorized []*tableacl.ACLResult
 
+	// ResultCacheTTL is set if the results of the plan can be
+	// served from the result cache. ResultCacheTables are the
+	// tables the results are read from.
+	ResultCacheTTL    time.Duration
+	ResultCacheTables []string
+
+	// HintedQueries holds, for each QRAddHints rule of the plan, the
+	// FullQuery of the plan with the hints of the rule applied. Rules
+	// whose hints don't apply to the query have no entry.
+	HintedQueries map[*rules.Rule]*sqlparser.ParsedQuery
+
 	QueryCount   uint64
 	Time         uint64
 	MysqlTime    uint64
@@ -98,6 +109,69 @@ func (ep *TabletPlan) buildAuthorized() {
 	}
 }
 
+// buildHintedQueries sets 'HintedQueries'. The statement of sql is
+// hinted by every QRAddHints rule of the plan in turn, and build makes
+// the plan of the result. A rule whose hints break the query is skipped:
+// the query then runs without its hints.
+func (ep *TabletPlan) buildHintedQueries(sql string, build func(sqlparser.Statement) (*planbuilder.Plan, error)) {
+	hintRules := ep.Rules.ByAction(rules.QRAddHints)
+	if len(hintRules) == 0 || ep.FullQuery == nil {
+		return
+	}
+	statement, err := sqlparser.Parse(sql)
+	if err != nil {
+		return
+	}
+	for _, rule := range hintRules {
+		hinted := sqlparser.CloneStatement(statement)
+		if !rule.ApplyHints(hinted, ep.TableName().String()) {
+			continue
+		}
+		splan, err := build(hinted)
+		if err != nil || splan.FullQuery == nil {
+			log.Warningf("could not apply the hints of rule %s: %v", rule.Name, err)
+			continue
+		}
+		if ep.HintedQueries == nil {
+			ep.HintedQueries = make(map[*rules.Rule]*sqlparser.ParsedQuery)
+		}
+		ep.HintedQueries[rule] = splan.FullQuery
+	}
+}
+
+// buildResultCache sets 'ResultCacheTTL' and 'ResultCacheTables' if the
+// results of the plan can be cached. Only selects which read from tables
+// of the current database qualify.
+func (ep *TabletPlan) buildResultCache(statement sqlparser.Statement, rc *ResultCache) {
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok || ep.PlanID != planbuilder.PlanSelect || !rc.Enabled() {
+		return
+	}
+	qualified := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && !tableName.Qualifier.IsEmpty() {
+			qualified = true
+			return false, nil
+		}
+		return true, nil
+	}, sel)
+	if qualified {
+		return
+	}
+	var tables []string
+	seen := make(map[string]bool)
+	for _, perm := range ep.Permissions {
+		if !seen[perm.TableName] {
+			seen[perm.TableName] = true
+			tables = append(tables, perm.TableName)
+		}
+	}
+	if ttl := rc.TTL(sel, tables); ttl > 0 {
+		ep.ResultCacheTTL = ttl
+		ep.ResultCacheTables = tables
+	}
+}
+
 //_______________________________________________
 
 // QueryEngine implements the core functionality of tabletserver.
@@ -125,6 +199,7 @@ type QueryEngine struct {
 	// Services
 	consolidator       *sync2.Consolidator
 	streamConsolidator *StreamConsolidator
+	resultCache        *ResultCache
 	// txSerializer protects vttablet from applications which try to concurrently
 	// UPDATE (or DELETE) a "hot" row (or range of rows).
 	// Such queries would be serialized by MySQL anyway. This serializer prevents
@@ -159,7 +234,7 @@ type QueryEngine struct {
 // NewQueryEngine creates a new QueryEngine.
 // This is a singleton class.
 // You must call this only once.
-func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
+func NewQueryEngine(env tabletenv.Env, se *schema.Engine, vs VStreamer) *QueryEngine {
 	config := env.Config()
 	cacheCfg := &cache.Config{
 		MaxEntries:     int64(config.QueryCacheSize),
@@ -183,6 +258,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	if config.ConsolidatorStreamTotalSize > 0 && config.ConsolidatorStreamQuerySize > 0 {
 		qe.streamConsolidator = NewStreamConsolidator(config.ConsolidatorStreamTotalSize, config.ConsolidatorStreamQuerySize, returnStreamResult)
 	}
+	qe.resultCache = NewResultCache(env, vs)
 	qe.txSerializer = txserializer.New(env)
 
 	qe.strictTableACL = config.StrictTableACL
@@ -263,6 +339,7 @@ func (qe *QueryEngine) Open() error {
 
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
+	qe.resultCache.Open()
 	qe.isOpen = true
 	return nil
 }
@@ -275,6 +352,7 @@ func (qe *QueryEngine) Close() {
 		return
 	}
 	// Close in reverse order of Open.
+	qe.resultCache.Close()
 	qe.se.UnregisterNotifier("qe")
 	qe.plans.Clear()
 	qe.tables = make(map[string]*schema.Table)
@@ -313,6 +391,10 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	plan.buildHintedQueries(sql, func(statement sqlparser.Statement) (*planbuilder.Plan, error) {
+		return planbuilder.Build(statement, qe.tables, isReservedConn, qe.env.Config().DB.DBName)
+	})
+	plan.buildResultCache(statement, qe.resultCache)
 	if plan.PlanID.IsSelect() {
 		if !skipQueryPlanCache && qe.enableQueryPlanFieldCaching && plan.FieldQuery != nil {
 			conn, err := qe.conns.Get(ctx)
@@ -351,6 +433,9 @@ func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPl
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	plan.buildHintedQueries(sql, func(statement sqlparser.Statement) (*planbuilder.Plan, error) {
+		return planbuilder.BuildStreaming(sqlparser.String(statement), qe.tables, isReservedConn)
+	})
 	return plan, nil
 }
 
@@ -62,7 +62,7 @@ func TestStrictMode(t *testing.T) {
 	config.DB = newDBConfigs(db)
 	env := tabletenv.NewEnv(config, "TabletServerTest")
 	se := schema.NewEngine(env)
-	qe := NewQueryEngine(env, se)
+	qe := NewQueryEngine(env, se, nil)
 	qe.se.InitDBConfig(newDBConfigs(db).DbaWithDB())
 	qe.se.Open()
 	if err := qe.Open(); err != nil {
@@ -78,7 +78,7 @@ func TestStrictMode(t *testing.T) {
 			Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("")}},
 		},
 	)
-	qe = NewQueryEngine(env, se)
+	qe = NewQueryEngine(env, se, nil)
 	err := qe.Open()
 	wantErr := "require sql_mode to be STRICT_TRANS_TABLES or STRICT_ALL_TABLES: got ''"
 	if err == nil || err.Error() != wantErr {
@@ -88,7 +88,7 @@ func TestStrictMode(t *testing.T) {
 
 	// Test that we succeed if the enforcement flag is off.
 	config.EnforceStrictTransTables = false
-	qe = NewQueryEngine(env, se)
+	qe = NewQueryEngine(env, se, nil)
 	if err := qe.Open(); err != nil {
 		t.Fatal(err)
 	}
@@ -317,7 +317,7 @@ func newTestQueryEngine(idleTimeout time.Duration, strict bool, dbcfgs *dbconfig
 	config.TxPool.IdleTimeoutSeconds.Set(idleTimeout)
 	env := tabletenv.NewEnv(config, "TabletServerTest")
 	se := schema.NewEngine(env)
-	qe := NewQueryEngine(env, se)
+	qe := NewQueryEngine(env, se, nil)
 	se.InitDBConfig(dbcfgs.DbaWithDB())
 	return qe
 }
@@ -418,7 +418,7 @@ func benchmarkPlanCache(b *testing.B, db *fakesqldb.DB, lfu bool, par int) {
 
 	env := tabletenv.NewEnv(config, "TabletServerTest")
 	se := schema.NewEngine(env)
-	qe := NewQueryEngine(env, se)
+	qe := NewQueryEngine(env, se, nil)
 
 	se.InitDBConfig(dbcfgs.DbaWithDB())
 	require.NoError(b, se.Open())
@@ -484,7 +484,7 @@ func TestPlanCachePollution(t *testing.T) {
 
 	env := tabletenv.NewEnv(config, "TabletServerTest")
 	se := schema.NewEngine(env)
-	qe := NewQueryEngine(env, se)
+	qe := NewQueryEngine(env, se, nil)
 
 	se.InitDBConfig(dbcfgs.DbaWithDB())
 	se.Open()
@@ -58,6 +58,12 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+
+	// hintRule is the QRAddHints query rule triggered by the query, if any.
+	hintRule *rules.Rule
+	// releaseRule releases the slot taken by the query in the query rule
+	// which limits it, if any.
+	releaseRule func()
 }
 
 const streamRowsSize = 256
@@ -122,6 +128,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		qre.tsv.Stats().ResultHistogram.Add(int64(len(reply.Rows)))
 	}(time.Now())
 
+	defer qre.releaseRuleSlot()
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
@@ -274,6 +281,7 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
 	}(time.Now())
 
+	defer qre.releaseRuleSlot()
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
@@ -341,8 +349,9 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 	})
 }
 
-// MessageStream streams messages from a message table.
-func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
+// MessageStream streams messages from a message table, or from one
+// of its consumer groups if consumerGroup is not empty.
+func (qre *QueryExecutor) MessageStream(consumerGroup string, callback StreamCallback) error {
 	qre.logStats.OriginalSQL = qre.query
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
@@ -351,11 +360,12 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 		qre.recordUserQuery("MessageStream", int64(time.Since(start)))
 	}(time.Now())
 
+	defer qre.releaseRuleSlot()
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
 
-	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), func(r *sqltypes.Result) error {
+	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), consumerGroup, func(r *sqltypes.Result) error {
 		select {
 		case <-qre.ctx.Done():
 			return io.EOF
@@ -386,12 +396,10 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars, qre.marginComments)
-	switch action {
-	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
-	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	for _, rule := range qre.plan.Rules.GetRules(remoteAddr, username, qre.bindVars, qre.marginComments) {
+		if err := qre.applyRule(rule); err != nil {
+			return err
+		}
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -429,6 +437,49 @@ func (qre *QueryExecutor) checkPermissions() error {
 	return nil
 }
 
+// applyRule performs the action of a query rule triggered by the query.
+func (qre *QueryExecutor) applyRule(rule *rules.Rule) error {
+	result := "Admitted"
+	defer func() {
+		qre.tsv.Stats().QueryRuleActions.Add([]string{rule.Name, rule.Action().String(), result}, 1)
+	}()
+
+	switch rule.Action() {
+	case rules.QRFail:
+		result = "Rejected"
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", rule.Description)
+	case rules.QRFailRetry:
+		result = "Rejected"
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", rule.Description)
+	case rules.QRThrottle, rules.QRLimitConcurrency:
+		release, ok := rule.Acquire()
+		if !ok {
+			result = "Rejected"
+			return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "throttled due to rule: %s", rule.Description)
+		}
+		qre.releaseRule = release
+	case rules.QRAddHints:
+		// If several rules add hints, the first one wins.
+		if qre.hintRule == nil {
+			qre.hintRule = rule
+		}
+	case rules.QRLog:
+		if rule.ShouldLog() {
+			log.Infof("query triggered rule %s: %s", rule.Name, queryAsString(qre.query, qre.bindVars))
+		}
+	}
+	return nil
+}
+
+// releaseRuleSlot releases the slot taken by the query in a query rule
+// which limits its concurrency.
+func (qre *QueryExecutor) releaseRuleSlot() {
+	if qre.releaseRule != nil {
+		qre.releaseRule()
+		qre.releaseRule = nil
+	}
+}
+
 func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
 	if !authorized.IsMember(callerID) {
@@ -593,6 +644,30 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 // execSelect sends a query to mysql only if another identical query is not running. Otherwise, it waits and
 // reuses the result. If the plan is missing field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
+	if qre.plan.ResultCacheTTL == 0 {
+		return qre.fetchSelect()
+	}
+	_, key, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rc := qre.tsv.qe.resultCache
+	if result, ok := rc.Get(key); ok {
+		qre.logStats.QuerySources |= tabletenv.QuerySourceResultCache
+		return result, nil
+	}
+	// The snapshot must be taken before sending the query, so that
+	// the changes made while it runs drop its result.
+	epoch, generations := rc.Snapshot(qre.plan.ResultCacheTables)
+	result, err := qre.fetchSelect()
+	if err != nil {
+		return nil, err
+	}
+	rc.Set(key, qre.plan.ResultCacheTables, epoch, generations, result, qre.plan.ResultCacheTTL)
+	return result, nil
+}
+
+func (qre *QueryExecutor) fetchSelect() (*sqltypes.Result, error) {
 	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil {
 		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
 		if err != nil {
@@ -745,6 +820,9 @@ func (qre *QueryExecutor) txFetch(conn *StatefulConnection, record bool) (*sqlty
 }
 
 func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (string, string, error) {
+	if hinted, ok := qre.plan.HintedQueries[qre.hintRule]; ok && parsedQuery == qre.plan.FullQuery {
+		parsedQuery = hinted
+	}
 	query, err := parsedQuery.GenerateQuery(bindVars, nil)
 	if err != nil {
 		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
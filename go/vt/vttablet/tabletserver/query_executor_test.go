@@ -45,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -694,7 +695,7 @@ func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	}
 
 	// Should not fail because u1 has permission.
-	err = qre.MessageStream(func(qr *sqltypes.Result) error {
+	err = qre.MessageStream("", func(qr *sqltypes.Result) error {
 		return io.EOF
 	})
 	if err != nil {
@@ -706,7 +707,7 @@ func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	}
 	qre.ctx = callerid.NewContext(context.Background(), nil, callerID)
 	// Should fail because u2 does not have permission.
-	err = qre.MessageStream(func(qr *sqltypes.Result) error {
+	err = qre.MessageStream("", func(qr *sqltypes.Result) error {
 		return io.EOF
 	})
 
@@ -1108,6 +1109,178 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorRuleThrottle(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	db.AddQuery("select * from test_table where `name` = 1 limit 1000", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	throttleRule := rules.NewQueryRule("throttle select", "throttle select", rules.QRFail)
+	require.NoError(t, throttleRule.SetConcurrencyLimit(1))
+	throttleRule.AddTableCond("test_table")
+
+	rulesName := "throttleRules"
+	qrs := rules.New()
+	qrs.Add(throttleRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	// Take the only slot of the rule, as a running query would. The copies of
+	// the rule in the query plans share the slots of the rule.
+	release, ok := throttleRule.Acquire()
+	require.True(t, ok)
+
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualValues(t, 1, tsv.Stats().QueryRuleActions.Counts()["throttle select.LIMIT_CONCURRENCY.Rejected"])
+
+	release()
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	// The slot was released by the query.
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, tsv.Stats().QueryRuleActions.Counts()["throttle select.LIMIT_CONCURRENCY.Admitted"])
+}
+
+func TestQueryExecutorRuleAddHints(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery("select /*+ MAX_EXECUTION_TIME(100) */ * from test_table use index (name_idx) where `name` = 1 limit 1000", want)
+
+	hintsRule := rules.NewQueryRule("hint select", "hint select", rules.QRFail)
+	require.NoError(t, hintsRule.SetHints(100, "name_idx"))
+	hintsRule.AddPlanCond(planbuilder.PlanSelect)
+
+	rulesName := "hintsRules"
+	qrs := rules.New()
+	qrs.Add(hintsRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	got, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want.Fields, got.Fields)
+
+	// A rule which adds hints doesn't shadow the rules after it.
+	failRule := rules.NewQueryRule("fail select", "fail select", rules.QRFail)
+	failRule.AddPlanCond(planbuilder.PlanSelect)
+	qrs.Add(failRule)
+	require.NoError(t, tsv.SetQueryRules(rulesName, qrs))
+
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disallowed due to rule: fail select")
+}
+
+func TestQueryExecutorResultCache(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt32(1),
+			sqltypes.NewInt32(2),
+			sqltypes.NewInt32(3),
+		}},
+	}
+	db.AddQuery("select * from test_table where `name` = 1 limit 10001", want)
+	db.AddQuery("select * from test_table where `name` = 2 limit 10001", want)
+	db.AddQuery("select /*vt+ RESULT_CACHE_TTL_MS=0 */ * from test_table where `name` = 3 limit 10001", want)
+	db.AddQuery("select * from msg where id = 1 limit 10001", want)
+	db.AddQuery("select /*vt+ RESULT_CACHE_TTL_MS=1000 */ * from msg where id = 2 limit 10001", want)
+	db.AddQuery("select * from test_table as t join msg as m on t.pk = m.id limit 10001", want)
+	db.AddQuery("select * from test_table as t join msg as m on t.pk = m.id where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableResultCache, db)
+	defer tsv.StopService()
+	// Stop the replication stream, which can't be watched with a fake
+	// database, and pretend it's being watched.
+	rc := tsv.qe.resultCache
+	rc.Close()
+	rc.setStreaming(true)
+
+	testcases := []struct {
+		query  string
+		cached bool
+	}{{
+		query:  "select * from test_table where name = 1",
+		cached: true,
+	}, {
+		query:  "select * from test_table where name = 2",
+		cached: true,
+	}, {
+		// The directive opts out.
+		query:  "select /*vt+ RESULT_CACHE_TTL_MS=0 */ * from test_table where name = 3",
+		cached: false,
+	}, {
+		query:  "select * from msg where id = 1",
+		cached: false,
+	}, {
+		// The directive opts in.
+		query:  "select /*vt+ RESULT_CACHE_TTL_MS=1000 */ * from msg where id = 2",
+		cached: true,
+	}, {
+		// Not all the tables are cached.
+		query:  "select * from test_table as t join msg as m on t.pk = m.id",
+		cached: false,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			for i := 0; i < 2; i++ {
+				qre := newTestQueryExecutor(ctx, tsv, tcase.query, 0)
+				got, err := qre.Execute()
+				require.NoError(t, err)
+				assert.Equal(t, want.Rows, got.Rows)
+				fromCache := qre.logStats.QuerySources&tabletenv.QuerySourceResultCache != 0
+				assert.Equal(t, tcase.cached && i == 1, fromCache, "execution %d", i)
+			}
+		})
+	}
+
+	// A change on the table invalidates its results only.
+	rc.handleEvents([]*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "test_table"},
+	}})
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table where name = 1", 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "mysql", qre.logStats.FmtQuerySources())
+	qre = newTestQueryExecutor(ctx, tsv, "select /*vt+ RESULT_CACHE_TTL_MS=1000 */ * from msg where id = 2", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "resultcache", qre.logStats.FmtQuerySources())
+
+	// Selects in transactions are never served from the cache.
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, "select * from test_table where name = 1", txid)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "mysql", qre.logStats.FmtQuerySources())
+	_, err = tsv.Rollback(ctx, tsv.sm.Target(), txid)
+	require.NoError(t, err)
+}
+
 type executorFlags int64
 
 const (
@@ -1117,6 +1290,7 @@ const (
 	noTwopc
 	shortTwopcAge
 	smallResultSize
+	enableResultCache
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1147,6 +1321,10 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&enableResultCache > 0 {
+		config.ResultCache.Size = 100
+		config.ResultCache.Tables = []string{"test_table"}
+	}
 	dbconfigs := newDBConfigs(db)
 	config.DB = dbconfigs
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
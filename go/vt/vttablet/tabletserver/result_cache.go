@@ -0,0 +1,287 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// ResultCache caches the results of the selects on the tables which
+// opted in, either through the config or through a RESULT_CACHE_TTL_MS
+// comment directive. Entries expire after their TTL, and are
+// invalidated as soon as the replication stream shows a change on one
+// of the tables they were read from.
+//
+// Invalidation is done by bumping a per-table generation: an entry is
+// only served if the generations of its tables did not change since
+// the query that produced it was sent to MySQL. This also covers the
+// changes that happen while that query is in flight.
+//
+// Only the results of selects are cached. DMLs are neither cached nor
+// consolidated: each of them must be applied.
+type ResultCache struct {
+	env    tabletenv.Env
+	vs     VStreamer
+	ttl    time.Duration
+	tables map[string]bool
+
+	entries *cache.LRUCache
+
+	// mu protects the following fields.
+	mu sync.Mutex
+	// epoch is bumped when the whole cache is invalidated.
+	epoch       int64
+	generations map[string]int64
+	// streaming is true while the replication stream is being
+	// watched. Results are not cached otherwise.
+	streaming bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	hits, misses, invalidations *stats.Counter
+}
+
+type resultCacheEntry struct {
+	result      *sqltypes.Result
+	expires     time.Time
+	tables      []string
+	epoch       int64
+	generations []int64
+}
+
+// NewResultCache creates a new ResultCache. The cache is
+// disabled if the configured size is 0.
+func NewResultCache(env tabletenv.Env, vs VStreamer) *ResultCache {
+	config := env.Config().ResultCache
+	rc := &ResultCache{
+		env:         env,
+		vs:          vs,
+		ttl:         config.TTLSeconds.Get(),
+		tables:      make(map[string]bool),
+		generations: make(map[string]int64),
+	}
+	for _, table := range config.Tables {
+		rc.tables[table] = true
+	}
+	if config.Size > 0 {
+		rc.entries = cache.NewLRUCache(int64(config.Size), func(_ interface{}) int64 {
+			return 1
+		})
+	}
+	rc.hits = env.Exporter().NewCounter("ResultCacheHits", "Query engine result cache hits")
+	rc.misses = env.Exporter().NewCounter("ResultCacheMisses", "Query engine result cache misses")
+	rc.invalidations = env.Exporter().NewCounter("ResultCacheInvalidations", "Query engine result cache invalidations")
+	env.Exporter().NewGaugeFunc("ResultCacheLength", "Query engine result cache length", func() int64 {
+		if rc.entries == nil {
+			return 0
+		}
+		return int64(rc.entries.Len())
+	})
+	return rc
+}
+
+// Enabled returns true if the result cache is enabled.
+func (rc *ResultCache) Enabled() bool {
+	return rc.entries != nil
+}
+
+// Open starts watching the replication stream.
+func (rc *ResultCache) Open() {
+	if rc.cancel != nil || !rc.Enabled() || rc.vs == nil {
+		return
+	}
+	log.Info("Result Cache: opening")
+
+	ctx, cancel := context.WithCancel(tabletenv.LocalContext())
+	rc.cancel = cancel
+	rc.wg.Add(1)
+	go rc.process(ctx)
+}
+
+// Close stops watching the replication stream and
+// empties the cache.
+func (rc *ResultCache) Close() {
+	if rc.cancel == nil {
+		return
+	}
+	rc.cancel()
+	rc.cancel = nil
+	rc.wg.Wait()
+	rc.InvalidateAll()
+	log.Info("Result Cache: closed")
+}
+
+// TTL returns how long the results of the select can be cached,
+// or 0 if they must not be cached. The RESULT_CACHE_TTL_MS directive
+// takes precedence over the configured tables.
+func (rc *ResultCache) TTL(sel *sqlparser.Select, tables []string) time.Duration {
+	if !rc.Enabled() || len(tables) == 0 {
+		return 0
+	}
+	directives := sqlparser.ExtractCommentDirectives(sel.Comments)
+	if val, ok := directives[sqlparser.DirectiveResultCacheTTL]; ok {
+		ms, ok := val.(int)
+		if !ok || ms < 0 {
+			return 0
+		}
+		return time.Duration(ms) * time.Millisecond
+	}
+	for _, table := range tables {
+		if !rc.tables[table] {
+			return 0
+		}
+	}
+	return rc.ttl
+}
+
+// Get returns a copy of the cached result of the query, if it's still
+// valid. The caller owns the copy.
+func (rc *ResultCache) Get(key string) (*sqltypes.Result, bool) {
+	v, ok := rc.entries.Get(key)
+	if !ok {
+		rc.misses.Add(1)
+		return nil, false
+	}
+	entry := v.(*resultCacheEntry)
+	if time.Now().After(entry.expires) || !rc.isCurrent(entry.tables, entry.epoch, entry.generations) {
+		rc.entries.Delete(key)
+		rc.misses.Add(1)
+		return nil, false
+	}
+	rc.hits.Add(1)
+	return entry.result.Copy(), true
+}
+
+// Snapshot returns the generations of the tables. It must be called
+// before fetching the result that will be passed to Set.
+func (rc *ResultCache) Snapshot(tables []string) (epoch int64, generations []int64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	generations = make([]int64, len(tables))
+	for i, table := range tables {
+		generations[i] = rc.generations[table]
+	}
+	return rc.epoch, generations
+}
+
+// Set caches a copy of the result of the query for ttl. The result is
+// dropped if the tables changed since the snapshot was taken.
+func (rc *ResultCache) Set(key string, tables []string, epoch int64, generations []int64, result *sqltypes.Result, ttl time.Duration) {
+	if !rc.isCurrent(tables, epoch, generations) {
+		return
+	}
+	rc.entries.Set(key, &resultCacheEntry{
+		result:      result.Copy(),
+		expires:     time.Now().Add(ttl),
+		tables:      tables,
+		epoch:       epoch,
+		generations: generations,
+	})
+}
+
+func (rc *ResultCache) isCurrent(tables []string, epoch int64, generations []int64) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !rc.streaming || rc.epoch != epoch {
+		return false
+	}
+	for i, table := range tables {
+		if rc.generations[table] != generations[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Invalidate invalidates the cached results that were read from the table.
+func (rc *ResultCache) Invalidate(table string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generations[table]++
+	rc.invalidations.Add(1)
+}
+
+// InvalidateAll invalidates all the cached results.
+func (rc *ResultCache) InvalidateAll() {
+	rc.mu.Lock()
+	rc.epoch++
+	rc.generations = make(map[string]int64)
+	rc.mu.Unlock()
+	rc.invalidations.Add(1)
+	if rc.entries != nil {
+		rc.entries.Clear()
+	}
+}
+
+func (rc *ResultCache) setStreaming(streaming bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.streaming = streaming
+}
+
+func (rc *ResultCache) process(ctx context.Context) {
+	defer rc.env.LogError()
+	defer rc.wg.Done()
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+
+	for {
+		err := rc.vs.Stream(ctx, "current", nil, filter, func(events []*binlogdatapb.VEvent) error {
+			rc.handleEvents(events)
+			return nil
+		})
+		// Changes are missed until the stream is restarted: stop
+		// caching and drop what could become stale.
+		rc.setStreaming(false)
+		rc.InvalidateAll()
+		log.Infof("Result Cache VStream ended: %v, retrying in 5 seconds", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (rc *ResultCache) handleEvents(events []*binlogdatapb.VEvent) {
+	for _, ev := range events {
+		switch ev.Type {
+		case binlogdatapb.VEventType_ROW:
+			rc.Invalidate(ev.RowEvent.TableName)
+		case binlogdatapb.VEventType_DDL:
+			rc.InvalidateAll()
+		}
+	}
+	// The stream is established once it sends its first events.
+	rc.setStreaming(true)
+}
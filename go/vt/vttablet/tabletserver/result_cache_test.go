@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func newTestResultCache(size int) *ResultCache {
+	config := tabletenv.NewDefaultConfig()
+	config.ResultCache.Size = size
+	config.ResultCache.TTLSeconds = 10
+	config.ResultCache.Tables = []string{"t1", "t2"}
+	rc := NewResultCache(tabletenv.NewEnv(config, "ResultCacheTest"), nil)
+	rc.setStreaming(true)
+	return rc
+}
+
+func TestResultCacheTTL(t *testing.T) {
+	rc := newTestResultCache(10)
+	testcases := []struct {
+		query  string
+		tables []string
+		ttl    time.Duration
+	}{{
+		query:  "select * from t1",
+		tables: []string{"t1"},
+		ttl:    10 * time.Second,
+	}, {
+		query:  "select * from t1 join t2",
+		tables: []string{"t1", "t2"},
+		ttl:    10 * time.Second,
+	}, {
+		query:  "select * from t1 join t3",
+		tables: []string{"t1", "t3"},
+	}, {
+		query:  "select /*vt+ RESULT_CACHE_TTL_MS=100 */ * from t3",
+		tables: []string{"t3"},
+		ttl:    100 * time.Millisecond,
+	}, {
+		query:  "select /*vt+ RESULT_CACHE_TTL_MS=0 */ * from t1",
+		tables: []string{"t1"},
+	}, {
+		query:  "select /*vt+ RESULT_CACHE_TTL_MS=abc */ * from t1",
+		tables: []string{"t1"},
+	}, {
+		query: "select /*vt+ RESULT_CACHE_TTL_MS=100 */ now()",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := sqlparser.Parse(tcase.query)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.ttl, rc.TTL(stmt.(*sqlparser.Select), tcase.tables), tcase.query)
+	}
+
+	stmt, err := sqlparser.Parse("select * from t1")
+	require.NoError(t, err)
+	assert.Zero(t, newTestResultCache(0).TTL(stmt.(*sqlparser.Select), []string{"t1"}))
+}
+
+func TestResultCacheGetSet(t *testing.T) {
+	rc := newTestResultCache(10)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	tables := []string{"t1", "t2"}
+	// The counters are shared by the caches of the tests.
+	hits, misses := rc.hits.Get(), rc.misses.Get()
+
+	_, ok := rc.Get("q1")
+	assert.False(t, ok)
+	epoch, generations := rc.Snapshot(tables)
+	rc.Set("q1", tables, epoch, generations, result, time.Hour)
+	got, ok := rc.Get("q1")
+	require.True(t, ok)
+	assert.True(t, result.Equal(got), "got %v, want %v", got, result)
+
+	// A change on one of the tables invalidates the result.
+	rc.Invalidate("t3")
+	_, ok = rc.Get("q1")
+	assert.True(t, ok)
+	rc.Invalidate("t2")
+	_, ok = rc.Get("q1")
+	assert.False(t, ok)
+
+	// A change while the query runs drops its result.
+	epoch, generations = rc.Snapshot(tables)
+	rc.Invalidate("t1")
+	rc.Set("q1", tables, epoch, generations, result, time.Hour)
+	_, ok = rc.Get("q1")
+	assert.False(t, ok)
+
+	// Results expire.
+	epoch, generations = rc.Snapshot(tables)
+	rc.Set("q1", tables, epoch, generations, result, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	_, ok = rc.Get("q1")
+	assert.False(t, ok)
+
+	// Nothing is cached while the replication stream is not watched.
+	rc.setStreaming(false)
+	epoch, generations = rc.Snapshot(tables)
+	rc.Set("q1", tables, epoch, generations, result, time.Hour)
+	_, ok = rc.Get("q1")
+	assert.False(t, ok)
+
+	assert.EqualValues(t, 2, rc.hits.Get()-hits)
+	assert.EqualValues(t, 5, rc.misses.Get()-misses)
+}
+
+func TestResultCacheCopies(t *testing.T) {
+	rc := newTestResultCache(10)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	want := result.Copy()
+	tables := []string{"t1"}
+
+	// Neither the result which was cached nor the ones which are served
+	// share their memory with the cache.
+	epoch, generations := rc.Snapshot(tables)
+	rc.Set("q1", tables, epoch, generations, result, time.Hour)
+	result.Rows[0][0] = sqltypes.NewInt64(2)
+	got, ok := rc.Get("q1")
+	require.True(t, ok)
+	assert.True(t, want.Equal(got), "got %v, want %v", got, want)
+	got.Fields = nil
+	got.Rows[0][0] = sqltypes.NewInt64(3)
+	got, ok = rc.Get("q1")
+	require.True(t, ok)
+	assert.True(t, want.Equal(got), "got %v, want %v", got, want)
+}
+
+func TestResultCacheConcurrency(t *testing.T) {
+	// Run with -race: the results served concurrently are modified the
+	// way TabletServer.Execute does.
+	rc := newTestResultCache(10)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "1|a", "2|b")
+	tables := []string{"t1"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("q%d", i%3)
+			for j := 0; j < 100; j++ {
+				if result, ok := rc.Get(key); ok {
+					if !assert.True(t, want.Equal(result), "got %v, want %v", result, want) {
+						return
+					}
+					result.Fields = nil
+					result.Rows = result.Rows[:1]
+					result.Rows[0][1] = sqltypes.NewVarChar("z")
+					continue
+				}
+				epoch, generations := rc.Snapshot(tables)
+				result := want.Copy()
+				rc.Set(key, tables, epoch, generations, result, time.Hour)
+				result.Rows[1][0] = sqltypes.NewInt64(3)
+				if j%10 == 0 {
+					rc.Invalidate("t1")
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestResultCacheEvents(t *testing.T) {
+	rc := newTestResultCache(10)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	set := func(key string, tables ...string) {
+		epoch, generations := rc.Snapshot(tables)
+		rc.Set(key, tables, epoch, generations, result, time.Hour)
+	}
+	cached := func(key string) bool {
+		_, ok := rc.Get(key)
+		return ok
+	}
+
+	set("q1", "t1")
+	set("q2", "t2")
+	rc.handleEvents([]*binlogdatapb.VEvent{{
+		Type: binlogdatapb.VEventType_BEGIN,
+	}, {
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "t1"},
+	}, {
+		Type: binlogdatapb.VEventType_COMMIT,
+	}})
+	assert.False(t, cached("q1"))
+	assert.True(t, cached("q2"))
+
+	set("q1", "t1")
+	rc.handleEvents([]*binlogdatapb.VEvent{{
+		Type:      binlogdatapb.VEventType_DDL,
+		Statement: "alter table t3 add column c int",
+	}})
+	assert.False(t, cached("q1"))
+	assert.False(t, cached("q2"))
+}
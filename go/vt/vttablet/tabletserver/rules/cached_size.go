@@ -43,7 +43,7 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(232)
+		size += int64(304)
 	}
 	// field Description string
 	size += int64(len(cached.Description))
@@ -77,6 +77,12 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
+	// field useIndex string
+	size += int64(len(cached.useIndex))
+	// field limiter *vitess.io/vitess/go/vt/vttablet/tabletserver/rules.actionLimiter
+	size += cached.limiter.CachedSize(true)
+	// field maxExecutionTimeHint string
+	size += int64(len(cached.maxExecutionTimeHint))
 	return size
 }
 func (cached *Rules) CachedSize(alloc bool) int64 {
@@ -96,6 +102,18 @@ func (cached *Rules) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *actionLimiter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field rateLimiter *vitess.io/vitess/go/ratelimiter.RateLimiter
+	size += cached.rateLimiter.CachedSize(true)
+	return size
+}
 func (cached *bvcre) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
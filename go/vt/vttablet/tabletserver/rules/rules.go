@@ -20,13 +20,19 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/rand"
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
+	"vitess.io/vitess/go/ratelimiter"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -153,6 +159,17 @@ func (qrs *Rules) MarshalJSON() ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+// ByAction returns the rules which perform act, in order.
+func (qrs *Rules) ByAction(act Action) []*Rule {
+	var matched []*Rule
+	for _, qr := range qrs.rules {
+		if qr.act == act {
+			matched = append(matched, qr)
+		}
+	}
+	return matched
+}
+
 // FilterByPlan creates a new Rules by prefiltering on the query and planId. This allows
 // us to create query plan specific Rules out of the original Rules. In the new rules,
 // query, plans and tableNames predicates are empty.
@@ -167,18 +184,48 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 }
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
+// It is the action of the terminal rule which fires, if any, or else of the
+// first rule which fires.
 func (qrs *Rules) GetAction(
 	ip,
 	user string,
 	bindVars map[string]*querypb.BindVariable,
 	marginComments sqlparser.MarginComments,
 ) (action Action, desc string) {
+	fired := qrs.GetRules(ip, user, bindVars, marginComments)
+	if len(fired) == 0 {
+		return QRContinue, ""
+	}
+	qr := fired[0]
+	if last := fired[len(fired)-1]; last.act.IsTerminal() {
+		qr = last
+	}
+	return qr.act, qr.Description
+}
+
+// GetRules runs the input against the rules engine and returns the rules
+// which fire, in order. Rules with a non-terminal action, like QRLog or
+// QRAddHints, don't stop the evaluation: it goes on until a rule with a
+// terminal action fires, which is then the last rule returned. Unlike
+// GetAction, it gives access to the parameters of the actions.
+func (qrs *Rules) GetRules(
+	ip,
+	user string,
+	bindVars map[string]*querypb.BindVariable,
+	marginComments sqlparser.MarginComments,
+) []*Rule {
+	var fired []*Rule
 	for _, qr := range qrs.rules {
-		if act := qr.GetAction(ip, user, bindVars, marginComments); act != QRContinue {
-			return act, qr.Description
+		act := qr.GetAction(ip, user, bindVars, marginComments)
+		if act == QRContinue {
+			continue
+		}
+		fired = append(fired, qr)
+		if act.IsTerminal() {
+			break
 		}
 	}
-	return QRContinue, ""
+	return fired
 }
 
 //-----------------------------------------------
@@ -211,6 +258,30 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the action. maxQPS is used by QRThrottle, maxConcurrency
+	// by QRLimitConcurrency, maxExecutionTime (in milliseconds) and useIndex
+	// by QRAddHints, and sampleRate by QRLog.
+	maxQPS           int64
+	maxConcurrency   int64
+	maxExecutionTime int64
+	useIndex         string
+	sampleRate       float64
+
+	// maxExecutionTimeHint is the optimizer hint built from maxExecutionTime.
+	maxExecutionTimeHint string
+
+	// limiter keeps track of the queries admitted by a QRThrottle or
+	// QRLimitConcurrency rule. It is shared by all the copies of the rule,
+	// so that the limits apply across all the plans the rule was filtered into.
+	limiter *actionLimiter
+}
+
+// actionLimiter holds the state of the QRThrottle and QRLimitConcurrency
+// actions.
+type actionLimiter struct {
+	rateLimiter *ratelimiter.RateLimiter
+	concurrency sync2.AtomicInt64
 }
 
 type namedRegexp struct {
@@ -252,20 +323,33 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.maxQPS == other.maxQPS &&
+		qr.maxConcurrency == other.maxConcurrency &&
+		qr.maxExecutionTime == other.maxExecutionTime &&
+		qr.useIndex == other.useIndex &&
+		qr.sampleRate == other.sampleRate)
 }
 
 // Copy performs a deep copy of a Rule.
 func (qr *Rule) Copy() (newqr *Rule) {
 	newqr = &Rule{
-		Description:     qr.Description,
-		Name:            qr.Name,
-		requestIP:       qr.requestIP,
-		user:            qr.user,
-		query:           qr.query,
-		leadingComment:  qr.leadingComment,
-		trailingComment: qr.trailingComment,
-		act:             qr.act,
+		Description:      qr.Description,
+		Name:             qr.Name,
+		requestIP:        qr.requestIP,
+		user:             qr.user,
+		query:            qr.query,
+		leadingComment:   qr.leadingComment,
+		trailingComment:  qr.trailingComment,
+		act:              qr.act,
+		maxQPS:           qr.maxQPS,
+		maxConcurrency:   qr.maxConcurrency,
+		maxExecutionTime: qr.maxExecutionTime,
+		useIndex:         qr.useIndex,
+		sampleRate:       qr.sampleRate,
+		limiter:          qr.limiter,
+
+		maxExecutionTimeHint: qr.maxExecutionTimeHint,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -314,6 +398,21 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.maxQPS != 0 {
+		safeEncode(b, `,"MaxQPS":`, qr.maxQPS)
+	}
+	if qr.maxConcurrency != 0 {
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	}
+	if qr.maxExecutionTime != 0 {
+		safeEncode(b, `,"MaxExecutionTime":`, qr.maxExecutionTime)
+	}
+	if qr.useIndex != "" {
+		safeEncode(b, `,"UseIndex":`, qr.useIndex)
+	}
+	if qr.sampleRate != 0 {
+		safeEncode(b, `,"SampleRate":`, qr.sampleRate)
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -369,6 +468,64 @@ func (qr *Rule) SetTrailingCommentCond(pattern string) (err error) {
 	return
 }
 
+// SetThrottle sets the action of the rule to QRThrottle. Queries which
+// trigger the rule are rejected once they exceed maxQPS queries per second.
+func (qr *Rule) SetThrottle(maxQPS int64) error {
+	if maxQPS <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxQPS must be positive: %d", maxQPS)
+	}
+	qr.act = QRThrottle
+	qr.maxQPS = maxQPS
+	qr.limiter = &actionLimiter{rateLimiter: ratelimiter.NewRateLimiter(int(maxQPS), time.Second)}
+	return nil
+}
+
+// SetConcurrencyLimit sets the action of the rule to QRLimitConcurrency.
+// Queries which trigger the rule are rejected while maxConcurrency of them
+// are already executing.
+func (qr *Rule) SetConcurrencyLimit(maxConcurrency int64) error {
+	if maxConcurrency <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxConcurrency must be positive: %d", maxConcurrency)
+	}
+	qr.act = QRLimitConcurrency
+	qr.maxConcurrency = maxConcurrency
+	qr.limiter = &actionLimiter{}
+	return nil
+}
+
+// SetHints sets the action of the rule to QRAddHints. If maxExecutionTime
+// is not 0, a MAX_EXECUTION_TIME optimizer hint, in milliseconds, is added
+// to the SELECT queries which trigger the rule. If useIndex is not empty, a
+// USE INDEX hint is added for the table of the query.
+func (qr *Rule) SetHints(maxExecutionTime int64, useIndex string) error {
+	if maxExecutionTime < 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxExecutionTime must not be negative: %d", maxExecutionTime)
+	}
+	if maxExecutionTime == 0 && useIndex == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxExecutionTime or UseIndex is required for ADD_HINTS")
+	}
+	qr.act = QRAddHints
+	qr.maxExecutionTime = maxExecutionTime
+	qr.useIndex = useIndex
+	qr.maxExecutionTimeHint = ""
+	if maxExecutionTime > 0 {
+		qr.maxExecutionTimeHint = fmt.Sprintf("/*+ MAX_EXECUTION_TIME(%d) */", maxExecutionTime)
+	}
+	return nil
+}
+
+// SetLog sets the action of the rule to QRLog. Queries which trigger the
+// rule are executed as usual, and logged with a probability of sampleRate.
+// A sampleRate of 0 logs all of them.
+func (qr *Rule) SetLog(sampleRate float64) error {
+	if sampleRate < 0 || sampleRate > 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "SampleRate must be between 0 and 1: %v", sampleRate)
+	}
+	qr.act = QRLog
+	qr.sampleRate = sampleRate
+	return nil
+}
+
 // makeExact forces a full string match for the regex instead of substring
 func makeExact(pattern string) string {
 	return fmt.Sprintf("^%s$", pattern)
@@ -482,6 +639,77 @@ func (qr *Rule) GetAction(
 	return qr.act
 }
 
+// Action returns the action of the rule.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// Acquire admits a query which triggered a QRThrottle or QRLimitConcurrency
+// rule. It returns false if the query exceeds the limits of the rule.
+// Otherwise, release must be called once the query is done.
+func (qr *Rule) Acquire() (release func(), ok bool) {
+	if qr.limiter == nil {
+		return func() {}, true
+	}
+	switch qr.act {
+	case QRThrottle:
+		return func() {}, qr.limiter.rateLimiter.Allow()
+	case QRLimitConcurrency:
+		if qr.limiter.concurrency.Add(1) > qr.maxConcurrency {
+			qr.limiter.concurrency.Add(-1)
+			return nil, false
+		}
+		return func() { qr.limiter.concurrency.Add(-1) }, true
+	}
+	return func() {}, true
+}
+
+// ShouldLog returns true if a query which triggered a QRLog rule
+// should be logged, according to the sample rate of the rule.
+func (qr *Rule) ShouldLog() bool {
+	return qr.sampleRate == 0 || rand.Float64() < qr.sampleRate
+}
+
+// ApplyHints adds the hints of a QRAddHints rule to stmt, and returns
+// true if any applied. The USE INDEX hint is added to the references to
+// tableName which don't already have index hints. The MAX_EXECUTION_TIME
+// hint is only added to SELECT statements which don't already have
+// optimizer hints. It is meant to be called once per query plan, on a copy
+// of the statement of the plan.
+func (qr *Rule) ApplyHints(stmt sqlparser.Statement, tableName string) bool {
+	changed := false
+	if sel, ok := stmt.(*sqlparser.Select); ok && qr.maxExecutionTimeHint != "" && !hasOptimizerHints(sel.Comments) {
+		sel.Comments = append(sel.Comments, qr.maxExecutionTimeHint)
+		changed = true
+	}
+	if qr.useIndex != "" && tableName != "" {
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+			if !ok || tableExpr.Hints != nil {
+				return true, nil
+			}
+			if name, ok := tableExpr.Expr.(sqlparser.TableName); ok && name.Name.String() == tableName {
+				tableExpr.Hints = &sqlparser.IndexHints{
+					Type:    sqlparser.UseOp,
+					Indexes: []sqlparser.ColIdent{sqlparser.NewColIdent(qr.useIndex)},
+				}
+				changed = true
+			}
+			return true, nil
+		}, stmt)
+	}
+	return changed
+}
+
+func hasOptimizerHints(comments sqlparser.Comments) bool {
+	for _, comment := range comments {
+		if strings.HasPrefix(comment, "/*+") {
+			return true
+		}
+	}
+	return false
+}
+
 func reMatch(re *regexp.Regexp, val string) bool {
 	return re == nil || re.MatchString(val)
 }
@@ -533,21 +761,47 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	// QRThrottle rejects the queries which exceed a number of queries per second.
+	QRThrottle
+	// QRLimitConcurrency rejects the queries which exceed a number of
+	// concurrent executions.
+	QRLimitConcurrency
+	// QRAddHints adds MAX_EXECUTION_TIME or USE INDEX hints to the queries.
+	QRAddHints
+	// QRLog logs a sample of the queries, which are otherwise executed as usual.
+	QRLog
 )
 
-// MarshalJSON marshals to JSON.
-func (act Action) MarshalJSON() ([]byte, error) {
-	// If we add more actions, we'll need to use a map.
-	var str string
+var actionNames = map[Action]string{
+	QRFail:             "FAIL",
+	QRFailRetry:        "FAIL_RETRY",
+	QRThrottle:         "THROTTLE",
+	QRLimitConcurrency: "LIMIT_CONCURRENCY",
+	QRAddHints:         "ADD_HINTS",
+	QRLog:              "LOG",
+}
+
+// IsTerminal returns true if the action decides whether the query runs, in
+// which case the rules after the one which fired are not evaluated.
+func (act Action) IsTerminal() bool {
 	switch act {
-	case QRFail:
-		str = "FAIL"
-	case QRFailRetry:
-		str = "FAIL_RETRY"
-	default:
-		str = "INVALID"
+	case QRFail, QRFailRetry, QRThrottle, QRLimitConcurrency:
+		return true
 	}
-	return json.Marshal(str)
+	return false
+}
+
+// String returns the name of the action, as used in JSON.
+func (act Action) String() string {
+	if str, ok := actionNames[act]; ok {
+		return str
+	}
+	return "INVALID"
+}
+
+// MarshalJSON marshals to JSON.
+func (act Action) MarshalJSON() ([]byte, error) {
+	return json.Marshal(act.String())
 }
 
 // BindVarCond represents a bind var condition.
@@ -829,12 +1083,14 @@ func MapStrOperator(strop string) (op Operator, err error) {
 // BuildQueryRule builds a query rule from a ruleInfo.
 func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	qr = NewQueryRule("", "", QRFail)
+	// The parameters of the action are only applied once the action is known.
+	params := make(map[string]interface{})
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment", "UseIndex":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
@@ -844,6 +1100,10 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for %s", k)
 			}
+		case "MaxQPS", "MaxConcurrency", "MaxExecutionTime", "SampleRate":
+			if _, ok = toFloat64(v); !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
 		default:
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s", k)
 		}
@@ -909,19 +1169,101 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				}
 			}
 		case "Action":
-			switch sv {
-			case "FAIL":
-				qr.act = QRFail
-			case "FAIL_RETRY":
-				qr.act = QRFailRetry
-			default:
+			found := false
+			for act, name := range actionNames {
+				if sv == name {
+					qr.act = act
+					found = true
+					break
+				}
+			}
+			if !found {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "MaxQPS", "MaxConcurrency", "MaxExecutionTime", "SampleRate", "UseIndex":
+			params[k] = v
 		}
 	}
+	if err := qr.setActionParams(params); err != nil {
+		return nil, err
+	}
 	return qr, nil
 }
 
+// actionParams lists the parameters accepted by each action.
+var actionParams = map[Action][]string{
+	QRThrottle:         {"MaxQPS"},
+	QRLimitConcurrency: {"MaxConcurrency"},
+	QRAddHints:         {"MaxExecutionTime", "UseIndex"},
+	QRLog:              {"SampleRate"},
+}
+
+// setActionParams applies the parameters of the action of a rule built
+// from JSON.
+func (qr *Rule) setActionParams(params map[string]interface{}) error {
+	for k := range params {
+		valid := false
+		for _, name := range actionParams[qr.act] {
+			if k == name {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s is not supported for Action %s", k, qr.act)
+		}
+	}
+	getInt := func(k string) (int64, error) {
+		v, ok := params[k]
+		if !ok {
+			return 0, nil
+		}
+		f, _ := toFloat64(v)
+		if f != math.Trunc(f) {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want integer for %s: %v", k, v)
+		}
+		return int64(f), nil
+	}
+
+	switch qr.act {
+	case QRThrottle:
+		maxQPS, err := getInt("MaxQPS")
+		if err != nil {
+			return err
+		}
+		return qr.SetThrottle(maxQPS)
+	case QRLimitConcurrency:
+		maxConcurrency, err := getInt("MaxConcurrency")
+		if err != nil {
+			return err
+		}
+		return qr.SetConcurrencyLimit(maxConcurrency)
+	case QRAddHints:
+		maxExecutionTime, err := getInt("MaxExecutionTime")
+		if err != nil {
+			return err
+		}
+		useIndex, _ := params["UseIndex"].(string)
+		return qr.SetHints(maxExecutionTime, useIndex)
+	case QRLog:
+		sampleRate, _ := toFloat64(params["SampleRate"])
+		return qr.SetLog(sampleRate)
+	}
+	return nil
+}
+
+// toFloat64 converts a JSON number, decoded with or without UseNumber.
+func toFloat64(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
 func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
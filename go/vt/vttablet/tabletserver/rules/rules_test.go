@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -692,6 +693,14 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"Action": "THROTTLE", "MaxQPS": "1" }]`, "want number for MaxQPS"},
+	{`[{"Action": "THROTTLE" }]`, "MaxQPS must be positive: 0"},
+	{`[{"Action": "THROTTLE", "MaxQPS": 1.5 }]`, "want integer for MaxQPS: 1.5"},
+	{`[{"Action": "LIMIT_CONCURRENCY", "MaxConcurrency": -1 }]`, "MaxConcurrency must be positive: -1"},
+	{`[{"Action": "ADD_HINTS" }]`, "MaxExecutionTime or UseIndex is required for ADD_HINTS"},
+	{`[{"Action": "ADD_HINTS", "UseIndex": 1 }]`, "want string for UseIndex"},
+	{`[{"Action": "LOG", "SampleRate": 2 }]`, "SampleRate must be between 0 and 1: 2"},
+	{`[{"Action": "FAIL", "MaxQPS": 10 }]`, "MaxQPS is not supported for Action FAIL"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -770,3 +779,144 @@ func marshalled(in interface{}) string {
 	}
 	return string(b)
 }
+
+func TestNonTerminalActions(t *testing.T) {
+	qrs := New()
+	qrs.Add(NewQueryRule("log everything", "log", QRLog))
+	hints := NewQueryRule("hint user", "hints", QRAddHints)
+	hints.SetUserCond("user")
+	require.NoError(t, hints.SetHints(1000, ""))
+	qrs.Add(hints)
+	fail := NewQueryRule("fail on a", "fail", QRFail)
+	fail.AddBindVarCond("a", true, true, QREqual, uint64(1))
+	qrs.Add(fail)
+	qrs.Add(NewQueryRule("fail everything", "fail all", QRFailRetry))
+
+	bv := map[string]*querypb.BindVariable{"a": sqltypes.Uint64BindVariable(1)}
+	names := func(fired []*Rule) []string {
+		var names []string
+		for _, qr := range fired {
+			names = append(names, qr.Name)
+		}
+		return names
+	}
+
+	// The non-terminal rules don't shadow the terminal ones, and the
+	// evaluation stops at the first terminal rule which fires.
+	fired := qrs.GetRules("", "user", bv, sqlparser.MarginComments{})
+	assert.Equal(t, []string{"log", "hints", "fail"}, names(fired))
+	action, desc := qrs.GetAction("", "user", bv, sqlparser.MarginComments{})
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "fail on a", desc)
+
+	bv["a"] = sqltypes.Uint64BindVariable(0)
+	fired = qrs.GetRules("", "other", bv, sqlparser.MarginComments{})
+	assert.Equal(t, []string{"log", "fail all"}, names(fired))
+
+	// Without a terminal rule, the first rule which fires gives the action.
+	qrs.Delete("fail all")
+	fired = qrs.GetRules("", "user", bv, sqlparser.MarginComments{})
+	assert.Equal(t, []string{"log", "hints"}, names(fired))
+	action, desc = qrs.GetAction("", "user", bv, sqlparser.MarginComments{})
+	assert.Equal(t, QRLog, action)
+	assert.Equal(t, "log everything", desc)
+
+	assert.Equal(t, []*Rule{hints}, qrs.ByAction(QRAddHints))
+}
+
+func TestActionParams(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "throttle",
+		"Action": "THROTTLE",
+		"MaxQPS": 2
+	}, {
+		"Name": "concurrency",
+		"Action": "LIMIT_CONCURRENCY",
+		"MaxConcurrency": 1
+	}, {
+		"Name": "hints",
+		"Action": "ADD_HINTS",
+		"MaxExecutionTime": 1000,
+		"UseIndex": "idx"
+	}, {
+		"Name": "log",
+		"Action": "LOG",
+		"SampleRate": 0.5
+	}]`))
+	require.NoError(t, err)
+
+	b, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	want := `[{"Description":"","Name":"throttle","Action":"THROTTLE","MaxQPS":2},` +
+		`{"Description":"","Name":"concurrency","Action":"LIMIT_CONCURRENCY","MaxConcurrency":1},` +
+		`{"Description":"","Name":"hints","Action":"ADD_HINTS","MaxExecutionTime":1000,"UseIndex":"idx"},` +
+		`{"Description":"","Name":"log","Action":"LOG","SampleRate":0.5}]`
+	assert.Equal(t, want, compacted(string(b)))
+
+	// The copies of a rule share its limits.
+	throttle := qrs.Find("throttle")
+	throttleCopy := qrs.Copy().Find("throttle")
+	assert.True(t, throttle.Equal(throttleCopy))
+	_, ok := throttle.Acquire()
+	assert.True(t, ok)
+	_, ok = throttleCopy.Acquire()
+	assert.True(t, ok)
+	_, ok = throttle.Acquire()
+	assert.False(t, ok)
+
+	concurrency := qrs.Find("concurrency")
+	release, ok := concurrency.Acquire()
+	require.True(t, ok)
+	_, ok = concurrency.Copy().Acquire()
+	assert.False(t, ok)
+	release()
+	release, ok = concurrency.Acquire()
+	assert.True(t, ok)
+	release()
+
+	assert.Equal(t, QRLog, qrs.Find("log").Action())
+}
+
+func TestApplyHints(t *testing.T) {
+	testcases := []struct {
+		maxExecutionTime int64
+		useIndex         string
+		in, out          string
+	}{{
+		maxExecutionTime: 1000,
+		in:               "select a from t where b = 1",
+		out:              "select /*+ MAX_EXECUTION_TIME(1000) */ a from t where b = 1",
+	}, {
+		maxExecutionTime: 1000,
+		in:               "select /*+ MAX_EXECUTION_TIME(10) */ a from t",
+		out:              "select /*+ MAX_EXECUTION_TIME(10) */ a from t",
+	}, {
+		useIndex: "idx",
+		in:       "select a from t join u on t.id = u.id",
+		out:      "select a from t use index (idx) join u on t.id = u.id",
+	}, {
+		useIndex: "idx",
+		in:       "select a from t force index (other)",
+		out:      "select a from t force index (other)",
+	}, {
+		maxExecutionTime: 1000,
+		useIndex:         "idx",
+		in:               "update t set a = 1 where b = 2",
+		out:              "update t use index (idx) set a = 1 where b = 2",
+	}, {
+		maxExecutionTime: 1000,
+		in:               "delete from t where b = 2",
+		out:              "delete from t where b = 2",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			qr := NewQueryRule("", "hints", QRFail)
+			require.NoError(t, qr.SetHints(tcase.maxExecutionTime, tcase.useIndex))
+			stmt, err := sqlparser.Parse(tcase.in)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.in != tcase.out, qr.ApplyHints(stmt, "t"))
+			assert.Equal(t, tcase.out, sqlparser.String(stmt))
+		})
+	}
+}
@@ -23,7 +23,7 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(152)
 	}
 	// field Fields []*vitess.io/vitess/go/vt/proto/query.Field
 	{
@@ -32,6 +32,17 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field DeadLetterTable string
+	size += int64(len(cached.DeadLetterTable))
+	// field ConsumerGroupTable string
+	size += int64(len(cached.ConsumerGroupTable))
+	// field ConsumerGroups []string
+	{
+		size += int64(cap(cached.ConsumerGroups)) * int64(16)
+		for _, elem := range cached.ConsumerGroups {
+			size += int64(len(elem))
+		}
+	}
 	return size
 }
 func (cached *SequenceInfo) CachedSize(alloc bool) int64 {
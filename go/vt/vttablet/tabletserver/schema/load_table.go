@@ -62,6 +62,7 @@ func loadMessageInfo(ta *Table, comment string) error {
 		"time_next":  {},
 		"epoch":      {},
 		"time_acked": {},
+		"deliver_at": {},
 	}
 
 	requiredCols := []string{
@@ -110,6 +111,25 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	ta.MessageInfo.MaxAttempts, _ = getNum(keyvals, "vt_max_attempts")
+	ta.MessageInfo.DeadLetterTable = keyvals["vt_dead_letter_table"]
+	if ta.MessageInfo.DeadLetterTable != "" && ta.MessageInfo.MaxAttempts == 0 {
+		return fmt.Errorf("vt_dead_letter_table requires vt_max_attempts for message table: %s", ta.Name.String())
+	}
+	ta.MessageInfo.HasDeliverAt = ta.FindColumn(sqlparser.NewColIdent("deliver_at")) != -1
+	ta.MessageInfo.ConsumerGroupTable = keyvals["vt_consumer_group_table"]
+	if groups := keyvals["vt_consumer_groups"]; groups != "" {
+		ta.MessageInfo.ConsumerGroups = strings.Split(groups, ":")
+	}
+	if (ta.MessageInfo.ConsumerGroupTable == "") != (ta.MessageInfo.ConsumerGroups == nil) {
+		return fmt.Errorf("vt_consumer_group_table and vt_consumer_groups must be specified together for message table: %s", ta.Name.String())
+	}
+	for _, group := range ta.MessageInfo.ConsumerGroups {
+		if group == "" {
+			return fmt.Errorf("empty consumer group in vt_consumer_groups for message table: %s", ta.Name.String())
+		}
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -141,6 +141,30 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading max attempts and dead-letter table
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_attempts=5,vt_dead_letter_table=test_table_dead", db)
+	require.NoError(t, err)
+	assert.Equal(t, 5, table.MessageInfo.MaxAttempts)
+	assert.Equal(t, "test_table_dead", table.MessageInfo.DeadLetterTable)
+
+	// Test loading the consumer groups
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_group_table=test_table_groups,vt_consumer_groups=g1:g2", db)
+	require.NoError(t, err)
+	assert.Equal(t, "test_table_groups", table.MessageInfo.ConsumerGroupTable)
+	assert.Equal(t, []string{"g1", "g2"}, table.MessageInfo.ConsumerGroups)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_group_table=test_table_groups", db)
+	require.EqualError(t, err, "vt_consumer_group_table and vt_consumer_groups must be specified together for message table: test_table")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=g1", db)
+	require.EqualError(t, err, "vt_consumer_group_table and vt_consumer_groups must be specified together for message table: test_table")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_group_table=test_table_groups,vt_consumer_groups=g1::g2", db)
+	require.EqualError(t, err, "empty consumer group in vt_consumer_groups for message table: test_table")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_dead_letter_table=test_table_dead", db)
+	require.EqualError(t, err, "vt_dead_letter_table requires vt_max_attempts for message table: test_table")
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
@@ -148,6 +172,21 @@ func TestLoadTableMessage(t *testing.T) {
 		t.Errorf("newTestLoadTable: %v, want %s", err, wanterr)
 	}
 
+	// The optional deliver_at column is hidden.
+	deliverAtQueries := getMessageTableQueries()
+	result := deliverAtQueries["select * from test_table where 1 != 1"]
+	result.Fields = append(result.Fields, &querypb.Field{
+		Name: "deliver_at",
+		Type: sqltypes.Int64,
+	})
+	for query, result := range deliverAtQueries {
+		db.AddQuery(query, result)
+	}
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30", db)
+	require.NoError(t, err)
+	assert.True(t, table.MessageInfo.HasDeliverAt)
+	assert.Equal(t, want.MessageInfo.Fields, table.MessageInfo.Fields)
+
 	for query, result := range getTestLoadTableQueries() {
 		db.AddQuery(query, result)
 	}
@@ -103,6 +103,28 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// MaxAttempts specifies how many times a message is sent
+	// before it's given up on. Zero means no limit.
+	MaxAttempts int
+
+	// DeadLetterTable is the table messages are moved to
+	// once they reach MaxAttempts. If empty, such messages
+	// are acked and eventually purged.
+	DeadLetterTable string
+
+	// HasDeliverAt is set if the table has the optional
+	// deliver_at column, which delays the first delivery
+	// of a message.
+	HasDeliverAt bool
+
+	// ConsumerGroupTable is the table that tracks the delivery
+	// of the messages to each consumer group.
+	ConsumerGroupTable string
+
+	// ConsumerGroups are the consumer groups of the table. They're
+	// set along with ConsumerGroupTable.
+	ConsumerGroups []string
 }
 
 // NewTable creates a new Table.
@@ -154,6 +154,9 @@ func init() {
 	flagutil.DualFormatBoolVar(&enableConsolidator, "enable_consolidator", true, "This option enables the query consolidator.")
 	flagutil.DualFormatBoolVar(&enableConsolidatorReplicas, "enable_consolidator_replicas", false, "This option enables the query consolidator only on replicas.")
 	flagutil.DualFormatBoolVar(&currentConfig.CacheResultFields, "enable_query_plan_field_caching", defaultConfig.CacheResultFields, "This option fetches & caches fields (columns) when storing query plans")
+	flag.IntVar(&currentConfig.ResultCache.Size, "queryserver-config-result-cache-size", defaultConfig.ResultCache.Size, "query server result cache size, maximum number of query results to be cached. Only the SELECTs on the tables listed in -queryserver-config-result-cache-tables, or the ones with a RESULT_CACHE_TTL_MS comment directive, are cached. Cached results are invalidated from the replication stream. 0 disables the result cache.")
+	SecondsVar(&currentConfig.ResultCache.TTLSeconds, "queryserver-config-result-cache-ttl", defaultConfig.ResultCache.TTLSeconds, "query server result cache ttl (in seconds), how long the result of a query on a cached table is served from the result cache.")
+	flagutil.StringListVar(&currentConfig.ResultCache.Tables, "queryserver-config-result-cache-tables", defaultConfig.ResultCache.Tables, "comma separated list of tables whose query results are cached by the query server result cache.")
 
 	flag.DurationVar(&healthCheckInterval, "health_check_interval", 20*time.Second, "Interval between health checks")
 	flag.DurationVar(&degradedThreshold, "degraded_threshold", 30*time.Second, "replication lag after which a replica is considered degraded")
@@ -243,6 +246,8 @@ type TabletConfig struct {
 
 	ReplicationTracker ReplicationTrackerConfig `json:"replicationTracker,omitempty"`
 
+	ResultCache ResultCacheConfig `json:"resultCache,omitempty"`
+
 	// Consolidator can be enable, disable, or notOnMaster. Default is enable.
 	Consolidator                            string  `json:"consolidator,omitempty"`
 	PassthroughDML                          bool    `json:"passthroughDML,omitempty"`
@@ -340,6 +345,14 @@ type TransactionLimitConfig struct {
 	TransactionLimitBySubcomponent bool
 }
 
+// ResultCacheConfig contains the config for the query result cache.
+type ResultCacheConfig struct {
+	// Size is the maximum number of cached results. 0 disables the cache.
+	Size       int      `json:"size,omitempty"`
+	TTLSeconds Seconds  `json:"ttlSeconds,omitempty"`
+	Tables     []string `json:"tables,omitempty"`
+}
+
 // NewCurrentConfig returns a copy of the current config.
 func NewCurrentConfig() *TabletConfig {
 	return currentConfig.Clone()
@@ -376,6 +389,9 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if v := c.ResultCache.Size; v < 0 {
+		return fmt.Errorf("-queryserver-config-result-cache-size must be >= 0 (specified value: %v)", v)
+	}
 	return nil
 }
 
@@ -450,6 +466,9 @@ var defaultConfig = TabletConfig{
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
 	},
+	ResultCache: ResultCacheConfig{
+		TTLSeconds: 1,
+	},
 	Consolidator:                Enable,
 	ConsolidatorStreamTotalSize: 128 * 1024 * 1024,
 	ConsolidatorStreamQuerySize: 2 * 1024 * 1024,
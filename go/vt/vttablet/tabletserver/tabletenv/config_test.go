@@ -79,6 +79,7 @@ oltpReadPool:
   size: 16
   timeoutSeconds: 10
 replicationTracker: {}
+resultCache: {}
 txPool: {}
 `
 	assert.Equal(t, wantBytes, string(gotBytes))
@@ -141,6 +142,8 @@ queryCacheSize: 5000
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
+resultCache:
+  ttlSeconds: 1
 schemaReloadIntervalSeconds: 1800
 signalSchemaChangeReloadIntervalSeconds: 5
 streamBufferSize: 32768
@@ -197,6 +200,9 @@ func TestFlags(t *testing.T) {
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
+		ResultCache: ResultCacheConfig{
+			TTLSeconds: 1,
+		},
 		StreamBufferSize:                        32768,
 		QueryCacheSize:                          int(cache.DefaultConfig.MaxEntries),
 		QueryCacheMemory:                        cache.DefaultConfig.MaxMemoryUsage,
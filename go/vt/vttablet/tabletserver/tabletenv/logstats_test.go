@@ -200,6 +200,11 @@ func TestLogStatsFormatQuerySources(t *testing.T) {
 	if !strings.Contains(logStats.FmtQuerySources(), "consolidator") {
 		t.Fatalf("'consolidator' should be in formatted query sources")
 	}
+
+	logStats.QuerySources |= QuerySourceResultCache
+	if !strings.Contains(logStats.FmtQuerySources(), "resultcache") {
+		t.Fatalf("'resultcache' should be in formatted query sources")
+	}
 }
 
 func TestLogStatsContextHTML(t *testing.T) {
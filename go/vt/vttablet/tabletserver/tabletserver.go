@@ -170,14 +170,14 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.statelessql = NewQueryList("oltp-stateless")
 	tsv.statefulql = NewQueryList("oltp-stateful")
 	tsv.olapql = NewQueryList("olap")
-	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
+	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, alias.Cell, tabletTypeFunc)
 	tsv.hs = newHealthStreamer(tsv, alias)
 	tsv.se = schema.NewEngine(tsv)
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se, tsv.lagThrottler, alias.Cell)
 	tsv.tracker = schema.NewTracker(tsv, tsv.vstreamer, tsv.se)
 	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config)
-	tsv.qe = NewQueryEngine(tsv, tsv.se)
+	tsv.qe = NewQueryEngine(tsv, tsv.se, tsv.vstreamer)
 	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
 	tsv.te = NewTxEngine(tsv)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
@@ -1023,7 +1023,9 @@ func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.
 }
 
 // MessageStream streams messages from the requested table.
-func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+// If consumerGroup is not empty, the messages of that consumer group
+// are streamed instead.
+func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name, consumerGroup string, callback func(*sqltypes.Result) error) (err error) {
 	return tsv.execRequest(
 		ctx, 0,
 		"MessageStream", "stream", nil,
@@ -1040,25 +1042,50 @@ func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Targ
 				logStats: logStats,
 				tsv:      tsv,
 			}
-			return qre.MessageStream(callback)
+			return qre.MessageStream(consumerGroup, callback)
 		},
 	)
 }
 
 // MessageAck acks the list of messages for a given message table.
+// If consumerGroup is not empty, the messages are acked for that
+// consumer group only.
 // It returns the number of messages successfully acked.
-func (tsv *TabletServer) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (tsv *TabletServer) MessageAck(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []*querypb.Value) (count int64, err error) {
 	sids := make([]string, 0, len(ids))
 	for _, val := range ids {
 		sids = append(sids, sqltypes.ProtoToValue(val).ToString())
 	}
+	statsName := name
 	count, err = tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
-		return tsv.messager.GenerateAckQuery(name, sids)
+		if consumerGroup == "" {
+			return tsv.messager.GenerateAckQuery(name, sids)
+		}
+		statsName = name + ":" + consumerGroup
+		return tsv.messager.GenerateConsumerGroupAckQuery(name, consumerGroup, sids)
 	})
 	if err != nil {
 		return 0, err
 	}
-	messager.MessageStats.Add([]string{name, "Acked"}, count)
+	messager.MessageStats.Add([]string{statsName, "Acked"}, count)
+	return count, nil
+}
+
+// MessageNack makes the list of messages for a given message table
+// due for immediate redelivery.
+// It returns the number of messages successfully nacked.
+func (tsv *TabletServer) MessageNack(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+	sids := make([]string, 0, len(ids))
+	for _, val := range ids {
+		sids = append(sids, sqltypes.ProtoToValue(val).ToString())
+	}
+	count, err = tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
+		return tsv.messager.GenerateNackQuery(name, sids)
+	})
+	if err != nil {
+		return 0, err
+	}
+	messager.MessageStats.Add([]string{name, "Nacked"}, count)
 	return count, nil
 }
 
@@ -1072,20 +1099,53 @@ func (tsv *TabletServer) PostponeMessages(ctx context.Context, target *querypb.T
 
 // PurgeMessages purges messages older than specified time in Unix Nanoseconds.
 // It purges at most 500 messages. It returns the number of messages successfully purged.
+// The consumer group acks of purged messages are purged along with them.
 func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]*querypb.BoundQuery, error) {
+		return tsv.messager.GeneratePurgeQueries(name, timeCutoff)
+	})
+}
+
+// DeadLetterMessages moves the list of messages for a given message table
+// to its dead-letter table, or acks them if it has none.
+// If consumerGroup is not empty, the messages are acked for that
+// consumer group only, and stay in the message table.
+// It returns the number of messages successfully dead-lettered.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []string) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]*querypb.BoundQuery, error) {
+		return tsv.messager.GenerateDeadLetterQueries(name, consumerGroup, ids)
+	})
+}
+
+// PostponeConsumerGroupMessages records the list of messages as pending
+// for a consumer group of a given message table.
+// It returns the number of messages successfully postponed.
+func (tsv *TabletServer) PostponeConsumerGroupMessages(ctx context.Context, target *querypb.Target, name, consumerGroup string, ids []string) (count int64, err error) {
 	return tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
-		return tsv.messager.GeneratePurgeQuery(name, timeCutoff)
+		return tsv.messager.GenerateConsumerGroupPostponeQuery(name, consumerGroup, ids)
 	})
 }
 
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]*querypb.BoundQuery, error) {
+		query, bv, err := queryGenerator()
+		if err != nil {
+			return nil, err
+		}
+		return []*querypb.BoundQuery{{Sql: query, BindVariables: bv}}, nil
+	})
+}
+
+// execDMLs executes the queries in a transaction, and returns
+// the number of rows affected by the last one.
+func (tsv *TabletServer) execDMLs(ctx context.Context, target *querypb.Target, queryGenerator func() ([]*querypb.BoundQuery, error)) (count int64, err error) {
 	if err = tsv.sm.StartRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.sm.EndRequest()
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
+	queries, err := queryGenerator()
 	if err != nil {
 		return 0, err
 	}
@@ -1101,9 +1161,12 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
-	if err != nil {
-		return 0, err
+	var qr *sqltypes.Result
+	for _, query := range queries {
+		qr, err = tsv.Execute(ctx, target, query.Sql, query.BindVariables, transactionID, 0, nil)
+		if err != nil {
+			return 0, err
+		}
 	}
 	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
@@ -1623,6 +1686,7 @@ func (tsv *TabletServer) registerThrottlerCheckHandlers() {
 			}
 			flags := &throttle.CheckFlags{
 				LowPriority: (r.URL.Query().Get("p") == "low"),
+				MetricName:  r.URL.Query().Get("metric"),
 			}
 			checkResult := tsv.lagThrottler.CheckByType(ctx, appName, remoteAddr, flags, checkType)
 			if checkResult.StatusCode == http.StatusNotFound && flags.OKIfNotExists {
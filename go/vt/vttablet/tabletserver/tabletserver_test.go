@@ -1617,7 +1617,7 @@ func TestMessageStream(t *testing.T) {
 	defer tsv.StopService()
 	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
 
-	err := tsv.MessageStream(ctx, &target, "nomsg", func(qr *sqltypes.Result) error {
+	err := tsv.MessageStream(ctx, &target, "nomsg", "", func(qr *sqltypes.Result) error {
 		return nil
 	})
 	wantErr := "table nomsg not found in schema"
@@ -1627,7 +1627,7 @@ func TestMessageStream(t *testing.T) {
 
 	// Check that the streaming mechanism works.
 	called := false
-	err = tsv.MessageStream(ctx, &target, "msg", func(qr *sqltypes.Result) error {
+	err = tsv.MessageStream(ctx, &target, "msg", "", func(qr *sqltypes.Result) error {
 		called = true
 		return io.EOF
 	})
@@ -1637,6 +1637,30 @@ func TestMessageStream(t *testing.T) {
 	}
 }
 
+func TestMessageStreamConsumerGroup(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	err := tsv.MessageStream(ctx, &target, "msg", "g1", func(qr *sqltypes.Result) error {
+		return nil
+	})
+	require.EqualError(t, err, "consumer group g1 of message table msg not found")
+
+	_, err = tsv.MessageAck(ctx, &target, "msg", "g1", []*querypb.Value{{Type: sqltypes.VarChar, Value: []byte("1")}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "consumer group g1 of message table msg not found")
+
+	_, err = tsv.PostponeConsumerGroupMessages(ctx, &target, "msg", "g1", []string{"1"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "consumer group g1 of message table msg not found")
+
+	_, err = tsv.DeadLetterMessages(ctx, &target, "msg", "g1", []string{"1"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "consumer group g1 of message table msg not found")
+}
+
 func TestMessageAck(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
@@ -1650,22 +1674,51 @@ func TestMessageAck(t *testing.T) {
 		Type:  sqltypes.VarChar,
 		Value: []byte("2"),
 	}}
-	_, err := tsv.MessageAck(ctx, &target, "nonmsg", ids)
+	_, err := tsv.MessageAck(ctx, &target, "nonmsg", "", ids)
 	want := "message table nonmsg not found in schema"
 	require.Error(t, err)
 	require.Contains(t, err.Error(), want)
 
-	_, err = tsv.MessageAck(ctx, &target, "msg", ids)
+	_, err = tsv.MessageAck(ctx, &target, "msg", "", ids)
 	want = "query: 'update msg set time_acked"
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), want)
 
 	db.AddQueryPattern("update msg set time_acked = .*", &sqltypes.Result{RowsAffected: 1})
-	count, err := tsv.MessageAck(ctx, &target, "msg", ids)
+	count, err := tsv.MessageAck(ctx, &target, "msg", "", ids)
 	require.NoError(t, err)
 	require.EqualValues(t, 1, count)
 }
 
+func TestMessageNack(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	ids := []*querypb.Value{{
+		Type:  sqltypes.VarChar,
+		Value: []byte("1"),
+	}, {
+		Type:  sqltypes.VarChar,
+		Value: []byte("2"),
+	}}
+	_, err := tsv.MessageNack(ctx, &target, "nonmsg", ids)
+	want := "message table nonmsg not found in schema"
+	require.Error(t, err)
+	require.Contains(t, err.Error(), want)
+
+	_, err = tsv.MessageNack(ctx, &target, "msg", ids)
+	want = "query: 'update msg set time_next"
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), want)
+
+	db.AddQueryPattern("update msg set time_next = .*", &sqltypes.Result{RowsAffected: 2})
+	count, err := tsv.MessageNack(ctx, &target, "msg", ids)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, count)
+}
+
 func TestRescheduleMessages(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
@@ -1709,6 +1762,24 @@ func TestPurgeMessages(t *testing.T) {
 	require.EqualValues(t, 1, count)
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", "", []string{"1", "2"})
+	want := "message table nonmsg not found in schema"
+	require.Error(t, err)
+	require.Contains(t, err.Error(), want)
+
+	// msg has no dead-letter table: the messages are acked.
+	db.AddQueryPattern("update msg set time_acked = .*", &sqltypes.Result{RowsAffected: 2})
+	count, err := tsv.DeadLetterMessages(ctx, &target, "msg", "", []string{"1", "2"})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, count)
+}
+
 func TestHandleExecUnknownError(t *testing.T) {
 	logStats := tabletenv.NewLogStats(ctx, "TestHandleExecError")
 	config := tabletenv.NewDefaultConfig()
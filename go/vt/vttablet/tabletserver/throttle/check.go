@@ -33,6 +33,8 @@ type CheckFlags struct {
 	OverrideThreshold float64
 	LowPriority       bool
 	OKIfNotExists     bool
+	// MetricName is the metric to check. All the metrics are checked if empty.
+	MetricName string
 }
 
 // StandardCheckFlags have no special hints
@@ -71,6 +73,9 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 	if appName == "" {
 		return NewCheckResult(http.StatusExpectationFailed, value, threshold, fmt.Errorf("no app indicated"))
 	}
+	defer func() {
+		checkResult.MetricName = metricName
+	}()
 
 	var statusCode int
 
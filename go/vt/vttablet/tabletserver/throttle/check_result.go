@@ -19,6 +19,8 @@ type CheckResult struct {
 	Threshold  float64 `json:"Threshold"`
 	Error      error   `json:"-"`
 	Message    string  `json:"Message"`
+	// MetricName is the metric the result is for, of the form <store type>/<cluster name>
+	MetricName string `json:"MetricName"`
 }
 
 // NewCheckResult returns a CheckResult
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"flag"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
+)
+
+const (
+	// LagMetricName is the name of the replication lag metric, which is always collected.
+	LagMetricName = "lag"
+	// ThreadsRunningMetricName is the name of the metric of the MySQL threads running.
+	ThreadsRunningMetricName = "threads_running"
+	// HistoryListLengthMetricName is the name of the metric of the InnoDB history list length.
+	HistoryListLengthMetricName = "history_list_length"
+
+	threadsRunningQuery    = `show global status like 'threads_running'`
+	historyListLengthQuery = `select count as history_list_length from information_schema.innodb_metrics where name = 'trx_rseg_history_len'`
+)
+
+var (
+	throttleThreadsRunningThreshold    = flag.Float64("throttle_threads_running_threshold", 0, "Threshold of the threads_running throttler metric, the number of MySQL threads running. 0 disables the metric")
+	throttleHistoryListLengthThreshold = flag.Float64("throttle_history_list_length_threshold", 0, "Threshold of the history_list_length throttler metric, the InnoDB history list length. 0 disables the metric")
+	throttleCustomMetrics              customMetricsFlag
+)
+
+func init() {
+	flag.Var(&throttleCustomMetrics, "throttle_custom_metric", "Custom throttler metric, in the form <name>=<threshold>:<query>. The query is either a `SELECT` returning a single row with a single value, or a `SHOW GLOBAL ... LIKE ...` query, and the throttler account needs the privileges to run it on the replicas. Can be repeated")
+}
+
+var metricNameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// metricDefinition is a metric the throttler collects on each store on top of
+// the replication lag. Each metric has its own threshold.
+type metricDefinition struct {
+	name      string
+	query     string
+	threshold float64
+}
+
+// customMetricsFlag is the list of custom metrics given on the command line.
+type customMetricsFlag []*metricDefinition
+
+// String is part of the flag.Value interface.
+func (f *customMetricsFlag) String() string {
+	var metrics []string
+	for _, metric := range *f {
+		metrics = append(metrics, fmt.Sprintf("%s=%v:%s", metric.name, metric.threshold, metric.query))
+	}
+	return strings.Join(metrics, ", ")
+}
+
+// Set is part of the flag.Value interface.
+func (f *customMetricsFlag) Set(value string) error {
+	metric, err := parseCustomMetric(value)
+	if err != nil {
+		return err
+	}
+	for _, existing := range *f {
+		if existing.name == metric.name {
+			return fmt.Errorf("duplicate throttler metric %s", metric.name)
+		}
+	}
+	*f = append(*f, metric)
+	return nil
+}
+
+// parseCustomMetric parses a metric of the form <name>=<threshold>:<query>.
+func parseCustomMetric(value string) (*metricDefinition, error) {
+	eq := strings.Index(value, "=")
+	colon := strings.Index(value, ":")
+	if eq < 0 || colon < eq {
+		return nil, fmt.Errorf("invalid throttler metric %q, expected <name>=<threshold>:<query>", value)
+	}
+	metric := &metricDefinition{
+		name:  strings.TrimSpace(value[:eq]),
+		query: strings.TrimSpace(value[colon+1:]),
+	}
+	if !metricNameRegexp.MatchString(metric.name) {
+		return nil, fmt.Errorf("invalid throttler metric name %q, only lowercase letters, digits and underscores are allowed", metric.name)
+	}
+	switch metric.name {
+	case LagMetricName, ThreadsRunningMetricName, HistoryListLengthMetricName:
+		return nil, fmt.Errorf("throttler metric name %s is reserved", metric.name)
+	}
+	threshold, err := strconv.ParseFloat(strings.TrimSpace(value[eq+1:colon]), 64)
+	if err != nil || threshold <= 0 {
+		return nil, fmt.Errorf("invalid threshold for throttler metric %s: %q must be a positive number", metric.name, value[eq+1:colon])
+	}
+	metric.threshold = threshold
+	switch mysql.GetMetricsQueryType(metric.query) {
+	case mysql.MetricsQueryTypeSelect, mysql.MetricsQueryTypeShowGlobal:
+	default:
+		return nil, fmt.Errorf("invalid query for throttler metric %s: %q must be a SELECT or a SHOW GLOBAL query", metric.name, metric.query)
+	}
+	return metric, nil
+}
+
+// additionalMetrics returns the metrics enabled on the command line, on top
+// of the replication lag.
+func additionalMetrics() []*metricDefinition {
+	var metrics []*metricDefinition
+	if *throttleThreadsRunningThreshold > 0 {
+		metrics = append(metrics, &metricDefinition{
+			name:      ThreadsRunningMetricName,
+			query:     threadsRunningQuery,
+			threshold: *throttleThreadsRunningThreshold,
+		})
+	}
+	if *throttleHistoryListLengthThreshold > 0 {
+		metrics = append(metrics, &metricDefinition{
+			name:      HistoryListLengthMetricName,
+			query:     historyListLengthQuery,
+			threshold: *throttleHistoryListLengthThreshold,
+		})
+	}
+	return append(metrics, throttleCustomMetrics...)
+}
+
+// clusterName returns the name of the MySQL cluster which collects a metric
+// of a store. The replication lag is collected by the cluster named after the
+// store, and any other metric by <store>.<metric>.
+func clusterName(storeName, metricName string) string {
+	if metricName == "" || metricName == LagMetricName {
+		return storeName
+	}
+	return storeName + "." + metricName
+}
+
+// isSelfCluster returns true if a cluster collects a metric of the self store.
+func isSelfCluster(clusterName string) bool {
+	return clusterName == selfStoreName || strings.HasPrefix(clusterName, selfStoreName+".")
+}
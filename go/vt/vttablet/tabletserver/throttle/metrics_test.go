@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+)
+
+func TestParseCustomMetric(t *testing.T) {
+	metric, err := parseCustomMetric("queue_size=100:select count(*) from queue where ts > '10:00'")
+	require.NoError(t, err)
+	assert.Equal(t, &metricDefinition{name: "queue_size", query: "select count(*) from queue where ts > '10:00'", threshold: 100}, metric)
+
+	metric, err = parseCustomMetric("connected = 0.5 : show global status like 'threads_connected'")
+	require.NoError(t, err)
+	assert.Equal(t, &metricDefinition{name: "connected", query: "show global status like 'threads_connected'", threshold: 0.5}, metric)
+
+	testcases := []struct {
+		in  string
+		err string
+	}{{
+		in:  "queue_size",
+		err: `invalid throttler metric "queue_size", expected <name>=<threshold>:<query>`,
+	}, {
+		in:  "queue:size=1:select 1",
+		err: `invalid throttler metric "queue:size=1:select 1", expected <name>=<threshold>:<query>`,
+	}, {
+		in:  "Queue=1:select 1",
+		err: `invalid throttler metric name "Queue", only lowercase letters, digits and underscores are allowed`,
+	}, {
+		in:  "lag=1:select 1",
+		err: "throttler metric name lag is reserved",
+	}, {
+		in:  "queue=-1:select 1",
+		err: `invalid threshold for throttler metric queue: "-1" must be a positive number`,
+	}, {
+		in:  "queue=1:delete from queue",
+		err: `invalid query for throttler metric queue: "delete from queue" must be a SELECT or a SHOW GLOBAL query`,
+	}}
+	for _, tcase := range testcases {
+		_, err := parseCustomMetric(tcase.in)
+		assert.EqualError(t, err, tcase.err, tcase.in)
+	}
+
+	var f customMetricsFlag
+	require.NoError(t, f.Set("a=1:select 1"))
+	require.NoError(t, f.Set("b=2:select 2"))
+	assert.EqualError(t, f.Set("a=3:select 3"), "duplicate throttler metric a")
+	assert.Equal(t, "a=1:select 1, b=2:select 2", f.String())
+}
+
+func TestClusterName(t *testing.T) {
+	assert.Equal(t, "shard", clusterName(shardStoreName, ""))
+	assert.Equal(t, "shard", clusterName(shardStoreName, LagMetricName))
+	assert.Equal(t, "self.threads_running", clusterName(selfStoreName, ThreadsRunningMetricName))
+	assert.True(t, isSelfCluster("self"))
+	assert.True(t, isSelfCluster("self.threads_running"))
+	assert.False(t, isSelfCluster("shard.threads_running"))
+}
+
+func newTestThrottler() *Throttler {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableLagThrottler = true
+	throttler := &Throttler{
+		env: tabletenv.NewEnv(config, "ThrottlerTest"),
+		metrics: []*metricDefinition{{
+			name:      ThreadsRunningMetricName,
+			query:     threadsRunningQuery,
+			threshold: 50,
+		}, {
+			name:      "queue_size",
+			query:     "select count(*) from queue",
+			threshold: 100,
+		}},
+		mysqlClusterThresholds:             cache.New(cache.NoExpiration, 0),
+		aggregatedMetrics:                  cache.New(aggregatedMetricsExpiration, aggregatedMetricsCleanup),
+		throttledApps:                      cache.New(cache.NoExpiration, 10*time.Second),
+		recentApps:                         cache.New(recentAppsExpiration, time.Minute),
+		nonLowPriorityAppRequestsThrottled: cache.New(nonDeprioritizedAppMapExpiration, nonDeprioritizedAppMapInterval),
+	}
+	throttler.check = NewThrottlerCheck(throttler)
+	return throttler
+}
+
+func TestCheckStoreMetrics(t *testing.T) {
+	throttler := newTestThrottler()
+	setMetric := func(clusterName string, threshold, value float64) {
+		throttler.mysqlClusterThresholds.Set(clusterName, threshold, cache.DefaultExpiration)
+		throttler.aggregatedMetrics.Set("mysql/"+clusterName, base.NewSimpleMetricResult(value), cache.DefaultExpiration)
+	}
+	setMetric("shard", 1, 0.5)
+	setMetric("shard.threads_running", 50, 80)
+	setMetric("shard.queue_size", 100, 10)
+
+	ctx := context.Background()
+	check := func(metricName string) *CheckResult {
+		return throttler.CheckByType(ctx, "test", "", &CheckFlags{MetricName: metricName, ReadCheck: true}, ThrottleCheckPrimaryWrite)
+	}
+
+	result := check(LagMetricName)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, "mysql/shard", result.MetricName)
+
+	result = check("queue_size")
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, 10.0, result.Value)
+
+	// Without a metric, the first one which is exceeded is reported.
+	result = check("")
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Equal(t, "mysql/shard.threads_running", result.MetricName)
+	assert.Equal(t, 80.0, result.Value)
+	assert.Equal(t, 50.0, result.Threshold)
+
+	setMetric("shard.threads_running", 50, 20)
+	result = check("")
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+
+	result = check("no_such_metric")
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+}
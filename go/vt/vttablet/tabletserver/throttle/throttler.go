@@ -79,7 +79,9 @@ var (
 	sqlGrantThrottlerUser = []string{
 		`GRANT SELECT ON _vt.heartbeat TO %s`,
 	}
-	replicationLagQuery = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
+	// sqlGrantThrottlerUserProcess allows the throttler account to read information_schema.innodb_metrics
+	sqlGrantThrottlerUserProcess = `GRANT PROCESS ON *.* TO %s`
+	replicationLagQuery          = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
 )
 
 // ThrottleCheckType allows a client to indicate what type of check it wants to issue. See available types below.
@@ -101,6 +103,7 @@ func init() {
 type Throttler struct {
 	keyspace string
 	shard    string
+	cell     string
 
 	check    *ThrottlerCheck
 	isLeader int64
@@ -121,7 +124,15 @@ type Throttler struct {
 
 	metricsQuery     string
 	MetricsThreshold sync2.AtomicFloat64
-	metricsQueryType mysql.MetricsQueryType
+
+	// topoThreshold is the threshold of the SrvKeyspace, 0 if unset
+	topoThreshold sync2.AtomicFloat64
+	// topoThrottledApps are the apps throttled by the SrvKeyspace
+	topoThrottledApps map[string]bool
+	topoConfigMutex   sync.Mutex
+
+	// metrics are the metrics collected on top of the replication lag
+	metrics []*metricDefinition
 
 	mysqlClusterThresholds *cache.Cache
 	aggregatedMetrics      *cache.Cache
@@ -153,11 +164,12 @@ type ThrottlerStatus struct {
 }
 
 // NewThrottler creates a Throttler
-func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType) *Throttler {
+func NewThrottler(env tabletenv.Env, ts *topo.Server, cell string, tabletTypeFunc func() topodatapb.TabletType) *Throttler {
 	throttler := &Throttler{
 		isLeader: 0,
 		isOpen:   0,
 
+		cell:           cell,
 		env:            env,
 		tabletTypeFunc: tabletTypeFunc,
 		ts:             ts,
@@ -174,6 +186,7 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 
 		metricsQuery:     replicationLagQuery,
 		MetricsThreshold: sync2.NewAtomicFloat64(throttleThreshold.Seconds()),
+		metrics:          additionalMetrics(),
 
 		throttledApps:          cache.New(cache.NoExpiration, 10*time.Second),
 		mysqlClusterThresholds: cache.New(cache.NoExpiration, 0),
@@ -218,6 +231,7 @@ func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 	throttler.shard = shard
 	if throttler.env.Config().EnableLagThrottler {
 		go throttler.Operate(context.Background())
+		go throttler.watchSrvKeyspace(context.Background())
 	}
 }
 
@@ -235,27 +249,45 @@ func (throttler *Throttler) initConfig(password string) {
 	if *throttleMetricQuery != "" {
 		throttler.metricsQuery = *throttleMetricQuery
 	}
-	if *throttleMetricThreshold != math.MaxFloat64 {
-		throttler.MetricsThreshold = sync2.NewAtomicFloat64(*throttleMetricThreshold)
+	if threshold := throttler.topoThreshold.Get(); threshold > 0 {
+		throttler.MetricsThreshold.Set(threshold)
+	} else if *throttleMetricThreshold != math.MaxFloat64 {
+		throttler.MetricsThreshold.Set(*throttleMetricThreshold)
 	}
-	throttler.metricsQueryType = mysql.GetMetricsQueryType(throttler.metricsQuery)
 
-	config.Instance.Stores.MySQL.Clusters[selfStoreName] = &config.MySQLClusterConfigurationSettings{
-		User:              "", // running on local tablet server, will use vttablet DBA user
-		Password:          "", // running on local tablet server, will use vttablet DBA user
-		MetricQuery:       throttler.metricsQuery,
-		ThrottleThreshold: throttler.MetricsThreshold.Get(),
-		IgnoreHostsCount:  0,
-	}
-	if password != "" {
-		config.Instance.Stores.MySQL.Clusters[shardStoreName] = &config.MySQLClusterConfigurationSettings{
-			User:              throttlerUser,
+	addClusters := func(storeName string, user string, password string) {
+		config.Instance.Stores.MySQL.Clusters[storeName] = &config.MySQLClusterConfigurationSettings{
+			User:              user,
 			Password:          password,
 			MetricQuery:       throttler.metricsQuery,
 			ThrottleThreshold: throttler.MetricsThreshold.Get(),
 			IgnoreHostsCount:  0,
 		}
+		for _, metric := range throttler.metrics {
+			config.Instance.Stores.MySQL.Clusters[clusterName(storeName, metric.name)] = &config.MySQLClusterConfigurationSettings{
+				User:              user,
+				Password:          password,
+				MetricQuery:       metric.query,
+				ThrottleThreshold: metric.threshold,
+				IgnoreHostsCount:  0,
+			}
+		}
+	}
+	// running on local tablet server, will use vttablet DBA user
+	addClusters(selfStoreName, "", "")
+	if password != "" {
+		addClusters(shardStoreName, throttlerUser, password)
+	}
+}
+
+// hasMetric returns true if the throttler collects a metric.
+func (throttler *Throttler) hasMetric(metricName string) bool {
+	for _, metric := range throttler.metrics {
+		if metric.name == metricName {
+			return true
+		}
 	}
+	return false
 }
 
 // Open opens database pool and initializes the schema
@@ -343,7 +375,11 @@ func (throttler *Throttler) createThrottlerUser(ctx context.Context) (password s
 			return password, err
 		}
 	}
-	for _, query := range sqlGrantThrottlerUser {
+	grants := sqlGrantThrottlerUser
+	if throttler.hasMetric(HistoryListLengthMetricName) {
+		grants = append(grants, sqlGrantThrottlerUserProcess)
+	}
+	for _, query := range grants {
 		parsed := sqlparser.BuildParsedQuery(query, throttlerGrant)
 		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
 			return password, err
@@ -353,10 +389,10 @@ func (throttler *Throttler) createThrottlerUser(ctx context.Context) (password s
 	return password, nil
 }
 
-// readSelfMySQLThrottleMetric reads the mysql metric from thi very tablet's backend mysql.
-func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMetric {
+// readSelfMySQLThrottleMetric reads the mysql metric of a self cluster from this very tablet's backend mysql.
+func (throttler *Throttler) readSelfMySQLThrottleMetric(clusterName string, metricsQuery string) *mysql.MySQLThrottleMetric {
 	metric := &mysql.MySQLThrottleMetric{
-		ClusterName: selfStoreName,
+		ClusterName: clusterName,
 		Key:         *mysql.SelfInstanceKey,
 		Value:       0,
 		Err:         nil,
@@ -369,7 +405,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	}
 	defer conn.Recycle()
 
-	tm, err := conn.Exec(ctx, throttler.metricsQuery, 1, true)
+	tm, err := conn.Exec(ctx, metricsQuery, 1, true)
 	if err != nil {
 		metric.Err = err
 		return metric
@@ -380,7 +416,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 		return metric
 	}
 
-	switch throttler.metricsQueryType {
+	switch mysql.GetMetricsQueryType(metricsQuery) {
 	case mysql.MetricsQueryTypeSelect:
 		// We expect a single row, single column result.
 		// The "for" iteration below is just a way to get first result without knowning column name
@@ -390,7 +426,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	case mysql.MetricsQueryTypeShowGlobal:
 		metric.Value, metric.Err = strconv.ParseFloat(row["Value"].ToString(), 64)
 	default:
-		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", throttler.metricsQuery)
+		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", metricsQuery)
 	}
 
 	return metric
@@ -536,11 +572,13 @@ func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 					}
 					defer atomic.StoreInt64(&probe.QueryInProgress, 0)
 
-					// Apply an override to metrics read, if this is the special "self" cluster
+					// Apply an override to metrics read, if this is a special "self" cluster
 					// (where we incidentally know there's a single probe)
-					overrideGetMySQLThrottleMetricFunc := throttler.readSelfMySQLThrottleMetric
-					if clusterName != selfStoreName {
-						overrideGetMySQLThrottleMetricFunc = nil
+					var overrideGetMySQLThrottleMetricFunc func() *mysql.MySQLThrottleMetric
+					if isSelfCluster(clusterName) {
+						overrideGetMySQLThrottleMetricFunc = func() *mysql.MySQLThrottleMetric {
+							return throttler.readSelfMySQLThrottleMetric(clusterName, probe.MetricQuery)
+						}
 					}
 					throttleMetrics := mysql.ReadThrottleMetric(probe, clusterName, overrideGetMySQLThrottleMetricFunc)
 					throttler.mysqlThrottleMetricChan <- throttleMetrics
@@ -582,14 +620,19 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 		// config may dynamically change, but internal structure (config.Settings().Stores.MySQL.Clusters in our case)
 		// is immutable and can only be _replaced_. Hence, it's safe to read in a goroutine:
 		go func() {
-			throttler.mysqlClusterThresholds.Set(clusterName, clusterSettings.ThrottleThreshold, cache.DefaultExpiration)
+			threshold := clusterSettings.ThrottleThreshold
+			if clusterName == selfStoreName || clusterName == shardStoreName {
+				// the lag threshold may change at runtime
+				threshold = throttler.MetricsThreshold.Get()
+			}
+			throttler.mysqlClusterThresholds.Set(clusterName, threshold, cache.DefaultExpiration)
 			clusterProbes := &mysql.ClusterProbes{
 				ClusterName:      clusterName,
 				IgnoreHostsCount: clusterSettings.IgnoreHostsCount,
 				InstanceProbes:   mysql.NewProbes(),
 			}
 
-			if clusterName == selfStoreName {
+			if isSelfCluster(clusterName) {
 				// special case: just looking at this tablet's MySQL server
 				// We will probe this "cluster" (of one server) is a special way.
 				addInstanceKey(mysql.SelfInstanceKey, clusterName, clusterSettings, clusterProbes.InstanceProbes)
@@ -813,12 +856,24 @@ func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName
 	return metricResultFunc()
 }
 
-// checkStore checks the aggregated value of given MySQL store
+// checkStore checks the aggregated value of given MySQL store. The metric of the flags is checked if given, and
+// otherwise all the metrics of the store are checked, starting with the replication lag: the first one which is
+// not satisfied is returned.
 func (throttler *Throttler) checkStore(ctx context.Context, appName string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
 	if !throttler.env.Config().EnableLagThrottler {
 		return okMetricCheckResult
 	}
-	return throttler.check.Check(ctx, appName, "mysql", storeName, remoteAddr, flags)
+	if flags.MetricName != "" {
+		return throttler.check.Check(ctx, appName, "mysql", clusterName(storeName, flags.MetricName), remoteAddr, flags)
+	}
+	checkResult = throttler.check.Check(ctx, appName, "mysql", storeName, remoteAddr, flags)
+	for _, metric := range throttler.metrics {
+		if checkResult.StatusCode != http.StatusOK {
+			break
+		}
+		checkResult = throttler.check.Check(ctx, appName, "mysql", clusterName(storeName, metric.name), remoteAddr, flags)
+	}
+	return checkResult
 }
 
 // checkShard checks the health of the shard, and runs on the primary tablet only
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	watchSrvKeyspaceRetryInterval = 5 * time.Second
+
+	// topoThrottledAppTTL is the expiry of the throttled apps of the
+	// SrvKeyspace which have none.
+	topoThrottledAppTTL = time.Hour * 24 * 365 * 10
+)
+
+// watchSrvKeyspace watches the SrvKeyspace of the tablet's cell, and applies
+// its throttler configuration. As the configuration is stored in the topo, it
+// survives the restarts of the tablet.
+func (throttler *Throttler) watchSrvKeyspace(ctx context.Context) {
+	if throttler.ts == nil || throttler.keyspace == "" {
+		return
+	}
+	for {
+		current, changes, cancel := throttler.ts.WatchSrvKeyspace(ctx, throttler.cell, throttler.keyspace)
+		if current.Err != nil {
+			// Don't log if there is no SrvKeyspace to start with.
+			if !topo.IsErrType(current.Err, topo.NoNode) {
+				log.Warningf("Throttler: error watching the SrvKeyspace of %s in cell %s (will wait %v before retrying): %v", throttler.keyspace, throttler.cell, watchSrvKeyspaceRetryInterval, current.Err)
+			}
+		} else {
+			throttler.applyThrottlerConfig(current.Value.GetThrottlerConfig())
+			for c := range changes {
+				if c.Err != nil {
+					log.Warningf("Throttler: error while watching the SrvKeyspace of %s in cell %s (will wait %v before retrying): %v", throttler.keyspace, throttler.cell, watchSrvKeyspaceRetryInterval, c.Err)
+					break
+				}
+				throttler.applyThrottlerConfig(c.Value.GetThrottlerConfig())
+			}
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchSrvKeyspaceRetryInterval):
+		}
+	}
+}
+
+// applyThrottlerConfig applies the throttler configuration of the SrvKeyspace:
+// its threshold overrides the one of the command line, and its throttled apps
+// replace the ones of the previous configuration. The apps throttled through
+// the API of the tablet are left alone.
+func (throttler *Throttler) applyThrottlerConfig(throttlerConfig *topodatapb.ThrottlerConfig) {
+	throttler.topoConfigMutex.Lock()
+	defer throttler.topoConfigMutex.Unlock()
+
+	threshold := throttlerConfig.GetThreshold()
+	throttler.topoThreshold.Set(threshold)
+	if threshold <= 0 {
+		threshold = throttleThreshold.Seconds()
+		if *throttleMetricThreshold != math.MaxFloat64 {
+			threshold = *throttleMetricThreshold
+		}
+	}
+	if threshold != throttler.MetricsThreshold.Get() {
+		log.Infof("Throttler: setting the threshold to %v", threshold)
+		throttler.MetricsThreshold.Set(threshold)
+		for _, clusterName := range []string{selfStoreName, shardStoreName} {
+			if _, found := throttler.mysqlClusterThresholds.Get(clusterName); found {
+				throttler.mysqlClusterThresholds.Set(clusterName, threshold, cache.DefaultExpiration)
+			}
+		}
+	}
+
+	throttledApps := make(map[string]bool)
+	for name, rule := range throttlerConfig.GetThrottledApps() {
+		expireAt := time.Now().Add(topoThrottledAppTTL)
+		if rule.ExpiresAt != nil {
+			expireAt = protoutil.TimeFromProto(rule.ExpiresAt)
+		}
+		throttler.ThrottleApp(name, expireAt, rule.Ratio)
+		throttledApps[name] = true
+	}
+	for name := range throttler.topoThrottledApps {
+		if !throttledApps[name] {
+			throttler.UnthrottleApp(name)
+		}
+	}
+	throttler.topoThrottledApps = throttledApps
+}
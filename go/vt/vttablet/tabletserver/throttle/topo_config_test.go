@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestApplyThrottlerConfig(t *testing.T) {
+	throttler := newTestThrottler()
+	throttler.MetricsThreshold.Set(throttleThreshold.Seconds())
+	throttler.mysqlClusterThresholds.Set(selfStoreName, throttleThreshold.Seconds(), cache.DefaultExpiration)
+	// Apps throttled through the API of the tablet are left alone.
+	throttler.ThrottleApp("local", time.Now().Add(time.Hour), 1)
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	throttler.applyThrottlerConfig(&topodatapb.ThrottlerConfig{
+		Threshold: 5,
+		ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+			"online-ddl":   {Name: "online-ddl", Ratio: 0.5, ExpiresAt: protoutil.TimeToProto(expiresAt)},
+			"vreplication": {Name: "vreplication", Ratio: 1},
+			"expired":      {Name: "expired", Ratio: 1, ExpiresAt: protoutil.TimeToProto(time.Now().Add(-time.Hour))},
+		},
+	})
+	assert.Equal(t, 5.0, throttler.MetricsThreshold.Get())
+	threshold, _ := throttler.mysqlClusterThresholds.Get(selfStoreName)
+	assert.Equal(t, 5.0, threshold)
+
+	apps := throttler.ThrottledAppsMap()
+	require.Contains(t, apps, "online-ddl")
+	assert.Equal(t, 0.5, apps["online-ddl"].Ratio)
+	assert.True(t, apps["online-ddl"].ExpireAt.Equal(expiresAt))
+	assert.True(t, throttler.IsAppThrottled("vreplication"))
+	assert.NotContains(t, apps, "expired")
+	assert.Contains(t, apps, "local")
+
+	// The apps which are gone from the configuration are unthrottled, and the
+	// threshold falls back to the one of the command line.
+	throttler.applyThrottlerConfig(&topodatapb.ThrottlerConfig{
+		ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+			"vreplication": {Name: "vreplication", Ratio: 1},
+		},
+	})
+	assert.Equal(t, throttleThreshold.Seconds(), throttler.MetricsThreshold.Get())
+	apps = throttler.ThrottledAppsMap()
+	assert.NotContains(t, apps, "online-ddl")
+	assert.Contains(t, apps, "vreplication")
+	assert.Contains(t, apps, "local")
+
+	throttler.applyThrottlerConfig(nil)
+	apps = throttler.ThrottledAppsMap()
+	assert.NotContains(t, apps, "vreplication")
+	assert.Contains(t, apps, "local")
+}
+
+func TestWatchSrvKeyspace(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := memorytopo.NewServer("zone1")
+	throttler := newTestThrottler()
+	throttler.ts = ts
+	throttler.keyspace = "ks"
+	throttler.cell = "zone1"
+	go throttler.watchSrvKeyspace(ctx)
+
+	// The configuration of the topo survives restarts: a throttler which
+	// starts after it is set picks it up.
+	err := ts.UpdateSrvKeyspace(ctx, "zone1", "ks", &topodatapb.SrvKeyspace{
+		ThrottlerConfig: &topodatapb.ThrottlerConfig{
+			ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+				"online-ddl": {Name: "online-ddl", Ratio: 1},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return throttler.IsAppThrottled("online-ddl")
+	}, 10*time.Second, 10*time.Millisecond)
+
+	err = ts.UpdateSrvKeyspace(ctx, "zone1", "ks", &topodatapb.SrvKeyspace{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return !throttler.IsAppThrottled("online-ddl")
+	}, 10*time.Second, 10*time.Millisecond)
+}
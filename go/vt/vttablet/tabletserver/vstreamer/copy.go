@@ -33,6 +33,11 @@ import (
 // starts the copy phase for the first table in the (sorted) list.
 // can be continuing the copy of a partially completed table or start a new one
 func (uvs *uvstreamer) copy(ctx context.Context) error {
+	if uvs.filter.CopyProgress {
+		if err := uvs.announceTablesToCopy(); err != nil {
+			return err
+		}
+	}
 	for len(uvs.tablesToCopy) > 0 {
 		tableName := uvs.tablesToCopy[0]
 		log.V(2).Infof("Copystate not empty starting catchupAndCopy on table %s", tableName)
@@ -45,6 +50,35 @@ func (uvs *uvstreamer) copy(ctx context.Context) error {
 	return nil
 }
 
+// announceTablesToCopy sends a LastPK event without a last pk for each table to copy which is not in the
+// TableLastPKs of the client. The TableLastPKs recorded by the client then list all the tables which remain to be
+// copied, and a stream restarted from a position mid-copy resumes the copy of all of them.
+func (uvs *uvstreamer) announceTablesToCopy() error {
+	known := make(map[string]bool)
+	for _, tablePK := range uvs.inTablePKs {
+		known[tablePK.TableName] = true
+	}
+	evs := []*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_BEGIN}}
+	for _, tableName := range uvs.tablesToCopy {
+		if known[tableName] {
+			continue
+		}
+		evs = append(evs, &binlogdatapb.VEvent{
+			Type: binlogdatapb.VEventType_LASTPK,
+			LastPKEvent: &binlogdatapb.LastPKEvent{
+				TableLastPK: &binlogdatapb.TableLastPK{
+					TableName: tableName,
+				},
+			},
+		})
+	}
+	if len(evs) == 1 {
+		return nil
+	}
+	evs = append(evs, &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT})
+	return uvs.send(evs)
+}
+
 // first does a catchup for tables already fully or partially copied (upto last pk)
 func (uvs *uvstreamer) catchupAndCopy(ctx context.Context, tableName string) error {
 	log.Infof("catchupAndCopy for %s", tableName)
@@ -122,6 +122,8 @@ func newUVStreamer(ctx context.Context, vse *Engine, cp dbconfigs.Connector, se
 // it can be called
 //		the first time, with just the filter and an empty pos
 //		during a restart, with both the filter and list of TableLastPK from the vgtid
+// The latter only happens if the filter reports the copy progress. The
+// TableLastPKs then list every table to copy, with the completed ones marked.
 func (uvs *uvstreamer) buildTablePlan() error {
 	uvs.plans = make(map[string]*tablePlan)
 	tableLastPKs := make(map[string]*binlogdatapb.TableLastPK)
@@ -155,7 +157,13 @@ func (uvs *uvstreamer) buildTablePlan() error {
 			},
 		}
 		tablePK, ok := tableLastPKs[tableName]
+		if ok && tablePK.Completed {
+			continue
+		}
 		if !ok {
+			if uvs.startPos != "" {
+				return fmt.Errorf("table %s has no copy state in the TableLastPKs of a restart from position %s", tableName, uvs.startPos)
+			}
 			tablePK = &binlogdatapb.TableLastPK{
 				TableName: tableName,
 				Lastpk:    nil,
@@ -346,7 +354,8 @@ func (uvs *uvstreamer) init() error {
 		if err := uvs.setStreamStartPosition(); err != nil {
 			return err
 		}
-	} else if uvs.startPos == "" || len(uvs.inTablePKs) > 0 {
+	}
+	if uvs.startPos == "" || (uvs.filter.CopyProgress && len(uvs.inTablePKs) > 0) {
 		if err := uvs.buildTablePlan(); err != nil {
 			return err
 		}
@@ -371,6 +380,11 @@ func (uvs *uvstreamer) Stream() error {
 			uvs.vse.errorCounts.Add("Copy", 1)
 			return err
 		}
+		if uvs.filter.CopyProgress {
+			if err := uvs.send([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_COPY_COMPLETED}}); err != nil {
+				return err
+			}
+		}
 		uvs.sendTestEvent("Copy Done")
 	}
 	vs := newVStreamer(uvs.ctx, uvs.cp, uvs.se, mysql.EncodePosition(uvs.pos), mysql.EncodePosition(uvs.stopPos), uvs.filter, uvs.getVSchema(), uvs.send, "replicate", uvs.vse)
@@ -557,6 +557,63 @@ func TestVStreamCopyWithDifferentFilters(t *testing.T) {
 	wg.Wait()
 }
 
+func TestVStreamCopyProgress(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	execStatements(t, []string{
+		"create table t1(id1 int, id2 int, primary key(id1))",
+		"create table t2(id1 int, id2 int, primary key(id1))",
+		"insert into t1(id1, id2) values (1, 2)",
+		"insert into t2(id1, id2) values (1, 3)",
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		"drop table t2",
+	})
+	engine.se.Reload(context.Background())
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(10*time.Second))
+	defer cancel()
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/t.*",
+		}},
+		CopyProgress: true,
+	}
+
+	// The tables to copy are announced first, and the end of the copy is reported.
+	var got []string
+	err := engine.Stream(ctx, "", nil, filter, func(evs []*binlogdatapb.VEvent) error {
+		for _, ev := range evs {
+			switch ev.Type {
+			case binlogdatapb.VEventType_BEGIN, binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_LASTPK:
+				got = append(got, ev.String())
+			case binlogdatapb.VEventType_COPY_COMPLETED:
+				got = append(got, ev.String())
+				return io.EOF
+			}
+		}
+		return nil
+	})
+	require.Error(t, err)
+	require.GreaterOrEqual(t, len(got), 4)
+	assert.Equal(t, []string{
+		"type:BEGIN",
+		"type:LASTPK last_p_k_event:{table_last_p_k:{table_name:\"t1\"}}",
+		"type:LASTPK last_p_k_event:{table_last_p_k:{table_name:\"t2\"}}",
+		"type:COMMIT",
+	}, got[:4])
+	assert.Equal(t, "type:COPY_COMPLETED", got[len(got)-1])
+
+	// A restart from a position must have the copy state of every table.
+	tablePKs := []*binlogdatapb.TableLastPK{{TableName: "t1", Completed: true}}
+	err = engine.Stream(ctx, masterPosition(t), tablePKs, filter, func(evs []*binlogdatapb.VEvent) error {
+		return nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "table t2 has no copy state")
+}
+
 func TestFilteredVarBinary(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
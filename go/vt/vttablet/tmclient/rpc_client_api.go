@@ -203,8 +203,10 @@ type TabletManagerClient interface {
 	// Backup creates a database backup
 	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// If restoreToPos or restoreToTimestamp is set, binlog backups are
+	// applied on top of the full backup to reach that point.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error)
 
 	//
 	// Management methods
@@ -1217,6 +1217,8 @@ var testBackupConcurrency = 24
 var testBackupAllowMaster = false
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
+var testRestoreToPos = "MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-7"
+var testRestoreToTimestamp = time.Date(2021, time.June, 1, 12, 30, 0, 0, time.UTC)
 
 func (fra *fakeRPCTM) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error {
 	if fra.panics {
@@ -1250,17 +1252,19 @@ func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.Tab
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTimestamp time.Time) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup restoreToPos", restoreToPos, testRestoreToPos)
+	compare(fra.t, "RestoreFromBackup restoreToTimestamp", restoreToTimestamp.UTC(), testRestoreToTimestamp)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
 func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToPos, testRestoreToTimestamp)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1269,7 +1273,7 @@ func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclie
 }
 
 func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToPos, testRestoreToTimestamp)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// The states of a lookup vindex created by CreateLookupVindex. A vindex is
+// backfilled (Copying), then verified with VDiff (NotVerified, Verifying,
+// Mismatch, VDiffError, Verified), and finally externalized.
+const (
+	LookupVindexCopying      = "Copying"
+	LookupVindexNotVerified  = "NotVerified"
+	LookupVindexVerifying    = "Verifying"
+	LookupVindexMismatch     = "Mismatch"
+	LookupVindexVDiffError   = "VDiffError"
+	LookupVindexVerified     = "Verified"
+	LookupVindexExternalized = "Externalized"
+)
+
+// lookupVindexWorkflowSuffix is appended to the name of the table of a lookup
+// vindex to name the workflow which backfills it.
+const lookupVindexWorkflowSuffix = "_vdx"
+
+// LookupVindexStatus is the state of the lookup vindex backfilled by a
+// workflow. It is part of the output of Workflow show.
+type LookupVindexStatus struct {
+	// Name is the qualified name of the vindex: keyspace.vindex.
+	Name      string
+	Owner     string
+	WriteOnly bool
+	// LastVDiff is the uuid of the most recent vdiff of the workflow.
+	LastVDiff string
+	State     string
+}
+
+// findLookupVindex returns the name and the definition of the lookup vindex
+// of sourceKeyspace backfilled by workflow. It returns a nil vindex if the
+// workflow does not backfill any.
+func (wr *Wrangler) findLookupVindex(ctx context.Context, sourceKeyspace, targetKeyspace, workflow string) (string, *vschemapb.Vindex, error) {
+	if !strings.HasSuffix(workflow, lookupVindexWorkflowSuffix) {
+		return "", nil, nil
+	}
+	vschema, err := wr.ts.GetVSchema(ctx, sourceKeyspace)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	table := targetKeyspace + "." + strings.TrimSuffix(workflow, lookupVindexWorkflowSuffix)
+	names := make([]string, 0, len(vschema.Vindexes))
+	for name := range vschema.Vindexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		vindex := vschema.Vindexes[name]
+		if strings.Contains(vindex.Type, "lookup") && vindex.Params["table"] == table {
+			return name, vindex, nil
+		}
+	}
+	return "", nil, nil
+}
+
+// lookupVindexStatus returns the status of the lookup vindex backfilled by
+// the workflow of rsr, or nil if it does not backfill any.
+func (wr *Wrangler) lookupVindexStatus(ctx context.Context, rsr *ReplicationStatusResult) (*LookupVindexStatus, error) {
+	name, vindex, err := wr.findLookupVindex(ctx, rsr.SourceLocation.Keyspace, rsr.TargetLocation.Keyspace, rsr.Workflow)
+	if err != nil || vindex == nil {
+		return nil, err
+	}
+	status := &LookupVindexStatus{
+		Name:      rsr.SourceLocation.Keyspace + "." + name,
+		Owner:     vindex.Owner,
+		WriteOnly: vindex.Params["write_only"] == "true",
+	}
+	status.LastVDiff, status.State, err = wr.lastLookupVDiff(ctx, rsr.TargetLocation.Keyspace, rsr.Workflow, rsr.TargetLocation.Shards)
+	if err != nil {
+		return nil, err
+	}
+	copying := false
+	for _, shardStatus := range rsr.ShardStatuses {
+		for _, stream := range shardStatus.MasterReplicationStatuses {
+			if len(stream.CopyState) != 0 {
+				copying = true
+			}
+		}
+	}
+	switch {
+	case !status.WriteOnly:
+		status.State = LookupVindexExternalized
+	case copying:
+		status.State = LookupVindexCopying
+	case status.LastVDiff == "":
+		status.State = LookupVindexNotVerified
+	}
+	return status, nil
+}
+
+// lastLookupVDiff returns the uuid of the last vdiff of the workflow which
+// backfills a lookup vindex, and the verification state of the vindex that
+// it gives, across the target shards.
+func (wr *Wrangler) lastLookupVDiff(ctx context.Context, keyspace, workflowName string, shards []string) (string, string, error) {
+	resp, err := workflow.NewServer(wr.ts, wr.tmc).VDiffShow(ctx, &vtctldatapb.VDiffShowRequest{
+		Keyspace: keyspace,
+		Workflow: workflowName,
+		Uuid:     workflow.VDiffLast,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if len(resp.Vdiffs) == 0 {
+		return "", LookupVindexNotVerified, nil
+	}
+	vdiff := resp.Vdiffs[len(resp.Vdiffs)-1]
+	var failed, mismatch, running bool
+	for _, shard := range shards {
+		status := vdiff.ShardStatuses[shard]
+		if status == nil {
+			return vdiff.Uuid, LookupVindexNotVerified, nil
+		}
+		switch status.State {
+		case "completed":
+		case "error":
+			failed = true
+		default:
+			running = true
+		}
+		for _, table := range status.Tables {
+			if table.HasMismatch {
+				mismatch = true
+			}
+		}
+	}
+	switch {
+	case failed:
+		return vdiff.Uuid, LookupVindexVDiffError, nil
+	case mismatch:
+		return vdiff.Uuid, LookupVindexMismatch, nil
+	case running:
+		return vdiff.Uuid, LookupVindexVerifying, nil
+	}
+	return vdiff.Uuid, LookupVindexVerified, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestLookupVindexStatus(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	err := env.topoServ.SaveVSchema(context.Background(), ms.SourceKeyspace, &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"owned": {
+				Type:   "lookup_unique",
+				Params: map[string]string{"table": "targetks.lkp", "from": "c1", "to": "c2", "write_only": "true"},
+				Owner:  "t1",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	newResult := func(workflow string, copying bool) *ReplicationStatusResult {
+		status := &ReplicationStatus{State: "Stopped"}
+		if copying {
+			status.CopyState = []copyState{{Table: "lkp"}}
+		}
+		return &ReplicationStatusResult{
+			Workflow:       workflow,
+			SourceLocation: ReplicationLocation{Keyspace: "sourceks", Shards: []string{"0"}},
+			TargetLocation: ReplicationLocation{Keyspace: "targetks", Shards: []string{"-80", "80-"}},
+			ShardStatuses: map[string]*ShardReplicationStatus{
+				"-80/zone1-200": {MasterReplicationStatuses: []*ReplicationStatus{status}},
+			},
+		}
+	}
+	vdiff := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|vdiff_uuid|state|last_error|created_at|started_at|completed_at",
+		"int64|varchar|varbinary|varbinary|timestamp|timestamp|timestamp"),
+		"1|u1|completed||2021-01-01 00:00:00|2021-01-01 00:00:00|2021-01-01 00:01:00",
+	)
+	vdiffTables := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"vdiff_id|table_name|state|rows_compared|mismatch|report",
+		"int64|varbinary|varbinary|int64|int64|json"),
+		"1|lkp|completed|10|0|",
+	)
+	expectVDiff := func(vdiff, vdiffTables *sqltypes.Result) {
+		for _, tabletID := range []int{200, 210} {
+			env.tmc.expectVRQuery(tabletID, "/from _vt.vdiff where", vdiff)
+			if vdiffTables != nil {
+				env.tmc.expectVRQuery(tabletID, "/from _vt.vdiff_table where", vdiffTables)
+			}
+		}
+	}
+
+	// Other workflows have no lookup vindex status.
+	status, err := env.wr.lookupVindexStatus(context.Background(), newResult("wf", false))
+	require.NoError(t, err)
+	assert.Nil(t, status)
+
+	expectVDiff(sqltypes.MakeTestResult(vdiff.Fields), nil)
+	status, err = env.wr.lookupVindexStatus(context.Background(), newResult("lkp_vdx", true))
+	require.NoError(t, err)
+	assert.Equal(t, &LookupVindexStatus{
+		Name:      "sourceks.owned",
+		Owner:     "t1",
+		WriteOnly: true,
+		State:     LookupVindexCopying,
+	}, status)
+
+	expectVDiff(sqltypes.MakeTestResult(vdiff.Fields), nil)
+	status, err = env.wr.lookupVindexStatus(context.Background(), newResult("lkp_vdx", false))
+	require.NoError(t, err)
+	assert.Equal(t, LookupVindexNotVerified, status.State)
+
+	expectVDiff(vdiff, vdiffTables)
+	status, err = env.wr.lookupVindexStatus(context.Background(), newResult("lkp_vdx", false))
+	require.NoError(t, err)
+	assert.Equal(t, "u1", status.LastVDiff)
+	assert.Equal(t, LookupVindexVerified, status.State)
+	env.tmc.verifyQueries(t)
+}
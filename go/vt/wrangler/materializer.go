@@ -647,7 +647,9 @@ func generateColDef(lines []string, sourceVindexCol, vindexFromCol string) (stri
 }
 
 // ExternalizeVindex externalizes a lookup vindex that's finished backfilling or has caught up.
-func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName string) error {
+// Unless skipVDiff is set, the last vdiff of the workflow which backfilled it must have
+// completed without mismatch on all the target shards.
+func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName string, skipVDiff bool) error {
 	splits := strings.Split(qualifiedVindexName, ".")
 	if len(splits) != 2 {
 		return fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
@@ -667,7 +669,7 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 		return fmt.Errorf("table name in vindex should be of the form keyspace.table: %s", qualifiedTableName)
 	}
 	targetKeyspace, targetTableName := splits[0], splits[1]
-	workflow := targetTableName + "_vdx"
+	workflow := targetTableName + lookupVindexWorkflowSuffix
 	targetShards, err := wr.ts.GetServingShards(ctx, targetKeyspace)
 	if err != nil {
 		return err
@@ -701,6 +703,7 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 			return err
 		}
 		qr := sqltypes.Proto3ToResult(p3qr)
+		var ids []string
 		for _, row := range qr.Rows {
 			id, err := evalengine.ToInt64(row[0])
 			if err != nil {
@@ -713,6 +716,7 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 				if state != binlogplayer.BlpRunning {
 					return fmt.Errorf("stream %d for %v.%v is not in Running state: %v", id, targetShard.Keyspace(), targetShard.ShardName(), state)
 				}
+				ids = append(ids, fmt.Sprintf("%d", id))
 			} else {
 				// If there is an owner, all streams need to be stopped after copy.
 				if state != binlogplayer.BlpStopped || !strings.Contains(message, "Stopped after copy") {
@@ -720,12 +724,40 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 				}
 			}
 		}
+		if len(ids) == 0 {
+			return nil
+		}
+		// The running streams must be done copying.
+		p3qr, err = wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select distinct vrepl_id from _vt.copy_state where vrepl_id in (%s)", strings.Join(ids, ", ")))
+		if err != nil {
+			return err
+		}
+		if qr := sqltypes.Proto3ToResult(p3qr); len(qr.Rows) != 0 {
+			return fmt.Errorf("stream %v for %v.%v is still copying", qr.Rows[0][0].ToString(), targetShard.Keyspace(), targetShard.ShardName())
+		}
 		return nil
 	})
 	if err != nil {
 		return err
 	}
 
+	if !skipVDiff {
+		shards := make([]string, 0, len(targetShards))
+		for _, targetShard := range targetShards {
+			shards = append(shards, targetShard.ShardName())
+		}
+		uuid, state, err := wr.lastLookupVDiff(ctx, targetKeyspace, workflow, shards)
+		if err != nil {
+			return err
+		}
+		switch {
+		case uuid == "":
+			return fmt.Errorf("workflow %s.%s has no vdiff: verify the lookup vindex with VDiff first, or skip the verification", targetKeyspace, workflow)
+		case state != LookupVindexVerified:
+			return fmt.Errorf("vdiff %s of workflow %s.%s is not verified: %s", uuid, targetKeyspace, workflow, state)
+		}
+	}
+
 	if sourceVindex.Owner != "" {
 		// If there is an owner, we have to delete the streams.
 		err := forAllTargets(func(targetShard *topo.ShardInfo) error {
@@ -745,7 +777,8 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 		}
 	}
 
-	// Remove the write_only param and save the source vschema.
+	// Remove the write_only param and save the source vschema: this
+	// switches the vindex to a regular one in a single update.
 	delete(sourceVindex.Params, "write_only")
 	if err := wr.ts.SaveVSchema(ctx, sourceKeyspace, sourceVSchema); err != nil {
 		return err
@@ -256,6 +256,11 @@ func (tmc *testMaterializerTMClient) ExecuteFetchAsDba(ctx context.Context, tabl
 	return tmc.VReplicationExec(ctx, tablet, string(query))
 }
 
+func (tmc *testMaterializerTMClient) VExec(ctx context.Context, tablet *topodatapb.Tablet, query, workflow, keyspace string) (*querypb.QueryResult, error) {
+	// Reuse VReplicationExec
+	return tmc.VReplicationExec(ctx, tablet, query)
+}
+
 func (tmc *testMaterializerTMClient) verifyQueries(t *testing.T) {
 	t.Helper()
 
@@ -1489,18 +1489,63 @@ func TestExternalizeVindex(t *testing.T) {
 	)
 	running := sqltypes.MakeTestResult(fields, "1|Running|msg")
 	stopped := sqltypes.MakeTestResult(fields, "1|Stopped|Stopped after copy")
+	copied := sqltypes.MakeTestResult(sqltypes.MakeTestFields("vrepl_id", "int64"))
+	copying := sqltypes.MakeTestResult(sqltypes.MakeTestFields("vrepl_id", "int64"), "1")
+	vdiffFields := sqltypes.MakeTestFields(
+		"id|vdiff_uuid|state|last_error|created_at|started_at|completed_at",
+		"int64|varchar|varbinary|varbinary|timestamp|timestamp|timestamp",
+	)
+	vdiffTableFields := sqltypes.MakeTestFields(
+		"vdiff_id|table_name|state|rows_compared|mismatch|report",
+		"int64|varbinary|varbinary|int64|int64|json",
+	)
+	noVDiff := sqltypes.MakeTestResult(vdiffFields)
+	completed := sqltypes.MakeTestResult(vdiffFields, "1|u1|completed||2021-01-01 00:00:00|2021-01-01 00:00:00|2021-01-01 00:01:00")
+	matching := sqltypes.MakeTestResult(vdiffTableFields, "1|lkp|completed|10|0|")
+	mismatching := sqltypes.MakeTestResult(vdiffTableFields, "1|lkp|completed|10|1|")
 	testcases := []struct {
-		input        string
-		vrResponse   *sqltypes.Result
-		expectDelete bool
-		err          string
+		input         string
+		skipVDiff     bool
+		vrResponse    *sqltypes.Result
+		copyResponse  *sqltypes.Result
+		vdiffResponse *sqltypes.Result
+		tableResponse *sqltypes.Result
+		expectDelete  bool
+		err           string
 	}{{
+		input:         "sourceks.owned",
+		vrResponse:    stopped,
+		vdiffResponse: completed,
+		tableResponse: matching,
+		expectDelete:  true,
+	}, {
+		input:         "sourceks.unowned",
+		vrResponse:    running,
+		copyResponse:  copied,
+		vdiffResponse: completed,
+		tableResponse: matching,
+	}, {
 		input:        "sourceks.owned",
+		skipVDiff:    true,
 		vrResponse:   stopped,
 		expectDelete: true,
 	}, {
-		input:      "sourceks.unowned",
-		vrResponse: running,
+		input:         "sourceks.owned",
+		vrResponse:    stopped,
+		vdiffResponse: noVDiff,
+		err:           "workflow targetks.lkp_vdx has no vdiff",
+	}, {
+		input:         "sourceks.owned",
+		vrResponse:    stopped,
+		vdiffResponse: completed,
+		tableResponse: mismatching,
+		err:           "vdiff u1 of workflow targetks.lkp_vdx is not verified: Mismatch",
+	}, {
+		input:        "sourceks.unowned",
+		skipVDiff:    true,
+		vrResponse:   running,
+		copyResponse: copying,
+		err:          "stream 1 for targetks.-80 is still copying",
 	}, {
 		input: "unqualified",
 		err:   "vindex name should be of the form keyspace.vindex: unqualified",
@@ -1529,6 +1574,19 @@ func TestExternalizeVindex(t *testing.T) {
 			env.tmc.expectVRQuery(200, validationQuery, tcase.vrResponse)
 			env.tmc.expectVRQuery(210, validationQuery, tcase.vrResponse)
 		}
+		if tcase.copyResponse != nil {
+			copyQuery := "select distinct vrepl_id from _vt.copy_state where vrepl_id in (1)"
+			env.tmc.expectVRQuery(200, copyQuery, tcase.copyResponse)
+			env.tmc.expectVRQuery(210, copyQuery, copied)
+		}
+		if tcase.vdiffResponse != nil {
+			env.tmc.expectVRQuery(200, "/from _vt.vdiff where", tcase.vdiffResponse)
+			env.tmc.expectVRQuery(210, "/from _vt.vdiff where", tcase.vdiffResponse)
+		}
+		if tcase.tableResponse != nil {
+			env.tmc.expectVRQuery(200, "/from _vt.vdiff_table where", tcase.tableResponse)
+			env.tmc.expectVRQuery(210, "/from _vt.vdiff_table where", tcase.tableResponse)
+		}
 
 		if tcase.expectDelete {
 			deleteQuery := "delete from _vt.vreplication where db_name='vt_targetks' and workflow='lkp_vdx'"
@@ -1536,13 +1594,16 @@ func TestExternalizeVindex(t *testing.T) {
 			env.tmc.expectVRQuery(210, deleteQuery, &sqltypes.Result{})
 		}
 
-		err := env.wr.ExternalizeVindex(context.Background(), tcase.input)
+		err := env.wr.ExternalizeVindex(context.Background(), tcase.input, tcase.skipVDiff)
 		if tcase.err != "" {
 			if err == nil || !strings.Contains(err.Error(), tcase.err) {
 				t.Errorf("ExternalizeVindex(%s) err: %v, must contain %v", tcase.input, err, tcase.err)
 			}
+			// Drop the queries which were not sent after the error.
+			env.tmc.vrQueries = make(map[int][]*queryResult)
 			continue
 		}
+		env.tmc.verifyQueries(t)
 		require.NoError(t, err)
 
 		outvschema, err := env.topoServ.GetVSchema(context.Background(), ms.SourceKeyspace)
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/schematools"
 
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -70,47 +71,7 @@ func (wr *Wrangler) ReloadSchema(ctx context.Context, tabletAlias *topodatapb.Ta
 // So we do this on a best-effort basis, and log warnings for any tablets
 // that fail to reload within the context deadline.
 func (wr *Wrangler) ReloadSchemaShard(ctx context.Context, keyspace, shard, replicationPos string, concurrency *sync2.Semaphore, includeMaster bool) {
-	tablets, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
-	switch {
-	case topo.IsErrType(err, topo.PartialResult):
-		// We got a partial result. Do what we can, but warn
-		// that some may be missed.
-		wr.logger.Warningf("ReloadSchemaShard(%v/%v) got a partial tablet list. Some tablets may not have schema reloaded (use vtctl ReloadSchema to fix individual tablets)", keyspace, shard)
-	case err == nil:
-		// Good case, keep going too.
-	default:
-		// This is best-effort, so just log it and move on.
-		wr.logger.Warningf("ReloadSchemaShard(%v/%v) failed to load tablet list, will not reload schema (use vtctl ReloadSchemaShard to try again): %v", keyspace, shard, err)
-		return
-	}
-
-	var wg sync.WaitGroup
-	for _, ti := range tablets {
-		if !includeMaster && ti.Type == topodatapb.TabletType_MASTER {
-			// We don't need to reload on the master
-			// because we assume ExecuteFetchAsDba()
-			// already did that.
-			continue
-		}
-
-		wg.Add(1)
-		go func(tablet *topodatapb.Tablet) {
-			defer wg.Done()
-			concurrency.Acquire()
-			defer concurrency.Release()
-			pos := replicationPos
-			// Master is always up-to-date. So, don't wait for position.
-			if tablet.Type == topodatapb.TabletType_MASTER {
-				pos = ""
-			}
-			if err := wr.tmc.ReloadSchema(ctx, tablet, pos); err != nil {
-				wr.logger.Warningf(
-					"Failed to reload schema on replica tablet %v in %v/%v (use vtctl ReloadSchema to try again): %v",
-					topoproto.TabletAliasString(tablet.Alias), keyspace, shard, err)
-			}
-		}(ti.Tablet)
-	}
-	wg.Wait()
+	schematools.ReloadShard(ctx, wr.ts, wr.tmc, wr.logger, keyspace, shard, replicationPos, concurrency, includeMaster)
 }
 
 // ReloadSchemaKeyspace reloads the schema in all shards in a
@@ -100,6 +100,13 @@ func (dr *switcherDryRun) allowTargetWrites(ctx context.Context) error {
 
 func (dr *switcherDryRun) changeRouting(ctx context.Context) error {
 	dr.drLog.Log(fmt.Sprintf("Switch routing from keyspace %s to keyspace %s", dr.ts.sourceKeyspace, dr.ts.targetKeyspace))
+	_, rangeMaps, err := dr.ts.pendingRangeMaps(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rangeMaps) > 0 {
+		dr.drLog.Log(fmt.Sprintf("Range maps [%s] will be switched", strings.Join(rangeMaps, ",")))
+	}
 	var deleteLogs, addLogs []string
 	if dr.ts.migrationType == binlogdatapb.MigrationType_TABLES {
 		tables := strings.Join(dr.ts.tables, ",")
@@ -1253,9 +1253,70 @@ func (ts *trafficSwitcher) changeRouting(ctx context.Context) error {
 	if ts.migrationType == binlogdatapb.MigrationType_TABLES {
 		return ts.changeWriteRoute(ctx)
 	}
+	// The shard routing is in the SrvKeyspace, which cannot change along with
+	// the SrvVSchema. The range maps are published first: the source shards
+	// don't take writes any more, so no vtgate writes with the old range maps
+	// to the target shards.
+	rangeMapsChanged, err := ts.switchRangeMaps(ctx)
+	if err != nil {
+		return err
+	}
+	if rangeMapsChanged {
+		if err := ts.wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
+			return err
+		}
+	}
 	return ts.changeShardRouting(ctx)
 }
 
+// pendingRangeMaps returns the range maps and the names of the range maps
+// pending for the workflow.
+func (ts *trafficSwitcher) pendingRangeMaps(ctx context.Context) (*vschemapb.RangeMaps, []string, error) {
+	rm, err := ts.wr.ts.GetRangeMaps(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var names []string
+	for _, pending := range rm.Pending {
+		if pending.Keyspace != ts.targetKeyspace || pending.Workflow != ts.workflow {
+			continue
+		}
+		for name := range pending.RangeMaps {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return rm, names, nil
+}
+
+// switchRangeMaps replaces the range maps with the ones pending for the
+// workflow. It returns true if there were pending range maps.
+func (ts *trafficSwitcher) switchRangeMaps(ctx context.Context) (bool, error) {
+	rm, names, err := ts.pendingRangeMaps(ctx)
+	if err != nil || len(names) == 0 {
+		return false, err
+	}
+	if rm.RangeMaps == nil {
+		rm.RangeMaps = make(map[string]*vschemapb.RangeMap)
+	}
+	pendings := rm.Pending[:0]
+	for _, pending := range rm.Pending {
+		if pending.Keyspace != ts.targetKeyspace || pending.Workflow != ts.workflow {
+			pendings = append(pendings, pending)
+			continue
+		}
+		for name, rangeMap := range pending.RangeMaps {
+			rm.RangeMaps[name] = rangeMap
+		}
+	}
+	rm.Pending = pendings
+	ts.wr.Logger().Infof("Switch range maps: %v", names)
+	if err := ts.wr.ts.SaveRangeMaps(ctx, rm); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (ts *trafficSwitcher) changeWriteRoute(ctx context.Context) error {
 	rules, err := topotools.GetRoutingRules(ctx, ts.wr.ts)
 	if err != nil {
@@ -1268,6 +1329,11 @@ func (ts *trafficSwitcher) changeWriteRoute(ctx context.Context) error {
 		rules[ts.sourceKeyspace+"."+table] = []string{ts.targetKeyspace + "." + table}
 		ts.wr.Logger().Infof("Add routing: %v %v", table, ts.sourceKeyspace+"."+table)
 	}
+	// The range maps are switched before the routing rules, and both are
+	// published by the same SrvVSchema rebuild.
+	if _, err := ts.switchRangeMaps(ctx); err != nil {
+		return err
+	}
 	if err := topotools.SaveRoutingRules(ctx, ts.wr.ts, rules); err != nil {
 		return err
 	}
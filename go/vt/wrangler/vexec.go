@@ -283,6 +283,9 @@ func (wr *Wrangler) WorkflowAction(ctx context.Context, workflow, keyspace, acti
 		if err != nil {
 			return nil, err
 		}
+		if replStatus.LookupVindex, err = wr.lookupVindexStatus(ctx, replStatus); err != nil {
+			return nil, err
+		}
 		err = dumpStreamListAsJSON(replStatus, wr)
 		return nil, err
 	} else if action == "listall" {
@@ -338,6 +341,8 @@ type ReplicationStatusResult struct {
 
 	// Statuses is a map of <shard>/<master tablet alias> : ShardReplicationStatus (for the given shard).
 	ShardStatuses map[string]*ShardReplicationStatus
+	// LookupVindex is set if the workflow backfills a lookup vindex created by CreateLookupVindex.
+	LookupVindex *LookupVindexStatus `json:",omitempty"`
 }
 
 // ReplicationLocation represents a location that data is either replicating from, or replicating into.
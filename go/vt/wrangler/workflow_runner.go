@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
+)
+
+// defaultSwitchTrafficTimeout is how long switching writes waits for
+// replication to catch up, when unset in a request.
+const defaultSwitchTrafficTimeout = 30 * time.Second
+
+func init() {
+	grpcvtctldserver.RegisterVReplicationWorkflowRunner(func(ts *topo.Server, tmc tmclient.TabletManagerClient, logger logutil.Logger) grpcvtctldserver.VReplicationWorkflowRunner {
+		return &workflowRunner{wr: New(logger, ts, tmc)}
+	})
+}
+
+// workflowRunner runs the MoveTables and Reshard rpcs of the VtctldServer.
+type workflowRunner struct {
+	wr *Wrangler
+}
+
+// MoveTables is part of the grpcvtctldserver.VReplicationWorkflowRunner interface.
+func (r *workflowRunner) MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error) {
+	timeout, err := switchTrafficTimeout(req.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	params := &VReplicationWorkflowParams{
+		WorkflowType:             MoveTablesWorkflow,
+		Workflow:                 req.Workflow,
+		TargetKeyspace:           req.TargetKeyspace,
+		Cells:                    strings.Join(req.Cells, ","),
+		TabletTypes:              tabletTypesParam(req.Action, req.TabletTypes),
+		ExcludeTables:            strings.Join(req.ExcludeTables, ","),
+		EnableReverseReplication: req.EnableReverseReplication,
+		DryRun:                   req.DryRun,
+		KeepData:                 req.KeepData,
+		Timeout:                  timeout,
+		Direction:                workflow.DirectionForward,
+		SourceKeyspace:           req.SourceKeyspace,
+		Tables:                   req.Tables,
+		AllTables:                req.AllTables,
+		RenameTables:             req.RenameTables,
+		AutoStart:                req.AutoStart,
+		StopAfterCopy:            req.StopAfterCopy,
+	}
+	if req.Action == vtctldatapb.VReplicationWorkflowAction_CREATE {
+		if req.SourceKeyspace == "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "source_keyspace field is required")
+		}
+		if !req.AllTables && req.Tables == "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "no tables specified to move")
+		}
+		if !req.AllTables && len(req.ExcludeTables) > 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "tables can only be excluded when all tables are moved")
+		}
+		if _, err := r.wr.ts.GetKeyspace(ctx, req.SourceKeyspace); err != nil {
+			return nil, err
+		}
+	}
+
+	state, dryRunResults, err := r.run(ctx, req.Action, params)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.MoveTablesResponse{
+		State:         state,
+		DryRunResults: dryRunResults,
+	}, nil
+}
+
+// Reshard is part of the grpcvtctldserver.VReplicationWorkflowRunner interface.
+func (r *workflowRunner) Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error) {
+	timeout, err := switchTrafficTimeout(req.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	params := &VReplicationWorkflowParams{
+		WorkflowType:             ReshardWorkflow,
+		Workflow:                 req.Workflow,
+		TargetKeyspace:           req.Keyspace,
+		Cells:                    strings.Join(req.Cells, ","),
+		TabletTypes:              tabletTypesParam(req.Action, req.TabletTypes),
+		EnableReverseReplication: req.EnableReverseReplication,
+		DryRun:                   req.DryRun,
+		KeepData:                 req.KeepData,
+		Timeout:                  timeout,
+		Direction:                workflow.DirectionForward,
+		SourceKeyspace:           req.Keyspace,
+		SourceShards:             req.SourceShards,
+		TargetShards:             req.TargetShards,
+		SkipSchemaCopy:           req.SkipSchemaCopy,
+		AutoStart:                req.AutoStart,
+		StopAfterCopy:            req.StopAfterCopy,
+	}
+	if req.Action == vtctldatapb.VReplicationWorkflowAction_CREATE && (len(req.SourceShards) == 0 || len(req.TargetShards) == 0) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "source_shards and target_shards fields are required")
+	}
+
+	state, dryRunResults, err := r.run(ctx, req.Action, params)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.ReshardResponse{
+		State:         state,
+		DryRunResults: dryRunResults,
+	}, nil
+}
+
+// run runs the action on the workflow, and returns the state of the workflow
+// after it, along with the dry run results if any.
+func (r *workflowRunner) run(ctx context.Context, action vtctldatapb.VReplicationWorkflowAction, params *VReplicationWorkflowParams) (string, []string, error) {
+	if _, err := r.wr.ts.GetKeyspace(ctx, params.TargetKeyspace); err != nil {
+		return "", nil, err
+	}
+	if params.DryRun {
+		switch action {
+		case vtctldatapb.VReplicationWorkflowAction_SWITCH_TRAFFIC, vtctldatapb.VReplicationWorkflowAction_REVERSE_TRAFFIC, vtctldatapb.VReplicationWorkflowAction_COMPLETE:
+		default:
+			return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "dry_run is only supported for SWITCH_TRAFFIC, REVERSE_TRAFFIC and COMPLETE, not for %v", action)
+		}
+	}
+
+	wf, err := r.wr.NewVReplicationWorkflow(ctx, params.WorkflowType, params)
+	if err != nil {
+		return "", nil, err
+	}
+	if !wf.Exists() && action != vtctldatapb.VReplicationWorkflowAction_CREATE {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %s.%s does not exist", params.TargetKeyspace, params.Workflow)
+	}
+
+	var dryRunResults *[]string
+	switch action {
+	case vtctldatapb.VReplicationWorkflowAction_CREATE:
+		if wf.Exists() {
+			return "", nil, vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "workflow %s.%s already exists", params.TargetKeyspace, params.Workflow)
+		}
+		err = wf.Create(ctx)
+	case vtctldatapb.VReplicationWorkflowAction_SWITCH_TRAFFIC:
+		dryRunResults, err = wf.SwitchTraffic(workflow.DirectionForward)
+	case vtctldatapb.VReplicationWorkflowAction_REVERSE_TRAFFIC:
+		dryRunResults, err = wf.ReverseTraffic()
+	case vtctldatapb.VReplicationWorkflowAction_COMPLETE:
+		dryRunResults, err = wf.Complete()
+	case vtctldatapb.VReplicationWorkflowAction_CANCEL:
+		err = wf.Cancel()
+	default:
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported workflow action %v", action)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	state := wf.CurrentState()
+	if dryRunResults == nil {
+		return state, nil, nil
+	}
+	return state, *dryRunResults, nil
+}
+
+// switchTrafficTimeout returns the timeout of a request, or the default one
+// if it is unset.
+func switchTrafficTimeout(timeout *vttimepb.Duration) (time.Duration, error) {
+	d, ok, err := protoutil.DurationFromProto(timeout)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "invalid timeout")
+	}
+	if !ok {
+		return defaultSwitchTrafficTimeout, nil
+	}
+	return d, nil
+}
+
+// tabletTypesParam returns the tablet types of a request as a workflow
+// parameter. Traffic switches default to all the tablet types.
+func tabletTypesParam(action vtctldatapb.VReplicationWorkflowAction, tabletTypes []topodatapb.TabletType) string {
+	if len(tabletTypes) == 0 {
+		switch action {
+		case vtctldatapb.VReplicationWorkflowAction_SWITCH_TRAFFIC, vtctldatapb.VReplicationWorkflowAction_REVERSE_TRAFFIC:
+			return "master,replica,rdonly"
+		}
+	}
+	return strings.Join(topoproto.MakeStringTypeList(tabletTypes), ",")
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestWorkflowRunnerMoveTables(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+	runner := &workflowRunner{wr: tme.wr}
+
+	_, err := runner.MoveTables(ctx, &vtctldatapb.MoveTablesRequest{
+		Action:         vtctldatapb.VReplicationWorkflowAction_CREATE,
+		TargetKeyspace: "ks2",
+		Workflow:       "test",
+		Tables:         "t1,t2",
+	})
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), "create without a source keyspace: %v", err)
+
+	_, err = runner.MoveTables(ctx, &vtctldatapb.MoveTablesRequest{
+		Action:         vtctldatapb.VReplicationWorkflowAction_CANCEL,
+		TargetKeyspace: "ks2",
+		Workflow:       "test",
+		DryRun:         true,
+	})
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), "dry run of a cancel: %v", err)
+
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addInvariant("select id, source, message, cell, tablet_types from _vt.vreplication where workflow='nosuchworkflow' and db_name='vt_ks2'",
+			sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+				"id|source|message|cell|tablet_types",
+				"int64|varchar|varchar|varchar|varchar"),
+			),
+		)
+	}
+	_, err = runner.MoveTables(ctx, &vtctldatapb.MoveTablesRequest{
+		Action:         vtctldatapb.VReplicationWorkflowAction_SWITCH_TRAFFIC,
+		TargetKeyspace: "ks2",
+		Workflow:       "nosuchworkflow",
+	})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err), "switch of a missing workflow: %v", err)
+
+	expectMoveTablesQueries(t, tme)
+	_, err = runner.MoveTables(ctx, &vtctldatapb.MoveTablesRequest{
+		Action:         vtctldatapb.VReplicationWorkflowAction_CREATE,
+		TargetKeyspace: "ks2",
+		Workflow:       "test",
+		SourceKeyspace: "ks1",
+		Tables:         "t1,t2",
+	})
+	assert.Equal(t, vtrpcpb.Code_ALREADY_EXISTS, vterrors.Code(err), "create of an existing workflow: %v", err)
+
+	expectMoveTablesQueries(t, tme)
+	expectMoveTablesQueries(t, tme)
+	_, err = runner.MoveTables(ctx, &vtctldatapb.MoveTablesRequest{
+		Action:         vtctldatapb.VReplicationWorkflowAction_CANCEL,
+		TargetKeyspace: "ks2",
+		Workflow:       "test",
+	})
+	require.NoError(t, err)
+	validateRoutingRuleCount(ctx, t, tme.wr.ts, 0)
+	assert.False(t, checkIfTableExistInVSchema(ctx, t, tme.wr.ts, "ks2", "t1"))
+}
+
+func TestWorkflowRunnerTabletTypes(t *testing.T) {
+	assert.Equal(t, "master,replica,rdonly", tabletTypesParam(vtctldatapb.VReplicationWorkflowAction_SWITCH_TRAFFIC, nil))
+	assert.Equal(t, "", tabletTypesParam(vtctldatapb.VReplicationWorkflowAction_CREATE, nil))
+	assert.Equal(t, "rdonly,replica", tabletTypesParam(vtctldatapb.VReplicationWorkflowAction_CREATE, []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY}))
+}